@@ -0,0 +1,22 @@
+BEGIN;
+
+-- Running, cancelling and approving executions used to require canvases:update,
+-- and now requires executions:update. Roles that could update canvases keep
+-- being able to operate them. Default roles get it from the policy templates.
+INSERT INTO casbin_rule (ptype, v0, v1, v2, v3, v4, v5)
+SELECT DISTINCT 'p', rule.v0, rule.v1, 'executions', 'update', '', ''
+FROM casbin_rule rule
+WHERE rule.ptype = 'p'
+  AND rule.v2 = 'canvases'
+  AND rule.v3 = 'update'
+  AND NOT EXISTS (
+    SELECT 1
+    FROM casbin_rule existing
+    WHERE existing.ptype = 'p'
+      AND existing.v0 = rule.v0
+      AND existing.v1 = rule.v1
+      AND existing.v2 = 'executions'
+      AND existing.v3 = 'update'
+  );
+
+COMMIT;
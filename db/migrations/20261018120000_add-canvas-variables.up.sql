@@ -0,0 +1,5 @@
+ALTER TABLE workflow_versions
+  ADD COLUMN variables jsonb DEFAULT '[]'::jsonb NOT NULL;
+
+ALTER TABLE workflows
+  ADD COLUMN environment character varying(64) DEFAULT '' NOT NULL;
@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS canvas_memory_namespaces (
+  id UUID NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+  canvas_id UUID NOT NULL REFERENCES workflows(id) ON DELETE CASCADE,
+  namespace TEXT NOT NULL,
+  ttl_seconds INTEGER NOT NULL DEFAULT 0,
+  fields JSONB NOT NULL DEFAULT '[]'::jsonb,
+  indexed_fields JSONB NOT NULL DEFAULT '[]'::jsonb,
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+  UNIQUE (canvas_id, namespace)
+);
+
+ALTER TABLE canvas_memories
+  ADD COLUMN index_values JSONB NOT NULL DEFAULT '{}'::jsonb;
+
+CREATE INDEX IF NOT EXISTS idx_canvas_memories_index_values
+  ON canvas_memories USING gin (index_values jsonb_path_ops);
+
+CREATE INDEX IF NOT EXISTS idx_canvas_memories_canvas_namespace_updated_at
+  ON canvas_memories (canvas_id, namespace, updated_at);
@@ -0,0 +1,27 @@
+CREATE TABLE IF NOT EXISTS organization_memory_namespaces (
+  id UUID NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+  organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+  namespace TEXT NOT NULL,
+  description TEXT NOT NULL DEFAULT '',
+  default_access TEXT NOT NULL DEFAULT 'none',
+  canvas_access JSONB NOT NULL DEFAULT '[]'::jsonb,
+  created_by UUID,
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+  UNIQUE (organization_id, namespace)
+);
+
+CREATE TABLE IF NOT EXISTS organization_memories (
+  id UUID NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+  organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+  namespace TEXT NOT NULL,
+  "values" JSONB NOT NULL,
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_organization_memories_organization_namespace
+  ON organization_memories (organization_id, namespace);
+
+CREATE INDEX IF NOT EXISTS idx_organization_memories_values
+  ON organization_memories USING gin ("values" jsonb_path_ops);
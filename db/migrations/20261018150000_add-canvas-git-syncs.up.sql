@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS canvas_git_syncs (
+  id UUID NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+  canvas_id UUID NOT NULL REFERENCES workflows(id) ON DELETE CASCADE,
+  organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+  integration_id UUID REFERENCES app_installations(id) ON DELETE SET NULL,
+  repository TEXT NOT NULL,
+  branch TEXT NOT NULL,
+  path TEXT NOT NULL,
+  enabled BOOLEAN NOT NULL DEFAULT true,
+  created_by UUID NOT NULL,
+  last_content_digest TEXT NOT NULL DEFAULT '',
+  last_live_version_id UUID,
+  last_change_request_id UUID,
+  drift_detected BOOLEAN NOT NULL DEFAULT false,
+  last_error TEXT NOT NULL DEFAULT '',
+  last_synced_at TIMESTAMP WITH TIME ZONE,
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+  UNIQUE (canvas_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_canvas_git_syncs_last_synced_at
+  ON canvas_git_syncs (last_synced_at)
+  WHERE enabled;
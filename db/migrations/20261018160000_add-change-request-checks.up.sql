@@ -0,0 +1,5 @@
+ALTER TABLE workflow_change_requests
+  ADD COLUMN checks jsonb DEFAULT '[]'::jsonb NOT NULL;
+
+ALTER TABLE workflows
+  ADD COLUMN change_request_checks jsonb DEFAULT '{}'::jsonb NOT NULL;
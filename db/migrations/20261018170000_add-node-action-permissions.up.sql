@@ -0,0 +1,2 @@
+ALTER TABLE workflows
+  ADD COLUMN node_action_permissions jsonb DEFAULT '[]'::jsonb NOT NULL;
@@ -0,0 +1,18 @@
+ALTER TABLE secrets
+  ADD COLUMN active_version INTEGER NOT NULL DEFAULT 1;
+
+CREATE TABLE IF NOT EXISTS secret_versions (
+  id UUID NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+  secret_id UUID NOT NULL REFERENCES secrets(id) ON DELETE CASCADE,
+  version INTEGER NOT NULL,
+  data BYTEA NOT NULL,
+  reason TEXT NOT NULL,
+  created_by UUID,
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+  activated_at TIMESTAMP WITH TIME ZONE,
+  UNIQUE (secret_id, version)
+);
+
+INSERT INTO secret_versions (secret_id, version, data, reason, created_by, created_at, activated_at)
+SELECT id, 1, data, 'created', created_by, created_at, updated_at
+FROM secrets;
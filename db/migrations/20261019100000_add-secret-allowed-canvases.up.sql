@@ -0,0 +1,2 @@
+ALTER TABLE secrets
+  ADD COLUMN allowed_canvas_ids JSONB NOT NULL DEFAULT '[]'::jsonb;
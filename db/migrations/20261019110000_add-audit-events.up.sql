@@ -0,0 +1,38 @@
+CREATE TABLE IF NOT EXISTS audit_events (
+  id UUID NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+  organization_id UUID NOT NULL,
+  actor_id UUID,
+  actor_type VARCHAR(64) NOT NULL DEFAULT '',
+  actor_name TEXT NOT NULL DEFAULT '',
+  actor_email TEXT NOT NULL DEFAULT '',
+  resource_type VARCHAR(64) NOT NULL,
+  resource_id TEXT NOT NULL DEFAULT '',
+  resource_name TEXT NOT NULL DEFAULT '',
+  action VARCHAR(64) NOT NULL,
+  method TEXT NOT NULL,
+  domain_type VARCHAR(64) NOT NULL,
+  domain_id VARCHAR(64) NOT NULL,
+  status VARCHAR(64) NOT NULL,
+  request JSONB NOT NULL DEFAULT '{}'::jsonb,
+  response JSONB NOT NULL DEFAULT '{}'::jsonb,
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_events_organization_created_at ON audit_events (organization_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_audit_events_organization_resource ON audit_events (organization_id, resource_type, resource_id);
+
+--
+-- Audit events are append-only. They are only deleted
+-- when they are older than the retention period.
+--
+CREATE OR REPLACE FUNCTION prevent_audit_event_update() RETURNS trigger
+  LANGUAGE plpgsql
+  AS $$
+BEGIN
+  RAISE EXCEPTION 'audit events are append-only';
+END;
+$$;
+
+CREATE TRIGGER audit_events_append_only
+  BEFORE UPDATE ON audit_events
+  FOR EACH ROW EXECUTE FUNCTION prevent_audit_event_update();
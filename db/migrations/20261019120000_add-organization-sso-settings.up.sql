@@ -0,0 +1,26 @@
+begin;
+
+CREATE TABLE organization_sso_settings (
+  id                            uuid NOT NULL DEFAULT uuid_generate_v4(),
+  organization_id               uuid NOT NULL,
+  protocol                      CHARACTER VARYING(16) NOT NULL DEFAULT 'oidc',
+  enabled                       boolean NOT NULL DEFAULT false,
+  enforced                      boolean NOT NULL DEFAULT false,
+  jit_provisioning              boolean NOT NULL DEFAULT false,
+  oidc_issuer_url               CHARACTER VARYING(2048),
+  oidc_client_id                CHARACTER VARYING(255),
+  oidc_client_secret_ciphertext BYTEA,
+  saml_idp_metadata_url         CHARACTER VARYING(2048),
+  saml_idp_metadata             text,
+  updated_by                    uuid,
+  created_at                    timestamp without time zone NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at                    timestamp without time zone NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+  PRIMARY KEY (id),
+  CONSTRAINT organization_sso_settings_organization_id_key UNIQUE (organization_id),
+  CONSTRAINT organization_sso_settings_protocol_check CHECK (protocol IN ('oidc', 'saml')),
+  FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE,
+  FOREIGN KEY (updated_by) REFERENCES users(id) ON DELETE SET NULL
+);
+
+commit;
@@ -0,0 +1,21 @@
+begin;
+
+CREATE TABLE organization_sso_domains (
+  id                  uuid NOT NULL DEFAULT uuid_generate_v4(),
+  organization_id     uuid NOT NULL,
+  domain              CHARACTER VARYING(255) NOT NULL,
+  verification_token  CHARACTER VARYING(64) NOT NULL,
+  verified_at         timestamp without time zone,
+  created_at          timestamp without time zone NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+  PRIMARY KEY (id),
+  CONSTRAINT organization_sso_domains_organization_id_domain_key UNIQUE (organization_id, domain),
+  FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE
+);
+
+--
+-- A domain can only be verified by one organization.
+--
+CREATE UNIQUE INDEX idx_organization_sso_domains_verified_domain ON organization_sso_domains (domain) WHERE verified_at IS NOT NULL;
+
+commit;
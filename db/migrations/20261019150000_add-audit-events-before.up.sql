@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE audit_events ADD COLUMN before jsonb NOT NULL DEFAULT '{}'::jsonb;
+
+COMMIT;
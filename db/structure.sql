@@ -33,6 +33,19 @@ CREATE EXTENSION IF NOT EXISTS "uuid-ossp" WITH SCHEMA public;
 COMMENT ON EXTENSION "uuid-ossp" IS 'generate universally unique identifiers (UUIDs)';
 
 
+--
+-- Name: prevent_audit_event_update(); Type: FUNCTION; Schema: public; Owner: -
+--
+
+CREATE FUNCTION public.prevent_audit_event_update() RETURNS trigger
+    LANGUAGE plpgsql
+    AS $$
+BEGIN
+  RAISE EXCEPTION 'audit events are append-only';
+END;
+$$;
+
+
 SET default_tablespace = '';
 
 SET default_table_access_method = heap;
@@ -150,6 +163,32 @@ CREATE TABLE public.app_installations (
 );
 
 
+--
+-- Name: audit_events; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.audit_events (
+    id uuid DEFAULT gen_random_uuid() NOT NULL,
+    organization_id uuid NOT NULL,
+    actor_id uuid,
+    actor_type character varying(64) DEFAULT ''::character varying NOT NULL,
+    actor_name text DEFAULT ''::text NOT NULL,
+    actor_email text DEFAULT ''::text NOT NULL,
+    resource_type character varying(64) NOT NULL,
+    resource_id text DEFAULT ''::text NOT NULL,
+    resource_name text DEFAULT ''::text NOT NULL,
+    action character varying(64) NOT NULL,
+    method text NOT NULL,
+    domain_type character varying(64) NOT NULL,
+    domain_id character varying(64) NOT NULL,
+    status character varying(64) NOT NULL,
+    request jsonb DEFAULT '{}'::jsonb NOT NULL,
+    response jsonb DEFAULT '{}'::jsonb NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    before jsonb DEFAULT '{}'::jsonb NOT NULL
+);
+
+
 --
 -- Name: blueprints; Type: TABLE; Schema: public; Owner: -
 --
@@ -171,6 +210,31 @@ CREATE TABLE public.blueprints (
 );
 
 
+--
+-- Name: canvas_git_syncs; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.canvas_git_syncs (
+    id uuid DEFAULT gen_random_uuid() NOT NULL,
+    canvas_id uuid NOT NULL,
+    organization_id uuid NOT NULL,
+    integration_id uuid,
+    repository text NOT NULL,
+    branch text NOT NULL,
+    path text NOT NULL,
+    enabled boolean DEFAULT true NOT NULL,
+    created_by uuid NOT NULL,
+    last_content_digest text DEFAULT ''::text NOT NULL,
+    last_live_version_id uuid,
+    last_change_request_id uuid,
+    drift_detected boolean DEFAULT false NOT NULL,
+    last_error text DEFAULT ''::text NOT NULL,
+    last_synced_at timestamp with time zone,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
 --
 -- Name: canvas_memories; Type: TABLE; Schema: public; Owner: -
 --
@@ -181,6 +245,23 @@ CREATE TABLE public.canvas_memories (
     "values" jsonb NOT NULL,
     id uuid DEFAULT gen_random_uuid() NOT NULL,
     created_at timestamp with time zone DEFAULT now() NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL,
+    index_values jsonb DEFAULT '{}'::jsonb NOT NULL
+);
+
+
+--
+-- Name: canvas_memory_namespaces; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.canvas_memory_namespaces (
+    id uuid DEFAULT gen_random_uuid() NOT NULL,
+    canvas_id uuid NOT NULL,
+    namespace text NOT NULL,
+    ttl_seconds integer DEFAULT 0 NOT NULL,
+    fields jsonb DEFAULT '[]'::jsonb NOT NULL,
+    indexed_fields jsonb DEFAULT '[]'::jsonb NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
     updated_at timestamp with time zone DEFAULT now() NOT NULL
 );
 
@@ -300,6 +381,43 @@ CREATE TABLE public.organization_agent_settings (
 );
 
 
+--
+-- Name: organization_sso_domains; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.organization_sso_domains (
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
+    organization_id uuid NOT NULL,
+    domain character varying(255) NOT NULL,
+    verification_token character varying(64) NOT NULL,
+    verified_at timestamp without time zone,
+    created_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+
+--
+-- Name: organization_sso_settings; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.organization_sso_settings (
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
+    organization_id uuid NOT NULL,
+    protocol character varying(16) DEFAULT 'oidc'::character varying NOT NULL,
+    enabled boolean DEFAULT false NOT NULL,
+    enforced boolean DEFAULT false NOT NULL,
+    jit_provisioning boolean DEFAULT false NOT NULL,
+    oidc_issuer_url character varying(2048),
+    oidc_client_id character varying(255),
+    oidc_client_secret_ciphertext bytea,
+    saml_idp_metadata_url character varying(2048),
+    saml_idp_metadata text,
+    updated_by uuid,
+    created_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    CONSTRAINT organization_sso_settings_protocol_check CHECK (((protocol)::text = ANY ((ARRAY['oidc'::character varying, 'saml'::character varying])::text[])))
+);
+
+
 --
 -- Name: organization_invitations; Type: TABLE; Schema: public; Owner: -
 --
@@ -330,6 +448,37 @@ CREATE TABLE public.organization_invite_links (
 );
 
 
+--
+-- Name: organization_memories; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.organization_memories (
+    id uuid DEFAULT gen_random_uuid() NOT NULL,
+    organization_id uuid NOT NULL,
+    namespace text NOT NULL,
+    "values" jsonb NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
+--
+-- Name: organization_memory_namespaces; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.organization_memory_namespaces (
+    id uuid DEFAULT gen_random_uuid() NOT NULL,
+    organization_id uuid NOT NULL,
+    namespace text NOT NULL,
+    description text DEFAULT ''::text NOT NULL,
+    default_access text DEFAULT 'none'::text NOT NULL,
+    canvas_access jsonb DEFAULT '[]'::jsonb NOT NULL,
+    created_by uuid,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
 --
 -- Name: organizations; Type: TABLE; Schema: public; Owner: -
 --
@@ -372,6 +521,22 @@ CREATE TABLE public.schema_migrations (
 );
 
 
+--
+-- Name: secret_versions; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.secret_versions (
+    id uuid DEFAULT gen_random_uuid() NOT NULL,
+    secret_id uuid NOT NULL,
+    version integer NOT NULL,
+    data bytea NOT NULL,
+    reason text NOT NULL,
+    created_by uuid,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    activated_at timestamp with time zone
+);
+
+
 --
 -- Name: secrets; Type: TABLE; Schema: public; Owner: -
 --
@@ -385,7 +550,9 @@ CREATE TABLE public.secrets (
     provider character varying(64) NOT NULL,
     data bytea NOT NULL,
     domain_type character varying(64) NOT NULL,
-    domain_id character varying(64) NOT NULL
+    domain_id character varying(64) NOT NULL,
+    active_version integer DEFAULT 1 NOT NULL,
+    allowed_canvas_ids jsonb DEFAULT '[]'::jsonb NOT NULL
 );
 
 
@@ -465,7 +632,8 @@ CREATE TABLE public.workflow_change_requests (
     created_at timestamp without time zone NOT NULL,
     updated_at timestamp without time zone NOT NULL,
     based_on_version_id uuid,
-    conflicting_node_ids jsonb DEFAULT '[]'::jsonb NOT NULL
+    conflicting_node_ids jsonb DEFAULT '[]'::jsonb NOT NULL,
+    checks jsonb DEFAULT '[]'::jsonb NOT NULL
 );
 
 
@@ -608,7 +776,8 @@ CREATE TABLE public.workflow_versions (
     nodes jsonb DEFAULT '[]'::jsonb NOT NULL,
     edges jsonb DEFAULT '[]'::jsonb NOT NULL,
     created_at timestamp without time zone NOT NULL,
-    updated_at timestamp without time zone NOT NULL
+    updated_at timestamp without time zone NOT NULL,
+    variables jsonb DEFAULT '[]'::jsonb NOT NULL
 );
 
 
@@ -628,7 +797,10 @@ CREATE TABLE public.workflows (
     is_template boolean DEFAULT false NOT NULL,
     live_version_id uuid NOT NULL,
     versioning_enabled boolean DEFAULT false NOT NULL,
-    change_request_approvers jsonb DEFAULT '[{"type": "anyone"}]'::jsonb NOT NULL
+    change_request_approvers jsonb DEFAULT '[{"type": "anyone"}]'::jsonb NOT NULL,
+    environment character varying(64) DEFAULT ''::character varying NOT NULL,
+    change_request_checks jsonb DEFAULT '{}'::jsonb NOT NULL,
+    node_action_permissions jsonb DEFAULT '[]'::jsonb NOT NULL
 );
 
 
@@ -735,6 +907,14 @@ ALTER TABLE ONLY public.app_installations
     ADD CONSTRAINT app_installations_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: audit_events audit_events_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.audit_events
+    ADD CONSTRAINT audit_events_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: blueprints blueprints_organization_id_name_key; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -751,6 +931,22 @@ ALTER TABLE ONLY public.blueprints
     ADD CONSTRAINT blueprints_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: canvas_git_syncs canvas_git_syncs_canvas_id_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.canvas_git_syncs
+    ADD CONSTRAINT canvas_git_syncs_canvas_id_key UNIQUE (canvas_id);
+
+
+--
+-- Name: canvas_git_syncs canvas_git_syncs_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.canvas_git_syncs
+    ADD CONSTRAINT canvas_git_syncs_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: canvas_memories canvas_memories_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -759,6 +955,22 @@ ALTER TABLE ONLY public.canvas_memories
     ADD CONSTRAINT canvas_memories_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: canvas_memory_namespaces canvas_memory_namespaces_canvas_id_namespace_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.canvas_memory_namespaces
+    ADD CONSTRAINT canvas_memory_namespaces_canvas_id_namespace_key UNIQUE (canvas_id, namespace);
+
+
+--
+-- Name: canvas_memory_namespaces canvas_memory_namespaces_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.canvas_memory_namespaces
+    ADD CONSTRAINT canvas_memory_namespaces_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: casbin_rule casbin_rule_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -823,6 +1035,38 @@ ALTER TABLE ONLY public.organization_agent_settings
     ADD CONSTRAINT organization_agent_settings_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: organization_sso_domains organization_sso_domains_organization_id_domain_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.organization_sso_domains
+    ADD CONSTRAINT organization_sso_domains_organization_id_domain_key UNIQUE (organization_id, domain);
+
+
+--
+-- Name: organization_sso_domains organization_sso_domains_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.organization_sso_domains
+    ADD CONSTRAINT organization_sso_domains_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: organization_sso_settings organization_sso_settings_organization_id_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.organization_sso_settings
+    ADD CONSTRAINT organization_sso_settings_organization_id_key UNIQUE (organization_id);
+
+
+--
+-- Name: organization_sso_settings organization_sso_settings_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.organization_sso_settings
+    ADD CONSTRAINT organization_sso_settings_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: organization_invitations organization_invitations_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -855,6 +1099,30 @@ ALTER TABLE ONLY public.organization_invite_links
     ADD CONSTRAINT organization_invite_links_token_key UNIQUE (token);
 
 
+--
+-- Name: organization_memories organization_memories_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.organization_memories
+    ADD CONSTRAINT organization_memories_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: organization_memory_namespaces organization_memory_namespaces_organization_id_namespace_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.organization_memory_namespaces
+    ADD CONSTRAINT organization_memory_namespaces_organization_id_namespace_key UNIQUE (organization_id, namespace);
+
+
+--
+-- Name: organization_memory_namespaces organization_memory_namespaces_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.organization_memory_namespaces
+    ADD CONSTRAINT organization_memory_namespaces_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: organizations organizations_name_key; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -887,6 +1155,22 @@ ALTER TABLE ONLY public.schema_migrations
     ADD CONSTRAINT schema_migrations_pkey PRIMARY KEY (version);
 
 
+--
+-- Name: secret_versions secret_versions_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.secret_versions
+    ADD CONSTRAINT secret_versions_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: secret_versions secret_versions_secret_id_version_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.secret_versions
+    ADD CONSTRAINT secret_versions_secret_id_version_key UNIQUE (secret_id, version);
+
+
 --
 -- Name: secrets secrets_domain_id_name_key; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -1138,6 +1422,20 @@ CREATE UNIQUE INDEX idx_app_installations_org_name_unique ON public.app_installa
 CREATE INDEX idx_app_installations_organization_id ON public.app_installations USING btree (organization_id);
 
 
+--
+-- Name: idx_audit_events_organization_created_at; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_audit_events_organization_created_at ON public.audit_events USING btree (organization_id, created_at DESC);
+
+
+--
+-- Name: idx_audit_events_organization_resource; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_audit_events_organization_resource ON public.audit_events USING btree (organization_id, resource_type, resource_id);
+
+
 --
 -- Name: idx_blueprints_organization_id; Type: INDEX; Schema: public; Owner: -
 --
@@ -1145,6 +1443,13 @@ CREATE INDEX idx_app_installations_organization_id ON public.app_installations U
 CREATE INDEX idx_blueprints_organization_id ON public.blueprints USING btree (organization_id);
 
 
+--
+-- Name: idx_canvas_git_syncs_last_synced_at; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_canvas_git_syncs_last_synced_at ON public.canvas_git_syncs USING btree (last_synced_at) WHERE enabled;
+
+
 --
 -- Name: idx_canvas_memories_canvas_namespace; Type: INDEX; Schema: public; Owner: -
 --
@@ -1152,6 +1457,20 @@ CREATE INDEX idx_blueprints_organization_id ON public.blueprints USING btree (or
 CREATE INDEX idx_canvas_memories_canvas_namespace ON public.canvas_memories USING btree (canvas_id, namespace);
 
 
+--
+-- Name: idx_canvas_memories_canvas_namespace_updated_at; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_canvas_memories_canvas_namespace_updated_at ON public.canvas_memories USING btree (canvas_id, namespace, updated_at);
+
+
+--
+-- Name: idx_canvas_memories_index_values; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_canvas_memories_index_values ON public.canvas_memories USING gin (index_values jsonb_path_ops);
+
+
 --
 -- Name: idx_casbin_rule_ptype; Type: INDEX; Schema: public; Owner: -
 --
@@ -1201,6 +1520,27 @@ CREATE INDEX idx_node_requests_state_run_at ON public.workflow_node_requests USI
 CREATE INDEX idx_organization_agent_settings_organization_id ON public.organization_agent_settings USING btree (organization_id);
 
 
+--
+-- Name: idx_organization_memories_organization_namespace; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_organization_memories_organization_namespace ON public.organization_memories USING btree (organization_id, namespace);
+
+
+--
+-- Name: idx_organization_memories_values; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_organization_memories_values ON public.organization_memories USING gin ("values" jsonb_path_ops);
+
+
+--
+-- Name: idx_organization_sso_domains_verified_domain; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX idx_organization_sso_domains_verified_domain ON public.organization_sso_domains USING btree (domain) WHERE (verified_at IS NOT NULL);
+
+
 --
 -- Name: idx_organizations_deleted_at; Type: INDEX; Schema: public; Owner: -
 --
@@ -1467,6 +1807,13 @@ CREATE UNIQUE INDEX unique_human_user_in_organization ON public.users USING btre
 CREATE UNIQUE INDEX unique_service_account_in_organization ON public.users USING btree (organization_id, name) WHERE ((type)::text = 'service_account'::text);
 
 
+--
+-- Name: audit_events audit_events_append_only; Type: TRIGGER; Schema: public; Owner: -
+--
+
+CREATE TRIGGER audit_events_append_only BEFORE UPDATE ON public.audit_events FOR EACH ROW EXECUTE FUNCTION public.prevent_audit_event_update();
+
+
 --
 -- Name: account_password_auth account_password_auth_account_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -1539,6 +1886,30 @@ ALTER TABLE ONLY public.app_installations
     ADD CONSTRAINT app_installations_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id) ON DELETE CASCADE;
 
 
+--
+-- Name: canvas_git_syncs canvas_git_syncs_canvas_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.canvas_git_syncs
+    ADD CONSTRAINT canvas_git_syncs_canvas_id_fkey FOREIGN KEY (canvas_id) REFERENCES public.workflows(id) ON DELETE CASCADE;
+
+
+--
+-- Name: canvas_git_syncs canvas_git_syncs_integration_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.canvas_git_syncs
+    ADD CONSTRAINT canvas_git_syncs_integration_id_fkey FOREIGN KEY (integration_id) REFERENCES public.app_installations(id) ON DELETE SET NULL;
+
+
+--
+-- Name: canvas_git_syncs canvas_git_syncs_organization_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.canvas_git_syncs
+    ADD CONSTRAINT canvas_git_syncs_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id) ON DELETE CASCADE;
+
+
 --
 -- Name: canvas_memories canvas_memories_canvas_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -1547,6 +1918,14 @@ ALTER TABLE ONLY public.canvas_memories
     ADD CONSTRAINT canvas_memories_canvas_id_fkey FOREIGN KEY (canvas_id) REFERENCES public.workflows(id) ON DELETE CASCADE;
 
 
+--
+-- Name: canvas_memory_namespaces canvas_memory_namespaces_canvas_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.canvas_memory_namespaces
+    ADD CONSTRAINT canvas_memory_namespaces_canvas_id_fkey FOREIGN KEY (canvas_id) REFERENCES public.workflows(id) ON DELETE CASCADE;
+
+
 --
 -- Name: workflow_node_execution_kvs fk_wnek_workflow; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -1619,6 +1998,30 @@ ALTER TABLE ONLY public.organization_agent_settings
     ADD CONSTRAINT organization_agent_settings_updated_by_fkey FOREIGN KEY (updated_by) REFERENCES public.users(id) ON DELETE SET NULL;
 
 
+--
+-- Name: organization_sso_domains organization_sso_domains_organization_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.organization_sso_domains
+    ADD CONSTRAINT organization_sso_domains_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id) ON DELETE CASCADE;
+
+
+--
+-- Name: organization_sso_settings organization_sso_settings_organization_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.organization_sso_settings
+    ADD CONSTRAINT organization_sso_settings_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id) ON DELETE CASCADE;
+
+
+--
+-- Name: organization_sso_settings organization_sso_settings_updated_by_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.organization_sso_settings
+    ADD CONSTRAINT organization_sso_settings_updated_by_fkey FOREIGN KEY (updated_by) REFERENCES public.users(id) ON DELETE SET NULL;
+
+
 --
 -- Name: organization_invitations organization_invitations_organization_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -1635,6 +2038,30 @@ ALTER TABLE ONLY public.organization_invite_links
     ADD CONSTRAINT organization_invite_links_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id) ON DELETE CASCADE;
 
 
+--
+-- Name: organization_memories organization_memories_organization_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.organization_memories
+    ADD CONSTRAINT organization_memories_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id) ON DELETE CASCADE;
+
+
+--
+-- Name: organization_memory_namespaces organization_memory_namespaces_organization_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.organization_memory_namespaces
+    ADD CONSTRAINT organization_memory_namespaces_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id) ON DELETE CASCADE;
+
+
+--
+-- Name: secret_versions secret_versions_secret_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.secret_versions
+    ADD CONSTRAINT secret_versions_secret_id_fkey FOREIGN KEY (secret_id) REFERENCES public.secrets(id) ON DELETE CASCADE;
+
+
 --
 -- Name: users users_account_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -1939,7 +2366,7 @@ SET row_security = off;
 --
 
 COPY public.schema_migrations (version, dirty) FROM stdin;
-20260318013637	f
+20261019150000	f
 \.
 
 
@@ -1975,7 +2402,7 @@ SET row_security = off;
 --
 
 COPY public.data_migrations (version, dirty) FROM stdin;
-20260202201226	f
+20261019130000	f
 \.
 
 
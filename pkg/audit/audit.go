@@ -0,0 +1,142 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/models"
+	pbCanvases "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/datatypes"
+)
+
+// StatusPermissionDenied is recorded for calls rejected by the authorization checks.
+// The caller gets a NotFound error in that case, so it cannot tell
+// if the resource exists, but the audit log keeps the real reason.
+const StatusPermissionDenied = "PermissionDenied"
+
+// Execution actions all need the executions:update permission,
+// so the audit log records what was done instead.
+// Actions invoked on nodes and executions, like approvals,
+// are recorded with the name of the action.
+var executionActions = map[string]string{
+	pbCanvases.Canvases_CancelExecution_FullMethodName:        "cancel",
+	pbCanvases.Canvases_ResolveExecutionErrors_FullMethodName: "resolve_errors",
+	pbCanvases.Canvases_DeleteNodeQueueItem_FullMethodName:    "delete_queue_item",
+	pbCanvases.Canvases_UpdateNodePause_FullMethodName:        "update_pause",
+	pbCanvases.Canvases_EmitNodeEvent_FullMethodName:          "emit_event",
+}
+
+// Call describes a gRPC call that tried to change something in an organization.
+type Call struct {
+	OrganizationID uuid.UUID
+	UserID         string
+	ResourceType   string
+	Action         string
+	Method         string
+	DomainType     string
+	DomainID       string
+	Request        any
+	Response       any
+	Err            error
+	Denied         bool
+
+	// Before is the resource changed by the call, as it was before the call.
+	Before any
+}
+
+// StateLoader loads the resource changed by a call, before the call is handled,
+// so the audit log records what it looked like before it was changed.
+type StateLoader func(ctx context.Context, request any) (proto.Message, error)
+
+// RecordCall stores an audit event for a gRPC call.
+// Failing to record it does not fail the call, but is logged.
+func RecordCall(call Call) {
+	event := NewEventForCall(call)
+
+	err := models.CreateAuditEvent(event)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"audit":           "audit_event_failed",
+			"organization_id": call.OrganizationID.String(),
+			"method":          call.Method,
+		}).WithError(err).Error("Error recording audit event")
+	}
+}
+
+func NewEventForCall(call Call) *models.AuditEvent {
+	request := Summarize(call.ResourceType, call.Request)
+	response := map[string]any{}
+	if call.Err == nil && !call.Denied {
+		response = Summarize(call.ResourceType, call.Response)
+	}
+
+	resourceID, resourceName := findResource(request, response)
+	event := &models.AuditEvent{
+		OrganizationID: call.OrganizationID,
+		ResourceType:   call.ResourceType,
+		ResourceID:     resourceID,
+		ResourceName:   resourceName,
+		Action:         call.Action,
+		Method:         call.Method,
+		DomainType:     call.DomainType,
+		DomainID:       call.DomainID,
+		Status:         callStatus(call),
+		Request:        datatypes.NewJSONType(request),
+		Response:       datatypes.NewJSONType(response),
+		Before:         datatypes.NewJSONType(Summarize(call.ResourceType, call.Before)),
+	}
+
+	if action, ok := executionActions[call.Method]; ok {
+		event.Action = action
+	}
+
+	if action := stringField(request, "actionName"); action != "" && len(action) <= 64 {
+		event.Action = action
+	}
+
+	setActor(event, call.OrganizationID, call.UserID)
+	return event
+}
+
+func callStatus(call Call) string {
+	if call.Denied {
+		return StatusPermissionDenied
+	}
+
+	return status.Code(call.Err).String()
+}
+
+// setActor copies the name and email of the actor into the event, so the audit log
+// still shows who made a change after they leave the organization.
+func setActor(event *models.AuditEvent, organizationID uuid.UUID, userID string) {
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return
+	}
+
+	event.ActorID = &id
+	user, err := models.FindMaybeDeletedUserByID(organizationID.String(), userID)
+	if err != nil {
+		return
+	}
+
+	event.ActorType = user.Type
+	event.ActorName = user.Name
+	event.ActorEmail = user.GetEmail()
+}
+
+func isProtoMessage(v any) (proto.Message, bool) {
+	message, ok := v.(proto.Message)
+	if !ok || message == nil {
+		return nil, false
+	}
+
+	if !message.ProtoReflect().IsValid() {
+		return nil, false
+	}
+
+	return message, true
+}
@@ -0,0 +1,39 @@
+package audit
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	pbSecrets "github.com/superplanehq/superplane/pkg/protos/secrets"
+)
+
+func Test__NewEventForCall(t *testing.T) {
+	call := Call{
+		OrganizationID: uuid.New(),
+		ResourceType:   "secrets",
+		Action:         "update",
+		Method:         pbSecrets.Secrets_UpdateSecret_FullMethodName,
+		Request:        &pbSecrets.UpdateSecretRequest{IdOrName: "prod-token"},
+		Before: &pbSecrets.DescribeSecretResponse{
+			Secret: &pbSecrets.Secret{
+				Metadata: &pbSecrets.Secret_Metadata{Id: "secret-1", Name: "prod-token"},
+				Spec: &pbSecrets.Secret_Spec{
+					Local: &pbSecrets.Secret_Local{Data: map[string]string{"token": "old"}},
+				},
+			},
+		},
+	}
+
+	t.Run("state before the call is summarized and redacted", func(t *testing.T) {
+		event := NewEventForCall(call)
+		before := event.Before.Data()["secret"].(map[string]any)
+		assert.Equal(t, "prod-token", before["metadata"].(map[string]any)["name"])
+		assert.Equal(t, RedactedValue, before["spec"].(map[string]any)["local"].(map[string]any)["data"])
+	})
+
+	t.Run("no state before the call -> empty", func(t *testing.T) {
+		call.Before = nil
+		assert.Empty(t, NewEventForCall(call).Before.Data())
+	})
+}
@@ -0,0 +1,165 @@
+package audit
+
+import (
+	"encoding/json"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	RedactedValue = "<redacted>"
+
+	// Summaries bigger than this, like full canvas specs,
+	// only keep their scalar fields and resource metadata.
+	maxSummarySize = 16 * 1024
+)
+
+// Fields that hold values which must never end up in the audit log,
+// like secret values, tokens and API keys. The names are the JSON names of the fields.
+var redactedFields = map[string]bool{
+	"data":         true,
+	"value":        true,
+	"token":        true,
+	"apiKey":       true,
+	"password":     true,
+	"formFields":   true,
+	"clientSecret": true,
+}
+
+// Integration configuration can hold credentials for the integration.
+var redactedFieldsByResource = map[string]map[string]bool{
+	"integrations": {"configuration": true},
+}
+
+// Fields that identify the resource changed by a call, in order of preference.
+// Integration requests use id for the organization, so integrationId comes first.
+var resourceIDFields = []string{"integrationId", "id", "idOrName", "executionId", "itemId", "nodeId", "canvasId", "userId", "groupName", "roleName"}
+
+// Summarize converts a request or response into a map for the audit log,
+// with sensitive values redacted.
+func Summarize(resourceType string, message any) map[string]any {
+	m, ok := isProtoMessage(message)
+	if !ok {
+		return map[string]any{}
+	}
+
+	data, err := protojson.Marshal(m)
+	if err != nil {
+		return map[string]any{}
+	}
+
+	summary := map[string]any{}
+	err = json.Unmarshal(data, &summary)
+	if err != nil {
+		return map[string]any{}
+	}
+
+	redact(summary, redactedFieldsByResource[resourceType])
+
+	if len(data) > maxSummarySize {
+		return truncate(summary)
+	}
+
+	return summary
+}
+
+func redact(value any, extra map[string]bool) {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, field := range v {
+			if redactedFields[key] || extra[key] {
+				v[key] = RedactedValue
+				continue
+			}
+
+			redact(field, extra)
+		}
+
+	case []any:
+		for _, item := range v {
+			redact(item, extra)
+		}
+	}
+}
+
+func truncate(summary map[string]any) map[string]any {
+	truncated := map[string]any{"truncated": true}
+
+	for key, value := range summary {
+		switch v := value.(type) {
+		case map[string]any:
+			if metadata, ok := v["metadata"]; ok {
+				truncated[key] = map[string]any{"metadata": metadata}
+			}
+		case []any:
+			continue
+		default:
+			truncated[key] = v
+		}
+	}
+
+	return truncated
+}
+
+// findResource returns the ID and name of the resource changed by a call.
+// The metadata of the resource in the response is preferred,
+// since the request does not have the ID of a new resource.
+func findResource(request, response map[string]any) (string, string) {
+	id, name := findMetadata(response)
+	if id != "" {
+		return id, firstNonEmpty(name, stringField(request, "name"))
+	}
+
+	requestID, requestName := findMetadata(request)
+	if requestID != "" {
+		return requestID, firstNonEmpty(name, requestName)
+	}
+
+	for _, field := range resourceIDFields {
+		if value := stringField(request, field); value != "" {
+			return value, firstNonEmpty(name, requestName, stringField(request, "name"))
+		}
+	}
+
+	return "", firstNonEmpty(name, requestName, stringField(request, "name"))
+}
+
+func findMetadata(summary map[string]any) (string, string) {
+	for _, value := range summary {
+		resource, ok := value.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		//
+		// Most resources keep their ID and name in metadata,
+		// but some, like service accounts, have them at the top level.
+		//
+		metadata, ok := resource["metadata"].(map[string]any)
+		if !ok {
+			metadata = resource
+		}
+
+		id := stringField(metadata, "id")
+		if id != "" {
+			return id, stringField(metadata, "name")
+		}
+	}
+
+	return "", ""
+}
+
+func stringField(m map[string]any, field string) string {
+	value, _ := m[field].(string)
+	return value
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
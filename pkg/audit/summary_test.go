@@ -0,0 +1,106 @@
+package audit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pbOrganizations "github.com/superplanehq/superplane/pkg/protos/organizations"
+	pbSecrets "github.com/superplanehq/superplane/pkg/protos/secrets"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func Test__Summarize(t *testing.T) {
+	t.Run("nil message -> empty summary", func(t *testing.T) {
+		var request *pbSecrets.CreateSecretRequest
+		assert.Empty(t, Summarize("secrets", request))
+		assert.Empty(t, Summarize("secrets", nil))
+	})
+
+	t.Run("secret values are redacted", func(t *testing.T) {
+		request := &pbSecrets.CreateSecretRequest{
+			Secret: &pbSecrets.Secret{
+				Metadata: &pbSecrets.Secret_Metadata{Name: "prod-token"},
+				Spec: &pbSecrets.Secret_Spec{
+					Provider: pbSecrets.Secret_PROVIDER_LOCAL,
+					Local:    &pbSecrets.Secret_Local{Data: map[string]string{"token": "abc"}},
+				},
+			},
+		}
+
+		summary := Summarize("secrets", request)
+		secret := summary["secret"].(map[string]any)
+		assert.Equal(t, "prod-token", secret["metadata"].(map[string]any)["name"])
+		assert.Equal(t, RedactedValue, secret["spec"].(map[string]any)["local"].(map[string]any)["data"])
+	})
+
+	t.Run("integration configuration is redacted", func(t *testing.T) {
+		configuration, err := structpb.NewStruct(map[string]any{"apiToken": "abc"})
+		require.NoError(t, err)
+
+		request := &pbOrganizations.UpdateIntegrationRequest{Id: "org-1", IntegrationId: "integration-1", Configuration: configuration}
+		summary := Summarize("integrations", request)
+		assert.Equal(t, "integration-1", summary["integrationId"])
+		assert.Equal(t, RedactedValue, summary["configuration"])
+
+		//
+		// Configuration of other resources is kept.
+		//
+		summary = Summarize("canvases", request)
+		assert.Equal(t, map[string]any{"apiToken": "abc"}, summary["configuration"])
+	})
+
+	t.Run("big summaries only keep scalar fields and metadata", func(t *testing.T) {
+		data := map[string]string{}
+		for i := 0; i < 2000; i++ {
+			data[string(rune('a'+i%26))+string(rune('0'+i/26%10))+string(rune('0'+i/260))] = "0123456789"
+		}
+
+		request := &pbSecrets.UpdateSecretRequest{
+			IdOrName: "prod-token",
+			Secret: &pbSecrets.Secret{
+				Metadata: &pbSecrets.Secret_Metadata{Id: "secret-1", Name: "prod-token"},
+				Spec:     &pbSecrets.Secret_Spec{Local: &pbSecrets.Secret_Local{Data: data}},
+			},
+		}
+
+		summary := Summarize("secrets", request)
+		assert.Equal(t, true, summary["truncated"])
+		assert.Equal(t, "prod-token", summary["idOrName"])
+		assert.Equal(t, map[string]any{"metadata": map[string]any{"id": "secret-1", "name": "prod-token"}}, summary["secret"])
+	})
+}
+
+func Test__FindResource(t *testing.T) {
+	t.Run("metadata in the response is preferred", func(t *testing.T) {
+		request := map[string]any{"secret": map[string]any{"metadata": map[string]any{"name": "prod-token"}}}
+		response := map[string]any{"secret": map[string]any{"metadata": map[string]any{"id": "secret-1", "name": "prod-token"}}}
+
+		id, name := findResource(request, response)
+		assert.Equal(t, "secret-1", id)
+		assert.Equal(t, "prod-token", name)
+	})
+
+	t.Run("top-level ID and name", func(t *testing.T) {
+		response := map[string]any{"serviceAccount": map[string]any{"id": "sa-1", "name": "deployer"}}
+
+		id, name := findResource(map[string]any{}, response)
+		assert.Equal(t, "sa-1", id)
+		assert.Equal(t, "deployer", name)
+	})
+
+	t.Run("integration ID is preferred over the organization ID", func(t *testing.T) {
+		request := map[string]any{"id": "org-1", "integrationId": "integration-1"}
+
+		id, _ := findResource(request, map[string]any{})
+		assert.Equal(t, "integration-1", id)
+	})
+
+	t.Run("request fields are used when the response has no resource", func(t *testing.T) {
+		request := map[string]any{"canvasId": "canvas-1", "executionId": "execution-1"}
+
+		id, name := findResource(request, map[string]any{})
+		assert.Equal(t, "execution-1", id)
+		assert.Empty(t, name)
+	})
+}
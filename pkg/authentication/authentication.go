@@ -37,6 +37,7 @@ type Handler struct {
 	templateDir          string
 	blockSignup          bool
 	passwordLoginEnabled bool
+	baseURL              string
 }
 
 type ProviderConfig struct {
@@ -93,6 +94,12 @@ func (a *Handler) RegisterRoutes(router *mux.Router) {
 		router.HandleFunc("/signup", a.handlePasswordSignup).Methods("POST")
 	}
 
+	//
+	// SSO routes are registered before the generic provider routes,
+	// and are also available locally, since they do not depend on goth providers.
+	//
+	a.registerSSORoutes(router)
+
 	//
 	// If we are running the application locally,
 	// we provide handlers that auto-autenticate to
@@ -556,7 +563,11 @@ func allowSignupFromRequest(r *http.Request) bool {
 }
 
 func updateAccountProviders(encryptor crypto.Encryptor, account *models.Account, gothUser goth.User) error {
-	accessToken, err := encryptor.Encrypt(context.Background(), []byte(gothUser.AccessToken), []byte(gothUser.Email))
+	//
+	// The normalized email is the one stored with the provider,
+	// so the token can be re-encrypted when keys are rotated.
+	//
+	accessToken, err := encryptor.Encrypt(context.Background(), []byte(gothUser.AccessToken), []byte(utils.NormalizeEmail(gothUser.Email)))
 	if err != nil {
 		return err
 	}
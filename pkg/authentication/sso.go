@@ -0,0 +1,406 @@
+package authentication
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/markbates/goth"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/utils"
+	"gorm.io/gorm"
+)
+
+const (
+	// SSOOrganizationClaim is the claim of account tokens issued by SSO logins.
+	// These sessions can only be used for the organization they were issued for.
+	SSOOrganizationClaim = "sso_org"
+
+	ssoRequestCookie   = "sso_request"
+	ssoRequestDuration = 10 * time.Minute
+)
+
+var (
+	errSSONotMember        = errors.New("not a member of the organization")
+	errSSOUnverifiedDomain = errors.New("email domain not verified by the organization")
+)
+
+// ssoIdentity is the user authenticated by the identity provider of an organization.
+type ssoIdentity struct {
+	Provider string
+	Subject  string
+	Email    string
+	Name     string
+}
+
+// ssoRequest tracks a login started with the identity provider of an organization,
+// so the response can be matched with it. It is stored in a signed cookie.
+type ssoRequest struct {
+	OrganizationID string
+	State          string
+	// Nonce is the OIDC nonce, or the ID of the SAML authentication request.
+	Nonce       string
+	RedirectURL string
+}
+
+func SSOLoginURL(baseURL, organizationID string) string {
+	return fmt.Sprintf("%s/auth/sso/%s", strings.TrimRight(baseURL, "/"), organizationID)
+}
+
+func OIDCRedirectURL(baseURL, organizationID string) string {
+	return SSOLoginURL(baseURL, organizationID) + "/oidc/callback"
+}
+
+func SAMLACSURL(baseURL, organizationID string) string {
+	return SSOLoginURL(baseURL, organizationID) + "/saml/acs"
+}
+
+func SAMLMetadataURL(baseURL, organizationID string) string {
+	return SSOLoginURL(baseURL, organizationID) + "/saml/metadata"
+}
+
+// InitializeSSO configures the base URL used for the redirect URLs
+// registered with the identity providers of organizations.
+func (a *Handler) InitializeSSO(baseURL string) {
+	a.baseURL = strings.TrimRight(baseURL, "/")
+}
+
+func (a *Handler) registerSSORoutes(router *mux.Router) {
+	router.HandleFunc("/auth/sso/{organizationID}", a.handleSSOLogin).Methods("GET")
+	router.HandleFunc("/auth/sso/{organizationID}/oidc/callback", a.handleOIDCCallback).Methods("GET")
+	router.HandleFunc("/auth/sso/{organizationID}/saml/acs", a.handleSAMLACS).Methods("POST")
+	router.HandleFunc("/auth/sso/{organizationID}/saml/metadata", a.handleSAMLMetadata).Methods("GET")
+}
+
+func (a *Handler) handleSSOLogin(w http.ResponseWriter, r *http.Request) {
+	settings, ok := a.findEnabledSSOSettings(w, r)
+	if !ok {
+		return
+	}
+
+	state, err := randomSSOValue()
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	request := &ssoRequest{
+		OrganizationID: settings.OrganizationID.String(),
+		State:          state,
+		RedirectURL:    getRedirectURL(r),
+	}
+
+	var loginURL string
+	switch settings.Protocol {
+	case models.SSOProtocolOIDC:
+		loginURL, err = a.beginOIDCLogin(r.Context(), settings, request)
+	case models.SSOProtocolSAML:
+		loginURL, err = a.beginSAMLLogin(settings, request)
+	default:
+		err = fmt.Errorf("unsupported SSO protocol %s", settings.Protocol)
+	}
+
+	if err != nil {
+		log.Errorf("Error starting SSO login for organization %s: %v", request.OrganizationID, err)
+		http.Error(w, "Error starting SSO login", http.StatusBadGateway)
+		return
+	}
+
+	err = a.setSSORequestCookie(w, r, request)
+	if err != nil {
+		log.Errorf("Error starting SSO login for organization %s: %v", request.OrganizationID, err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	http.Redirect(w, r, loginURL, http.StatusFound)
+}
+
+func (a *Handler) findEnabledSSOSettings(w http.ResponseWriter, r *http.Request) (*models.OrganizationSSOSettings, bool) {
+	organizationID := mux.Vars(r)["organizationID"]
+
+	_, err := models.FindOrganizationByID(organizationID)
+	if err != nil {
+		http.Error(w, "SSO is not configured for this organization", http.StatusNotFound)
+		return nil, false
+	}
+
+	settings, err := models.FindOrganizationSSOSettings(organizationID)
+	if err != nil || !settings.Enabled {
+		http.Error(w, "SSO is not configured for this organization", http.StatusNotFound)
+		return nil, false
+	}
+
+	return settings, true
+}
+
+// completeSSOLogin signs in the user authenticated by the identity provider,
+// creating their account and organization membership if just-in-time provisioning is enabled.
+func (a *Handler) completeSSOLogin(w http.ResponseWriter, r *http.Request, settings *models.OrganizationSSOSettings, request *ssoRequest, identity *ssoIdentity) {
+	organizationID := settings.OrganizationID.String()
+	identity.Email = utils.NormalizeEmail(identity.Email)
+	if identity.Email == "" {
+		log.Warnf("SSO login for organization %s without email for subject %s", organizationID, identity.Subject)
+		http.Error(w, "The identity provider did not return an email for the user", http.StatusUnauthorized)
+		return
+	}
+
+	if identity.Name == "" {
+		identity.Name = identity.Email
+	}
+
+	account, err := a.provisionSSOUser(settings, identity)
+	if err != nil {
+		if errors.Is(err, errSSONotMember) {
+			log.Warnf("SSO login for organization %s rejected for %s: not a member", organizationID, identity.Email)
+			http.Error(w, "You are not a member of this organization. Ask an organization admin to invite you.", http.StatusForbidden)
+			return
+		}
+
+		if errors.Is(err, errSSOUnverifiedDomain) {
+			log.Warnf("SSO login for organization %s rejected for %s: email domain not verified", organizationID, identity.Email)
+			http.Error(w, "The domain of your email is not verified for single sign-on in this organization.", http.StatusForbidden)
+			return
+		}
+
+		log.Errorf("Error provisioning SSO user %s in organization %s: %v", identity.Email, organizationID, err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	//
+	// Provider IDs are scoped to the organization,
+	// since subjects are only unique for an identity provider.
+	//
+	err = updateAccountProviders(a.encryptor, account, goth.User{
+		Provider: identity.Provider,
+		UserID:   organizationID + ":" + identity.Subject,
+		Email:    identity.Email,
+		Name:     identity.Name,
+	})
+
+	if err != nil {
+		log.Errorf("Error updating account providers for %s: %v", identity.Email, err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	token, err := a.jwtSigner.GenerateWithClaims(account.ID.String(), 24*time.Hour, map[string]any{
+		SSOOrganizationClaim: organizationID,
+	})
+
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	clearSSORequestCookie(w, r)
+	http.SetCookie(w, &http.Cookie{
+		Name:     "account_token",
+		Value:    token,
+		Path:     "/",
+		MaxAge:   int(24 * time.Hour.Seconds()),
+		HttpOnly: true,
+		Secure:   r.TLS != nil,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	log.Infof("SSO login for %s in organization %s", identity.Email, organizationID)
+
+	redirectURL := request.RedirectURL
+	if redirectURL == "" || redirectURL == "/" {
+		redirectURL = "/" + organizationID
+	}
+
+	// SAML responses are posted, so use 303 to get the redirect with GET.
+	http.Redirect(w, r, redirectURL, http.StatusSeeOther)
+}
+
+// provisionSSOUser returns the account for the user authenticated by the identity provider.
+// Without just-in-time provisioning, the user must already be a member of the organization.
+//
+// Accounts are global, so the email must be in a domain verified by the organization.
+// Otherwise, the identity provider of any organization could sign in as any account.
+//
+// Sign-up restrictions do not apply here: organizations enabling just-in-time provisioning
+// allow everyone their identity provider authenticates to join.
+func (a *Handler) provisionSSOUser(settings *models.OrganizationSSOSettings, identity *ssoIdentity) (*models.Account, error) {
+	organizationID := settings.OrganizationID.String()
+
+	verified, err := models.IsOrganizationSSODomainVerified(organizationID, emailDomain(identity.Email))
+	if err != nil {
+		return nil, err
+	}
+
+	if !verified {
+		return nil, errSSOUnverifiedDomain
+	}
+
+	account, err := models.FindAccountByEmail(identity.Email)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	user, err := models.FindMaybeDeletedUserByEmail(organizationID, identity.Email)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	if account != nil && user != nil && !user.DeletedAt.Valid {
+		return account, nil
+	}
+
+	if !settings.JITProvisioning {
+		return nil, errSSONotMember
+	}
+
+	err = database.Conn().Transaction(func(tx *gorm.DB) error {
+		if account == nil {
+			account, err = models.CreateAccountInTransaction(tx, identity.Name, identity.Email)
+			if err != nil {
+				return err
+			}
+		}
+
+		switch {
+		case user == nil:
+			user, err = models.CreateUserInTransaction(tx, settings.OrganizationID, account.ID, identity.Email, identity.Name)
+		case user.DeletedAt.Valid:
+			err = user.RestoreInTransaction(tx)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return a.authService.AssignRole(user.ID.String(), models.RoleOrgViewer, organizationID, models.DomainTypeOrganization)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("SSO: provisioned %s in organization %s", identity.Email, organizationID)
+	return account, nil
+}
+
+func emailDomain(email string) string {
+	i := strings.LastIndex(email, "@")
+	if i < 0 {
+		return ""
+	}
+
+	return email[i+1:]
+}
+
+func (a *Handler) setSSORequestCookie(w http.ResponseWriter, r *http.Request, request *ssoRequest) error {
+	token, err := a.jwtSigner.GenerateWithClaims(request.OrganizationID, ssoRequestDuration, map[string]any{
+		"state":    request.State,
+		"nonce":    request.Nonce,
+		"redirect": request.RedirectURL,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	//
+	// SAML responses are posted by the identity provider, from another site,
+	// so the cookie needs SameSite=None, which browsers only accept for secure cookies.
+	//
+	sameSite := http.SameSiteLaxMode
+	secure := r.TLS != nil || strings.HasPrefix(a.baseURL, "https://")
+	if secure {
+		sameSite = http.SameSiteNoneMode
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     ssoRequestCookie,
+		Value:    token,
+		Path:     "/auth/sso/",
+		MaxAge:   int(ssoRequestDuration.Seconds()),
+		HttpOnly: true,
+		Secure:   secure,
+		SameSite: sameSite,
+	})
+
+	return nil
+}
+
+// readSSORequest returns the login started for the organization by this browser,
+// if its state matches the one returned by the identity provider.
+func (a *Handler) readSSORequest(r *http.Request, organizationID, state string) (*ssoRequest, error) {
+	cookie, err := r.Cookie(ssoRequestCookie)
+	if err != nil {
+		return nil, fmt.Errorf("SSO request cookie not found")
+	}
+
+	claims, err := a.jwtSigner.ValidateAndGetClaims(cookie.Value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid SSO request cookie: %v", err)
+	}
+
+	request := &ssoRequest{}
+	request.OrganizationID, _ = claims["sub"].(string)
+	request.State, _ = claims["state"].(string)
+	request.Nonce, _ = claims["nonce"].(string)
+	request.RedirectURL, _ = claims["redirect"].(string)
+
+	if request.OrganizationID != organizationID {
+		return nil, fmt.Errorf("SSO request is for another organization")
+	}
+
+	if request.State == "" || request.State != state {
+		return nil, fmt.Errorf("SSO state does not match")
+	}
+
+	if !isValidRedirectURL(request.RedirectURL) {
+		request.RedirectURL = "/"
+	}
+
+	return request, nil
+}
+
+func clearSSORequestCookie(w http.ResponseWriter, r *http.Request) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     ssoRequestCookie,
+		Value:    "",
+		Path:     "/auth/sso/",
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   r.TLS != nil,
+	})
+}
+
+func randomSSOValue() (string, error) {
+	b := make([]byte, 32)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
+// decryptOIDCClientSecret returns the client secret of the OIDC settings of an organization.
+// It is encrypted with the organization ID as associated data.
+func (a *Handler) decryptOIDCClientSecret(ctx context.Context, settings *models.OrganizationSSOSettings) (string, error) {
+	if len(settings.OIDCClientSecretCiphertext) == 0 {
+		return "", fmt.Errorf("client secret not configured")
+	}
+
+	secret, err := a.encryptor.Decrypt(ctx, settings.OIDCClientSecretCiphertext, []byte(settings.OrganizationID.String()))
+	if err != nil {
+		return "", err
+	}
+
+	return string(secret), nil
+}
@@ -0,0 +1,163 @@
+package authentication
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/gorilla/mux"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/models"
+	"golang.org/x/oauth2"
+)
+
+type oidcClaims struct {
+	Email         string `json:"email"`
+	EmailVerified any    `json:"email_verified"`
+	Name          string `json:"name"`
+}
+
+func (a *Handler) oidcConfig(ctx context.Context, settings *models.OrganizationSSOSettings) (*oidc.Provider, *oauth2.Config, error) {
+	if settings.OIDCIssuerURL == nil || settings.OIDCClientID == nil {
+		return nil, nil, fmt.Errorf("OIDC issuer not configured")
+	}
+
+	provider, err := oidc.NewProvider(ctx, *settings.OIDCIssuerURL)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error discovering OIDC issuer: %v", err)
+	}
+
+	clientSecret, err := a.decryptOIDCClientSecret(ctx, settings)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error decrypting client secret: %v", err)
+	}
+
+	config := &oauth2.Config{
+		ClientID:     *settings.OIDCClientID,
+		ClientSecret: clientSecret,
+		Endpoint:     provider.Endpoint(),
+		RedirectURL:  OIDCRedirectURL(a.baseURL, settings.OrganizationID.String()),
+		Scopes:       []string{oidc.ScopeOpenID, "email", "profile"},
+	}
+
+	return provider, config, nil
+}
+
+func (a *Handler) beginOIDCLogin(ctx context.Context, settings *models.OrganizationSSOSettings, request *ssoRequest) (string, error) {
+	_, config, err := a.oidcConfig(ctx, settings)
+	if err != nil {
+		return "", err
+	}
+
+	request.Nonce, err = randomSSOValue()
+	if err != nil {
+		return "", err
+	}
+
+	return config.AuthCodeURL(request.State, oidc.Nonce(request.Nonce)), nil
+}
+
+func (a *Handler) handleOIDCCallback(w http.ResponseWriter, r *http.Request) {
+	settings, ok := a.findEnabledSSOSettings(w, r)
+	if !ok {
+		return
+	}
+
+	organizationID := mux.Vars(r)["organizationID"]
+	if settings.Protocol != models.SSOProtocolOIDC {
+		http.Error(w, "OIDC is not configured for this organization", http.StatusNotFound)
+		return
+	}
+
+	request, err := a.readSSORequest(r, organizationID, r.URL.Query().Get("state"))
+	if err != nil {
+		log.Warnf("Invalid OIDC callback for organization %s: %v", organizationID, err)
+		http.Error(w, "Invalid SSO login request, please try again", http.StatusBadRequest)
+		return
+	}
+
+	if errorCode := r.URL.Query().Get("error"); errorCode != "" {
+		log.Warnf("OIDC login for organization %s failed: %s - %s", organizationID, errorCode, r.URL.Query().Get("error_description"))
+		http.Error(w, "Authentication failed", http.StatusUnauthorized)
+		return
+	}
+
+	identity, err := a.exchangeOIDCCode(r.Context(), settings, request, r.URL.Query().Get("code"))
+	if err != nil {
+		log.Warnf("OIDC login for organization %s failed: %v", organizationID, err)
+		http.Error(w, "Authentication failed", http.StatusUnauthorized)
+		return
+	}
+
+	a.completeSSOLogin(w, r, settings, request, identity)
+}
+
+func (a *Handler) exchangeOIDCCode(ctx context.Context, settings *models.OrganizationSSOSettings, request *ssoRequest, code string) (*ssoIdentity, error) {
+	if code == "" {
+		return nil, fmt.Errorf("missing authorization code")
+	}
+
+	provider, config, err := a.oidcConfig(ctx, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := config.Exchange(ctx, code)
+	if err != nil {
+		return nil, fmt.Errorf("error exchanging code: %v", err)
+	}
+
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok {
+		return nil, fmt.Errorf("no id_token in token response")
+	}
+
+	idToken, err := provider.Verifier(&oidc.Config{ClientID: config.ClientID}).Verify(ctx, rawIDToken)
+	if err != nil {
+		return nil, fmt.Errorf("invalid id_token: %v", err)
+	}
+
+	if request.Nonce == "" || idToken.Nonce != request.Nonce {
+		return nil, fmt.Errorf("nonce does not match")
+	}
+
+	claims := oidcClaims{}
+	err = idToken.Claims(&claims)
+	if err != nil {
+		return nil, fmt.Errorf("error reading id_token claims: %v", err)
+	}
+
+	if claims.Email == "" {
+		userInfo, err := provider.UserInfo(ctx, oauth2.StaticTokenSource(token))
+		if err == nil {
+			_ = userInfo.Claims(&claims)
+		}
+	}
+
+	if !isEmailVerified(claims.EmailVerified) {
+		return nil, fmt.Errorf("email %s is not verified", claims.Email)
+	}
+
+	return &ssoIdentity{
+		Provider: models.ProviderOIDC,
+		Subject:  idToken.Subject,
+		Email:    claims.Email,
+		Name:     claims.Name,
+	}, nil
+}
+
+// isEmailVerified only rejects emails the issuer explicitly marks as unverified,
+// since not every issuer returns the claim. Some issuers return it as a string.
+func isEmailVerified(value any) bool {
+	switch v := value.(type) {
+	case bool:
+		return v
+	case string:
+		verified, err := strconv.ParseBool(v)
+		return err != nil || verified
+	default:
+		return true
+	}
+}
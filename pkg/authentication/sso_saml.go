@@ -0,0 +1,235 @@
+package authentication
+
+import (
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/crewjam/saml"
+	"github.com/crewjam/saml/samlsp"
+	"github.com/gorilla/mux"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/models"
+)
+
+//
+// Attribute names identity providers commonly use for the email and name of users.
+//
+
+var samlEmailAttributes = []string{
+	"email",
+	"mail",
+	"emailaddress",
+	"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/emailaddress",
+	"urn:oid:0.9.2342.19200300.100.1.3",
+}
+
+var samlNameAttributes = []string{
+	"name",
+	"displayname",
+	"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/name",
+	"http://schemas.microsoft.com/identity/claims/displayname",
+	"urn:oid:2.16.840.1.113730.3.1.241",
+}
+
+var samlFirstNameAttributes = []string{
+	"firstname",
+	"givenname",
+	"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/givenname",
+	"urn:oid:2.5.4.42",
+}
+
+var samlLastNameAttributes = []string{
+	"lastname",
+	"surname",
+	"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/surname",
+	"urn:oid:2.5.4.4",
+}
+
+// samlServiceProvider returns the SAML service provider of an organization.
+// Authentication requests are not signed, and identity providers must sign their assertions.
+func (a *Handler) samlServiceProvider(settings *models.OrganizationSSOSettings) (*saml.ServiceProvider, error) {
+	if settings.SAMLIdPMetadata == nil || *settings.SAMLIdPMetadata == "" {
+		return nil, fmt.Errorf("SAML identity provider metadata not configured")
+	}
+
+	idpMetadata, err := samlsp.ParseMetadata([]byte(*settings.SAMLIdPMetadata))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing SAML identity provider metadata: %v", err)
+	}
+
+	organizationID := settings.OrganizationID.String()
+	metadataURL, err := url.Parse(SAMLMetadataURL(a.baseURL, organizationID))
+	if err != nil {
+		return nil, err
+	}
+
+	acsURL, err := url.Parse(SAMLACSURL(a.baseURL, organizationID))
+	if err != nil {
+		return nil, err
+	}
+
+	return &saml.ServiceProvider{
+		EntityID:          metadataURL.String(),
+		MetadataURL:       *metadataURL,
+		AcsURL:            *acsURL,
+		IDPMetadata:       idpMetadata,
+		AuthnNameIDFormat: saml.EmailAddressNameIDFormat,
+		AllowIDPInitiated: false,
+	}, nil
+}
+
+func (a *Handler) beginSAMLLogin(settings *models.OrganizationSSOSettings, request *ssoRequest) (string, error) {
+	sp, err := a.samlServiceProvider(settings)
+	if err != nil {
+		return "", err
+	}
+
+	idpURL := sp.GetSSOBindingLocation(saml.HTTPRedirectBinding)
+	if idpURL == "" {
+		return "", fmt.Errorf("SAML identity provider has no redirect binding")
+	}
+
+	authnRequest, err := sp.MakeAuthenticationRequest(idpURL, saml.HTTPRedirectBinding, saml.HTTPPostBinding)
+	if err != nil {
+		return "", err
+	}
+
+	redirectURL, err := authnRequest.Redirect(request.State, sp)
+	if err != nil {
+		return "", err
+	}
+
+	request.Nonce = authnRequest.ID
+	return redirectURL.String(), nil
+}
+
+func (a *Handler) handleSAMLACS(w http.ResponseWriter, r *http.Request) {
+	settings, ok := a.findEnabledSSOSettings(w, r)
+	if !ok {
+		return
+	}
+
+	organizationID := mux.Vars(r)["organizationID"]
+	if settings.Protocol != models.SSOProtocolSAML {
+		http.Error(w, "SAML is not configured for this organization", http.StatusNotFound)
+		return
+	}
+
+	err := r.ParseForm()
+	if err != nil {
+		http.Error(w, "Invalid SAML response", http.StatusBadRequest)
+		return
+	}
+
+	request, err := a.readSSORequest(r, organizationID, r.PostForm.Get("RelayState"))
+	if err != nil {
+		log.Warnf("Invalid SAML response for organization %s: %v", organizationID, err)
+		http.Error(w, "Invalid SSO login request, please try again", http.StatusBadRequest)
+		return
+	}
+
+	sp, err := a.samlServiceProvider(settings)
+	if err != nil {
+		log.Errorf("Error loading SAML configuration for organization %s: %v", organizationID, err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	rawResponse, err := base64.StdEncoding.DecodeString(r.PostForm.Get("SAMLResponse"))
+	if err != nil {
+		http.Error(w, "Invalid SAML response", http.StatusBadRequest)
+		return
+	}
+
+	assertion, err := sp.ParseXMLResponse(rawResponse, []string{request.Nonce}, sp.AcsURL)
+	if err != nil {
+		log.Warnf("SAML login for organization %s failed: %v", organizationID, samlErrorDetail(err))
+		http.Error(w, "Authentication failed", http.StatusUnauthorized)
+		return
+	}
+
+	a.completeSSOLogin(w, r, settings, request, samlIdentity(assertion))
+}
+
+func (a *Handler) handleSAMLMetadata(w http.ResponseWriter, r *http.Request) {
+	settings, ok := a.findEnabledSSOSettings(w, r)
+	if !ok {
+		return
+	}
+
+	sp, err := a.samlServiceProvider(settings)
+	if err != nil {
+		http.Error(w, "SAML is not configured for this organization", http.StatusNotFound)
+		return
+	}
+
+	metadata, err := xml.MarshalIndent(sp.Metadata(), "", "  ")
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/samlmetadata+xml")
+	_, _ = w.Write(metadata)
+}
+
+// samlIdentity returns the user of a SAML assertion.
+// The email comes from the common email attributes, falling back to the name ID.
+func samlIdentity(assertion *saml.Assertion) *ssoIdentity {
+	identity := &ssoIdentity{Provider: models.ProviderSAML}
+	if assertion.Subject != nil && assertion.Subject.NameID != nil {
+		identity.Subject = assertion.Subject.NameID.Value
+	}
+
+	identity.Email = samlAttribute(assertion, samlEmailAttributes)
+	if identity.Email == "" && strings.Contains(identity.Subject, "@") {
+		identity.Email = identity.Subject
+	}
+
+	identity.Name = samlAttribute(assertion, samlNameAttributes)
+	if identity.Name == "" {
+		first := samlAttribute(assertion, samlFirstNameAttributes)
+		last := samlAttribute(assertion, samlLastNameAttributes)
+		identity.Name = strings.TrimSpace(first + " " + last)
+	}
+
+	if identity.Subject == "" {
+		identity.Subject = identity.Email
+	}
+
+	return identity
+}
+
+func samlAttribute(assertion *saml.Assertion, names []string) string {
+	for _, name := range names {
+		for _, statement := range assertion.AttributeStatements {
+			for _, attribute := range statement.Attributes {
+				if !strings.EqualFold(attribute.Name, name) && !strings.EqualFold(attribute.FriendlyName, name) {
+					continue
+				}
+
+				for _, value := range attribute.Values {
+					if value.Value != "" {
+						return value.Value
+					}
+				}
+			}
+		}
+	}
+
+	return ""
+}
+
+// samlErrorDetail returns the reason of SAML validation errors,
+// which the SAML library hides behind a generic error.
+func samlErrorDetail(err error) error {
+	if invalid, ok := err.(*saml.InvalidResponseError); ok && invalid.PrivateErr != nil {
+		return invalid.PrivateErr
+	}
+
+	return err
+}
@@ -0,0 +1,260 @@
+package authentication
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/crewjam/saml"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/test/support"
+)
+
+const testSAMLIdPMetadata = `<EntityDescriptor xmlns="urn:oasis:names:tc:SAML:2.0:metadata" entityID="https://idp.example.com/metadata">
+  <IDPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+    <SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="https://idp.example.com/sso"/>
+  </IDPSSODescriptor>
+</EntityDescriptor>`
+
+func newTestOIDCIssuer(t *testing.T) *httptest.Server {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/.well-known/openid-configuration" {
+			http.NotFound(w, r)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"issuer":                 server.URL,
+			"authorization_endpoint": server.URL + "/authorize",
+			"token_endpoint":         server.URL + "/token",
+			"jwks_uri":               server.URL + "/keys",
+		})
+	}))
+
+	t.Cleanup(server.Close)
+	return server
+}
+
+func createSSOSettings(t *testing.T, r *support.ResourceRegistry, settings *models.OrganizationSSOSettings) {
+	settings.OrganizationID = r.Organization.ID
+	settings.Enabled = true
+	settings.CreatedAt = time.Now()
+	settings.UpdatedAt = time.Now()
+	require.NoError(t, models.UpsertOrganizationSSOSettings(settings))
+}
+
+func newSSORouter(handler *Handler) *mux.Router {
+	router := mux.NewRouter()
+	handler.registerSSORoutes(router)
+	return router
+}
+
+func TestHandler_handleSSOLogin(t *testing.T) {
+	t.Run("organization without SSO returns not found", func(t *testing.T) {
+		handler, r := setupAuthHandler(t, false)
+		handler.InitializeSSO("http://localhost:8000")
+
+		req := httptest.NewRequest(http.MethodGet, "/auth/sso/"+r.Organization.ID.String(), nil)
+		res := httptest.NewRecorder()
+		newSSORouter(handler).ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusNotFound, res.Code)
+	})
+
+	t.Run("OIDC login redirects to the issuer", func(t *testing.T) {
+		handler, r := setupAuthHandler(t, false)
+		handler.InitializeSSO("http://localhost:8000")
+		issuer := newTestOIDCIssuer(t)
+
+		ciphertext, err := r.Encryptor.Encrypt(context.Background(), []byte("client-secret"), []byte(r.Organization.ID.String()))
+		require.NoError(t, err)
+
+		issuerURL := issuer.URL
+		clientID := "client-id"
+		createSSOSettings(t, r, &models.OrganizationSSOSettings{
+			Protocol:                   models.SSOProtocolOIDC,
+			OIDCIssuerURL:              &issuerURL,
+			OIDCClientID:               &clientID,
+			OIDCClientSecretCiphertext: ciphertext,
+		})
+
+		req := httptest.NewRequest(http.MethodGet, "/auth/sso/"+r.Organization.ID.String()+"?redirect=/canvases", nil)
+		res := httptest.NewRecorder()
+		newSSORouter(handler).ServeHTTP(res, req)
+
+		require.Equal(t, http.StatusFound, res.Code)
+		location, err := url.Parse(res.Header().Get("Location"))
+		require.NoError(t, err)
+		assert.Equal(t, issuer.URL+"/authorize", location.Scheme+"://"+location.Host+location.Path)
+		assert.Equal(t, clientID, location.Query().Get("client_id"))
+		assert.Equal(t, OIDCRedirectURL("http://localhost:8000", r.Organization.ID.String()), location.Query().Get("redirect_uri"))
+		assert.NotEmpty(t, location.Query().Get("nonce"))
+
+		//
+		// The request cookie has the state sent to the issuer.
+		//
+		cookies := res.Result().Cookies()
+		require.Len(t, cookies, 1)
+		assert.Equal(t, ssoRequestCookie, cookies[0].Name)
+
+		callback := httptest.NewRequest(http.MethodGet, "/", nil)
+		callback.AddCookie(cookies[0])
+		request, err := handler.readSSORequest(callback, r.Organization.ID.String(), location.Query().Get("state"))
+		require.NoError(t, err)
+		assert.Equal(t, "/canvases", request.RedirectURL)
+		assert.Equal(t, location.Query().Get("nonce"), request.Nonce)
+
+		_, err = handler.readSSORequest(callback, r.Organization.ID.String(), "other-state")
+		assert.Error(t, err)
+	})
+
+	t.Run("SAML login redirects to the identity provider", func(t *testing.T) {
+		handler, r := setupAuthHandler(t, false)
+		handler.InitializeSSO("http://localhost:8000")
+
+		metadata := testSAMLIdPMetadata
+		createSSOSettings(t, r, &models.OrganizationSSOSettings{
+			Protocol:        models.SSOProtocolSAML,
+			SAMLIdPMetadata: &metadata,
+		})
+
+		req := httptest.NewRequest(http.MethodGet, "/auth/sso/"+r.Organization.ID.String(), nil)
+		res := httptest.NewRecorder()
+		newSSORouter(handler).ServeHTTP(res, req)
+
+		require.Equal(t, http.StatusFound, res.Code)
+		location, err := url.Parse(res.Header().Get("Location"))
+		require.NoError(t, err)
+		assert.Equal(t, "idp.example.com", location.Host)
+		assert.NotEmpty(t, location.Query().Get("SAMLRequest"))
+		assert.NotEmpty(t, location.Query().Get("RelayState"))
+	})
+}
+
+func createSSODomain(t *testing.T, r *support.ResourceRegistry, domain string) {
+	now := time.Now()
+	require.NoError(t, models.ReplaceOrganizationSSODomainsInTransaction(database.Conn(), r.Organization.ID, []models.OrganizationSSODomain{
+		{Domain: domain, VerificationToken: "token", VerifiedAt: &now, CreatedAt: now},
+	}))
+}
+
+func TestHandler_provisionSSOUser(t *testing.T) {
+	t.Run("non-members are rejected without just-in-time provisioning", func(t *testing.T) {
+		handler, r := setupAuthHandler(t, false)
+		createSSODomain(t, r, "example.com")
+		settings := &models.OrganizationSSOSettings{OrganizationID: r.Organization.ID, Enabled: true}
+
+		_, err := handler.provisionSSOUser(settings, &ssoIdentity{Email: "new@example.com", Name: "New"})
+		assert.ErrorIs(t, err, errSSONotMember)
+
+		_, err = models.FindAccountByEmail("new@example.com")
+		assert.Error(t, err)
+	})
+
+	t.Run("members are accepted without just-in-time provisioning", func(t *testing.T) {
+		handler, r := setupAuthHandler(t, false)
+		createSSODomain(t, r, "test.com")
+		user := support.CreateUser(t, r, r.Organization.ID)
+		settings := &models.OrganizationSSOSettings{OrganizationID: r.Organization.ID, Enabled: true}
+
+		account, err := handler.provisionSSOUser(settings, &ssoIdentity{Email: user.GetEmail(), Name: user.Name})
+		require.NoError(t, err)
+		assert.Equal(t, *user.AccountID, account.ID)
+	})
+
+	t.Run("members with emails outside the verified domains are rejected", func(t *testing.T) {
+		handler, r := setupAuthHandler(t, false)
+		createSSODomain(t, r, "example.com")
+		user := support.CreateUser(t, r, r.Organization.ID)
+		settings := &models.OrganizationSSOSettings{OrganizationID: r.Organization.ID, Enabled: true}
+
+		_, err := handler.provisionSSOUser(settings, &ssoIdentity{Email: user.GetEmail(), Name: user.Name})
+		assert.ErrorIs(t, err, errSSOUnverifiedDomain)
+	})
+
+	t.Run("existing accounts outside the verified domains are not provisioned", func(t *testing.T) {
+		handler, r := setupAuthHandler(t, true)
+		createSSODomain(t, r, "example.com")
+		account, err := models.CreateAccount("Other", "other@other.com")
+		require.NoError(t, err)
+		settings := &models.OrganizationSSOSettings{OrganizationID: r.Organization.ID, Enabled: true, JITProvisioning: true}
+
+		_, err = handler.provisionSSOUser(settings, &ssoIdentity{Email: account.Email, Name: account.Name})
+		assert.ErrorIs(t, err, errSSOUnverifiedDomain)
+
+		_, err = models.FindMaybeDeletedUserByEmail(r.Organization.ID.String(), account.Email)
+		assert.Error(t, err)
+	})
+
+	t.Run("just-in-time provisioning creates the account and member", func(t *testing.T) {
+		handler, r := setupAuthHandler(t, true)
+		createSSODomain(t, r, "example.com")
+		settings := &models.OrganizationSSOSettings{OrganizationID: r.Organization.ID, Enabled: true, JITProvisioning: true}
+
+		account, err := handler.provisionSSOUser(settings, &ssoIdentity{Email: "new@example.com", Name: "New"})
+		require.NoError(t, err)
+		assert.Equal(t, "new@example.com", account.Email)
+
+		user, err := models.FindActiveUserByEmail(r.Organization.ID.String(), "new@example.com")
+		require.NoError(t, err)
+
+		roles, err := r.AuthService.GetUserRolesForOrg(user.ID.String(), r.Organization.ID.String())
+		require.NoError(t, err)
+
+		roleNames := []string{}
+		for _, role := range roles {
+			roleNames = append(roleNames, role.Name)
+		}
+		assert.Contains(t, roleNames, models.RoleOrgViewer)
+	})
+}
+
+func Test__samlIdentity(t *testing.T) {
+	t.Run("email and name from attributes", func(t *testing.T) {
+		identity := samlIdentity(&saml.Assertion{
+			Subject: &saml.Subject{NameID: &saml.NameID{Value: "user-123"}},
+			AttributeStatements: []saml.AttributeStatement{
+				{
+					Attributes: []saml.Attribute{
+						{Name: "http://schemas.xmlsoap.org/ws/2005/05/identity/claims/emailaddress", Values: []saml.AttributeValue{{Value: "jane@example.com"}}},
+						{Name: "firstName", Values: []saml.AttributeValue{{Value: "Jane"}}},
+						{Name: "lastName", Values: []saml.AttributeValue{{Value: "Doe"}}},
+					},
+				},
+			},
+		})
+
+		assert.Equal(t, models.ProviderSAML, identity.Provider)
+		assert.Equal(t, "user-123", identity.Subject)
+		assert.Equal(t, "jane@example.com", identity.Email)
+		assert.Equal(t, "Jane Doe", identity.Name)
+	})
+
+	t.Run("email from name ID", func(t *testing.T) {
+		identity := samlIdentity(&saml.Assertion{
+			Subject: &saml.Subject{NameID: &saml.NameID{Value: "jane@example.com"}},
+		})
+
+		assert.Equal(t, "jane@example.com", identity.Subject)
+		assert.Equal(t, "jane@example.com", identity.Email)
+		assert.Empty(t, identity.Name)
+	})
+}
+
+func Test__isEmailVerified(t *testing.T) {
+	assert.True(t, isEmailVerified(nil))
+	assert.True(t, isEmailVerified(true))
+	assert.True(t, isEmailVerified("true"))
+	assert.False(t, isEmailVerified(false))
+	assert.False(t, isEmailVerified("false"))
+}
@@ -2,9 +2,14 @@ package authorization
 
 import (
 	"context"
+	"errors"
 
+	"github.com/google/uuid"
 	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/audit"
 	"github.com/superplanehq/superplane/pkg/models"
+	pbAudit "github.com/superplanehq/superplane/pkg/protos/audit"
+	pbAuth "github.com/superplanehq/superplane/pkg/protos/authorization"
 	pbBlueprints "github.com/superplanehq/superplane/pkg/protos/blueprints"
 	pbCanvases "github.com/superplanehq/superplane/pkg/protos/canvases"
 	pbGroups "github.com/superplanehq/superplane/pkg/protos/groups"
@@ -17,6 +22,8 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
 )
 
 type contextKey string
@@ -29,24 +36,39 @@ type AuthorizationRule struct {
 	Resource   string
 	Action     string
 	DomainType string
+
+	//
+	// Organization rules for resources that can also belong to a canvas.
+	// Requests with a canvas domain type are checked against the canvas in their domain id.
+	//
+	CanvasDomainAllowed bool
+}
+
+type domainRequest interface {
+	GetDomainType() pbAuth.DomainType
+	GetDomainId() string
 }
 
 type AuthorizationInterceptor struct {
-	authService Authorization
-	rules       map[string]AuthorizationRule
+	authService  Authorization
+	rules        map[string]AuthorizationRule
+	stateLoaders map[string]audit.StateLoader
 }
 
 func NewAuthorizationInterceptor(authService Authorization) *AuthorizationInterceptor {
 	rules := map[string]AuthorizationRule{
 		// Secrets rules
-		pbSecrets.Secrets_CreateSecret_FullMethodName:     {Resource: "secrets", Action: "create", DomainType: models.DomainTypeOrganization},
-		pbSecrets.Secrets_UpdateSecret_FullMethodName:     {Resource: "secrets", Action: "update", DomainType: models.DomainTypeOrganization},
-		pbSecrets.Secrets_DescribeSecret_FullMethodName:   {Resource: "secrets", Action: "read", DomainType: models.DomainTypeOrganization},
-		pbSecrets.Secrets_ListSecrets_FullMethodName:      {Resource: "secrets", Action: "read", DomainType: models.DomainTypeOrganization},
-		pbSecrets.Secrets_DeleteSecret_FullMethodName:     {Resource: "secrets", Action: "delete", DomainType: models.DomainTypeOrganization},
-		pbSecrets.Secrets_SetSecretKey_FullMethodName:     {Resource: "secrets", Action: "update", DomainType: models.DomainTypeOrganization},
-		pbSecrets.Secrets_DeleteSecretKey_FullMethodName:  {Resource: "secrets", Action: "update", DomainType: models.DomainTypeOrganization},
-		pbSecrets.Secrets_UpdateSecretName_FullMethodName: {Resource: "secrets", Action: "update", DomainType: models.DomainTypeOrganization},
+		pbSecrets.Secrets_CreateSecret_FullMethodName:       {Resource: "secrets", Action: "create", DomainType: models.DomainTypeOrganization, CanvasDomainAllowed: true},
+		pbSecrets.Secrets_UpdateSecret_FullMethodName:       {Resource: "secrets", Action: "update", DomainType: models.DomainTypeOrganization, CanvasDomainAllowed: true},
+		pbSecrets.Secrets_DescribeSecret_FullMethodName:     {Resource: "secrets", Action: "read", DomainType: models.DomainTypeOrganization, CanvasDomainAllowed: true},
+		pbSecrets.Secrets_ListSecrets_FullMethodName:        {Resource: "secrets", Action: "read", DomainType: models.DomainTypeOrganization, CanvasDomainAllowed: true},
+		pbSecrets.Secrets_DeleteSecret_FullMethodName:       {Resource: "secrets", Action: "delete", DomainType: models.DomainTypeOrganization, CanvasDomainAllowed: true},
+		pbSecrets.Secrets_SetSecretKey_FullMethodName:       {Resource: "secrets", Action: "update", DomainType: models.DomainTypeOrganization, CanvasDomainAllowed: true},
+		pbSecrets.Secrets_DeleteSecretKey_FullMethodName:    {Resource: "secrets", Action: "update", DomainType: models.DomainTypeOrganization, CanvasDomainAllowed: true},
+		pbSecrets.Secrets_UpdateSecretName_FullMethodName:   {Resource: "secrets", Action: "update", DomainType: models.DomainTypeOrganization, CanvasDomainAllowed: true},
+		pbSecrets.Secrets_RotateSecret_FullMethodName:       {Resource: "secrets", Action: "update", DomainType: models.DomainTypeOrganization, CanvasDomainAllowed: true},
+		pbSecrets.Secrets_ListSecretVersions_FullMethodName: {Resource: "secrets", Action: "read", DomainType: models.DomainTypeOrganization, CanvasDomainAllowed: true},
+		pbSecrets.Secrets_RollbackSecret_FullMethodName:     {Resource: "secrets", Action: "update", DomainType: models.DomainTypeOrganization, CanvasDomainAllowed: true},
 
 		// Groups rules
 		pbGroups.Groups_CreateGroup_FullMethodName:         {Resource: "groups", Action: "create", DomainType: models.DomainTypeOrganization},
@@ -84,6 +106,8 @@ func NewAuthorizationInterceptor(authService Authorization) *AuthorizationInterc
 		pbOrganization.Organizations_UpdateAgentSettings_FullMethodName:      {Resource: "org", Action: "update", DomainType: models.DomainTypeOrganization},
 		pbOrganization.Organizations_SetAgentOpenAIKey_FullMethodName:        {Resource: "org", Action: "update", DomainType: models.DomainTypeOrganization},
 		pbOrganization.Organizations_DeleteAgentOpenAIKey_FullMethodName:     {Resource: "org", Action: "update", DomainType: models.DomainTypeOrganization},
+		pbOrganization.Organizations_GetSSOSettings_FullMethodName:           {Resource: "org", Action: "read", DomainType: models.DomainTypeOrganization},
+		pbOrganization.Organizations_UpdateSSOSettings_FullMethodName:        {Resource: "org", Action: "update", DomainType: models.DomainTypeOrganization},
 		pbOrganization.Organizations_RemoveUser_FullMethodName:               {Resource: "members", Action: "delete", DomainType: models.DomainTypeOrganization},
 		pbOrganization.Organizations_DeleteOrganization_FullMethodName:       {Resource: "org", Action: "delete", DomainType: models.DomainTypeOrganization},
 		pbOrganization.Organizations_CreateIntegration_FullMethodName:        {Resource: "integrations", Action: "create", DomainType: models.DomainTypeOrganization},
@@ -92,6 +116,11 @@ func NewAuthorizationInterceptor(authService Authorization) *AuthorizationInterc
 		pbOrganization.Organizations_ListIntegrations_FullMethodName:         {Resource: "integrations", Action: "read", DomainType: models.DomainTypeOrganization},
 		pbOrganization.Organizations_DescribeIntegration_FullMethodName:      {Resource: "integrations", Action: "read", DomainType: models.DomainTypeOrganization},
 		pbOrganization.Organizations_ListIntegrationResources_FullMethodName: {Resource: "integrations", Action: "read", DomainType: models.DomainTypeOrganization},
+		pbOrganization.Organizations_ListMemoryNamespaces_FullMethodName:     {Resource: "memory", Action: "read", DomainType: models.DomainTypeOrganization},
+		pbOrganization.Organizations_UpdateMemoryNamespace_FullMethodName:    {Resource: "memory", Action: "update", DomainType: models.DomainTypeOrganization},
+		pbOrganization.Organizations_DeleteMemoryNamespace_FullMethodName:    {Resource: "memory", Action: "delete", DomainType: models.DomainTypeOrganization},
+		pbOrganization.Organizations_ListMemories_FullMethodName:             {Resource: "memory", Action: "read", DomainType: models.DomainTypeOrganization},
+		pbOrganization.Organizations_DeleteMemory_FullMethodName:             {Resource: "memory", Action: "delete", DomainType: models.DomainTypeOrganization},
 
 		// Blueprints rules
 		pbBlueprints.Blueprints_ListBlueprints_FullMethodName:    {Resource: "blueprints", Action: "read", DomainType: models.DomainTypeOrganization},
@@ -101,43 +130,53 @@ func NewAuthorizationInterceptor(authService Authorization) *AuthorizationInterc
 		pbBlueprints.Blueprints_DeleteBlueprint_FullMethodName:   {Resource: "blueprints", Action: "delete", DomainType: models.DomainTypeOrganization},
 
 		// Canvases rules
-		pbCanvases.Canvases_ListCanvases_FullMethodName:              {Resource: "canvases", Action: "read", DomainType: models.DomainTypeOrganization},
-		pbCanvases.Canvases_DescribeCanvas_FullMethodName:            {Resource: "canvases", Action: "read", DomainType: models.DomainTypeOrganization},
-		pbCanvases.Canvases_CreateCanvas_FullMethodName:              {Resource: "canvases", Action: "create", DomainType: models.DomainTypeOrganization},
-		pbCanvases.Canvases_UpdateCanvas_FullMethodName:              {Resource: "canvases", Action: "update", DomainType: models.DomainTypeOrganization},
-		pbCanvases.Canvases_CreateCanvasVersion_FullMethodName:       {Resource: "canvases", Action: "update", DomainType: models.DomainTypeOrganization},
-		pbCanvases.Canvases_ListCanvasVersions_FullMethodName:        {Resource: "canvases", Action: "read", DomainType: models.DomainTypeOrganization},
-		pbCanvases.Canvases_DescribeCanvasVersion_FullMethodName:     {Resource: "canvases", Action: "read", DomainType: models.DomainTypeOrganization},
-		pbCanvases.Canvases_UpdateCanvasVersion_FullMethodName:       {Resource: "canvases", Action: "update", DomainType: models.DomainTypeOrganization},
-		pbCanvases.Canvases_CreateCanvasChangeRequest_FullMethodName: {Resource: "canvases", Action: "update", DomainType: models.DomainTypeOrganization},
-		pbCanvases.Canvases_ListCanvasChangeRequests_FullMethodName:  {Resource: "canvases", Action: "read", DomainType: models.DomainTypeOrganization},
-		pbCanvases.Canvases_DescribeCanvasChangeRequest_FullMethodName: {
-			Resource:   "canvases",
-			Action:     "read",
-			DomainType: models.DomainTypeOrganization,
-		},
-		pbCanvases.Canvases_ActOnCanvasChangeRequest_FullMethodName: {Resource: "canvases", Action: "update", DomainType: models.DomainTypeOrganization},
-		pbCanvases.Canvases_ResolveCanvasChangeRequest_FullMethodName: {
-			Resource:   "canvases",
-			Action:     "update",
-			DomainType: models.DomainTypeOrganization,
-		},
-		pbCanvases.Canvases_DeleteCanvas_FullMethodName:              {Resource: "canvases", Action: "delete", DomainType: models.DomainTypeOrganization},
-		pbCanvases.Canvases_ListNodeExecutions_FullMethodName:        {Resource: "canvases", Action: "read", DomainType: models.DomainTypeOrganization},
-		pbCanvases.Canvases_ListNodeQueueItems_FullMethodName:        {Resource: "canvases", Action: "read", DomainType: models.DomainTypeOrganization},
-		pbCanvases.Canvases_DeleteNodeQueueItem_FullMethodName:       {Resource: "canvases", Action: "update", DomainType: models.DomainTypeOrganization},
-		pbCanvases.Canvases_UpdateNodePause_FullMethodName:           {Resource: "canvases", Action: "update", DomainType: models.DomainTypeOrganization},
-		pbCanvases.Canvases_ListCanvasEvents_FullMethodName:          {Resource: "canvases", Action: "read", DomainType: models.DomainTypeOrganization},
-		pbCanvases.Canvases_ListEventExecutions_FullMethodName:       {Resource: "canvases", Action: "read", DomainType: models.DomainTypeOrganization},
-		pbCanvases.Canvases_ListChildExecutions_FullMethodName:       {Resource: "canvases", Action: "read", DomainType: models.DomainTypeOrganization},
-		pbCanvases.Canvases_ListCanvasMemories_FullMethodName:        {Resource: "canvases", Action: "read", DomainType: models.DomainTypeOrganization},
-		pbCanvases.Canvases_DeleteCanvasMemory_FullMethodName:        {Resource: "canvases", Action: "update", DomainType: models.DomainTypeOrganization},
-		pbCanvases.Canvases_CancelExecution_FullMethodName:           {Resource: "canvases", Action: "update", DomainType: models.DomainTypeOrganization},
-		pbCanvases.Canvases_ResolveExecutionErrors_FullMethodName:    {Resource: "canvases", Action: "update", DomainType: models.DomainTypeOrganization},
-		pbCanvases.Canvases_InvokeNodeExecutionAction_FullMethodName: {Resource: "canvases", Action: "update", DomainType: models.DomainTypeOrganization},
-		pbCanvases.Canvases_InvokeNodeTriggerAction_FullMethodName:   {Resource: "canvases", Action: "update", DomainType: models.DomainTypeOrganization},
-		pbCanvases.Canvases_ListNodeEvents_FullMethodName:            {Resource: "canvases", Action: "read", DomainType: models.DomainTypeOrganization},
-		pbCanvases.Canvases_EmitNodeEvent_FullMethodName:             {Resource: "canvases", Action: "update", DomainType: models.DomainTypeOrganization},
+		pbCanvases.Canvases_ListCanvases_FullMethodName:                {Resource: "canvases", Action: "read", DomainType: models.DomainTypeOrganization},
+		pbCanvases.Canvases_DescribeCanvas_FullMethodName:              {Resource: "canvases", Action: "read", DomainType: models.DomainTypeCanvas},
+		pbCanvases.Canvases_CreateCanvas_FullMethodName:                {Resource: "canvases", Action: "create", DomainType: models.DomainTypeOrganization},
+		pbCanvases.Canvases_ExportCanvas_FullMethodName:                {Resource: "canvases", Action: "read", DomainType: models.DomainTypeCanvas},
+		pbCanvases.Canvases_ImportCanvas_FullMethodName:                {Resource: "canvases", Action: "create", DomainType: models.DomainTypeOrganization},
+		pbCanvases.Canvases_UpdateCanvas_FullMethodName:                {Resource: "canvases", Action: "update", DomainType: models.DomainTypeCanvas},
+		pbCanvases.Canvases_CreateCanvasVersion_FullMethodName:         {Resource: "canvases", Action: "update", DomainType: models.DomainTypeCanvas},
+		pbCanvases.Canvases_ListCanvasVersions_FullMethodName:          {Resource: "canvases", Action: "read", DomainType: models.DomainTypeCanvas},
+		pbCanvases.Canvases_DescribeCanvasVersion_FullMethodName:       {Resource: "canvases", Action: "read", DomainType: models.DomainTypeCanvas},
+		pbCanvases.Canvases_UpdateCanvasVersion_FullMethodName:         {Resource: "canvases", Action: "update", DomainType: models.DomainTypeCanvas},
+		pbCanvases.Canvases_DiffCanvasVersions_FullMethodName:          {Resource: "canvases", Action: "read", DomainType: models.DomainTypeCanvas},
+		pbCanvases.Canvases_RollbackCanvasVersion_FullMethodName:       {Resource: "canvases", Action: "update", DomainType: models.DomainTypeCanvas},
+		pbCanvases.Canvases_CreateCanvasChangeRequest_FullMethodName:   {Resource: "canvases", Action: "update", DomainType: models.DomainTypeCanvas},
+		pbCanvases.Canvases_ListCanvasChangeRequests_FullMethodName:    {Resource: "canvases", Action: "read", DomainType: models.DomainTypeCanvas},
+		pbCanvases.Canvases_DescribeCanvasChangeRequest_FullMethodName: {Resource: "canvases", Action: "read", DomainType: models.DomainTypeCanvas},
+		pbCanvases.Canvases_ActOnCanvasChangeRequest_FullMethodName:    {Resource: "canvases", Action: "update", DomainType: models.DomainTypeCanvas},
+		pbCanvases.Canvases_ResolveCanvasChangeRequest_FullMethodName:  {Resource: "canvases", Action: "update", DomainType: models.DomainTypeCanvas},
+		pbCanvases.Canvases_DeleteCanvas_FullMethodName:                {Resource: "canvases", Action: "delete", DomainType: models.DomainTypeCanvas},
+		pbCanvases.Canvases_ListNodeExecutions_FullMethodName:          {Resource: "canvases", Action: "read", DomainType: models.DomainTypeCanvas},
+		pbCanvases.Canvases_ListNodeQueueItems_FullMethodName:          {Resource: "canvases", Action: "read", DomainType: models.DomainTypeCanvas},
+		pbCanvases.Canvases_DeleteNodeQueueItem_FullMethodName:         {Resource: "executions", Action: "update", DomainType: models.DomainTypeCanvas},
+		pbCanvases.Canvases_UpdateNodePause_FullMethodName:             {Resource: "executions", Action: "update", DomainType: models.DomainTypeCanvas},
+		pbCanvases.Canvases_ListCanvasEvents_FullMethodName:            {Resource: "canvases", Action: "read", DomainType: models.DomainTypeCanvas},
+		pbCanvases.Canvases_ListEventExecutions_FullMethodName:         {Resource: "canvases", Action: "read", DomainType: models.DomainTypeCanvas},
+		pbCanvases.Canvases_ListChildExecutions_FullMethodName:         {Resource: "canvases", Action: "read", DomainType: models.DomainTypeCanvas},
+		pbCanvases.Canvases_ListCanvasMemories_FullMethodName:          {Resource: "canvases", Action: "read", DomainType: models.DomainTypeCanvas},
+		pbCanvases.Canvases_DeleteCanvasMemory_FullMethodName:          {Resource: "canvases", Action: "update", DomainType: models.DomainTypeCanvas},
+		pbCanvases.Canvases_ListCanvasMemoryNamespaces_FullMethodName:  {Resource: "canvases", Action: "read", DomainType: models.DomainTypeCanvas},
+		pbCanvases.Canvases_UpdateCanvasMemoryNamespace_FullMethodName: {Resource: "canvases", Action: "update", DomainType: models.DomainTypeCanvas},
+		pbCanvases.Canvases_DeleteCanvasMemoryNamespace_FullMethodName: {Resource: "canvases", Action: "update", DomainType: models.DomainTypeCanvas},
+		pbCanvases.Canvases_DescribeCanvasGitSync_FullMethodName:       {Resource: "canvases", Action: "read", DomainType: models.DomainTypeCanvas},
+		pbCanvases.Canvases_UpdateCanvasGitSync_FullMethodName:         {Resource: "canvases", Action: "update", DomainType: models.DomainTypeCanvas},
+		pbCanvases.Canvases_DeleteCanvasGitSync_FullMethodName:         {Resource: "canvases", Action: "update", DomainType: models.DomainTypeCanvas},
+		pbCanvases.Canvases_ValidateExpression_FullMethodName:          {Resource: "canvases", Action: "read", DomainType: models.DomainTypeCanvas},
+		pbCanvases.Canvases_CompleteExpression_FullMethodName:          {Resource: "canvases", Action: "read", DomainType: models.DomainTypeCanvas},
+		pbCanvases.Canvases_CancelExecution_FullMethodName:             {Resource: "executions", Action: "update", DomainType: models.DomainTypeCanvas},
+		pbCanvases.Canvases_ResolveExecutionErrors_FullMethodName:      {Resource: "executions", Action: "update", DomainType: models.DomainTypeCanvas},
+		pbCanvases.Canvases_InvokeNodeExecutionAction_FullMethodName:   {Resource: "executions", Action: "update", DomainType: models.DomainTypeCanvas},
+		pbCanvases.Canvases_InvokeNodeTriggerAction_FullMethodName:     {Resource: "executions", Action: "update", DomainType: models.DomainTypeCanvas},
+		pbCanvases.Canvases_ListNodeEvents_FullMethodName:              {Resource: "canvases", Action: "read", DomainType: models.DomainTypeCanvas},
+		pbCanvases.Canvases_EmitNodeEvent_FullMethodName:               {Resource: "executions", Action: "update", DomainType: models.DomainTypeCanvas},
+		pbCanvases.Canvases_ListCanvasMembers_FullMethodName:           {Resource: "members", Action: "read", DomainType: models.DomainTypeCanvas},
+		pbCanvases.Canvases_UpdateCanvasMember_FullMethodName:          {Resource: "members", Action: "update", DomainType: models.DomainTypeCanvas},
+		pbCanvases.Canvases_RemoveCanvasMember_FullMethodName:          {Resource: "members", Action: "update", DomainType: models.DomainTypeCanvas},
+		pbCanvases.Canvases_ListCanvasGroups_FullMethodName:            {Resource: "members", Action: "read", DomainType: models.DomainTypeCanvas},
+		pbCanvases.Canvases_UpdateCanvasGroup_FullMethodName:           {Resource: "members", Action: "update", DomainType: models.DomainTypeCanvas},
+		pbCanvases.Canvases_RemoveCanvasGroup_FullMethodName:           {Resource: "members", Action: "update", DomainType: models.DomainTypeCanvas},
 
 		// Service Accounts rules
 		pbServiceAccounts.ServiceAccounts_CreateServiceAccount_FullMethodName:          {Resource: "service_accounts", Action: "create", DomainType: models.DomainTypeOrganization},
@@ -146,14 +185,26 @@ func NewAuthorizationInterceptor(authService Authorization) *AuthorizationInterc
 		pbServiceAccounts.ServiceAccounts_UpdateServiceAccount_FullMethodName:          {Resource: "service_accounts", Action: "update", DomainType: models.DomainTypeOrganization},
 		pbServiceAccounts.ServiceAccounts_DeleteServiceAccount_FullMethodName:          {Resource: "service_accounts", Action: "delete", DomainType: models.DomainTypeOrganization},
 		pbServiceAccounts.ServiceAccounts_RegenerateServiceAccountToken_FullMethodName: {Resource: "service_accounts", Action: "update", DomainType: models.DomainTypeOrganization},
+
+		// Audit rules
+		pbAudit.Audit_ListAuditEvents_FullMethodName: {Resource: "audit", Action: "read", DomainType: models.DomainTypeOrganization},
 	}
 
 	return &AuthorizationInterceptor{
-		authService: authService,
-		rules:       rules,
+		authService:  authService,
+		rules:        rules,
+		stateLoaders: map[string]audit.StateLoader{},
 	}
 }
 
+// WithStateLoaders sets how the resources changed by calls are loaded,
+// by resource type, so the audit log records them as they were before the call.
+// Resources without a loader are recorded without their previous state.
+func (a *AuthorizationInterceptor) WithStateLoaders(loaders map[string]audit.StateLoader) *AuthorizationInterceptor {
+	a.stateLoaders = loaders
+	return a
+}
+
 func (a *AuthorizationInterceptor) UnaryInterceptor() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 		rule, requiresAuth := a.rules[info.FullMethod]
@@ -186,19 +237,140 @@ func (a *AuthorizationInterceptor) UnaryInterceptor() grpc.UnaryServerIntercepto
 			return nil, status.Error(codes.NotFound, "organization not found")
 		}
 
-		allowed, err := a.authService.CheckOrganizationPermission(userID, org.ID.String(), rule.Resource, rule.Action)
+		//
+		// Canvas-scoped rules are checked against the canvas in the request,
+		// so canvas roles apply in addition to the organization roles.
+		// Templates are not part of the organization,
+		// so reading them is checked against the organization only.
+		//
+		domainType := models.DomainTypeOrganization
+		domainID := organizationID
+		canvasID, err := findCanvasIDForRule(rule, org.ID, req)
 		if err != nil {
 			return nil, err
 		}
 
+		var allowed bool
+		if canvasID != "" {
+			domainType = models.DomainTypeCanvas
+			domainID = canvasID
+			allowed, err = a.authService.CheckCanvasPermission(userID, org.ID.String(), canvasID, rule.Resource, rule.Action)
+		} else {
+			allowed, err = a.authService.CheckOrganizationPermission(userID, org.ID.String(), rule.Resource, rule.Action)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		call := audit.Call{
+			OrganizationID: org.ID,
+			UserID:         userID,
+			ResourceType:   rule.Resource,
+			Action:         rule.Action,
+			Method:         info.FullMethod,
+			DomainType:     domainType,
+			DomainID:       domainID,
+			Request:        req,
+		}
+
 		if !allowed {
-			log.Warnf("User %s tried to %s %s in organization %s", userID, rule.Action, rule.Resource, org.ID.String())
+			log.Warnf("User %s tried to %s %s in %s %s", userID, rule.Action, rule.Resource, domainType, domainID)
+			if rule.Action != "read" {
+				call.Denied = true
+				audit.RecordCall(call)
+			}
+
 			return nil, status.Error(codes.NotFound, "Not found")
 		}
 
 		newContext := context.WithValue(ctx, OrganizationContextKey, organizationID)
-		newContext = context.WithValue(newContext, DomainTypeContextKey, models.DomainTypeOrganization)
-		newContext = context.WithValue(newContext, DomainIdContextKey, organizationID)
-		return handler(newContext, req)
+		newContext = context.WithValue(newContext, DomainTypeContextKey, domainType)
+		newContext = context.WithValue(newContext, DomainIdContextKey, domainID)
+		if rule.Action == "update" || rule.Action == "delete" {
+			call.Before = a.loadState(newContext, rule.Resource, req)
+		}
+
+		response, err := handler(newContext, req)
+
+		//
+		// Every call that can change something is recorded,
+		// including the ones that fail, but reads are not.
+		//
+		if rule.Action != "read" {
+			call.Response = response
+			call.Err = err
+			audit.RecordCall(call)
+		}
+
+		return response, err
+	}
+}
+
+func (a *AuthorizationInterceptor) loadState(ctx context.Context, resource string, req interface{}) proto.Message {
+	loader, ok := a.stateLoaders[resource]
+	if !ok {
+		return nil
+	}
+
+	state, err := loader(ctx, req)
+	if err != nil {
+		log.Warnf("Error loading %s state for audit log: %v", resource, err)
+		return nil
+	}
+
+	return state
+}
+
+// findCanvasIDForRule returns the canvas a rule is checked against.
+// An empty ID means the rule is checked against the organization,
+// which is the case for organization rules and for reading canvas templates.
+func findCanvasIDForRule(rule AuthorizationRule, orgID uuid.UUID, req interface{}) (string, error) {
+	var id string
+	switch {
+	case rule.CanvasDomainAllowed && requestsCanvasDomain(req):
+		id = req.(domainRequest).GetDomainId()
+	case rule.DomainType == models.DomainTypeCanvas:
+		switch r := req.(type) {
+		case interface{ GetCanvasId() string }:
+			id = r.GetCanvasId()
+		case interface{ GetId() string }:
+			id = r.GetId()
+		}
+	default:
+		return "", nil
+	}
+
+	canvasID, err := uuid.Parse(id)
+	if err != nil {
+		return "", status.Error(codes.InvalidArgument, "invalid canvas ID")
 	}
+
+	canvas, err := models.FindCanvas(orgID, canvasID)
+	if err == nil {
+		return canvas.ID.String(), nil
+	}
+
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.Errorf("Error finding canvas %s: %v", canvasID, err)
+		return "", status.Error(codes.Internal, "error finding canvas")
+	}
+
+	//
+	// Templates are not part of any organization,
+	// and every organization can read them.
+	//
+	if rule.Action == "read" && !requestsCanvasDomain(req) {
+		_, err := models.FindCanvasTemplate(canvasID)
+		if err == nil {
+			return "", nil
+		}
+	}
+
+	return "", status.Error(codes.NotFound, "Not found")
+}
+
+func requestsCanvasDomain(req interface{}) bool {
+	r, ok := req.(domainRequest)
+	return ok && r.GetDomainType() == pbAuth.DomainType_DOMAIN_TYPE_CANVAS
 }
@@ -0,0 +1,100 @@
+package authorization_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/audit"
+	"github.com/superplanehq/superplane/pkg/authorization"
+	"github.com/superplanehq/superplane/pkg/models"
+	pbCanvases "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func Test__AuthorizationInterceptor_CanvasRules(t *testing.T) {
+	r := support.Setup(t)
+	canvas, _ := support.CreateCanvas(t, r.Organization.ID, r.User, []models.CanvasNode{}, []models.Edge{})
+
+	interceptor := authorization.NewAuthorizationInterceptor(r.AuthService).UnaryInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: pbCanvases.Canvases_CancelExecution_FullMethodName}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		"x-user-id", r.User.String(),
+		"x-organization-id", r.Organization.ID.String(),
+	))
+
+	handler := func(ctx context.Context, req any) (any, error) {
+		return &pbCanvases.CancelExecutionResponse{}, nil
+	}
+
+	t.Run("invalid canvas ID -> invalid argument", func(t *testing.T) {
+		_, err := interceptor(ctx, &pbCanvases.CancelExecutionRequest{CanvasId: "not-a-uuid"}, info, handler)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("canvas from another organization -> not found", func(t *testing.T) {
+		_, err := interceptor(ctx, &pbCanvases.CancelExecutionRequest{CanvasId: uuid.NewString()}, info, handler)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("canvas from the organization -> allowed", func(t *testing.T) {
+		_, err := interceptor(ctx, &pbCanvases.CancelExecutionRequest{CanvasId: canvas.ID.String()}, info, handler)
+		require.NoError(t, err)
+	})
+}
+
+func Test__AuthorizationInterceptor_RecordsStateBeforeChanges(t *testing.T) {
+	r := support.Setup(t)
+	canvas, _ := support.CreateCanvas(t, r.Organization.ID, r.User, []models.CanvasNode{}, []models.Edge{})
+
+	loads := 0
+	interceptor := authorization.NewAuthorizationInterceptor(r.AuthService).
+		WithStateLoaders(map[string]audit.StateLoader{
+			"canvases": func(ctx context.Context, request any) (proto.Message, error) {
+				loads++
+				return &pbCanvases.DescribeCanvasResponse{
+					Canvas: &pbCanvases.Canvas{
+						Metadata: &pbCanvases.Canvas_Metadata{Id: canvas.ID.String(), Name: "before"},
+					},
+				}, nil
+			},
+		}).
+		UnaryInterceptor()
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		"x-user-id", r.User.String(),
+		"x-organization-id", r.Organization.ID.String(),
+	))
+
+	handler := func(ctx context.Context, req any) (any, error) {
+		return &pbCanvases.UpdateCanvasResponse{}, nil
+	}
+
+	t.Run("creating -> state is not loaded", func(t *testing.T) {
+		info := &grpc.UnaryServerInfo{FullMethod: pbCanvases.Canvases_CreateCanvas_FullMethodName}
+		_, err := interceptor(ctx, &pbCanvases.CreateCanvasRequest{}, info, handler)
+		require.NoError(t, err)
+		assert.Zero(t, loads)
+	})
+
+	t.Run("updating -> state before the call is recorded", func(t *testing.T) {
+		info := &grpc.UnaryServerInfo{FullMethod: pbCanvases.Canvases_UpdateCanvas_FullMethodName}
+		_, err := interceptor(ctx, &pbCanvases.UpdateCanvasRequest{Id: canvas.ID.String()}, info, handler)
+		require.NoError(t, err)
+		assert.Equal(t, 1, loads)
+
+		events, err := models.ListAuditEvents(r.Organization.ID, models.AuditEventFilters{Action: "update"}, 1, nil)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+
+		before := events[0].Before.Data()["canvas"].(map[string]any)
+		assert.Equal(t, "before", before["metadata"].(map[string]any)["name"])
+	})
+}
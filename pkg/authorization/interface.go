@@ -4,6 +4,7 @@ import "gorm.io/gorm"
 
 type PermissionChecker interface {
 	CheckOrganizationPermission(userID, orgID, resource, action string) (bool, error)
+	CheckCanvasPermission(userID, orgID, canvasID, resource, action string) (bool, error)
 	IsValidPermission(domainType string, permission *Permission) bool
 }
 
@@ -26,6 +27,16 @@ type RoleManager interface {
 	GetOrgUsersForRole(role string, orgID string) ([]string, error)
 }
 
+// Canvas access management interface.
+// Canvas roles can be granted to organization users and groups.
+type CanvasAccessManager interface {
+	GetCanvasUserRoles(canvasID string) (map[string]string, error)
+	GetCanvasGroupRoles(canvasID string) (map[string]string, error)
+	AssignCanvasGroupRole(orgID, canvasID, group, role string) error
+	RemoveCanvasUserRole(canvasID, userID string) error
+	RemoveCanvasGroupRole(canvasID, group string) error
+}
+
 // Setup and initialization interface
 type AuthorizationSetup interface {
 	SetupOrganization(tx *gorm.DB, orgID, ownerID string) error
@@ -35,6 +46,7 @@ type AuthorizationSetup interface {
 // User access and role query interface
 type UserAccessQuery interface {
 	GetUserRolesForOrg(userID string, orgID string) ([]*RoleDefinition, error)
+	GetUserRolesForCanvas(userID, orgID, canvasID string) ([]string, error)
 }
 
 // Role definition and hierarchy interface
@@ -58,6 +70,7 @@ type Authorization interface {
 	PermissionChecker
 	GroupManager
 	RoleManager
+	CanvasAccessManager
 	AuthorizationSetup
 	UserAccessQuery
 	RoleDefinitionQuery
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"slices"
 	"strings"
 
 	"github.com/casbin/casbin/v2"
@@ -25,13 +26,15 @@ const (
 var _ Authorization = (*AuthService)(nil)
 
 type AuthService struct {
-	enforcer           *casbin.SyncedEnforcer
-	orgPolicyTemplates [][5]string
+	enforcer              *casbin.SyncedEnforcer
+	orgPolicyTemplates    [][5]string
+	canvasPolicyTemplates [][5]string
 }
 
 func NewAuthService() (*AuthService, error) {
 	modelPath := os.Getenv("RBAC_MODEL_PATH")
 	orgPolicyPath := os.Getenv("RBAC_ORG_POLICY_PATH")
+	canvasPolicyPath := os.Getenv("RBAC_CANVAS_POLICY_PATH")
 
 	adapter, err := gormadapter.NewTransactionalAdapterByDB(database.Conn())
 	if err != nil {
@@ -60,9 +63,20 @@ func NewAuthService() (*AuthService, error) {
 		return nil, fmt.Errorf("failed to parse org policies: %w", err)
 	}
 
+	canvasPoliciesCsv, err := os.ReadFile(canvasPolicyPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read canvas policies: %w", err)
+	}
+
+	canvasPolicyTemplates, err := parsePoliciesFromCsv(canvasPoliciesCsv)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse canvas policies: %w", err)
+	}
+
 	service := &AuthService{
-		enforcer:           enforcer,
-		orgPolicyTemplates: orgPolicyTemplates,
+		enforcer:              enforcer,
+		orgPolicyTemplates:    orgPolicyTemplates,
+		canvasPolicyTemplates: canvasPolicyTemplates,
 	}
 
 	if err := service.loadDefaultPolicies(); err != nil {
@@ -76,16 +90,60 @@ func (a *AuthService) CheckOrganizationPermission(userID, orgID, resource, actio
 	return a.checkPermission(userID, orgID, models.DomainTypeOrganization, resource, action)
 }
 
+// CheckCanvasPermission checks a permission on a single canvas.
+// Organization roles apply to all canvases of the organization, so they are checked first.
+// Canvas roles are granted to users directly, or to the organization groups they belong to.
+func (a *AuthService) CheckCanvasPermission(userID, orgID, canvasID, resource, action string) (bool, error) {
+	orgDomain := prefixDomain(models.DomainTypeOrganization, orgID)
+	canvasDomain := prefixDomain(models.DomainTypeCanvas, canvasID)
+
+	err := a.reloadPolicies(orgDomain, defaultDomain(models.DomainTypeOrganization), canvasDomain, defaultDomain(models.DomainTypeCanvas))
+	if err != nil {
+		return false, err
+	}
+
+	prefixedUserID := prefixUserID(userID)
+	allowed, err := a.enforcer.Enforce(prefixedUserID, orgDomain, resource, action)
+	if err != nil || allowed {
+		return allowed, err
+	}
+
+	subjects := []string{prefixedUserID}
+	memberships, err := a.enforcer.GetFilteredGroupingPolicy(0, prefixedUserID, "", orgDomain)
+	if err != nil {
+		return false, fmt.Errorf("failed to get groups for user: %w", err)
+	}
+
+	for _, membership := range memberships {
+		if strings.HasPrefix(membership[1], "/groups/") {
+			subjects = append(subjects, membership[1])
+		}
+	}
+
+	for _, subject := range subjects {
+		allowed, err := a.enforcer.Enforce(subject, canvasDomain, resource, action)
+		if err != nil {
+			return false, err
+		}
+
+		if allowed {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
 func (a *AuthService) IsValidPermission(domainType string, permission *Permission) bool {
 	if permission == nil {
 		return false
 	}
 
-	if domainType != models.DomainTypeOrganization {
+	if models.ValidateDomainType(domainType) != nil {
 		return false
 	}
 
-	for _, policy := range a.orgPolicyTemplates {
+	for _, policy := range a.policyTemplates(domainType) {
 		if policy[0] != "p" {
 			continue
 		}
@@ -99,16 +157,29 @@ func (a *AuthService) IsValidPermission(domainType string, permission *Permissio
 
 func (a *AuthService) checkPermission(userID, domainID, domainType, resource, action string) (bool, error) {
 	domain := prefixDomain(domainType, domainID)
-	defaultDomain := ""
-	if domainType == models.DomainTypeOrganization {
-		defaultDomain = "/org/*"
-	}
-
 	policyDomains := []string{domain}
-	if defaultDomain != "" {
+	if defaultDomain := defaultDomain(domainType); defaultDomain != "" {
 		policyDomains = append(policyDomains, defaultDomain)
 	}
 
+	if err := a.reloadPolicies(policyDomains...); err != nil {
+		return false, err
+	}
+
+	prefixedUserID := prefixUserID(userID)
+	allowed, err := a.enforcer.Enforce(prefixedUserID, domain, resource, action)
+	if err != nil {
+		return false, err
+	}
+
+	if allowed {
+		return true, nil
+	}
+
+	return false, nil
+}
+
+func (a *AuthService) reloadPolicies(policyDomains ...string) error {
 	filters := []gormadapter.Filter{
 		{
 			Ptype: []string{"p"},
@@ -127,24 +198,10 @@ func (a *AuthService) checkPermission(userID, domainID, domainType, resource, ac
 	//
 	err := a.enforcer.LoadFilteredPolicy(filters)
 	if err != nil {
-		return false, err
-	}
-
-	if err := a.loadDefaultPolicies(); err != nil {
-		return false, err
-	}
-
-	prefixedUserID := prefixUserID(userID)
-	allowed, err := a.enforcer.Enforce(prefixedUserID, domain, resource, action)
-	if err != nil {
-		return false, err
-	}
-
-	if allowed {
-		return true, nil
+		return err
 	}
 
-	return false, nil
+	return a.loadDefaultPolicies()
 }
 
 func (a *AuthService) CreateGroup(domainID string, domainType string, groupName string, role string, displayName string, description string) error {
@@ -399,49 +456,42 @@ func (a *AuthService) AssignRole(userID, role, domainID string, domainType strin
 }
 
 func (a *AuthService) assignRoleWithEnforcer(enforcer casbin.IEnforcer, userID, role, domainID, domainType string) error {
+	return a.assignSubjectRoleWithEnforcer(enforcer, prefixUserID(userID), role, domainID, domainType)
+}
+
+func (a *AuthService) assignSubjectRoleWithEnforcer(enforcer casbin.IEnforcer, subject, role, domainID, domainType string) error {
 	domain := prefixDomain(domainType, domainID)
 	prefixedRole := prefixRoleName(role)
 
-	// Check if it's a default role
-	validRoles := map[string][]string{
-		models.DomainTypeOrganization: {models.RoleOrgViewer, models.RoleOrgAdmin, models.RoleOrgOwner},
-	}
-
-	isValidDefaultRole := false
-	if roles, exists := validRoles[domainType]; exists {
-		isValidDefaultRole = contains(roles, role)
-	}
-
 	// If not a default role, check if it's a custom role that exists
-	if !isValidDefaultRole {
+	if !a.IsDefaultRole(role, domainType) {
 		policies, _ := enforcer.GetFilteredPolicy(0, prefixedRole, domain)
 		if len(policies) == 0 {
 			return fmt.Errorf("invalid role %s for domain type %s", role, domainType)
 		}
 	}
 
-	prefixedUserID := prefixUserID(userID)
-	existingRoles, err := enforcer.GetFilteredGroupingPolicy(0, prefixedUserID, "", domain)
+	existingRoles, err := enforcer.GetFilteredGroupingPolicy(0, subject, "", domain)
 	if err != nil {
-		return fmt.Errorf("failed to get existing roles for user: %w", err)
+		return fmt.Errorf("failed to get existing roles for %s: %w", subject, err)
 	}
 
 	for _, existingRole := range existingRoles {
 		if strings.HasPrefix(existingRole[1], "/roles/") {
-			_, err := enforcer.RemoveGroupingPolicy(prefixedUserID, existingRole[1], domain)
+			_, err := enforcer.RemoveGroupingPolicy(subject, existingRole[1], domain)
 			if err != nil {
-				log.Warnf("failed to remove existing role %s for user %s: %v", existingRole[1], userID, err)
+				log.Warnf("failed to remove existing role %s for %s: %v", existingRole[1], subject, err)
 			}
 		}
 	}
 
-	ruleAdded, err := enforcer.AddGroupingPolicy(prefixedUserID, prefixedRole, domain)
+	ruleAdded, err := enforcer.AddGroupingPolicy(subject, prefixedRole, domain)
 	if err != nil {
 		return fmt.Errorf("failed to add role: %w", err)
 	}
 
 	if !ruleAdded {
-		log.Infof("role %s already exists for user %s", role, userID)
+		log.Infof("role %s already exists for %s", role, subject)
 	}
 
 	return nil
@@ -552,6 +602,78 @@ func (a *AuthService) DestroyOrganization(tx *gorm.DB, orgID string) error {
 	return nil
 }
 
+// GetCanvasUserRoles returns the roles granted on a canvas to users, by user ID.
+func (a *AuthService) GetCanvasUserRoles(canvasID string) (map[string]string, error) {
+	return a.getCanvasSubjectRoles(canvasID, "/users/")
+}
+
+// GetCanvasGroupRoles returns the roles granted on a canvas to organization groups, by group name.
+func (a *AuthService) GetCanvasGroupRoles(canvasID string) (map[string]string, error) {
+	return a.getCanvasSubjectRoles(canvasID, "/groups/")
+}
+
+func (a *AuthService) getCanvasSubjectRoles(canvasID string, subjectPrefix string) (map[string]string, error) {
+	domain := prefixDomain(models.DomainTypeCanvas, canvasID)
+	policies, err := a.enforcer.GetFilteredGroupingPolicy(2, domain)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get canvas roles: %w", err)
+	}
+
+	roles := map[string]string{}
+	for _, policy := range policies {
+		if !strings.HasPrefix(policy[0], subjectPrefix) || !strings.HasPrefix(policy[1], "/roles/") {
+			continue
+		}
+
+		roles[strings.TrimPrefix(policy[0], subjectPrefix)] = strings.TrimPrefix(policy[1], "/roles/")
+	}
+
+	return roles, nil
+}
+
+// AssignCanvasGroupRole grants a role on a canvas to all members of an organization group.
+// The group keeps a single role on the canvas, so any previous role is replaced.
+func (a *AuthService) AssignCanvasGroupRole(orgID, canvasID, group, role string) error {
+	orgDomain := prefixDomain(models.DomainTypeOrganization, orgID)
+	prefixedGroupName := prefixGroupName(group)
+
+	groups, err := a.enforcer.GetFilteredGroupingPolicy(0, prefixedGroupName, "", orgDomain)
+	if err != nil {
+		return fmt.Errorf("failed to check group existence: %w", err)
+	}
+
+	if len(groups) == 0 {
+		return fmt.Errorf("group %s does not exist in organization %s", group, orgID)
+	}
+
+	db := a.enforcer.GetAdapter().(*gormadapter.Adapter)
+	return db.Transaction(a.enforcer, func(enforcerTx casbin.IEnforcer) error {
+		return a.assignSubjectRoleWithEnforcer(enforcerTx, prefixedGroupName, role, canvasID, models.DomainTypeCanvas)
+	})
+}
+
+// RemoveCanvasUserRole removes the role of a user on a canvas, if there is one.
+func (a *AuthService) RemoveCanvasUserRole(canvasID, userID string) error {
+	domain := prefixDomain(models.DomainTypeCanvas, canvasID)
+	_, err := a.enforcer.RemoveFilteredGroupingPolicy(0, prefixUserID(userID), "", domain)
+	if err != nil {
+		return fmt.Errorf("failed to remove canvas role for user %s: %w", userID, err)
+	}
+
+	return nil
+}
+
+// RemoveCanvasGroupRole removes the role of an organization group on a canvas, if there is one.
+func (a *AuthService) RemoveCanvasGroupRole(canvasID, group string) error {
+	domain := prefixDomain(models.DomainTypeCanvas, canvasID)
+	_, err := a.enforcer.RemoveFilteredGroupingPolicy(0, prefixGroupName(group), "", domain)
+	if err != nil {
+		return fmt.Errorf("failed to remove canvas role for group %s: %w", group, err)
+	}
+
+	return nil
+}
+
 func (a *AuthService) GetUserRolesForOrg(userID string, orgID string) ([]*RoleDefinition, error) {
 	orgDomain := prefixDomain(models.DomainTypeOrganization, orgID)
 	prefixedUserID := prefixUserID(userID)
@@ -579,6 +701,49 @@ func (a *AuthService) GetUserRolesForOrg(userID string, orgID string) ([]*RoleDe
 	return roles, nil
 }
 
+// GetUserRolesForCanvas returns the names of the canvas roles of a user,
+// granted to the user directly or to one of the organization groups of the user,
+// including the roles they inherit.
+func (a *AuthService) GetUserRolesForCanvas(userID, orgID, canvasID string) ([]string, error) {
+	orgDomain := prefixDomain(models.DomainTypeOrganization, orgID)
+	canvasDomain := prefixDomain(models.DomainTypeCanvas, canvasID)
+
+	err := a.reloadPolicies(orgDomain, defaultDomain(models.DomainTypeOrganization), canvasDomain, defaultDomain(models.DomainTypeCanvas))
+	if err != nil {
+		return nil, err
+	}
+
+	prefixedUserID := prefixUserID(userID)
+	subjects := []string{prefixedUserID}
+	memberships, err := a.enforcer.GetFilteredGroupingPolicy(0, prefixedUserID, "", orgDomain)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get groups for user: %w", err)
+	}
+
+	for _, membership := range memberships {
+		if strings.HasPrefix(membership[1], "/groups/") {
+			subjects = append(subjects, membership[1])
+		}
+	}
+
+	roles := []string{}
+	for _, subject := range subjects {
+		roleNames, err := a.enforcer.GetImplicitRolesForUser(subject, canvasDomain)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get canvas roles: %w", err)
+		}
+
+		for _, roleName := range roleNames {
+			roleName, ok := strings.CutPrefix(roleName, "/roles/")
+			if ok && !slices.Contains(roles, roleName) {
+				roles = append(roles, roleName)
+			}
+		}
+	}
+
+	return roles, nil
+}
+
 func (a *AuthService) GetRoleDefinition(roleName string, domainType string, domainID string) (*RoleDefinition, error) {
 	if err := models.ValidateDomainType(domainType); err != nil {
 		return nil, err
@@ -868,6 +1033,7 @@ func (a *AuthService) DeleteCustomRole(domainID string, domainType string, roleN
 func (a *AuthService) IsDefaultRole(roleName string, domainType string) bool {
 	defaultRoles := map[string][]string{
 		models.DomainTypeOrganization: {models.RoleOrgOwner, models.RoleOrgAdmin, models.RoleOrgViewer},
+		models.DomainTypeCanvas:       {models.RoleCanvasOwner, models.RoleCanvasEditor, models.RoleCanvasOperator, models.RoleCanvasViewer},
 	}
 
 	roles, exists := defaultRoles[domainType]
@@ -899,8 +1065,20 @@ func parsePoliciesFromCsv(content []byte) ([][5]string, error) {
 	return policies, nil
 }
 
+func (a *AuthService) policyTemplates(domainType string) [][5]string {
+	switch domainType {
+	case models.DomainTypeOrganization:
+		return a.orgPolicyTemplates
+	case models.DomainTypeCanvas:
+		return a.canvasPolicyTemplates
+	default:
+		return nil
+	}
+}
+
 func (a *AuthService) loadDefaultPolicies() error {
-	for _, policy := range a.orgPolicyTemplates {
+	policies := append(append([][5]string{}, a.orgPolicyTemplates...), a.canvasPolicyTemplates...)
+	for _, policy := range policies {
 		switch policy[0] {
 		case "g":
 			_, err := a.enforcer.AddGroupingPolicy(policy[1], policy[2], policy[3])
@@ -1005,11 +1183,12 @@ func (a *AuthService) getDefaultRolePermissions(roleName, domainType string) []*
 		prefixRoleName(roleName): true,
 	}
 
+	templates := a.policyTemplates(domainType)
 	queue := []string{prefixRoleName(roleName)}
 	for len(queue) > 0 {
 		current := queue[0]
 		queue = queue[1:]
-		for _, policy := range a.orgPolicyTemplates {
+		for _, policy := range templates {
 			if policy[0] != "g" {
 				continue
 			}
@@ -1025,7 +1204,7 @@ func (a *AuthService) getDefaultRolePermissions(roleName, domainType string) []*
 	}
 
 	permissionSet := make(map[string]*Permission)
-	for _, policy := range a.orgPolicyTemplates {
+	for _, policy := range templates {
 		if policy[0] != "p" {
 			continue
 		}
@@ -1131,10 +1310,16 @@ func (a *AuthService) getRolesFromPolicies(domain string) ([]string, error) {
 		}
 	}
 
-	if a.getDomainTypeFromDomain(domain) == models.DomainTypeOrganization {
+	switch a.getDomainTypeFromDomain(domain) {
+	case models.DomainTypeOrganization:
 		roles[models.RoleOrgOwner] = true
 		roles[models.RoleOrgAdmin] = true
 		roles[models.RoleOrgViewer] = true
+	case models.DomainTypeCanvas:
+		roles[models.RoleCanvasOwner] = true
+		roles[models.RoleCanvasEditor] = true
+		roles[models.RoleCanvasOperator] = true
+		roles[models.RoleCanvasViewer] = true
 	}
 
 	roleList := make([]string, 0, len(roles))
@@ -1150,6 +1335,11 @@ func (a *AuthService) getRoleDescription(roleName string) string {
 		models.RoleOrgViewer: models.DescOrgViewer,
 		models.RoleOrgAdmin:  models.DescOrgAdmin,
 		models.RoleOrgOwner:  models.DescOrgOwner,
+
+		models.RoleCanvasViewer:   models.DescCanvasViewer,
+		models.RoleCanvasOperator: models.DescCanvasOperator,
+		models.RoleCanvasEditor:   models.DescCanvasEditor,
+		models.RoleCanvasOwner:    models.DescCanvasOwner,
 	}
 
 	if description, exists := descriptions[roleName]; exists {
@@ -1172,6 +1362,10 @@ func (a *AuthService) getDomainTypeFromDomain(domain string) string {
 		return models.DomainTypeOrganization
 	}
 
+	if strings.HasPrefix(domain, "/canvas/") {
+		return models.DomainTypeCanvas
+	}
+
 	return ""
 }
 
@@ -1227,6 +1421,19 @@ func prefixDomain(domainType string, domainID string) string {
 	return fmt.Sprintf("/%s/%s", domainType, domainID)
 }
 
+// defaultDomain returns the domain pattern that the default policies
+// of a domain type are loaded into.
+func defaultDomain(domainType string) string {
+	switch domainType {
+	case models.DomainTypeOrganization:
+		return "/org/*"
+	case models.DomainTypeCanvas:
+		return "/canvas/*"
+	default:
+		return ""
+	}
+}
+
 func useIfNonEmpty(a, b string) string {
 	if a != "" {
 		return a
@@ -1,6 +1,7 @@
 package authorization_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/google/uuid"
@@ -249,6 +250,152 @@ func Test__AuthService_GroupManagement(t *testing.T) {
 	})
 }
 
+func Test__AuthService_CanvasPermissions(t *testing.T) {
+	r := support.Setup(t)
+	orgID := r.Organization.ID.String()
+	canvasID := uuid.NewString()
+	otherCanvasID := uuid.NewString()
+
+	checkActions := func(t *testing.T, userID string, expected map[string]bool) {
+		for permission, allowed := range expected {
+			resourceAndAction := strings.Split(permission, ":")
+			result, err := r.AuthService.CheckCanvasPermission(userID, orgID, canvasID, resourceAndAction[0], resourceAndAction[1])
+			require.NoError(t, err)
+			assert.Equal(t, allowed, result, "unexpected result for %s", permission)
+		}
+	}
+
+	t.Run("canvas roles only apply to their canvas", func(t *testing.T) {
+		userID := uuid.NewString()
+		err := r.AuthService.AssignRole(userID, models.RoleCanvasEditor, canvasID, models.DomainTypeCanvas)
+		require.NoError(t, err)
+
+		checkActions(t, userID, map[string]bool{
+			"canvases:read":     true,
+			"executions:update": true,
+			"canvases:update":   true,
+			"secrets:update":    true,
+			"canvases:delete":   false,
+			"members:update":    false,
+		})
+
+		allowed, err := r.AuthService.CheckCanvasPermission(userID, orgID, otherCanvasID, "canvases", "read")
+		require.NoError(t, err)
+		assert.False(t, allowed)
+
+		allowed, err = r.AuthService.CheckOrganizationPermission(userID, orgID, "canvases", "update")
+		require.NoError(t, err)
+		assert.False(t, allowed)
+	})
+
+	t.Run("canvas role hierarchy", func(t *testing.T) {
+		viewerID := uuid.NewString()
+		operatorID := uuid.NewString()
+		ownerID := uuid.NewString()
+		require.NoError(t, r.AuthService.AssignRole(viewerID, models.RoleCanvasViewer, canvasID, models.DomainTypeCanvas))
+		require.NoError(t, r.AuthService.AssignRole(operatorID, models.RoleCanvasOperator, canvasID, models.DomainTypeCanvas))
+		require.NoError(t, r.AuthService.AssignRole(ownerID, models.RoleCanvasOwner, canvasID, models.DomainTypeCanvas))
+
+		checkActions(t, viewerID, map[string]bool{
+			"canvases:read":     true,
+			"executions:update": false,
+			"canvases:update":   false,
+		})
+
+		checkActions(t, operatorID, map[string]bool{
+			"canvases:read":     true,
+			"executions:update": true,
+			"canvases:update":   false,
+			"secrets:read":      false,
+		})
+
+		checkActions(t, ownerID, map[string]bool{
+			"canvases:update": true,
+			"canvases:delete": true,
+			"members:update":  true,
+		})
+	})
+
+	t.Run("organization roles apply to all canvases", func(t *testing.T) {
+		adminID := uuid.NewString()
+		require.NoError(t, r.AuthService.AssignRole(adminID, models.RoleOrgAdmin, orgID, models.DomainTypeOrganization))
+
+		checkActions(t, adminID, map[string]bool{
+			"canvases:update":   true,
+			"canvases:delete":   true,
+			"executions:update": true,
+			"members:update":    true,
+		})
+	})
+
+	t.Run("canvas roles of organization groups", func(t *testing.T) {
+		userID := uuid.NewString()
+		require.NoError(t, r.AuthService.CreateGroup(orgID, models.DomainTypeOrganization, "operators", models.RoleOrgViewer, "Operators", ""))
+		require.NoError(t, r.AuthService.AddUserToGroup(orgID, models.DomainTypeOrganization, userID, "operators"))
+		require.NoError(t, r.AuthService.AssignCanvasGroupRole(orgID, canvasID, "operators", models.RoleCanvasOperator))
+
+		checkActions(t, userID, map[string]bool{
+			"canvases:read":     true,
+			"executions:update": true,
+			"canvases:update":   false,
+		})
+
+		groups, err := r.AuthService.GetCanvasGroupRoles(canvasID)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"operators": models.RoleCanvasOperator}, groups)
+
+		require.NoError(t, r.AuthService.RemoveCanvasGroupRole(canvasID, "operators"))
+		checkActions(t, userID, map[string]bool{
+			"executions:update": false,
+		})
+	})
+
+	t.Run("canvas roles of a user", func(t *testing.T) {
+		userID := uuid.NewString()
+		require.NoError(t, r.AuthService.AssignRole(userID, models.RoleCanvasEditor, canvasID, models.DomainTypeCanvas))
+		require.NoError(t, r.AuthService.CreateGroup(orgID, models.DomainTypeOrganization, "owners", models.RoleOrgViewer, "Owners", ""))
+		require.NoError(t, r.AuthService.AddUserToGroup(orgID, models.DomainTypeOrganization, userID, "owners"))
+		require.NoError(t, r.AuthService.AssignCanvasGroupRole(orgID, canvasID, "owners", models.RoleCanvasOwner))
+
+		roles, err := r.AuthService.GetUserRolesForCanvas(userID, orgID, canvasID)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{
+			models.RoleCanvasOwner,
+			models.RoleCanvasEditor,
+			models.RoleCanvasOperator,
+			models.RoleCanvasViewer,
+		}, roles)
+
+		roles, err = r.AuthService.GetUserRolesForCanvas(uuid.NewString(), orgID, canvasID)
+		require.NoError(t, err)
+		assert.Empty(t, roles)
+	})
+
+	t.Run("group must exist in the organization", func(t *testing.T) {
+		err := r.AuthService.AssignCanvasGroupRole(orgID, canvasID, "does-not-exist", models.RoleCanvasViewer)
+		require.ErrorContains(t, err, "does not exist")
+	})
+
+	t.Run("organization roles are not valid canvas roles", func(t *testing.T) {
+		err := r.AuthService.AssignRole(uuid.NewString(), models.RoleOrgAdmin, canvasID, models.DomainTypeCanvas)
+		require.ErrorContains(t, err, "invalid role")
+	})
+
+	t.Run("removing a member", func(t *testing.T) {
+		userID := uuid.NewString()
+		require.NoError(t, r.AuthService.AssignRole(userID, models.RoleCanvasViewer, canvasID, models.DomainTypeCanvas))
+
+		members, err := r.AuthService.GetCanvasUserRoles(canvasID)
+		require.NoError(t, err)
+		assert.Equal(t, models.RoleCanvasViewer, members[userID])
+
+		require.NoError(t, r.AuthService.RemoveCanvasUserRole(canvasID, userID))
+		checkActions(t, userID, map[string]bool{
+			"canvases:read": false,
+		})
+	})
+}
+
 func Test__AuthService_RoleHierarchy(t *testing.T) {
 	r := support.Setup(t)
 	orgID := r.Organization.ID.String()
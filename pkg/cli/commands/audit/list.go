@@ -0,0 +1,105 @@
+package audit
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+type ListAuditEventsCommand struct {
+	ResourceType *string
+	ResourceID   *string
+	ActorID      *string
+	Action       *string
+	Limit        *int64
+	Before       *string
+}
+
+func (c *ListAuditEventsCommand) Execute(ctx core.CommandContext) error {
+	request := ctx.API.AuditAPI.AuditListAuditEvents(ctx.Context)
+
+	if *c.ResourceType != "" {
+		request = request.ResourceType(*c.ResourceType)
+	}
+
+	if *c.ResourceID != "" {
+		request = request.ResourceId(*c.ResourceID)
+	}
+
+	if *c.ActorID != "" {
+		request = request.ActorId(*c.ActorID)
+	}
+
+	if *c.Action != "" {
+		request = request.Action(*c.Action)
+	}
+
+	if *c.Limit > 0 {
+		request = request.Limit(*c.Limit)
+	}
+
+	if *c.Before != "" {
+		beforeTime, err := time.Parse(time.RFC3339, *c.Before)
+		if err != nil {
+			return fmt.Errorf("invalid --before value %q: expected RFC3339 timestamp", *c.Before)
+		}
+		request = request.Before(beforeTime)
+	}
+
+	response, _, err := request.Execute()
+	if err != nil {
+		return err
+	}
+
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(response)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		return renderAuditEventsText(stdout, response.GetEvents())
+	})
+}
+
+func renderAuditEventsText(stdout io.Writer, events []openapi_client.AuditAuditEvent) error {
+	writer := tabwriter.NewWriter(stdout, 0, 8, 2, ' ', 0)
+	_, _ = fmt.Fprintln(writer, "CREATED_AT\tACTOR\tACTION\tRESOURCE_TYPE\tRESOURCE\tSTATUS")
+	for _, event := range events {
+		resource := event.GetResource()
+		_, _ = fmt.Fprintf(
+			writer,
+			"%s\t%s\t%s\t%s\t%s\t%s\n",
+			event.GetCreatedAt().Format(time.RFC3339),
+			actorName(event.GetActor()),
+			event.GetAction(),
+			resource.GetType(),
+			resourceName(resource),
+			event.GetStatus(),
+		)
+	}
+
+	return writer.Flush()
+}
+
+func actorName(actor openapi_client.AuditEventActor) string {
+	if actor.GetEmail() != "" {
+		return actor.GetEmail()
+	}
+
+	if actor.GetName() != "" {
+		return actor.GetName()
+	}
+
+	return actor.GetId()
+}
+
+func resourceName(resource openapi_client.AuditEventResource) string {
+	if resource.GetName() != "" {
+		return resource.GetName()
+	}
+
+	return resource.GetId()
+}
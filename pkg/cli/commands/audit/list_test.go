@@ -0,0 +1,59 @@
+package audit
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+func TestRenderAuditEventsText(t *testing.T) {
+	actor := openapi_client.AuditEventActor{}
+	actor.SetId("user-1")
+	actor.SetName("Jane")
+	actor.SetEmail("jane@example.com")
+
+	resource := openapi_client.AuditEventResource{}
+	resource.SetType("secrets")
+	resource.SetId("secret-1")
+	resource.SetName("prod-token")
+
+	event := openapi_client.AuditAuditEvent{}
+	event.SetActor(actor)
+	event.SetResource(resource)
+	event.SetAction("update")
+	event.SetStatus("OK")
+	event.SetCreatedAt(time.Date(2026, 10, 19, 11, 0, 0, 0, time.UTC))
+
+	//
+	// Events for resources without a name, and actors that no longer exist,
+	// fall back to their IDs.
+	//
+	deleted := openapi_client.AuditEventResource{}
+	deleted.SetType("canvases")
+	deleted.SetId("canvas-1")
+
+	unknown := openapi_client.AuditEventActor{}
+	unknown.SetId("user-2")
+
+	denied := openapi_client.AuditAuditEvent{}
+	denied.SetActor(unknown)
+	denied.SetResource(deleted)
+	denied.SetAction("delete")
+	denied.SetStatus("PermissionDenied")
+	denied.SetCreatedAt(time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC))
+
+	var output bytes.Buffer
+	if err := renderAuditEventsText(&output, []openapi_client.AuditAuditEvent{event, denied}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := `CREATED_AT            ACTOR             ACTION  RESOURCE_TYPE  RESOURCE    STATUS
+2026-10-19T11:00:00Z  jane@example.com  update  secrets        prod-token  OK
+2026-10-19T10:00:00Z  user-2            delete  canvases       canvas-1    PermissionDenied
+`
+	if output.String() != expected {
+		t.Fatalf("unexpected audit events output:\n%s", output.String())
+	}
+}
@@ -0,0 +1,44 @@
+package audit
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/superplanehq/superplane/pkg/cli/core"
+)
+
+func NewCommand(options core.BindOptions) *cobra.Command {
+	var resourceType string
+	var resourceID string
+	var actorID string
+	var action string
+	var limit int64
+	var before string
+
+	root := &cobra.Command{
+		Use:   "audit",
+		Short: "Inspect the audit log of the organization",
+	}
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List audit events, newest first",
+		Args:  cobra.NoArgs,
+	}
+	listCmd.Flags().StringVar(&resourceType, "resource-type", "", "only list events for this type of resource, like canvases or secrets")
+	listCmd.Flags().StringVar(&resourceID, "resource-id", "", "only list events for this resource")
+	listCmd.Flags().StringVar(&actorID, "actor-id", "", "only list events for changes made by this user or service account")
+	listCmd.Flags().StringVar(&action, "action", "", "only list events for this action, like create or delete")
+	listCmd.Flags().Int64Var(&limit, "limit", 50, "maximum number of items to return")
+	listCmd.Flags().StringVar(&before, "before", "", "return items before this timestamp (RFC3339)")
+	core.Bind(listCmd, &ListAuditEventsCommand{
+		ResourceType: &resourceType,
+		ResourceID:   &resourceID,
+		ActorID:      &actorID,
+		Action:       &action,
+		Limit:        &limit,
+		Before:       &before,
+	}, options)
+
+	root.AddCommand(listCmd)
+
+	return root
+}
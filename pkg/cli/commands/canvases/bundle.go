@@ -0,0 +1,170 @@
+package canvases
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/superplanehq/superplane/pkg/cli/commands/canvases/models"
+	"github.com/superplanehq/superplane/pkg/cli/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+type exportCommand struct {
+	file *string
+}
+
+func (c *exportCommand) Execute(ctx core.CommandContext) error {
+	target := ""
+	if len(ctx.Args) == 1 {
+		target = strings.TrimSpace(ctx.Args[0])
+	}
+
+	canvasID, err := resolveCanvasTargetFromOptionalArg(ctx, target)
+	if err != nil {
+		return err
+	}
+
+	response, _, err := ctx.API.CanvasAPI.CanvasesExportCanvas(ctx.Context, canvasID).Execute()
+	if err != nil {
+		return err
+	}
+	if response.Bundle == nil {
+		return fmt.Errorf("canvas %q returned an empty bundle", canvasID)
+	}
+
+	resource := models.CanvasBundleResourceFromBundle(*response.Bundle)
+
+	filePath := ""
+	if c.file != nil {
+		filePath = strings.TrimSpace(*c.file)
+	}
+
+	if filePath == "" && !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(resource)
+	}
+
+	payload, err := yaml.Marshal(resource)
+	if err != nil {
+		return fmt.Errorf("failed to serialize canvas bundle: %w", err)
+	}
+
+	if filePath == "" {
+		return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+			_, err := stdout.Write(payload)
+			return err
+		})
+	}
+
+	if err := os.WriteFile(filePath, payload, 0600); err != nil {
+		return fmt.Errorf("failed to write canvas bundle: %w", err)
+	}
+
+	if !ctx.Renderer.IsText() {
+		return nil
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		_, err := fmt.Fprintf(stdout, "Canvas exported to %s\n", filePath)
+		return err
+	})
+}
+
+type importCommand struct {
+	file         *string
+	name         *string
+	integrations *[]string
+}
+
+func (c *importCommand) Execute(ctx core.CommandContext) error {
+	filePath := ""
+	if c.file != nil {
+		filePath = strings.TrimSpace(*c.file)
+	}
+	if filePath == "" {
+		return fmt.Errorf("--file is required")
+	}
+
+	resource, err := parseCanvasBundleFromFile(filePath)
+	if err != nil {
+		return err
+	}
+
+	mappings := []openapi_client.ImportCanvasRequestIntegrationMapping{}
+	if c.integrations != nil {
+		mappings, err = parseIntegrationMappings(*c.integrations)
+		if err != nil {
+			return err
+		}
+	}
+
+	request := openapi_client.CanvasesImportCanvasRequest{}
+	request.SetBundle(models.BundleFromCanvasBundle(*resource))
+	request.SetIntegrationMappings(mappings)
+	if c.name != nil && strings.TrimSpace(*c.name) != "" {
+		request.SetName(strings.TrimSpace(*c.name))
+	}
+
+	response, _, err := ctx.API.CanvasAPI.CanvasesImportCanvas(ctx.Context).Body(request).Execute()
+	if err != nil {
+		return err
+	}
+
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(response)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		canvas := response.GetCanvas()
+		metadata := canvas.GetMetadata()
+		_, _ = fmt.Fprintf(stdout, "Canvas %q imported (ID: %s)\n", metadata.GetName(), metadata.GetId())
+
+		missingSecrets := response.GetMissingSecrets()
+		if len(missingSecrets) == 0 {
+			return nil
+		}
+
+		_, err := fmt.Fprintf(stdout, "Missing secrets: %s\n", strings.Join(missingSecrets, ", "))
+		return err
+	})
+}
+
+func parseCanvasBundleFromFile(filePath string) (*models.CanvasBundle, error) {
+	// #nosec
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read resource file: %w", err)
+	}
+
+	_, kind, err := core.ParseYamlResourceHeaders(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if kind != models.CanvasBundleKind {
+		return nil, fmt.Errorf("unsupported resource kind %q for import", kind)
+	}
+
+	return models.ParseCanvasBundle(data)
+}
+
+func parseIntegrationMappings(values []string) ([]openapi_client.ImportCanvasRequestIntegrationMapping, error) {
+	mappings := make([]openapi_client.ImportCanvasRequestIntegrationMapping, 0, len(values))
+	for _, value := range values {
+		from, to, ok := strings.Cut(value, "=")
+		from = strings.TrimSpace(from)
+		to = strings.TrimSpace(to)
+		if !ok || from == "" || to == "" {
+			return nil, fmt.Errorf("invalid integration mapping %q, expected <bundle-name>=<integration-name>", value)
+		}
+
+		mapping := openapi_client.ImportCanvasRequestIntegrationMapping{}
+		mapping.SetFrom(from)
+		mapping.SetTo(to)
+		mappings = append(mappings, mapping)
+	}
+
+	return mappings, nil
+}
@@ -473,11 +473,30 @@ func renderCanvasChangeRequestText(ctx core.CommandContext, changeRequest openap
 			_, _ = fmt.Fprintln(stdout, "")
 		}
 
+		renderCanvasChangeRequestChecksText(stdout, changeRequest.GetChecks())
+
 		_, err := fmt.Fprintln(stdout)
 		return err
 	})
 }
 
+func renderCanvasChangeRequestChecksText(stdout io.Writer, checks []openapi_client.CanvasesCanvasChangeRequestCheck) {
+	_, _ = fmt.Fprintf(stdout, "Checks: %d\n", len(checks))
+	for _, check := range checks {
+		status := strings.ToLower(strings.TrimPrefix(string(check.GetStatus()), "STATUS_"))
+		_, _ = fmt.Fprintf(stdout, "  - %s: %s\n", check.GetName(), status)
+
+		for _, problem := range check.GetProblems() {
+			if problem.GetNodeId() == "" {
+				_, _ = fmt.Fprintf(stdout, "      %s\n", problem.GetMessage())
+				continue
+			}
+
+			_, _ = fmt.Fprintf(stdout, "      %s: %s\n", problem.GetNodeId(), problem.GetMessage())
+		}
+	}
+}
+
 func renderCanvasChangeRequestSummaryText(
 	ctx core.CommandContext,
 	event string,
@@ -0,0 +1,35 @@
+package canvases
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+func TestRenderCanvasChangeRequestChecksText(t *testing.T) {
+	passed := openapi_client.CanvasesCanvasChangeRequestCheck{}
+	passed.SetName("configuration")
+	passed.SetStatus(openapi_client.CANVASESCANVASCHANGEREQUESTCHECKSTATUS_STATUS_PASSED)
+
+	problem := openapi_client.CanvasChangeRequestCheckProblem{}
+	problem.SetNodeId("node-1")
+	problem.SetMessage("component noop is not allowed on this canvas")
+
+	failed := openapi_client.CanvasesCanvasChangeRequestCheck{}
+	failed.SetName("forbidden-components")
+	failed.SetStatus(openapi_client.CANVASESCANVASCHANGEREQUESTCHECKSTATUS_STATUS_FAILED)
+	failed.SetProblems([]openapi_client.CanvasChangeRequestCheckProblem{problem})
+
+	var output bytes.Buffer
+	renderCanvasChangeRequestChecksText(&output, []openapi_client.CanvasesCanvasChangeRequestCheck{passed, failed})
+
+	expected := `Checks: 2
+  - configuration: passed
+  - forbidden-components: failed
+      node-1: component noop is not allowed on this canvas
+`
+	if output.String() != expected {
+		t.Fatalf("unexpected checks output:\n%s", output.String())
+	}
+}
@@ -0,0 +1,183 @@
+package canvases
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+type validateExpressionCommand struct {
+	nodeID    *string
+	versionID *string
+}
+
+func (c *validateExpressionCommand) Execute(ctx core.CommandContext) error {
+	expression, canvasID, err := parseExpressionArgs(ctx)
+	if err != nil {
+		return err
+	}
+
+	nodeID := strings.TrimSpace(*c.nodeID)
+	if nodeID == "" {
+		return fmt.Errorf("--node is required")
+	}
+
+	body := openapi_client.CanvasesValidateExpressionBody{}
+	body.SetNodeId(nodeID)
+	body.SetExpression(expression)
+	if versionID := strings.TrimSpace(*c.versionID); versionID != "" {
+		body.SetVersionId(versionID)
+	}
+
+	response, _, err := ctx.API.CanvasAPI.
+		CanvasesValidateExpression(ctx.Context, canvasID).
+		Body(body).
+		Execute()
+	if err != nil {
+		return err
+	}
+
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(response)
+	}
+
+	err = ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		diagnostics := response.GetDiagnostics()
+		if len(diagnostics) == 0 {
+			_, err := fmt.Fprintln(stdout, "Expression is valid")
+			return err
+		}
+
+		for _, diagnostic := range diagnostics {
+			_, _ = fmt.Fprintf(
+				stdout,
+				"%s [%d:%d]: %s\n",
+				expressionSeverityLabel(diagnostic.GetSeverity()),
+				diagnostic.GetFrom(),
+				diagnostic.GetTo(),
+				diagnostic.GetMessage(),
+			)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if !response.GetValid() {
+		return fmt.Errorf("expression is not valid")
+	}
+
+	return nil
+}
+
+type completeExpressionCommand struct {
+	nodeID    *string
+	versionID *string
+	cursor    *int
+}
+
+func (c *completeExpressionCommand) Execute(ctx core.CommandContext) error {
+	expression, canvasID, err := parseExpressionArgs(ctx)
+	if err != nil {
+		return err
+	}
+
+	nodeID := strings.TrimSpace(*c.nodeID)
+	if nodeID == "" {
+		return fmt.Errorf("--node is required")
+	}
+
+	body := openapi_client.CanvasesCompleteExpressionBody{}
+	body.SetNodeId(nodeID)
+	body.SetExpression(expression)
+	if versionID := strings.TrimSpace(*c.versionID); versionID != "" {
+		body.SetVersionId(versionID)
+	}
+	if *c.cursor >= 0 {
+		body.SetCursor(int32(*c.cursor))
+	}
+
+	response, _, err := ctx.API.CanvasAPI.
+		CanvasesCompleteExpression(ctx.Context, canvasID).
+		Body(body).
+		Execute()
+	if err != nil {
+		return err
+	}
+
+	completions := response.GetCompletions()
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(completions)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		writer := tabwriter.NewWriter(stdout, 0, 8, 2, ' ', 0)
+		_, _ = fmt.Fprintln(writer, "LABEL\tKIND\tDETAIL")
+		for _, completion := range completions {
+			_, _ = fmt.Fprintf(
+				writer,
+				"%s\t%s\t%s\n",
+				completion.GetLabel(),
+				expressionCompletionKindLabel(completion.GetKind()),
+				completion.GetDetail(),
+			)
+		}
+
+		return writer.Flush()
+	})
+}
+
+func parseExpressionArgs(ctx core.CommandContext) (string, string, error) {
+	if len(ctx.Args) < 1 || len(ctx.Args) > 2 {
+		return "", "", fmt.Errorf("expected <expression> [name-or-id]")
+	}
+
+	expression := ctx.Args[0]
+	if strings.TrimSpace(expression) == "" {
+		return "", "", fmt.Errorf("<expression> is required")
+	}
+
+	target := ""
+	if len(ctx.Args) == 2 {
+		target = strings.TrimSpace(ctx.Args[1])
+	}
+
+	canvasID, err := resolveCanvasTargetFromOptionalArg(ctx, target)
+	if err != nil {
+		return "", "", err
+	}
+
+	return expression, canvasID, nil
+}
+
+func expressionSeverityLabel(severity openapi_client.ExpressionDiagnosticSeverity) string {
+	switch severity {
+	case openapi_client.EXPRESSIONDIAGNOSTICSEVERITY_SEVERITY_ERROR:
+		return "error"
+	case openapi_client.EXPRESSIONDIAGNOSTICSEVERITY_SEVERITY_WARNING:
+		return "warning"
+	default:
+		return "info"
+	}
+}
+
+func expressionCompletionKindLabel(kind openapi_client.ExpressionCompletionKind) string {
+	switch kind {
+	case openapi_client.EXPRESSIONCOMPLETIONKIND_KIND_FUNCTION:
+		return "function"
+	case openapi_client.EXPRESSIONCOMPLETIONKIND_KIND_VARIABLE:
+		return "variable"
+	case openapi_client.EXPRESSIONCOMPLETIONKIND_KIND_NODE:
+		return "node"
+	case openapi_client.EXPRESSIONCOMPLETIONKIND_KIND_FIELD:
+		return "field"
+	default:
+		return "-"
+	}
+}
@@ -0,0 +1,258 @@
+package canvases
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+type memberListCommand struct{}
+
+func (c *memberListCommand) Execute(ctx core.CommandContext) error {
+	if len(ctx.Args) > 1 {
+		return fmt.Errorf("list accepts at most one positional argument")
+	}
+
+	target := ""
+	if len(ctx.Args) == 1 {
+		target = strings.TrimSpace(ctx.Args[0])
+	}
+
+	canvasID, err := resolveCanvasTargetFromOptionalArg(ctx, target)
+	if err != nil {
+		return err
+	}
+
+	response, _, err := ctx.API.CanvasAPI.CanvasesListCanvasMembers(ctx.Context, canvasID).Execute()
+	if err != nil {
+		return err
+	}
+
+	members := response.GetMembers()
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(members)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		return renderCanvasMembersText(stdout, members)
+	})
+}
+
+type memberSetCommand struct {
+	role *string
+}
+
+func (c *memberSetCommand) Execute(ctx core.CommandContext) error {
+	userID, canvasTarget, err := parseCanvasSubjectTargetArgs(ctx.Args, "user-id")
+	if err != nil {
+		return err
+	}
+
+	role := strings.TrimSpace(*c.role)
+	if role == "" {
+		return fmt.Errorf("--role is required")
+	}
+
+	canvasID, err := resolveCanvasTargetFromOptionalArg(ctx, canvasTarget)
+	if err != nil {
+		return err
+	}
+
+	body := openapi_client.CanvasesUpdateCanvasMemberBody{}
+	body.SetRole(role)
+
+	response, _, err := ctx.API.CanvasAPI.
+		CanvasesUpdateCanvasMember(ctx.Context, canvasID, userID).
+		Body(body).
+		Execute()
+	if err != nil {
+		return err
+	}
+
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(response.GetMember())
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		member := response.GetMember()
+		_, err := fmt.Fprintf(stdout, "User %s now has role %s on canvas %s\n", member.GetUserId(), member.GetRole(), canvasID)
+		return err
+	})
+}
+
+type memberRemoveCommand struct{}
+
+func (c *memberRemoveCommand) Execute(ctx core.CommandContext) error {
+	userID, canvasTarget, err := parseCanvasSubjectTargetArgs(ctx.Args, "user-id")
+	if err != nil {
+		return err
+	}
+
+	canvasID, err := resolveCanvasTargetFromOptionalArg(ctx, canvasTarget)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = ctx.API.CanvasAPI.CanvasesRemoveCanvasMember(ctx.Context, canvasID, userID).Execute()
+	if err != nil {
+		return err
+	}
+
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(map[string]any{"userId": userID, "removed": true})
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		_, err := fmt.Fprintf(stdout, "User %s removed from canvas %s\n", userID, canvasID)
+		return err
+	})
+}
+
+type groupListCommand struct{}
+
+func (c *groupListCommand) Execute(ctx core.CommandContext) error {
+	if len(ctx.Args) > 1 {
+		return fmt.Errorf("list accepts at most one positional argument")
+	}
+
+	target := ""
+	if len(ctx.Args) == 1 {
+		target = strings.TrimSpace(ctx.Args[0])
+	}
+
+	canvasID, err := resolveCanvasTargetFromOptionalArg(ctx, target)
+	if err != nil {
+		return err
+	}
+
+	response, _, err := ctx.API.CanvasAPI.CanvasesListCanvasGroups(ctx.Context, canvasID).Execute()
+	if err != nil {
+		return err
+	}
+
+	groups := response.GetGroups()
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(groups)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		writer := tabwriter.NewWriter(stdout, 0, 8, 2, ' ', 0)
+		_, _ = fmt.Fprintln(writer, "GROUP\tDISPLAY_NAME\tROLE")
+
+		for _, group := range groups {
+			_, _ = fmt.Fprintf(writer, "%s\t%s\t%s\n", group.GetGroupName(), group.GetDisplayName(), group.GetRole())
+		}
+
+		return writer.Flush()
+	})
+}
+
+type groupSetCommand struct {
+	role *string
+}
+
+func (c *groupSetCommand) Execute(ctx core.CommandContext) error {
+	groupName, canvasTarget, err := parseCanvasSubjectTargetArgs(ctx.Args, "group-name")
+	if err != nil {
+		return err
+	}
+
+	role := strings.TrimSpace(*c.role)
+	if role == "" {
+		return fmt.Errorf("--role is required")
+	}
+
+	canvasID, err := resolveCanvasTargetFromOptionalArg(ctx, canvasTarget)
+	if err != nil {
+		return err
+	}
+
+	body := openapi_client.CanvasesUpdateCanvasGroupBody{}
+	body.SetRole(role)
+
+	response, _, err := ctx.API.CanvasAPI.
+		CanvasesUpdateCanvasGroup(ctx.Context, canvasID, groupName).
+		Body(body).
+		Execute()
+	if err != nil {
+		return err
+	}
+
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(response.GetGroup())
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		group := response.GetGroup()
+		_, err := fmt.Fprintf(stdout, "Group %s now has role %s on canvas %s\n", group.GetGroupName(), group.GetRole(), canvasID)
+		return err
+	})
+}
+
+type groupRemoveCommand struct{}
+
+func (c *groupRemoveCommand) Execute(ctx core.CommandContext) error {
+	groupName, canvasTarget, err := parseCanvasSubjectTargetArgs(ctx.Args, "group-name")
+	if err != nil {
+		return err
+	}
+
+	canvasID, err := resolveCanvasTargetFromOptionalArg(ctx, canvasTarget)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = ctx.API.CanvasAPI.CanvasesRemoveCanvasGroup(ctx.Context, canvasID, groupName).Execute()
+	if err != nil {
+		return err
+	}
+
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(map[string]any{"groupName": groupName, "removed": true})
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		_, err := fmt.Fprintf(stdout, "Group %s removed from canvas %s\n", groupName, canvasID)
+		return err
+	})
+}
+
+func renderCanvasMembersText(stdout io.Writer, members []openapi_client.CanvasesCanvasMember) error {
+	writer := tabwriter.NewWriter(stdout, 0, 8, 2, ' ', 0)
+	_, _ = fmt.Fprintln(writer, "USER_ID\tNAME\tEMAIL\tROLE")
+
+	for _, member := range members {
+		_, _ = fmt.Fprintf(
+			writer,
+			"%s\t%s\t%s\t%s\n",
+			member.GetUserId(),
+			member.GetUserName(),
+			member.GetUserEmail(),
+			member.GetRole(),
+		)
+	}
+
+	return writer.Flush()
+}
+
+func parseCanvasSubjectTargetArgs(args []string, subject string) (string, string, error) {
+	if len(args) < 1 || len(args) > 2 {
+		return "", "", fmt.Errorf("expected <%s> [name-or-id]", subject)
+	}
+
+	subjectID := strings.TrimSpace(args[0])
+	if subjectID == "" {
+		return "", "", fmt.Errorf("<%s> is required", subject)
+	}
+
+	canvasTarget := ""
+	if len(args) == 2 {
+		canvasTarget = strings.TrimSpace(args[1])
+	}
+
+	return subjectID, canvasTarget, nil
+}
@@ -0,0 +1,43 @@
+package canvases
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+func TestRenderCanvasMembersText(t *testing.T) {
+	member := openapi_client.CanvasesCanvasMember{}
+	member.SetUserId("user-1")
+	member.SetUserName("Jane")
+	member.SetUserEmail("jane@example.com")
+	member.SetRole("canvas_operator")
+
+	var output bytes.Buffer
+	if err := renderCanvasMembersText(&output, []openapi_client.CanvasesCanvasMember{member}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := `USER_ID  NAME  EMAIL             ROLE
+user-1   Jane  jane@example.com  canvas_operator
+`
+	if output.String() != expected {
+		t.Fatalf("unexpected members output:\n%s", output.String())
+	}
+}
+
+func TestParseCanvasSubjectTargetArgs(t *testing.T) {
+	subject, target, err := parseCanvasSubjectTargetArgs([]string{"deployers", "my-canvas"}, "group-name")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if subject != "deployers" || target != "my-canvas" {
+		t.Fatalf("unexpected subject %q and target %q", subject, target)
+	}
+
+	_, _, err = parseCanvasSubjectTargetArgs([]string{" "}, "group-name")
+	if err == nil || err.Error() != "<group-name> is required" {
+		t.Fatalf("expected missing subject error, got %v", err)
+	}
+}
@@ -0,0 +1,84 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	CanvasBundleKind = "CanvasBundle"
+)
+
+type CanvasBundle struct {
+	APIVersion   string                                            `json:"apiVersion" yaml:"apiVersion"`
+	Kind         string                                            `json:"kind" yaml:"kind"`
+	Metadata     *openapi_client.CanvasesCanvasBundleMetadata      `json:"metadata" yaml:"metadata"`
+	Spec         *openapi_client.CanvasesCanvasSpec                `json:"spec,omitempty" yaml:"spec,omitempty"`
+	Blueprints   []openapi_client.BlueprintsBlueprint              `json:"blueprints,omitempty" yaml:"blueprints,omitempty"`
+	Integrations []openapi_client.CanvasBundleIntegrationReference `json:"integrations,omitempty" yaml:"integrations,omitempty"`
+	Secrets      []openapi_client.CanvasBundleSecretReference      `json:"secrets,omitempty" yaml:"secrets,omitempty"`
+}
+
+func ParseCanvasBundle(raw []byte) (*CanvasBundle, error) {
+	var yamlObject any
+	if err := yaml.Unmarshal(raw, &yamlObject); err != nil {
+		return nil, fmt.Errorf("failed to parse canvas bundle: %w", err)
+	}
+
+	jsonData, err := json.Marshal(yamlObject)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert canvas bundle to json: %w", err)
+	}
+
+	var resource CanvasBundle
+	if err := json.Unmarshal(jsonData, &resource); err != nil {
+		return nil, fmt.Errorf("failed to parse canvas bundle json payload: %w", err)
+	}
+
+	if resource.Kind != CanvasBundleKind {
+		return nil, fmt.Errorf("unsupported resource kind %q", resource.Kind)
+	}
+
+	if resource.APIVersion == "" {
+		return nil, fmt.Errorf("canvas bundle apiVersion is required")
+	}
+
+	if resource.Metadata == nil || resource.Metadata.GetName() == "" {
+		return nil, fmt.Errorf("canvas bundle metadata.name is required")
+	}
+
+	if resource.Spec == nil {
+		return nil, fmt.Errorf("canvas bundle spec is required")
+	}
+
+	return &resource, nil
+}
+
+func CanvasBundleResourceFromBundle(bundle openapi_client.CanvasesCanvasBundle) CanvasBundle {
+	return CanvasBundle{
+		APIVersion:   "v1",
+		Kind:         CanvasBundleKind,
+		Metadata:     bundle.Metadata,
+		Spec:         bundle.Spec,
+		Blueprints:   bundle.Blueprints,
+		Integrations: bundle.Integrations,
+		Secrets:      bundle.Secrets,
+	}
+}
+
+func BundleFromCanvasBundle(resource CanvasBundle) openapi_client.CanvasesCanvasBundle {
+	bundle := openapi_client.CanvasesCanvasBundle{}
+	if resource.Metadata != nil {
+		bundle.SetMetadata(*resource.Metadata)
+	}
+	if resource.Spec != nil {
+		bundle.SetSpec(*resource.Spec)
+	}
+	bundle.Blueprints = resource.Blueprints
+	bundle.Integrations = resource.Integrations
+	bundle.Secrets = resource.Secrets
+	return bundle
+}
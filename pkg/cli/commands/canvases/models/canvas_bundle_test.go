@@ -0,0 +1,84 @@
+package models
+
+import "testing"
+
+func TestParseCanvasBundle(t *testing.T) {
+	raw := []byte(`
+apiVersion: v1
+kind: CanvasBundle
+metadata:
+  name: deployments
+spec:
+  nodes:
+    - id: deploy
+      name: deploy
+      type: TYPE_BLUEPRINT
+      blueprint:
+        id: 0d2b5a3c-95a9-4f29-9c1b-47f09a4d0e35
+      integration:
+        name: production-github
+  edges: []
+blueprints:
+  - id: 0d2b5a3c-95a9-4f29-9c1b-47f09a4d0e35
+    name: deploy-service
+integrations:
+  - name: production-github
+    type: github
+secrets:
+  - name: deploy-keys
+    keys:
+      - token
+`)
+
+	resource, err := ParseCanvasBundle(raw)
+	if err != nil {
+		t.Fatalf("ParseCanvasBundle returned error: %v", err)
+	}
+
+	if resource.Metadata.GetName() != "deployments" {
+		t.Fatalf("expected name=deployments, got %q", resource.Metadata.GetName())
+	}
+
+	nodes := resource.Spec.GetNodes()
+	if len(nodes) != 1 {
+		t.Fatalf("expected 1 node, got %d", len(nodes))
+	}
+
+	integration := nodes[0].GetIntegration()
+	if integration.GetName() != "production-github" {
+		t.Fatalf("expected integration production-github, got %q", integration.GetName())
+	}
+
+	if len(resource.Blueprints) != 1 || resource.Blueprints[0].GetName() != "deploy-service" {
+		t.Fatalf("expected blueprint deploy-service, got %+v", resource.Blueprints)
+	}
+
+	if len(resource.Integrations) != 1 || resource.Integrations[0].GetType() != "github" {
+		t.Fatalf("expected github integration reference, got %+v", resource.Integrations)
+	}
+
+	if len(resource.Secrets) != 1 || len(resource.Secrets[0].GetKeys()) != 1 {
+		t.Fatalf("expected deploy-keys secret reference, got %+v", resource.Secrets)
+	}
+
+	bundle := BundleFromCanvasBundle(*resource)
+	if len(bundle.GetBlueprints()) != 1 || len(bundle.GetSecrets()) != 1 {
+		t.Fatalf("expected blueprints and secrets to be kept in the bundle")
+	}
+}
+
+func TestParseCanvasBundleRejectsOtherKinds(t *testing.T) {
+	raw := []byte(`
+apiVersion: v1
+kind: Canvas
+metadata:
+  name: deployments
+spec:
+  nodes: []
+  edges: []
+`)
+
+	if _, err := ParseCanvasBundle(raw); err == nil {
+		t.Fatalf("expected error for Canvas kind")
+	}
+}
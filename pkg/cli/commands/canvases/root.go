@@ -80,6 +80,96 @@ func NewCommand(options core.BindOptions) *cobra.Command {
 		autoLayoutNodes: &updateAutoLayoutNodes,
 	}, options)
 
+	var validateExpressionNodeID string
+	var validateExpressionVersionID string
+	validateExpressionCmd := &cobra.Command{
+		Use:   "validate-expression <expression> [name-or-id]",
+		Short: "Validate an expression against the nodes upstream of a node",
+		Args:  cobra.RangeArgs(1, 2),
+	}
+	validateExpressionCmd.Flags().StringVar(&validateExpressionNodeID, "node", "", "id of the node using the expression")
+	validateExpressionCmd.Flags().StringVar(&validateExpressionVersionID, "version-id", "", "canvas version to validate against (defaults to the live version)")
+	core.Bind(validateExpressionCmd, &validateExpressionCommand{
+		nodeID:    &validateExpressionNodeID,
+		versionID: &validateExpressionVersionID,
+	}, options)
+
+	var completeExpressionNodeID string
+	var completeExpressionVersionID string
+	var completeExpressionCursor int
+	completeExpressionCmd := &cobra.Command{
+		Use:   "complete-expression <expression> [name-or-id]",
+		Short: "List completions for an expression used by a node",
+		Args:  cobra.RangeArgs(1, 2),
+	}
+	completeExpressionCmd.Flags().StringVar(&completeExpressionNodeID, "node", "", "id of the node using the expression")
+	completeExpressionCmd.Flags().StringVar(&completeExpressionVersionID, "version-id", "", "canvas version to complete against (defaults to the live version)")
+	completeExpressionCmd.Flags().IntVar(&completeExpressionCursor, "cursor", -1, "character offset of the cursor (defaults to the end of the expression)")
+	core.Bind(completeExpressionCmd, &completeExpressionCommand{
+		nodeID:    &completeExpressionNodeID,
+		versionID: &completeExpressionVersionID,
+		cursor:    &completeExpressionCursor,
+	}, options)
+
+	var exportFile string
+	exportCmd := &cobra.Command{
+		Use:   "export [name-or-id]",
+		Short: "Export a canvas as a portable bundle",
+		Long:  "Exports the live version of a canvas with the components it uses. Integrations and secrets are referenced by name, and secret values are not included.",
+		Args:  cobra.MaximumNArgs(1),
+	}
+	exportCmd.Flags().StringVarP(&exportFile, "file", "f", "", "file to write the bundle to (defaults to stdout)")
+	core.Bind(exportCmd, &exportCommand{file: &exportFile}, options)
+
+	var importFile string
+	var importName string
+	var importIntegrations []string
+	importCmd := &cobra.Command{
+		Use:   "import",
+		Short: "Create a canvas from a bundle",
+		Args:  cobra.NoArgs,
+	}
+	importCmd.Flags().StringVarP(&importFile, "file", "f", "", "bundle file to import")
+	importCmd.Flags().StringVar(&importName, "name", "", "name for the new canvas (defaults to the name in the bundle)")
+	importCmd.Flags().StringArrayVar(&importIntegrations, "integration", nil, "use an integration in place of one in the bundle, as <bundle-name>=<integration-name> (repeatable)")
+	core.Bind(importCmd, &importCommand{
+		file:         &importFile,
+		name:         &importName,
+		integrations: &importIntegrations,
+	}, options)
+
+	versionsCmd := &cobra.Command{
+		Use:   "versions",
+		Short: "Manage canvas versions",
+	}
+
+	versionsListCmd := &cobra.Command{
+		Use:   "list [name-or-id]",
+		Short: "List versions of a canvas",
+		Args:  cobra.MaximumNArgs(1),
+	}
+	core.Bind(versionsListCmd, &versionListCommand{}, options)
+
+	var versionsDiffBaseVersionID string
+	versionsDiffCmd := &cobra.Command{
+		Use:   "diff <version-id> [name-or-id]",
+		Short: "Show the node, edge and variable changes in a version",
+		Args:  cobra.RangeArgs(1, 2),
+	}
+	versionsDiffCmd.Flags().StringVar(&versionsDiffBaseVersionID, "base", "", "version id to compare with (defaults to the live version)")
+	core.Bind(versionsDiffCmd, &versionDiffCommand{baseVersionID: &versionsDiffBaseVersionID}, options)
+
+	versionsRollbackCmd := &cobra.Command{
+		Use:   "rollback <version-id> [name-or-id]",
+		Short: "Publish an older version of a canvas as a new live version, through a change request when versioning is enabled",
+		Args:  cobra.RangeArgs(1, 2),
+	}
+	core.Bind(versionsRollbackCmd, &versionRollbackCommand{}, options)
+
+	versionsCmd.AddCommand(versionsListCmd)
+	versionsCmd.AddCommand(versionsDiffCmd)
+	versionsCmd.AddCommand(versionsRollbackCmd)
+
 	var changeRequestsListStatusFilter string
 	var changeRequestsListOnlyMine bool
 	var changeRequestsListQuery string
@@ -209,12 +299,83 @@ func NewCommand(options core.BindOptions) *cobra.Command {
 	changeRequestsCmd.AddCommand(changeRequestsPublishCmd)
 	changeRequestsCmd.AddCommand(changeRequestsResolveCmd)
 
+	membersCmd := &cobra.Command{
+		Use:   "members",
+		Short: "Manage users with a role on a canvas",
+	}
+
+	membersListCmd := &cobra.Command{
+		Use:   "list [name-or-id]",
+		Short: "List users with a role on a canvas",
+		Args:  cobra.MaximumNArgs(1),
+	}
+	core.Bind(membersListCmd, &memberListCommand{}, options)
+
+	var membersSetRole string
+	membersSetCmd := &cobra.Command{
+		Use:   "set <user-id> [name-or-id]",
+		Short: "Grant a canvas role to a user",
+		Args:  cobra.RangeArgs(1, 2),
+	}
+	membersSetCmd.Flags().StringVar(&membersSetRole, "role", "", "canvas role: canvas_viewer, canvas_operator, canvas_editor or canvas_owner")
+	core.Bind(membersSetCmd, &memberSetCommand{role: &membersSetRole}, options)
+
+	membersRemoveCmd := &cobra.Command{
+		Use:   "remove <user-id> [name-or-id]",
+		Short: "Remove the canvas role of a user",
+		Args:  cobra.RangeArgs(1, 2),
+	}
+	core.Bind(membersRemoveCmd, &memberRemoveCommand{}, options)
+
+	membersCmd.AddCommand(membersListCmd)
+	membersCmd.AddCommand(membersSetCmd)
+	membersCmd.AddCommand(membersRemoveCmd)
+
+	groupsCmd := &cobra.Command{
+		Use:   "groups",
+		Short: "Manage organization groups with a role on a canvas",
+	}
+
+	groupsListCmd := &cobra.Command{
+		Use:   "list [name-or-id]",
+		Short: "List groups with a role on a canvas",
+		Args:  cobra.MaximumNArgs(1),
+	}
+	core.Bind(groupsListCmd, &groupListCommand{}, options)
+
+	var groupsSetRole string
+	groupsSetCmd := &cobra.Command{
+		Use:   "set <group-name> [name-or-id]",
+		Short: "Grant a canvas role to all members of a group",
+		Args:  cobra.RangeArgs(1, 2),
+	}
+	groupsSetCmd.Flags().StringVar(&groupsSetRole, "role", "", "canvas role: canvas_viewer, canvas_operator, canvas_editor or canvas_owner")
+	core.Bind(groupsSetCmd, &groupSetCommand{role: &groupsSetRole}, options)
+
+	groupsRemoveCmd := &cobra.Command{
+		Use:   "remove <group-name> [name-or-id]",
+		Short: "Remove the canvas role of a group",
+		Args:  cobra.RangeArgs(1, 2),
+	}
+	core.Bind(groupsRemoveCmd, &groupRemoveCommand{}, options)
+
+	groupsCmd.AddCommand(groupsListCmd)
+	groupsCmd.AddCommand(groupsSetCmd)
+	groupsCmd.AddCommand(groupsRemoveCmd)
+
 	root.AddCommand(listCmd)
 	root.AddCommand(getCmd)
 	root.AddCommand(activeCmd)
 	root.AddCommand(createCmd)
 	root.AddCommand(updateCmd)
+	root.AddCommand(validateExpressionCmd)
+	root.AddCommand(completeExpressionCmd)
+	root.AddCommand(exportCmd)
+	root.AddCommand(importCmd)
+	root.AddCommand(versionsCmd)
 	root.AddCommand(changeRequestsCmd)
+	root.AddCommand(membersCmd)
+	root.AddCommand(groupsCmd)
 
 	return root
 }
@@ -0,0 +1,243 @@
+package canvases
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/cli/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+type versionListCommand struct{}
+
+func (c *versionListCommand) Execute(ctx core.CommandContext) error {
+	if len(ctx.Args) > 1 {
+		return fmt.Errorf("list accepts at most one positional argument")
+	}
+
+	target := ""
+	if len(ctx.Args) == 1 {
+		target = strings.TrimSpace(ctx.Args[0])
+	}
+
+	canvasID, err := resolveCanvasTargetFromOptionalArg(ctx, target)
+	if err != nil {
+		return err
+	}
+
+	response, _, err := ctx.API.CanvasVersionAPI.CanvasesListCanvasVersions(ctx.Context, canvasID).Execute()
+	if err != nil {
+		return err
+	}
+
+	versions := response.GetVersions()
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(versions)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		writer := tabwriter.NewWriter(stdout, 0, 8, 2, ' ', 0)
+		_, _ = fmt.Fprintln(writer, "ID\tPUBLISHED\tOWNER\tPUBLISHED_AT\tUPDATED_AT")
+
+		for _, version := range versions {
+			metadata := version.GetMetadata()
+			owner := metadata.GetOwner()
+
+			ownerName := owner.GetName()
+			if strings.TrimSpace(ownerName) == "" {
+				ownerName = "-"
+			}
+
+			_, _ = fmt.Fprintf(
+				writer,
+				"%s\t%t\t%s\t%s\t%s\n",
+				metadata.GetId(),
+				metadata.GetIsPublished(),
+				ownerName,
+				formatTimeOrDash(metadata.GetPublishedAt(), metadata.HasPublishedAt()),
+				formatTimeOrDash(metadata.GetUpdatedAt(), metadata.HasUpdatedAt()),
+			)
+		}
+
+		return writer.Flush()
+	})
+}
+
+type versionDiffCommand struct {
+	baseVersionID *string
+}
+
+func (c *versionDiffCommand) Execute(ctx core.CommandContext) error {
+	versionID, canvasTarget, err := parseCanvasVersionTargetArgs(ctx.Args)
+	if err != nil {
+		return err
+	}
+
+	canvasID, err := resolveCanvasTargetFromOptionalArg(ctx, canvasTarget)
+	if err != nil {
+		return err
+	}
+
+	request := ctx.API.CanvasVersionAPI.CanvasesDiffCanvasVersions(ctx.Context, canvasID, versionID)
+	if baseVersionID := strings.TrimSpace(*c.baseVersionID); baseVersionID != "" {
+		request = request.BaseVersionId(baseVersionID)
+	}
+
+	response, _, err := request.Execute()
+	if err != nil {
+		return err
+	}
+
+	diff := response.GetDiff()
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(diff)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		return renderCanvasVersionDiffText(stdout, diff)
+	})
+}
+
+type versionRollbackCommand struct{}
+
+func (c *versionRollbackCommand) Execute(ctx core.CommandContext) error {
+	versionID, canvasTarget, err := parseCanvasVersionTargetArgs(ctx.Args)
+	if err != nil {
+		return err
+	}
+
+	canvasID, err := resolveCanvasTargetFromOptionalArg(ctx, canvasTarget)
+	if err != nil {
+		return err
+	}
+
+	response, _, err := ctx.API.CanvasVersionAPI.
+		CanvasesRollbackCanvasVersion(ctx.Context, canvasID, versionID).
+		Body(map[string]interface{}{}).
+		Execute()
+	if err != nil {
+		return err
+	}
+
+	version := response.GetVersion()
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(version)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		metadata := version.GetMetadata()
+		if !metadata.GetIsPublished() {
+			_, _ = fmt.Fprintf(stdout, "Change request opened to roll back to version %s\n", versionID)
+			_, err := fmt.Fprintf(stdout, "Change request version: %s\n", metadata.GetId())
+			return err
+		}
+
+		_, _ = fmt.Fprintf(stdout, "Canvas rolled back to version %s\n", versionID)
+		_, err := fmt.Fprintf(stdout, "Live version: %s\n", metadata.GetId())
+		return err
+	})
+}
+
+func parseCanvasVersionTargetArgs(args []string) (string, string, error) {
+	if len(args) < 1 || len(args) > 2 {
+		return "", "", fmt.Errorf("expected <version-id> [name-or-id]")
+	}
+
+	versionID := strings.TrimSpace(args[0])
+	if versionID == "" {
+		return "", "", fmt.Errorf("<version-id> is required")
+	}
+	if _, err := uuid.Parse(versionID); err != nil {
+		return "", "", fmt.Errorf("invalid version id %q", versionID)
+	}
+
+	canvasTarget := ""
+	if len(args) == 2 {
+		canvasTarget = strings.TrimSpace(args[1])
+	}
+
+	return versionID, canvasTarget, nil
+}
+
+// renderCanvasVersionDiffText prints a diff in the style of a unified diff:
+// "+" for added, "-" for removed and "~" for modified nodes, edges and variables,
+// with their changed fields indented below them.
+func renderCanvasVersionDiffText(stdout io.Writer, diff openapi_client.CanvasesCanvasVersionDiff) error {
+	_, _ = fmt.Fprintf(stdout, "Base: %s\n", diff.GetBaseVersionId())
+	_, _ = fmt.Fprintf(stdout, "Target: %s\n", diff.GetTargetVersionId())
+
+	nodes := diff.GetNodes()
+	edges := diff.GetEdges()
+	variables := diff.GetVariables()
+	if len(nodes) == 0 && len(edges) == 0 && len(variables) == 0 {
+		_, err := fmt.Fprintln(stdout, "\nNo changes")
+		return err
+	}
+
+	if len(nodes) > 0 {
+		_, _ = fmt.Fprintln(stdout, "\nNodes:")
+		for _, node := range nodes {
+			_, _ = fmt.Fprintf(stdout, "  %s %s (%s)\n", diffChangeTypeSymbol(node.GetType()), node.GetNodeName(), node.GetNodeId())
+			if node.GetType() == openapi_client.CANVASVERSIONDIFFCHANGETYPE_CHANGE_TYPE_REMOVED {
+				continue
+			}
+
+			renderCanvasVersionDiffFields(stdout, node.GetFields())
+		}
+	}
+
+	if len(edges) > 0 {
+		_, _ = fmt.Fprintln(stdout, "\nEdges:")
+		for _, edge := range edges {
+			_, _ = fmt.Fprintf(
+				stdout,
+				"  %s %s -> %s (%s)\n",
+				diffChangeTypeSymbol(edge.GetType()),
+				edge.GetSourceId(),
+				edge.GetTargetId(),
+				edge.GetChannel(),
+			)
+		}
+	}
+
+	if len(variables) > 0 {
+		_, _ = fmt.Fprintln(stdout, "\nVariables:")
+		for _, variable := range variables {
+			_, _ = fmt.Fprintf(stdout, "  %s %s\n", diffChangeTypeSymbol(variable.GetType()), variable.GetName())
+			if variable.GetType() == openapi_client.CANVASVERSIONDIFFCHANGETYPE_CHANGE_TYPE_REMOVED {
+				continue
+			}
+
+			renderCanvasVersionDiffFields(stdout, variable.GetFields())
+		}
+	}
+
+	return nil
+}
+
+func renderCanvasVersionDiffFields(stdout io.Writer, fields []openapi_client.CanvasVersionDiffFieldChange) {
+	for _, field := range fields {
+		switch {
+		case field.GetBefore() == "":
+			_, _ = fmt.Fprintf(stdout, "      %s: %s\n", field.GetPath(), field.GetAfter())
+		case field.GetAfter() == "":
+			_, _ = fmt.Fprintf(stdout, "      %s: %s (removed)\n", field.GetPath(), field.GetBefore())
+		default:
+			_, _ = fmt.Fprintf(stdout, "      %s: %s -> %s\n", field.GetPath(), field.GetBefore(), field.GetAfter())
+		}
+	}
+}
+
+func diffChangeTypeSymbol(changeType openapi_client.CanvasVersionDiffChangeType) string {
+	switch changeType {
+	case openapi_client.CANVASVERSIONDIFFCHANGETYPE_CHANGE_TYPE_ADDED:
+		return "+"
+	case openapi_client.CANVASVERSIONDIFFCHANGETYPE_CHANGE_TYPE_REMOVED:
+		return "-"
+	default:
+		return "~"
+	}
+}
@@ -0,0 +1,80 @@
+package canvases
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+func TestRenderCanvasVersionDiffText(t *testing.T) {
+	diff := openapi_client.CanvasesCanvasVersionDiff{}
+	diff.SetBaseVersionId("base")
+	diff.SetTargetVersionId("target")
+
+	nameChange := openapi_client.CanvasVersionDiffFieldChange{}
+	nameChange.SetPath("name")
+	nameChange.SetBefore(`"Build"`)
+	nameChange.SetAfter(`"Build app"`)
+
+	urlChange := openapi_client.CanvasVersionDiffFieldChange{}
+	urlChange.SetPath("configuration.url")
+	urlChange.SetAfter(`"https://example.com"`)
+
+	modified := openapi_client.CanvasVersionDiffNodeChange{}
+	modified.SetNodeId("node-1")
+	modified.SetNodeName("Build app")
+	modified.SetType(openapi_client.CANVASVERSIONDIFFCHANGETYPE_CHANGE_TYPE_MODIFIED)
+	modified.SetFields([]openapi_client.CanvasVersionDiffFieldChange{nameChange, urlChange})
+
+	removed := openapi_client.CanvasVersionDiffNodeChange{}
+	removed.SetNodeId("node-2")
+	removed.SetNodeName("Notify")
+	removed.SetType(openapi_client.CANVASVERSIONDIFFCHANGETYPE_CHANGE_TYPE_REMOVED)
+	removed.SetFields([]openapi_client.CanvasVersionDiffFieldChange{nameChange})
+
+	edge := openapi_client.CanvasVersionDiffEdgeChange{}
+	edge.SetType(openapi_client.CANVASVERSIONDIFFCHANGETYPE_CHANGE_TYPE_REMOVED)
+	edge.SetSourceId("node-1")
+	edge.SetTargetId("node-2")
+	edge.SetChannel("default")
+
+	diff.SetNodes([]openapi_client.CanvasVersionDiffNodeChange{modified, removed})
+	diff.SetEdges([]openapi_client.CanvasVersionDiffEdgeChange{edge})
+
+	var output bytes.Buffer
+	if err := renderCanvasVersionDiffText(&output, diff); err != nil {
+		t.Fatalf("renderCanvasVersionDiffText returned error: %v", err)
+	}
+
+	expected := `Base: base
+Target: target
+
+Nodes:
+  ~ Build app (node-1)
+      name: "Build" -> "Build app"
+      configuration.url: "https://example.com"
+  - Notify (node-2)
+
+Edges:
+  - node-1 -> node-2 (default)
+`
+	if output.String() != expected {
+		t.Fatalf("unexpected diff output:\n%s", output.String())
+	}
+}
+
+func TestRenderCanvasVersionDiffTextWithoutChanges(t *testing.T) {
+	diff := openapi_client.CanvasesCanvasVersionDiff{}
+	diff.SetBaseVersionId("base")
+	diff.SetTargetVersionId("target")
+
+	var output bytes.Buffer
+	if err := renderCanvasVersionDiffText(&output, diff); err != nil {
+		t.Fatalf("renderCanvasVersionDiffText returned error: %v", err)
+	}
+
+	if output.String() != "Base: base\nTarget: target\n\nNo changes\n" {
+		t.Fatalf("unexpected diff output:\n%s", output.String())
+	}
+}
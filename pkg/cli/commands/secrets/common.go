@@ -42,6 +42,25 @@ func organizationDomainType() openapi_client.AuthorizationDomainType {
 	return openapi_client.AUTHORIZATIONDOMAINTYPE_DOMAIN_TYPE_ORGANIZATION
 }
 
+// secretDomain selects whether a command manages the secrets
+// of the organization or the secrets of a canvas.
+type secretDomain struct {
+	canvasID *string
+}
+
+func (d secretDomain) resolveDomain(ctx core.CommandContext) (openapi_client.AuthorizationDomainType, string, error) {
+	if d.canvasID != nil && strings.TrimSpace(*d.canvasID) != "" {
+		return openapi_client.AUTHORIZATIONDOMAINTYPE_DOMAIN_TYPE_CANVAS, strings.TrimSpace(*d.canvasID), nil
+	}
+
+	organizationID, err := resolveOrganizationID(ctx)
+	if err != nil {
+		return "", "", err
+	}
+
+	return organizationDomainType(), organizationID, nil
+}
+
 func parseSecretFile(path string) (*secretResource, error) {
 	// #nosec
 	data, err := os.ReadFile(path)
@@ -122,6 +141,9 @@ func renderSecretText(stdout io.Writer, item openapi_client.SecretsSecret) error
 	_, _ = fmt.Fprintf(stdout, "Provider: %s\n", spec.GetProvider())
 	_, _ = fmt.Fprintf(stdout, "DomainType: %s\n", metadata.GetDomainType())
 	_, _ = fmt.Fprintf(stdout, "DomainID: %s\n", metadata.GetDomainId())
+	if metadata.HasActiveVersion() {
+		_, _ = fmt.Fprintf(stdout, "ActiveVersion: %d\n", metadata.GetActiveVersion())
+	}
 	if metadata.HasCreatedAt() {
 		_, _ = fmt.Fprintf(stdout, "CreatedAt: %s\n", metadata.GetCreatedAt().Format(time.RFC3339))
 	}
@@ -9,6 +9,7 @@ import (
 )
 
 type createCommand struct {
+	secretDomain
 	file *string
 }
 
@@ -21,7 +22,7 @@ func (c *createCommand) Execute(ctx core.CommandContext) error {
 		return fmt.Errorf("--file is required")
 	}
 
-	organizationID, err := resolveOrganizationID(ctx)
+	domainType, domainID, err := c.resolveDomain(ctx)
 	if err != nil {
 		return err
 	}
@@ -35,8 +36,8 @@ func (c *createCommand) Execute(ctx core.CommandContext) error {
 
 	request := openapi_client.SecretsCreateSecretRequest{}
 	request.SetSecret(secret)
-	request.SetDomainType(organizationDomainType())
-	request.SetDomainId(organizationID)
+	request.SetDomainType(domainType)
+	request.SetDomainId(domainID)
 
 	response, _, err := ctx.API.SecretAPI.SecretsCreateSecret(ctx.Context).Body(request).Execute()
 	if err != nil {
@@ -7,18 +7,20 @@ import (
 	"github.com/superplanehq/superplane/pkg/cli/core"
 )
 
-type deleteCommand struct{}
+type deleteCommand struct {
+	secretDomain
+}
 
 func (c *deleteCommand) Execute(ctx core.CommandContext) error {
-	organizationID, err := resolveOrganizationID(ctx)
+	domainType, domainID, err := c.resolveDomain(ctx)
 	if err != nil {
 		return err
 	}
 
 	response, _, err := ctx.API.SecretAPI.
 		SecretsDeleteSecret(ctx.Context, ctx.Args[0]).
-		DomainType(string(organizationDomainType())).
-		DomainId(organizationID).
+		DomainType(string(domainType)).
+		DomainId(domainID).
 		Execute()
 	if err != nil {
 		return err
@@ -6,18 +6,20 @@ import (
 	"github.com/superplanehq/superplane/pkg/cli/core"
 )
 
-type getCommand struct{}
+type getCommand struct {
+	secretDomain
+}
 
 func (c *getCommand) Execute(ctx core.CommandContext) error {
-	organizationID, err := resolveOrganizationID(ctx)
+	domainType, domainID, err := c.resolveDomain(ctx)
 	if err != nil {
 		return err
 	}
 
 	response, _, err := ctx.API.SecretAPI.
 		SecretsDescribeSecret(ctx.Context, ctx.Args[0]).
-		DomainType(string(organizationDomainType())).
-		DomainId(organizationID).
+		DomainType(string(domainType)).
+		DomainId(domainID).
 		Execute()
 	if err != nil {
 		return err
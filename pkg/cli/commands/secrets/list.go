@@ -6,18 +6,20 @@ import (
 	"github.com/superplanehq/superplane/pkg/cli/core"
 )
 
-type listCommand struct{}
+type listCommand struct {
+	secretDomain
+}
 
 func (c *listCommand) Execute(ctx core.CommandContext) error {
-	organizationID, err := resolveOrganizationID(ctx)
+	domainType, domainID, err := c.resolveDomain(ctx)
 	if err != nil {
 		return err
 	}
 
 	response, _, err := ctx.API.SecretAPI.
 		SecretsListSecrets(ctx.Context).
-		DomainType(string(organizationDomainType())).
-		DomainId(organizationID).
+		DomainType(string(domainType)).
+		DomainId(domainID).
 		Execute()
 	if err != nil {
 		return err
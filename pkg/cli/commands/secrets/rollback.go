@@ -0,0 +1,46 @@
+package secrets
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+type rollbackCommand struct {
+	secretDomain
+	version *int32
+}
+
+func (c *rollbackCommand) Execute(ctx core.CommandContext) error {
+	if *c.version <= 0 {
+		return fmt.Errorf("--version must be a positive number")
+	}
+
+	domainType, domainID, err := c.resolveDomain(ctx)
+	if err != nil {
+		return err
+	}
+
+	request := openapi_client.SecretsRollbackSecretBody{}
+	request.SetVersion(*c.version)
+	request.SetDomainType(domainType)
+	request.SetDomainId(domainID)
+
+	response, _, err := ctx.API.SecretAPI.SecretsRollbackSecret(ctx.Context, ctx.Args[0]).Body(request).Execute()
+	if err != nil {
+		return err
+	}
+
+	secret := response.GetSecret()
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(secret)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		metadata := secret.GetMetadata()
+		_, err := fmt.Fprintf(stdout, "Secret %s rolled back to version %d\n", metadata.GetName(), metadata.GetActiveVersion())
+		return err
+	})
+}
@@ -12,19 +12,23 @@ func NewCommand(options core.BindOptions) *cobra.Command {
 		Aliases: []string{"secret"},
 	}
 
+	var canvasID string
+	root.PersistentFlags().StringVar(&canvasID, "canvas-id", "", "manage the secrets of this canvas instead of the organization secrets")
+	domain := secretDomain{canvasID: &canvasID}
+
 	listCmd := &cobra.Command{
 		Use:   "list",
 		Short: "List secrets",
 		Args:  cobra.NoArgs,
 	}
-	core.Bind(listCmd, &listCommand{}, options)
+	core.Bind(listCmd, &listCommand{secretDomain: domain}, options)
 
 	getCmd := &cobra.Command{
 		Use:   "get <id-or-name>",
 		Short: "Get a secret",
 		Args:  cobra.ExactArgs(1),
 	}
-	core.Bind(getCmd, &getCommand{}, options)
+	core.Bind(getCmd, &getCommand{secretDomain: domain}, options)
 
 	createCmd := &cobra.Command{
 		Use:   "create",
@@ -34,7 +38,7 @@ func NewCommand(options core.BindOptions) *cobra.Command {
 	var createFile string
 	createCmd.Flags().StringVarP(&createFile, "file", "f", "", "filename, directory, or URL to files to use to create the resource")
 	_ = createCmd.MarkFlagRequired("file")
-	core.Bind(createCmd, &createCommand{file: &createFile}, options)
+	core.Bind(createCmd, &createCommand{secretDomain: domain, file: &createFile}, options)
 
 	updateCmd := &cobra.Command{
 		Use:   "update",
@@ -44,20 +48,50 @@ func NewCommand(options core.BindOptions) *cobra.Command {
 	var updateFile string
 	updateCmd.Flags().StringVarP(&updateFile, "file", "f", "", "filename, directory, or URL to files to use to update the resource")
 	_ = updateCmd.MarkFlagRequired("file")
-	core.Bind(updateCmd, &updateCommand{file: &updateFile}, options)
+	core.Bind(updateCmd, &updateCommand{secretDomain: domain, file: &updateFile}, options)
 
 	deleteCmd := &cobra.Command{
 		Use:   "delete <id-or-name>",
 		Short: "Delete a secret",
 		Args:  cobra.ExactArgs(1),
 	}
-	core.Bind(deleteCmd, &deleteCommand{}, options)
+	core.Bind(deleteCmd, &deleteCommand{secretDomain: domain}, options)
+
+	versionsCmd := &cobra.Command{
+		Use:   "versions <id-or-name>",
+		Short: "List the versions of a secret",
+		Args:  cobra.ExactArgs(1),
+	}
+	core.Bind(versionsCmd, &versionsCommand{secretDomain: domain}, options)
+
+	rotateCmd := &cobra.Command{
+		Use:   "rotate <id-or-name>",
+		Short: "Store new values for a secret as a new version",
+		Args:  cobra.ExactArgs(1),
+	}
+	var rotateLiterals []string
+	rotateCmd.Flags().StringArrayVar(&rotateLiterals, "from-literal", nil, "key and value to store, as <key>=<value> (repeatable)")
+	_ = rotateCmd.MarkFlagRequired("from-literal")
+	core.Bind(rotateCmd, &rotateCommand{secretDomain: domain, literals: &rotateLiterals}, options)
+
+	rollbackCmd := &cobra.Command{
+		Use:   "rollback <id-or-name>",
+		Short: "Activate a previous version of a secret",
+		Args:  cobra.ExactArgs(1),
+	}
+	var rollbackVersion int32
+	rollbackCmd.Flags().Int32Var(&rollbackVersion, "version", 0, "version to activate")
+	_ = rollbackCmd.MarkFlagRequired("version")
+	core.Bind(rollbackCmd, &rollbackCommand{secretDomain: domain, version: &rollbackVersion}, options)
 
 	root.AddCommand(listCmd)
 	root.AddCommand(getCmd)
 	root.AddCommand(createCmd)
 	root.AddCommand(updateCmd)
 	root.AddCommand(deleteCmd)
+	root.AddCommand(versionsCmd)
+	root.AddCommand(rotateCmd)
+	root.AddCommand(rollbackCmd)
 
 	return root
 }
@@ -0,0 +1,69 @@
+package secrets
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+type rotateCommand struct {
+	secretDomain
+	literals *[]string
+}
+
+func (c *rotateCommand) Execute(ctx core.CommandContext) error {
+	data, err := parseSecretLiterals(*c.literals)
+	if err != nil {
+		return err
+	}
+
+	domainType, domainID, err := c.resolveDomain(ctx)
+	if err != nil {
+		return err
+	}
+
+	request := openapi_client.SecretsRotateSecretBody{}
+	request.SetData(data)
+	request.SetDomainType(domainType)
+	request.SetDomainId(domainID)
+
+	response, _, err := ctx.API.SecretAPI.SecretsRotateSecret(ctx.Context, ctx.Args[0]).Body(request).Execute()
+	if err != nil {
+		return err
+	}
+
+	secret := response.GetSecret()
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(secret)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		return renderSecretText(stdout, secret)
+	})
+}
+
+func parseSecretLiterals(literals []string) (map[string]string, error) {
+	if len(literals) == 0 {
+		return nil, fmt.Errorf("at least one --from-literal is required")
+	}
+
+	data := map[string]string{}
+	for _, literal := range literals {
+		key, value, ok := strings.Cut(literal, "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid literal %q, expected <key>=<value>", literal)
+		}
+
+		if _, exists := data[key]; exists {
+			return nil, fmt.Errorf("duplicate key %q", key)
+		}
+
+		data[key] = value
+	}
+
+	return data, nil
+}
@@ -9,6 +9,7 @@ import (
 )
 
 type updateCommand struct {
+	secretDomain
 	file *string
 }
 
@@ -24,7 +25,7 @@ func (c *updateCommand) Execute(ctx core.CommandContext) error {
 		return fmt.Errorf("update does not accept positional arguments")
 	}
 
-	organizationID, err := resolveOrganizationID(ctx)
+	domainType, domainID, err := c.resolveDomain(ctx)
 	if err != nil {
 		return err
 	}
@@ -41,8 +42,16 @@ func (c *updateCommand) Execute(ctx core.CommandContext) error {
 
 	request := openapi_client.SecretsUpdateSecretBody{}
 	request.SetSecret(secret)
-	request.SetDomainType(organizationDomainType())
-	request.SetDomainId(organizationID)
+	request.SetDomainType(domainType)
+	request.SetDomainId(domainID)
+
+	//
+	// Only replace the canvases allowed to use the secret
+	// if the file lists them, even if the list is empty.
+	//
+	if resource.Spec != nil && resource.Spec.HasAllowedCanvasIds() {
+		request.SetUpdateAllowedCanvasIds(true)
+	}
 
 	response, _, err := ctx.API.SecretAPI.SecretsUpdateSecret(ctx.Context, resource.Metadata.GetId()).Body(request).Execute()
 	if err != nil {
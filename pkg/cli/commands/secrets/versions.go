@@ -0,0 +1,65 @@
+package secrets
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+)
+
+type versionsCommand struct {
+	secretDomain
+}
+
+func (c *versionsCommand) Execute(ctx core.CommandContext) error {
+	domainType, domainID, err := c.resolveDomain(ctx)
+	if err != nil {
+		return err
+	}
+
+	response, _, err := ctx.API.SecretAPI.
+		SecretsListSecretVersions(ctx.Context, ctx.Args[0]).
+		DomainType(string(domainType)).
+		DomainId(domainID).
+		Execute()
+	if err != nil {
+		return err
+	}
+
+	versions := response.GetVersions()
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(versions)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		writer := tabwriter.NewWriter(stdout, 0, 8, 2, ' ', 0)
+		_, _ = fmt.Fprintln(writer, "VERSION\tACTIVE\tREASON\tCREATED_BY\tCREATED_AT\tACTIVATED_AT")
+
+		for _, version := range versions {
+			createdAt := ""
+			if version.HasCreatedAt() {
+				createdAt = version.GetCreatedAt().Format(time.RFC3339)
+			}
+
+			activatedAt := ""
+			if version.HasActivatedAt() {
+				activatedAt = version.GetActivatedAt().Format(time.RFC3339)
+			}
+
+			_, _ = fmt.Fprintf(
+				writer,
+				"%d\t%t\t%s\t%s\t%s\t%s\n",
+				version.GetVersion(),
+				version.GetActive(),
+				version.GetReason(),
+				version.GetCreatedBy(),
+				createdAt,
+				activatedAt,
+			)
+		}
+
+		return writer.Flush()
+	})
+}
@@ -78,7 +78,7 @@ func (c *AddMemory) Configuration() []configuration.Field {
 			Name:        "namespace",
 			Label:       "Namespace",
 			Type:        configuration.FieldTypeString,
-			Description: "Memory namespace for this record",
+			Description: "Memory namespace for this record. Use org/<name> for an organization namespace",
 			Required:    true,
 		},
 		{
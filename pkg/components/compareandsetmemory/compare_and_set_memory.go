@@ -0,0 +1,331 @@
+package compareandsetmemory
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/registry"
+)
+
+const ComponentName = "compareAndSetMemory"
+const PayloadType = "memory.compareAndSet"
+const ChannelNameSet = "set"
+const ChannelNameConflict = "conflict"
+
+func init() {
+	registry.RegisterComponent(ComponentName, &CompareAndSetMemory{})
+}
+
+type CompareAndSetMemory struct{}
+
+type Spec struct {
+	Namespace string      `json:"namespace"`
+	MatchList []FieldPair `json:"matchList"`
+	Field     string      `json:"field"`
+	Expected  any         `json:"expected"`
+	Value     any         `json:"value"`
+}
+
+type FieldPair struct {
+	Name  string `json:"name"`
+	Value any    `json:"value"`
+}
+
+type canvasMemoryCompareAndSetContext interface {
+	CompareAndSet(namespace string, matches map[string]any, field string, expected any, value any) (bool, []any, error)
+}
+
+func (c *CompareAndSetMemory) Name() string {
+	return ComponentName
+}
+
+func (c *CompareAndSetMemory) Label() string {
+	return "Compare and Set Memory"
+}
+
+func (c *CompareAndSetMemory) Description() string {
+	return "Atomically set a field in canvas memory only if it has the expected value"
+}
+
+func (c *CompareAndSetMemory) Documentation() string {
+	return `The Compare and Set Memory component atomically sets a field of matching rows in canvas-level memory storage, but only if the field currently has the expected value.
+
+## Use Cases
+
+- Acquire and release deploy locks
+- Move a record between states without overwriting concurrent changes
+- Make sure only one execution handles an event
+
+## How It Works
+
+1. Reads ` + "`namespace`" + `, ` + "`matchList`" + `, ` + "`field`" + `, ` + "`expected`" + `, and ` + "`value`" + ` from configuration
+2. Compares ` + "`field`" + ` on every matching memory row with ` + "`expected`" + `
+3. If all of them are equal, sets ` + "`field`" + ` to ` + "`value`" + ` on every matching row
+4. Emits ` + "`memory.compareAndSet`" + ` to the ` + "`set`" + ` or ` + "`conflict`" + ` channel
+
+An empty ` + "`expected`" + ` means the field must not be set. When no row matches,
+a new row with the matches and ` + "`value`" + ` is created only if ` + "`expected`" + ` is empty.
+An empty ` + "`value`" + ` removes the field.
+
+For a deploy lock, acquire it with an empty ` + "`expected`" + ` and the execution ID as ` + "`value`" + `,
+and release it with the execution ID as ` + "`expected`" + ` and an empty ` + "`value`" + `.
+
+## Output Channels
+
+- **Set**: The field had the expected value and was set
+- **Conflict**: The field did not have the expected value, and nothing was changed`
+}
+
+func (c *CompareAndSetMemory) Icon() string {
+	return "database"
+}
+
+func (c *CompareAndSetMemory) Color() string {
+	return "blue"
+}
+
+func (c *CompareAndSetMemory) ExampleOutput() map[string]any {
+	return exampleOutput()
+}
+
+func (c *CompareAndSetMemory) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{
+		{Name: ChannelNameSet, Label: "Set"},
+		{Name: ChannelNameConflict, Label: "Conflict"},
+	}
+}
+
+func (c *CompareAndSetMemory) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:        "namespace",
+			Label:       "Namespace",
+			Type:        configuration.FieldTypeString,
+			Description: "Memory namespace to update in. Use org/<name> for an organization namespace",
+			Required:    true,
+		},
+		{
+			Name:        "matchList",
+			Label:       "Matches",
+			Type:        configuration.FieldTypeList,
+			Description: "List of exact field/value matches used to find rows",
+			Required:    true,
+			TypeOptions: &configuration.TypeOptions{
+				List: &configuration.ListTypeOptions{
+					ItemLabel: "Match",
+					ItemDefinition: &configuration.ListItemDefinition{
+						Type: configuration.FieldTypeObject,
+						Schema: []configuration.Field{
+							{
+								Name:        "name",
+								Label:       "Field Name",
+								Type:        configuration.FieldTypeString,
+								Description: "Field name to match",
+								Required:    true,
+							},
+							{
+								Name:        "value",
+								Label:       "Field Value",
+								Type:        configuration.FieldTypeExpression,
+								Description: "Expected field value (can be expression)",
+								Required:    true,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:        "field",
+			Label:       "Field",
+			Type:        configuration.FieldTypeString,
+			Description: "Field to compare and set",
+			Required:    true,
+		},
+		{
+			Name:        "expected",
+			Label:       "Expected Value",
+			Type:        configuration.FieldTypeExpression,
+			Description: "Current value the field must have, empty if it must not be set (can be expression)",
+		},
+		{
+			Name:        "value",
+			Label:       "New Value",
+			Type:        configuration.FieldTypeExpression,
+			Description: "Value to set, empty to remove the field (can be expression)",
+		},
+	}
+}
+
+func (c *CompareAndSetMemory) Setup(ctx core.SetupContext) error {
+	spec, err := decodeSpec(ctx.Configuration)
+	if err != nil {
+		return err
+	}
+	spec = normalizeSpec(spec)
+	return validateSpec(spec)
+}
+
+func (c *CompareAndSetMemory) Execute(ctx core.ExecutionContext) error {
+	spec, err := decodeSpec(ctx.Configuration)
+	if err != nil {
+		return err
+	}
+	spec = normalizeSpec(spec)
+	if err := validateSpec(spec); err != nil {
+		return err
+	}
+
+	casCtx, ok := ctx.CanvasMemory.(canvasMemoryCompareAndSetContext)
+	if !ok {
+		return fmt.Errorf("canvas memory compare-and-set operations are not supported")
+	}
+
+	matches := buildPairs(spec.MatchList)
+	set, records, casErr := casCtx.CompareAndSet(spec.Namespace, matches, spec.Field, spec.Expected, spec.Value)
+	if casErr != nil {
+		return fmt.Errorf("failed to compare and set canvas memory: %w", casErr)
+	}
+
+	var current any
+	if len(records) > 0 {
+		if record, ok := records[0].(map[string]any); ok {
+			current = record[spec.Field]
+		}
+	}
+
+	metadata := map[string]any{
+		"namespace":   spec.Namespace,
+		"matchFields": extractFieldNames(spec.MatchList),
+		"matches":     matches,
+		"field":       spec.Field,
+		"set":         set,
+	}
+	if err := ctx.Metadata.Set(metadata); err != nil {
+		return fmt.Errorf("failed to set execution metadata: %w", err)
+	}
+	if err := ctx.NodeMetadata.Set(metadata); err != nil {
+		return fmt.Errorf("failed to set node metadata: %w", err)
+	}
+
+	channel := ChannelNameConflict
+	if set {
+		channel = ChannelNameSet
+	}
+
+	return ctx.ExecutionState.Emit(
+		channel,
+		PayloadType,
+		[]any{
+			map[string]any{
+				"data": map[string]any{
+					"namespace": spec.Namespace,
+					"matches":   matches,
+					"field":     spec.Field,
+					"expected":  spec.Expected,
+					"value":     spec.Value,
+					"set":       set,
+					"current":   current,
+					"records":   records,
+					"count":     len(records),
+				},
+			},
+		},
+	)
+}
+
+func decodeSpec(raw any) (Spec, error) {
+	var spec Spec
+	if err := mapstructure.Decode(raw, &spec); err != nil {
+		return Spec{}, fmt.Errorf("failed to decode configuration: %w", err)
+	}
+	return spec, nil
+}
+
+// normalizeSpec turns empty expected values and new values into nil,
+// which stand for a field that is not set.
+func normalizeSpec(spec Spec) Spec {
+	spec.Namespace = strings.TrimSpace(spec.Namespace)
+	spec.Field = strings.TrimSpace(spec.Field)
+	if value, ok := spec.Expected.(string); ok && value == "" {
+		spec.Expected = nil
+	}
+	if value, ok := spec.Value.(string); ok && value == "" {
+		spec.Value = nil
+	}
+	return spec
+}
+
+func validateSpec(spec Spec) error {
+	if spec.Namespace == "" {
+		return fmt.Errorf("namespace is required")
+	}
+	if len(buildPairs(spec.MatchList)) == 0 {
+		return fmt.Errorf("at least one memory match is required")
+	}
+	if spec.Field == "" {
+		return fmt.Errorf("field is required")
+	}
+	if _, ok := buildPairs(spec.MatchList)[spec.Field]; ok {
+		return fmt.Errorf("field %s cannot also be used as a match", spec.Field)
+	}
+	return nil
+}
+
+func buildPairs(pairs []FieldPair) map[string]any {
+	values := make(map[string]any, len(pairs))
+	for _, pair := range pairs {
+		name := strings.TrimSpace(pair.Name)
+		if name == "" {
+			continue
+		}
+		values[name] = pair.Value
+	}
+	return values
+}
+
+func extractFieldNames(pairs []FieldPair) []string {
+	fields := make([]string, 0, len(pairs))
+	seen := map[string]struct{}{}
+	for _, pair := range pairs {
+		name := strings.TrimSpace(pair.Name)
+		if name == "" {
+			continue
+		}
+		if _, ok := seen[name]; ok {
+			continue
+		}
+		seen[name] = struct{}{}
+		fields = append(fields, name)
+	}
+	return fields
+}
+
+func (c *CompareAndSetMemory) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *CompareAndSetMemory) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *CompareAndSetMemory) HandleAction(ctx core.ActionContext) error {
+	return fmt.Errorf("compareAndSetMemory does not support actions")
+}
+
+func (c *CompareAndSetMemory) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *CompareAndSetMemory) HandleWebhook(ctx core.WebhookRequestContext) (int, *core.WebhookResponseBody, error) {
+	return http.StatusOK, nil, nil
+}
+
+func (c *CompareAndSetMemory) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,182 @@
+package compareandsetmemory
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/test/support/contexts"
+)
+
+type canvasMemoryContext struct {
+	namespace string
+	matches   map[string]any
+	field     string
+	expected  any
+	value     any
+	set       bool
+	records   []any
+	calls     int
+	err       error
+}
+
+func (c *canvasMemoryContext) Add(namespace string, values any) error {
+	return nil
+}
+
+func (c *canvasMemoryContext) Find(namespace string, matches map[string]any) ([]any, error) {
+	return []any{}, nil
+}
+
+func (c *canvasMemoryContext) FindFirst(namespace string, matches map[string]any) (any, error) {
+	return nil, nil
+}
+
+func (c *canvasMemoryContext) CompareAndSet(namespace string, matches map[string]any, field string, expected any, value any) (bool, []any, error) {
+	c.calls++
+	c.namespace = namespace
+	c.matches = matches
+	c.field = field
+	c.expected = expected
+	c.value = value
+	if c.err != nil {
+		return false, nil, c.err
+	}
+	return c.set, c.records, nil
+}
+
+func lockConfiguration(expected, value string) map[string]any {
+	return map[string]any{
+		"namespace": "locks",
+		"matchList": []map[string]any{
+			{"name": "environment", "value": "production"},
+		},
+		"field":    "holder",
+		"expected": expected,
+		"value":    value,
+	}
+}
+
+func TestCompareAndSetMemoryExecute(t *testing.T) {
+	t.Run("acquires lock and emits set channel", func(t *testing.T) {
+		component := &CompareAndSetMemory{}
+		execState := &contexts.ExecutionStateContext{}
+		memoryCtx := &canvasMemoryContext{
+			set:     true,
+			records: []any{map[string]any{"environment": "production", "holder": "deploy-1"}},
+		}
+		nodeMetadata := &contexts.MetadataContext{}
+
+		err := component.Execute(core.ExecutionContext{
+			Configuration:  lockConfiguration("", "deploy-1"),
+			Metadata:       &contexts.MetadataContext{},
+			NodeMetadata:   nodeMetadata,
+			CanvasMemory:   memoryCtx,
+			ExecutionState: execState,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, memoryCtx.calls)
+		assert.Equal(t, "locks", memoryCtx.namespace)
+		assert.Equal(t, map[string]any{"environment": "production"}, memoryCtx.matches)
+		assert.Equal(t, "holder", memoryCtx.field)
+		assert.Nil(t, memoryCtx.expected)
+		assert.Equal(t, "deploy-1", memoryCtx.value)
+		assert.Equal(t, ChannelNameSet, execState.Channel)
+		assert.Equal(t, PayloadType, execState.Type)
+		assert.Equal(t, true, nodeMetadata.Get().(map[string]any)["set"])
+
+		require.Len(t, execState.Payloads, 1)
+		wrapped := execState.Payloads[0].(map[string]any)["data"].(map[string]any)
+		data := wrapped["data"].(map[string]any)
+		assert.Equal(t, "deploy-1", data["current"])
+	})
+
+	t.Run("emits conflict channel when value is not the expected one", func(t *testing.T) {
+		component := &CompareAndSetMemory{}
+		execState := &contexts.ExecutionStateContext{}
+		memoryCtx := &canvasMemoryContext{
+			set:     false,
+			records: []any{map[string]any{"environment": "production", "holder": "deploy-2"}},
+		}
+
+		err := component.Execute(core.ExecutionContext{
+			Configuration:  lockConfiguration("", "deploy-1"),
+			Metadata:       &contexts.MetadataContext{},
+			NodeMetadata:   &contexts.MetadataContext{},
+			CanvasMemory:   memoryCtx,
+			ExecutionState: execState,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, ChannelNameConflict, execState.Channel)
+
+		wrapped := execState.Payloads[0].(map[string]any)["data"].(map[string]any)
+		data := wrapped["data"].(map[string]any)
+		assert.Equal(t, "deploy-2", data["current"])
+	})
+
+	t.Run("empty value releases the field", func(t *testing.T) {
+		component := &CompareAndSetMemory{}
+		memoryCtx := &canvasMemoryContext{set: true}
+
+		err := component.Execute(core.ExecutionContext{
+			Configuration:  lockConfiguration("deploy-1", ""),
+			Metadata:       &contexts.MetadataContext{},
+			NodeMetadata:   &contexts.MetadataContext{},
+			CanvasMemory:   memoryCtx,
+			ExecutionState: &contexts.ExecutionStateContext{},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "deploy-1", memoryCtx.expected)
+		assert.Nil(t, memoryCtx.value)
+	})
+
+	t.Run("returns error when operation fails", func(t *testing.T) {
+		component := &CompareAndSetMemory{}
+		memoryCtx := &canvasMemoryContext{err: errors.New("db failed")}
+
+		err := component.Execute(core.ExecutionContext{
+			Configuration:  lockConfiguration("", "deploy-1"),
+			Metadata:       &contexts.MetadataContext{},
+			NodeMetadata:   &contexts.MetadataContext{},
+			CanvasMemory:   memoryCtx,
+			ExecutionState: &contexts.ExecutionStateContext{},
+		})
+
+		assert.ErrorContains(t, err, "failed to compare and set canvas memory")
+	})
+}
+
+func TestCompareAndSetMemorySetup(t *testing.T) {
+	component := &CompareAndSetMemory{}
+
+	t.Run("valid configuration passes", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{Configuration: lockConfiguration("", "deploy-1")})
+		assert.NoError(t, err)
+	})
+
+	t.Run("missing matches fails", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Configuration: map[string]any{"namespace": "locks", "matchList": []map[string]any{}, "field": "holder"},
+		})
+		assert.ErrorContains(t, err, "at least one memory match is required")
+	})
+
+	t.Run("missing field fails", func(t *testing.T) {
+		config := lockConfiguration("", "deploy-1")
+		config["field"] = " "
+		err := component.Setup(core.SetupContext{Configuration: config})
+		assert.ErrorContains(t, err, "field is required")
+	})
+
+	t.Run("field used as match fails", func(t *testing.T) {
+		config := lockConfiguration("", "deploy-1")
+		config["field"] = "environment"
+		err := component.Setup(core.SetupContext{Configuration: config})
+		assert.ErrorContains(t, err, "cannot also be used as a match")
+	})
+}
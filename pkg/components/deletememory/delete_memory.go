@@ -94,7 +94,7 @@ func (c *DeleteMemory) Configuration() []configuration.Field {
 			Name:        "namespace",
 			Label:       "Namespace",
 			Type:        configuration.FieldTypeString,
-			Description: "Memory namespace to delete from",
+			Description: "Memory namespace to delete from. Use org/<name> for an organization namespace",
 			Required:    true,
 		},
 		{
@@ -0,0 +1,344 @@
+package incrementmemory
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/registry"
+)
+
+const ComponentName = "incrementMemory"
+const PayloadType = "memory.incremented"
+
+func init() {
+	registry.RegisterComponent(ComponentName, &IncrementMemory{})
+}
+
+type IncrementMemory struct{}
+
+type Spec struct {
+	Namespace string      `json:"namespace"`
+	MatchList []FieldPair `json:"matchList"`
+	Field     string      `json:"field"`
+	Amount    any         `json:"amount"`
+}
+
+type FieldPair struct {
+	Name  string `json:"name"`
+	Value any    `json:"value"`
+}
+
+type canvasMemoryIncrementContext interface {
+	Increment(namespace string, matches map[string]any, field string, delta float64) ([]any, error)
+}
+
+func (c *IncrementMemory) Name() string {
+	return ComponentName
+}
+
+func (c *IncrementMemory) Label() string {
+	return "Increment Memory"
+}
+
+func (c *IncrementMemory) Description() string {
+	return "Atomically increment or decrement a numeric field in canvas memory"
+}
+
+func (c *IncrementMemory) Documentation() string {
+	return `The Increment Memory component atomically adds an amount to a numeric field of matching rows in canvas-level memory storage.
+
+## Use Cases
+
+- Count consecutive failures of a deployment or health check
+- Keep per-service or per-environment counters
+- Decrement remaining quotas or retries
+
+## How It Works
+
+1. Reads ` + "`namespace`" + `, ` + "`matchList`" + `, ` + "`field`" + `, and ` + "`amount`" + ` from configuration
+2. Adds ` + "`amount`" + ` to ` + "`field`" + ` on every matching memory row, treating a missing field as zero
+3. Creates a new row with the matches and ` + "`field`" + ` set to ` + "`amount`" + ` when no row matches
+4. Emits ` + "`memory.incremented`" + ` to the default channel with the new ` + "`value`" + `
+
+Executions touching the same namespace and matches are serialized,
+so concurrent increments are never lost. Use a negative amount to decrement.`
+}
+
+func (c *IncrementMemory) Icon() string {
+	return "database"
+}
+
+func (c *IncrementMemory) Color() string {
+	return "blue"
+}
+
+func (c *IncrementMemory) ExampleOutput() map[string]any {
+	return exampleOutput()
+}
+
+func (c *IncrementMemory) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *IncrementMemory) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:        "namespace",
+			Label:       "Namespace",
+			Type:        configuration.FieldTypeString,
+			Description: "Memory namespace of the counter. Use org/<name> for an organization namespace",
+			Required:    true,
+		},
+		{
+			Name:        "matchList",
+			Label:       "Matches",
+			Type:        configuration.FieldTypeList,
+			Description: "List of exact field/value matches used to find rows",
+			Required:    true,
+			TypeOptions: &configuration.TypeOptions{
+				List: &configuration.ListTypeOptions{
+					ItemLabel: "Match",
+					ItemDefinition: &configuration.ListItemDefinition{
+						Type: configuration.FieldTypeObject,
+						Schema: []configuration.Field{
+							{
+								Name:        "name",
+								Label:       "Field Name",
+								Type:        configuration.FieldTypeString,
+								Description: "Field name to match",
+								Required:    true,
+							},
+							{
+								Name:        "value",
+								Label:       "Field Value",
+								Type:        configuration.FieldTypeExpression,
+								Description: "Expected field value (can be expression)",
+								Required:    true,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:        "field",
+			Label:       "Field",
+			Type:        configuration.FieldTypeString,
+			Description: "Numeric field to increment",
+			Required:    true,
+		},
+		{
+			Name:        "amount",
+			Label:       "Amount",
+			Type:        configuration.FieldTypeExpression,
+			Description: "Amount to add to the field, negative to decrement (can be expression)",
+			Default:     "1",
+		},
+	}
+}
+
+func (c *IncrementMemory) Setup(ctx core.SetupContext) error {
+	spec, err := decodeSpec(ctx.Configuration)
+	if err != nil {
+		return err
+	}
+	spec = normalizeSpec(spec)
+	if err := validateSpec(spec); err != nil {
+		return err
+	}
+
+	//
+	// Amounts using expressions are only known at execution time.
+	//
+	if amount, ok := spec.Amount.(string); ok && strings.Contains(amount, "{{") {
+		return nil
+	}
+
+	_, err = parseAmount(spec.Amount)
+	return err
+}
+
+func (c *IncrementMemory) Execute(ctx core.ExecutionContext) error {
+	spec, err := decodeSpec(ctx.Configuration)
+	if err != nil {
+		return err
+	}
+	spec = normalizeSpec(spec)
+	if err := validateSpec(spec); err != nil {
+		return err
+	}
+
+	amount, err := parseAmount(spec.Amount)
+	if err != nil {
+		return err
+	}
+
+	incrementCtx, ok := ctx.CanvasMemory.(canvasMemoryIncrementContext)
+	if !ok {
+		return fmt.Errorf("canvas memory increment operations are not supported")
+	}
+
+	matches := buildPairs(spec.MatchList)
+	records, incrementErr := incrementCtx.Increment(spec.Namespace, matches, spec.Field, amount)
+	if incrementErr != nil {
+		return fmt.Errorf("failed to increment canvas memory: %w", incrementErr)
+	}
+
+	var value any
+	if len(records) > 0 {
+		if record, ok := records[0].(map[string]any); ok {
+			value = record[spec.Field]
+		}
+	}
+
+	metadata := map[string]any{
+		"namespace":   spec.Namespace,
+		"matchFields": extractFieldNames(spec.MatchList),
+		"matches":     matches,
+		"field":       spec.Field,
+		"value":       value,
+	}
+	if err := ctx.Metadata.Set(metadata); err != nil {
+		return fmt.Errorf("failed to set execution metadata: %w", err)
+	}
+	if err := ctx.NodeMetadata.Set(metadata); err != nil {
+		return fmt.Errorf("failed to set node metadata: %w", err)
+	}
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		PayloadType,
+		[]any{
+			map[string]any{
+				"data": map[string]any{
+					"namespace": spec.Namespace,
+					"matches":   matches,
+					"field":     spec.Field,
+					"amount":    amount,
+					"value":     value,
+					"records":   records,
+					"count":     len(records),
+				},
+			},
+		},
+	)
+}
+
+func decodeSpec(raw any) (Spec, error) {
+	var spec Spec
+	if err := mapstructure.Decode(raw, &spec); err != nil {
+		return Spec{}, fmt.Errorf("failed to decode configuration: %w", err)
+	}
+	return spec, nil
+}
+
+func normalizeSpec(spec Spec) Spec {
+	spec.Namespace = strings.TrimSpace(spec.Namespace)
+	spec.Field = strings.TrimSpace(spec.Field)
+	return spec
+}
+
+func validateSpec(spec Spec) error {
+	if spec.Namespace == "" {
+		return fmt.Errorf("namespace is required")
+	}
+	if len(buildPairs(spec.MatchList)) == 0 {
+		return fmt.Errorf("at least one memory match is required")
+	}
+	if spec.Field == "" {
+		return fmt.Errorf("field is required")
+	}
+	if _, ok := buildPairs(spec.MatchList)[spec.Field]; ok {
+		return fmt.Errorf("field %s cannot also be used as a match", spec.Field)
+	}
+	return nil
+}
+
+// parseAmount accepts numbers and numeric strings,
+// since resolved expressions are always strings.
+// A missing amount increments by one.
+func parseAmount(raw any) (float64, error) {
+	switch amount := raw.(type) {
+	case nil:
+		return 1, nil
+	case int:
+		return float64(amount), nil
+	case int64:
+		return float64(amount), nil
+	case float64:
+		return amount, nil
+	case string:
+		amount = strings.TrimSpace(amount)
+		if amount == "" {
+			return 1, nil
+		}
+
+		value, err := strconv.ParseFloat(amount, 64)
+		if err != nil {
+			return 0, fmt.Errorf("amount must be a number: %s", amount)
+		}
+
+		return value, nil
+	default:
+		return 0, fmt.Errorf("amount must be a number")
+	}
+}
+
+func buildPairs(pairs []FieldPair) map[string]any {
+	values := make(map[string]any, len(pairs))
+	for _, pair := range pairs {
+		name := strings.TrimSpace(pair.Name)
+		if name == "" {
+			continue
+		}
+		values[name] = pair.Value
+	}
+	return values
+}
+
+func extractFieldNames(pairs []FieldPair) []string {
+	fields := make([]string, 0, len(pairs))
+	seen := map[string]struct{}{}
+	for _, pair := range pairs {
+		name := strings.TrimSpace(pair.Name)
+		if name == "" {
+			continue
+		}
+		if _, ok := seen[name]; ok {
+			continue
+		}
+		seen[name] = struct{}{}
+		fields = append(fields, name)
+	}
+	return fields
+}
+
+func (c *IncrementMemory) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *IncrementMemory) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *IncrementMemory) HandleAction(ctx core.ActionContext) error {
+	return fmt.Errorf("incrementMemory does not support actions")
+}
+
+func (c *IncrementMemory) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *IncrementMemory) HandleWebhook(ctx core.WebhookRequestContext) (int, *core.WebhookResponseBody, error) {
+	return http.StatusOK, nil, nil
+}
+
+func (c *IncrementMemory) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
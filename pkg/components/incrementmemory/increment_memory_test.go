@@ -0,0 +1,193 @@
+package incrementmemory
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/test/support/contexts"
+)
+
+type canvasMemoryContext struct {
+	namespace      string
+	matches        map[string]any
+	field          string
+	delta          float64
+	records        []any
+	incrementCalls int
+	err            error
+}
+
+func (c *canvasMemoryContext) Add(namespace string, values any) error {
+	return nil
+}
+
+func (c *canvasMemoryContext) Find(namespace string, matches map[string]any) ([]any, error) {
+	return []any{}, nil
+}
+
+func (c *canvasMemoryContext) FindFirst(namespace string, matches map[string]any) (any, error) {
+	return nil, nil
+}
+
+func (c *canvasMemoryContext) Increment(namespace string, matches map[string]any, field string, delta float64) ([]any, error) {
+	c.incrementCalls++
+	c.namespace = namespace
+	c.matches = matches
+	c.field = field
+	c.delta = delta
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.records, nil
+}
+
+func TestIncrementMemoryExecute(t *testing.T) {
+	t.Run("increments field and emits new value", func(t *testing.T) {
+		component := &IncrementMemory{}
+		execState := &contexts.ExecutionStateContext{}
+		memoryCtx := &canvasMemoryContext{
+			records: []any{
+				map[string]any{"service": "api", "count": 3.0},
+			},
+		}
+
+		err := component.Execute(core.ExecutionContext{
+			Configuration: map[string]any{
+				"namespace": "failures",
+				"matchList": []map[string]any{
+					{"name": "service", "value": "api"},
+				},
+				"field":  "count",
+				"amount": "1",
+			},
+			Metadata:       &contexts.MetadataContext{},
+			NodeMetadata:   &contexts.MetadataContext{},
+			CanvasMemory:   memoryCtx,
+			ExecutionState: execState,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, memoryCtx.incrementCalls)
+		assert.Equal(t, "failures", memoryCtx.namespace)
+		assert.Equal(t, map[string]any{"service": "api"}, memoryCtx.matches)
+		assert.Equal(t, "count", memoryCtx.field)
+		assert.Equal(t, 1.0, memoryCtx.delta)
+		assert.Equal(t, core.DefaultOutputChannel.Name, execState.Channel)
+		assert.Equal(t, PayloadType, execState.Type)
+
+		require.Len(t, execState.Payloads, 1)
+		wrapped := execState.Payloads[0].(map[string]any)["data"].(map[string]any)
+		data := wrapped["data"].(map[string]any)
+		assert.Equal(t, 3.0, data["value"])
+		assert.Equal(t, 1, data["count"])
+	})
+
+	t.Run("negative amount decrements", func(t *testing.T) {
+		component := &IncrementMemory{}
+		memoryCtx := &canvasMemoryContext{records: []any{}}
+
+		err := component.Execute(core.ExecutionContext{
+			Configuration: map[string]any{
+				"namespace": "quotas",
+				"matchList": []map[string]any{
+					{"name": "team", "value": "platform"},
+				},
+				"field":  "remaining",
+				"amount": "-2.5",
+			},
+			Metadata:       &contexts.MetadataContext{},
+			NodeMetadata:   &contexts.MetadataContext{},
+			CanvasMemory:   memoryCtx,
+			ExecutionState: &contexts.ExecutionStateContext{},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, -2.5, memoryCtx.delta)
+	})
+
+	t.Run("missing amount increments by one", func(t *testing.T) {
+		component := &IncrementMemory{}
+		memoryCtx := &canvasMemoryContext{records: []any{}}
+
+		err := component.Execute(core.ExecutionContext{
+			Configuration: map[string]any{
+				"namespace": "failures",
+				"matchList": []map[string]any{
+					{"name": "service", "value": "api"},
+				},
+				"field": "count",
+			},
+			Metadata:       &contexts.MetadataContext{},
+			NodeMetadata:   &contexts.MetadataContext{},
+			CanvasMemory:   memoryCtx,
+			ExecutionState: &contexts.ExecutionStateContext{},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 1.0, memoryCtx.delta)
+	})
+
+	t.Run("returns error when increment fails", func(t *testing.T) {
+		component := &IncrementMemory{}
+		memoryCtx := &canvasMemoryContext{err: errors.New("field count is not a number")}
+
+		err := component.Execute(core.ExecutionContext{
+			Configuration: map[string]any{
+				"namespace": "failures",
+				"matchList": []map[string]any{
+					{"name": "service", "value": "api"},
+				},
+				"field": "count",
+			},
+			Metadata:       &contexts.MetadataContext{},
+			NodeMetadata:   &contexts.MetadataContext{},
+			CanvasMemory:   memoryCtx,
+			ExecutionState: &contexts.ExecutionStateContext{},
+		})
+
+		assert.ErrorContains(t, err, "failed to increment canvas memory")
+	})
+}
+
+func TestIncrementMemorySetup(t *testing.T) {
+	component := &IncrementMemory{}
+	matchList := []map[string]any{{"name": "service", "value": "api"}}
+
+	t.Run("valid configuration passes", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Configuration: map[string]any{"namespace": "failures", "matchList": matchList, "field": "count", "amount": "5"},
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("amount expressions are not checked", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Configuration: map[string]any{"namespace": "failures", "matchList": matchList, "field": "count", "amount": "{{ $.data.n }}"},
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("non-numeric amount fails", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Configuration: map[string]any{"namespace": "failures", "matchList": matchList, "field": "count", "amount": "many"},
+		})
+		assert.ErrorContains(t, err, "amount must be a number")
+	})
+
+	t.Run("missing field fails", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Configuration: map[string]any{"namespace": "failures", "matchList": matchList},
+		})
+		assert.ErrorContains(t, err, "field is required")
+	})
+
+	t.Run("field used as match fails", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Configuration: map[string]any{"namespace": "failures", "matchList": matchList, "field": "service"},
+		})
+		assert.ErrorContains(t, err, "cannot also be used as a match")
+	})
+}
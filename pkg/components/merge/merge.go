@@ -328,7 +328,7 @@ func expressionOptions(env map[string]any) []expr.Option {
 		expr.AsBool(),
 		expr.WithContext("ctx"),
 		expr.Timezone(time.UTC.String()),
-		exprruntime.DateFunctionOption(),
+		exprruntime.StandardLibrary(),
 		expr.Function("root", func(params ...any) (any, error) {
 			if len(params) != 0 {
 				return nil, fmt.Errorf("root() takes no arguments")
@@ -98,7 +98,7 @@ func (c *ReadMemory) Configuration() []configuration.Field {
 			Name:        "namespace",
 			Label:       "Namespace",
 			Type:        configuration.FieldTypeString,
-			Description: "Memory namespace to search in",
+			Description: "Memory namespace to search in. Use org/<name> for an organization namespace",
 			Required:    true,
 		},
 		{
@@ -96,7 +96,7 @@ func (c *UpdateMemory) Configuration() []configuration.Field {
 			Name:        "namespace",
 			Label:       "Namespace",
 			Type:        configuration.FieldTypeString,
-			Description: "Memory namespace to update in",
+			Description: "Memory namespace to update in. Use org/<name> for an organization namespace",
 			Required:    true,
 		},
 		{
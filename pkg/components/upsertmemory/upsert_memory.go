@@ -98,7 +98,7 @@ func (c *UpsertMemory) Configuration() []configuration.Field {
 			Name:        "namespace",
 			Label:       "Namespace",
 			Type:        configuration.FieldTypeString,
-			Description: "Memory namespace to upsert in",
+			Description: "Memory namespace to upsert in. Use org/<name> for an organization namespace",
 			Required:    true,
 		},
 		{
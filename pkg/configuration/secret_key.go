@@ -8,3 +8,66 @@ type SecretKeyRef struct {
 func (r SecretKeyRef) IsSet() bool {
 	return r.Secret != "" && r.Key != ""
 }
+
+// FindSecretKeyRefs returns the secret keys referenced by secret key fields of a configuration,
+// including the ones in objects and lists.
+func FindSecretKeyRefs(config map[string]any, fields []Field) []SecretKeyRef {
+	refs := []SecretKeyRef{}
+	for _, field := range fields {
+		value, ok := config[field.Name]
+		if !ok || value == nil {
+			continue
+		}
+
+		refs = append(refs, findFieldSecretKeyRefs(value, field.Type, field.TypeOptions)...)
+	}
+
+	return refs
+}
+
+func findFieldSecretKeyRefs(value any, fieldType string, options *TypeOptions) []SecretKeyRef {
+	if fieldType == FieldTypeSecretKey {
+		ref, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+
+		secret, _ := ref["secret"].(string)
+		key, _ := ref["key"].(string)
+		if secret == "" {
+			return nil
+		}
+
+		return []SecretKeyRef{{Secret: secret, Key: key}}
+	}
+
+	if options == nil {
+		return nil
+	}
+
+	refs := []SecretKeyRef{}
+	if options.Object != nil && len(options.Object.Schema) > 0 {
+		if object, ok := value.(map[string]any); ok {
+			refs = append(refs, FindSecretKeyRefs(object, options.Object.Schema)...)
+		}
+	}
+
+	if options.List != nil && options.List.ItemDefinition != nil {
+		items, ok := value.([]any)
+		if !ok {
+			return refs
+		}
+
+		definition := options.List.ItemDefinition
+		for _, item := range items {
+			if object, ok := item.(map[string]any); ok && len(definition.Schema) > 0 {
+				refs = append(refs, FindSecretKeyRefs(object, definition.Schema)...)
+				continue
+			}
+
+			refs = append(refs, findFieldSecretKeyRefs(item, definition.Type, nil)...)
+		}
+	}
+
+	return refs
+}
@@ -332,6 +332,13 @@ type NotificationContext interface {
 
 type SecretsContext interface {
 	GetKey(secretName, keyName string) ([]byte, error)
+
+	//
+	// Rotate stores new values for keys of a secret of the canvas,
+	// e.g. after regenerating a webhook secret with NodeWebhookContext.ResetSecret.
+	// Other keys keep their values, and the previous values are kept as an older version of the secret.
+	//
+	Rotate(secretName string, values map[string]string) error
 }
 
 type User struct {
@@ -109,6 +109,27 @@ type WebhookHandler interface {
 	Merge(current, requested any) (merged any, changed bool, err error)
 }
 
+/*
+ * RepositoryFileReader is implemented by integrations
+ * that can read files from the Git repositories they have access to.
+ * It is used to sync canvases from YAML files stored in a repository.
+ */
+type RepositoryFileReader interface {
+
+	/*
+	 * Read the contents of a file in a repository, at the tip of a branch.
+	 */
+	ReadRepositoryFile(ctx RepositoryFileContext) ([]byte, error)
+}
+
+type RepositoryFileContext struct {
+	HTTP        HTTPContext
+	Integration IntegrationContext
+	Repository  string
+	Branch      string
+	Path        string
+}
+
 type WebhookHandlerContext struct {
 	Logger      *logrus.Entry
 	HTTP        HTTPContext
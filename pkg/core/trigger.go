@@ -105,6 +105,7 @@ type TriggerActionContext struct {
 	Requests      RequestContext
 	Events        EventContext
 	Webhook       NodeWebhookContext
+	Secrets       SecretsContext
 	Integration   IntegrationContext
 }
 
@@ -0,0 +1,200 @@
+package crypto
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// Envelope ciphertexts start with a magic header, so they can be told apart
+// from the nonce+ciphertext values written by the AESGCMEncryptor.
+// The layout is:
+//
+//	magic (4) | key ID length (1) | key ID | wrapped key length (2) | wrapped key | nonce+ciphertext
+var envelopeMagic = []byte{'S', 'P', 'E', 1}
+
+const dataKeySize = 32
+
+// KeyEncryptionKeyProvider wraps and unwraps the data keys
+// used by the EnvelopeEncryptor with a key it never hands out.
+type KeyEncryptionKeyProvider interface {
+	// ActiveKeyID returns the ID of the key used to wrap new data keys.
+	ActiveKeyID(ctx context.Context) (string, error)
+
+	// WrapKey encrypts a data key with the active key,
+	// and returns the ID of the key used for it.
+	WrapKey(ctx context.Context, dataKey []byte) (string, []byte, error)
+
+	// UnwrapKey decrypts a data key wrapped by the key with the given ID.
+	UnwrapKey(ctx context.Context, keyID string, wrapped []byte) ([]byte, error)
+}
+
+// EnvelopeEncryptor encrypts every value with a fresh data key,
+// and stores the data key, wrapped by the key encryption key provider,
+// next to the ciphertext, together with the ID of the key that wrapped it.
+//
+// Values written before envelope encryption was enabled
+// are decrypted with the legacy encryptor, if one is configured.
+type EnvelopeEncryptor struct {
+	provider KeyEncryptionKeyProvider
+	legacy   Encryptor
+}
+
+func NewEnvelopeEncryptor(provider KeyEncryptionKeyProvider, legacy Encryptor) *EnvelopeEncryptor {
+	return &EnvelopeEncryptor{provider: provider, legacy: legacy}
+}
+
+type envelope struct {
+	keyID      string
+	wrappedKey []byte
+	ciphertext []byte
+}
+
+func (e *EnvelopeEncryptor) Encrypt(ctx context.Context, data []byte, associatedData []byte) ([]byte, error) {
+	dataKey := make([]byte, dataKeySize)
+	_, err := rand.Read(dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	ciphertext, err := NewAESGCMEncryptor(dataKey).Encrypt(ctx, data, associatedData)
+	if err != nil {
+		return nil, err
+	}
+
+	keyID, wrappedKey, err := e.provider.WrapKey(ctx, dataKey)
+	if err != nil {
+		return nil, fmt.Errorf("error wrapping data key: %w", err)
+	}
+
+	return encodeEnvelope(envelope{keyID: keyID, wrappedKey: wrappedKey, ciphertext: ciphertext})
+}
+
+func (e *EnvelopeEncryptor) Decrypt(ctx context.Context, data []byte, associatedData []byte) ([]byte, error) {
+	env, ok := decodeEnvelope(data)
+	if !ok {
+		if e.legacy == nil {
+			return nil, errors.New("ciphertext is not envelope encrypted")
+		}
+
+		return e.legacy.Decrypt(ctx, data, associatedData)
+	}
+
+	dataKey, err := e.provider.UnwrapKey(ctx, env.keyID, env.wrappedKey)
+	if err != nil {
+		return nil, fmt.Errorf("error unwrapping data key with %s: %w", env.keyID, err)
+	}
+
+	return NewAESGCMEncryptor(dataKey).Decrypt(ctx, env.ciphertext, associatedData)
+}
+
+// NeedsRewrap reports whether a ciphertext was not written
+// with the active key of the provider, either because
+// it predates envelope encryption or because the key was rotated.
+func (e *EnvelopeEncryptor) NeedsRewrap(ctx context.Context, data []byte) (bool, error) {
+	activeKeyID, err := e.provider.ActiveKeyID(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	env, ok := decodeEnvelope(data)
+	if !ok {
+		return true, nil
+	}
+
+	return env.keyID != activeKeyID, nil
+}
+
+// Rewrap re-encrypts a ciphertext for the active key of the provider.
+// For envelope ciphertexts, only the data key is re-wrapped,
+// so the associated data is only used for values
+// written by the legacy encryptor, which are encrypted again.
+func (e *EnvelopeEncryptor) Rewrap(ctx context.Context, data []byte, associatedData []byte) ([]byte, error) {
+	env, ok := decodeEnvelope(data)
+	if !ok {
+		plaintext, err := e.Decrypt(ctx, data, associatedData)
+		if err != nil {
+			return nil, err
+		}
+
+		return e.Encrypt(ctx, plaintext, associatedData)
+	}
+
+	dataKey, err := e.provider.UnwrapKey(ctx, env.keyID, env.wrappedKey)
+	if err != nil {
+		return nil, fmt.Errorf("error unwrapping data key with %s: %w", env.keyID, err)
+	}
+
+	keyID, wrappedKey, err := e.provider.WrapKey(ctx, dataKey)
+	if err != nil {
+		return nil, fmt.Errorf("error wrapping data key: %w", err)
+	}
+
+	return encodeEnvelope(envelope{keyID: keyID, wrappedKey: wrappedKey, ciphertext: env.ciphertext})
+}
+
+// EnvelopeKeyID returns the ID of the key that wrapped
+// the data key of an envelope ciphertext.
+func EnvelopeKeyID(data []byte) (string, bool) {
+	env, ok := decodeEnvelope(data)
+	if !ok {
+		return "", false
+	}
+
+	return env.keyID, true
+}
+
+func encodeEnvelope(env envelope) ([]byte, error) {
+	if len(env.keyID) == 0 || len(env.keyID) > 255 {
+		return nil, fmt.Errorf("invalid key ID length %d", len(env.keyID))
+	}
+
+	if len(env.wrappedKey) == 0 || len(env.wrappedKey) > 65535 {
+		return nil, fmt.Errorf("invalid wrapped key length %d", len(env.wrappedKey))
+	}
+
+	var buf bytes.Buffer
+	buf.Grow(len(envelopeMagic) + 3 + len(env.keyID) + len(env.wrappedKey) + len(env.ciphertext))
+	buf.Write(envelopeMagic)
+	buf.WriteByte(byte(len(env.keyID)))
+	buf.WriteString(env.keyID)
+	_ = binary.Write(&buf, binary.BigEndian, uint16(len(env.wrappedKey)))
+	buf.Write(env.wrappedKey)
+	buf.Write(env.ciphertext)
+	return buf.Bytes(), nil
+}
+
+func decodeEnvelope(data []byte) (envelope, bool) {
+	if !bytes.HasPrefix(data, envelopeMagic) {
+		return envelope{}, false
+	}
+
+	rest := data[len(envelopeMagic):]
+	if len(rest) < 1 {
+		return envelope{}, false
+	}
+
+	keyIDLength := int(rest[0])
+	rest = rest[1:]
+	if keyIDLength == 0 || len(rest) < keyIDLength+2 {
+		return envelope{}, false
+	}
+
+	keyID := string(rest[:keyIDLength])
+	rest = rest[keyIDLength:]
+
+	wrappedKeyLength := int(binary.BigEndian.Uint16(rest[:2]))
+	rest = rest[2:]
+	if wrappedKeyLength == 0 || len(rest) < wrappedKeyLength {
+		return envelope{}, false
+	}
+
+	return envelope{
+		keyID:      keyID,
+		wrappedKey: rest[:wrappedKeyLength],
+		ciphertext: rest[wrappedKeyLength:],
+	}, true
+}
@@ -0,0 +1,158 @@
+package crypto
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testKeyProvider struct {
+	activeKeyID string
+	keys        map[string][]byte
+}
+
+func newTestKeyProvider(keyIDs ...string) *testKeyProvider {
+	provider := &testKeyProvider{keys: map[string][]byte{}}
+	for _, keyID := range keyIDs {
+		key := make([]byte, 32)
+		_, _ = rand.Read(key)
+		provider.keys[keyID] = key
+		provider.activeKeyID = keyID
+	}
+
+	return provider
+}
+
+func (p *testKeyProvider) ActiveKeyID(ctx context.Context) (string, error) {
+	return p.activeKeyID, nil
+}
+
+func (p *testKeyProvider) WrapKey(ctx context.Context, dataKey []byte) (string, []byte, error) {
+	wrapped, err := NewAESGCMEncryptor(p.keys[p.activeKeyID]).Encrypt(ctx, dataKey, []byte(p.activeKeyID))
+	return p.activeKeyID, wrapped, err
+}
+
+func (p *testKeyProvider) UnwrapKey(ctx context.Context, keyID string, wrapped []byte) ([]byte, error) {
+	key, ok := p.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("key %s not found", keyID)
+	}
+
+	return NewAESGCMEncryptor(key).Decrypt(ctx, wrapped, []byte(keyID))
+}
+
+func Test__EnvelopeEncryptor(t *testing.T) {
+	ctx := context.Background()
+	data := []byte("testing encryption")
+	assocData := []byte("aaaa")
+
+	t.Run("encrypts and decrypts properly", func(t *testing.T) {
+		encryptor := NewEnvelopeEncryptor(newTestKeyProvider("k1"), nil)
+
+		ciphertext, err := encryptor.Encrypt(ctx, data, assocData)
+		require.NoError(t, err)
+		require.NotContains(t, string(ciphertext), string(data))
+
+		keyID, ok := EnvelopeKeyID(ciphertext)
+		require.True(t, ok)
+		require.Equal(t, "k1", keyID)
+
+		plaintext, err := encryptor.Decrypt(ctx, ciphertext, assocData)
+		require.NoError(t, err)
+		require.Equal(t, data, plaintext)
+	})
+
+	t.Run("decryption fails with wrong associated data", func(t *testing.T) {
+		encryptor := NewEnvelopeEncryptor(newTestKeyProvider("k1"), nil)
+
+		ciphertext, err := encryptor.Encrypt(ctx, data, assocData)
+		require.NoError(t, err)
+
+		plaintext, err := encryptor.Decrypt(ctx, ciphertext, []byte("bbbb"))
+		require.Error(t, err)
+		require.Nil(t, plaintext)
+	})
+
+	t.Run("legacy ciphertexts are decrypted with the legacy encryptor", func(t *testing.T) {
+		key := make([]byte, 32)
+		_, _ = rand.Read(key)
+		legacy := NewAESGCMEncryptor(key)
+
+		ciphertext, err := legacy.Encrypt(ctx, data, assocData)
+		require.NoError(t, err)
+
+		encryptor := NewEnvelopeEncryptor(newTestKeyProvider("k1"), legacy)
+		plaintext, err := encryptor.Decrypt(ctx, ciphertext, assocData)
+		require.NoError(t, err)
+		require.Equal(t, data, plaintext)
+
+		_, err = NewEnvelopeEncryptor(newTestKeyProvider("k1"), nil).Decrypt(ctx, ciphertext, assocData)
+		require.Error(t, err)
+	})
+
+	t.Run("rotated key re-wraps the data key only", func(t *testing.T) {
+		provider := newTestKeyProvider("k1")
+		encryptor := NewEnvelopeEncryptor(provider, nil)
+
+		ciphertext, err := encryptor.Encrypt(ctx, data, assocData)
+		require.NoError(t, err)
+
+		needsRewrap, err := encryptor.NeedsRewrap(ctx, ciphertext)
+		require.NoError(t, err)
+		require.False(t, needsRewrap)
+
+		provider.keys["k2"] = make([]byte, 32)
+		_, _ = rand.Read(provider.keys["k2"])
+		provider.activeKeyID = "k2"
+
+		needsRewrap, err = encryptor.NeedsRewrap(ctx, ciphertext)
+		require.NoError(t, err)
+		require.True(t, needsRewrap)
+
+		// the old ciphertext is still readable while k1 is around
+		plaintext, err := encryptor.Decrypt(ctx, ciphertext, assocData)
+		require.NoError(t, err)
+		require.Equal(t, data, plaintext)
+
+		rewrapped, err := encryptor.Rewrap(ctx, ciphertext, nil)
+		require.NoError(t, err)
+		keyID, _ := EnvelopeKeyID(rewrapped)
+		require.Equal(t, "k2", keyID)
+
+		delete(provider.keys, "k1")
+		plaintext, err = encryptor.Decrypt(ctx, rewrapped, assocData)
+		require.NoError(t, err)
+		require.Equal(t, data, plaintext)
+	})
+
+	t.Run("legacy ciphertexts are re-encrypted on rewrap", func(t *testing.T) {
+		key := make([]byte, 32)
+		_, _ = rand.Read(key)
+		legacy := NewAESGCMEncryptor(key)
+		encryptor := NewEnvelopeEncryptor(newTestKeyProvider("k1"), legacy)
+
+		ciphertext, err := legacy.Encrypt(ctx, data, assocData)
+		require.NoError(t, err)
+
+		needsRewrap, err := encryptor.NeedsRewrap(ctx, ciphertext)
+		require.NoError(t, err)
+		require.True(t, needsRewrap)
+
+		rewrapped, err := encryptor.Rewrap(ctx, ciphertext, assocData)
+		require.NoError(t, err)
+		keyID, ok := EnvelopeKeyID(rewrapped)
+		require.True(t, ok)
+		require.Equal(t, "k1", keyID)
+
+		plaintext, err := NewEnvelopeEncryptor(newTestKeyProvider(), nil).Decrypt(ctx, ciphertext, assocData)
+		require.Error(t, err)
+		require.Nil(t, plaintext)
+
+		plaintext, err = encryptor.Decrypt(ctx, rewrapped, assocData)
+		require.NoError(t, err)
+		require.Equal(t, data, plaintext)
+	})
+}
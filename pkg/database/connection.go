@@ -113,7 +113,8 @@ func TruncateTables() error {
 			workflow_node_executions,
 			workflow_node_queue_items,
 			workflow_node_requests,
-			webhooks
+			webhooks,
+			audit_events
 		restart identity cascade;
 	`).Error
 }
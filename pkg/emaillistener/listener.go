@@ -0,0 +1,191 @@
+package emaillistener
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
+	"github.com/superplanehq/superplane/pkg/logging"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/registry"
+	"github.com/superplanehq/superplane/pkg/triggers/email"
+	"github.com/superplanehq/superplane/pkg/workers/contexts"
+)
+
+/*
+ * Listener receives emails on a built-in SMTP server,
+ * and delivers them to the email trigger nodes.
+ * Only emails for the given domain are accepted,
+ * and the local part of the recipient address is the ID of the node webhook.
+ */
+type Listener struct {
+	registry       *registry.Registry
+	encryptor      crypto.Encryptor
+	webhookBaseURL string
+	logger         *log.Entry
+	server         *Server
+}
+
+func NewListener(registry *registry.Registry, encryptor crypto.Encryptor, webhookBaseURL, domain string) *Listener {
+	l := &Listener{
+		registry:       registry,
+		encryptor:      encryptor,
+		webhookBaseURL: webhookBaseURL,
+		logger:         log.WithFields(log.Fields{"server": "EmailListener"}),
+	}
+
+	l.server = NewServer(l, domain, email.MaxMessageSize)
+	return l
+}
+
+func (l *Listener) Server() *Server {
+	return l.server
+}
+
+func (l *Listener) ListenAndServe(addr string) error {
+	l.logger.Infof("Listening for emails on %s", addr)
+	return l.server.ListenAndServe(addr)
+}
+
+func (l *Listener) Close() error {
+	return l.server.Close()
+}
+
+func (l *Listener) ValidateRecipient(address string) error {
+	webhookID, err := webhookIDFromAddress(address)
+	if err != nil {
+		return &RejectionError{Code: 550, Message: "No such recipient"}
+	}
+
+	_, err = models.FindWebhook(webhookID)
+	if err != nil {
+		return &RejectionError{Code: 550, Message: "No such recipient"}
+	}
+
+	return nil
+}
+
+func (l *Listener) Deliver(from string, recipients []string, data []byte) error {
+	//
+	// The same message can be sent to multiple addresses,
+	// so we group the recipients by webhook,
+	// to deliver the message only once for each of them.
+	//
+	webhooks := map[uuid.UUID][]string{}
+	order := []uuid.UUID{}
+	for _, recipient := range recipients {
+		webhookID, err := webhookIDFromAddress(recipient)
+		if err != nil {
+			continue
+		}
+
+		if _, ok := webhooks[webhookID]; !ok {
+			order = append(order, webhookID)
+		}
+
+		webhooks[webhookID] = append(webhooks[webhookID], recipient)
+	}
+
+	newEvents := []models.CanvasEvent{}
+	onNewEvents := func(events []models.CanvasEvent) {
+		newEvents = append(newEvents, events...)
+	}
+
+	var firstErr error
+	for _, webhookID := range order {
+		err := l.deliverToWebhook(webhookID, from, webhooks[webhookID], data, onNewEvents)
+		if err != nil {
+			l.logger.Warnf("Error delivering email to webhook %s: %v", webhookID, err)
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+
+	for _, event := range newEvents {
+		messages.NewCanvasEventCreatedMessage(event.WorkflowID.String(), &event).Publish()
+	}
+
+	//
+	// If the message was delivered to at least one node,
+	// we accept it, since rejecting it would cause it
+	// to be delivered again to the ones that accepted it.
+	//
+	if len(newEvents) == 0 && firstErr != nil {
+		return firstErr
+	}
+
+	return nil
+}
+
+func (l *Listener) deliverToWebhook(webhookID uuid.UUID, from string, recipients []string, data []byte, onNewEvents func([]models.CanvasEvent)) error {
+	nodes, err := models.FindWebhookNodes(webhookID)
+	if err != nil {
+		return fmt.Errorf("error finding nodes: %w", err)
+	}
+
+	delivery := email.Delivery{From: from, To: recipients, Data: data}
+	for _, node := range nodes {
+		ref := node.Ref.Data()
+		if node.Type != models.NodeTypeTrigger || ref.Trigger == nil || ref.Trigger.Name != email.Name {
+			continue
+		}
+
+		err := l.executeTriggerNode(delivery, node, onNewEvents)
+		if err != nil {
+			if errors.Is(err, email.ErrSenderNotAllowed) {
+				return &RejectionError{Code: 550, Message: "Sender not allowed"}
+			}
+
+			if errors.Is(err, email.ErrInvalidMessage) {
+				return &RejectionError{Code: 554, Message: "Invalid message"}
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (l *Listener) executeTriggerNode(delivery email.Delivery, node models.CanvasNode, onNewEvents func([]models.CanvasEvent)) error {
+	trigger, err := l.registry.GetTrigger(email.Name)
+	if err != nil {
+		return fmt.Errorf("trigger not found: %w", err)
+	}
+
+	tx := database.Conn()
+	_, err = trigger.HandleAction(core.TriggerActionContext{
+		Name:          email.ReceiveAction,
+		Parameters:    delivery.Parameters(),
+		Configuration: node.Configuration.Data(),
+		Metadata:      contexts.NewNodeMetadataContext(tx, &node),
+		Logger:        logging.ForNode(node),
+		HTTP:          l.registry.HTTPContext(),
+		Webhook:       contexts.NewNodeWebhookContext(context.Background(), tx, l.encryptor, &node, l.webhookBaseURL),
+		Events:        contexts.NewEventContext(tx, &node, onNewEvents),
+	})
+
+	return err
+}
+
+func webhookIDFromAddress(address string) (uuid.UUID, error) {
+	localPart, _, found := strings.Cut(address, "@")
+	if !found {
+		return uuid.Nil, fmt.Errorf("invalid address %s", address)
+	}
+
+	//
+	// Allow sub-addressing, e.g. <id>+alerts@domain.
+	//
+	localPart, _, _ = strings.Cut(localPart, "+")
+	return uuid.Parse(localPart)
+}
@@ -0,0 +1,401 @@
+package emaillistener
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/textproto"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	DefaultMaxRecipients = 50
+	DefaultTimeout       = 5 * time.Minute
+	maxLineLength        = 4096
+)
+
+// Backend decides which recipients are accepted,
+// and what to do with the messages received for them.
+type Backend interface {
+	ValidateRecipient(address string) error
+	Deliver(from string, recipients []string, data []byte) error
+}
+
+// RejectionError allows the backend to control the SMTP reply code
+// used when rejecting a recipient or a message.
+type RejectionError struct {
+	Code    int
+	Message string
+}
+
+func (e *RejectionError) Error() string {
+	return e.Message
+}
+
+/*
+ * Server is a receive-only SMTP server.
+ * It implements the minimal set of commands from RFC 5321
+ * needed to accept messages from mail transfer agents and SMTP clients.
+ * No authentication or relaying is supported: recipients
+ * outside of the server domain are rejected.
+ */
+type Server struct {
+	Domain         string
+	MaxMessageSize int
+	MaxRecipients  int
+	Timeout        time.Duration
+
+	backend  Backend
+	logger   *log.Entry
+	mu       sync.Mutex
+	listener net.Listener
+	conns    map[net.Conn]struct{}
+	closed   bool
+	wg       sync.WaitGroup
+}
+
+func NewServer(backend Backend, domain string, maxMessageSize int) *Server {
+	return &Server{
+		Domain:         domain,
+		MaxMessageSize: maxMessageSize,
+		MaxRecipients:  DefaultMaxRecipients,
+		Timeout:        DefaultTimeout,
+		backend:        backend,
+		logger:         log.WithFields(log.Fields{"server": "EmailListener"}),
+		conns:          map[net.Conn]struct{}{},
+	}
+}
+
+func (s *Server) ListenAndServe(addr string) error {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+
+	return s.Serve(listener)
+}
+
+func (s *Server) Serve(listener net.Listener) error {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return net.ErrClosed
+	}
+
+	s.listener = listener
+	s.mu.Unlock()
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if s.isClosed() {
+				return nil
+			}
+
+			var netErr net.Error
+			if errors.As(err, &netErr) && netErr.Timeout() {
+				time.Sleep(100 * time.Millisecond)
+				continue
+			}
+
+			return err
+		}
+
+		if !s.track(conn) {
+			conn.Close()
+			return nil
+		}
+
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			defer s.untrack(conn)
+			s.handleConn(conn)
+		}()
+	}
+}
+
+func (s *Server) Close() error {
+	s.mu.Lock()
+	s.closed = true
+	var err error
+	if s.listener != nil {
+		err = s.listener.Close()
+	}
+
+	for conn := range s.conns {
+		conn.Close()
+	}
+	s.mu.Unlock()
+
+	s.wg.Wait()
+	return err
+}
+
+func (s *Server) isClosed() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.closed
+}
+
+func (s *Server) track(conn net.Conn) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return false
+	}
+
+	s.conns[conn] = struct{}{}
+	return true
+}
+
+func (s *Server) untrack(conn net.Conn) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.conns, conn)
+	conn.Close()
+}
+
+type session struct {
+	server     *Server
+	conn       net.Conn
+	text       *textproto.Conn
+	greeted    bool
+	from       *string
+	recipients []string
+}
+
+func (s *Server) handleConn(conn net.Conn) {
+	session := &session{
+		server: s,
+		conn:   conn,
+		text: textproto.NewConn(struct {
+			io.Reader
+			io.Writer
+			io.Closer
+		}{
+			Reader: bufio.NewReaderSize(conn, maxLineLength),
+			Writer: conn,
+			Closer: conn,
+		}),
+	}
+
+	session.reply(220, fmt.Sprintf("%s ESMTP SuperPlane", s.Domain))
+
+	for {
+		session.extendDeadline()
+		line, err := session.text.ReadLine()
+		if err != nil {
+			return
+		}
+
+		if len(line) > maxLineLength {
+			session.reply(500, "Line too long")
+			continue
+		}
+
+		command, args := parseCommand(line)
+		if command == "QUIT" {
+			session.reply(221, "Bye")
+			return
+		}
+
+		session.handle(command, args)
+	}
+}
+
+func (s *session) extendDeadline() {
+	if s.server.Timeout > 0 {
+		_ = s.conn.SetDeadline(time.Now().Add(s.server.Timeout))
+	}
+}
+
+func (s *session) reply(code int, message string) {
+	_ = s.text.PrintfLine("%d %s", code, message)
+}
+
+func (s *session) reset() {
+	s.from = nil
+	s.recipients = nil
+}
+
+func (s *session) handle(command, args string) {
+	switch command {
+	case "HELO":
+		s.greeted = true
+		s.reset()
+		s.reply(250, s.server.Domain)
+	case "EHLO":
+		s.greeted = true
+		s.reset()
+		_ = s.text.PrintfLine("250-%s", s.server.Domain)
+		_ = s.text.PrintfLine("250-8BITMIME")
+		_ = s.text.PrintfLine("250-SIZE %d", s.server.MaxMessageSize)
+		s.reply(250, "ENHANCEDSTATUSCODES")
+	case "MAIL":
+		s.handleMail(args)
+	case "RCPT":
+		s.handleRcpt(args)
+	case "DATA":
+		s.handleData()
+	case "RSET":
+		s.reset()
+		s.reply(250, "OK")
+	case "NOOP":
+		s.reply(250, "OK")
+	case "VRFY":
+		s.reply(252, "Cannot verify user")
+	default:
+		s.reply(502, "Command not implemented")
+	}
+}
+
+func (s *session) handleMail(args string) {
+	if !s.greeted {
+		s.reply(503, "Send HELO/EHLO first")
+		return
+	}
+
+	if s.from != nil {
+		s.reply(503, "Sender already specified")
+		return
+	}
+
+	address, params, ok := parsePath(args, "FROM:")
+	if !ok {
+		s.reply(501, "Syntax: MAIL FROM:<address>")
+		return
+	}
+
+	for _, param := range params {
+		key, value, _ := strings.Cut(param, "=")
+		if strings.ToUpper(key) != "SIZE" {
+			continue
+		}
+
+		var size int
+		_, err := fmt.Sscanf(value, "%d", &size)
+		if err == nil && s.server.MaxMessageSize > 0 && size > s.server.MaxMessageSize {
+			s.reply(552, "Message size exceeds maximum allowed")
+			return
+		}
+	}
+
+	s.from = &address
+	s.reply(250, "OK")
+}
+
+func (s *session) handleRcpt(args string) {
+	if s.from == nil {
+		s.reply(503, "Send MAIL first")
+		return
+	}
+
+	address, _, ok := parsePath(args, "TO:")
+	if !ok || address == "" {
+		s.reply(501, "Syntax: RCPT TO:<address>")
+		return
+	}
+
+	if len(s.recipients) >= s.server.MaxRecipients {
+		s.reply(452, "Too many recipients")
+		return
+	}
+
+	if !s.server.isLocal(address) {
+		s.reply(550, "Relaying denied")
+		return
+	}
+
+	err := s.server.backend.ValidateRecipient(address)
+	if err != nil {
+		s.replyError(err, 550, "Recipient rejected")
+		return
+	}
+
+	s.recipients = append(s.recipients, address)
+	s.reply(250, "OK")
+}
+
+func (s *session) handleData() {
+	if s.from == nil || len(s.recipients) == 0 {
+		s.reply(503, "Send MAIL and RCPT first")
+		return
+	}
+
+	s.reply(354, "End data with <CR><LF>.<CR><LF>")
+
+	reader := s.text.DotReader()
+	data, err := io.ReadAll(io.LimitReader(reader, int64(s.server.MaxMessageSize)+1))
+	if err != nil {
+		s.reset()
+		return
+	}
+
+	if len(data) > s.server.MaxMessageSize {
+		_, _ = io.Copy(io.Discard, reader)
+		s.reset()
+		s.reply(552, "Message size exceeds maximum allowed")
+		return
+	}
+
+	from := *s.from
+	recipients := s.recipients
+	s.reset()
+
+	err = s.server.backend.Deliver(from, recipients, data)
+	if err != nil {
+		s.server.logger.Warnf("Error delivering message from %s: %v", from, err)
+		s.replyError(err, 451, "Error processing message")
+		return
+	}
+
+	s.reply(250, "OK: message accepted")
+}
+
+// isLocal reports whether the address belongs to the server domain.
+func (s *Server) isLocal(address string) bool {
+	_, domain, found := strings.Cut(address, "@")
+	return found && strings.EqualFold(domain, s.Domain)
+}
+
+func (s *session) replyError(err error, defaultCode int, defaultMessage string) {
+	var rejection *RejectionError
+	if errors.As(err, &rejection) {
+		s.reply(rejection.Code, rejection.Message)
+		return
+	}
+
+	s.reply(defaultCode, defaultMessage)
+}
+
+func parseCommand(line string) (string, string) {
+	command, args, _ := strings.Cut(strings.TrimSpace(line), " ")
+	return strings.ToUpper(command), strings.TrimSpace(args)
+}
+
+// parsePath parses the arguments of MAIL and RCPT commands,
+// e.g. "FROM:<user@example.com> SIZE=1000".
+func parsePath(args, prefix string) (string, []string, bool) {
+	if len(args) < len(prefix) || !strings.EqualFold(args[:len(prefix)], prefix) {
+		return "", nil, false
+	}
+
+	rest := strings.TrimSpace(args[len(prefix):])
+	if !strings.HasPrefix(rest, "<") {
+		return "", nil, false
+	}
+
+	end := strings.Index(rest, ">")
+	if end < 0 {
+		return "", nil, false
+	}
+
+	return rest[1:end], strings.Fields(rest[end+1:]), true
+}
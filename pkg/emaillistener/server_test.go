@@ -0,0 +1,170 @@
+package emaillistener
+
+import (
+	"fmt"
+	"net"
+	"net/smtp"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type delivery struct {
+	From       string
+	Recipients []string
+	Data       string
+}
+
+type testBackend struct {
+	mu         sync.Mutex
+	deliveries []delivery
+	deliverErr error
+}
+
+func (b *testBackend) ValidateRecipient(address string) error {
+	if strings.HasPrefix(address, "unknown@") {
+		return &RejectionError{Code: 550, Message: "No such recipient"}
+	}
+
+	return nil
+}
+
+func (b *testBackend) Deliver(from string, recipients []string, data []byte) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.deliverErr != nil {
+		return b.deliverErr
+	}
+
+	b.deliveries = append(b.deliveries, delivery{From: from, Recipients: recipients, Data: string(data)})
+	return nil
+}
+
+func startServer(t *testing.T, backend Backend, maxMessageSize int) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := NewServer(backend, "superplane.test", maxMessageSize)
+	go server.Serve(listener)
+	t.Cleanup(func() { server.Close() })
+
+	return listener.Addr().String()
+}
+
+func Test__Server_ReceivesMessage(t *testing.T) {
+	backend := &testBackend{}
+	addr := startServer(t, backend, 1024)
+
+	message := "From: alerts@vendor.com\r\nSubject: hello\r\n\r\nhello world\r\n.leading dot\r\n"
+	err := smtp.SendMail(addr, nil, "alerts@vendor.com", []string{"a@superplane.test", "b@superplane.test"}, []byte(message))
+	require.NoError(t, err)
+
+	require.Len(t, backend.deliveries, 1)
+	assert.Equal(t, "alerts@vendor.com", backend.deliveries[0].From)
+	assert.Equal(t, []string{"a@superplane.test", "b@superplane.test"}, backend.deliveries[0].Recipients)
+
+	//
+	// Line endings are normalized when reading the message.
+	//
+	assert.Equal(t, strings.ReplaceAll(message, "\r\n", "\n"), backend.deliveries[0].Data)
+}
+
+func Test__Server_RejectsUnknownRecipient(t *testing.T) {
+	backend := &testBackend{}
+	addr := startServer(t, backend, 1024)
+
+	err := smtp.SendMail(addr, nil, "alerts@vendor.com", []string{"unknown@superplane.test"}, []byte("Subject: hello\r\n\r\nhello\r\n"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "550")
+	assert.Empty(t, backend.deliveries)
+}
+
+func Test__Server_RejectsForeignDomains(t *testing.T) {
+	backend := &testBackend{}
+	addr := startServer(t, backend, 1024)
+
+	client, err := smtp.Dial(addr)
+	require.NoError(t, err)
+	defer client.Close()
+
+	require.NoError(t, client.Mail("alerts@vendor.com"))
+
+	err = client.Rcpt("a@example.com")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "550")
+
+	//
+	// Domains are case-insensitive.
+	//
+	require.NoError(t, client.Rcpt("a@SuperPlane.test"))
+}
+
+func Test__Server_RejectsLargeMessages(t *testing.T) {
+	backend := &testBackend{}
+	addr := startServer(t, backend, 64)
+
+	client, err := smtp.Dial(addr)
+	require.NoError(t, err)
+	defer client.Close()
+
+	ok, size := client.Extension("SIZE")
+	require.True(t, ok)
+	assert.Equal(t, "64", size)
+
+	require.NoError(t, client.Mail("alerts@vendor.com"))
+	require.NoError(t, client.Rcpt("a@superplane.test"))
+
+	w, err := client.Data()
+	require.NoError(t, err)
+	_, err = fmt.Fprintf(w, "Subject: hello\r\n\r\n%s\r\n", strings.Repeat("a", 100))
+	require.NoError(t, err)
+	err = w.Close()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "552")
+	assert.Empty(t, backend.deliveries)
+
+	//
+	// Session can still be used after rejection.
+	//
+	require.NoError(t, client.Reset())
+	require.NoError(t, client.Quit())
+}
+
+func Test__Server_DeliveryErrors(t *testing.T) {
+	backend := &testBackend{deliverErr: &RejectionError{Code: 550, Message: "Sender not allowed"}}
+	addr := startServer(t, backend, 1024)
+
+	err := smtp.SendMail(addr, nil, "someone@else.com", []string{"a@superplane.test"}, []byte("Subject: hello\r\n\r\nhello\r\n"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Sender not allowed")
+}
+
+func Test__Server_RequiresCommandsInOrder(t *testing.T) {
+	backend := &testBackend{}
+	addr := startServer(t, backend, 1024)
+
+	client, err := smtp.Dial(addr)
+	require.NoError(t, err)
+	defer client.Close()
+
+	err = client.Rcpt("a@superplane.test")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "503")
+}
+
+func Test__WebhookIDFromAddress(t *testing.T) {
+	id, err := webhookIDFromAddress("0b5f3f8e-6a8e-4a3c-9d2b-7f1f5d2c8e11@superplane.test")
+	require.NoError(t, err)
+	assert.Equal(t, "0b5f3f8e-6a8e-4a3c-9d2b-7f1f5d2c8e11", id.String())
+
+	id, err = webhookIDFromAddress("0b5f3f8e-6a8e-4a3c-9d2b-7f1f5d2c8e11+alerts@superplane.test")
+	require.NoError(t, err)
+	assert.Equal(t, "0b5f3f8e-6a8e-4a3c-9d2b-7f1f5d2c8e11", id.String())
+
+	_, err = webhookIDFromAddress("alerts@superplane.test")
+	require.Error(t, err)
+}
@@ -0,0 +1,275 @@
+package exprruntime
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/expr-lang/expr/builtin"
+)
+
+const (
+	CompletionKindFunction = "function"
+	CompletionKindVariable = "variable"
+	CompletionKindNode     = "node"
+	CompletionKindField    = "field"
+)
+
+// Completion is a candidate to insert at the cursor.
+// InsertText replaces the Replace characters before the cursor.
+type Completion struct {
+	Label      string
+	Kind       string
+	Detail     string
+	InsertText string
+	Replace    int
+}
+
+var (
+	// $["Node name"].a.b[0].partial, root().a.partial, config.partial, vars.partial, ...
+	completionPathRegex = regexp.MustCompile(`(\$|root\(\)|previous\(\d*\)|config|vars)((?:\[\s*(?:"[^"]*"|'[^']*'|\d+)\s*\]|\.[A-Za-z_][A-Za-z0-9_]*)*)(?:\.([A-Za-z_][A-Za-z0-9_]*)?|\[\s*["']([^"']*))$`)
+	pathSegmentRegex    = regexp.MustCompile(`\[\s*(?:"([^"]*)"|'([^']*)'|(\d+))\s*\]|\.([A-Za-z_][A-Za-z0-9_]*)`)
+	identifierTailRegex = regexp.MustCompile(`[A-Za-z_$][A-Za-z0-9_]*$`)
+)
+
+// Complete returns completion candidates for the text before the cursor,
+// which is a rune offset. For templates, only the {{ }} expression
+// the cursor is in is considered.
+func Complete(text string, cursor int, scope Scope) []Completion {
+	runes := []rune(text)
+	if cursor < 0 || cursor > len(runes) {
+		cursor = len(runes)
+	}
+
+	before := string(runes[:cursor])
+	if IsTemplate(text) {
+		open := strings.LastIndex(before, "{{")
+		if open == -1 || strings.Contains(before[open:], "}}") {
+			return []Completion{}
+		}
+
+		before = before[open+2:]
+	}
+
+	if matches := completionPathRegex.FindStringSubmatch(before); matches != nil {
+		return completeMembers(matches, scope)
+	}
+
+	return completeIdentifiers(identifierTailRegex.FindString(before), scope)
+}
+
+func completeMembers(matches []string, scope Scope) []Completion {
+	base, ok := completionBase(matches[1], scope)
+	if !ok {
+		return []Completion{}
+	}
+
+	value, ok := walkCompletionPath(base, matches[2])
+	if !ok {
+		return []Completion{}
+	}
+
+	object, ok := value.(map[string]any)
+	if !ok {
+		return []Completion{}
+	}
+
+	//
+	// Inside brackets, the key is inserted as is,
+	// otherwise keys that are not identifiers need to switch to brackets.
+	//
+	tail := matches[0][len(matches[1])+len(matches[2]):]
+	bracket := strings.HasPrefix(tail, "[")
+
+	prefix := matches[3]
+	if bracket {
+		prefix = matches[4]
+	}
+
+	kind := CompletionKindField
+	if matches[1] == "$" && matches[2] == "" {
+		kind = CompletionKindNode
+	}
+	if matches[1] == "vars" && matches[2] == "" {
+		kind = CompletionKindVariable
+	}
+
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		if strings.HasPrefix(strings.ToLower(key), strings.ToLower(prefix)) {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Strings(keys)
+	completions := make([]Completion, 0, len(keys))
+	for _, key := range keys {
+		insertText := key
+		replace := len([]rune(prefix))
+		if !bracket && !identifierRegex.MatchString(key) {
+			insertText = "[" + strconv.Quote(key) + "]"
+			replace++ // the dot before the key
+		}
+
+		completions = append(completions, Completion{
+			Label:      key,
+			Kind:       kind,
+			Detail:     describeValue(object[key]),
+			InsertText: insertText,
+			Replace:    replace,
+		})
+	}
+
+	return completions
+}
+
+func completionBase(base string, scope Scope) (any, bool) {
+	switch {
+	case base == "$":
+		return scope.Nodes, scope.Nodes != nil
+	case base == "config":
+		return scope.Config, scope.Config != nil
+	case base == "vars":
+		return scope.Vars, scope.Vars != nil
+	case base == "root()":
+		return scope.Root, scope.Root != nil
+	case strings.HasPrefix(base, "previous("):
+		depth := 1
+		if arg := strings.TrimSuffix(strings.TrimPrefix(base, "previous("), ")"); arg != "" {
+			depth, _ = strconv.Atoi(arg)
+		}
+
+		if depth < 1 || depth > len(scope.Previous) {
+			return nil, false
+		}
+
+		return scope.Previous[depth-1], scope.Previous[depth-1] != nil
+	}
+
+	return nil, false
+}
+
+func walkCompletionPath(value any, path string) (any, bool) {
+	for _, segment := range pathSegmentRegex.FindAllStringSubmatch(path, -1) {
+		switch {
+		case segment[3] != "":
+			list, ok := value.([]any)
+			if !ok || len(list) == 0 {
+				return nil, false
+			}
+
+			index, _ := strconv.Atoi(segment[3])
+			if index >= len(list) {
+				index = 0
+			}
+
+			value = list[index]
+
+		default:
+			key := segment[1] + segment[2] + segment[4]
+			object, ok := value.(map[string]any)
+			if !ok {
+				return nil, false
+			}
+
+			value, ok = object[key]
+			if !ok {
+				return nil, false
+			}
+		}
+	}
+
+	return value, value != nil
+}
+
+func completeIdentifiers(prefix string, scope Scope) []Completion {
+	candidates := []Completion{
+		{Label: "$", Kind: CompletionKindVariable, Detail: "Payloads of upstream nodes, by node name"},
+		{Label: "vars", Kind: CompletionKindVariable, Detail: "Canvas variables, by name"},
+		{Label: "memory", Kind: CompletionKindVariable, Detail: "Canvas memory, with memory.find(namespace, matches) and memory.findFirst(namespace, matches)"},
+		{Label: "root", Kind: CompletionKindFunction, Detail: "root(): payload of the event that started the run", InsertText: "root()"},
+		{Label: "previous", Kind: CompletionKindFunction, Detail: "previous(depth): payload of a previous node in the run", InsertText: "previous()"},
+	}
+
+	if scope.Config != nil {
+		candidates = append(candidates, Completion{Label: "config", Kind: CompletionKindVariable, Detail: "Configuration of the blueprint node"})
+	}
+
+	for _, fn := range Functions() {
+		candidates = append(candidates, Completion{
+			Label:      fn.Name,
+			Kind:       CompletionKindFunction,
+			Detail:     fn.Signature + ": " + fn.Description,
+			InsertText: fn.Name + "(",
+		})
+	}
+
+	for _, fn := range builtin.Builtins {
+		if _, ok := functions[fn.Name]; ok || fn.Name == "date" {
+			continue
+		}
+
+		candidates = append(candidates, Completion{
+			Label:      fn.Name,
+			Kind:       CompletionKindFunction,
+			InsertText: fn.Name + "(",
+		})
+	}
+
+	candidates = append(candidates, Completion{
+		Label:      "date",
+		Kind:       CompletionKindFunction,
+		Detail:     "date(value, timezone): parses a date, in UTC unless a timezone is given",
+		InsertText: "date(",
+	})
+
+	completions := []Completion{}
+	for _, candidate := range candidates {
+		if !strings.HasPrefix(candidate.Label, prefix) {
+			continue
+		}
+
+		if candidate.InsertText == "" {
+			candidate.InsertText = candidate.Label
+		}
+
+		candidate.Replace = len([]rune(prefix))
+		completions = append(completions, candidate)
+	}
+
+	sort.SliceStable(completions, func(i, j int) bool {
+		return completions[i].Label < completions[j].Label
+	})
+
+	return completions
+}
+
+func describeValue(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return "any"
+	case map[string]any:
+		return "object"
+	case []any:
+		return "list"
+	case string:
+		return fmt.Sprintf("string, e.g. %q", truncate(v, 40))
+	case bool:
+		return fmt.Sprintf("bool, e.g. %t", v)
+	case float64, float32, int, int64, int32:
+		return fmt.Sprintf("number, e.g. %v", v)
+	default:
+		return fmt.Sprintf("%T", v)
+	}
+}
+
+func truncate(s string, max int) string {
+	runes := []rune(s)
+	if len(runes) <= max {
+		return s
+	}
+
+	return string(runes[:max]) + "..."
+}
@@ -0,0 +1,96 @@
+package exprruntime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func completionLabels(completions []Completion) []string {
+	labels := make([]string, 0, len(completions))
+	for _, completion := range completions {
+		labels = append(labels, completion.Label)
+	}
+
+	return labels
+}
+
+func TestComplete(t *testing.T) {
+	scope := testScope()
+
+	t.Run("node names", func(t *testing.T) {
+		completions := Complete(`$.`, -1, scope)
+		require.Len(t, completions, 2)
+		assert.Equal(t, []string{"GitHub push", "Unknown"}, completionLabels(completions))
+		assert.Equal(t, CompletionKindNode, completions[0].Kind)
+
+		//
+		// Names that are not identifiers switch to brackets, replacing the dot.
+		//
+		assert.Equal(t, `["GitHub push"]`, completions[0].InsertText)
+		assert.Equal(t, 1, completions[0].Replace)
+	})
+
+	t.Run("node names inside brackets", func(t *testing.T) {
+		completions := Complete(`$["Git`, -1, scope)
+		require.Len(t, completions, 1)
+		assert.Equal(t, "GitHub push", completions[0].InsertText)
+		assert.Equal(t, 3, completions[0].Replace)
+	})
+
+	t.Run("payload fields", func(t *testing.T) {
+		completions := Complete(`$["GitHub push"].data.c`, -1, scope)
+		require.Len(t, completions, 1)
+		assert.Equal(t, "commits", completions[0].Label)
+		assert.Equal(t, CompletionKindField, completions[0].Kind)
+		assert.Equal(t, "list", completions[0].Detail)
+		assert.Equal(t, 1, completions[0].Replace)
+	})
+
+	t.Run("list items", func(t *testing.T) {
+		completions := Complete(`$["GitHub push"].data.commits[0].`, -1, scope)
+		assert.Equal(t, []string{"id", "message"}, completionLabels(completions))
+	})
+
+	t.Run("root and previous", func(t *testing.T) {
+		assert.Equal(t, []string{"ref"}, completionLabels(Complete(`root().data.`, -1, scope)))
+		assert.Equal(t, []string{"status"}, completionLabels(Complete(`previous().data.`, -1, scope)))
+		assert.Empty(t, Complete(`previous(2).data.`, -1, scope))
+	})
+
+	t.Run("identifiers", func(t *testing.T) {
+		completions := Complete(`semverS`, -1, scope)
+		require.Len(t, completions, 1)
+		assert.Equal(t, "semverSatisfies", completions[0].Label)
+		assert.Equal(t, CompletionKindFunction, completions[0].Kind)
+		assert.Equal(t, "semverSatisfies(", completions[0].InsertText)
+		assert.Equal(t, 7, completions[0].Replace)
+	})
+
+	t.Run("config is only offered inside blueprints", func(t *testing.T) {
+		assert.NotContains(t, completionLabels(Complete(`con`, -1, scope)), "config")
+
+		blueprintScope := testScope()
+		blueprintScope.Config = map[string]any{"environment": "production"}
+		assert.Contains(t, completionLabels(Complete(`con`, -1, blueprintScope)), "config")
+		assert.Equal(t, []string{"environment"}, completionLabels(Complete(`config.`, -1, blueprintScope)))
+	})
+
+	t.Run("canvas variables", func(t *testing.T) {
+		variablesScope := testScope()
+		variablesScope.Vars = map[string]any{"region": "us-east-1", "replicas": "3"}
+
+		completions := Complete(`vars.re`, -1, variablesScope)
+		require.Len(t, completions, 2)
+		assert.Equal(t, []string{"region", "replicas"}, completionLabels(completions))
+		assert.Equal(t, CompletionKindVariable, completions[0].Kind)
+		assert.Contains(t, completionLabels(Complete(`va`, -1, variablesScope)), "vars")
+	})
+
+	t.Run("cursor inside a template", func(t *testing.T) {
+		text := `Ref: {{ root().data. }}`
+		assert.Equal(t, []string{"ref"}, completionLabels(Complete(text, 20, scope)))
+		assert.Empty(t, Complete(text, 3, scope))
+	})
+}
@@ -0,0 +1,157 @@
+package exprruntime
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+func init() {
+	register(
+		Function{
+			Name:        "parseDuration",
+			Signature:   "parseDuration(s)",
+			Description: `Parses a duration like "90s", "1h30m", "2d" or "1w"`,
+			Fn:          parseDurationFn,
+		},
+		Function{
+			Name:        "addDuration",
+			Signature:   "addDuration(date, duration)",
+			Description: `Adds a duration to a date. Negative durations, like "-2h", subtract from it`,
+			Fn:          addDurationFn,
+		},
+		Function{
+			Name:        "since",
+			Signature:   "since(date)",
+			Description: "Returns the duration elapsed since a date",
+			Fn:          sinceFn,
+		},
+		Function{
+			Name:        "until",
+			Signature:   "until(date)",
+			Description: "Returns the duration until a date",
+			Fn:          untilFn,
+		},
+		Function{
+			Name:        "durationSeconds",
+			Signature:   "durationSeconds(duration)",
+			Description: "Returns the number of seconds in a duration",
+			Fn:          durationSecondsFn,
+		},
+	)
+}
+
+// Go durations do not support days and weeks,
+// so those units are converted into hours before parsing.
+var durationDaysPattern = regexp.MustCompile(`(\d+(?:\.\d+)?)(d|w)`)
+
+func parseDuration(s string) (time.Duration, error) {
+	s = strings.TrimSpace(s)
+	converted := durationDaysPattern.ReplaceAllStringFunc(s, func(part string) string {
+		matches := durationDaysPattern.FindStringSubmatch(part)
+		n, _ := strconv.ParseFloat(matches[1], 64)
+		if matches[2] == "w" {
+			n *= 7
+		}
+
+		return strconv.FormatFloat(n*24, 'f', -1, 64) + "h"
+	})
+
+	d, err := time.ParseDuration(converted)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration %q", s)
+	}
+
+	return d, nil
+}
+
+func durationArg(name string, params []any, i int) (time.Duration, error) {
+	switch v := params[i].(type) {
+	case time.Duration:
+		return v, nil
+	case string:
+		return parseDuration(v)
+	default:
+		return 0, fmt.Errorf("%s() expects argument %d to be a duration or string, got %T", name, i+1, params[i])
+	}
+}
+
+func dateArg(name string, params []any, i int) (time.Time, error) {
+	t, ok, err := parseTime(params[i], time.UTC)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%s(): %w", name, err)
+	}
+
+	if !ok {
+		return time.Time{}, fmt.Errorf("%s() expects argument %d to be a date", name, i+1)
+	}
+
+	return t, nil
+}
+
+func parseDurationFn(params ...any) (any, error) {
+	if err := expectArgs("parseDuration", params, 1, 1); err != nil {
+		return nil, err
+	}
+
+	return durationArg("parseDuration", params, 0)
+}
+
+func addDurationFn(params ...any) (any, error) {
+	if err := expectArgs("addDuration", params, 2, 2); err != nil {
+		return nil, err
+	}
+
+	t, err := dateArg("addDuration", params, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := durationArg("addDuration", params, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.Add(d), nil
+}
+
+func sinceFn(params ...any) (any, error) {
+	if err := expectArgs("since", params, 1, 1); err != nil {
+		return nil, err
+	}
+
+	t, err := dateArg("since", params, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return time.Since(t), nil
+}
+
+func untilFn(params ...any) (any, error) {
+	if err := expectArgs("until", params, 1, 1); err != nil {
+		return nil, err
+	}
+
+	t, err := dateArg("until", params, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return time.Until(t), nil
+}
+
+func durationSecondsFn(params ...any) (any, error) {
+	if err := expectArgs("durationSeconds", params, 1, 1); err != nil {
+		return nil, err
+	}
+
+	d, err := durationArg("durationSeconds", params, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.Seconds(), nil
+}
@@ -0,0 +1,159 @@
+package exprruntime
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+)
+
+func init() {
+	register(
+		Function{
+			Name:        "base64URLEncode",
+			Signature:   "base64URLEncode(s)",
+			Description: "Encodes a string using URL-safe base64, without padding",
+			Fn:          base64URLEncodeFn,
+		},
+		Function{
+			Name:        "base64URLDecode",
+			Signature:   "base64URLDecode(s)",
+			Description: "Decodes a URL-safe base64 string, with or without padding",
+			Fn:          base64URLDecodeFn,
+		},
+		Function{
+			Name:        "hexEncode",
+			Signature:   "hexEncode(s)",
+			Description: "Encodes a string as hexadecimal",
+			Fn:          hexEncodeFn,
+		},
+		Function{
+			Name:        "hexDecode",
+			Signature:   "hexDecode(s)",
+			Description: "Decodes a hexadecimal string",
+			Fn:          hexDecodeFn,
+		},
+		Function{
+			Name:        "sha256",
+			Signature:   "sha256(s)",
+			Description: "Returns the hex-encoded SHA-256 digest of a string",
+			Fn:          sha256Fn,
+		},
+		Function{
+			Name:        "sha512",
+			Signature:   "sha512(s)",
+			Description: "Returns the hex-encoded SHA-512 digest of a string",
+			Fn:          sha512Fn,
+		},
+		Function{
+			Name:        "hmacSha256",
+			Signature:   "hmacSha256(key, message)",
+			Description: "Returns the hex-encoded HMAC-SHA256 of a message",
+			Fn:          hmacSha256Fn,
+		},
+	)
+}
+
+func singleStringArg(name string, params []any) (string, error) {
+	if err := expectArgs(name, params, 1, 1); err != nil {
+		return "", err
+	}
+
+	return stringArg(name, params, 0)
+}
+
+func base64URLEncodeFn(params ...any) (any, error) {
+	s, err := singleStringArg("base64URLEncode", params)
+	if err != nil {
+		return nil, err
+	}
+
+	return base64.RawURLEncoding.EncodeToString([]byte(s)), nil
+}
+
+func base64URLDecodeFn(params ...any) (any, error) {
+	s, err := singleStringArg("base64URLDecode", params)
+	if err != nil {
+		return nil, err
+	}
+
+	decoded, err := base64.RawURLEncoding.DecodeString(trimPadding(s))
+	if err != nil {
+		return nil, fmt.Errorf("base64URLDecode(): %w", err)
+	}
+
+	return string(decoded), nil
+}
+
+func trimPadding(s string) string {
+	for len(s) > 0 && s[len(s)-1] == '=' {
+		s = s[:len(s)-1]
+	}
+
+	return s
+}
+
+func hexEncodeFn(params ...any) (any, error) {
+	s, err := singleStringArg("hexEncode", params)
+	if err != nil {
+		return nil, err
+	}
+
+	return hex.EncodeToString([]byte(s)), nil
+}
+
+func hexDecodeFn(params ...any) (any, error) {
+	s, err := singleStringArg("hexDecode", params)
+	if err != nil {
+		return nil, err
+	}
+
+	decoded, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("hexDecode(): %w", err)
+	}
+
+	return string(decoded), nil
+}
+
+func sha256Fn(params ...any) (any, error) {
+	s, err := singleStringArg("sha256", params)
+	if err != nil {
+		return nil, err
+	}
+
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func sha512Fn(params ...any) (any, error) {
+	s, err := singleStringArg("sha512", params)
+	if err != nil {
+		return nil, err
+	}
+
+	sum := sha512.Sum512([]byte(s))
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func hmacSha256Fn(params ...any) (any, error) {
+	if err := expectArgs("hmacSha256", params, 2, 2); err != nil {
+		return nil, err
+	}
+
+	key, err := stringArg("hmacSha256", params, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	message, err := stringArg("hmacSha256", params, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(message))
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
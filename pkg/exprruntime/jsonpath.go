@@ -0,0 +1,242 @@
+package exprruntime
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+func init() {
+	register(
+		Function{
+			Name:        "jsonPath",
+			Signature:   "jsonPath(value, path)",
+			Description: `Queries a value, or a JSON string, with a JSON path like "$.items[0].name", "$.items[*].id" or "$['key with spaces']"`,
+			Fn:          jsonPathFn,
+		},
+	)
+}
+
+type jsonPathSegment struct {
+	Key      string
+	Index    *int
+	Wildcard bool
+}
+
+func jsonPathFn(params ...any) (any, error) {
+	if err := expectArgs("jsonPath", params, 2, 2); err != nil {
+		return nil, err
+	}
+
+	path, err := stringArg("jsonPath", params, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	value := params[0]
+	if s, ok := value.(string); ok {
+		if err := json.Unmarshal([]byte(s), &value); err != nil {
+			return nil, fmt.Errorf("jsonPath(): value is not valid JSON: %w", err)
+		}
+	}
+
+	segments, err := parseJSONPath(path)
+	if err != nil {
+		return nil, fmt.Errorf("jsonPath(): %w", err)
+	}
+
+	return evaluateJSONPath(value, segments)
+}
+
+// evaluateJSONPath returns a single value for definite paths,
+// and a list with every match if the path contains a wildcard.
+func evaluateJSONPath(value any, segments []jsonPathSegment) (any, error) {
+	nodes := []any{value}
+	wildcard := false
+
+	for _, segment := range segments {
+		next := []any{}
+		for _, node := range nodes {
+			if segment.Wildcard {
+				wildcard = true
+				next = append(next, jsonPathChildren(node)...)
+				continue
+			}
+
+			key := segment.Key
+			if segment.Index != nil {
+				key = strconv.Itoa(*segment.Index)
+			}
+
+			child, err := jsonPathChild(node, key)
+			if err != nil {
+				return nil, fmt.Errorf("jsonPath(): %w", err)
+			}
+
+			if child != nil || !wildcard {
+				next = append(next, child)
+			}
+		}
+
+		nodes = next
+	}
+
+	if wildcard {
+		return nodes, nil
+	}
+
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+
+	return nodes[0], nil
+}
+
+func parseJSONPath(path string) ([]jsonPathSegment, error) {
+	path = strings.TrimSpace(path)
+	if !strings.HasPrefix(path, "$") {
+		return nil, fmt.Errorf("path %q must start with $", path)
+	}
+
+	segments := []jsonPathSegment{}
+	rest := path[1:]
+	for len(rest) > 0 {
+		switch rest[0] {
+		case '.':
+			rest = rest[1:]
+			end := strings.IndexAny(rest, ".[")
+			if end == -1 {
+				end = len(rest)
+			}
+
+			key := rest[:end]
+			rest = rest[end:]
+			if key == "" {
+				return nil, fmt.Errorf("empty key in path %q", path)
+			}
+
+			if key == "*" {
+				segments = append(segments, jsonPathSegment{Wildcard: true})
+				continue
+			}
+
+			segments = append(segments, jsonPathSegment{Key: key})
+
+		case '[':
+			end := strings.Index(rest, "]")
+			if end == -1 {
+				return nil, fmt.Errorf("unclosed bracket in path %q", path)
+			}
+
+			segment, err := parseJSONPathBracket(rest[1:end])
+			if err != nil {
+				return nil, fmt.Errorf("%w in path %q", err, path)
+			}
+
+			segments = append(segments, segment)
+			rest = rest[end+1:]
+
+		default:
+			return nil, fmt.Errorf("unexpected %q in path %q", rest[0], path)
+		}
+	}
+
+	return segments, nil
+}
+
+func parseJSONPathBracket(content string) (jsonPathSegment, error) {
+	content = strings.TrimSpace(content)
+	if content == "*" {
+		return jsonPathSegment{Wildcard: true}, nil
+	}
+
+	if len(content) >= 2 && (content[0] == '\'' || content[0] == '"') && content[len(content)-1] == content[0] {
+		return jsonPathSegment{Key: content[1 : len(content)-1]}, nil
+	}
+
+	index, err := strconv.Atoi(content)
+	if err != nil {
+		return jsonPathSegment{}, fmt.Errorf("invalid index %q", content)
+	}
+
+	return jsonPathSegment{Index: &index}, nil
+}
+
+// jsonPathChild returns the value under a key of a map,
+// or under an index of a list. Negative indexes count from the end.
+// Missing keys and out of range indexes return nil.
+func jsonPathChild(value any, key string) (any, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return nil, nil
+		}
+
+		item := v.MapIndex(reflect.ValueOf(key).Convert(v.Type().Key()))
+		if !item.IsValid() {
+			return nil, nil
+		}
+
+		return item.Interface(), nil
+
+	case reflect.Slice, reflect.Array:
+		index, err := strconv.Atoi(key)
+		if err != nil {
+			return nil, fmt.Errorf("cannot access key %q of a list", key)
+		}
+
+		if index < 0 {
+			index += v.Len()
+		}
+
+		if index < 0 || index >= v.Len() {
+			return nil, nil
+		}
+
+		return v.Index(index).Interface(), nil
+
+	default:
+		return nil, nil
+	}
+}
+
+// jsonPathChildren returns every value of a map, sorted by key,
+// or every item of a list.
+func jsonPathChildren(value any) []any {
+	if value == nil {
+		return nil
+	}
+
+	children := []any{}
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Map:
+		keys := v.MapKeys()
+		sortedKeys := make([]string, 0, len(keys))
+		for _, key := range keys {
+			if key.Kind() == reflect.String {
+				sortedKeys = append(sortedKeys, key.String())
+			}
+		}
+
+		sort.Strings(sortedKeys)
+		for _, key := range sortedKeys {
+			children = append(children, v.MapIndex(reflect.ValueOf(key).Convert(v.Type().Key())).Interface())
+		}
+
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			children = append(children, v.Index(i).Interface())
+		}
+	}
+
+	return children
+}
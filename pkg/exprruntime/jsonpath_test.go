@@ -0,0 +1,58 @@
+package exprruntime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONPath(t *testing.T) {
+	env := map[string]any{
+		"data": map[string]any{
+			"items": []any{
+				map[string]any{"id": 1, "name": "first"},
+				map[string]any{"id": 2, "name": "second", "tags": []any{"x"}},
+			},
+			"key with spaces": "value",
+		},
+		"raw": `{"a": {"b": [10, 20, 30]}}`,
+	}
+
+	testCases := []struct {
+		expression string
+		expected   any
+	}{
+		{`jsonPath(data, "$.items[0].name")`, "first"},
+		{`jsonPath(data, "$.items[-1].id")`, 2},
+		{`jsonPath(data, "$['key with spaces']")`, "value"},
+		{`jsonPath(data, "$.items[*].name")`, []any{"first", "second"}},
+		{`jsonPath(data, "$.items[*].tags[0]")`, []any{"x"}},
+		{`jsonPath(data, "$.missing.key")`, nil},
+		{`jsonPath(data, "$.items[5]")`, nil},
+		{`jsonPath(raw, "$.a.b[1]")`, float64(20)},
+		{`jsonPath(raw, "$.a.*")`, []any{[]any{float64(10), float64(20), float64(30)}}},
+		{`jsonPath(data, "$")`, env["data"]},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.expression, func(t *testing.T) {
+			out, err := evaluate(t, tc.expression, env)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, out)
+		})
+	}
+
+	t.Run("invalid path returns error", func(t *testing.T) {
+		_, err := evaluate(t, `jsonPath(data, "items[0")`, env)
+		require.ErrorContains(t, err, "must start with $")
+
+		_, err = evaluate(t, `jsonPath(data, "$.items[0")`, env)
+		require.ErrorContains(t, err, "unclosed bracket")
+	})
+
+	t.Run("invalid JSON string returns error", func(t *testing.T) {
+		_, err := evaluate(t, `jsonPath("{", "$.a")`, env)
+		require.ErrorContains(t, err, "not valid JSON")
+	})
+}
@@ -0,0 +1,83 @@
+package exprruntime
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/expr-lang/expr"
+	"github.com/expr-lang/expr/conf"
+)
+
+// Function is a function available in every expression,
+// on top of the ones expr already provides.
+type Function struct {
+	Name        string
+	Signature   string
+	Description string
+	Fn          func(params ...any) (any, error)
+}
+
+// functions holds the curated library of functions,
+// registered by the files in this package.
+var functions = map[string]Function{}
+
+func register(fns ...Function) {
+	for _, fn := range fns {
+		if _, ok := functions[fn.Name]; ok {
+			panic(fmt.Sprintf("expression function %s registered twice", fn.Name))
+		}
+
+		functions[fn.Name] = fn
+	}
+}
+
+// Functions returns the functions in the library, sorted by name.
+func Functions() []Function {
+	result := make([]Function, 0, len(functions))
+	for _, fn := range functions {
+		result = append(result, fn)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+
+	return result
+}
+
+// StandardLibrary registers the date() override and every function in the library.
+// It should be used everywhere expressions are compiled,
+// so the same functions are available in every expression.
+func StandardLibrary() expr.Option {
+	options := []expr.Option{DateFunctionOption()}
+	for _, fn := range Functions() {
+		options = append(options, expr.Function(fn.Name, fn.Fn))
+	}
+
+	return func(c *conf.Config) {
+		for _, option := range options {
+			option(c)
+		}
+	}
+}
+
+func expectArgs(name string, params []any, min, max int) error {
+	if len(params) >= min && len(params) <= max {
+		return nil
+	}
+
+	if min == max {
+		return fmt.Errorf("%s() expects %d argument(s), got %d", name, min, len(params))
+	}
+
+	return fmt.Errorf("%s() expects %d to %d arguments, got %d", name, min, max, len(params))
+}
+
+func stringArg(name string, params []any, i int) (string, error) {
+	s, ok := params[i].(string)
+	if !ok {
+		return "", fmt.Errorf("%s() expects argument %d to be a string, got %T", name, i+1, params[i])
+	}
+
+	return s, nil
+}
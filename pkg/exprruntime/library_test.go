@@ -0,0 +1,188 @@
+package exprruntime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/expr-lang/expr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func evaluate(t *testing.T, expression string, env map[string]any) (any, error) {
+	t.Helper()
+
+	program, err := expr.Compile(expression, expr.Env(env), expr.AsAny(), StandardLibrary())
+	require.NoError(t, err)
+	return expr.Run(program, env)
+}
+
+func TestStandardLibrary(t *testing.T) {
+	t.Run("every function has a signature and description", func(t *testing.T) {
+		fns := Functions()
+		require.NotEmpty(t, fns)
+		for _, fn := range fns {
+			assert.NotEmpty(t, fn.Signature, fn.Name)
+			assert.NotEmpty(t, fn.Description, fn.Name)
+			assert.NotNil(t, fn.Fn, fn.Name)
+		}
+	})
+
+	t.Run("date() override is included", func(t *testing.T) {
+		out, err := evaluate(t, `date("2026-03-17").Format("2006-01-02T15:04:05Z07:00")`, map[string]any{})
+		require.NoError(t, err)
+		assert.Equal(t, "2026-03-17T00:00:00Z", out)
+	})
+
+	t.Run("wrong number of arguments returns error", func(t *testing.T) {
+		_, err := evaluate(t, `sha256("a", "b")`, map[string]any{})
+		require.ErrorContains(t, err, "sha256() expects 1 argument(s), got 2")
+	})
+}
+
+func TestRegexFunctions(t *testing.T) {
+	env := map[string]any{"ref": "refs/tags/v1.2.3"}
+
+	testCases := []struct {
+		expression string
+		expected   any
+	}{
+		{`regexMatch(ref, "^refs/tags/")`, true},
+		{`regexMatch(ref, "^refs/heads/")`, false},
+		{`regexFind(ref, "v[0-9.]+")`, "v1.2.3"},
+		{`regexFind(ref, "nope")`, ""},
+		{`regexFindAll("a1b22c333", "[0-9]+")`, []any{"1", "22", "333"}},
+		{`regexExtract(ref, "^refs/(?P<kind>[a-z]+)/(.*)$")`, map[string]any{"kind": "tags", "2": "v1.2.3"}},
+		{`regexExtract(ref, "^refs/heads/(.*)$")`, map[string]any{}},
+		{`regexReplace(ref, "^refs/tags/v(.*)$", "release-$1")`, "release-1.2.3"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.expression, func(t *testing.T) {
+			out, err := evaluate(t, tc.expression, env)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, out)
+		})
+	}
+
+	t.Run("invalid pattern returns error", func(t *testing.T) {
+		_, err := evaluate(t, `regexMatch(ref, "(")`, env)
+		require.ErrorContains(t, err, "invalid regular expression")
+	})
+}
+
+func TestEncodingFunctions(t *testing.T) {
+	testCases := []struct {
+		expression string
+		expected   any
+	}{
+		{`base64URLEncode("hello?>")`, "aGVsbG8_Pg"},
+		{`base64URLDecode("aGVsbG8_Pg")`, "hello?>"},
+		{`base64URLDecode("aGVsbG8_Pg==")`, "hello?>"},
+		{`hexEncode("hi")`, "6869"},
+		{`hexDecode("6869")`, "hi"},
+		{`sha256("abc")`, "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"},
+		{`len(sha512("abc"))`, 128},
+		{`hmacSha256("key", "The quick brown fox jumps over the lazy dog")`, "f7bc83f430538424b13298e6aa6fb143ef4d59a14946175997479dbc2d1a3cd8"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.expression, func(t *testing.T) {
+			out, err := evaluate(t, tc.expression, map[string]any{})
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, out)
+		})
+	}
+
+	t.Run("invalid hex returns error", func(t *testing.T) {
+		_, err := evaluate(t, `hexDecode("zz")`, map[string]any{})
+		require.ErrorContains(t, err, "hexDecode()")
+	})
+}
+
+func TestURLFunctions(t *testing.T) {
+	out, err := evaluate(t, `urlParse("https://bot@example.com:8443/hooks/run?env=prod&tag=a&tag=b#top")`, map[string]any{})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"scheme":   "https",
+		"host":     "example.com:8443",
+		"hostname": "example.com",
+		"port":     "8443",
+		"path":     "/hooks/run",
+		"rawQuery": "env=prod&tag=a&tag=b",
+		"query":    map[string]any{"env": "prod", "tag": []any{"a", "b"}},
+		"fragment": "top",
+		"user":     "bot",
+	}, out)
+
+	out, err = evaluate(t, `urlEncode("a b&c")`, map[string]any{})
+	require.NoError(t, err)
+	assert.Equal(t, "a+b%26c", out)
+
+	out, err = evaluate(t, `urlDecode("a+b%26c")`, map[string]any{})
+	require.NoError(t, err)
+	assert.Equal(t, "a b&c", out)
+}
+
+func TestDurationFunctions(t *testing.T) {
+	env := map[string]any{
+		"createdAt": "2026-03-17T10:00:00Z",
+		"past":      time.Now().Add(-2 * time.Hour).Format(time.RFC3339),
+		"future":    time.Now().Add(3 * time.Hour).Format(time.RFC3339),
+	}
+
+	testCases := []struct {
+		expression string
+		expected   any
+	}{
+		{`parseDuration("1h30m")`, 90 * time.Minute},
+		{`parseDuration("2d")`, 48 * time.Hour},
+		{`parseDuration("1w1d")`, 8 * 24 * time.Hour},
+		{`durationSeconds("1m30s")`, 90.0},
+		{`addDuration(createdAt, "1d").Format("2006-01-02T15:04:05Z07:00")`, "2026-03-18T10:00:00Z"},
+		{`addDuration(createdAt, "-2h").Format("2006-01-02T15:04:05Z07:00")`, "2026-03-17T08:00:00Z"},
+		{`addDuration(date(createdAt), duration("30m")).Format("15:04")`, "10:30"},
+		{`since(past) > duration("1h")`, true},
+		{`until(future) > duration("2h")`, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.expression, func(t *testing.T) {
+			out, err := evaluate(t, tc.expression, env)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, out)
+		})
+	}
+
+	t.Run("invalid duration returns error", func(t *testing.T) {
+		_, err := evaluate(t, `parseDuration("soon")`, env)
+		require.ErrorContains(t, err, `invalid duration "soon"`)
+	})
+}
+
+func TestTemplateFunctions(t *testing.T) {
+	env := map[string]any{
+		"app":  map[string]any{"name": "api", "regions": []any{"us-east-1", "eu-west-1"}},
+		"env":  "prod",
+		"tags": []any{"a", "b"},
+	}
+
+	testCases := []struct {
+		expression string
+		expected   any
+	}{
+		{`template("Deploy ${app.name} to ${env}", $env)`, "Deploy api to prod"},
+		{`template("First region: ${ app.regions.0 }", $env)`, "First region: us-east-1"},
+		{`template("Missing: [${app.owner}]", $env)`, "Missing: []"},
+		{`template("Tags: ${tags}", $env)`, `Tags: ["a","b"]`},
+		{`sprintf("%s-%03d", env, 7)`, "prod-007"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.expression, func(t *testing.T) {
+			out, err := evaluate(t, tc.expression, env)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, out)
+		})
+	}
+}
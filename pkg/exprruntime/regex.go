@@ -0,0 +1,170 @@
+package exprruntime
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"sync"
+)
+
+func init() {
+	register(
+		Function{
+			Name:        "regexMatch",
+			Signature:   "regexMatch(s, pattern)",
+			Description: "Checks if a string matches a regular expression",
+			Fn:          regexMatchFn,
+		},
+		Function{
+			Name:        "regexFind",
+			Signature:   "regexFind(s, pattern)",
+			Description: "Returns the first match of a regular expression, or an empty string",
+			Fn:          regexFindFn,
+		},
+		Function{
+			Name:        "regexFindAll",
+			Signature:   "regexFindAll(s, pattern)",
+			Description: "Returns every match of a regular expression",
+			Fn:          regexFindAllFn,
+		},
+		Function{
+			Name:        "regexExtract",
+			Signature:   "regexExtract(s, pattern)",
+			Description: `Returns the capture groups of the first match, keyed by name, or by position for unnamed groups ("1", "2", ...)`,
+			Fn:          regexExtractFn,
+		},
+		Function{
+			Name:        "regexReplace",
+			Signature:   "regexReplace(s, pattern, replacement)",
+			Description: "Replaces every match of a regular expression. The replacement can reference groups with $1 or ${name}",
+			Fn:          regexReplaceFn,
+		},
+	)
+}
+
+// Expressions are evaluated repeatedly with the same patterns,
+// so compiled regular expressions are cached, up to a limit.
+var (
+	regexCache      = map[string]*regexp.Regexp{}
+	regexCacheMutex sync.RWMutex
+)
+
+const regexCacheLimit = 1000
+
+func compileRegex(name string, pattern string) (*regexp.Regexp, error) {
+	regexCacheMutex.RLock()
+	re, ok := regexCache[pattern]
+	regexCacheMutex.RUnlock()
+	if ok {
+		return re, nil
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("%s(): invalid regular expression %q: %w", name, pattern, err)
+	}
+
+	regexCacheMutex.Lock()
+	if len(regexCache) < regexCacheLimit {
+		regexCache[pattern] = re
+	}
+	regexCacheMutex.Unlock()
+
+	return re, nil
+}
+
+func regexArgs(name string, params []any, count int) (string, *regexp.Regexp, error) {
+	if err := expectArgs(name, params, count, count); err != nil {
+		return "", nil, err
+	}
+
+	s, err := stringArg(name, params, 0)
+	if err != nil {
+		return "", nil, err
+	}
+
+	pattern, err := stringArg(name, params, 1)
+	if err != nil {
+		return "", nil, err
+	}
+
+	re, err := compileRegex(name, pattern)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return s, re, nil
+}
+
+func regexMatchFn(params ...any) (any, error) {
+	s, re, err := regexArgs("regexMatch", params, 2)
+	if err != nil {
+		return nil, err
+	}
+
+	return re.MatchString(s), nil
+}
+
+func regexFindFn(params ...any) (any, error) {
+	s, re, err := regexArgs("regexFind", params, 2)
+	if err != nil {
+		return nil, err
+	}
+
+	return re.FindString(s), nil
+}
+
+func regexFindAllFn(params ...any) (any, error) {
+	s, re, err := regexArgs("regexFindAll", params, 2)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []any{}
+	for _, match := range re.FindAllString(s, -1) {
+		result = append(result, match)
+	}
+
+	return result, nil
+}
+
+func regexExtractFn(params ...any) (any, error) {
+	s, re, err := regexArgs("regexExtract", params, 2)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := map[string]any{}
+	matches := re.FindStringSubmatch(s)
+	if matches == nil {
+		return groups, nil
+	}
+
+	for i, groupName := range re.SubexpNames() {
+		if i == 0 {
+			continue
+		}
+
+		if groupName == "" {
+			groupName = strconv.Itoa(i)
+		}
+
+		groups[groupName] = matches[i]
+	}
+
+	return groups, nil
+}
+
+func regexReplaceFn(params ...any) (any, error) {
+	s, re, err := regexArgs("regexReplace", params, 3)
+	if err != nil {
+		return nil, err
+	}
+
+	replacement, err := stringArg("regexReplace", params, 2)
+	if err != nil {
+		return nil, err
+	}
+
+	return re.ReplaceAllString(s, replacement), nil
+}
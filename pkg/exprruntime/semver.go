@@ -0,0 +1,362 @@
+package exprruntime
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+func init() {
+	register(
+		Function{
+			Name:        "semver",
+			Signature:   "semver(version)",
+			Description: "Parses a semantic version, returning its major, minor, patch, prerelease and build parts",
+			Fn:          semverFn,
+		},
+		Function{
+			Name:        "semverCompare",
+			Signature:   "semverCompare(a, b)",
+			Description: "Compares two semantic versions, returning -1, 0 or 1",
+			Fn:          semverCompareFn,
+		},
+		Function{
+			Name:        "semverSatisfies",
+			Signature:   "semverSatisfies(version, constraint)",
+			Description: `Checks if a semantic version satisfies a constraint, e.g. ">=1.2.0 <2.0.0", "^1.4", "~1.4.2" or "1.x || 2.x"`,
+			Fn:          semverSatisfiesFn,
+		},
+	)
+}
+
+var semverPattern = regexp.MustCompile(`^v?(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)(?:-([0-9A-Za-z.-]+))?(?:\+([0-9A-Za-z.-]+))?$`)
+
+type version struct {
+	Major      int64
+	Minor      int64
+	Patch      int64
+	Prerelease []string
+	Build      string
+}
+
+func parseVersion(s string) (*version, error) {
+	matches := semverPattern.FindStringSubmatch(strings.TrimSpace(s))
+	if matches == nil {
+		return nil, fmt.Errorf("invalid semantic version %q", s)
+	}
+
+	v := &version{Build: matches[5]}
+	v.Major, _ = strconv.ParseInt(matches[1], 10, 64)
+	v.Minor, _ = strconv.ParseInt(matches[2], 10, 64)
+	v.Patch, _ = strconv.ParseInt(matches[3], 10, 64)
+
+	if matches[4] != "" {
+		v.Prerelease = strings.Split(matches[4], ".")
+		for _, identifier := range v.Prerelease {
+			if identifier == "" {
+				return nil, fmt.Errorf("invalid semantic version %q", s)
+			}
+		}
+	}
+
+	return v, nil
+}
+
+func (v *version) String() string {
+	s := fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+	if len(v.Prerelease) > 0 {
+		s += "-" + strings.Join(v.Prerelease, ".")
+	}
+
+	if v.Build != "" {
+		s += "+" + v.Build
+	}
+
+	return s
+}
+
+// compare follows the precedence rules from the semver 2.0 spec.
+// Build metadata is ignored.
+func (v *version) compare(other *version) int {
+	for _, pair := range [][2]int64{{v.Major, other.Major}, {v.Minor, other.Minor}, {v.Patch, other.Patch}} {
+		if pair[0] != pair[1] {
+			if pair[0] < pair[1] {
+				return -1
+			}
+			return 1
+		}
+	}
+
+	//
+	// A version without prerelease has higher precedence
+	// than the same version with one.
+	//
+	if len(v.Prerelease) == 0 || len(other.Prerelease) == 0 {
+		switch {
+		case len(v.Prerelease) == len(other.Prerelease):
+			return 0
+		case len(v.Prerelease) == 0:
+			return 1
+		default:
+			return -1
+		}
+	}
+
+	for i := 0; i < len(v.Prerelease) && i < len(other.Prerelease); i++ {
+		if c := compareIdentifier(v.Prerelease[i], other.Prerelease[i]); c != 0 {
+			return c
+		}
+	}
+
+	switch {
+	case len(v.Prerelease) < len(other.Prerelease):
+		return -1
+	case len(v.Prerelease) > len(other.Prerelease):
+		return 1
+	default:
+		return 0
+	}
+}
+
+func compareIdentifier(a, b string) int {
+	aNum, aErr := strconv.ParseInt(a, 10, 64)
+	bNum, bErr := strconv.ParseInt(b, 10, 64)
+
+	switch {
+	case aErr == nil && bErr == nil:
+		if aNum < bNum {
+			return -1
+		}
+		if aNum > bNum {
+			return 1
+		}
+		return 0
+	case aErr == nil:
+		return -1
+	case bErr == nil:
+		return 1
+	default:
+		return strings.Compare(a, b)
+	}
+}
+
+func semverFn(params ...any) (any, error) {
+	if err := expectArgs("semver", params, 1, 1); err != nil {
+		return nil, err
+	}
+
+	s, err := stringArg("semver", params, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := parseVersion(s)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		"major":      int(v.Major),
+		"minor":      int(v.Minor),
+		"patch":      int(v.Patch),
+		"prerelease": strings.Join(v.Prerelease, "."),
+		"build":      v.Build,
+		"version":    v.String(),
+	}, nil
+}
+
+func semverCompareFn(params ...any) (any, error) {
+	if err := expectArgs("semverCompare", params, 2, 2); err != nil {
+		return nil, err
+	}
+
+	versions := make([]*version, 2)
+	for i := range versions {
+		s, err := stringArg("semverCompare", params, i)
+		if err != nil {
+			return nil, err
+		}
+
+		versions[i], err = parseVersion(s)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return versions[0].compare(versions[1]), nil
+}
+
+func semverSatisfiesFn(params ...any) (any, error) {
+	if err := expectArgs("semverSatisfies", params, 2, 2); err != nil {
+		return nil, err
+	}
+
+	s, err := stringArg("semverSatisfies", params, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	constraint, err := stringArg("semverSatisfies", params, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := parseVersion(s)
+	if err != nil {
+		return nil, err
+	}
+
+	return satisfies(v, constraint)
+}
+
+// satisfies checks a version against a constraint.
+// Ranges separated by "||" are alternatives,
+// and comparisons separated by spaces within a range must all match.
+func satisfies(v *version, constraint string) (bool, error) {
+	for _, alternative := range strings.Split(constraint, "||") {
+		comparisons := strings.Fields(alternative)
+		if len(comparisons) == 0 {
+			return false, fmt.Errorf("invalid constraint %q", constraint)
+		}
+
+		matched := true
+		for _, comparison := range comparisons {
+			ok, err := satisfiesComparison(v, comparison)
+			if err != nil {
+				return false, err
+			}
+
+			if !ok {
+				matched = false
+				break
+			}
+		}
+
+		if matched {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+var comparisonPattern = regexp.MustCompile(`^(>=|<=|!=|==|=|>|<|\^|~)?v?(.+)$`)
+
+func satisfiesComparison(v *version, comparison string) (bool, error) {
+	matches := comparisonPattern.FindStringSubmatch(comparison)
+	if matches == nil {
+		return false, fmt.Errorf("invalid constraint %q", comparison)
+	}
+
+	operator := matches[1]
+	lower, upper, exact, err := parseBound(matches[2])
+	if err != nil {
+		return false, err
+	}
+
+	switch operator {
+	case "", "=", "==":
+		if exact {
+			return v.compare(lower) == 0, nil
+		}
+		return v.compare(lower) >= 0 && v.compare(upper) < 0, nil
+	case "!=":
+		if exact {
+			return v.compare(lower) != 0, nil
+		}
+		return v.compare(lower) < 0 || v.compare(upper) >= 0, nil
+	case ">":
+		if exact {
+			return v.compare(lower) > 0, nil
+		}
+		return v.compare(upper) >= 0, nil
+	case ">=":
+		return v.compare(lower) >= 0, nil
+	case "<":
+		return v.compare(lower) < 0, nil
+	case "<=":
+		if exact {
+			return v.compare(lower) <= 0, nil
+		}
+		return v.compare(upper) < 0, nil
+	case "^":
+		return v.compare(lower) >= 0 && v.compare(caretUpper(lower)) < 0, nil
+	case "~":
+		return v.compare(lower) >= 0 && v.compare(tildeUpper(lower, matches[2])) < 0, nil
+	}
+
+	return false, fmt.Errorf("invalid constraint %q", comparison)
+}
+
+// parseBound parses a possibly partial version, like "1", "1.2", "1.x" or "1.2.3".
+// Partial versions match a range, from lower (inclusive) to upper (exclusive).
+func parseBound(s string) (lower *version, upper *version, exact bool, err error) {
+	if v, err := parseVersion(s); err == nil {
+		return v, v, true, nil
+	}
+
+	parts := strings.Split(s, ".")
+	if len(parts) > 3 {
+		return nil, nil, false, fmt.Errorf("invalid version %q in constraint", s)
+	}
+
+	numbers := []int64{}
+	for _, part := range parts {
+		if part == "x" || part == "X" || part == "*" {
+			break
+		}
+
+		n, err := strconv.ParseInt(part, 10, 64)
+		if err != nil || n < 0 {
+			return nil, nil, false, fmt.Errorf("invalid version %q in constraint", s)
+		}
+
+		numbers = append(numbers, n)
+	}
+
+	lower = &version{}
+	upper = &version{}
+	switch len(numbers) {
+	case 0:
+		upper = &version{Major: 1 << 62}
+	case 1:
+		lower.Major = numbers[0]
+		upper.Major = numbers[0] + 1
+	case 2:
+		lower.Major, lower.Minor = numbers[0], numbers[1]
+		upper.Major, upper.Minor = numbers[0], numbers[1]+1
+	case 3:
+		lower.Major, lower.Minor, lower.Patch = numbers[0], numbers[1], numbers[2]
+		return lower, lower, true, nil
+	}
+
+	//
+	// Prerelease versions are lower than their release,
+	// so the upper bound excludes prereleases of the next version too.
+	//
+	upper.Prerelease = []string{"0"}
+	return lower, upper, false, nil
+}
+
+// caretUpper allows changes that do not modify the left-most non-zero number.
+func caretUpper(v *version) *version {
+	switch {
+	case v.Major > 0:
+		return &version{Major: v.Major + 1, Prerelease: []string{"0"}}
+	case v.Minor > 0:
+		return &version{Minor: v.Minor + 1, Prerelease: []string{"0"}}
+	default:
+		return &version{Patch: v.Patch + 1, Prerelease: []string{"0"}}
+	}
+}
+
+// tildeUpper allows patch changes if a minor version is given,
+// and minor changes otherwise.
+func tildeUpper(v *version, raw string) *version {
+	if len(strings.Split(raw, ".")) == 1 {
+		return &version{Major: v.Major + 1, Prerelease: []string{"0"}}
+	}
+
+	return &version{Major: v.Major, Minor: v.Minor + 1, Prerelease: []string{"0"}}
+}
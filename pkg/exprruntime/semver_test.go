@@ -0,0 +1,92 @@
+package exprruntime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSemver(t *testing.T) {
+	out, err := evaluate(t, `semver("v1.2.3-rc.1+build.5")`, map[string]any{})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"major":      1,
+		"minor":      2,
+		"patch":      3,
+		"prerelease": "rc.1",
+		"build":      "build.5",
+		"version":    "1.2.3-rc.1+build.5",
+	}, out)
+
+	_, err = evaluate(t, `semver("1.2")`, map[string]any{})
+	require.ErrorContains(t, err, `invalid semantic version "1.2"`)
+}
+
+func TestSemverCompare(t *testing.T) {
+	testCases := []struct {
+		a, b     string
+		expected int
+	}{
+		{"1.2.3", "1.2.3", 0},
+		{"v1.2.3", "1.2.3+build", 0},
+		{"1.2.3", "1.10.0", -1},
+		{"2.0.0", "1.99.99", 1},
+		{"1.0.0-alpha", "1.0.0", -1},
+		{"1.0.0-alpha", "1.0.0-alpha.1", -1},
+		{"1.0.0-alpha.1", "1.0.0-alpha.beta", -1},
+		{"1.0.0-beta.2", "1.0.0-beta.11", -1},
+		{"1.0.0-rc.1", "1.0.0-beta.11", 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.a+" vs "+tc.b, func(t *testing.T) {
+			out, err := evaluate(t, `semverCompare(a, b)`, map[string]any{"a": tc.a, "b": tc.b})
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, out)
+		})
+	}
+}
+
+func TestSemverSatisfies(t *testing.T) {
+	testCases := []struct {
+		version    string
+		constraint string
+		expected   bool
+	}{
+		{"1.2.3", "1.2.3", true},
+		{"1.2.3", "=1.2.4", false},
+		{"1.2.3", "!=1.2.4", true},
+		{"1.2.3", ">=1.2.0 <2.0.0", true},
+		{"2.0.0", ">=1.2.0 <2.0.0", false},
+		{"2.0.0-rc.1", "<2.0.0", true},
+		{"1.9.0", "^1.4", true},
+		{"2.0.0", "^1.4", false},
+		{"0.2.5", "^0.2.3", true},
+		{"0.3.0", "^0.2.3", false},
+		{"1.4.9", "~1.4.2", true},
+		{"1.5.0", "~1.4.2", false},
+		{"1.9.0", "~1", true},
+		{"1.7.1", "1.x", true},
+		{"1.7.1", "1.7", true},
+		{"1.8.0", "1.7", false},
+		{"1.8.0", ">1.7", true},
+		{"1.7.9", "<=1.7", true},
+		{"3.0.0", "1.x || 2.x", false},
+		{"2.1.0", "1.x || 2.x", true},
+		{"5.0.0", "*", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.version+" "+tc.constraint, func(t *testing.T) {
+			out, err := evaluate(t, `semverSatisfies(v, c)`, map[string]any{"v": tc.version, "c": tc.constraint})
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, out)
+		})
+	}
+
+	t.Run("invalid constraint returns error", func(t *testing.T) {
+		_, err := evaluate(t, `semverSatisfies("1.0.0", ">=a.b")`, map[string]any{})
+		require.ErrorContains(t, err, "invalid version")
+	})
+}
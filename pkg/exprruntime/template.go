@@ -0,0 +1,106 @@
+package exprruntime
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+func init() {
+	register(
+		Function{
+			Name:        "template",
+			Signature:   "template(text, data)",
+			Description: `Replaces ${path} placeholders in a text with values from data, e.g. template("Deploy ${app.name} to ${env}", $)`,
+			Fn:          templateFn,
+		},
+		Function{
+			Name:        "sprintf",
+			Signature:   "sprintf(format, args...)",
+			Description: `Formats a string using Go's fmt verbs, e.g. sprintf("%s-%03d", name, n)`,
+			Fn:          sprintfFn,
+		},
+	)
+}
+
+var templatePlaceholder = regexp.MustCompile(`\$\{\s*([^{}]+?)\s*\}`)
+
+func templateFn(params ...any) (any, error) {
+	if err := expectArgs("template", params, 2, 2); err != nil {
+		return nil, err
+	}
+
+	text, err := stringArg("template", params, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var renderErr error
+	result := templatePlaceholder.ReplaceAllStringFunc(text, func(placeholder string) string {
+		path := templatePlaceholder.FindStringSubmatch(placeholder)[1]
+		value, err := lookupPath(params[1], path)
+		if err != nil {
+			renderErr = err
+			return placeholder
+		}
+
+		return templateValue(value)
+	})
+
+	if renderErr != nil {
+		return nil, fmt.Errorf("template(): %w", renderErr)
+	}
+
+	return result, nil
+}
+
+// lookupPath resolves dotted paths, like "a.b.0.c", against maps and lists.
+// Missing keys resolve to nil, so they render as empty strings.
+func lookupPath(data any, path string) (any, error) {
+	current := data
+	for _, key := range strings.Split(path, ".") {
+		if key == "" {
+			return nil, fmt.Errorf("invalid placeholder ${%s}", path)
+		}
+
+		next, err := jsonPathChild(current, key)
+		if err != nil {
+			return nil, err
+		}
+
+		current = next
+	}
+
+	return current, nil
+}
+
+func templateValue(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case map[string]any, []any:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(data)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func sprintfFn(params ...any) (any, error) {
+	if len(params) < 1 {
+		return nil, fmt.Errorf("sprintf() expects at least 1 argument, got 0")
+	}
+
+	format, err := stringArg("sprintf", params, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return fmt.Sprintf(format, params[1:]...), nil
+}
@@ -0,0 +1,100 @@
+package exprruntime
+
+import (
+	"fmt"
+	"net/url"
+)
+
+func init() {
+	register(
+		Function{
+			Name:        "urlParse",
+			Signature:   "urlParse(url)",
+			Description: "Parses a URL into its scheme, host, hostname, port, path, query, fragment and user",
+			Fn:          urlParseFn,
+		},
+		Function{
+			Name:        "urlEncode",
+			Signature:   "urlEncode(s)",
+			Description: "Escapes a string so it can be used in a URL query",
+			Fn:          urlEncodeFn,
+		},
+		Function{
+			Name:        "urlDecode",
+			Signature:   "urlDecode(s)",
+			Description: "Unescapes a URL query string",
+			Fn:          urlDecodeFn,
+		},
+	)
+}
+
+func urlParseFn(params ...any) (any, error) {
+	s, err := singleStringArg("urlParse", params)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := url.Parse(s)
+	if err != nil {
+		return nil, fmt.Errorf("urlParse(): %w", err)
+	}
+
+	//
+	// Query parameters with a single value are returned as strings,
+	// since that is the common case, and repeated ones as lists.
+	//
+	query := map[string]any{}
+	for key, values := range u.Query() {
+		if len(values) == 1 {
+			query[key] = values[0]
+			continue
+		}
+
+		items := make([]any, 0, len(values))
+		for _, value := range values {
+			items = append(items, value)
+		}
+
+		query[key] = items
+	}
+
+	user := ""
+	if u.User != nil {
+		user = u.User.Username()
+	}
+
+	return map[string]any{
+		"scheme":   u.Scheme,
+		"host":     u.Host,
+		"hostname": u.Hostname(),
+		"port":     u.Port(),
+		"path":     u.Path,
+		"rawQuery": u.RawQuery,
+		"query":    query,
+		"fragment": u.Fragment,
+		"user":     user,
+	}, nil
+}
+
+func urlEncodeFn(params ...any) (any, error) {
+	s, err := singleStringArg("urlEncode", params)
+	if err != nil {
+		return nil, err
+	}
+
+	return url.QueryEscape(s), nil
+}
+
+func urlDecodeFn(params ...any) (any, error) {
+	s, err := singleStringArg("urlDecode", params)
+	if err != nil {
+		return nil, err
+	}
+
+	decoded, err := url.QueryUnescape(s)
+	if err != nil {
+		return nil, fmt.Errorf("urlDecode(): %w", err)
+	}
+
+	return decoded, nil
+}
@@ -0,0 +1,381 @@
+package exprruntime
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/expr-lang/expr"
+	"github.com/expr-lang/expr/ast"
+	"github.com/expr-lang/expr/file"
+	"github.com/expr-lang/expr/parser"
+)
+
+const (
+	SeverityError   = "error"
+	SeverityWarning = "warning"
+)
+
+var templateRegex = regexp.MustCompile(`\{\{(.*?)\}\}`)
+
+// Scope describes what is available to the expressions of a node,
+// using the example payloads of the nodes around it.
+// A nil payload means its shape is unknown, so fields under it are not checked.
+type Scope struct {
+	// Nodes maps the names of upstream nodes to their example payloads.
+	// If nil, node references are not checked.
+	Nodes map[string]any
+
+	// Root is the example payload of the trigger that starts the run.
+	Root any
+
+	// Previous holds the example payloads returned by previous(depth),
+	// indexed by depth - 1.
+	Previous []any
+
+	// Config is the configuration of the parent blueprint node,
+	// if the expression is used inside a blueprint.
+	Config map[string]any
+
+	// Vars holds the canvas variables.
+	// If nil, variable references are not checked.
+	Vars map[string]any
+}
+
+// Diagnostic is a problem found in an expression.
+// From and To are character offsets in the validated text.
+type Diagnostic struct {
+	Severity string
+	Message  string
+	From     int
+	To       int
+}
+
+// IsTemplate returns true if the text contains {{ }} expressions,
+// as used in configuration fields, instead of being an expression itself.
+func IsTemplate(text string) bool {
+	return strings.Contains(text, "{{")
+}
+
+// ValidateText validates a configuration value,
+// which is either a template with {{ }} expressions, or an expression itself.
+func ValidateText(text string, scope Scope) []Diagnostic {
+	if !IsTemplate(text) {
+		return Validate(text, scope)
+	}
+
+	diagnostics := []Diagnostic{}
+	lastEnd := 0
+	for _, match := range templateRegex.FindAllStringSubmatchIndex(text, -1) {
+		offset := utf8.RuneCountInString(text[:match[2]])
+		for _, diagnostic := range Validate(text[match[2]:match[3]], scope) {
+			diagnostic.From += offset
+			diagnostic.To += offset
+			diagnostics = append(diagnostics, diagnostic)
+		}
+
+		lastEnd = match[1]
+	}
+
+	if i := strings.Index(text[lastEnd:], "{{"); i != -1 {
+		from := utf8.RuneCountInString(text[:lastEnd+i])
+		diagnostics = append(diagnostics, Diagnostic{
+			Severity: SeverityWarning,
+			Message:  "unclosed {{, the text after it is not evaluated",
+			From:     from,
+			To:       from + 2,
+		})
+	}
+
+	return diagnostics
+}
+
+// Validate type-checks an expression, and checks the fields it reads
+// against the example payloads in the scope.
+func Validate(expression string, scope Scope) []Diagnostic {
+	if strings.TrimSpace(expression) == "" {
+		return []Diagnostic{{Severity: SeverityError, Message: "expression is empty", From: 0, To: 0}}
+	}
+
+	tree, err := parser.Parse(expression)
+	if err != nil {
+		return []Diagnostic{diagnosticFromError(err, expression)}
+	}
+
+	_, err = expr.Compile(expression, ValidationOptions(scope)...)
+	if err != nil {
+		return []Diagnostic{diagnosticFromError(err, expression)}
+	}
+
+	checker := &fieldChecker{
+		scope:       scope,
+		resolutions: map[ast.Node]resolution{},
+		diagnostics: []Diagnostic{},
+	}
+
+	ast.Walk(&tree.Node, checker)
+	return checker.diagnostics
+}
+
+// ValidationOptions returns the options used to compile expressions for validation.
+// They mirror the environment NodeConfigurationBuilder uses when evaluating them.
+func ValidationOptions(scope Scope) []expr.Option {
+	nodes := scope.Nodes
+	if nodes == nil {
+		nodes = map[string]any{}
+	}
+
+	vars := scope.Vars
+	if vars == nil {
+		vars = map[string]any{}
+	}
+
+	lookup := func(params ...any) (any, error) { return nil, nil }
+	env := map[string]any{
+		"$":    nodes,
+		"vars": vars,
+		"memory": map[string]any{
+			"find":      lookup,
+			"findFirst": lookup,
+		},
+	}
+
+	if scope.Config != nil {
+		env["config"] = scope.Config
+	}
+
+	return []expr.Option{
+		expr.Env(env),
+		expr.AsAny(),
+		expr.WithContext("ctx"),
+		expr.Timezone(time.UTC.String()),
+		StandardLibrary(),
+		expr.Function("root", func(params ...any) (any, error) { return scope.Root, nil }),
+		expr.Function("previous", func(params ...any) (any, error) { return nil, nil }),
+	}
+}
+
+func diagnosticFromError(err error, expression string) Diagnostic {
+	var fileErr *file.Error
+	if errors.As(err, &fileErr) {
+		from, to := fileErr.From, fileErr.To
+		if to <= from {
+			to = from + 1
+		}
+
+		length := utf8.RuneCountInString(expression)
+		if to > length {
+			to = length
+		}
+
+		if from > to {
+			from = to
+		}
+
+		return Diagnostic{Severity: SeverityError, Message: fileErr.Message, From: from, To: to}
+	}
+
+	return Diagnostic{
+		Severity: SeverityError,
+		Message:  err.Error(),
+		From:     0,
+		To:       utf8.RuneCountInString(expression),
+	}
+}
+
+// resolution is the example value an AST node evaluates to.
+// If known is false, nothing is known about the value's shape.
+type resolution struct {
+	value any
+	known bool
+	path  string
+}
+
+type fieldChecker struct {
+	scope       Scope
+	resolutions map[ast.Node]resolution
+	diagnostics []Diagnostic
+}
+
+func (c *fieldChecker) Visit(node *ast.Node) {
+	if _, ok := (*node).(*ast.MemberNode); ok {
+		c.resolve(*node)
+	}
+}
+
+func (c *fieldChecker) resolve(node ast.Node) resolution {
+	if r, ok := c.resolutions[node]; ok {
+		return r
+	}
+
+	r := c.resolveNode(node)
+	c.resolutions[node] = r
+	return r
+}
+
+func (c *fieldChecker) resolveNode(node ast.Node) resolution {
+	switch n := node.(type) {
+	case *ast.IdentifierNode:
+		switch n.Value {
+		case "$":
+			if c.scope.Nodes == nil {
+				return resolution{}
+			}
+			return resolution{value: c.scope.Nodes, known: true, path: "$"}
+		case "config":
+			if c.scope.Config == nil {
+				return resolution{}
+			}
+			return resolution{value: c.scope.Config, known: true, path: "config"}
+		case "vars":
+			if c.scope.Vars == nil {
+				return resolution{}
+			}
+			return resolution{value: c.scope.Vars, known: true, path: "vars"}
+		}
+
+	case *ast.CallNode:
+		callee, ok := n.Callee.(*ast.IdentifierNode)
+		if !ok {
+			return resolution{}
+		}
+
+		switch callee.Value {
+		case "root":
+			return resolution{value: c.scope.Root, known: c.scope.Root != nil, path: "root()"}
+		case "previous":
+			depth := 1
+			if len(n.Arguments) == 1 {
+				integer, ok := n.Arguments[0].(*ast.IntegerNode)
+				if !ok {
+					return resolution{}
+				}
+				depth = integer.Value
+			}
+
+			if depth < 1 || depth > len(c.scope.Previous) || c.scope.Previous[depth-1] == nil {
+				return resolution{}
+			}
+
+			return resolution{value: c.scope.Previous[depth-1], known: true, path: fmt.Sprintf("previous(%d)", depth)}
+		}
+
+	case *ast.ChainNode:
+		return c.resolve(n.Node)
+
+	case *ast.MemberNode:
+		return c.resolveMember(n)
+	}
+
+	return resolution{}
+}
+
+func (c *fieldChecker) resolveMember(member *ast.MemberNode) resolution {
+	parent := c.resolve(member.Node)
+	if !parent.known || member.Method {
+		return resolution{}
+	}
+
+	switch property := member.Property.(type) {
+	case *ast.StringNode:
+		value, ok := parent.value.(map[string]any)
+		if !ok {
+			return resolution{}
+		}
+
+		path := parent.path + memberPath(property.Value)
+		child, exists := value[property.Value]
+		if !exists {
+			c.reportMissing(member, parent, property.Value)
+			return resolution{}
+		}
+
+		//
+		// A node without an example payload is still a valid reference,
+		// but we don't know anything about its fields.
+		//
+		if child == nil {
+			return resolution{}
+		}
+
+		return resolution{value: child, known: true, path: path}
+
+	case *ast.IntegerNode:
+		list, ok := parent.value.([]any)
+		if !ok || len(list) == 0 {
+			return resolution{}
+		}
+
+		//
+		// Examples only have a few representative items,
+		// so any index is checked against an item of the example list.
+		//
+		index := property.Value
+		if index < 0 || index >= len(list) {
+			index = 0
+		}
+
+		if list[index] == nil {
+			return resolution{}
+		}
+
+		return resolution{value: list[index], known: true, path: fmt.Sprintf("%s[%d]", parent.path, property.Value)}
+	}
+
+	return resolution{}
+}
+
+func (c *fieldChecker) reportMissing(member *ast.MemberNode, parent resolution, key string) {
+	//
+	// Optional chaining means the expression already handles missing fields.
+	//
+	if member.Optional {
+		return
+	}
+
+	location := member.Property.Location()
+	if location.To <= location.From {
+		location = member.Location()
+	}
+
+	if parent.path == "$" {
+		c.diagnostics = append(c.diagnostics, Diagnostic{
+			Severity: SeverityError,
+			Message:  fmt.Sprintf("node %q is not connected upstream of this node", key),
+			From:     location.From,
+			To:       location.To,
+		})
+		return
+	}
+
+	if parent.path == "vars" {
+		c.diagnostics = append(c.diagnostics, Diagnostic{
+			Severity: SeverityError,
+			Message:  fmt.Sprintf("variable %q is not defined in this canvas", key),
+			From:     location.From,
+			To:       location.To,
+		})
+		return
+	}
+
+	c.diagnostics = append(c.diagnostics, Diagnostic{
+		Severity: SeverityWarning,
+		Message:  fmt.Sprintf("field %q not found in %s example payload", key, parent.path),
+		From:     location.From,
+		To:       location.To,
+	})
+}
+
+var identifierRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+func memberPath(key string) string {
+	if identifierRegex.MatchString(key) {
+		return "." + key
+	}
+
+	return "[" + strconv.Quote(key) + "]"
+}
@@ -0,0 +1,142 @@
+package exprruntime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testScope() Scope {
+	return Scope{
+		Nodes: map[string]any{
+			"GitHub push": map[string]any{
+				"data": map[string]any{
+					"ref": "refs/heads/main",
+					"commits": []any{
+						map[string]any{"id": "abc", "message": "fix"},
+					},
+				},
+			},
+			"Unknown": nil,
+		},
+		Root: map[string]any{
+			"data": map[string]any{"ref": "refs/heads/main"},
+		},
+		Previous: []any{
+			map[string]any{"data": map[string]any{"status": 200}},
+		},
+	}
+}
+
+func TestValidate(t *testing.T) {
+	scope := testScope()
+
+	t.Run("valid expression has no diagnostics", func(t *testing.T) {
+		diagnostics := Validate(`$["GitHub push"].data.ref == "refs/heads/main"`, scope)
+		assert.Empty(t, diagnostics)
+	})
+
+	t.Run("empty expression is an error", func(t *testing.T) {
+		diagnostics := Validate("  ", scope)
+		require.Len(t, diagnostics, 1)
+		assert.Equal(t, SeverityError, diagnostics[0].Severity)
+	})
+
+	t.Run("syntax error is reported with its location", func(t *testing.T) {
+		diagnostics := Validate(`root().data.ref ==`, scope)
+		require.Len(t, diagnostics, 1)
+		assert.Equal(t, SeverityError, diagnostics[0].Severity)
+		assert.LessOrEqual(t, diagnostics[0].To, len(`root().data.ref ==`))
+	})
+
+	t.Run("unknown function is an error", func(t *testing.T) {
+		diagnostics := Validate(`nope(1)`, scope)
+		require.Len(t, diagnostics, 1)
+		assert.Equal(t, SeverityError, diagnostics[0].Severity)
+	})
+
+	t.Run("library functions are available", func(t *testing.T) {
+		diagnostics := Validate(`semverCompare("1.0.0", "2.0.0") < 0`, scope)
+		assert.Empty(t, diagnostics)
+	})
+
+	t.Run("node not upstream is an error", func(t *testing.T) {
+		diagnostics := Validate(`$["Deploy"].data`, scope)
+		require.Len(t, diagnostics, 1)
+		assert.Equal(t, SeverityError, diagnostics[0].Severity)
+		assert.Contains(t, diagnostics[0].Message, `node "Deploy" is not connected upstream`)
+		assert.Equal(t, 2, diagnostics[0].From)
+	})
+
+	t.Run("missing field is a warning", func(t *testing.T) {
+		diagnostics := Validate(`$["GitHub push"].data.branch`, scope)
+		require.Len(t, diagnostics, 1)
+		assert.Equal(t, SeverityWarning, diagnostics[0].Severity)
+		assert.Equal(t, `field "branch" not found in $["GitHub push"].data example payload`, diagnostics[0].Message)
+	})
+
+	t.Run("list items are checked against the example item", func(t *testing.T) {
+		assert.Empty(t, Validate(`$["GitHub push"].data.commits[3].message`, scope))
+
+		diagnostics := Validate(`$["GitHub push"].data.commits[0].author`, scope)
+		require.Len(t, diagnostics, 1)
+		assert.Equal(t, SeverityWarning, diagnostics[0].Severity)
+	})
+
+	t.Run("optional chaining skips missing fields", func(t *testing.T) {
+		assert.Empty(t, Validate(`$["GitHub push"].data?.branch ?? "main"`, scope))
+	})
+
+	t.Run("fields of nodes without example are not checked", func(t *testing.T) {
+		assert.Empty(t, Validate(`$["Unknown"].data.anything`, scope))
+	})
+
+	t.Run("root and previous are checked", func(t *testing.T) {
+		diagnostics := Validate(`root().data.sha + previous().data.code`, scope)
+		require.Len(t, diagnostics, 2)
+		assert.Contains(t, diagnostics[0].Message, `field "sha" not found in root().data`)
+		assert.Contains(t, diagnostics[1].Message, `field "code" not found in previous(1).data`)
+	})
+
+	t.Run("previous deeper than the known chain is not checked", func(t *testing.T) {
+		assert.Empty(t, Validate(`previous(2).data.anything`, scope))
+	})
+
+	t.Run("canvas variables are checked", func(t *testing.T) {
+		variablesScope := testScope()
+		variablesScope.Vars = map[string]any{
+			"region": "us-east-1",
+			"sshKey": map[string]any{"secret": "keys", "key": "ssh"},
+		}
+
+		assert.Empty(t, Validate(`vars.region + "-" + vars.sshKey.key`, variablesScope))
+
+		diagnostics := Validate(`vars.zone`, variablesScope)
+		require.Len(t, diagnostics, 1)
+		assert.Equal(t, SeverityError, diagnostics[0].Severity)
+		assert.Equal(t, `variable "zone" is not defined in this canvas`, diagnostics[0].Message)
+	})
+}
+
+func TestValidateText(t *testing.T) {
+	scope := testScope()
+
+	t.Run("plain expression", func(t *testing.T) {
+		assert.Empty(t, ValidateText(`root().data.ref`, scope))
+	})
+
+	t.Run("template offsets are relative to the whole text", func(t *testing.T) {
+		text := `Ref: {{ root().data.sha }}`
+		diagnostics := ValidateText(text, scope)
+		require.Len(t, diagnostics, 1)
+		assert.Equal(t, "sha", text[diagnostics[0].From:diagnostics[0].To])
+	})
+
+	t.Run("unclosed template is a warning", func(t *testing.T) {
+		diagnostics := ValidateText(`{{ root().data.ref }} and {{ root()`, scope)
+		require.Len(t, diagnostics, 1)
+		assert.Equal(t, SeverityWarning, diagnostics[0].Severity)
+		assert.Equal(t, 26, diagnostics[0].From)
+	})
+}
@@ -0,0 +1,134 @@
+package audit
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/grpc/actions"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/audit"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	DefaultLimit = 50
+	MaxLimit     = 100
+)
+
+func ListAuditEvents(ctx context.Context, organizationID string, req *pb.ListAuditEventsRequest) (*pb.ListAuditEventsResponse, error) {
+	orgID, err := uuid.Parse(organizationID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid organization id")
+	}
+
+	filters := models.AuditEventFilters{
+		ResourceType: req.ResourceType,
+		ResourceID:   req.ResourceId,
+		Action:       req.Action,
+	}
+
+	if req.ActorId != "" {
+		actorID, err := uuid.Parse(req.ActorId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid actor id")
+		}
+
+		filters.ActorID = &actorID
+	}
+
+	limit := getLimit(req.Limit)
+	var before *time.Time
+	if req.Before != nil {
+		t := req.Before.AsTime()
+		before = &t
+	}
+
+	events, err := models.ListAuditEvents(orgID, filters, int(limit), before)
+	if err != nil {
+		log.Errorf("Error listing audit events for organization %s: %v", organizationID, err)
+		return nil, status.Error(codes.Internal, "failed to list audit events")
+	}
+
+	count, err := models.CountAuditEvents(orgID, filters)
+	if err != nil {
+		log.Errorf("Error counting audit events for organization %s: %v", organizationID, err)
+		return nil, status.Error(codes.Internal, "failed to list audit events")
+	}
+
+	serialized := make([]*pb.AuditEvent, 0, len(events))
+	for _, event := range events {
+		serialized = append(serialized, serializeAuditEvent(event))
+	}
+
+	response := &pb.ListAuditEventsResponse{
+		Events:      serialized,
+		TotalCount:  uint32(count),
+		HasNextPage: int64(len(events)) >= int64(limit) && int64(len(events)) < count,
+	}
+
+	if len(events) > 0 && events[len(events)-1].CreatedAt != nil {
+		response.LastTimestamp = timestamppb.New(*events[len(events)-1].CreatedAt)
+	}
+
+	return response, nil
+}
+
+func getLimit(limit uint32) uint32 {
+	if limit == 0 {
+		return DefaultLimit
+	}
+
+	if limit > MaxLimit {
+		return MaxLimit
+	}
+
+	return limit
+}
+
+func serializeAuditEvent(event models.AuditEvent) *pb.AuditEvent {
+	serialized := &pb.AuditEvent{
+		Id: event.ID.String(),
+		Actor: &pb.AuditEvent_Actor{
+			Type:  event.ActorType,
+			Name:  event.ActorName,
+			Email: event.ActorEmail,
+		},
+		Resource: &pb.AuditEvent_Resource{
+			Type: event.ResourceType,
+			Id:   event.ResourceID,
+			Name: event.ResourceName,
+		},
+		Action:     event.Action,
+		Method:     event.Method,
+		DomainType: actions.DomainTypeToProto(event.DomainType),
+		DomainId:   event.DomainID,
+		Status:     event.Status,
+		Request:    toStruct(event.Request.Data()),
+		Response:   toStruct(event.Response.Data()),
+		Before:     toStruct(event.Before.Data()),
+	}
+
+	if event.ActorID != nil {
+		serialized.Actor.Id = event.ActorID.String()
+	}
+
+	if event.CreatedAt != nil {
+		serialized.CreatedAt = timestamppb.New(*event.CreatedAt)
+	}
+
+	return serialized
+}
+
+func toStruct(data map[string]any) *structpb.Struct {
+	s, err := structpb.NewStruct(data)
+	if err != nil {
+		return &structpb.Struct{}
+	}
+
+	return s
+}
@@ -0,0 +1,181 @@
+package audit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/audit"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	protos "github.com/superplanehq/superplane/pkg/protos/audit"
+	pbAuth "github.com/superplanehq/superplane/pkg/protos/authorization"
+	pbSecrets "github.com/superplanehq/superplane/pkg/protos/secrets"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func Test__ListAuditEvents(t *testing.T) {
+	r := support.SetupWithOptions(t, support.SetupOptions{})
+	ctx := context.Background()
+
+	recordSecretCall := func(action string, err error, denied bool) {
+		audit.RecordCall(audit.Call{
+			OrganizationID: r.Organization.ID,
+			UserID:         r.User.String(),
+			ResourceType:   "secrets",
+			Action:         action,
+			Method:         pbSecrets.Secrets_CreateSecret_FullMethodName,
+			DomainType:     models.DomainTypeOrganization,
+			DomainID:       r.Organization.ID.String(),
+			Request: &pbSecrets.CreateSecretRequest{
+				Secret: &pbSecrets.Secret{
+					Metadata: &pbSecrets.Secret_Metadata{Name: "prod-token"},
+					Spec: &pbSecrets.Secret_Spec{
+						Local: &pbSecrets.Secret_Local{Data: map[string]string{"token": "abc"}},
+					},
+				},
+			},
+			Response: &pbSecrets.CreateSecretResponse{
+				Secret: &pbSecrets.Secret{
+					Metadata: &pbSecrets.Secret_Metadata{Id: "secret-1", Name: "prod-token"},
+				},
+			},
+			Before: &pbSecrets.DescribeSecretResponse{
+				Secret: &pbSecrets.Secret{
+					Metadata: &pbSecrets.Secret_Metadata{Id: "secret-1", Name: "prod-token"},
+					Spec: &pbSecrets.Secret_Spec{
+						Local: &pbSecrets.Secret_Local{Data: map[string]string{"token": "old"}},
+					},
+				},
+			},
+			Err:    err,
+			Denied: denied,
+		})
+	}
+
+	t.Run("no events", func(t *testing.T) {
+		response, err := ListAuditEvents(ctx, r.Organization.ID.String(), &protos.ListAuditEventsRequest{})
+		require.NoError(t, err)
+		assert.Empty(t, response.Events)
+		assert.Zero(t, response.TotalCount)
+		assert.False(t, response.HasNextPage)
+	})
+
+	t.Run("events are listed newest first, with the actor and redacted values", func(t *testing.T) {
+		recordSecretCall("create", nil, false)
+		recordSecretCall("delete", status.Error(codes.NotFound, "secret not found"), false)
+		recordSecretCall("delete", nil, true)
+
+		response, err := ListAuditEvents(ctx, r.Organization.ID.String(), &protos.ListAuditEventsRequest{})
+		require.NoError(t, err)
+		require.Len(t, response.Events, 3)
+		assert.Equal(t, uint32(3), response.TotalCount)
+
+		denied := response.Events[0]
+		assert.Equal(t, "delete", denied.Action)
+		assert.Equal(t, audit.StatusPermissionDenied, denied.Status)
+		assert.Empty(t, denied.Response.AsMap())
+
+		failed := response.Events[1]
+		assert.Equal(t, "delete", failed.Action)
+		assert.Equal(t, codes.NotFound.String(), failed.Status)
+
+		created := response.Events[2]
+		assert.Equal(t, "create", created.Action)
+		assert.Equal(t, codes.OK.String(), created.Status)
+		assert.Equal(t, pbSecrets.Secrets_CreateSecret_FullMethodName, created.Method)
+		assert.Equal(t, pbAuth.DomainType_DOMAIN_TYPE_ORGANIZATION, created.DomainType)
+		assert.Equal(t, r.Organization.ID.String(), created.DomainId)
+		assert.Equal(t, r.User.String(), created.Actor.Id)
+		assert.Equal(t, r.UserModel.Name, created.Actor.Name)
+		assert.Equal(t, r.Account.Email, created.Actor.Email)
+		assert.Equal(t, "secrets", created.Resource.Type)
+		assert.Equal(t, "secret-1", created.Resource.Id)
+		assert.Equal(t, "prod-token", created.Resource.Name)
+
+		spec := created.Request.AsMap()["secret"].(map[string]any)["spec"].(map[string]any)
+		assert.Equal(t, audit.RedactedValue, spec["local"].(map[string]any)["data"])
+
+		before := created.Before.AsMap()["secret"].(map[string]any)
+		assert.Equal(t, "prod-token", before["metadata"].(map[string]any)["name"])
+		assert.Equal(t, audit.RedactedValue, before["spec"].(map[string]any)["local"].(map[string]any)["data"])
+	})
+
+	t.Run("events are filtered", func(t *testing.T) {
+		response, err := ListAuditEvents(ctx, r.Organization.ID.String(), &protos.ListAuditEventsRequest{Action: "delete"})
+		require.NoError(t, err)
+		require.Len(t, response.Events, 2)
+		assert.Equal(t, uint32(2), response.TotalCount)
+
+		response, err = ListAuditEvents(ctx, r.Organization.ID.String(), &protos.ListAuditEventsRequest{
+			ResourceType: "secrets",
+			ResourceId:   "secret-1",
+			ActorId:      r.User.String(),
+		})
+		require.NoError(t, err)
+		require.Len(t, response.Events, 1)
+		assert.Equal(t, "create", response.Events[0].Action)
+
+		response, err = ListAuditEvents(ctx, r.Organization.ID.String(), &protos.ListAuditEventsRequest{ActorId: uuid.NewString()})
+		require.NoError(t, err)
+		assert.Empty(t, response.Events)
+	})
+
+	t.Run("events are paginated", func(t *testing.T) {
+		response, err := ListAuditEvents(ctx, r.Organization.ID.String(), &protos.ListAuditEventsRequest{Limit: 2})
+		require.NoError(t, err)
+		require.Len(t, response.Events, 2)
+		assert.True(t, response.HasNextPage)
+		require.NotNil(t, response.LastTimestamp)
+
+		response, err = ListAuditEvents(ctx, r.Organization.ID.String(), &protos.ListAuditEventsRequest{
+			Limit:  2,
+			Before: response.LastTimestamp,
+		})
+		require.NoError(t, err)
+		require.Len(t, response.Events, 1)
+		assert.Equal(t, "create", response.Events[0].Action)
+		assert.False(t, response.HasNextPage)
+	})
+
+	t.Run("events of other organizations are not listed", func(t *testing.T) {
+		response, err := ListAuditEvents(ctx, uuid.NewString(), &protos.ListAuditEventsRequest{})
+		require.NoError(t, err)
+		assert.Empty(t, response.Events)
+	})
+
+	t.Run("invalid actor id -> error", func(t *testing.T) {
+		_, err := ListAuditEvents(ctx, r.Organization.ID.String(), &protos.ListAuditEventsRequest{ActorId: "not-a-uuid"})
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+	})
+
+	t.Run("events cannot be updated", func(t *testing.T) {
+		err := database.Conn().
+			Model(&models.AuditEvent{}).
+			Where("organization_id = ?", r.Organization.ID).
+			Update("action", "read").
+			Error
+
+		require.ErrorContains(t, err, "audit events are append-only")
+	})
+
+	t.Run("old events are deleted", func(t *testing.T) {
+		deleted, err := models.DeleteAuditEventsOlderThan(time.Now().Add(time.Hour), 100)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), deleted)
+
+		response, err := ListAuditEvents(ctx, r.Organization.ID.String(), &protos.ListAuditEventsRequest{
+			Before: timestamppb.New(time.Now().Add(time.Hour)),
+		})
+		require.NoError(t, err)
+		assert.Empty(t, response.Events)
+	})
+}
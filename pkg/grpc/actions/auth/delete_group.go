@@ -5,6 +5,7 @@ import (
 
 	log "github.com/sirupsen/logrus"
 	"github.com/superplanehq/superplane/pkg/authorization"
+	"github.com/superplanehq/superplane/pkg/models"
 	pb "github.com/superplanehq/superplane/pkg/protos/groups"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -27,6 +28,26 @@ func DeleteGroup(ctx context.Context, domainType, domainID, groupName string, au
 		return nil, status.Error(codes.Internal, "failed to delete group")
 	}
 
+	//
+	// Organization groups can have roles on canvases of the organization.
+	// Those are removed with the group, so a new group with the same name gets no access.
+	//
+	if domainType == models.DomainTypeOrganization {
+		canvases, err := models.ListCanvases(domainID, false)
+		if err != nil {
+			log.Errorf("failed to list canvases for %s: %v", domainID, err)
+			return nil, status.Error(codes.Internal, "failed to delete group")
+		}
+
+		for _, canvas := range canvases {
+			err = authService.RemoveCanvasGroupRole(canvas.ID.String(), groupName)
+			if err != nil {
+				log.Errorf("failed to remove group %s from canvas %s: %v", groupName, canvas.ID.String(), err)
+				return nil, status.Error(codes.Internal, "failed to delete group")
+			}
+		}
+	}
+
 	log.Infof("deleted group %s from domain %s", groupName, domainID)
 
 	return &pb.DeleteGroupResponse{}, nil
@@ -16,9 +16,27 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"gorm.io/datatypes"
+	"gorm.io/gorm"
 )
 
 func CreateBlueprint(ctx context.Context, registry *registry.Registry, organizationID string, blueprint *pb.Blueprint) (*pb.CreateBlueprintResponse, error) {
+	model, err := CreateBlueprintInTransaction(ctx, database.Conn(), registry, organizationID, blueprint)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateBlueprintResponse{
+		Blueprint: SerializeBlueprint(model),
+	}, nil
+}
+
+func CreateBlueprintInTransaction(
+	ctx context.Context,
+	tx *gorm.DB,
+	registry *registry.Registry,
+	organizationID string,
+	blueprint *pb.Blueprint,
+) (*models.Blueprint, error) {
 	userID, ok := authentication.GetUserIdFromMetadata(ctx)
 	if !ok {
 		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
@@ -61,7 +79,7 @@ func CreateBlueprint(ctx context.Context, registry *registry.Registry, organizat
 		OutputChannels: datatypes.NewJSONSlice(outputChannels),
 	}
 
-	err = database.Conn().Create(model).Error
+	err = tx.Create(model).Error
 	if err != nil {
 		if strings.Contains(err.Error(), "unique constraint") {
 			return nil, status.Error(codes.InvalidArgument, "A component with this name already exists")
@@ -70,9 +88,7 @@ func CreateBlueprint(ctx context.Context, registry *registry.Registry, organizat
 		return nil, err
 	}
 
-	return &pb.CreateBlueprintResponse{
-		Blueprint: SerializeBlueprint(model),
-	}, nil
+	return model, nil
 }
 
 func ParseOutputChannels(registry *registry.Registry, nodes []*componentpb.Node, outputChannels []*pb.OutputChannel) ([]models.BlueprintOutputChannel, error) {
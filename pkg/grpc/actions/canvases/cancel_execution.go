@@ -10,6 +10,7 @@ import (
 	"github.com/superplanehq/superplane/pkg/core"
 	"github.com/superplanehq/superplane/pkg/crypto"
 	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
 	"github.com/superplanehq/superplane/pkg/logging"
 	"github.com/superplanehq/superplane/pkg/models"
 	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
@@ -41,6 +42,15 @@ func CancelExecution(ctx context.Context, authService authorization.Authorizatio
 		return nil, status.Error(codes.InvalidArgument, "cannot cancel child execution directly, cancel the parent execution instead")
 	}
 
+	canvas, err := models.FindCanvas(uuid.MustParse(organizationID), workflowID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "canvas not found")
+	}
+
+	if err := authorizeNodeAction(authService, canvas, execution.NodeID, user); err != nil {
+		return nil, err
+	}
+
 	err = database.Conn().Transaction(func(tx *gorm.DB) error {
 		node, err := models.FindCanvasNode(tx, workflowID, execution.NodeID)
 
@@ -61,6 +71,8 @@ func CancelExecution(ctx context.Context, authService authorization.Authorizatio
 		return nil, err
 	}
 
+	messages.NewCanvasExecutionMessage(execution.WorkflowID.String(), execution.ID.String(), execution.NodeID).Publish()
+
 	return &pb.CancelExecutionResponse{}, nil
 }
 
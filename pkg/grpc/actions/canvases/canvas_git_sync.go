@@ -0,0 +1,445 @@
+package canvases
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"github.com/superplanehq/superplane/pkg/registry"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+const CanvasGitSyncFileKind = "Canvas"
+
+func DescribeCanvasGitSync(ctx context.Context, organizationID, canvasID string) (*pb.DescribeCanvasGitSyncResponse, error) {
+	canvas, err := findCanvasForGitSync(organizationID, canvasID)
+	if err != nil {
+		return nil, err
+	}
+
+	sync, err := models.FindCanvasGitSync(canvas.ID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "canvas is not synced from a Git repository")
+		}
+
+		return nil, status.Error(codes.Internal, "failed to load canvas Git sync")
+	}
+
+	return &pb.DescribeCanvasGitSyncResponse{
+		Sync: serializeCanvasGitSync(sync),
+	}, nil
+}
+
+func UpdateCanvasGitSync(ctx context.Context, organizationID string, req *pb.UpdateCanvasGitSyncRequest) (*pb.UpdateCanvasGitSyncResponse, error) {
+	userID, ok := authentication.GetUserIdFromMetadata(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	canvas, err := findCanvasForGitSync(organizationID, req.CanvasId)
+	if err != nil {
+		return nil, err
+	}
+
+	if canvas.IsTemplate {
+		return nil, status.Error(codes.FailedPrecondition, "templates are read-only")
+	}
+
+	versioningEnabled, err := isCanvasVersioningEnabledForCanvas(canvas)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load canvas versioning: %v", err)
+	}
+
+	//
+	// Changes from the repository are proposed as change requests,
+	// which only exist for canvases with versioning enabled.
+	//
+	if !versioningEnabled {
+		return nil, status.Error(codes.FailedPrecondition, "canvas versioning is disabled for this canvas")
+	}
+
+	repository := strings.TrimSpace(req.Repository)
+	branch := strings.TrimSpace(req.Branch)
+	path := strings.TrimSpace(req.Path)
+	if repository == "" || branch == "" || path == "" {
+		return nil, status.Error(codes.InvalidArgument, "repository, branch and path are required")
+	}
+
+	if strings.HasPrefix(branch, "-") {
+		return nil, status.Error(codes.InvalidArgument, "invalid branch")
+	}
+
+	if filepath.IsAbs(path) || strings.HasPrefix(filepath.Clean(path), "..") {
+		return nil, status.Error(codes.InvalidArgument, "path must be relative to the repository root")
+	}
+
+	var integrationID *uuid.UUID
+	if strings.TrimSpace(req.IntegrationId) != "" {
+		integration, err := findIntegrationForGitSync(canvas.OrganizationID, req.IntegrationId)
+		if err != nil {
+			return nil, err
+		}
+
+		integrationID = &integration.ID
+	} else if !IsValidGitRepositoryURL(repository) {
+		return nil, status.Error(codes.InvalidArgument, "repository must be an HTTPS or SSH Git URL when no integration is used")
+	}
+
+	sync := &models.CanvasGitSync{
+		CanvasID:       canvas.ID,
+		OrganizationID: canvas.OrganizationID,
+		IntegrationID:  integrationID,
+		Repository:     repository,
+		Branch:         branch,
+		Path:           filepath.ToSlash(filepath.Clean(path)),
+		Enabled:        req.Enabled,
+		CreatedBy:      uuid.MustParse(userID),
+	}
+
+	if err := models.SaveCanvasGitSyncInTransaction(database.Conn(), sync); err != nil {
+		log.Errorf("failed to save Git sync for canvas %s: %v", canvas.ID, err)
+		return nil, status.Error(codes.Internal, "failed to update canvas Git sync")
+	}
+
+	sync, err = models.FindCanvasGitSync(canvas.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to load canvas Git sync")
+	}
+
+	return &pb.UpdateCanvasGitSyncResponse{
+		Sync: serializeCanvasGitSync(sync),
+	}, nil
+}
+
+func DeleteCanvasGitSync(ctx context.Context, organizationID, canvasID string) (*pb.DeleteCanvasGitSyncResponse, error) {
+	canvas, err := findCanvasForGitSync(organizationID, canvasID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := models.DeleteCanvasGitSync(canvas.ID); err != nil {
+		log.Errorf("failed to delete Git sync for canvas %s: %v", canvas.ID, err)
+		return nil, status.Error(codes.Internal, "failed to delete canvas Git sync")
+	}
+
+	return &pb.DeleteCanvasGitSyncResponse{}, nil
+}
+
+// CanvasGitSyncLocalRepositoriesEnv lists the directories, separated by commas,
+// from which repositories can be synced by their local path.
+// Local paths are not accepted when it is not set.
+const CanvasGitSyncLocalRepositoriesEnv = "CANVAS_GIT_SYNC_LOCAL_REPOSITORIES"
+
+// IsValidGitRepositoryURL reports whether a repository
+// can be cloned directly, without going through an integration.
+// Only HTTPS and SSH remotes are accepted, and local paths
+// inside of the directories allowed by the server.
+func IsValidGitRepositoryURL(repository string) bool {
+	if strings.HasPrefix(repository, "-") {
+		return false
+	}
+
+	if filepath.IsAbs(repository) {
+		return isAllowedLocalGitRepository(repository)
+	}
+
+	//
+	// scp-like syntax, e.g. git@github.com:org/repo.git.
+	// Git treats it as a local path when a slash comes before the colon.
+	//
+	if !strings.Contains(repository, "://") {
+		userHost, _, found := strings.Cut(repository, ":")
+		return found && strings.Contains(userHost, "@") && !strings.Contains(userHost, "/")
+	}
+
+	u, err := url.Parse(repository)
+	if err != nil || u.Host == "" {
+		return false
+	}
+
+	switch u.Scheme {
+	case "https", "ssh":
+		return true
+	default:
+		return false
+	}
+}
+
+func isAllowedLocalGitRepository(repository string) bool {
+	repository = filepath.Clean(repository)
+
+	for _, dir := range strings.Split(os.Getenv(CanvasGitSyncLocalRepositoriesEnv), ",") {
+		dir = strings.TrimSpace(dir)
+		if dir == "" || !filepath.IsAbs(dir) {
+			continue
+		}
+
+		dir = filepath.Clean(dir)
+		if strings.HasPrefix(repository, strings.TrimSuffix(dir, string(filepath.Separator))+string(filepath.Separator)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SyncCanvasFromGit compares a canvas file read from the repository
+// with the live canvas, and records the result in the sync status.
+//
+// When the file changed since the last sync and does not match the live canvas,
+// a change request is opened with its contents, through an edit version
+// owned by the user who configured the sync.
+// When the file did not change, but the live canvas was changed
+// and no longer matches it, drift is reported.
+func SyncCanvasFromGit(
+	ctx context.Context,
+	encryptor crypto.Encryptor,
+	registry *registry.Registry,
+	sync *models.CanvasGitSync,
+	content []byte,
+	webhookBaseURL string,
+) error {
+	organizationID := sync.OrganizationID.String()
+	canvas, err := models.FindCanvas(sync.OrganizationID, sync.CanvasID)
+	if err != nil {
+		return fmt.Errorf("failed to find canvas: %w", err)
+	}
+
+	liveVersion, err := models.FindLiveCanvasVersionByCanvasInTransaction(database.Conn(), canvas)
+	if err != nil {
+		return fmt.Errorf("failed to find live canvas version: %w", err)
+	}
+
+	pbCanvas, err := ParseCanvasGitSyncFile(content)
+	if err != nil {
+		return err
+	}
+
+	//
+	// The canvas file does not control the name or description of the canvas.
+	//
+	pbCanvas.Metadata = &pb.Canvas_Metadata{Name: canvas.Name, Description: canvas.Description}
+
+	nodes, edges, err := ParseCanvas(registry, organizationID, pbCanvas)
+	if err != nil {
+		return fmt.Errorf("invalid canvas file: %s", status.Convert(err).Message())
+	}
+
+	variables, err := ParseCanvasVariables(pbCanvas)
+	if err != nil {
+		return fmt.Errorf("invalid canvas file: %s", status.Convert(err).Message())
+	}
+
+	matchesLive := len(resolveChangedNodeIDSet(liveVersion.Nodes, liveVersion.Edges, nodes, edges)) == 0 &&
+		canvasVariablesEqual(liveVersion.Variables, variables)
+
+	digest := canvasGitSyncDigest(content)
+	liveChanged := sync.LastLiveVersionID == nil || *sync.LastLiveVersionID != liveVersion.ID
+
+	switch {
+	case digest != sync.LastContentDigest && matchesLive:
+		sync.DriftDetected = false
+
+	case digest != sync.LastContentDigest:
+		changeRequestID, err := createCanvasGitSyncChangeRequest(ctx, encryptor, registry, sync, pbCanvas, digest, webhookBaseURL)
+		if err != nil {
+			return err
+		}
+
+		sync.LastChangeRequestID = changeRequestID
+		sync.DriftDetected = false
+
+	case liveChanged:
+		sync.DriftDetected = !matchesLive
+	}
+
+	sync.LastContentDigest = digest
+	sync.LastLiveVersionID = &liveVersion.ID
+	return nil
+}
+
+// ParseCanvasGitSyncFile parses a canvas YAML file, in the format used by the CLI.
+// The apiVersion and kind headers are optional.
+func ParseCanvasGitSyncFile(content []byte) (*pb.Canvas, error) {
+	jsonData, err := yaml.YAMLToJSON(content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse canvas file: %w", err)
+	}
+
+	var headers struct {
+		Kind string `json:"kind"`
+	}
+
+	if err := json.Unmarshal(jsonData, &headers); err != nil {
+		return nil, fmt.Errorf("failed to parse canvas file: %w", err)
+	}
+
+	if headers.Kind != "" && headers.Kind != CanvasGitSyncFileKind {
+		return nil, fmt.Errorf("unsupported resource kind %q", headers.Kind)
+	}
+
+	var canvas pb.Canvas
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(jsonData, &canvas); err != nil {
+		return nil, fmt.Errorf("failed to parse canvas file: %w", err)
+	}
+
+	if canvas.Spec == nil {
+		return nil, fmt.Errorf("canvas file has no spec")
+	}
+
+	return &canvas, nil
+}
+
+func createCanvasGitSyncChangeRequest(
+	ctx context.Context,
+	encryptor crypto.Encryptor,
+	registry *registry.Registry,
+	sync *models.CanvasGitSync,
+	pbCanvas *pb.Canvas,
+	digest string,
+	webhookBaseURL string,
+) (*uuid.UUID, error) {
+	organizationID := sync.OrganizationID.String()
+	canvasID := sync.CanvasID.String()
+	ctx = authentication.SetUserIdInMetadata(ctx, sync.CreatedBy.String())
+
+	version, err := CreateCanvasVersion(ctx, organizationID, canvasID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create canvas version: %s", status.Convert(err).Message())
+	}
+
+	_, err = UpdateCanvasVersion(
+		ctx,
+		encryptor,
+		registry,
+		organizationID,
+		canvasID,
+		version.Version.Metadata.Id,
+		pbCanvas,
+		nil,
+		webhookBaseURL,
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to update canvas version: %s", status.Convert(err).Message())
+	}
+
+	response, err := CreateCanvasChangeRequestWithMetadata(
+		ctx,
+		registry,
+		organizationID,
+		canvasID,
+		version.Version.Metadata.Id,
+		fmt.Sprintf("Sync from %s@%s", sync.Repository, sync.Branch),
+		fmt.Sprintf("Changes to %s in %s, on branch %s (sha256:%s).", sync.Path, sync.Repository, sync.Branch, digest),
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to create change request: %s", status.Convert(err).Message())
+	}
+
+	changeRequestID := uuid.MustParse(response.ChangeRequest.Metadata.Id)
+	return &changeRequestID, nil
+}
+
+func canvasGitSyncDigest(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+func canvasVariablesEqual(a, b []models.CanvasVariable) bool {
+	if len(a) == 0 && len(b) == 0 {
+		return true
+	}
+
+	return reflect.DeepEqual(a, b)
+}
+
+func findCanvasForGitSync(organizationID, canvasID string) (*models.Canvas, error) {
+	orgUUID, err := uuid.Parse(organizationID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid organization_id")
+	}
+
+	canvasUUID, err := uuid.Parse(canvasID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid canvas_id")
+	}
+
+	canvas, err := models.FindCanvas(orgUUID, canvasUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "canvas not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to load canvas")
+	}
+
+	return canvas, nil
+}
+
+func findIntegrationForGitSync(organizationID uuid.UUID, integrationID string) (*models.Integration, error) {
+	integrationUUID, err := uuid.Parse(integrationID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid integration_id")
+	}
+
+	integration, err := models.FindIntegration(organizationID, integrationUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "integration not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to load integration")
+	}
+
+	return integration, nil
+}
+
+func serializeCanvasGitSync(sync *models.CanvasGitSync) *pb.CanvasGitSync {
+	result := &pb.CanvasGitSync{
+		CanvasId:   sync.CanvasID.String(),
+		Repository: sync.Repository,
+		Branch:     sync.Branch,
+		Path:       sync.Path,
+		Enabled:    sync.Enabled,
+		Status: &pb.CanvasGitSync_Status{
+			ContentDigest: sync.LastContentDigest,
+			DriftDetected: sync.DriftDetected,
+			LastError:     sync.LastError,
+		},
+	}
+
+	if sync.IntegrationID != nil {
+		result.IntegrationId = sync.IntegrationID.String()
+	}
+
+	if sync.LastChangeRequestID != nil {
+		result.Status.LastChangeRequestId = sync.LastChangeRequestID.String()
+	}
+
+	if sync.LastSyncedAt != nil {
+		result.Status.LastSyncedAt = timestamppb.New(*sync.LastSyncedAt)
+	}
+
+	return result
+}
@@ -0,0 +1,150 @@
+package canvases
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/test/support"
+)
+
+func Test__IsValidGitRepositoryURL(t *testing.T) {
+	assert.True(t, IsValidGitRepositoryURL("https://github.com/superplanehq/canvases.git"))
+	assert.True(t, IsValidGitRepositoryURL("ssh://git@github.com/superplanehq/canvases.git"))
+	assert.True(t, IsValidGitRepositoryURL("git@github.com:superplanehq/canvases.git"))
+
+	assert.False(t, IsValidGitRepositoryURL("http://github.com/superplanehq/canvases.git"))
+	assert.False(t, IsValidGitRepositoryURL("git://github.com/superplanehq/canvases.git"))
+	assert.False(t, IsValidGitRepositoryURL("file:///srv/git/canvases.git"))
+	assert.False(t, IsValidGitRepositoryURL("/srv/git/canvases.git"))
+	assert.False(t, IsValidGitRepositoryURL("./git@github.com:canvases.git"))
+	assert.False(t, IsValidGitRepositoryURL("superplanehq/canvases"))
+	assert.False(t, IsValidGitRepositoryURL("--upload-pack=touch /tmp/pwned"))
+	assert.False(t, IsValidGitRepositoryURL("ext::sh -c touch% /tmp/pwned"))
+
+	t.Run("local paths inside of allowed directories", func(t *testing.T) {
+		t.Setenv(CanvasGitSyncLocalRepositoriesEnv, "/srv/git, /opt/repos/")
+
+		assert.True(t, IsValidGitRepositoryURL("/srv/git/canvases.git"))
+		assert.True(t, IsValidGitRepositoryURL("/opt/repos/canvases"))
+		assert.False(t, IsValidGitRepositoryURL("/srv/git"))
+		assert.False(t, IsValidGitRepositoryURL("/srv/gitlab/canvases.git"))
+		assert.False(t, IsValidGitRepositoryURL("/srv/git/../../etc"))
+		assert.False(t, IsValidGitRepositoryURL("file:///srv/git/canvases.git"))
+	})
+}
+
+func Test__ParseCanvasGitSyncFile(t *testing.T) {
+	t.Run("canvas resource", func(t *testing.T) {
+		canvas, err := ParseCanvasGitSyncFile([]byte(`
+apiVersion: v1
+kind: Canvas
+metadata:
+  name: deployments
+spec:
+  nodes:
+    - id: node-1
+      name: noop
+      type: TYPE_COMPONENT
+      component:
+        name: noop
+  edges: []
+`))
+
+		require.NoError(t, err)
+		require.Len(t, canvas.Spec.Nodes, 1)
+		assert.Equal(t, "noop", canvas.Spec.Nodes[0].Component.Name)
+	})
+
+	t.Run("other kinds -> error", func(t *testing.T) {
+		_, err := ParseCanvasGitSyncFile([]byte("apiVersion: v1\nkind: CanvasBundle\nspec: {}\n"))
+		require.ErrorContains(t, err, "unsupported resource kind")
+	})
+
+	t.Run("no spec -> error", func(t *testing.T) {
+		_, err := ParseCanvasGitSyncFile([]byte("metadata:\n  name: deployments\n"))
+		require.ErrorContains(t, err, "no spec")
+	})
+}
+
+func Test__SyncCanvasFromGit(t *testing.T) {
+	r := support.Setup(t)
+	ctx := authentication.SetUserIdInMetadata(context.Background(), r.User.String())
+
+	canvasID := createCanvasWithNoopNode(ctx, t, r, "git-sync")
+	sync := &models.CanvasGitSync{
+		CanvasID:       uuid.MustParse(canvasID),
+		OrganizationID: r.Organization.ID,
+		Repository:     "https://github.com/superplanehq/canvases.git",
+		Branch:         "main",
+		Path:           "canvases/git-sync.yaml",
+		Enabled:        true,
+		CreatedBy:      r.User,
+	}
+
+	require.NoError(t, models.SaveCanvasGitSyncInTransaction(database.Conn(), sync))
+
+	canvasFile := func(nodeName string) []byte {
+		return []byte(`
+apiVersion: v1
+kind: Canvas
+metadata:
+  name: git-sync
+spec:
+  nodes:
+    - id: node-1
+      name: ` + nodeName + `
+      type: TYPE_COMPONENT
+      component:
+        name: noop
+  edges: []
+`)
+	}
+
+	t.Run("file matching the live canvas -> no change request", func(t *testing.T) {
+		require.NoError(t, SyncCanvasFromGit(ctx, r.Encryptor, r.Registry, sync, canvasFile("Initial Name"), testWebhookBaseURL))
+
+		assert.NotEmpty(t, sync.LastContentDigest)
+		assert.NotNil(t, sync.LastLiveVersionID)
+		assert.Nil(t, sync.LastChangeRequestID)
+		assert.False(t, sync.DriftDetected)
+	})
+
+	t.Run("file changed -> change request is opened", func(t *testing.T) {
+		require.NoError(t, SyncCanvasFromGit(ctx, r.Encryptor, r.Registry, sync, canvasFile("From Git"), testWebhookBaseURL))
+		require.NotNil(t, sync.LastChangeRequestID)
+
+		request, err := models.FindCanvasChangeRequest(sync.CanvasID, *sync.LastChangeRequestID)
+		require.NoError(t, err)
+		assert.Equal(t, "Sync from https://github.com/superplanehq/canvases.git@main", request.Title)
+		assert.Equal(t, models.CanvasChangeRequestStatusOpen, request.Status)
+		assert.Equal(t, []string{"node-1"}, []string(request.ChangedNodeIDs))
+		assert.False(t, sync.DriftDetected)
+	})
+
+	t.Run("file unchanged -> no new change request", func(t *testing.T) {
+		changeRequestID := *sync.LastChangeRequestID
+		require.NoError(t, SyncCanvasFromGit(ctx, r.Encryptor, r.Registry, sync, canvasFile("From Git"), testWebhookBaseURL))
+
+		assert.Equal(t, changeRequestID, *sync.LastChangeRequestID)
+		assert.False(t, sync.DriftDetected)
+	})
+
+	t.Run("live canvas edited outside of the repository -> drift", func(t *testing.T) {
+		liveVersion, err := models.FindLiveCanvasVersionInTransaction(database.Conn(), sync.CanvasID)
+		require.NoError(t, err)
+
+		nodes := liveVersion.Nodes
+		nodes[0].Name = "Edited in the UI"
+		_, err = models.CreatePublishedCanvasVersionInTransaction(database.Conn(), sync.CanvasID, &r.User, nodes, liveVersion.Edges, nil)
+		require.NoError(t, err)
+
+		require.NoError(t, SyncCanvasFromGit(ctx, r.Encryptor, r.Registry, sync, canvasFile("From Git"), testWebhookBaseURL))
+		assert.True(t, sync.DriftDetected)
+	})
+}
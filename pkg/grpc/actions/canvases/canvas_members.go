@@ -0,0 +1,235 @@
+package canvases
+
+import (
+	"context"
+	"sort"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/authorization"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func ListCanvasMembers(ctx context.Context, authService authorization.Authorization, organizationID, canvasID string) (*pb.ListCanvasMembersResponse, error) {
+	canvas, err := findCanvasForMembers(organizationID, canvasID)
+	if err != nil {
+		return nil, err
+	}
+
+	roles, err := authService.GetCanvasUserRoles(canvas.ID.String())
+	if err != nil {
+		log.Errorf("failed to get roles for canvas %s: %v", canvas.ID.String(), err)
+		return nil, status.Error(codes.Internal, "failed to list canvas members")
+	}
+
+	userIDs := make([]string, 0, len(roles))
+	for userID := range roles {
+		userIDs = append(userIDs, userID)
+	}
+
+	//
+	// Users removed from the organization are not members of its canvases anymore,
+	// even if their canvas roles were not cleaned up.
+	//
+	users, err := models.ListActiveUsersByID(organizationID, userIDs)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list canvas members")
+	}
+
+	members := make([]*pb.CanvasMember, 0, len(users))
+	for _, user := range users {
+		members = append(members, serializeCanvasMember(&user, roles[user.ID.String()]))
+	}
+
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].UserName < members[j].UserName
+	})
+
+	return &pb.ListCanvasMembersResponse{Members: members}, nil
+}
+
+func UpdateCanvasMember(ctx context.Context, authService authorization.Authorization, organizationID, canvasID, userID, role string) (*pb.UpdateCanvasMemberResponse, error) {
+	canvas, err := findCanvasForMembers(organizationID, canvasID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateCanvasRole(authService, role); err != nil {
+		return nil, err
+	}
+
+	user, err := models.FindActiveUserByID(organizationID, userID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+
+	err = authService.AssignRole(user.ID.String(), role, canvas.ID.String(), models.DomainTypeCanvas)
+	if err != nil {
+		log.Errorf("failed to assign role %s on canvas %s to user %s: %v", role, canvas.ID.String(), user.ID.String(), err)
+		return nil, status.Error(codes.Internal, "failed to update canvas member")
+	}
+
+	return &pb.UpdateCanvasMemberResponse{
+		Member: serializeCanvasMember(user, role),
+	}, nil
+}
+
+func RemoveCanvasMember(ctx context.Context, authService authorization.Authorization, organizationID, canvasID, userID string) (*pb.RemoveCanvasMemberResponse, error) {
+	canvas, err := findCanvasForMembers(organizationID, canvasID)
+	if err != nil {
+		return nil, err
+	}
+
+	roles, err := authService.GetCanvasUserRoles(canvas.ID.String())
+	if err != nil {
+		log.Errorf("failed to get roles for canvas %s: %v", canvas.ID.String(), err)
+		return nil, status.Error(codes.Internal, "failed to remove canvas member")
+	}
+
+	if _, ok := roles[userID]; !ok {
+		return nil, status.Error(codes.NotFound, "user is not a member of this canvas")
+	}
+
+	err = authService.RemoveCanvasUserRole(canvas.ID.String(), userID)
+	if err != nil {
+		log.Errorf("failed to remove user %s from canvas %s: %v", userID, canvas.ID.String(), err)
+		return nil, status.Error(codes.Internal, "failed to remove canvas member")
+	}
+
+	return &pb.RemoveCanvasMemberResponse{}, nil
+}
+
+func ListCanvasGroups(ctx context.Context, authService authorization.Authorization, organizationID, canvasID string) (*pb.ListCanvasGroupsResponse, error) {
+	canvas, err := findCanvasForMembers(organizationID, canvasID)
+	if err != nil {
+		return nil, err
+	}
+
+	roles, err := authService.GetCanvasGroupRoles(canvas.ID.String())
+	if err != nil {
+		log.Errorf("failed to get group roles for canvas %s: %v", canvas.ID.String(), err)
+		return nil, status.Error(codes.Internal, "failed to list canvas groups")
+	}
+
+	groups := make([]*pb.CanvasGroup, 0, len(roles))
+	for groupName, role := range roles {
+		groups = append(groups, serializeCanvasGroup(organizationID, groupName, role))
+	}
+
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].GroupName < groups[j].GroupName
+	})
+
+	return &pb.ListCanvasGroupsResponse{Groups: groups}, nil
+}
+
+func UpdateCanvasGroup(ctx context.Context, authService authorization.Authorization, organizationID, canvasID, groupName, role string) (*pb.UpdateCanvasGroupResponse, error) {
+	canvas, err := findCanvasForMembers(organizationID, canvasID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateCanvasRole(authService, role); err != nil {
+		return nil, err
+	}
+
+	if groupName == "" {
+		return nil, status.Error(codes.InvalidArgument, "group name must be specified")
+	}
+
+	if _, err := authService.GetGroupRole(organizationID, models.DomainTypeOrganization, groupName); err != nil {
+		return nil, status.Error(codes.NotFound, "group not found")
+	}
+
+	err = authService.AssignCanvasGroupRole(organizationID, canvas.ID.String(), groupName, role)
+	if err != nil {
+		log.Errorf("failed to assign role %s on canvas %s to group %s: %v", role, canvas.ID.String(), groupName, err)
+		return nil, status.Error(codes.Internal, "failed to update canvas group")
+	}
+
+	return &pb.UpdateCanvasGroupResponse{
+		Group: serializeCanvasGroup(organizationID, groupName, role),
+	}, nil
+}
+
+func RemoveCanvasGroup(ctx context.Context, authService authorization.Authorization, organizationID, canvasID, groupName string) (*pb.RemoveCanvasGroupResponse, error) {
+	canvas, err := findCanvasForMembers(organizationID, canvasID)
+	if err != nil {
+		return nil, err
+	}
+
+	roles, err := authService.GetCanvasGroupRoles(canvas.ID.String())
+	if err != nil {
+		log.Errorf("failed to get group roles for canvas %s: %v", canvas.ID.String(), err)
+		return nil, status.Error(codes.Internal, "failed to remove canvas group")
+	}
+
+	if _, ok := roles[groupName]; !ok {
+		return nil, status.Error(codes.NotFound, "group has no role on this canvas")
+	}
+
+	err = authService.RemoveCanvasGroupRole(canvas.ID.String(), groupName)
+	if err != nil {
+		log.Errorf("failed to remove group %s from canvas %s: %v", groupName, canvas.ID.String(), err)
+		return nil, status.Error(codes.Internal, "failed to remove canvas group")
+	}
+
+	return &pb.RemoveCanvasGroupResponse{}, nil
+}
+
+func findCanvasForMembers(organizationID, canvasID string) (*models.Canvas, error) {
+	id, err := uuid.Parse(canvasID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid canvas id")
+	}
+
+	canvas, err := models.FindCanvas(uuid.MustParse(organizationID), id)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "canvas not found")
+	}
+
+	if canvas.IsTemplate {
+		return nil, status.Error(codes.FailedPrecondition, "templates are read-only")
+	}
+
+	return canvas, nil
+}
+
+func validateCanvasRole(authService authorization.Authorization, role string) error {
+	if role == "" {
+		return status.Error(codes.InvalidArgument, "role must be specified")
+	}
+
+	if !authService.IsDefaultRole(role, models.DomainTypeCanvas) {
+		return status.Errorf(codes.InvalidArgument, "invalid canvas role %s", role)
+	}
+
+	return nil
+}
+
+func serializeCanvasMember(user *models.User, role string) *pb.CanvasMember {
+	return &pb.CanvasMember{
+		UserId:    user.ID.String(),
+		UserName:  user.Name,
+		UserEmail: user.GetEmail(),
+		Role:      role,
+	}
+}
+
+func serializeCanvasGroup(organizationID, groupName, role string) *pb.CanvasGroup {
+	group := &pb.CanvasGroup{
+		GroupName:   groupName,
+		DisplayName: groupName,
+		Role:        role,
+	}
+
+	metadata, err := models.FindGroupMetadata(groupName, models.DomainTypeOrganization, organizationID)
+	if err == nil && metadata.DisplayName != "" {
+		group.DisplayName = metadata.DisplayName
+	}
+
+	return group
+}
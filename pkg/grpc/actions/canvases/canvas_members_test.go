@@ -0,0 +1,86 @@
+package canvases
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCanvasMembers(t *testing.T) {
+	r := support.Setup(t)
+	ctx := authentication.SetUserIdInMetadata(context.Background(), r.User.String())
+	orgID := r.Organization.ID.String()
+	canvasID := createCanvasWithNoopNode(ctx, t, r, "members")
+
+	t.Run("invalid role -> error", func(t *testing.T) {
+		_, err := UpdateCanvasMember(ctx, r.AuthService, orgID, canvasID, r.User.String(), models.RoleOrgAdmin)
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("user not in organization -> error", func(t *testing.T) {
+		_, err := UpdateCanvasMember(ctx, r.AuthService, orgID, canvasID, uuid.NewString(), models.RoleCanvasEditor)
+		require.Error(t, err)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("add, update and remove member", func(t *testing.T) {
+		response, err := UpdateCanvasMember(ctx, r.AuthService, orgID, canvasID, r.User.String(), models.RoleCanvasOperator)
+		require.NoError(t, err)
+		assert.Equal(t, models.RoleCanvasOperator, response.Member.Role)
+
+		_, err = UpdateCanvasMember(ctx, r.AuthService, orgID, canvasID, r.User.String(), models.RoleCanvasEditor)
+		require.NoError(t, err)
+
+		members, err := ListCanvasMembers(ctx, r.AuthService, orgID, canvasID)
+		require.NoError(t, err)
+		require.Len(t, members.Members, 1)
+		assert.Equal(t, r.User.String(), members.Members[0].UserId)
+		assert.Equal(t, models.RoleCanvasEditor, members.Members[0].Role)
+
+		_, err = RemoveCanvasMember(ctx, r.AuthService, orgID, canvasID, r.User.String())
+		require.NoError(t, err)
+
+		members, err = ListCanvasMembers(ctx, r.AuthService, orgID, canvasID)
+		require.NoError(t, err)
+		assert.Empty(t, members.Members)
+
+		_, err = RemoveCanvasMember(ctx, r.AuthService, orgID, canvasID, r.User.String())
+		require.Error(t, err)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("add and remove group", func(t *testing.T) {
+		err := r.AuthService.CreateGroup(orgID, models.DomainTypeOrganization, "deployers", models.RoleOrgViewer, "Deployers", "")
+		require.NoError(t, err)
+
+		_, err = UpdateCanvasGroup(ctx, r.AuthService, orgID, canvasID, "unknown", models.RoleCanvasViewer)
+		require.Error(t, err)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+
+		response, err := UpdateCanvasGroup(ctx, r.AuthService, orgID, canvasID, "deployers", models.RoleCanvasOperator)
+		require.NoError(t, err)
+		assert.Equal(t, "Deployers", response.Group.DisplayName)
+
+		groups, err := ListCanvasGroups(ctx, r.AuthService, orgID, canvasID)
+		require.NoError(t, err)
+		require.Len(t, groups.Groups, 1)
+		assert.Equal(t, "deployers", groups.Groups[0].GroupName)
+		assert.Equal(t, models.RoleCanvasOperator, groups.Groups[0].Role)
+
+		_, err = RemoveCanvasGroup(ctx, r.AuthService, orgID, canvasID, "deployers")
+		require.NoError(t, err)
+
+		groups, err = ListCanvasGroups(ctx, r.AuthService, orgID, canvasID)
+		require.NoError(t, err)
+		assert.Empty(t, groups.Groups)
+	})
+}
@@ -0,0 +1,181 @@
+package canvases
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func ListCanvasMemoryNamespaces(ctx context.Context, organizationID, canvasID string) (*pb.ListCanvasMemoryNamespacesResponse, error) {
+	canvasUUID, err := findCanvasForMemoryNamespaces(organizationID, canvasID)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := models.ListCanvasMemoryNamespaces(canvasUUID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list canvas memory namespaces")
+	}
+
+	namespaces := make([]*pb.CanvasMemoryNamespace, 0, len(records))
+	for _, record := range records {
+		namespaces = append(namespaces, serializeCanvasMemoryNamespace(record))
+	}
+
+	return &pb.ListCanvasMemoryNamespacesResponse{
+		Namespaces: namespaces,
+	}, nil
+}
+
+func UpdateCanvasMemoryNamespace(ctx context.Context, organizationID string, req *pb.UpdateCanvasMemoryNamespaceRequest) (*pb.UpdateCanvasMemoryNamespaceResponse, error) {
+	canvasUUID, err := findCanvasForMemoryNamespaces(organizationID, req.CanvasId)
+	if err != nil {
+		return nil, err
+	}
+
+	namespace := strings.TrimSpace(req.Namespace)
+	if namespace == "" {
+		return nil, status.Error(codes.InvalidArgument, "namespace is required")
+	}
+
+	if req.TtlSeconds < 0 {
+		return nil, status.Error(codes.InvalidArgument, "ttl_seconds must not be negative")
+	}
+
+	fields, err := parseCanvasMemoryFields(req.Fields)
+	if err != nil {
+		return nil, err
+	}
+
+	indexedFields, err := parseCanvasMemoryIndexedFields(req.IndexedFields)
+	if err != nil {
+		return nil, err
+	}
+
+	record := &models.CanvasMemoryNamespace{
+		CanvasID:      canvasUUID,
+		Namespace:     namespace,
+		TTLSeconds:    int(req.TtlSeconds),
+		Fields:        fields,
+		IndexedFields: indexedFields,
+	}
+
+	if err := models.SaveCanvasMemoryNamespace(record); err != nil {
+		log.Errorf("failed to save memory namespace %s for canvas %s: %v", namespace, canvasUUID, err)
+		return nil, status.Error(codes.Internal, "failed to update canvas memory namespace")
+	}
+
+	return &pb.UpdateCanvasMemoryNamespaceResponse{
+		Namespace: serializeCanvasMemoryNamespace(*record),
+	}, nil
+}
+
+func DeleteCanvasMemoryNamespace(ctx context.Context, organizationID, canvasID, namespace string) (*pb.DeleteCanvasMemoryNamespaceResponse, error) {
+	canvasUUID, err := findCanvasForMemoryNamespaces(organizationID, canvasID)
+	if err != nil {
+		return nil, err
+	}
+
+	namespace = strings.TrimSpace(namespace)
+	if namespace == "" {
+		return nil, status.Error(codes.InvalidArgument, "namespace is required")
+	}
+
+	if err := models.DeleteCanvasMemoryNamespace(canvasUUID, namespace); err != nil {
+		log.Errorf("failed to delete memory namespace %s for canvas %s: %v", namespace, canvasUUID, err)
+		return nil, status.Error(codes.Internal, "failed to delete canvas memory namespace")
+	}
+
+	return &pb.DeleteCanvasMemoryNamespaceResponse{}, nil
+}
+
+func findCanvasForMemoryNamespaces(organizationID, canvasID string) (uuid.UUID, error) {
+	orgUUID, err := uuid.Parse(organizationID)
+	if err != nil {
+		return uuid.Nil, status.Error(codes.InvalidArgument, "invalid organization_id")
+	}
+
+	canvasUUID, err := uuid.Parse(canvasID)
+	if err != nil {
+		return uuid.Nil, status.Error(codes.InvalidArgument, "invalid canvas_id")
+	}
+
+	_, err = models.FindCanvas(orgUUID, canvasUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return uuid.Nil, status.Error(codes.NotFound, "canvas not found")
+		}
+		return uuid.Nil, status.Error(codes.Internal, "failed to load canvas")
+	}
+
+	return canvasUUID, nil
+}
+
+func parseCanvasMemoryFields(fields []*pb.CanvasMemoryNamespace_Field) ([]models.CanvasMemoryField, error) {
+	result := make([]models.CanvasMemoryField, 0, len(fields))
+	for i, field := range fields {
+		name := strings.TrimSpace(field.Name)
+		if name == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "field %d: name is required", i)
+		}
+
+		if slices.ContainsFunc(result, func(f models.CanvasMemoryField) bool { return f.Name == name }) {
+			return nil, status.Errorf(codes.InvalidArgument, "field %s: duplicate field name", name)
+		}
+
+		if !models.IsValidCanvasMemoryFieldType(field.Type) {
+			return nil, status.Errorf(codes.InvalidArgument, "field %s: invalid type %q", name, field.Type)
+		}
+
+		result = append(result, models.CanvasMemoryField{
+			Name:     name,
+			Type:     field.Type,
+			Required: field.Required,
+		})
+	}
+
+	return result, nil
+}
+
+func parseCanvasMemoryIndexedFields(fields []string) ([]string, error) {
+	result := make([]string, 0, len(fields))
+	for _, field := range fields {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			return nil, status.Error(codes.InvalidArgument, "indexed field name is required")
+		}
+
+		if !slices.Contains(result, field) {
+			result = append(result, field)
+		}
+	}
+
+	return result, nil
+}
+
+func serializeCanvasMemoryNamespace(record models.CanvasMemoryNamespace) *pb.CanvasMemoryNamespace {
+	fields := make([]*pb.CanvasMemoryNamespace_Field, 0, len(record.Fields))
+	for _, field := range record.Fields {
+		fields = append(fields, &pb.CanvasMemoryNamespace_Field{
+			Name:     field.Name,
+			Type:     field.Type,
+			Required: field.Required,
+		})
+	}
+
+	return &pb.CanvasMemoryNamespace{
+		Namespace:     record.Namespace,
+		TtlSeconds:    int32(record.TTLSeconds),
+		Fields:        fields,
+		IndexedFields: record.IndexedFields,
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/superplanehq/superplane/pkg/authentication"
@@ -25,7 +26,7 @@ func TestCreateCanvasChangeRequestCreatesOpenRequestOnly(t *testing.T) {
 	canvasID := createCanvasWithNoopNode(ctx, t, r, "create-cr-open-only")
 	draftVersionID := createDraftVersion(ctx, t, r, canvasID, "Draft Name")
 
-	createResponse, err := CreateCanvasChangeRequest(ctx, r.Organization.ID.String(), canvasID, draftVersionID)
+	createResponse, err := CreateCanvasChangeRequest(ctx, r.Registry, r.Organization.ID.String(), canvasID, draftVersionID)
 	require.NoError(t, err)
 	require.NotNil(t, createResponse.ChangeRequest)
 
@@ -39,7 +40,7 @@ func TestActOnCanvasChangeRequestRejectAndReopen(t *testing.T) {
 
 	canvasID := createCanvasWithNoopNode(ctx, t, r, "reject-reopen")
 	draftVersionID := createDraftVersion(ctx, t, r, canvasID, "Draft Name")
-	createResponse, err := CreateCanvasChangeRequest(ctx, r.Organization.ID.String(), canvasID, draftVersionID)
+	createResponse, err := CreateCanvasChangeRequest(ctx, r.Registry, r.Organization.ID.String(), canvasID, draftVersionID)
 	require.NoError(t, err)
 	changeRequestID := createResponse.ChangeRequest.Metadata.Id
 
@@ -71,12 +72,12 @@ func TestConflictedChangeRequestCannotBeApproved(t *testing.T) {
 	canvasID := createCanvasWithNoopNode(ctx, t, r, "conflict-approve")
 
 	firstDraftID := createDraftVersion(ctx, t, r, canvasID, "Draft One")
-	firstChangeRequestResponse, err := CreateCanvasChangeRequest(ctx, r.Organization.ID.String(), canvasID, firstDraftID)
+	firstChangeRequestResponse, err := CreateCanvasChangeRequest(ctx, r.Registry, r.Organization.ID.String(), canvasID, firstDraftID)
 	require.NoError(t, err)
 	firstChangeRequestID := firstChangeRequestResponse.ChangeRequest.Metadata.Id
 
 	secondDraftID := createDraftVersion(ctx, t, r, canvasID, "Draft Two")
-	secondChangeRequestResponse, err := CreateCanvasChangeRequest(ctx, r.Organization.ID.String(), canvasID, secondDraftID)
+	secondChangeRequestResponse, err := CreateCanvasChangeRequest(ctx, r.Registry, r.Organization.ID.String(), canvasID, secondDraftID)
 	require.NoError(t, err)
 	secondChangeRequestID := secondChangeRequestResponse.ChangeRequest.Metadata.Id
 
@@ -112,7 +113,7 @@ func TestApproveDoesNotPublishUntilPublishAction(t *testing.T) {
 
 	canvasID := createCanvasWithNoopNode(ctx, t, r, "approve-then-publish")
 	draftVersionID := createDraftVersion(ctx, t, r, canvasID, "Draft Name")
-	createResponse, err := CreateCanvasChangeRequest(ctx, r.Organization.ID.String(), canvasID, draftVersionID)
+	createResponse, err := CreateCanvasChangeRequest(ctx, r.Registry, r.Organization.ID.String(), canvasID, draftVersionID)
 	require.NoError(t, err)
 	changeRequestID := createResponse.ChangeRequest.Metadata.Id
 
@@ -145,7 +146,7 @@ func TestPublishRequiresApprovals(t *testing.T) {
 
 	canvasID := createCanvasWithNoopNode(ctx, t, r, "publish-needs-approval")
 	draftVersionID := createDraftVersion(ctx, t, r, canvasID, "Draft Name")
-	createResponse, err := CreateCanvasChangeRequest(ctx, r.Organization.ID.String(), canvasID, draftVersionID)
+	createResponse, err := CreateCanvasChangeRequest(ctx, r.Registry, r.Organization.ID.String(), canvasID, draftVersionID)
 	require.NoError(t, err)
 
 	_, err = actOnCanvasChangeRequestAction(
@@ -159,13 +160,65 @@ func TestPublishRequiresApprovals(t *testing.T) {
 	assert.Equal(t, codes.FailedPrecondition, grpcstatus.Code(err))
 }
 
+func TestPublishRequiresPassingChecks(t *testing.T) {
+	r := support.Setup(t)
+	ctx := authentication.SetUserIdInMetadata(context.Background(), r.User.String())
+
+	canvasID := createCanvasWithNoopNode(ctx, t, r, "publish-needs-checks")
+	draftVersionID := createDraftVersion(ctx, t, r, canvasID, "Draft Name")
+	createResponse, err := CreateCanvasChangeRequest(ctx, r.Registry, r.Organization.ID.String(), canvasID, draftVersionID)
+	require.NoError(t, err)
+	changeRequestID := createResponse.ChangeRequest.Metadata.Id
+
+	require.Len(t, createResponse.ChangeRequest.Checks, 2)
+	for _, check := range createResponse.ChangeRequest.Checks {
+		assert.Equal(t, pb.CanvasChangeRequestCheck_STATUS_PASSED, check.Status, check.Name)
+	}
+
+	_, err = UpdateCanvas(ctx, r.AuthService, r.Organization.ID.String(), canvasID, nil, nil, nil, nil,
+		&pb.CanvasChangeRequestCheckConfig{ForbiddenComponents: []string{"noop"}},
+		nil,
+		nil,
+	)
+	require.NoError(t, err)
+
+	_, err = actOnCanvasChangeRequestAction(ctx, r, canvasID, changeRequestID, pb.ActOnCanvasChangeRequestRequest_ACTION_APPROVE)
+	require.NoError(t, err)
+
+	_, err = actOnCanvasChangeRequestAction(ctx, r, canvasID, changeRequestID, pb.ActOnCanvasChangeRequestRequest_ACTION_PUBLISH)
+	require.Error(t, err)
+	assert.Equal(t, codes.FailedPrecondition, grpcstatus.Code(err))
+	assert.Contains(t, grpcstatus.Convert(err).Message(), models.CanvasChangeRequestCheckForbiddenComponents)
+
+	//
+	// The results of the checks run on publish are kept,
+	// even though the publish was rolled back.
+	//
+	changeRequest, err := models.FindCanvasChangeRequest(uuid.MustParse(canvasID), uuid.MustParse(changeRequestID))
+	require.NoError(t, err)
+	assert.Equal(t, []string{models.CanvasChangeRequestCheckForbiddenComponents}, changeRequest.FailedChecks())
+	assert.Equal(t, models.CanvasChangeRequestStatusOpen, changeRequest.Status)
+
+	_, err = UpdateCanvas(ctx, r.AuthService, r.Organization.ID.String(), canvasID, nil, nil, nil, nil,
+		&pb.CanvasChangeRequestCheckConfig{},
+		nil,
+		nil,
+	)
+	require.NoError(t, err)
+
+	publishResponse, err := actOnCanvasChangeRequestAction(ctx, r, canvasID, changeRequestID, pb.ActOnCanvasChangeRequestRequest_ACTION_PUBLISH)
+	require.NoError(t, err)
+	assert.Equal(t, pb.CanvasChangeRequest_STATUS_PUBLISHED, publishResponse.ChangeRequest.Metadata.Status)
+	require.Len(t, publishResponse.ChangeRequest.Checks, 2)
+}
+
 func TestUnapproveRequiresReapprovalBeforePublish(t *testing.T) {
 	r := support.Setup(t)
 	ctx := authentication.SetUserIdInMetadata(context.Background(), r.User.String())
 
 	canvasID := createCanvasWithNoopNode(ctx, t, r, "unapprove-before-publish")
 	draftVersionID := createDraftVersion(ctx, t, r, canvasID, "Draft Name")
-	createResponse, err := CreateCanvasChangeRequest(ctx, r.Organization.ID.String(), canvasID, draftVersionID)
+	createResponse, err := CreateCanvasChangeRequest(ctx, r.Registry, r.Organization.ID.String(), canvasID, draftVersionID)
 	require.NoError(t, err)
 	changeRequestID := createResponse.ChangeRequest.Metadata.Id
 
@@ -205,7 +258,7 @@ func TestRejectInvalidatesActiveApprovalsAndReopenAllowsApprovalsAgain(t *testin
 
 	canvasID := createCanvasWithNoopNode(ctx, t, r, "reject-invalidates-approvals")
 	draftVersionID := createDraftVersion(ctx, t, r, canvasID, "Draft Name")
-	createResponse, err := CreateCanvasChangeRequest(ctx, r.Organization.ID.String(), canvasID, draftVersionID)
+	createResponse, err := CreateCanvasChangeRequest(ctx, r.Registry, r.Organization.ID.String(), canvasID, draftVersionID)
 	require.NoError(t, err)
 	changeRequestID := createResponse.ChangeRequest.Metadata.Id
 
@@ -0,0 +1,333 @@
+package canvases
+
+import (
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/exprruntime"
+	"github.com/superplanehq/superplane/pkg/grpc/actions"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"github.com/superplanehq/superplane/pkg/registry"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// canvasChangeRequestCheck is an automated validation of the version proposed by a change request.
+// Checks that are not enabled for a canvas are not run, and are not recorded on the change request.
+type canvasChangeRequestCheck interface {
+	Name() string
+	Enabled(config models.CanvasChangeRequestCheckConfig) bool
+	Run(input *canvasChangeRequestCheckInput) []models.CanvasChangeRequestCheckProblem
+}
+
+type canvasChangeRequestCheckInput struct {
+	registry       *registry.Registry
+	organizationID string
+	canvas         *models.Canvas
+	version        *models.CanvasVersion
+}
+
+var canvasChangeRequestChecks = []canvasChangeRequestCheck{
+	&configurationCheck{},
+	&expressionsCheck{},
+	&forbiddenComponentsCheck{},
+	&simulationCheck{},
+}
+
+func runCanvasChangeRequestChecks(
+	registry *registry.Registry,
+	organizationID string,
+	canvas *models.Canvas,
+	version *models.CanvasVersion,
+) []models.CanvasChangeRequestCheck {
+	input := &canvasChangeRequestCheckInput{
+		registry:       registry,
+		organizationID: organizationID,
+		canvas:         canvas,
+		version:        version,
+	}
+
+	config := canvas.ChangeRequestChecks.Data()
+	now := time.Now()
+	results := []models.CanvasChangeRequestCheck{}
+	for _, check := range canvasChangeRequestChecks {
+		if !check.Enabled(config) {
+			continue
+		}
+
+		result := models.CanvasChangeRequestCheck{
+			Name:      check.Name(),
+			Status:    models.CanvasChangeRequestCheckStatusPassed,
+			Problems:  check.Run(input),
+			CheckedAt: &now,
+		}
+
+		if len(result.Problems) > 0 {
+			result.Status = models.CanvasChangeRequestCheckStatusFailed
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
+
+func parseCanvasChangeRequestCheckConfig(
+	config *pb.CanvasChangeRequestCheckConfig,
+) (models.CanvasChangeRequestCheckConfig, error) {
+	parsed := models.CanvasChangeRequestCheckConfig{
+		SimulationEnabled: config.SimulationEnabled,
+	}
+
+	for _, pattern := range config.ForbiddenComponents {
+		pattern = strings.TrimSpace(pattern)
+		if pattern == "" {
+			return parsed, fmt.Errorf("forbidden component patterns cannot be empty")
+		}
+
+		if _, err := path.Match(pattern, ""); err != nil {
+			return parsed, fmt.Errorf("invalid forbidden component pattern %q", pattern)
+		}
+
+		if !slices.Contains(parsed.ForbiddenComponents, pattern) {
+			parsed.ForbiddenComponents = append(parsed.ForbiddenComponents, pattern)
+		}
+	}
+
+	return parsed, nil
+}
+
+func ensureCanvasChangeRequestChecksPassed(request *models.CanvasChangeRequest) error {
+	failed := request.FailedChecks()
+	if len(failed) == 0 {
+		return nil
+	}
+
+	return status.Errorf(codes.FailedPrecondition, "change request checks have not passed: %s", strings.Join(failed, ", "))
+}
+
+// configurationCheck validates the configuration of every node
+// against the current definition of its component, trigger, widget or blueprint.
+type configurationCheck struct{}
+
+func (c *configurationCheck) Name() string {
+	return models.CanvasChangeRequestCheckConfiguration
+}
+
+func (c *configurationCheck) Enabled(_ models.CanvasChangeRequestCheckConfig) bool {
+	return true
+}
+
+func (c *configurationCheck) Run(input *canvasChangeRequestCheckInput) []models.CanvasChangeRequestCheckProblem {
+	problems := []models.CanvasChangeRequestCheckProblem{}
+	for _, node := range actions.NodesToProto(input.version.Nodes) {
+		if err := validateNodeRef(input.registry, input.organizationID, node); err != nil {
+			problems = append(problems, models.CanvasChangeRequestCheckProblem{
+				NodeID:  node.Id,
+				Message: err.Error(),
+			})
+		}
+	}
+
+	return problems
+}
+
+// expressionsCheck validates the expressions in node configurations,
+// using the nodes upstream of each node. Only errors fail the check.
+type expressionsCheck struct{}
+
+func (c *expressionsCheck) Name() string {
+	return models.CanvasChangeRequestCheckExpressions
+}
+
+func (c *expressionsCheck) Enabled(_ models.CanvasChangeRequestCheckConfig) bool {
+	return true
+}
+
+func (c *expressionsCheck) Run(input *canvasChangeRequestCheckInput) []models.CanvasChangeRequestCheckProblem {
+	nodes := input.version.Nodes
+	edges := input.version.Edges
+	vars := models.CanvasVariablesForExpressions(input.version.Variables, input.canvas.Environment)
+
+	problems := []models.CanvasChangeRequestCheckProblem{}
+	for _, node := range nodes {
+		if node.Type != models.NodeTypeComponent && node.Type != models.NodeTypeBlueprint {
+			continue
+		}
+
+		expressions := actions.FindConfigurationExpressions(actions.NodeConfigurationFields(input.registry, node), node.Configuration)
+		if len(expressions) == 0 {
+			continue
+		}
+
+		scope := actions.ExpressionScope(input.registry, nodes, edges, node.ID)
+		scope.Vars = vars
+		for _, expression := range expressions {
+			for _, diagnostic := range exprruntime.ValidateText(expression.Text, scope) {
+				if diagnostic.Severity != exprruntime.SeverityError {
+					continue
+				}
+
+				problems = append(problems, models.CanvasChangeRequestCheckProblem{
+					NodeID:  node.ID,
+					Message: fmt.Sprintf("%s: %s", expression.Path, diagnostic.Message),
+				})
+			}
+		}
+	}
+
+	return problems
+}
+
+// forbiddenComponentsCheck fails when the canvas uses a component or trigger
+// that matches one of the forbidden patterns of the canvas.
+type forbiddenComponentsCheck struct{}
+
+func (c *forbiddenComponentsCheck) Name() string {
+	return models.CanvasChangeRequestCheckForbiddenComponents
+}
+
+func (c *forbiddenComponentsCheck) Enabled(config models.CanvasChangeRequestCheckConfig) bool {
+	return len(config.ForbiddenComponents) > 0
+}
+
+func (c *forbiddenComponentsCheck) Run(input *canvasChangeRequestCheckInput) []models.CanvasChangeRequestCheckProblem {
+	patterns := input.canvas.ChangeRequestChecks.Data().ForbiddenComponents
+
+	problems := []models.CanvasChangeRequestCheckProblem{}
+	for _, node := range input.version.Nodes {
+		kind, name := "", ""
+		switch {
+		case node.Ref.Component != nil:
+			kind, name = "component", node.Ref.Component.Name
+		case node.Ref.Trigger != nil:
+			kind, name = "trigger", node.Ref.Trigger.Name
+		default:
+			continue
+		}
+
+		for _, pattern := range patterns {
+			if matched, _ := path.Match(pattern, name); matched {
+				problems = append(problems, models.CanvasChangeRequestCheckProblem{
+					NodeID:  node.ID,
+					Message: fmt.Sprintf("%s %s is not allowed on this canvas", kind, name),
+				})
+				break
+			}
+		}
+	}
+
+	return problems
+}
+
+// simulationCheck follows the events emitted by the triggers of the canvas through its edges,
+// without running anything. It fails on edges from output channels that the source node
+// does not have, and on nodes that no event can reach.
+type simulationCheck struct{}
+
+func (c *simulationCheck) Name() string {
+	return models.CanvasChangeRequestCheckSimulation
+}
+
+func (c *simulationCheck) Enabled(config models.CanvasChangeRequestCheckConfig) bool {
+	return config.SimulationEnabled
+}
+
+func (c *simulationCheck) Run(input *canvasChangeRequestCheckInput) []models.CanvasChangeRequestCheckProblem {
+	nodesByID := make(map[string]models.Node, len(input.version.Nodes))
+	for _, node := range input.version.Nodes {
+		nodesByID[node.ID] = node
+	}
+
+	outgoing := map[string][]models.Edge{}
+	for _, edge := range input.version.Edges {
+		outgoing[edge.SourceID] = append(outgoing[edge.SourceID], edge)
+	}
+
+	problems := []models.CanvasChangeRequestCheckProblem{}
+	reached := map[string]bool{}
+	queue := []string{}
+	for _, node := range input.version.Nodes {
+		if node.Type == models.NodeTypeTrigger {
+			reached[node.ID] = true
+			queue = append(queue, node.ID)
+		}
+	}
+
+	for len(queue) > 0 {
+		current := nodesByID[queue[0]]
+		queue = queue[1:]
+
+		channels := c.outputChannels(input, current)
+		for _, edge := range outgoing[current.ID] {
+			if channels != nil && !slices.Contains(channels, edge.Channel) {
+				problems = append(problems, models.CanvasChangeRequestCheckProblem{
+					NodeID:  current.ID,
+					Message: fmt.Sprintf("%s has no output channel %s", current.Name, edge.Channel),
+				})
+				continue
+			}
+
+			if reached[edge.TargetID] {
+				continue
+			}
+
+			reached[edge.TargetID] = true
+			queue = append(queue, edge.TargetID)
+		}
+	}
+
+	for _, node := range input.version.Nodes {
+		if node.Type == models.NodeTypeWidget || reached[node.ID] {
+			continue
+		}
+
+		problems = append(problems, models.CanvasChangeRequestCheckProblem{
+			NodeID:  node.ID,
+			Message: fmt.Sprintf("%s is not reachable from any trigger", node.Name),
+		})
+	}
+
+	return problems
+}
+
+// outputChannels returns the names of the output channels of a node,
+// or nil if they are not known.
+func (c *simulationCheck) outputChannels(input *canvasChangeRequestCheckInput, node models.Node) []string {
+	switch {
+	case node.Type == models.NodeTypeTrigger:
+		return []string{core.DefaultOutputChannel.Name}
+
+	case node.Type == models.NodeTypeComponent && node.Ref.Component != nil:
+		component, err := input.registry.GetComponent(node.Ref.Component.Name)
+		if err != nil {
+			return nil
+		}
+
+		channels := []string{}
+		for _, channel := range component.OutputChannels(nil) {
+			channels = append(channels, channel.Name)
+		}
+		return channels
+
+	case node.Type == models.NodeTypeBlueprint && node.Ref.Blueprint != nil:
+		blueprint, err := models.FindBlueprint(input.organizationID, node.Ref.Blueprint.ID)
+		if err != nil {
+			return nil
+		}
+
+		channels := []string{}
+		for _, channel := range blueprint.OutputChannels {
+			channels = append(channels, channel.Name)
+		}
+		return channels
+
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,187 @@
+package canvases
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	ifp "github.com/superplanehq/superplane/pkg/components/if"
+	"github.com/superplanehq/superplane/pkg/components/noop"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"github.com/superplanehq/superplane/pkg/registry"
+	manual "github.com/superplanehq/superplane/pkg/triggers/start"
+	"gorm.io/datatypes"
+)
+
+func TestRunCanvasChangeRequestChecks(t *testing.T) {
+	r := &registry.Registry{
+		Components: map[string]core.Component{
+			"noop": &noop.NoOp{},
+			"if":   &ifp.If{},
+		},
+		Triggers: map[string]core.Trigger{
+			"start": &manual.Start{},
+		},
+	}
+
+	version := &models.CanvasVersion{
+		ID: uuid.New(),
+		Nodes: datatypes.NewJSONSlice([]models.Node{
+			{
+				ID:   "start",
+				Name: "Start",
+				Type: models.NodeTypeTrigger,
+				Ref:  models.NodeRef{Trigger: &models.TriggerRef{Name: "start"}},
+			},
+			{
+				ID:            "check",
+				Name:          "Check",
+				Type:          models.NodeTypeComponent,
+				Ref:           models.NodeRef{Component: &models.ComponentRef{Name: "if"}},
+				Configuration: map[string]any{"expression": "1 +"},
+			},
+			{
+				ID:   "notify",
+				Name: "Notify",
+				Type: models.NodeTypeComponent,
+				Ref:  models.NodeRef{Component: &models.ComponentRef{Name: "noop"}},
+			},
+			{
+				ID:            "orphan",
+				Name:          "Orphan",
+				Type:          models.NodeTypeComponent,
+				Ref:           models.NodeRef{Component: &models.ComponentRef{Name: "if"}},
+				Configuration: map[string]any{},
+			},
+		}),
+		Edges: datatypes.NewJSONSlice([]models.Edge{
+			{SourceID: "start", TargetID: "check", Channel: "default"},
+			{SourceID: "check", TargetID: "notify", Channel: "yes"},
+		}),
+	}
+
+	findCheck := func(checks []models.CanvasChangeRequestCheck, name string) *models.CanvasChangeRequestCheck {
+		for i := range checks {
+			if checks[i].Name == name {
+				return &checks[i]
+			}
+		}
+		return nil
+	}
+
+	t.Run("optional checks are not run by default", func(t *testing.T) {
+		checks := runCanvasChangeRequestChecks(r, uuid.NewString(), &models.Canvas{}, version)
+		require.Len(t, checks, 2)
+
+		configuration := findCheck(checks, models.CanvasChangeRequestCheckConfiguration)
+		require.NotNil(t, configuration)
+		assert.Equal(t, models.CanvasChangeRequestCheckStatusFailed, configuration.Status)
+		require.Len(t, configuration.Problems, 1)
+		assert.Equal(t, "orphan", configuration.Problems[0].NodeID)
+
+		expressions := findCheck(checks, models.CanvasChangeRequestCheckExpressions)
+		require.NotNil(t, expressions)
+		assert.Equal(t, models.CanvasChangeRequestCheckStatusFailed, expressions.Status)
+		require.Len(t, expressions.Problems, 1)
+		assert.Equal(t, "check", expressions.Problems[0].NodeID)
+		assert.Contains(t, expressions.Problems[0].Message, "expression: ")
+	})
+
+	t.Run("forbidden components and simulation", func(t *testing.T) {
+		canvas := &models.Canvas{
+			ChangeRequestChecks: datatypes.NewJSONType(models.CanvasChangeRequestCheckConfig{
+				ForbiddenComponents: []string{"no*", "github.*"},
+				SimulationEnabled:   true,
+			}),
+		}
+
+		checks := runCanvasChangeRequestChecks(r, uuid.NewString(), canvas, version)
+		require.Len(t, checks, 4)
+
+		forbidden := findCheck(checks, models.CanvasChangeRequestCheckForbiddenComponents)
+		require.NotNil(t, forbidden)
+		assert.Equal(t, models.CanvasChangeRequestCheckStatusFailed, forbidden.Status)
+		require.Len(t, forbidden.Problems, 1)
+		assert.Equal(t, "notify", forbidden.Problems[0].NodeID)
+		assert.Equal(t, "component noop is not allowed on this canvas", forbidden.Problems[0].Message)
+
+		simulation := findCheck(checks, models.CanvasChangeRequestCheckSimulation)
+		require.NotNil(t, simulation)
+		assert.Equal(t, models.CanvasChangeRequestCheckStatusFailed, simulation.Status)
+		require.Len(t, simulation.Problems, 3)
+		assert.Equal(t, "Check has no output channel yes", simulation.Problems[0].Message)
+		assert.Equal(t, "Notify is not reachable from any trigger", simulation.Problems[1].Message)
+		assert.Equal(t, "Orphan is not reachable from any trigger", simulation.Problems[2].Message)
+	})
+
+	t.Run("valid canvas -> all checks pass", func(t *testing.T) {
+		valid := &models.CanvasVersion{
+			ID: uuid.New(),
+			Nodes: datatypes.NewJSONSlice([]models.Node{
+				version.Nodes[0],
+				{
+					ID:            "check",
+					Name:          "Check",
+					Type:          models.NodeTypeComponent,
+					Ref:           models.NodeRef{Component: &models.ComponentRef{Name: "if"}},
+					Configuration: map[string]any{"expression": "1 > 0"},
+				},
+				version.Nodes[2],
+			}),
+			Edges: datatypes.NewJSONSlice([]models.Edge{
+				{SourceID: "start", TargetID: "check", Channel: "default"},
+				{SourceID: "check", TargetID: "notify", Channel: "true"},
+			}),
+		}
+
+		canvas := &models.Canvas{
+			ChangeRequestChecks: datatypes.NewJSONType(models.CanvasChangeRequestCheckConfig{
+				ForbiddenComponents: []string{"http"},
+				SimulationEnabled:   true,
+			}),
+		}
+
+		request := &models.CanvasChangeRequest{
+			Checks: datatypes.NewJSONSlice(runCanvasChangeRequestChecks(r, uuid.NewString(), canvas, valid)),
+		}
+
+		require.Len(t, request.Checks, 4)
+		for _, check := range request.Checks {
+			assert.Equal(t, models.CanvasChangeRequestCheckStatusPassed, check.Status, check.Name)
+			assert.Empty(t, check.Problems, check.Name)
+		}
+		assert.NoError(t, ensureCanvasChangeRequestChecksPassed(request))
+	})
+}
+
+func TestParseCanvasChangeRequestCheckConfig(t *testing.T) {
+	t.Run("patterns are trimmed and deduplicated", func(t *testing.T) {
+		config, err := parseCanvasChangeRequestCheckConfig(&pb.CanvasChangeRequestCheckConfig{
+			ForbiddenComponents: []string{" ssh ", "github.*", "ssh"},
+			SimulationEnabled:   true,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"ssh", "github.*"}, config.ForbiddenComponents)
+		assert.True(t, config.SimulationEnabled)
+	})
+
+	t.Run("empty pattern -> error", func(t *testing.T) {
+		_, err := parseCanvasChangeRequestCheckConfig(&pb.CanvasChangeRequestCheckConfig{
+			ForbiddenComponents: []string{" "},
+		})
+
+		require.ErrorContains(t, err, "cannot be empty")
+	})
+
+	t.Run("invalid pattern -> error", func(t *testing.T) {
+		_, err := parseCanvasChangeRequestCheckConfig(&pb.CanvasChangeRequestCheckConfig{
+			ForbiddenComponents: []string{"github.["},
+		})
+
+		require.ErrorContains(t, err, "invalid forbidden component pattern")
+	})
+}
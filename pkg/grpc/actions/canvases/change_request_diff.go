@@ -204,12 +204,15 @@ func mapNodesByID(nodes []models.Node) map[string]models.Node {
 func mapEdgesByKey(edges []models.Edge) map[string]models.Edge {
 	result := make(map[string]models.Edge, len(edges))
 	for _, edge := range edges {
-		key := edge.SourceID + "|" + edge.TargetID + "|" + edge.Channel
-		result[key] = edge
+		result[edgeKey(edge)] = edge
 	}
 	return result
 }
 
+func edgeKey(edge models.Edge) string {
+	return edge.SourceID + "|" + edge.TargetID + "|" + edge.Channel
+}
+
 func toComparableCanvasNode(node models.Node) comparableCanvasNode {
 	return comparableCanvasNode{
 		ID:            node.ID,
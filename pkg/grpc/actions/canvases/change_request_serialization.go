@@ -33,6 +33,17 @@ func canvasChangeRequestApprovalStateToProto(state string) pb.CanvasChangeReques
 	}
 }
 
+func canvasChangeRequestCheckStatusToProto(status string) pb.CanvasChangeRequestCheck_Status {
+	switch status {
+	case models.CanvasChangeRequestCheckStatusPassed:
+		return pb.CanvasChangeRequestCheck_STATUS_PASSED
+	case models.CanvasChangeRequestCheckStatusFailed:
+		return pb.CanvasChangeRequestCheck_STATUS_FAILED
+	default:
+		return pb.CanvasChangeRequestCheck_STATUS_UNSPECIFIED
+	}
+}
+
 func SerializeCanvasChangeRequest(
 	request *models.CanvasChangeRequest,
 	version *models.CanvasVersion,
@@ -79,6 +90,7 @@ func SerializeCanvasChangeRequest(
 			ConflictingNodeIds: request.ConflictingNodeIDs,
 		},
 		Approvals: serializeCanvasChangeRequestApprovals(organizationID, approvals),
+		Checks:    serializeCanvasChangeRequestChecks(request.Checks),
 	}
 
 	if version != nil {
@@ -123,6 +135,32 @@ func serializeCanvasChangeRequestApprovals(
 	return serialized
 }
 
+func serializeCanvasChangeRequestChecks(checks []models.CanvasChangeRequestCheck) []*pb.CanvasChangeRequestCheck {
+	serialized := make([]*pb.CanvasChangeRequestCheck, 0, len(checks))
+	for _, check := range checks {
+		item := &pb.CanvasChangeRequestCheck{
+			Name:     check.Name,
+			Status:   canvasChangeRequestCheckStatusToProto(check.Status),
+			Problems: make([]*pb.CanvasChangeRequestCheck_Problem, 0, len(check.Problems)),
+		}
+
+		for _, problem := range check.Problems {
+			item.Problems = append(item.Problems, &pb.CanvasChangeRequestCheck_Problem{
+				NodeId:  problem.NodeID,
+				Message: problem.Message,
+			})
+		}
+
+		if check.CheckedAt != nil {
+			item.CheckedAt = timestamppb.New(*check.CheckedAt)
+		}
+
+		serialized = append(serialized, item)
+	}
+
+	return serialized
+}
+
 func findCanvasChangeRequestUserRef(organizationID string, userID *uuid.UUID) *pb.UserRef {
 	if userID == nil {
 		return nil
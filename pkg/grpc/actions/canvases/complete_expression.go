@@ -0,0 +1,61 @@
+package canvases
+
+import (
+	"context"
+	"unicode/utf8"
+
+	"github.com/superplanehq/superplane/pkg/exprruntime"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"github.com/superplanehq/superplane/pkg/registry"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func CompleteExpression(ctx context.Context, registry *registry.Registry, organizationID string, req *pb.CompleteExpressionRequest) (*pb.CompleteExpressionResponse, error) {
+	length := utf8.RuneCountInString(req.Expression)
+	cursor := length
+	if req.Cursor != nil {
+		cursor = int(*req.Cursor)
+	}
+
+	if cursor < 0 || cursor > length {
+		return nil, status.Errorf(codes.InvalidArgument, "cursor must be between 0 and %d", length)
+	}
+
+	scope, err := findExpressionScope(ctx, registry, organizationID, req.CanvasId, req.VersionId, req.NodeId)
+	if err != nil {
+		return nil, err
+	}
+
+	completions := exprruntime.Complete(req.Expression, cursor, *scope)
+	response := &pb.CompleteExpressionResponse{
+		Completions: make([]*pb.ExpressionCompletion, 0, len(completions)),
+	}
+
+	for _, completion := range completions {
+		response.Completions = append(response.Completions, &pb.ExpressionCompletion{
+			Label:      completion.Label,
+			Kind:       completionKindToProto(completion.Kind),
+			Detail:     completion.Detail,
+			InsertText: completion.InsertText,
+			Replace:    int32(completion.Replace),
+		})
+	}
+
+	return response, nil
+}
+
+func completionKindToProto(kind string) pb.ExpressionCompletion_Kind {
+	switch kind {
+	case exprruntime.CompletionKindFunction:
+		return pb.ExpressionCompletion_KIND_FUNCTION
+	case exprruntime.CompletionKindVariable:
+		return pb.ExpressionCompletion_KIND_VARIABLE
+	case exprruntime.CompletionKindNode:
+		return pb.ExpressionCompletion_KIND_NODE
+	case exprruntime.CompletionKindField:
+		return pb.ExpressionCompletion_KIND_FIELD
+	default:
+		return pb.ExpressionCompletion_KIND_UNSPECIFIED
+	}
+}
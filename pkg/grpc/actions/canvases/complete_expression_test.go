@@ -0,0 +1,93 @@
+package canvases
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test__CompleteExpression(t *testing.T) {
+	r := support.Setup(t)
+	canvas := createExpressionCanvas(t, r)
+
+	complete := func(nodeID, expression string, cursor *int32) (*pb.CompleteExpressionResponse, error) {
+		return CompleteExpression(context.Background(), r.Registry, r.Organization.ID.String(), &pb.CompleteExpressionRequest{
+			CanvasId:   canvas.ID.String(),
+			NodeId:     nodeID,
+			Expression: expression,
+			Cursor:     cursor,
+		})
+	}
+
+	labels := func(response *pb.CompleteExpressionResponse) []string {
+		result := []string{}
+		for _, completion := range response.Completions {
+			result = append(result, completion.Label)
+		}
+
+		return result
+	}
+
+	t.Run("upstream node names", func(t *testing.T) {
+		response, err := complete("notify", `$.`, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Check", "Start"}, labels(response))
+		assert.Equal(t, pb.ExpressionCompletion_KIND_NODE, response.Completions[0].Kind)
+	})
+
+	t.Run("fields of upstream node examples", func(t *testing.T) {
+		response, err := complete("notify", `$["Start"].`, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"data", "timestamp", "type"}, labels(response))
+		assert.Equal(t, pb.ExpressionCompletion_KIND_FIELD, response.Completions[0].Kind)
+
+		response, err = complete("notify", `$["Start"].data.`, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"foo"}, labels(response))
+	})
+
+	t.Run("root and previous", func(t *testing.T) {
+		response, err := complete("notify", `root().data.`, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"foo"}, labels(response))
+
+		response, err = complete("notify", `previous().`, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"data", "timestamp", "type"}, labels(response))
+	})
+
+	t.Run("canvas variables", func(t *testing.T) {
+		response, err := complete("check", `vars.`, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"region"}, labels(response))
+		assert.Equal(t, pb.ExpressionCompletion_KIND_VARIABLE, response.Completions[0].Kind)
+	})
+
+	t.Run("cursor in the middle of the expression", func(t *testing.T) {
+		cursor := int32(len(`root().data.`))
+		response, err := complete("notify", `root().data. == "bar"`, &cursor)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"foo"}, labels(response))
+	})
+
+	t.Run("cursor out of range -> invalid argument", func(t *testing.T) {
+		cursor := int32(100)
+		_, err := complete("notify", `root().`, &cursor)
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+	})
+
+	t.Run("unknown node -> not found", func(t *testing.T) {
+		_, err := complete("does-not-exist", `root().`, nil)
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+	})
+}
@@ -31,12 +31,49 @@ func CreateCanvasWithAutoLayout(
 	pbCanvas *pb.Canvas,
 	autoLayout *pb.CanvasAutoLayout,
 ) (*pb.CreateCanvasResponse, error) {
+	var canvas *models.Canvas
+	err := database.Conn().Transaction(func(tx *gorm.DB) error {
+		var err error
+		canvas, err = createCanvasInTransaction(ctx, tx, registry, organizationID, pbCanvas, autoLayout)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	proto, err := SerializeCanvas(canvas, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateCanvasResponse{
+		Canvas: proto,
+	}, nil
+}
+
+// createCanvasInTransaction creates a canvas and its live version.
+// Blueprints used by the canvas are found through the transaction,
+// so they can be created in the same transaction, as imports do.
+func createCanvasInTransaction(
+	ctx context.Context,
+	tx *gorm.DB,
+	registry *registry.Registry,
+	organizationID string,
+	pbCanvas *pb.Canvas,
+	autoLayout *pb.CanvasAutoLayout,
+) (*models.Canvas, error) {
 	userID, ok := authentication.GetUserIdFromMetadata(ctx)
 	if !ok {
 		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
 	}
 
-	nodes, edges, err := ParseCanvas(registry, organizationID, pbCanvas)
+	nodes, edges, err := ParseCanvasInTransaction(tx, registry, organizationID, pbCanvas)
+	if err != nil {
+		return nil, err
+	}
+
+	variables, err := ParseCanvasVariables(pbCanvas)
 	if err != nil {
 		return nil, err
 	}
@@ -46,7 +83,7 @@ func CreateCanvasWithAutoLayout(
 		return nil, err
 	}
 
-	expandedNodes, err := expandNodes(organizationID, nodes)
+	expandedNodes, err := expandNodesInTransaction(tx, organizationID, nodes)
 	if err != nil {
 		return nil, err
 	}
@@ -81,74 +118,59 @@ func CreateCanvasWithAutoLayout(
 		UpdatedAt:         &now,
 	}
 
-	err = database.Conn().Transaction(func(tx *gorm.DB) error {
-
-		//
-		// Create the workflow record
-		//
-		err := tx.Clauses(clause.Returning{}).Create(&canvas).Error
-		if err != nil {
-			if strings.Contains(err.Error(), ErrDuplicateCanvasName) {
-				return status.Errorf(codes.AlreadyExists, "Canvas with the same name already exists")
-			}
-			return err
+	//
+	// Create the workflow record
+	//
+	err = tx.Clauses(clause.Returning{}).Create(&canvas).Error
+	if err != nil {
+		if strings.Contains(err.Error(), ErrDuplicateCanvasName) {
+			return nil, status.Errorf(codes.AlreadyExists, "Canvas with the same name already exists")
 		}
+		return nil, err
+	}
 
-		//
-		// Create the workflow node records (including internal blueprint nodes)
-		//
-		for _, node := range expandedNodes {
-			// Set ParentNodeID for internal nodes (IDs like parent:child)
-			var parentNodeID *string
-			if idx := strings.Index(node.ID, ":"); idx != -1 {
-				parent := node.ID[:idx]
-				parentNodeID = &parent
-			}
-
-			canvasNode := models.CanvasNode{
-				WorkflowID:    canvas.ID,
-				NodeID:        node.ID,
-				ParentNodeID:  parentNodeID,
-				Name:          node.Name,
-				State:         models.CanvasNodeStateReady,
-				Type:          node.Type,
-				Ref:           datatypes.NewJSONType(node.Ref),
-				Configuration: datatypes.NewJSONType(node.Configuration),
-				Metadata:      datatypes.NewJSONType(node.Metadata),
-				CreatedAt:     &now,
-				UpdatedAt:     &now,
-			}
-
-			if err := tx.Create(&canvasNode).Error; err != nil {
-				return err
-			}
+	//
+	// Create the workflow node records (including internal blueprint nodes)
+	//
+	for _, node := range expandedNodes {
+		// Set ParentNodeID for internal nodes (IDs like parent:child)
+		var parentNodeID *string
+		if idx := strings.Index(node.ID, ":"); idx != -1 {
+			parent := node.ID[:idx]
+			parentNodeID = &parent
 		}
 
-		version, err := models.CreatePublishedCanvasVersionInTransaction(
-			tx,
-			canvas.ID,
-			&createdBy,
-			expandedNodes,
-			edges,
-		)
-		if err != nil {
-			return err
+		canvasNode := models.CanvasNode{
+			WorkflowID:    canvas.ID,
+			NodeID:        node.ID,
+			ParentNodeID:  parentNodeID,
+			Name:          node.Name,
+			State:         models.CanvasNodeStateReady,
+			Type:          node.Type,
+			Ref:           datatypes.NewJSONType(node.Ref),
+			Configuration: datatypes.NewJSONType(node.Configuration),
+			Metadata:      datatypes.NewJSONType(node.Metadata),
+			CreatedAt:     &now,
+			UpdatedAt:     &now,
 		}
-		canvas.LiveVersionID = &version.ID
-
-		return nil
-	})
 
-	if err != nil {
-		return nil, err
+		if err := tx.Create(&canvasNode).Error; err != nil {
+			return nil, err
+		}
 	}
 
-	proto, err := SerializeCanvas(&canvas, false)
+	version, err := models.CreatePublishedCanvasVersionInTransaction(
+		tx,
+		canvas.ID,
+		&createdBy,
+		expandedNodes,
+		edges,
+		variables,
+	)
 	if err != nil {
 		return nil, err
 	}
+	canvas.LiveVersionID = &version.ID
 
-	return &pb.CreateCanvasResponse{
-		Canvas: proto,
-	}, nil
+	return &canvas, nil
 }
@@ -13,22 +13,26 @@ import (
 	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
 	"github.com/superplanehq/superplane/pkg/models"
 	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"github.com/superplanehq/superplane/pkg/registry"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"gorm.io/datatypes"
 	"gorm.io/gorm"
 )
 
 func CreateCanvasChangeRequest(
 	ctx context.Context,
+	registry *registry.Registry,
 	organizationID string,
 	canvasID string,
 	versionID string,
 ) (*pb.CreateCanvasChangeRequestResponse, error) {
-	return CreateCanvasChangeRequestWithMetadata(ctx, organizationID, canvasID, versionID, "", "")
+	return CreateCanvasChangeRequestWithMetadata(ctx, registry, organizationID, canvasID, versionID, "", "")
 }
 
 func CreateCanvasChangeRequestWithMetadata(
 	ctx context.Context,
+	registry *registry.Registry,
 	organizationID string,
 	canvasID string,
 	versionID string,
@@ -119,6 +123,7 @@ func CreateCanvasChangeRequestWithMetadata(
 			userUUID,
 			draftVersion.Nodes,
 			draftVersion.Edges,
+			draftVersion.Variables,
 		)
 		if err != nil {
 			return err
@@ -134,6 +139,7 @@ func CreateCanvasChangeRequestWithMetadata(
 			Title:            requestedTitle,
 			Description:      requestedDescription,
 			Status:           models.CanvasChangeRequestStatusOpen,
+			Checks:           datatypes.NewJSONSlice(runCanvasChangeRequestChecks(registry, organizationID, canvasInTx, version)),
 			CreatedAt:        &now,
 			UpdatedAt:        &now,
 		}
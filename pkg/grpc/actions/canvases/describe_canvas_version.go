@@ -44,41 +44,49 @@ func DescribeCanvasVersion(ctx context.Context, organizationID string, canvasID
 	}
 
 	userUUID := uuid.MustParse(userID)
+	canAccess, err := isCanvasVersionVisibleToUser(canvas.ID, userUUID, version)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to resolve version access: %v", err)
+	}
+
+	if !canAccess {
+		return nil, status.Error(codes.PermissionDenied, "version is not visible in current flow")
+	}
+
+	return &pb.DescribeCanvasVersionResponse{
+		Version: SerializeCanvasVersion(version, organizationID),
+	}, nil
+}
+
+// isCanvasVersionVisibleToUser checks if a user can see a canvas version.
+// Published versions are visible to everyone, and unpublished ones only
+// to the user editing them, or to the owner of their change request.
+func isCanvasVersionVisibleToUser(canvasID uuid.UUID, userID uuid.UUID, version *models.CanvasVersion) (bool, error) {
 	if version.IsPublished {
-		return &pb.DescribeCanvasVersionResponse{
-			Version: SerializeCanvasVersion(version, organizationID),
-		}, nil
+		return true, nil
 	}
 
 	canAccess := false
-	if err := database.Conn().Transaction(func(tx *gorm.DB) error {
-		if _, draftErr := models.FindCanvasDraftByVersionInTransaction(tx, canvas.ID, userUUID, version.ID); draftErr == nil {
+	err := database.Conn().Transaction(func(tx *gorm.DB) error {
+		if _, draftErr := models.FindCanvasDraftByVersionInTransaction(tx, canvasID, userID, version.ID); draftErr == nil {
 			canAccess = true
 			return nil
 		} else if !errors.Is(draftErr, gorm.ErrRecordNotFound) {
 			return draftErr
 		}
 
-		request, requestErr := models.FindCanvasChangeRequestByVersionInTransaction(tx, canvas.ID, version.ID)
+		request, requestErr := models.FindCanvasChangeRequestByVersionInTransaction(tx, canvasID, version.ID)
 		if requestErr != nil {
 			if errors.Is(requestErr, gorm.ErrRecordNotFound) {
 				return nil
 			}
 			return requestErr
 		}
-		if request.OwnerID != nil && *request.OwnerID == userUUID {
+		if request.OwnerID != nil && *request.OwnerID == userID {
 			canAccess = true
 		}
 		return nil
-	}); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to resolve version access: %v", err)
-	}
+	})
 
-	if !canAccess {
-		return nil, status.Error(codes.PermissionDenied, "version is not visible in current flow")
-	}
-
-	return &pb.DescribeCanvasVersionResponse{
-		Version: SerializeCanvasVersion(version, organizationID),
-	}, nil
+	return canAccess, err
 }
@@ -0,0 +1,344 @@
+package canvases
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func DiffCanvasVersions(
+	ctx context.Context,
+	organizationID string,
+	canvasID string,
+	versionID string,
+	baseVersionID string,
+) (*pb.DiffCanvasVersionsResponse, error) {
+	userID, ok := authentication.GetUserIdFromMetadata(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	canvasUUID, err := uuid.Parse(canvasID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid canvas id: %v", err)
+	}
+
+	canvas, err := models.FindCanvas(uuid.MustParse(organizationID), canvasUUID)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "canvas not found: %v", err)
+	}
+
+	baseVersionID = strings.TrimSpace(baseVersionID)
+	if baseVersionID == "" {
+		if canvas.LiveVersionID == nil {
+			return nil, status.Error(codes.FailedPrecondition, "canvas live version not found")
+		}
+
+		baseVersionID = canvas.LiveVersionID.String()
+	}
+
+	userUUID := uuid.MustParse(userID)
+	target, err := findVisibleCanvasVersion(canvas.ID, userUUID, versionID)
+	if err != nil {
+		return nil, err
+	}
+
+	base, err := findVisibleCanvasVersion(canvas.ID, userUUID, baseVersionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DiffCanvasVersionsResponse{
+		Diff: computeCanvasVersionDiff(base, target),
+	}, nil
+}
+
+func findVisibleCanvasVersion(canvasID uuid.UUID, userID uuid.UUID, versionID string) (*models.CanvasVersion, error) {
+	versionUUID, err := uuid.Parse(versionID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid version id: %v", err)
+	}
+
+	version, err := models.FindCanvasVersion(canvasID, versionUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "version %s not found", versionID)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to load version: %v", err)
+	}
+
+	canAccess, err := isCanvasVersionVisibleToUser(canvasID, userID, version)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to resolve version access: %v", err)
+	}
+
+	if !canAccess {
+		return nil, status.Errorf(codes.PermissionDenied, "version %s is not visible in current flow", versionID)
+	}
+
+	return version, nil
+}
+
+func computeCanvasVersionDiff(base, target *models.CanvasVersion) *pb.CanvasVersionDiff {
+	return &pb.CanvasVersionDiff{
+		BaseVersionId:   base.ID.String(),
+		TargetVersionId: target.ID.String(),
+		Nodes:           diffCanvasNodes(base.Nodes, target.Nodes),
+		Edges:           diffCanvasEdges(base.Edges, target.Edges),
+		Variables:       diffCanvasVariables(base.Variables, target.Variables),
+	}
+}
+
+func diffCanvasNodes(baseNodes, targetNodes []models.Node) []*pb.CanvasVersionDiff_NodeChange {
+	baseByID := mapNodesByID(baseNodes)
+	targetByID := mapNodesByID(targetNodes)
+
+	nodeIDs := make(map[string]struct{}, len(baseByID)+len(targetByID))
+	for nodeID := range baseByID {
+		nodeIDs[nodeID] = struct{}{}
+	}
+	for nodeID := range targetByID {
+		nodeIDs[nodeID] = struct{}{}
+	}
+
+	changes := []*pb.CanvasVersionDiff_NodeChange{}
+	for _, nodeID := range resolveOrderedNodeIDs(nodeIDs, targetNodes, baseNodes) {
+		baseNode, hasBase := baseByID[nodeID]
+		targetNode, hasTarget := targetByID[nodeID]
+
+		var baseFields, targetFields map[string]any
+		change := &pb.CanvasVersionDiff_NodeChange{NodeId: nodeID}
+
+		switch {
+		case !hasBase:
+			change.Type = pb.CanvasVersionDiff_CHANGE_TYPE_ADDED
+			change.NodeName = targetNode.Name
+			targetFields = flattenCanvasNodeFields(targetNode)
+		case !hasTarget:
+			change.Type = pb.CanvasVersionDiff_CHANGE_TYPE_REMOVED
+			change.NodeName = baseNode.Name
+			baseFields = flattenCanvasNodeFields(baseNode)
+		default:
+			change.Type = pb.CanvasVersionDiff_CHANGE_TYPE_MODIFIED
+			change.NodeName = targetNode.Name
+			baseFields = flattenCanvasNodeFields(baseNode)
+			targetFields = flattenCanvasNodeFields(targetNode)
+		}
+
+		change.Fields = diffFlattenedFields(baseFields, targetFields)
+		if change.Type == pb.CanvasVersionDiff_CHANGE_TYPE_MODIFIED && len(change.Fields) == 0 {
+			continue
+		}
+
+		changes = append(changes, change)
+	}
+
+	return changes
+}
+
+func diffCanvasEdges(baseEdges, targetEdges []models.Edge) []*pb.CanvasVersionDiff_EdgeChange {
+	baseByKey := mapEdgesByKey(baseEdges)
+	targetByKey := mapEdgesByKey(targetEdges)
+
+	changes := []*pb.CanvasVersionDiff_EdgeChange{}
+	for _, edge := range targetEdges {
+		if _, ok := baseByKey[edgeKey(edge)]; ok {
+			continue
+		}
+
+		changes = append(changes, serializeCanvasEdgeChange(edge, pb.CanvasVersionDiff_CHANGE_TYPE_ADDED))
+	}
+
+	for _, edge := range baseEdges {
+		if _, ok := targetByKey[edgeKey(edge)]; ok {
+			continue
+		}
+
+		changes = append(changes, serializeCanvasEdgeChange(edge, pb.CanvasVersionDiff_CHANGE_TYPE_REMOVED))
+	}
+
+	return changes
+}
+
+func serializeCanvasEdgeChange(edge models.Edge, changeType pb.CanvasVersionDiff_ChangeType) *pb.CanvasVersionDiff_EdgeChange {
+	return &pb.CanvasVersionDiff_EdgeChange{
+		Type:     changeType,
+		SourceId: edge.SourceID,
+		TargetId: edge.TargetID,
+		Channel:  edge.Channel,
+	}
+}
+
+func diffCanvasVariables(baseVariables, targetVariables []models.CanvasVariable) []*pb.CanvasVersionDiff_VariableChange {
+	baseByName := make(map[string]models.CanvasVariable, len(baseVariables))
+	for _, variable := range baseVariables {
+		baseByName[variable.Name] = variable
+	}
+
+	targetByName := make(map[string]models.CanvasVariable, len(targetVariables))
+	for _, variable := range targetVariables {
+		targetByName[variable.Name] = variable
+	}
+
+	changes := []*pb.CanvasVersionDiff_VariableChange{}
+	for _, variable := range targetVariables {
+		baseVariable, ok := baseByName[variable.Name]
+		if !ok {
+			changes = append(changes, &pb.CanvasVersionDiff_VariableChange{
+				Name:   variable.Name,
+				Type:   pb.CanvasVersionDiff_CHANGE_TYPE_ADDED,
+				Fields: diffFlattenedFields(nil, flattenCanvasVariableFields(variable)),
+			})
+			continue
+		}
+
+		fields := diffFlattenedFields(flattenCanvasVariableFields(baseVariable), flattenCanvasVariableFields(variable))
+		if len(fields) == 0 {
+			continue
+		}
+
+		changes = append(changes, &pb.CanvasVersionDiff_VariableChange{
+			Name:   variable.Name,
+			Type:   pb.CanvasVersionDiff_CHANGE_TYPE_MODIFIED,
+			Fields: fields,
+		})
+	}
+
+	for _, variable := range baseVariables {
+		if _, ok := targetByName[variable.Name]; ok {
+			continue
+		}
+
+		changes = append(changes, &pb.CanvasVersionDiff_VariableChange{
+			Name:   variable.Name,
+			Type:   pb.CanvasVersionDiff_CHANGE_TYPE_REMOVED,
+			Fields: diffFlattenedFields(flattenCanvasVariableFields(variable), nil),
+		})
+	}
+
+	return changes
+}
+
+// flattenCanvasNodeFields maps a node into field paths, with nested configuration
+// fields joined by dots, so changes deep in the configuration are reported individually.
+func flattenCanvasNodeFields(node models.Node) map[string]any {
+	fields := map[string]any{
+		"name":      node.Name,
+		"type":      node.Type,
+		"ref":       node.Ref,
+		"position":  node.Position,
+		"collapsed": node.IsCollapsed,
+	}
+
+	if node.IntegrationID != nil {
+		fields["integration"] = *node.IntegrationID
+	}
+
+	flattenConfigurationFields(fields, "configuration", node.Configuration)
+	return fields
+}
+
+func flattenConfigurationFields(fields map[string]any, prefix string, configuration map[string]any) {
+	for key, value := range configuration {
+		path := prefix + "." + key
+		if nested, ok := value.(map[string]any); ok && len(nested) > 0 {
+			flattenConfigurationFields(fields, path, nested)
+			continue
+		}
+
+		fields[path] = value
+	}
+}
+
+func flattenCanvasVariableFields(variable models.CanvasVariable) map[string]any {
+	fields := map[string]any{}
+	if variable.Description != "" {
+		fields["description"] = variable.Description
+	}
+	if variable.Value != "" {
+		fields["value"] = variable.Value
+	}
+	if variable.Secret != nil {
+		fields["secret"] = variable.Secret
+	}
+	if len(variable.Overrides) > 0 {
+		fields["overrides"] = variable.Overrides
+	}
+
+	return fields
+}
+
+func diffFlattenedFields(baseFields, targetFields map[string]any) []*pb.CanvasVersionDiff_FieldChange {
+	paths := make([]string, 0, len(baseFields)+len(targetFields))
+	for path := range baseFields {
+		paths = append(paths, path)
+	}
+	for path := range targetFields {
+		if _, ok := baseFields[path]; !ok {
+			paths = append(paths, path)
+		}
+	}
+
+	sort.Slice(paths, func(i, j int) bool {
+		if fieldPathRank(paths[i]) != fieldPathRank(paths[j]) {
+			return fieldPathRank(paths[i]) < fieldPathRank(paths[j])
+		}
+		return paths[i] < paths[j]
+	})
+
+	changes := []*pb.CanvasVersionDiff_FieldChange{}
+	for _, path := range paths {
+		before := encodeDiffValue(baseFields, path)
+		after := encodeDiffValue(targetFields, path)
+		if before == after {
+			continue
+		}
+
+		changes = append(changes, &pb.CanvasVersionDiff_FieldChange{
+			Path:   path,
+			Before: before,
+			After:  after,
+		})
+	}
+
+	return changes
+}
+
+// fieldPathRank keeps the fields that identify a node first,
+// and the ones that only affect its layout last.
+func fieldPathRank(path string) int {
+	switch {
+	case path == "name":
+		return 0
+	case path == "type", path == "ref", path == "integration":
+		return 1
+	case path == "position", path == "collapsed":
+		return 3
+	default:
+		return 2
+	}
+}
+
+func encodeDiffValue(fields map[string]any, path string) string {
+	value, ok := fields[path]
+	if !ok {
+		return ""
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return ""
+	}
+
+	return string(data)
+}
@@ -0,0 +1,143 @@
+package canvases
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/datatypes"
+)
+
+func TestComputeCanvasVersionDiff(t *testing.T) {
+	base := &models.CanvasVersion{
+		ID: uuid.New(),
+		Nodes: datatypes.NewJSONSlice([]models.Node{
+			{
+				ID:            "node-a",
+				Name:          "Node A",
+				Type:          models.NodeTypeComponent,
+				Configuration: map[string]any{"url": "https://old.example.com", "headers": map[string]any{"accept": "json"}},
+			},
+			{ID: "node-b", Name: "Node B", Type: models.NodeTypeComponent},
+			{ID: "node-c", Name: "Node C", Type: models.NodeTypeComponent},
+		}),
+		Edges: datatypes.NewJSONSlice([]models.Edge{
+			{SourceID: "node-a", TargetID: "node-b", Channel: "default"},
+		}),
+		Variables: datatypes.NewJSONSlice([]models.CanvasVariable{
+			{Name: "ENV", Value: "staging"},
+			{Name: "REGION", Value: "us-east-1"},
+		}),
+	}
+
+	target := &models.CanvasVersion{
+		ID: uuid.New(),
+		Nodes: datatypes.NewJSONSlice([]models.Node{
+			{
+				ID:            "node-a",
+				Name:          "Node A Updated",
+				Type:          models.NodeTypeComponent,
+				Configuration: map[string]any{"url": "https://new.example.com", "headers": map[string]any{"accept": "json"}},
+			},
+			{ID: "node-b", Name: "Node B", Type: models.NodeTypeComponent},
+			{ID: "node-d", Name: "Node D", Type: models.NodeTypeComponent},
+		}),
+		Edges: datatypes.NewJSONSlice([]models.Edge{
+			{SourceID: "node-a", TargetID: "node-d", Channel: "default"},
+		}),
+		Variables: datatypes.NewJSONSlice([]models.CanvasVariable{
+			{Name: "ENV", Value: "production"},
+			{Name: "REGION", Value: "us-east-1"},
+		}),
+	}
+
+	diff := computeCanvasVersionDiff(base, target)
+	assert.Equal(t, base.ID.String(), diff.BaseVersionId)
+	assert.Equal(t, target.ID.String(), diff.TargetVersionId)
+
+	t.Run("nodes", func(t *testing.T) {
+		require.Len(t, diff.Nodes, 3)
+
+		modified := diff.Nodes[0]
+		assert.Equal(t, "node-a", modified.NodeId)
+		assert.Equal(t, pb.CanvasVersionDiff_CHANGE_TYPE_MODIFIED, modified.Type)
+		require.Len(t, modified.Fields, 2)
+		assert.Equal(t, "name", modified.Fields[0].Path)
+		assert.Equal(t, `"Node A"`, modified.Fields[0].Before)
+		assert.Equal(t, `"Node A Updated"`, modified.Fields[0].After)
+		assert.Equal(t, "configuration.url", modified.Fields[1].Path)
+		assert.Equal(t, `"https://old.example.com"`, modified.Fields[1].Before)
+		assert.Equal(t, `"https://new.example.com"`, modified.Fields[1].After)
+
+		added := diff.Nodes[1]
+		assert.Equal(t, "node-d", added.NodeId)
+		assert.Equal(t, pb.CanvasVersionDiff_CHANGE_TYPE_ADDED, added.Type)
+		assert.Equal(t, "name", added.Fields[0].Path)
+		assert.Empty(t, added.Fields[0].Before)
+		assert.Equal(t, `"Node D"`, added.Fields[0].After)
+
+		removed := diff.Nodes[2]
+		assert.Equal(t, "node-c", removed.NodeId)
+		assert.Equal(t, "Node C", removed.NodeName)
+		assert.Equal(t, pb.CanvasVersionDiff_CHANGE_TYPE_REMOVED, removed.Type)
+	})
+
+	t.Run("edges", func(t *testing.T) {
+		require.Len(t, diff.Edges, 2)
+		assert.Equal(t, pb.CanvasVersionDiff_CHANGE_TYPE_ADDED, diff.Edges[0].Type)
+		assert.Equal(t, "node-d", diff.Edges[0].TargetId)
+		assert.Equal(t, pb.CanvasVersionDiff_CHANGE_TYPE_REMOVED, diff.Edges[1].Type)
+		assert.Equal(t, "node-b", diff.Edges[1].TargetId)
+	})
+
+	t.Run("variables", func(t *testing.T) {
+		require.Len(t, diff.Variables, 1)
+		assert.Equal(t, "ENV", diff.Variables[0].Name)
+		assert.Equal(t, pb.CanvasVersionDiff_CHANGE_TYPE_MODIFIED, diff.Variables[0].Type)
+		require.Len(t, diff.Variables[0].Fields, 1)
+		assert.Equal(t, "value", diff.Variables[0].Fields[0].Path)
+		assert.Equal(t, `"staging"`, diff.Variables[0].Fields[0].Before)
+		assert.Equal(t, `"production"`, diff.Variables[0].Fields[0].After)
+	})
+
+	t.Run("same version -> no changes", func(t *testing.T) {
+		diff := computeCanvasVersionDiff(base, base)
+		assert.Empty(t, diff.Nodes)
+		assert.Empty(t, diff.Edges)
+		assert.Empty(t, diff.Variables)
+	})
+}
+
+func TestDiffCanvasVersionsDefaultsToLiveVersion(t *testing.T) {
+	r := support.Setup(t)
+	ctx := authentication.SetUserIdInMetadata(context.Background(), r.User.String())
+
+	canvasID := createCanvasWithNoopNode(ctx, t, r, "diff-versions")
+	draftVersionID := createDraftVersion(ctx, t, r, canvasID, "Draft Name")
+
+	canvas, err := models.FindCanvas(r.Organization.ID, uuid.MustParse(canvasID))
+	require.NoError(t, err)
+
+	response, err := DiffCanvasVersions(ctx, r.Organization.ID.String(), canvasID, draftVersionID, "")
+	require.NoError(t, err)
+	assert.Equal(t, canvas.LiveVersionID.String(), response.Diff.BaseVersionId)
+	require.Len(t, response.Diff.Nodes, 1)
+	assert.Equal(t, "Draft Name", response.Diff.Nodes[0].NodeName)
+
+	t.Run("draft of another user -> permission denied", func(t *testing.T) {
+		otherUser := support.CreateUser(t, r, r.Organization.ID)
+		otherCtx := authentication.SetUserIdInMetadata(context.Background(), otherUser.ID.String())
+
+		_, err := DiffCanvasVersions(otherCtx, r.Organization.ID.String(), canvasID, draftVersionID, "")
+		require.Error(t, err)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
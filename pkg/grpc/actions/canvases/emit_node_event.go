@@ -8,16 +8,21 @@ import (
 
 	"github.com/google/uuid"
 	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/authorization"
 	"github.com/superplanehq/superplane/pkg/database"
 	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
 	"github.com/superplanehq/superplane/pkg/models"
 	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
 	"github.com/superplanehq/superplane/pkg/workers/contexts"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"gorm.io/datatypes"
 )
 
 func EmitNodeEvent(
 	ctx context.Context,
+	authService authorization.Authorization,
 	orgID uuid.UUID,
 	canvasID uuid.UUID,
 	nodeID string,
@@ -34,6 +39,18 @@ func EmitNodeEvent(
 		return nil, fmt.Errorf("canvas node not found: %w", err)
 	}
 
+	var user *models.User
+	if userID, userIsSet := authentication.GetUserIdFromMetadata(ctx); userIsSet {
+		user, err = models.FindActiveUserByID(orgID.String(), userID)
+		if err != nil {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+	}
+
+	if err := authorizeNodeAction(authService, canvas, node.NodeID, user); err != nil {
+		return nil, err
+	}
+
 	now := time.Now()
 	event := models.CanvasEvent{
 		WorkflowID: canvas.ID,
@@ -23,6 +23,7 @@ func Test__EmitNodeEvent(t *testing.T) {
 	t.Run("canvas not found -> error", func(t *testing.T) {
 		_, err := EmitNodeEvent(
 			ctx,
+			r.AuthService,
 			r.Organization.ID,
 			uuid.New(),
 			"node-1",
@@ -53,6 +54,7 @@ func Test__EmitNodeEvent(t *testing.T) {
 
 		_, err := EmitNodeEvent(
 			ctx,
+			r.AuthService,
 			r.Organization.ID,
 			canvas.ID,
 			"non-existent-node",
@@ -88,6 +90,7 @@ func Test__EmitNodeEvent(t *testing.T) {
 
 		response, err := EmitNodeEvent(
 			ctx,
+			r.AuthService,
 			r.Organization.ID,
 			canvas.ID,
 			"node-1",
@@ -145,6 +148,7 @@ func Test__EmitNodeEvent(t *testing.T) {
 
 		response, err := EmitNodeEvent(
 			ctx,
+			r.AuthService,
 			r.Organization.ID,
 			canvas.ID,
 			"node-1",
@@ -187,6 +191,7 @@ func Test__EmitNodeEvent(t *testing.T) {
 
 		_, err := EmitNodeEvent(
 			ctx,
+			r.AuthService,
 			r.Organization.ID,
 			canvas.ID,
 			"node-1",
@@ -202,6 +207,7 @@ func Test__EmitNodeEvent(t *testing.T) {
 	t.Run("invalid organization ID -> error", func(t *testing.T) {
 		_, err := EmitNodeEvent(
 			ctx,
+			r.AuthService,
 			uuid.New(),
 			uuid.New(),
 			"node-1",
@@ -232,6 +238,7 @@ func Test__EmitNodeEvent(t *testing.T) {
 
 		_, err := EmitNodeEvent(
 			ctx,
+			r.AuthService,
 			r.Organization.ID,
 			canvas.ID,
 			"",
@@ -262,6 +269,7 @@ func Test__EmitNodeEvent(t *testing.T) {
 
 		response, err := EmitNodeEvent(
 			ctx,
+			r.AuthService,
 			r.Organization.ID,
 			canvas.ID,
 			"node-1",
@@ -3,7 +3,9 @@ package canvases
 import (
 	"fmt"
 
+	"github.com/superplanehq/superplane/pkg/database"
 	"github.com/superplanehq/superplane/pkg/models"
+	"gorm.io/gorm"
 )
 
 /*
@@ -12,6 +14,10 @@ import (
  * "<parentNodeID>:<internalNodeID>".
  */
 func expandNodes(organizationID string, nodes []models.Node) ([]models.Node, error) {
+	return expandNodesInTransaction(database.Conn(), organizationID, nodes)
+}
+
+func expandNodesInTransaction(tx *gorm.DB, organizationID string, nodes []models.Node) ([]models.Node, error) {
 	expanded := make([]models.Node, 0, len(nodes))
 
 	for _, n := range nodes {
@@ -26,7 +32,7 @@ func expandNodes(organizationID string, nodes []models.Node) ([]models.Node, err
 			return nil, fmt.Errorf("blueprint node %s missing blueprint id", n.ID)
 		}
 
-		b, err := models.FindBlueprint(organizationID, blueprintID)
+		b, err := models.FindBlueprintInTransaction(tx, organizationID, blueprintID)
 		if err != nil {
 			return nil, fmt.Errorf("blueprint %s not found: %w", blueprintID, err)
 		}
@@ -0,0 +1,284 @@
+package canvases
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/grpc/actions"
+	"github.com/superplanehq/superplane/pkg/grpc/actions/blueprints"
+	"github.com/superplanehq/superplane/pkg/models"
+	blueprintpb "github.com/superplanehq/superplane/pkg/protos/blueprints"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	compb "github.com/superplanehq/superplane/pkg/protos/components"
+	"github.com/superplanehq/superplane/pkg/registry"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func ExportCanvas(ctx context.Context, registry *registry.Registry, organizationID string, id string) (*pb.ExportCanvasResponse, error) {
+	canvasID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid canvas id: %v", err)
+	}
+
+	canvas, err := models.FindCanvas(uuid.MustParse(organizationID), canvasID)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "canvas not found: %v", err)
+		}
+
+		template, templateErr := models.FindCanvasTemplate(canvasID)
+		if templateErr != nil {
+			return nil, status.Errorf(codes.NotFound, "canvas not found: %v", err)
+		}
+
+		canvas = template
+	}
+
+	liveVersion, err := models.FindLiveCanvasVersionByCanvasInTransaction(database.Conn(), canvas)
+	if err != nil {
+		log.Errorf("failed to load live version for canvas %s: %v", canvas.ID.String(), err)
+		return nil, status.Error(codes.Internal, "failed to load canvas version")
+	}
+
+	exporter := newCanvasExporter(registry, canvas.OrganizationID)
+
+	//
+	// Nodes expanded from blueprints are recreated when the canvas is imported,
+	// so only the nodes defined directly on the canvas are exported.
+	//
+	nodes := []models.Node{}
+	for _, node := range liveVersion.Nodes {
+		if !strings.Contains(node.ID, ":") {
+			nodes = append(nodes, node)
+		}
+	}
+
+	serializedNodes, err := exporter.exportNodes(nodes)
+	if err != nil {
+		return nil, err
+	}
+
+	bundleBlueprints, err := exporter.exportBlueprints(nodes)
+	if err != nil {
+		return nil, err
+	}
+
+	exporter.collectVariableSecrets(liveVersion.Variables)
+
+	return &pb.ExportCanvasResponse{
+		Bundle: &pb.CanvasBundle{
+			Metadata: &pb.CanvasBundle_Metadata{
+				Name:        canvas.Name,
+				Description: canvas.Description,
+			},
+			Spec: &pb.Canvas_Spec{
+				Nodes:     serializedNodes,
+				Edges:     actions.EdgesToProto(liveVersion.Edges),
+				Variables: SerializeCanvasVariables(liveVersion.Variables),
+			},
+			Blueprints:   bundleBlueprints,
+			Integrations: exporter.integrationReferences(),
+			Secrets:      exporter.secretReferences(),
+		},
+	}, nil
+}
+
+type canvasExporter struct {
+	registry       *registry.Registry
+	organizationID uuid.UUID
+	integrations   map[string]*pb.CanvasBundle_IntegrationReference
+	secrets        map[string]map[string]bool
+}
+
+func newCanvasExporter(registry *registry.Registry, organizationID uuid.UUID) *canvasExporter {
+	return &canvasExporter{
+		registry:       registry,
+		organizationID: organizationID,
+		integrations:   map[string]*pb.CanvasBundle_IntegrationReference{},
+		secrets:        map[string]map[string]bool{},
+	}
+}
+
+// exportNodes serializes nodes without anything tied to the source organization:
+// integrations are referenced by name, and validation messages are dropped.
+func (e *canvasExporter) exportNodes(nodes []models.Node) ([]*compb.Node, error) {
+	serialized := actions.NodesToProto(nodes)
+	for i, node := range nodes {
+		serialized[i].ErrorMessage = ""
+		serialized[i].WarningMessage = ""
+
+		if node.IntegrationID != nil && *node.IntegrationID != "" {
+			name, err := e.addIntegration(*node.IntegrationID)
+			if err != nil {
+				return nil, err
+			}
+
+			serialized[i].Integration = &compb.IntegrationRef{Name: name}
+		}
+
+		e.collectNodeSecrets(node)
+	}
+
+	return serialized, nil
+}
+
+func (e *canvasExporter) exportBlueprints(nodes []models.Node) ([]*blueprintpb.Blueprint, error) {
+	result := []*blueprintpb.Blueprint{}
+	exported := map[string]bool{}
+	for _, node := range nodes {
+		if node.Ref.Blueprint == nil || exported[node.Ref.Blueprint.ID] {
+			continue
+		}
+
+		blueprint, err := models.FindBlueprint(e.organizationID.String(), node.Ref.Blueprint.ID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, status.Errorf(codes.FailedPrecondition, "node %s references a component that no longer exists", node.ID)
+			}
+
+			return nil, status.Error(codes.Internal, "failed to load component")
+		}
+
+		serializedNodes, err := e.exportNodes(blueprint.Nodes)
+		if err != nil {
+			return nil, err
+		}
+
+		serialized := blueprints.SerializeBlueprint(blueprint)
+		serialized.OrganizationId = ""
+		serialized.CreatedBy = nil
+		serialized.CreatedAt = nil
+		serialized.UpdatedAt = nil
+		serialized.Nodes = serializedNodes
+
+		exported[node.Ref.Blueprint.ID] = true
+		result = append(result, serialized)
+	}
+
+	return result, nil
+}
+
+func (e *canvasExporter) addIntegration(id string) (string, error) {
+	if reference, ok := e.integrations[id]; ok {
+		return reference.Name, nil
+	}
+
+	integrationID, err := uuid.Parse(id)
+	if err != nil {
+		return "", status.Errorf(codes.FailedPrecondition, "invalid integration ID %s", id)
+	}
+
+	integration, err := models.FindIntegration(e.organizationID, integrationID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", status.Errorf(codes.FailedPrecondition, "integration %s not found", id)
+		}
+
+		return "", status.Error(codes.Internal, "failed to load integration")
+	}
+
+	e.integrations[id] = &pb.CanvasBundle_IntegrationReference{
+		Name: integration.InstallationName,
+		Type: integration.AppName,
+	}
+
+	return integration.InstallationName, nil
+}
+
+func (e *canvasExporter) collectNodeSecrets(node models.Node) {
+	var fields []configuration.Field
+	switch {
+	case node.Ref.Component != nil:
+		component, err := e.registry.GetComponent(node.Ref.Component.Name)
+		if err != nil {
+			return
+		}
+		fields = component.Configuration()
+	case node.Ref.Trigger != nil:
+		trigger, err := e.registry.GetTrigger(node.Ref.Trigger.Name)
+		if err != nil {
+			return
+		}
+		fields = trigger.Configuration()
+	default:
+		return
+	}
+
+	for _, ref := range configuration.FindSecretKeyRefs(node.Configuration, fields) {
+		e.addSecret(ref.Secret, ref.Key)
+	}
+}
+
+func (e *canvasExporter) collectVariableSecrets(variables []models.CanvasVariable) {
+	for _, variable := range variables {
+		if variable.Secret != nil {
+			e.addSecret(variable.Secret.Secret, variable.Secret.Key)
+		}
+
+		for _, override := range variable.Overrides {
+			if override.Secret != nil {
+				e.addSecret(override.Secret.Secret, override.Secret.Key)
+			}
+		}
+	}
+}
+
+func (e *canvasExporter) addSecret(name, key string) {
+	if name == "" {
+		return
+	}
+
+	if _, ok := e.secrets[name]; !ok {
+		e.secrets[name] = map[string]bool{}
+	}
+
+	if key != "" {
+		e.secrets[name][key] = true
+	}
+}
+
+func (e *canvasExporter) integrationReferences() []*pb.CanvasBundle_IntegrationReference {
+	result := make([]*pb.CanvasBundle_IntegrationReference, 0, len(e.integrations))
+	seen := map[string]bool{}
+	for _, reference := range e.integrations {
+		if seen[reference.Name] {
+			continue
+		}
+
+		seen[reference.Name] = true
+		result = append(result, reference)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+
+	return result
+}
+
+func (e *canvasExporter) secretReferences() []*pb.CanvasBundle_SecretReference {
+	result := make([]*pb.CanvasBundle_SecretReference, 0, len(e.secrets))
+	for name, keys := range e.secrets {
+		reference := &pb.CanvasBundle_SecretReference{Name: name, Keys: []string{}}
+		for key := range keys {
+			reference.Keys = append(reference.Keys, key)
+		}
+
+		sort.Strings(reference.Keys)
+		result = append(result, reference)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+
+	return result
+}
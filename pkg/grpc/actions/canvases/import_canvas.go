@@ -0,0 +1,361 @@
+package canvases
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/authorization"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/grpc/actions"
+	"github.com/superplanehq/superplane/pkg/grpc/actions/blueprints"
+	"github.com/superplanehq/superplane/pkg/models"
+	blueprintpb "github.com/superplanehq/superplane/pkg/protos/blueprints"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	compb "github.com/superplanehq/superplane/pkg/protos/components"
+	"github.com/superplanehq/superplane/pkg/registry"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+)
+
+func ImportCanvas(
+	ctx context.Context,
+	authService authorization.Authorization,
+	registry *registry.Registry,
+	organizationID string,
+	req *pb.ImportCanvasRequest,
+) (*pb.ImportCanvasResponse, error) {
+	bundle := req.GetBundle()
+	if bundle == nil || bundle.Spec == nil {
+		return nil, status.Error(codes.InvalidArgument, "bundle spec is required")
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		name = strings.TrimSpace(bundle.GetMetadata().GetName())
+	}
+
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "canvas name is required")
+	}
+
+	orgID, err := uuid.Parse(organizationID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid organization id")
+	}
+
+	//
+	// Check the name before anything is created,
+	// so conflicting imports fail early.
+	//
+	if _, err := models.FindCanvasByName(name, orgID); err == nil {
+		return nil, status.Error(codes.AlreadyExists, "Canvas with the same name already exists")
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Error(codes.Internal, "failed to load canvas")
+	}
+
+	integrations, err := resolveBundleIntegrations(orgID, bundle, req.IntegrationMappings)
+	if err != nil {
+		return nil, err
+	}
+
+	//
+	// Components and the canvas are created in the same transaction,
+	// so a failed import does not leave components behind.
+	//
+	var canvas *models.Canvas
+	err = database.Conn().Transaction(func(tx *gorm.DB) error {
+		importer := &bundleBlueprintImporter{
+			ctx:          ctx,
+			tx:           tx,
+			authService:  authService,
+			registry:     registry,
+			orgID:        orgID,
+			integrations: integrations,
+		}
+
+		blueprintIDs, err := importer.importBlueprints(bundle.Blueprints)
+		if err != nil {
+			return err
+		}
+
+		spec := proto.Clone(bundle.Spec).(*pb.Canvas_Spec)
+		for _, node := range spec.Nodes {
+			remapBundleNodeIntegration(node, integrations)
+
+			if node.Blueprint == nil {
+				continue
+			}
+
+			blueprintID, ok := blueprintIDs[node.Blueprint.Id]
+			if !ok {
+				return status.Errorf(codes.InvalidArgument, "node %s references a component that is not in the bundle", node.Id)
+			}
+
+			node.Blueprint.Id = blueprintID
+		}
+
+		canvas, err = createCanvasInTransaction(ctx, tx, registry, organizationID, &pb.Canvas{
+			Metadata: &pb.Canvas_Metadata{
+				Name:        name,
+				Description: bundle.GetMetadata().GetDescription(),
+			},
+			Spec: spec,
+		}, nil)
+
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	serialized, err := SerializeCanvas(canvas, false)
+	if err != nil {
+		return nil, err
+	}
+
+	missingSecrets, err := findMissingBundleSecrets(orgID, bundle.Secrets)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ImportCanvasResponse{
+		Canvas:         serialized,
+		MissingSecrets: missingSecrets,
+	}, nil
+}
+
+// resolveBundleIntegrations maps the integration names used in the bundle
+// to the IDs of integrations in the target organization.
+// Explicit mappings take precedence over integrations with the same name.
+func resolveBundleIntegrations(
+	orgID uuid.UUID,
+	bundle *pb.CanvasBundle,
+	mappings []*pb.ImportCanvasRequest_IntegrationMapping,
+) (map[string]string, error) {
+	targets := map[string]string{}
+	for _, mapping := range mappings {
+		from := strings.TrimSpace(mapping.From)
+		to := strings.TrimSpace(mapping.To)
+		if from == "" || to == "" {
+			return nil, status.Error(codes.InvalidArgument, "integration mappings require both from and to")
+		}
+
+		targets[from] = to
+	}
+
+	types := map[string]string{}
+	for _, reference := range bundle.Integrations {
+		types[reference.Name] = reference.Type
+	}
+
+	for _, name := range bundleIntegrationNames(bundle) {
+		if _, ok := types[name]; !ok {
+			types[name] = ""
+		}
+	}
+
+	result := map[string]string{}
+	missing := []string{}
+	for name, integrationType := range types {
+		target := name
+		if mapped, ok := targets[name]; ok {
+			target = mapped
+		}
+
+		integration, err := models.FindIntegrationByName(orgID, target)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				missing = append(missing, name)
+				continue
+			}
+
+			return nil, status.Error(codes.Internal, "failed to load integration")
+		}
+
+		if integrationType != "" && integration.AppName != integrationType {
+			return nil, status.Errorf(
+				codes.FailedPrecondition,
+				"integration %s is of type %s, but %s is required",
+				target,
+				integration.AppName,
+				integrationType,
+			)
+		}
+
+		result[name] = integration.ID.String()
+	}
+
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return nil, status.Errorf(codes.FailedPrecondition, "integrations not found: %s", strings.Join(missing, ", "))
+	}
+
+	return result, nil
+}
+
+func bundleIntegrationNames(bundle *pb.CanvasBundle) []string {
+	names := []string{}
+	nodes := append([]*compb.Node{}, bundle.Spec.Nodes...)
+	for _, blueprint := range bundle.Blueprints {
+		nodes = append(nodes, blueprint.Nodes...)
+	}
+
+	for _, node := range nodes {
+		if node.Integration != nil && node.Integration.Name != "" {
+			names = append(names, node.Integration.Name)
+		}
+	}
+
+	return names
+}
+
+func remapBundleNodeIntegration(node *compb.Node, integrations map[string]string) {
+	if node.Integration == nil || node.Integration.Name == "" {
+		return
+	}
+
+	node.Integration.Id = integrations[node.Integration.Name]
+}
+
+// bundleBlueprintImporter creates the blueprints included in a bundle.
+// Blueprints with the same name that already exist in the organization
+// are reused, as long as their contents are the same as in the bundle.
+type bundleBlueprintImporter struct {
+	ctx          context.Context
+	tx           *gorm.DB
+	authService  authorization.Authorization
+	registry     *registry.Registry
+	orgID        uuid.UUID
+	integrations map[string]string
+	canCreate    bool
+}
+
+// importBlueprints returns a map from the bundle blueprint IDs to the IDs in the organization.
+func (i *bundleBlueprintImporter) importBlueprints(bundleBlueprints []*blueprintpb.Blueprint) (map[string]string, error) {
+	result := map[string]string{}
+	for _, bundleBlueprint := range bundleBlueprints {
+		blueprint := proto.Clone(bundleBlueprint).(*blueprintpb.Blueprint)
+		blueprint.Id = ""
+		for _, node := range blueprint.Nodes {
+			remapBundleNodeIntegration(node, i.integrations)
+		}
+
+		existing, err := models.FindBlueprintByNameInTransaction(i.tx, bundleBlueprint.Name, i.orgID)
+		if err == nil {
+			if !sameBlueprintContent(existing, blueprint) {
+				return nil, status.Errorf(
+					codes.FailedPrecondition,
+					"component %s already exists with a different content, rename it in the bundle or in the organization",
+					bundleBlueprint.Name,
+				)
+			}
+
+			result[bundleBlueprint.Id] = existing.ID.String()
+			continue
+		}
+
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.Internal, "failed to load component")
+		}
+
+		if err := i.authorizeCreate(); err != nil {
+			return nil, err
+		}
+
+		created, err := blueprints.CreateBlueprintInTransaction(i.ctx, i.tx, i.registry, i.orgID.String(), blueprint)
+		if err != nil {
+			return nil, err
+		}
+
+		result[bundleBlueprint.Id] = created.ID.String()
+	}
+
+	return result, nil
+}
+
+// authorizeCreate checks the user can create components.
+// Importing a canvas only requires permission to create canvases,
+// so it is checked here, before any component is created.
+func (i *bundleBlueprintImporter) authorizeCreate() error {
+	if i.canCreate {
+		return nil
+	}
+
+	userID, ok := authentication.GetUserIdFromMetadata(i.ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	allowed, err := i.authService.CheckOrganizationPermission(userID, i.orgID.String(), "blueprints", "create")
+	if err != nil {
+		log.Errorf("failed to check component access of user %s in organization %s: %v", userID, i.orgID, err)
+		return status.Error(codes.Internal, "failed to check permissions")
+	}
+
+	if !allowed {
+		return status.Error(codes.PermissionDenied, "importing a canvas with new components requires permission to create components")
+	}
+
+	i.canCreate = true
+	return nil
+}
+
+// sameBlueprintContent compares the nodes, edges, configuration and output channels
+// of an existing blueprint with a blueprint from a bundle.
+func sameBlueprintContent(existing *models.Blueprint, blueprint *blueprintpb.Blueprint) bool {
+	return proto.Equal(
+		blueprintContent(&blueprintpb.Blueprint{
+			Nodes:          actions.NodesToProto(existing.Nodes),
+			Edges:          actions.EdgesToProto(existing.Edges),
+			Configuration:  blueprints.ConfigurationToProto(existing.Configuration),
+			OutputChannels: blueprints.OutputChannelsToProto(existing.OutputChannels),
+		}),
+		blueprintContent(blueprint),
+	)
+}
+
+// blueprintContent converts nodes and edges to models and back,
+// the same way they are stored when blueprints are created,
+// so fields not stored, like node metadata, are not compared.
+// Validation messages are dropped, since they are not exported,
+// and integrations are compared by ID, after being mapped to the organization.
+func blueprintContent(blueprint *blueprintpb.Blueprint) *blueprintpb.Blueprint {
+	nodes := actions.ProtoToNodes(blueprint.Nodes)
+	for i := range nodes {
+		nodes[i].ErrorMessage = nil
+		nodes[i].WarningMessage = nil
+	}
+
+	return &blueprintpb.Blueprint{
+		Nodes:          actions.NodesToProto(nodes),
+		Edges:          actions.EdgesToProto(actions.ProtoToEdges(blueprint.Edges)),
+		Configuration:  blueprint.Configuration,
+		OutputChannels: blueprint.OutputChannels,
+	}
+}
+
+func findMissingBundleSecrets(orgID uuid.UUID, references []*pb.CanvasBundle_SecretReference) ([]string, error) {
+	missing := []string{}
+	for _, reference := range references {
+		_, err := models.FindSecretByName(models.DomainTypeOrganization, orgID, reference.Name)
+		if err == nil {
+			continue
+		}
+
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.Internal, "failed to load secret")
+		}
+
+		missing = append(missing, reference.Name)
+	}
+
+	return missing, nil
+}
@@ -0,0 +1,246 @@
+package canvases
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/grpc/actions"
+	"github.com/superplanehq/superplane/pkg/models"
+	blueprintpb "github.com/superplanehq/superplane/pkg/protos/blueprints"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	componentpb "github.com/superplanehq/superplane/pkg/protos/components"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+func Test__ExportAndImportCanvas(t *testing.T) {
+	r := support.Setup(t)
+	ctx := authentication.SetUserIdInMetadata(context.Background(), r.User.String())
+
+	created, err := CreateCanvas(ctx, r.Registry, r.Organization.ID.String(), &pb.Canvas{
+		Metadata: &pb.Canvas_Metadata{Name: "exported", Description: "Canvas to export"},
+		Spec: &pb.Canvas_Spec{
+			Nodes: []*componentpb.Node{
+				{
+					Id:        "node-1",
+					Name:      "noop",
+					Type:      componentpb.Node_TYPE_COMPONENT,
+					Component: &componentpb.Node_ComponentRef{Name: "noop"},
+				},
+			},
+			Edges: []*componentpb.Edge{},
+			Variables: []*pb.CanvasVariable{
+				{
+					Name:   "token",
+					Secret: &pb.CanvasVariable_SecretRef{Secret: "deploy-keys", Key: "token"},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	exported, err := ExportCanvas(ctx, r.Registry, r.Organization.ID.String(), created.Canvas.Metadata.Id)
+	require.NoError(t, err)
+
+	bundle := exported.Bundle
+	require.NotNil(t, bundle)
+	assert.Equal(t, "exported", bundle.Metadata.Name)
+	assert.Equal(t, "Canvas to export", bundle.Metadata.Description)
+	require.Len(t, bundle.Spec.Nodes, 1)
+	assert.Equal(t, "node-1", bundle.Spec.Nodes[0].Id)
+	assert.Empty(t, bundle.Integrations)
+	require.Len(t, bundle.Secrets, 1)
+	assert.Equal(t, "deploy-keys", bundle.Secrets[0].Name)
+	assert.Equal(t, []string{"token"}, bundle.Secrets[0].Keys)
+
+	t.Run("same name in the same organization -> error", func(t *testing.T) {
+		_, err := ImportCanvas(ctx, r.AuthService, r.Registry, r.Organization.ID.String(), &pb.ImportCanvasRequest{Bundle: bundle})
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.AlreadyExists, s.Code())
+	})
+
+	t.Run("missing integration -> error", func(t *testing.T) {
+		_, err := ImportCanvas(ctx, r.AuthService, r.Registry, r.Organization.ID.String(), &pb.ImportCanvasRequest{
+			Name: "with-integration",
+			Bundle: &pb.CanvasBundle{
+				Metadata: bundle.Metadata,
+				Spec:     bundle.Spec,
+				Integrations: []*pb.CanvasBundle_IntegrationReference{
+					{Name: "production-github", Type: "github"},
+				},
+			},
+		})
+
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.FailedPrecondition, s.Code())
+		assert.Contains(t, s.Message(), "production-github")
+	})
+
+	t.Run("import into another organization", func(t *testing.T) {
+		organization := support.CreateOrganization(t, r, r.User)
+
+		response, err := ImportCanvas(ctx, r.AuthService, r.Registry, organization.ID.String(), &pb.ImportCanvasRequest{
+			Bundle: bundle,
+			Name:   "imported",
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, "imported", response.Canvas.Metadata.Name)
+		assert.Equal(t, organization.ID.String(), response.Canvas.Metadata.OrganizationId)
+		require.Len(t, response.Canvas.Spec.Nodes, 1)
+		assert.Equal(t, "noop", response.Canvas.Spec.Nodes[0].Component.Name)
+		require.Len(t, response.Canvas.Spec.Variables, 1)
+		assert.Equal(t, []string{"deploy-keys"}, response.MissingSecrets)
+	})
+}
+
+func Test__ImportCanvasWithComponents(t *testing.T) {
+	r := support.Setup(t)
+	ctx := authentication.SetUserIdInMetadata(context.Background(), r.User.String())
+
+	blueprint := support.CreateBlueprint(t, r.Organization.ID, []models.Node{
+		{
+			ID:            "noop",
+			Name:          "noop",
+			Type:          models.NodeTypeComponent,
+			Ref:           models.NodeRef{Component: &models.ComponentRef{Name: "noop"}},
+			Configuration: map[string]any{},
+		},
+	}, []models.Edge{}, []models.BlueprintOutputChannel{})
+
+	created, err := CreateCanvas(ctx, r.Registry, r.Organization.ID.String(), &pb.Canvas{
+		Metadata: &pb.Canvas_Metadata{Name: "with-component"},
+		Spec: &pb.Canvas_Spec{
+			Nodes: []*componentpb.Node{
+				{
+					Id:        "node-1",
+					Name:      "component",
+					Type:      componentpb.Node_TYPE_BLUEPRINT,
+					Blueprint: &componentpb.Node_BlueprintRef{Id: blueprint.ID.String()},
+				},
+			},
+			Edges: []*componentpb.Edge{},
+		},
+	})
+	require.NoError(t, err)
+
+	exported, err := ExportCanvas(ctx, r.Registry, r.Organization.ID.String(), created.Canvas.Metadata.Id)
+	require.NoError(t, err)
+	bundle := exported.Bundle
+	require.Len(t, bundle.Blueprints, 1)
+
+	organization := support.CreateOrganization(t, r, r.User)
+
+	t.Run("components are created", func(t *testing.T) {
+		_, err := ImportCanvas(ctx, r.AuthService, r.Registry, organization.ID.String(), &pb.ImportCanvasRequest{Bundle: bundle})
+		require.NoError(t, err)
+
+		_, err = models.FindBlueprintByName(blueprint.Name, organization.ID)
+		require.NoError(t, err)
+	})
+
+	t.Run("components with the same content are reused", func(t *testing.T) {
+		_, err := ImportCanvas(ctx, r.AuthService, r.Registry, organization.ID.String(), &pb.ImportCanvasRequest{
+			Bundle: bundle,
+			Name:   "reused",
+		})
+		require.NoError(t, err)
+
+		var count int64
+		require.NoError(t, database.Conn().Model(&models.Blueprint{}).Where("organization_id = ?", organization.ID).Count(&count).Error)
+		assert.Equal(t, int64(1), count)
+	})
+
+	t.Run("component with a different content -> error", func(t *testing.T) {
+		changed := proto.Clone(bundle).(*pb.CanvasBundle)
+		changed.Blueprints[0].Nodes[0].Name = "changed"
+
+		_, err := ImportCanvas(ctx, r.AuthService, r.Registry, organization.ID.String(), &pb.ImportCanvasRequest{
+			Bundle: changed,
+			Name:   "changed",
+		})
+
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.FailedPrecondition, s.Code())
+		assert.Contains(t, s.Message(), blueprint.Name)
+	})
+
+	t.Run("failed import -> no components are left behind", func(t *testing.T) {
+		other := support.CreateOrganization(t, r, r.User)
+		broken := proto.Clone(bundle).(*pb.CanvasBundle)
+		broken.Spec.Nodes[0].Blueprint.Id = uuid.NewString()
+
+		_, err := ImportCanvas(ctx, r.AuthService, r.Registry, other.ID.String(), &pb.ImportCanvasRequest{Bundle: broken})
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+
+		_, err = models.FindBlueprintByName(blueprint.Name, other.ID)
+		assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	})
+
+	t.Run("user cannot create components -> error", func(t *testing.T) {
+		other := support.CreateOrganization(t, r, r.User)
+		viewer := support.CreateUser(t, r, other.ID)
+		viewerCtx := authentication.SetUserIdInMetadata(context.Background(), viewer.ID.String())
+
+		_, err := ImportCanvas(viewerCtx, r.AuthService, r.Registry, other.ID.String(), &pb.ImportCanvasRequest{Bundle: bundle})
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.PermissionDenied, s.Code())
+
+		_, err = models.FindBlueprintByName(blueprint.Name, other.ID)
+		assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	})
+}
+
+func Test__SameBlueprintContent(t *testing.T) {
+	integrationID := uuid.NewString()
+	existing := &models.Blueprint{
+		Nodes: datatypes.NewJSONSlice([]models.Node{
+			{
+				ID:            "create-issue",
+				Name:          "create-issue",
+				Type:          models.NodeTypeComponent,
+				Ref:           models.NodeRef{Component: &models.ComponentRef{Name: "github.createIssue"}},
+				Configuration: map[string]any{"title": "Deploy", "count": float64(2)},
+				Metadata:      map[string]any{"repository": "superplane"},
+				Position:      models.Position{X: 10, Y: 20},
+				IntegrationID: &integrationID,
+			},
+		}),
+		Edges: datatypes.NewJSONSlice([]models.Edge{}),
+	}
+
+	//
+	// Bundles have integrations referenced by name,
+	// and mapped to the organization on import.
+	//
+	exported := &blueprintpb.Blueprint{Nodes: actions.NodesToProto(existing.Nodes)}
+	exported.Nodes[0].Integration = &componentpb.IntegrationRef{Name: "github"}
+	exported.Nodes[0].Metadata = nil
+	remapBundleNodeIntegration(exported.Nodes[0], map[string]string{"github": integrationID})
+
+	assert.True(t, sameBlueprintContent(existing, exported))
+
+	changed := proto.Clone(exported).(*blueprintpb.Blueprint)
+	changed.Nodes[0].Configuration.Fields["title"] = structpb.NewStringValue("Rollback")
+	assert.False(t, sameBlueprintContent(existing, changed))
+
+	otherIntegration := proto.Clone(exported).(*blueprintpb.Blueprint)
+	remapBundleNodeIntegration(otherIntegration.Nodes[0], map[string]string{"github": uuid.NewString()})
+	assert.False(t, sameBlueprintContent(existing, otherIntegration))
+}
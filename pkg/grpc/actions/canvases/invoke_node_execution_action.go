@@ -79,6 +79,10 @@ func InvokeNodeExecutionAction(
 		return nil, fmt.Errorf("user not found: %w", err)
 	}
 
+	if err := authorizeNodeAction(authService, canvas, node.NodeID, user); err != nil {
+		return nil, err
+	}
+
 	newEvents := []models.CanvasEvent{}
 	onNewEvents := func(events []models.CanvasEvent) {
 		newEvents = append(newEvents, events...)
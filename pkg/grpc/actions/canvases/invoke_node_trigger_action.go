@@ -72,11 +72,15 @@ func InvokeNodeTriggerAction(
 		return nil, status.Errorf(codes.InvalidArgument, "action parameter validation failed: %v", err)
 	}
 
-	_, err = models.FindActiveUserByID(orgID.String(), userID)
+	user, err := models.FindActiveUserByID(orgID.String(), userID)
 	if err != nil {
 		return nil, status.Errorf(codes.NotFound, "user not found: %v", err)
 	}
 
+	if err := authorizeNodeAction(authService, canvas, node.NodeID, user); err != nil {
+		return nil, err
+	}
+
 	tx := database.Conn()
 	logger := logging.ForNode(*node)
 
@@ -88,6 +92,7 @@ func InvokeNodeTriggerAction(
 		Metadata:      contexts.NewNodeMetadataContext(tx, node),
 		Requests:      contexts.NewNodeRequestContext(tx, node),
 		Webhook:       contexts.NewNodeWebhookContext(ctx, tx, encryptor, node, webhookBaseURL),
+		Secrets:       contexts.NewSecretsContext(tx, orgID, canvasID, encryptor, registry.SecretBackends),
 	}
 
 	newEvents := []models.CanvasEvent{}
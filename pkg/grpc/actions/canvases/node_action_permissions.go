@@ -0,0 +1,279 @@
+package canvases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/authorization"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func parseCanvasNodeActionPermissionsConfig(
+	config *pb.CanvasNodeActionPermissionsConfig,
+) ([]models.CanvasNodeActionPermissions, error) {
+	parsed := make([]models.CanvasNodeActionPermissions, 0, len(config.Items))
+	seenNodes := map[string]struct{}{}
+
+	for index, item := range config.Items {
+		if item == nil {
+			return nil, fmt.Errorf("permissions %d are required", index+1)
+		}
+
+		nodeID := strings.TrimSpace(item.NodeId)
+		if nodeID == "" {
+			return nil, fmt.Errorf("permissions %d: node id is required", index+1)
+		}
+
+		if _, ok := seenNodes[nodeID]; ok {
+			return nil, fmt.Errorf("permissions %d: duplicate node %s", index+1, nodeID)
+		}
+
+		permissions := models.CanvasNodeActionPermissions{
+			NodeID: nodeID,
+			Users:  normalizeNodeActionSubjects(item.UserIds),
+			Groups: normalizeNodeActionSubjects(item.Groups),
+			Roles:  normalizeNodeActionSubjects(item.Roles),
+		}
+
+		if len(permissions.Users) == 0 && len(permissions.Groups) == 0 && len(permissions.Roles) == 0 {
+			return nil, fmt.Errorf("permissions %d: at least one user, group or role is required", index+1)
+		}
+
+		seenNodes[nodeID] = struct{}{}
+		parsed = append(parsed, permissions)
+	}
+
+	return parsed, nil
+}
+
+func normalizeNodeActionSubjects(values []string) []string {
+	subjects := []string{}
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value != "" && !slices.Contains(subjects, value) {
+			subjects = append(subjects, value)
+		}
+	}
+
+	return subjects
+}
+
+func validateCanvasNodeActionPermissions(
+	authService authorization.Authorization,
+	organizationID string,
+	canvasID string,
+	permissions []models.CanvasNodeActionPermissions,
+) error {
+	for _, item := range permissions {
+		for _, userID := range item.Users {
+			if _, err := uuid.Parse(userID); err != nil {
+				return fmt.Errorf("node %s: user %s is not a valid UUID", item.NodeID, userID)
+			}
+		}
+
+		users, err := models.ListActiveUsersByID(organizationID, item.Users)
+		if err != nil {
+			return fmt.Errorf("failed to validate users: %w", err)
+		}
+
+		if len(users) != len(item.Users) {
+			return fmt.Errorf("node %s: some users were not found in this organization", item.NodeID)
+		}
+
+		for _, group := range item.Groups {
+			if _, err := authService.GetGroupRole(organizationID, models.DomainTypeOrganization, group); err != nil {
+				return fmt.Errorf("node %s: group %s was not found in this organization", item.NodeID, group)
+			}
+		}
+
+		//
+		// Roles can be organization roles, or canvas roles,
+		// like canvas_operator, granted on this canvas.
+		//
+		for _, role := range item.Roles {
+			if _, err := authService.GetRoleDefinition(role, models.DomainTypeOrganization, organizationID); err == nil {
+				continue
+			}
+
+			if _, err := authService.GetRoleDefinition(role, models.DomainTypeCanvas, canvasID); err != nil {
+				return fmt.Errorf("node %s: role %s was not found in this organization or canvas", item.NodeID, role)
+			}
+		}
+	}
+
+	return nil
+}
+
+// authorizeNodeActionPermissionsChange checks the user can change who runs actions on nodes.
+// Updating a canvas is not enough, since it would let editors grant themselves access
+// to nodes restricted to others, so it requires managing the members of the canvas,
+// which organization admins and owners, and canvas owners, can do.
+func authorizeNodeActionPermissionsChange(
+	ctx context.Context,
+	authService authorization.Authorization,
+	canvas *models.Canvas,
+) error {
+	userID, ok := authentication.GetUserIdFromMetadata(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	allowed, err := authService.CheckCanvasPermission(userID, canvas.OrganizationID.String(), canvas.ID.String(), "members", "update")
+	if err != nil {
+		log.Errorf("failed to check node action permissions access of user %s in canvas %s: %v", userID, canvas.ID, err)
+		return status.Error(codes.Internal, "failed to check permissions")
+	}
+
+	if !allowed {
+		return status.Error(codes.PermissionDenied, "changing node action permissions requires permission to manage members")
+	}
+
+	return nil
+}
+
+func serializeCanvasNodeActionPermissionsConfig(
+	permissions []models.CanvasNodeActionPermissions,
+) *pb.CanvasNodeActionPermissionsConfig {
+	config := &pb.CanvasNodeActionPermissionsConfig{
+		Items: make([]*pb.CanvasNodeActionPermissions, 0, len(permissions)),
+	}
+
+	for _, item := range permissions {
+		config.Items = append(config.Items, &pb.CanvasNodeActionPermissions{
+			NodeId:  item.NodeID,
+			UserIds: item.Users,
+			Groups:  item.Groups,
+			Roles:   item.Roles,
+		})
+	}
+
+	return config
+}
+
+// authorizeNodeAction checks the allowlists of the node and of the blueprint nodes it is in,
+// if the canvas has any, so actions on the nodes inside a restricted blueprint node are restricted too.
+// Organization and canvas permissions are checked by the interceptor before this.
+func authorizeNodeAction(
+	authService authorization.Authorization,
+	canvas *models.Canvas,
+	nodeID string,
+	user *models.User,
+) error {
+	nodeIDs, err := findNodeActionScope(canvas, nodeID)
+	if err != nil {
+		log.Errorf("failed to find parent nodes of node %s in canvas %s: %v", nodeID, canvas.ID, err)
+		return status.Error(codes.Internal, "failed to check node permissions")
+	}
+
+	for _, id := range nodeIDs {
+		permissions := canvas.FindNodeActionPermissions(id)
+		if permissions == nil {
+			continue
+		}
+
+		if user == nil {
+			return status.Errorf(codes.PermissionDenied, "actions on node %s are restricted", id)
+		}
+
+		allowed, err := nodeActionPermissionsAllowUser(authService, canvas.OrganizationID.String(), canvas.ID.String(), permissions, user.ID.String())
+		if err != nil {
+			log.Errorf("failed to check action permissions of node %s in canvas %s: %v", id, canvas.ID, err)
+			return status.Error(codes.Internal, "failed to check node permissions")
+		}
+
+		if !allowed {
+			return status.Errorf(codes.PermissionDenied, "you are not allowed to run actions on node %s", id)
+		}
+	}
+
+	return nil
+}
+
+// findNodeActionScope returns the node and the blueprint nodes it is in,
+// starting with the node itself.
+func findNodeActionScope(canvas *models.Canvas, nodeID string) ([]string, error) {
+	nodeIDs := []string{nodeID}
+	if len(canvas.NodeActionPermissions) == 0 {
+		return nodeIDs, nil
+	}
+
+	current := nodeID
+	for {
+		node, err := canvas.FindNode(current)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nodeIDs, nil
+			}
+
+			return nil, err
+		}
+
+		if node.ParentNodeID == nil || slices.Contains(nodeIDs, *node.ParentNodeID) {
+			return nodeIDs, nil
+		}
+
+		current = *node.ParentNodeID
+		nodeIDs = append(nodeIDs, current)
+	}
+}
+
+func nodeActionPermissionsAllowUser(
+	authService authorization.Authorization,
+	organizationID string,
+	canvasID string,
+	permissions *models.CanvasNodeActionPermissions,
+	userID string,
+) (bool, error) {
+	if slices.Contains(permissions.Users, userID) {
+		return true, nil
+	}
+
+	for _, group := range permissions.Groups {
+		userIDs, err := authService.GetGroupUsers(organizationID, models.DomainTypeOrganization, group)
+		if err != nil {
+			return false, fmt.Errorf("error finding users in group %s: %w", group, err)
+		}
+
+		if slices.Contains(userIDs, userID) {
+			return true, nil
+		}
+	}
+
+	if len(permissions.Roles) == 0 {
+		return false, nil
+	}
+
+	roles, err := authService.GetUserRolesForOrg(userID, organizationID)
+	if err != nil {
+		return false, fmt.Errorf("error finding roles for user: %w", err)
+	}
+
+	for _, role := range roles {
+		if slices.Contains(permissions.Roles, role.Name) {
+			return true, nil
+		}
+	}
+
+	canvasRoles, err := authService.GetUserRolesForCanvas(userID, organizationID, canvasID)
+	if err != nil {
+		return false, fmt.Errorf("error finding canvas roles for user: %w", err)
+	}
+
+	for _, role := range canvasRoles {
+		if slices.Contains(permissions.Roles, role) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
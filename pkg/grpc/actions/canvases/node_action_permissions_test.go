@@ -0,0 +1,153 @@
+package canvases
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/datatypes"
+)
+
+func TestNodeActionPermissions(t *testing.T) {
+	r := support.Setup(t)
+	ctx := authentication.SetUserIdInMetadata(context.Background(), r.User.String())
+	orgID := r.Organization.ID.String()
+	canvasID := createCanvasWithNoopNode(ctx, t, r, "node-permissions")
+
+	updatePermissions := func(items ...*pb.CanvasNodeActionPermissions) (*pb.UpdateCanvasResponse, error) {
+		return UpdateCanvas(ctx, r.AuthService, orgID, canvasID, nil, nil, nil, nil, nil,
+			&pb.CanvasNodeActionPermissionsConfig{Items: items},
+			nil,
+		)
+	}
+
+	emitEvent := func() error {
+		_, err := EmitNodeEvent(ctx, r.AuthService, r.Organization.ID, uuid.MustParse(canvasID), "node-1", "default", map[string]any{})
+		return err
+	}
+
+	t.Run("node without subjects -> error", func(t *testing.T) {
+		_, err := updatePermissions(&pb.CanvasNodeActionPermissions{NodeId: "node-1"})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("unknown group -> error", func(t *testing.T) {
+		_, err := updatePermissions(&pb.CanvasNodeActionPermissions{NodeId: "node-1", Groups: []string{"unknown"}})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("only group members can emit events on a restricted node", func(t *testing.T) {
+		err := r.AuthService.CreateGroup(orgID, models.DomainTypeOrganization, "releasers", models.RoleOrgViewer, "Releasers", "")
+		require.NoError(t, err)
+
+		response, err := updatePermissions(&pb.CanvasNodeActionPermissions{NodeId: "node-1", Groups: []string{"releasers"}})
+		require.NoError(t, err)
+		require.Len(t, response.Canvas.Metadata.NodeActionPermissionsConfig.Items, 1)
+
+		err = emitEvent()
+		require.Error(t, err)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		require.NoError(t, r.AuthService.AddUserToGroup(orgID, models.DomainTypeOrganization, r.User.String(), "releasers"))
+		require.NoError(t, emitEvent())
+	})
+
+	t.Run("listed users can emit events on a restricted node", func(t *testing.T) {
+		_, err := updatePermissions(&pb.CanvasNodeActionPermissions{NodeId: "node-1", UserIds: []string{r.User.String()}})
+		require.NoError(t, err)
+		require.NoError(t, emitEvent())
+	})
+
+	t.Run("users with a canvas role can emit events on a node restricted to it", func(t *testing.T) {
+		_, err := updatePermissions(&pb.CanvasNodeActionPermissions{NodeId: "node-1", Roles: []string{models.RoleCanvasOperator}})
+		require.NoError(t, err)
+
+		err = emitEvent()
+		require.Error(t, err)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		require.NoError(t, r.AuthService.AssignRole(r.User.String(), models.RoleCanvasOperator, canvasID, models.DomainTypeCanvas))
+		require.NoError(t, emitEvent())
+	})
+
+	t.Run("unknown role -> error", func(t *testing.T) {
+		_, err := updatePermissions(&pb.CanvasNodeActionPermissions{NodeId: "node-1", Roles: []string{"unknown"}})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("users that cannot manage members cannot change permissions", func(t *testing.T) {
+		editor := support.CreateUser(t, r, r.Organization.ID)
+		require.NoError(t, r.AuthService.AssignRole(editor.ID.String(), models.RoleCanvasEditor, canvasID, models.DomainTypeCanvas))
+		editorCtx := authentication.SetUserIdInMetadata(context.Background(), editor.ID.String())
+
+		_, err := UpdateCanvas(editorCtx, r.AuthService, orgID, canvasID, nil, nil, nil, nil, nil,
+			&pb.CanvasNodeActionPermissionsConfig{Items: []*pb.CanvasNodeActionPermissions{
+				{NodeId: "node-1", UserIds: []string{editor.ID.String()}},
+			}},
+			nil,
+		)
+		require.Error(t, err)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("removing permissions opens the node again", func(t *testing.T) {
+		response, err := updatePermissions()
+		require.NoError(t, err)
+		assert.Empty(t, response.Canvas.Metadata.NodeActionPermissionsConfig.Items)
+		require.NoError(t, emitEvent())
+	})
+}
+
+func TestNodeActionPermissions_BlueprintNodes(t *testing.T) {
+	r := support.Setup(t)
+
+	blueprint := support.CreateBlueprint(t, r.Organization.ID, []models.Node{
+		{
+			ID:   "approval",
+			Name: "Approval",
+			Type: models.NodeTypeComponent,
+			Ref:  models.NodeRef{Component: &models.ComponentRef{Name: "noop"}},
+		},
+	}, []models.Edge{}, []models.BlueprintOutputChannel{})
+
+	canvas, _ := support.CreateCanvas(t, r.Organization.ID, r.User, []models.CanvasNode{
+		{
+			NodeID: "release",
+			Name:   "Release",
+			Type:   models.NodeTypeBlueprint,
+			Ref:    datatypes.NewJSONType(models.NodeRef{Blueprint: &models.BlueprintRef{ID: blueprint.ID.String()}}),
+		},
+	}, []models.Edge{})
+
+	user, err := models.FindActiveUserByID(r.Organization.ID.String(), r.User.String())
+	require.NoError(t, err)
+
+	t.Run("nodes inside a restricted blueprint node are restricted", func(t *testing.T) {
+		canvas.NodeActionPermissions = []models.CanvasNodeActionPermissions{
+			{NodeID: "release", Users: []string{uuid.NewString()}},
+		}
+
+		err := authorizeNodeAction(r.AuthService, canvas, "release:approval", user)
+		require.Error(t, err)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("users allowed on the blueprint node can act on nodes inside it", func(t *testing.T) {
+		canvas.NodeActionPermissions = []models.CanvasNodeActionPermissions{
+			{NodeID: "release", Users: []string{r.User.String()}},
+		}
+
+		require.NoError(t, authorizeNodeAction(r.AuthService, canvas, "release:approval", user))
+	})
+}
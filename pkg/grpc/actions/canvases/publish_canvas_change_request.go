@@ -16,6 +16,7 @@ import (
 	"github.com/superplanehq/superplane/pkg/registry"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"gorm.io/datatypes"
 	"gorm.io/gorm"
 )
 
@@ -65,6 +66,7 @@ func PublishCanvasChangeRequest(
 	var request *models.CanvasChangeRequest
 	var liveVersion *models.CanvasVersion
 	var renewedDraftVersion *models.CanvasVersion
+	var failedChecksRequest *models.CanvasChangeRequest
 
 	err = database.Conn().Transaction(func(tx *gorm.DB) error {
 		canvasForUpdate, canvasErr := models.FindCanvasInTransaction(tx, organizationUUID, canvasUUID)
@@ -104,6 +106,17 @@ func PublishCanvasChangeRequest(
 		if !isOpenCanvasChangeRequestStatus(request.Status) {
 			return status.Error(codes.FailedPrecondition, "change request cannot be published in its current status")
 		}
+
+		//
+		// Checks are run again, since the components, integrations
+		// and check configuration of the canvas might have changed.
+		//
+		request.Checks = datatypes.NewJSONSlice(runCanvasChangeRequestChecks(registry, organizationID, canvasForUpdate, version))
+		if checksErr := ensureCanvasChangeRequestChecksPassed(request); checksErr != nil {
+			failedChecksRequest = request
+			return checksErr
+		}
+
 		approvals, approvalsErr := models.ListCanvasChangeRequestApprovalsInTransaction(tx, canvasUUID, request.ID)
 		if approvalsErr != nil {
 			return approvalsErr
@@ -224,6 +237,7 @@ func PublishCanvasChangeRequest(
 			request.OwnerID,
 			mergedNodes,
 			mergedEdges,
+			version.Variables,
 		)
 		if err != nil {
 			return err
@@ -249,6 +263,7 @@ func PublishCanvasChangeRequest(
 				*request.OwnerID,
 				liveVersion.Nodes,
 				liveVersion.Edges,
+				liveVersion.Variables,
 			)
 			if err != nil {
 				return err
@@ -258,6 +273,17 @@ func PublishCanvasChangeRequest(
 		canvas = canvasForUpdate
 		return nil
 	})
+
+	//
+	// Failing checks roll back the transaction,
+	// so their results are saved after it, for them to be shown on the change request.
+	//
+	if failedChecksRequest != nil {
+		if saveErr := models.UpdateCanvasChangeRequestChecks(failedChecksRequest); saveErr != nil {
+			log.Errorf("failed to save checks for change request %s: %v", failedChecksRequest.ID, saveErr)
+		}
+	}
+
 	if err != nil {
 		if status.Code(err) != codes.Unknown {
 			return nil, nil, err
@@ -63,6 +63,10 @@ func ResolveCanvasChangeRequest(
 	if err != nil {
 		return nil, err
 	}
+	variables, err := ParseCanvasVariables(pbCanvas)
+	if err != nil {
+		return nil, err
+	}
 	nodes, edges, err = applyCanvasAutoLayout(nodes, edges, autoLayout, registry)
 	if err != nil {
 		return nil, err
@@ -113,12 +117,14 @@ func ResolveCanvasChangeRequest(
 		now := time.Now()
 		version.Nodes = datatypes.NewJSONSlice(nodes)
 		version.Edges = datatypes.NewJSONSlice(edges)
+		version.Variables = datatypes.NewJSONSlice(variables)
 		version.UpdatedAt = &now
 		if saveErr := tx.Save(version).Error; saveErr != nil {
 			return saveErr
 		}
 
 		request.BasedOnVersionID = canvasInTx.LiveVersionID
+		request.Checks = datatypes.NewJSONSlice(runCanvasChangeRequestChecks(registry, organizationID, canvasInTx, version))
 		return refreshCanvasChangeRequestDiffInTransaction(tx, canvasInTx, version, request)
 	})
 	if err != nil {
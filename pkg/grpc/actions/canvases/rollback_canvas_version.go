@@ -0,0 +1,303 @@
+package canvases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/grpc/actions"
+	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"github.com/superplanehq/superplane/pkg/registry"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// RollbackCanvasVersion publishes a new live version with the nodes, edges
+// and variables of an older published version. The older version is not changed,
+// so the history of the canvas keeps every version that was live.
+//
+// When versioning is enabled, the rollback opens a change request instead,
+// so it goes through the same checks and approvals as any other change.
+// The returned version is then the version of that change request.
+func RollbackCanvasVersion(
+	ctx context.Context,
+	encryptor crypto.Encryptor,
+	registry *registry.Registry,
+	organizationID string,
+	canvasID string,
+	versionID string,
+	webhookBaseURL string,
+) (*pb.RollbackCanvasVersionResponse, error) {
+	userID, ok := authentication.GetUserIdFromMetadata(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	canvasUUID, err := uuid.Parse(canvasID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid canvas id: %v", err)
+	}
+
+	versionUUID, err := uuid.Parse(versionID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid version id: %v", err)
+	}
+
+	organizationUUID := uuid.MustParse(organizationID)
+	userUUID := uuid.MustParse(userID)
+
+	canvas, err := models.FindCanvas(organizationUUID, canvasUUID)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "canvas not found: %v", err)
+	}
+
+	if canvas.IsTemplate {
+		return nil, status.Error(codes.FailedPrecondition, "templates are read-only")
+	}
+
+	versioningEnabled, err := isCanvasVersioningEnabledForCanvas(canvas)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load canvas versioning: %v", err)
+	}
+
+	var liveVersion *models.CanvasVersion
+	var changeRequestVersion *models.CanvasVersion
+	err = database.Conn().Transaction(func(tx *gorm.DB) error {
+		canvasForUpdate, canvasErr := models.FindCanvasInTransaction(tx, organizationUUID, canvasUUID)
+		if canvasErr != nil {
+			return canvasErr
+		}
+
+		version, versionErr := models.FindCanvasVersionInTransaction(tx, canvasUUID, versionUUID)
+		if versionErr != nil {
+			if errors.Is(versionErr, gorm.ErrRecordNotFound) {
+				return status.Error(codes.NotFound, "version not found")
+			}
+			return versionErr
+		}
+
+		if !version.IsPublished {
+			return status.Error(codes.FailedPrecondition, "only published versions can be rolled back to")
+		}
+
+		if canvasForUpdate.LiveVersionID != nil && *canvasForUpdate.LiveVersionID == version.ID {
+			return status.Error(codes.FailedPrecondition, "version is already live")
+		}
+
+		if versioningEnabled {
+			changeRequestVersion, err = openRollbackChangeRequestInTransaction(tx, registry, organizationID, canvasForUpdate, userUUID, version)
+			return err
+		}
+
+		nodes, edges, applyErr := applyCanvasNodesToLiveInTransaction(
+			ctx,
+			tx,
+			encryptor,
+			registry,
+			organizationID,
+			canvasUUID,
+			version.Nodes,
+			version.Edges,
+			webhookBaseURL,
+		)
+		if applyErr != nil {
+			return applyErr
+		}
+
+		liveVersion, err = models.CreatePublishedCanvasVersionInTransaction(
+			tx,
+			canvasUUID,
+			&userUUID,
+			nodes,
+			edges,
+			version.Variables,
+		)
+		if err != nil {
+			return err
+		}
+
+		//
+		// Open change requests were based on the previous live version,
+		// so their changed and conflicting nodes need to be recomputed.
+		//
+		return refreshOpenCanvasChangeRequestsInTransaction(tx, organizationUUID, canvasUUID, uuid.Nil)
+	})
+	if err != nil {
+		if status.Code(err) != codes.Unknown {
+			return nil, err
+		}
+		return nil, actions.ToStatus(err)
+	}
+
+	if changeRequestVersion != nil {
+		if err := messages.NewCanvasVersionUpdatedMessage(canvas.ID.String(), changeRequestVersion.ID.String()).PublishVersionUpdated(); err != nil {
+			log.Errorf("failed to publish canvas update RabbitMQ message: %v", err)
+		}
+
+		return &pb.RollbackCanvasVersionResponse{
+			Version: SerializeCanvasVersion(changeRequestVersion, organizationID),
+		}, nil
+	}
+
+	if err := messages.NewCanvasUpdatedMessage(canvas.ID.String()).Publish(true); err != nil {
+		log.Errorf("failed to publish canvas updated RabbitMQ message: %v", err)
+	}
+	if err := messages.NewCanvasVersionUpdatedMessage(canvas.ID.String(), liveVersion.ID.String()).PublishVersionUpdated(); err != nil {
+		log.Errorf("failed to publish canvas update RabbitMQ message: %v", err)
+	}
+
+	return &pb.RollbackCanvasVersionResponse{
+		Version: SerializeCanvasVersion(liveVersion, organizationID),
+	}, nil
+}
+
+// openRollbackChangeRequestInTransaction opens a change request
+// with the nodes, edges and variables of an older published version.
+func openRollbackChangeRequestInTransaction(
+	tx *gorm.DB,
+	registry *registry.Registry,
+	organizationID string,
+	canvas *models.Canvas,
+	userID uuid.UUID,
+	version *models.CanvasVersion,
+) (*models.CanvasVersion, error) {
+	if canvas.LiveVersionID == nil {
+		return nil, status.Error(codes.FailedPrecondition, "canvas live version not found")
+	}
+
+	snapshot, err := models.CreateCanvasSnapshotVersionInTransaction(
+		tx,
+		canvas.ID,
+		userID,
+		version.Nodes,
+		version.Edges,
+		version.Variables,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	request := &models.CanvasChangeRequest{
+		ID:               uuid.New(),
+		WorkflowID:       canvas.ID,
+		VersionID:        snapshot.ID,
+		OwnerID:          &userID,
+		BasedOnVersionID: canvas.LiveVersionID,
+		Title:            "Roll back " + canvas.Name + " to version " + version.ID.String(),
+		Status:           models.CanvasChangeRequestStatusOpen,
+		Checks:           datatypes.NewJSONSlice(runCanvasChangeRequestChecks(registry, organizationID, canvas, snapshot)),
+		CreatedAt:        &now,
+		UpdatedAt:        &now,
+	}
+
+	if err := tx.Create(request).Error; err != nil {
+		return nil, err
+	}
+
+	if err := refreshCanvasChangeRequestDiffInTransaction(tx, canvas, snapshot, request); err != nil {
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
+// applyCanvasNodesToLiveInTransaction makes the runtime nodes of a canvas match the given nodes,
+// creating, updating and setting up the ones in it, and deleting the ones that are not.
+// Node-level errors and metadata are recorded on the returned nodes.
+func applyCanvasNodesToLiveInTransaction(
+	ctx context.Context,
+	tx *gorm.DB,
+	encryptor crypto.Encryptor,
+	registry *registry.Registry,
+	organizationID string,
+	canvasID uuid.UUID,
+	nodes []models.Node,
+	edges []models.Edge,
+	webhookBaseURL string,
+) ([]models.Node, []models.Edge, error) {
+	existingNodesUnscoped, err := models.FindCanvasNodesUnscopedInTransaction(tx, canvasID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	nodes, edges, _ = remapNodeIDsForConflicts(canvasID, nodes, edges, existingNodesUnscoped)
+
+	parentNodesByNodeID := make(map[string]*models.Node, len(nodes))
+	for i := range nodes {
+		parentNodesByNodeID[nodes[i].ID] = &nodes[i]
+	}
+
+	expandedNodes, err := expandNodes(organizationID, nodes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	existingNodes, err := models.FindCanvasNodesInTransaction(tx, canvasID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, node := range expandedNodes {
+		if node.Type == models.NodeTypeWidget {
+			continue
+		}
+
+		workflowNode, nodeLevelErrorMessage, upsertErr := upsertNode(tx, existingNodes, node, canvasID)
+		if upsertErr != nil {
+			return nil, nil, upsertErr
+		}
+
+		errorNodeID := node.ID
+		if workflowNode.ParentNodeID != nil {
+			errorNodeID = *workflowNode.ParentNodeID
+		}
+
+		if nodeLevelErrorMessage != nil {
+			if parentNode, ok := parentNodesByNodeID[errorNodeID]; ok {
+				parentNode.ErrorMessage = nodeLevelErrorMessage
+			}
+		}
+
+		if workflowNode.State == models.CanvasNodeStateReady {
+			setupErr := setupNode(ctx, tx, encryptor, registry, workflowNode, webhookBaseURL)
+			if setupErr != nil {
+				workflowNode.State = models.CanvasNodeStateError
+				errorMsg := setupErr.Error()
+				workflowNode.StateReason = &errorMsg
+				if saveErr := tx.Save(workflowNode).Error; saveErr != nil {
+					return nil, nil, saveErr
+				}
+
+				if parentNode, ok := parentNodesByNodeID[errorNodeID]; ok {
+					parentNode.ErrorMessage = &errorMsg
+				}
+			}
+		}
+
+		if workflowNode.ParentNodeID != nil {
+			continue
+		}
+
+		parentNode, exists := parentNodesByNodeID[workflowNode.NodeID]
+		if !exists {
+			return nil, nil, status.Errorf(codes.Internal, "it was not possible to find the parent node %s", workflowNode.NodeID)
+		}
+		parentNode.Metadata = workflowNode.Metadata.Data()
+	}
+
+	if err := deleteNodes(tx, existingNodes, expandedNodes); err != nil {
+		return nil, nil, err
+	}
+
+	return nodes, edges, nil
+}
@@ -0,0 +1,97 @@
+package canvases
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRollbackCanvasVersion(t *testing.T) {
+	r := support.Setup(t)
+	ctx := authentication.SetUserIdInMetadata(context.Background(), r.User.String())
+
+	canvasID := createCanvasWithNoopNode(ctx, t, r, "rollback")
+	canvasUUID := uuid.MustParse(canvasID)
+
+	canvas, err := models.FindCanvas(r.Organization.ID, canvasUUID)
+	require.NoError(t, err)
+	initialVersionID := *canvas.LiveVersionID
+
+	//
+	// Publish a version that renames the node.
+	//
+	liveVersion, err := models.FindLiveCanvasVersionInTransaction(database.Conn(), canvasUUID)
+	require.NoError(t, err)
+	nodes := liveVersion.Nodes
+	nodes[0].Name = "Renamed"
+	renamedVersion, err := models.CreatePublishedCanvasVersionInTransaction(database.Conn(), canvasUUID, &r.User, nodes, liveVersion.Edges, nil)
+	require.NoError(t, err)
+
+	t.Run("live version -> error", func(t *testing.T) {
+		_, err := RollbackCanvasVersion(ctx, r.Encryptor, r.Registry, r.Organization.ID.String(), canvasID, renamedVersion.ID.String(), testWebhookBaseURL)
+		require.Error(t, err)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("draft version -> error", func(t *testing.T) {
+		draftVersionID := createDraftVersion(ctx, t, r, canvasID, "Draft Name")
+		_, err := RollbackCanvasVersion(ctx, r.Encryptor, r.Registry, r.Organization.ID.String(), canvasID, draftVersionID, testWebhookBaseURL)
+		require.Error(t, err)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("versioning enabled -> change request with the older version", func(t *testing.T) {
+		response, err := RollbackCanvasVersion(ctx, r.Encryptor, r.Registry, r.Organization.ID.String(), canvasID, initialVersionID.String(), testWebhookBaseURL)
+		require.NoError(t, err)
+		assert.False(t, response.Version.Metadata.IsPublished)
+		assert.Equal(t, "Initial Name", response.Version.Spec.Nodes[0].Name)
+
+		canvas, err := models.FindCanvas(r.Organization.ID, canvasUUID)
+		require.NoError(t, err)
+		assert.Equal(t, renamedVersion.ID, *canvas.LiveVersionID)
+
+		var request models.CanvasChangeRequest
+		require.NoError(t, database.Conn().Where("version_id = ?", response.Version.Metadata.Id).First(&request).Error)
+		assert.Equal(t, models.CanvasChangeRequestStatusOpen, request.Status)
+		assert.Equal(t, renamedVersion.ID, *request.BasedOnVersionID)
+		assert.NotEmpty(t, request.Checks)
+		assert.Equal(t, []string{"node-1"}, []string(request.ChangedNodeIDs))
+
+		canvasNodes, err := models.FindCanvasNodesInTransaction(database.Conn(), canvasUUID)
+		require.NoError(t, err)
+		require.Len(t, canvasNodes, 1)
+		assert.Equal(t, "Renamed", canvasNodes[0].Name)
+	})
+
+	t.Run("versioning disabled -> new live version", func(t *testing.T) {
+		require.NoError(t, database.Conn().Model(&models.Organization{}).Where("id = ?", r.Organization.ID).Update("versioning_enabled", false).Error)
+		require.NoError(t, database.Conn().Model(&models.Canvas{}).Where("id = ?", canvasUUID).Update("versioning_enabled", false).Error)
+
+		response, err := RollbackCanvasVersion(ctx, r.Encryptor, r.Registry, r.Organization.ID.String(), canvasID, initialVersionID.String(), testWebhookBaseURL)
+		require.NoError(t, err)
+
+		newVersionID := response.Version.Metadata.Id
+		assert.NotEqual(t, initialVersionID.String(), newVersionID)
+		assert.NotEqual(t, renamedVersion.ID.String(), newVersionID)
+		assert.True(t, response.Version.Metadata.IsPublished)
+		assert.Equal(t, "Initial Name", response.Version.Spec.Nodes[0].Name)
+
+		canvas, err := models.FindCanvas(r.Organization.ID, canvasUUID)
+		require.NoError(t, err)
+		assert.Equal(t, newVersionID, canvas.LiveVersionID.String())
+
+		canvasNodes, err := models.FindCanvasNodesInTransaction(database.Conn(), canvasUUID)
+		require.NoError(t, err)
+		require.Len(t, canvasNodes, 1)
+		assert.Equal(t, "Initial Name", canvasNodes[0].Name)
+	})
+}
@@ -16,6 +16,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
 )
 
 func SerializeCanvas(canvas *models.Canvas, includeStatus bool) (*pb.Canvas, error) {
@@ -59,10 +60,14 @@ func SerializeCanvas(canvas *models.Canvas, includeStatus bool) (*pb.Canvas, err
 				ChangeRequestApprovalConfig: serializeCanvasChangeRequestApprovalConfig(
 					canvas.EffectiveChangeRequestApprovers(),
 				),
+				Environment:                 canvas.Environment,
+				ChangeRequestCheckConfig:    serializeCanvasChangeRequestCheckConfig(canvas.ChangeRequestChecks.Data()),
+				NodeActionPermissionsConfig: serializeCanvasNodeActionPermissionsConfig(canvas.NodeActionPermissions),
 			},
 			Spec: &pb.Canvas_Spec{
-				Nodes: serializedNodes,
-				Edges: actions.EdgesToProto(liveVersion.Edges),
+				Nodes:     serializedNodes,
+				Edges:     actions.EdgesToProto(liveVersion.Edges),
+				Variables: SerializeCanvasVariables(liveVersion.Variables),
 			},
 			Status: nil,
 		}, nil
@@ -125,10 +130,14 @@ func SerializeCanvas(canvas *models.Canvas, includeStatus bool) (*pb.Canvas, err
 			ChangeRequestApprovalConfig: serializeCanvasChangeRequestApprovalConfig(
 				canvas.EffectiveChangeRequestApprovers(),
 			),
+			Environment:                 canvas.Environment,
+			ChangeRequestCheckConfig:    serializeCanvasChangeRequestCheckConfig(canvas.ChangeRequestChecks.Data()),
+			NodeActionPermissionsConfig: serializeCanvasNodeActionPermissionsConfig(canvas.NodeActionPermissions),
 		},
 		Spec: &pb.Canvas_Spec{
-			Nodes: serializedNodes,
-			Edges: actions.EdgesToProto(liveVersion.Edges),
+			Nodes:     serializedNodes,
+			Edges:     actions.EdgesToProto(liveVersion.Edges),
+			Variables: SerializeCanvasVariables(liveVersion.Variables),
 		},
 		Status: &pb.Canvas_Status{
 			LastExecutions: serializedExecutions,
@@ -156,6 +165,15 @@ func serializeCanvasChangeRequestApprovalConfig(
 	return config
 }
 
+func serializeCanvasChangeRequestCheckConfig(
+	config models.CanvasChangeRequestCheckConfig,
+) *pb.CanvasChangeRequestCheckConfig {
+	return &pb.CanvasChangeRequestCheckConfig{
+		ForbiddenComponents: config.ForbiddenComponents,
+		SimulationEnabled:   config.SimulationEnabled,
+	}
+}
+
 func canvasChangeRequestApproverTypeToProto(value string) pb.CanvasChangeRequestApprover_Type {
 	switch value {
 	case models.CanvasChangeRequestApproverTypeAnyone:
@@ -195,6 +213,12 @@ func serializeCanvasNodes(canvasID uuid.UUID, nodes []models.Node) ([]*compb.Nod
 }
 
 func ParseCanvas(registry *registry.Registry, orgID string, canvas *pb.Canvas) ([]models.Node, []models.Edge, error) {
+	return ParseCanvasInTransaction(database.Conn(), registry, orgID, canvas)
+}
+
+// ParseCanvasInTransaction parses a canvas, finding the blueprints
+// its nodes use through the transaction, so blueprints created in it are found too.
+func ParseCanvasInTransaction(tx *gorm.DB, registry *registry.Registry, orgID string, canvas *pb.Canvas) ([]models.Node, []models.Edge, error) {
 	if canvas.Metadata == nil {
 		return nil, nil, status.Error(codes.InvalidArgument, "canvas metadata is required")
 	}
@@ -207,6 +231,11 @@ func ParseCanvas(registry *registry.Registry, orgID string, canvas *pb.Canvas) (
 		return nil, nil, status.Error(codes.InvalidArgument, "canvas spec is required")
 	}
 
+	variables, err := ParseCanvasVariables(canvas)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	// Allow empty canvases
 	if len(canvas.Spec.Nodes) == 0 {
 		return []models.Node{}, []models.Edge{}, nil
@@ -232,7 +261,7 @@ func ParseCanvas(registry *registry.Registry, orgID string, canvas *pb.Canvas) (
 		nodeIDs[node.Id] = true
 		nodeTypeByID[node.Id] = node.Type
 
-		if err := validateNodeRef(registry, orgID, node); err != nil {
+		if err := validateNodeRefInTransaction(tx, registry, orgID, node); err != nil {
 			nodeValidationErrors[node.Id] = err.Error()
 		}
 	}
@@ -268,6 +297,8 @@ func ParseCanvas(registry *registry.Registry, orgID string, canvas *pb.Canvas) (
 
 	// Convert proto nodes to models, adding validation errors and warnings where applicable
 	nodes := actions.ProtoToNodes(canvas.Spec.Nodes)
+	edges := actions.ProtoToEdges(canvas.Spec.Edges)
+	expressionWarnings := actions.FindExpressionWarnings(registry, nodes, edges, variables)
 	for i := range nodes {
 		if errorMsg, hasError := nodeValidationErrors[nodes[i].ID]; hasError {
 			nodes[i].ErrorMessage = &errorMsg
@@ -275,17 +306,31 @@ func ParseCanvas(registry *registry.Registry, orgID string, canvas *pb.Canvas) (
 			nodes[i].ErrorMessage = nil
 		}
 
+		warnings := []string{}
 		if warningMsg, hasWarning := nodeWarnings[nodes[i].ID]; hasWarning {
+			warnings = append(warnings, warningMsg)
+		}
+
+		if warningMsg, hasWarning := expressionWarnings[nodes[i].ID]; hasWarning {
+			warnings = append(warnings, warningMsg)
+		}
+
+		if len(warnings) > 0 {
+			warningMsg := strings.Join(warnings, "\n")
 			nodes[i].WarningMessage = &warningMsg
 		} else {
 			nodes[i].WarningMessage = nil
 		}
 	}
 
-	return nodes, actions.ProtoToEdges(canvas.Spec.Edges), nil
+	return nodes, edges, nil
 }
 
 func validateNodeRef(registry *registry.Registry, organizationID string, node *compb.Node) error {
+	return validateNodeRefInTransaction(database.Conn(), registry, organizationID, node)
+}
+
+func validateNodeRefInTransaction(tx *gorm.DB, registry *registry.Registry, organizationID string, node *compb.Node) error {
 	switch node.Type {
 	case compb.Node_TYPE_COMPONENT:
 		if node.Component == nil {
@@ -312,7 +357,7 @@ func validateNodeRef(registry *registry.Registry, organizationID string, node *c
 			return fmt.Errorf("blueprint ID is required")
 		}
 
-		blueprint, err := models.FindBlueprint(organizationID, node.Blueprint.Id)
+		blueprint, err := models.FindBlueprintInTransaction(tx, organizationID, node.Blueprint.Id)
 		if err != nil {
 			return fmt.Errorf("blueprint %s not found", node.Blueprint.Id)
 		}
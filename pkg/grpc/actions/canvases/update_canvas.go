@@ -17,11 +17,12 @@ import (
 	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"gorm.io/datatypes"
 	"gorm.io/gorm"
 )
 
 func UpdateCanvas(
-	_ context.Context,
+	ctx context.Context,
 	authService authorization.Authorization,
 	organizationID string,
 	id string,
@@ -29,6 +30,9 @@ func UpdateCanvas(
 	description *string,
 	versioningEnabled *bool,
 	changeRequestApprovalConfig *pb.CanvasChangeRequestApprovalConfig,
+	changeRequestCheckConfig *pb.CanvasChangeRequestCheckConfig,
+	nodeActionPermissionsConfig *pb.CanvasNodeActionPermissionsConfig,
+	environment *string,
 ) (*pb.UpdateCanvasResponse, error) {
 	canvasID, err := uuid.Parse(id)
 	if err != nil {
@@ -90,11 +94,63 @@ func UpdateCanvas(
 		}
 	}
 
+	if changeRequestCheckConfig != nil {
+		checkConfig, parseErr := parseCanvasChangeRequestCheckConfig(changeRequestCheckConfig)
+		if parseErr != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid change request check config: %v", parseErr)
+		}
+
+		current := canvas.ChangeRequestChecks.Data()
+		if !slices.Equal(current.ForbiddenComponents, checkConfig.ForbiddenComponents) ||
+			current.SimulationEnabled != checkConfig.SimulationEnabled {
+			canvas.ChangeRequestChecks = datatypes.NewJSONType(checkConfig)
+			changed = true
+		}
+	}
+
+	if nodeActionPermissionsConfig != nil {
+		permissions, parseErr := parseCanvasNodeActionPermissionsConfig(nodeActionPermissionsConfig)
+		if parseErr != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid node action permissions config: %v", parseErr)
+		}
+
+		validateErr := validateCanvasNodeActionPermissions(authService, organizationID, canvas.ID.String(), permissions)
+		if validateErr != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid node action permissions config: %v", validateErr)
+		}
+
+		if !slices.EqualFunc(canvas.NodeActionPermissions, permissions, func(left, right models.CanvasNodeActionPermissions) bool {
+			return left.NodeID == right.NodeID &&
+				slices.Equal(left.Users, right.Users) &&
+				slices.Equal(left.Groups, right.Groups) &&
+				slices.Equal(left.Roles, right.Roles)
+		}) {
+			if err := authorizeNodeActionPermissionsChange(ctx, authService, canvas); err != nil {
+				return nil, err
+			}
+
+			canvas.NodeActionPermissions = permissions
+			changed = true
+		}
+	}
+
 	if versioningEnabled != nil && canvas.VersioningEnabled != *versioningEnabled {
 		canvas.VersioningEnabled = *versioningEnabled
 		changed = true
 	}
 
+	if environment != nil {
+		nextEnvironment := strings.TrimSpace(*environment)
+		if !models.IsValidCanvasEnvironment(nextEnvironment) {
+			return nil, status.Error(codes.InvalidArgument, "environment must have at most 64 characters and contain only letters, digits, dashes and underscores")
+		}
+
+		if canvas.Environment != nextEnvironment {
+			canvas.Environment = nextEnvironment
+			changed = true
+		}
+	}
+
 	if changed {
 		now := time.Now()
 		canvas.UpdatedAt = &now
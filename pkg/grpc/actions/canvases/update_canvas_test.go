@@ -21,7 +21,7 @@ func Test__UpdateCanvas(t *testing.T) {
 	t.Run("invalid canvas id -> error", func(t *testing.T) {
 		name := "name"
 		description := "description"
-		_, err := UpdateCanvas(context.Background(), r.AuthService, r.Organization.ID.String(), "invalid-id", &name, &description, nil, nil)
+		_, err := UpdateCanvas(context.Background(), r.AuthService, r.Organization.ID.String(), "invalid-id", &name, &description, nil, nil, nil, nil, nil)
 		s, ok := status.FromError(err)
 		assert.True(t, ok)
 		assert.Equal(t, codes.InvalidArgument, s.Code())
@@ -37,6 +37,9 @@ func Test__UpdateCanvas(t *testing.T) {
 			stringPointer("updated-description"),
 			nil,
 			nil,
+			nil,
+			nil,
+			nil,
 		)
 		s, ok := status.FromError(err)
 		assert.True(t, ok)
@@ -55,6 +58,9 @@ func Test__UpdateCanvas(t *testing.T) {
 			stringPointer("description"),
 			nil,
 			nil,
+			nil,
+			nil,
+			nil,
 		)
 		s, ok := status.FromError(err)
 		assert.True(t, ok)
@@ -75,6 +81,9 @@ func Test__UpdateCanvas(t *testing.T) {
 			&newDescription,
 			nil,
 			nil,
+			nil,
+			nil,
+			nil,
 		)
 		require.NoError(t, err)
 		require.NotNil(t, response)
@@ -103,6 +112,9 @@ func Test__UpdateCanvas(t *testing.T) {
 			&targetCanvas.Description,
 			nil,
 			nil,
+			nil,
+			nil,
+			nil,
 		)
 		s, ok := status.FromError(err)
 		assert.True(t, ok)
@@ -126,6 +138,9 @@ func Test__UpdateCanvas(t *testing.T) {
 			nil,
 			&enabled,
 			nil,
+			nil,
+			nil,
+			nil,
 		)
 		require.NoError(t, err)
 		require.NotNil(t, response)
@@ -155,6 +170,9 @@ func Test__UpdateCanvas(t *testing.T) {
 			nil,
 			&enabled,
 			nil,
+			nil,
+			nil,
+			nil,
 		)
 		require.NoError(t, err)
 
@@ -168,6 +186,9 @@ func Test__UpdateCanvas(t *testing.T) {
 			nil,
 			&disabled,
 			nil,
+			nil,
+			nil,
+			nil,
 		)
 		require.NoError(t, err)
 		require.NotNil(t, response)
@@ -197,6 +218,9 @@ func Test__UpdateCanvas(t *testing.T) {
 			nil,
 			&enabled,
 			nil,
+			nil,
+			nil,
+			nil,
 		)
 		require.NoError(t, err)
 		require.NotNil(t, response)
@@ -229,6 +253,9 @@ func Test__UpdateCanvas(t *testing.T) {
 					},
 				},
 			},
+			nil,
+			nil,
+			nil,
 		)
 		require.NoError(t, err)
 		require.NotNil(t, response)
@@ -259,6 +286,9 @@ func Test__UpdateCanvas(t *testing.T) {
 					},
 				},
 			},
+			nil,
+			nil,
+			nil,
 		)
 		s, ok := status.FromError(err)
 		assert.True(t, ok)
@@ -282,6 +312,9 @@ func Test__UpdateCanvas(t *testing.T) {
 					{Type: pb.CanvasChangeRequestApprover_TYPE_ANYONE},
 				},
 			},
+			nil,
+			nil,
+			nil,
 		)
 		s, ok := status.FromError(err)
 		assert.True(t, ok)
@@ -57,6 +57,11 @@ func UpdateCanvasVersion(
 		return nil, err
 	}
 
+	variables, err := ParseCanvasVariables(pbCanvas)
+	if err != nil {
+		return nil, err
+	}
+
 	nodes, edges, err = applyCanvasAutoLayout(nodes, edges, autoLayout, registry)
 	if err != nil {
 		return nil, err
@@ -81,6 +86,7 @@ func UpdateCanvasVersion(
 			canvas,
 			nodes,
 			edges,
+			variables,
 			webhookBaseURL,
 		)
 	}
@@ -124,6 +130,7 @@ func UpdateCanvasVersion(
 		now := time.Now()
 		version.Nodes = datatypes.NewJSONSlice(nodes)
 		version.Edges = datatypes.NewJSONSlice(edges)
+		version.Variables = datatypes.NewJSONSlice(variables)
 		version.UpdatedAt = &now
 
 		if err := tx.Save(version).Error; err != nil {
@@ -160,6 +167,7 @@ func updateLiveCanvasWithoutVersioning(
 	canvas *models.Canvas,
 	nodes []models.Node,
 	edges []models.Edge,
+	variables []models.CanvasVariable,
 	webhookBaseURL string,
 ) (*pb.UpdateCanvasVersionResponse, error) {
 	organizationID := organizationUUID.String()
@@ -266,6 +274,7 @@ func updateLiveCanvasWithoutVersioning(
 
 		liveVersion.Nodes = datatypes.NewJSONSlice(nodes)
 		liveVersion.Edges = datatypes.NewJSONSlice(edges)
+		liveVersion.Variables = datatypes.NewJSONSlice(variables)
 		liveVersion.UpdatedAt = &now
 		if saveErr := tx.Save(liveVersion).Error; saveErr != nil {
 			return saveErr
@@ -0,0 +1,127 @@
+package canvases
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/exprruntime"
+	"github.com/superplanehq/superplane/pkg/grpc/actions"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"github.com/superplanehq/superplane/pkg/registry"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func ValidateExpression(ctx context.Context, registry *registry.Registry, organizationID string, req *pb.ValidateExpressionRequest) (*pb.ValidateExpressionResponse, error) {
+	if strings.TrimSpace(req.Expression) == "" {
+		return nil, status.Error(codes.InvalidArgument, "expression is required")
+	}
+
+	scope, err := findExpressionScope(ctx, registry, organizationID, req.CanvasId, req.VersionId, req.NodeId)
+	if err != nil {
+		return nil, err
+	}
+
+	diagnostics := exprruntime.ValidateText(req.Expression, *scope)
+	response := &pb.ValidateExpressionResponse{
+		Valid:       true,
+		Diagnostics: make([]*pb.ExpressionDiagnostic, 0, len(diagnostics)),
+	}
+
+	for _, diagnostic := range diagnostics {
+		severity := pb.ExpressionDiagnostic_SEVERITY_WARNING
+		if diagnostic.Severity == exprruntime.SeverityError {
+			severity = pb.ExpressionDiagnostic_SEVERITY_ERROR
+			response.Valid = false
+		}
+
+		response.Diagnostics = append(response.Diagnostics, &pb.ExpressionDiagnostic{
+			Severity: severity,
+			Message:  diagnostic.Message,
+			From:     int32(diagnostic.From),
+			To:       int32(diagnostic.To),
+		})
+	}
+
+	return response, nil
+}
+
+// findExpressionScope builds the scope for the expressions of a node,
+// using the live canvas, or the given version of it.
+func findExpressionScope(ctx context.Context, registry *registry.Registry, organizationID, canvasID, versionID, nodeID string) (*exprruntime.Scope, error) {
+	if nodeID == "" {
+		return nil, status.Error(codes.InvalidArgument, "node_id is required")
+	}
+
+	nodes, edges, vars, err := findExpressionSpec(ctx, organizationID, canvasID, versionID)
+	if err != nil {
+		return nil, err
+	}
+
+	found := false
+	for _, node := range nodes {
+		if node.ID == nodeID {
+			found = true
+			break
+		}
+	}
+
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "node %s not found", nodeID)
+	}
+
+	scope := actions.ExpressionScope(registry, nodes, edges, nodeID)
+	scope.Vars = vars
+	return &scope, nil
+}
+
+func findExpressionSpec(ctx context.Context, organizationID, canvasID, versionID string) ([]models.Node, []models.Edge, map[string]any, error) {
+	canvasUUID, err := uuid.Parse(canvasID)
+	if err != nil {
+		return nil, nil, nil, status.Error(codes.InvalidArgument, "invalid canvas_id")
+	}
+
+	canvas, err := models.FindCanvas(uuid.MustParse(organizationID), canvasUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, nil, status.Error(codes.NotFound, "canvas not found")
+		}
+		return nil, nil, nil, status.Error(codes.Internal, "failed to load canvas")
+	}
+
+	//
+	// Versions other than the live one might be drafts,
+	// so we go through DescribeCanvasVersion, which checks if the user can see them.
+	//
+	if versionID != "" {
+		response, err := DescribeCanvasVersion(ctx, organizationID, canvasID, versionID)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		spec := response.Version.Spec
+		variables, err := ParseCanvasVariables(&pb.Canvas{Spec: spec})
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		vars := models.CanvasVariablesForExpressions(variables, canvas.Environment)
+		return actions.ProtoToNodes(spec.Nodes), actions.ProtoToEdges(spec.Edges), vars, nil
+	}
+
+	version, err := models.FindLiveCanvasVersionByCanvasInTransaction(database.Conn(), canvas)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return []models.Node{}, []models.Edge{}, map[string]any{}, nil
+		}
+		return nil, nil, nil, status.Error(codes.Internal, "failed to load canvas spec")
+	}
+
+	vars := models.CanvasVariablesForExpressions(version.Variables, canvas.Environment)
+	return version.Nodes, version.Edges, vars, nil
+}
@@ -0,0 +1,181 @@
+package canvases
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/datatypes"
+)
+
+// createExpressionCanvas creates a canvas with a Start trigger,
+// connected to an If component, connected to a NoOp component,
+// and a region variable.
+func createExpressionCanvas(t *testing.T, r *support.ResourceRegistry) *models.Canvas {
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: "start",
+				Name:   "Start",
+				Type:   models.NodeTypeTrigger,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Trigger: &models.TriggerRef{Name: "start"}}),
+			},
+			{
+				NodeID: "check",
+				Name:   "Check",
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "if"}}),
+			},
+			{
+				NodeID: "notify",
+				Name:   "Notify",
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+			},
+		},
+		[]models.Edge{
+			{SourceID: "start", TargetID: "check", Channel: "default"},
+			{SourceID: "check", TargetID: "notify", Channel: "true"},
+		},
+	)
+
+	variables := []models.CanvasVariable{{Name: "region", Value: "us-east-1"}}
+	err := database.Conn().
+		Model(&models.CanvasVersion{}).
+		Where("id = ?", canvas.LiveVersionID).
+		Update("variables", datatypes.NewJSONSlice(variables)).
+		Error
+	require.NoError(t, err)
+
+	return canvas
+}
+
+func Test__ValidateExpression(t *testing.T) {
+	r := support.Setup(t)
+	canvas := createExpressionCanvas(t, r)
+
+	validate := func(nodeID, expression string) (*pb.ValidateExpressionResponse, error) {
+		return ValidateExpression(context.Background(), r.Registry, r.Organization.ID.String(), &pb.ValidateExpressionRequest{
+			CanvasId:   canvas.ID.String(),
+			NodeId:     nodeID,
+			Expression: expression,
+		})
+	}
+
+	t.Run("fields of upstream nodes are checked against their examples", func(t *testing.T) {
+		response, err := validate("notify", `$["Start"].data.foo == "bar" && $["Check"].type != ""`)
+		require.NoError(t, err)
+		assert.True(t, response.Valid)
+		assert.Empty(t, response.Diagnostics)
+
+		response, err = validate("notify", `$["Start"].foo`)
+		require.NoError(t, err)
+		require.Len(t, response.Diagnostics, 1)
+		assert.Contains(t, response.Diagnostics[0].Message, `field "foo" not found`)
+
+		response, err = validate("notify", `$["Start"].data.missing`)
+		require.NoError(t, err)
+		assert.True(t, response.Valid)
+		require.Len(t, response.Diagnostics, 1)
+		assert.Equal(t, pb.ExpressionDiagnostic_SEVERITY_WARNING, response.Diagnostics[0].Severity)
+		assert.Contains(t, response.Diagnostics[0].Message, `field "missing" not found`)
+	})
+
+	t.Run("nodes not upstream are errors", func(t *testing.T) {
+		response, err := validate("check", `$["Notify"].data`)
+		require.NoError(t, err)
+		assert.False(t, response.Valid)
+		require.Len(t, response.Diagnostics, 1)
+		assert.Equal(t, pb.ExpressionDiagnostic_SEVERITY_ERROR, response.Diagnostics[0].Severity)
+		assert.Contains(t, response.Diagnostics[0].Message, `node "Notify" is not connected upstream`)
+	})
+
+	t.Run("root and previous", func(t *testing.T) {
+		response, err := validate("notify", `root().data.foo + previous(2).data.foo`)
+		require.NoError(t, err)
+		assert.Empty(t, response.Diagnostics)
+
+		response, err = validate("notify", `root().sha + previous().sha`)
+		require.NoError(t, err)
+		require.Len(t, response.Diagnostics, 2)
+		assert.Contains(t, response.Diagnostics[0].Message, `field "sha" not found in root()`)
+		assert.Contains(t, response.Diagnostics[1].Message, `field "sha" not found in previous(1)`)
+	})
+
+	t.Run("canvas variables", func(t *testing.T) {
+		response, err := validate("check", `vars.region == "us-east-1"`)
+		require.NoError(t, err)
+		assert.Empty(t, response.Diagnostics)
+
+		response, err = validate("check", `vars.zone`)
+		require.NoError(t, err)
+		assert.False(t, response.Valid)
+		require.Len(t, response.Diagnostics, 1)
+		assert.Equal(t, `variable "zone" is not defined in this canvas`, response.Diagnostics[0].Message)
+	})
+
+	t.Run("syntax errors are reported with their location", func(t *testing.T) {
+		response, err := validate("check", `root().data.foo ==`)
+		require.NoError(t, err)
+		assert.False(t, response.Valid)
+		require.Len(t, response.Diagnostics, 1)
+		assert.Equal(t, pb.ExpressionDiagnostic_SEVERITY_ERROR, response.Diagnostics[0].Severity)
+		assert.LessOrEqual(t, response.Diagnostics[0].To, int32(len(`root().data.foo ==`)))
+	})
+
+	t.Run("empty expression -> invalid argument", func(t *testing.T) {
+		_, err := validate("check", "  ")
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+	})
+
+	t.Run("node is required", func(t *testing.T) {
+		_, err := validate("", "1 + 1")
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+	})
+
+	t.Run("unknown node -> not found", func(t *testing.T) {
+		_, err := validate("does-not-exist", "1 + 1")
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+	})
+
+	t.Run("invalid canvas id -> invalid argument", func(t *testing.T) {
+		_, err := ValidateExpression(context.Background(), r.Registry, r.Organization.ID.String(), &pb.ValidateExpressionRequest{
+			CanvasId:   "invalid-id",
+			NodeId:     "check",
+			Expression: "1 + 1",
+		})
+
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+	})
+
+	t.Run("unknown canvas -> not found", func(t *testing.T) {
+		_, err := ValidateExpression(context.Background(), r.Registry, r.Organization.ID.String(), &pb.ValidateExpressionRequest{
+			CanvasId:   uuid.NewString(),
+			NodeId:     "check",
+			Expression: "1 + 1",
+		})
+
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+	})
+}
@@ -0,0 +1,132 @@
+package canvases
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func ParseCanvasVariables(canvas *pb.Canvas) ([]models.CanvasVariable, error) {
+	if canvas.Spec == nil {
+		return []models.CanvasVariable{}, nil
+	}
+
+	names := make(map[string]bool, len(canvas.Spec.Variables))
+	variables := make([]models.CanvasVariable, 0, len(canvas.Spec.Variables))
+	for i, variable := range canvas.Spec.Variables {
+		name := strings.TrimSpace(variable.Name)
+		if name == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "variable %d: name is required", i)
+		}
+
+		if !models.IsValidCanvasVariableName(name) {
+			return nil, status.Errorf(codes.InvalidArgument, "variable %s: name must start with a letter or underscore and contain only letters, digits and underscores", name)
+		}
+
+		if names[name] {
+			return nil, status.Errorf(codes.InvalidArgument, "variable %s: duplicate variable name", name)
+		}
+		names[name] = true
+
+		secret, err := parseCanvasVariableSecretRef(variable.Secret, variable.Value)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "variable %s: %v", name, err)
+		}
+
+		overrides, err := parseCanvasVariableOverrides(variable.Overrides)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "variable %s: %v", name, err)
+		}
+
+		variables = append(variables, models.CanvasVariable{
+			Name:        name,
+			Description: variable.Description,
+			Value:       variable.Value,
+			Secret:      secret,
+			Overrides:   overrides,
+		})
+	}
+
+	return variables, nil
+}
+
+func parseCanvasVariableOverrides(overrides []*pb.CanvasVariable_Override) ([]models.CanvasVariableOverride, error) {
+	environments := make(map[string]bool, len(overrides))
+	result := make([]models.CanvasVariableOverride, 0, len(overrides))
+	for i, override := range overrides {
+		environment := strings.TrimSpace(override.Environment)
+		if environment == "" {
+			return nil, fmt.Errorf("override %d: environment is required", i)
+		}
+
+		if environments[environment] {
+			return nil, fmt.Errorf("duplicate override for environment %s", environment)
+		}
+		environments[environment] = true
+
+		secret, err := parseCanvasVariableSecretRef(override.Secret, override.Value)
+		if err != nil {
+			return nil, fmt.Errorf("override for environment %s: %v", environment, err)
+		}
+
+		result = append(result, models.CanvasVariableOverride{
+			Environment: environment,
+			Value:       override.Value,
+			Secret:      secret,
+		})
+	}
+
+	return result, nil
+}
+
+func parseCanvasVariableSecretRef(ref *pb.CanvasVariable_SecretRef, value string) (*models.CanvasVariableSecretRef, error) {
+	if ref == nil || (ref.Secret == "" && ref.Key == "") {
+		return nil, nil
+	}
+
+	if ref.Secret == "" || ref.Key == "" {
+		return nil, fmt.Errorf("secret and key are required for secret-backed values")
+	}
+
+	if value != "" {
+		return nil, fmt.Errorf("value and secret cannot be used together")
+	}
+
+	return &models.CanvasVariableSecretRef{Secret: ref.Secret, Key: ref.Key}, nil
+}
+
+func SerializeCanvasVariables(variables []models.CanvasVariable) []*pb.CanvasVariable {
+	result := make([]*pb.CanvasVariable, 0, len(variables))
+	for _, variable := range variables {
+		overrides := make([]*pb.CanvasVariable_Override, 0, len(variable.Overrides))
+		for _, override := range variable.Overrides {
+			overrides = append(overrides, &pb.CanvasVariable_Override{
+				Environment: override.Environment,
+				Value:       override.Value,
+				Secret:      serializeCanvasVariableSecretRef(override.Secret),
+			})
+		}
+
+		result = append(result, &pb.CanvasVariable{
+			Name:        variable.Name,
+			Description: variable.Description,
+			Value:       variable.Value,
+			Secret:      serializeCanvasVariableSecretRef(variable.Secret),
+			Overrides:   overrides,
+		})
+	}
+
+	return result
+}
+
+func serializeCanvasVariableSecretRef(ref *models.CanvasVariableSecretRef) *pb.CanvasVariable_SecretRef {
+	if ref == nil {
+		return nil
+	}
+
+	return &pb.CanvasVariable_SecretRef{Secret: ref.Secret, Key: ref.Key}
+}
@@ -38,8 +38,9 @@ func SerializeCanvasVersion(version *models.CanvasVersion, organizationID string
 	return &pb.CanvasVersion{
 		Metadata: metadata,
 		Spec: &pb.Canvas_Spec{
-			Nodes: actions.NodesToProto(version.Nodes),
-			Edges: actions.EdgesToProto(version.Edges),
+			Nodes:     actions.NodesToProto(version.Nodes),
+			Edges:     actions.EdgesToProto(version.Edges),
+			Variables: SerializeCanvasVariables(version.Variables),
 		},
 	}
 }
@@ -37,6 +37,8 @@ func DomainTypeToProto(domainType string) pbAuth.DomainType {
 	switch domainType {
 	case models.DomainTypeOrganization:
 		return pbAuth.DomainType_DOMAIN_TYPE_ORGANIZATION
+	case models.DomainTypeCanvas:
+		return pbAuth.DomainType_DOMAIN_TYPE_CANVAS
 	default:
 		return pbAuth.DomainType_DOMAIN_TYPE_UNSPECIFIED
 	}
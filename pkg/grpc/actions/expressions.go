@@ -0,0 +1,274 @@
+package actions
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/exprruntime"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/registry"
+)
+
+// previous(depth) is only resolved while the upstream chain is linear,
+// and never deeper than this.
+const maxPreviousDepth = 10
+
+// exampleTimestamp is the timestamp of examples that do not have one.
+const exampleTimestamp = "2026-01-01T00:00:00Z"
+
+// ConfigurationExpression is an expression found in a node configuration.
+// Path is the location of the value in the configuration, like "headers[0].value".
+type ConfigurationExpression struct {
+	Path string
+	Text string
+}
+
+// ExpressionScope builds the scope used to validate and complete the expressions of a node,
+// using the example payloads of the nodes upstream of it.
+func ExpressionScope(registry *registry.Registry, nodes []models.Node, edges []models.Edge, nodeID string) exprruntime.Scope {
+	nodesByID := make(map[string]models.Node, len(nodes))
+	for _, node := range nodes {
+		nodesByID[node.ID] = node
+	}
+
+	incoming := map[string][]string{}
+	for _, edge := range edges {
+		if !slices.Contains(incoming[edge.TargetID], edge.SourceID) {
+			incoming[edge.TargetID] = append(incoming[edge.TargetID], edge.SourceID)
+		}
+	}
+
+	scope := exprruntime.Scope{Nodes: map[string]any{}}
+	triggers := []models.Node{}
+	seen := map[string]bool{nodeID: true}
+	queue := []string{nodeID}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		for _, sourceID := range incoming[current] {
+			if seen[sourceID] {
+				continue
+			}
+
+			seen[sourceID] = true
+			queue = append(queue, sourceID)
+
+			source, ok := nodesByID[sourceID]
+			if !ok || source.Name == "" {
+				continue
+			}
+
+			scope.Nodes[source.Name] = nodeExample(registry, source)
+			if source.Type == models.NodeTypeTrigger {
+				triggers = append(triggers, source)
+			}
+		}
+	}
+
+	//
+	// root() is only known if a single trigger can start the run.
+	//
+	if len(triggers) == 1 {
+		scope.Root = nodeExample(registry, triggers[0])
+	}
+
+	current := nodeID
+	for depth := 0; depth < maxPreviousDepth; depth++ {
+		sources := incoming[current]
+		if len(sources) != 1 {
+			break
+		}
+
+		source, ok := nodesByID[sources[0]]
+		if !ok {
+			break
+		}
+
+		scope.Previous = append(scope.Previous, nodeExample(registry, source))
+		current = source.ID
+	}
+
+	return scope
+}
+
+// nodeExample returns the example payload of a node, or nil if it is unknown.
+// Examples are copied, since they are shared by every node using the same component.
+func nodeExample(registry *registry.Registry, node models.Node) any {
+	var name string
+	var example map[string]any
+	switch {
+	case node.Type == models.NodeTypeComponent && node.Ref.Component != nil:
+		component, err := registry.GetComponent(node.Ref.Component.Name)
+		if err != nil {
+			return nil
+		}
+		name = component.Name()
+		example = component.ExampleOutput()
+
+	case node.Type == models.NodeTypeTrigger && node.Ref.Trigger != nil:
+		trigger, err := registry.GetTrigger(node.Ref.Trigger.Name)
+		if err != nil {
+			return nil
+		}
+		name = trigger.Name()
+		example = trigger.ExampleData()
+	}
+
+	if len(example) == 0 {
+		return nil
+	}
+
+	data, err := json.Marshal(exampleEvent(name, example))
+	if err != nil {
+		return nil
+	}
+
+	var copied any
+	if err := json.Unmarshal(data, &copied); err != nil {
+		return nil
+	}
+
+	return copied
+}
+
+// exampleEvent puts an example in the {type, timestamp, data} envelope events have at runtime.
+// Most examples are already in it, but some only have the data.
+func exampleEvent(name string, example map[string]any) map[string]any {
+	_, hasData := example["data"]
+	_, hasType := example["type"]
+	if !hasData || !hasType {
+		return map[string]any{
+			"type":      name,
+			"timestamp": exampleTimestamp,
+			"data":      example,
+		}
+	}
+
+	if _, ok := example["timestamp"]; ok {
+		return example
+	}
+
+	event := maps.Clone(example)
+	event["timestamp"] = exampleTimestamp
+	return event
+}
+
+// NodeConfigurationFields returns the configuration fields of a component node,
+// or nil if they are not known.
+func NodeConfigurationFields(registry *registry.Registry, node models.Node) []configuration.Field {
+	if node.Type != models.NodeTypeComponent || node.Ref.Component == nil {
+		return nil
+	}
+
+	component, err := registry.GetComponent(node.Ref.Component.Name)
+	if err != nil {
+		return nil
+	}
+
+	return component.Configuration()
+}
+
+// FindConfigurationExpressions returns the expressions in a node configuration:
+// values of expression fields, and values with {{ }} templates.
+func FindConfigurationExpressions(fields []configuration.Field, config map[string]any) []ConfigurationExpression {
+	expressions := []ConfigurationExpression{}
+	collectConfigurationExpressions(fields, config, "", &expressions)
+
+	sort.SliceStable(expressions, func(i, j int) bool {
+		return expressions[i].Path < expressions[j].Path
+	})
+
+	return expressions
+}
+
+func collectConfigurationExpressions(fields []configuration.Field, config map[string]any, prefix string, expressions *[]ConfigurationExpression) {
+	fieldsByName := make(map[string]configuration.Field, len(fields))
+	for _, field := range fields {
+		fieldsByName[field.Name] = field
+	}
+
+	for key, value := range config {
+		path := key
+		if prefix != "" {
+			path = prefix + "." + key
+		}
+
+		field, ok := fieldsByName[key]
+		if !ok {
+			collectValueExpressions(value, nil, path, expressions)
+			continue
+		}
+
+		collectValueExpressions(value, &field, path, expressions)
+	}
+}
+
+func collectValueExpressions(value any, field *configuration.Field, path string, expressions *[]ConfigurationExpression) {
+	switch v := value.(type) {
+	case string:
+		isExpressionField := field != nil && field.Type == configuration.FieldTypeExpression
+		if (isExpressionField && strings.TrimSpace(v) != "") || exprruntime.IsTemplate(v) {
+			*expressions = append(*expressions, ConfigurationExpression{Path: path, Text: v})
+		}
+
+	case map[string]any:
+		var schema []configuration.Field
+		if field != nil && field.TypeOptions != nil && field.TypeOptions.Object != nil {
+			schema = field.TypeOptions.Object.Schema
+		}
+
+		collectConfigurationExpressions(schema, v, path, expressions)
+
+	case []any:
+		var item *configuration.Field
+		if field != nil && field.TypeOptions != nil && field.TypeOptions.List != nil && field.TypeOptions.List.ItemDefinition != nil {
+			definition := field.TypeOptions.List.ItemDefinition
+			item = &configuration.Field{
+				Type:        definition.Type,
+				TypeOptions: &configuration.TypeOptions{Object: &configuration.ObjectTypeOptions{Schema: definition.Schema}},
+			}
+		}
+
+		for i, element := range v {
+			collectValueExpressions(element, item, fmt.Sprintf("%s[%d]", path, i), expressions)
+		}
+	}
+}
+
+// FindExpressionWarnings validates the expressions in the configuration of every node,
+// returning a warning for the nodes with problems.
+func FindExpressionWarnings(registry *registry.Registry, nodes []models.Node, edges []models.Edge, variables []models.CanvasVariable) map[string]string {
+	vars := models.CanvasVariablesForExpressions(variables, "")
+	warnings := map[string]string{}
+	for _, node := range nodes {
+		if node.Type != models.NodeTypeComponent && node.Type != models.NodeTypeBlueprint {
+			continue
+		}
+
+		expressions := FindConfigurationExpressions(NodeConfigurationFields(registry, node), node.Configuration)
+		if len(expressions) == 0 {
+			continue
+		}
+
+		scope := ExpressionScope(registry, nodes, edges, node.ID)
+		scope.Vars = vars
+		messages := []string{}
+		for _, expression := range expressions {
+			for _, diagnostic := range exprruntime.ValidateText(expression.Text, scope) {
+				messages = append(messages, fmt.Sprintf("%s: %s", expression.Path, diagnostic.Message))
+			}
+		}
+
+		if len(messages) > 0 {
+			warnings[node.ID] = "Expression problems: " + strings.Join(messages, "; ")
+		}
+	}
+
+	return warnings
+}
@@ -0,0 +1,124 @@
+package actions
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/registry"
+
+	_ "github.com/superplanehq/superplane/pkg/components/if"
+	_ "github.com/superplanehq/superplane/pkg/components/noop"
+	_ "github.com/superplanehq/superplane/pkg/triggers/start"
+)
+
+func expressionTestNode(id, name string, nodeType string, ref string) models.Node {
+	node := models.Node{ID: id, Name: name, Type: nodeType}
+	if nodeType == models.NodeTypeTrigger {
+		node.Ref = models.NodeRef{Trigger: &models.TriggerRef{Name: ref}}
+	} else {
+		node.Ref = models.NodeRef{Component: &models.ComponentRef{Name: ref}}
+	}
+
+	return node
+}
+
+// startExample is the example of the start trigger, in the envelope events have at runtime.
+var startExample = map[string]any{
+	"type":      "start",
+	"timestamp": exampleTimestamp,
+	"data":      map[string]any{"foo": "bar"},
+}
+
+func TestExpressionScope(t *testing.T) {
+	reg, err := registry.NewRegistry(&crypto.NoOpEncryptor{}, registry.HTTPOptions{})
+	require.NoError(t, err)
+
+	nodes := []models.Node{
+		expressionTestNode("start", "Start", models.NodeTypeTrigger, "start"),
+		expressionTestNode("check", "Check", models.NodeTypeComponent, "if"),
+		expressionTestNode("notify", "Notify", models.NodeTypeComponent, "noop"),
+		expressionTestNode("other", "Other", models.NodeTypeComponent, "noop"),
+		expressionTestNode("unknown", "Unknown", models.NodeTypeComponent, "does-not-exist"),
+	}
+
+	edges := []models.Edge{
+		{SourceID: "start", TargetID: "check", Channel: "default"},
+		{SourceID: "check", TargetID: "notify", Channel: "true"},
+		{SourceID: "unknown", TargetID: "other", Channel: "default"},
+	}
+
+	t.Run("upstream nodes use their examples", func(t *testing.T) {
+		scope := ExpressionScope(reg, nodes, edges, "notify")
+
+		assert.Equal(t, []string{"Check", "Start"}, sortedKeys(scope.Nodes))
+		assert.Equal(t, startExample, scope.Nodes["Start"])
+		assert.Equal(t, "if.executed", scope.Nodes["Check"].(map[string]any)["type"])
+	})
+
+	t.Run("examples already in the envelope are not wrapped again", func(t *testing.T) {
+		scope := ExpressionScope(reg, nodes, edges, "notify")
+
+		check := scope.Nodes["Check"].(map[string]any)
+		assert.Equal(t, []string{"data", "timestamp", "type"}, sortedKeys(check))
+		assert.NotContains(t, check["data"], "type")
+	})
+
+	t.Run("nodes without example are known, but not checked", func(t *testing.T) {
+		scope := ExpressionScope(reg, nodes, edges, "other")
+
+		require.Contains(t, scope.Nodes, "Unknown")
+		assert.Nil(t, scope.Nodes["Unknown"])
+		assert.Nil(t, scope.Root)
+	})
+
+	t.Run("root is the example of the single trigger", func(t *testing.T) {
+		scope := ExpressionScope(reg, nodes, edges, "notify")
+		assert.Equal(t, startExample, scope.Root)
+
+		withSecondTrigger := append(slices.Clone(nodes), expressionTestNode("start-2", "Start 2", models.NodeTypeTrigger, "start"))
+		withSecondEdge := append(slices.Clone(edges), models.Edge{SourceID: "start-2", TargetID: "check", Channel: "default"})
+		scope = ExpressionScope(reg, withSecondTrigger, withSecondEdge, "notify")
+		assert.Nil(t, scope.Root)
+	})
+
+	t.Run("previous follows the linear chain", func(t *testing.T) {
+		scope := ExpressionScope(reg, nodes, edges, "notify")
+		require.Len(t, scope.Previous, 2)
+		assert.Equal(t, "if.executed", scope.Previous[0].(map[string]any)["type"])
+		assert.Equal(t, startExample, scope.Previous[1])
+
+		merged := append(slices.Clone(edges), models.Edge{SourceID: "other", TargetID: "notify", Channel: "default"})
+		scope = ExpressionScope(reg, nodes, merged, "notify")
+		assert.Empty(t, scope.Previous)
+	})
+
+	t.Run("nodes without upstream have an empty scope", func(t *testing.T) {
+		scope := ExpressionScope(reg, nodes, edges, "start")
+		assert.Empty(t, scope.Nodes)
+		assert.Nil(t, scope.Root)
+		assert.Empty(t, scope.Previous)
+	})
+
+	t.Run("examples are copied", func(t *testing.T) {
+		scope := ExpressionScope(reg, nodes, edges, "check")
+		scope.Nodes["Start"].(map[string]any)["data"].(map[string]any)["foo"] = "changed"
+
+		scope = ExpressionScope(reg, nodes, edges, "check")
+		assert.Equal(t, startExample, scope.Nodes["Start"])
+	})
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	slices.Sort(keys)
+	return keys
+}
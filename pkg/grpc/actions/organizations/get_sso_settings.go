@@ -0,0 +1,49 @@
+package organizations
+
+import (
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func GetSSOSettings(baseURL, orgID string) (*pb.GetSSOSettingsResponse, error) {
+	settings, err := findOrganizationSSOSettings(orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	domains, err := models.ListOrganizationSSODomains(orgID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to load SSO domains")
+	}
+
+	return &pb.GetSSOSettingsResponse{
+		SsoSettings: serializeSSOSettings(baseURL, settings, domains),
+	}, nil
+}
+
+// findOrganizationSSOSettings returns the SSO settings of the organization,
+// or disabled settings if SSO was never configured for it.
+func findOrganizationSSOSettings(orgID string) (*models.OrganizationSSOSettings, error) {
+	settings, err := models.FindOrganizationSSOSettings(orgID)
+	if err == nil {
+		return settings, nil
+	}
+
+	if err != gorm.ErrRecordNotFound {
+		return nil, status.Error(codes.Internal, "failed to load SSO settings")
+	}
+
+	organizationID, err := uuid.Parse(orgID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid organization id")
+	}
+
+	return &models.OrganizationSSOSettings{
+		OrganizationID: organizationID,
+		Protocol:       models.SSOProtocolOIDC,
+	}, nil
+}
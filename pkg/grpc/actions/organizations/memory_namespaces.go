@@ -0,0 +1,241 @@
+package organizations
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+func ListMemoryNamespaces(ctx context.Context, orgID string) (*pb.ListMemoryNamespacesResponse, error) {
+	orgUUID, err := uuid.Parse(orgID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid organization id")
+	}
+
+	records, err := models.ListOrganizationMemoryNamespaces(orgUUID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list memory namespaces")
+	}
+
+	namespaces := make([]*pb.MemoryNamespace, 0, len(records))
+	for _, record := range records {
+		namespaces = append(namespaces, serializeMemoryNamespace(record))
+	}
+
+	return &pb.ListMemoryNamespacesResponse{Namespaces: namespaces}, nil
+}
+
+func UpdateMemoryNamespace(ctx context.Context, orgID, userID string, req *pb.UpdateMemoryNamespaceRequest) (*pb.UpdateMemoryNamespaceResponse, error) {
+	orgUUID, err := uuid.Parse(orgID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid organization id")
+	}
+
+	namespace := strings.TrimSpace(req.Namespace)
+	if !models.IsValidOrganizationMemoryNamespace(namespace) {
+		return nil, status.Error(codes.InvalidArgument, "namespace must have up to 128 letters, digits, dots, dashes or underscores")
+	}
+
+	defaultAccess, err := accessFromProto(req.DefaultAccess)
+	if err != nil {
+		return nil, err
+	}
+
+	canvasAccess, err := parseMemoryNamespaceCanvasAccess(orgUUID, req.CanvasAccess)
+	if err != nil {
+		return nil, err
+	}
+
+	record := &models.OrganizationMemoryNamespace{
+		OrganizationID: orgUUID,
+		Namespace:      namespace,
+		Description:    strings.TrimSpace(req.Description),
+		DefaultAccess:  defaultAccess,
+		CanvasAccess:   canvasAccess,
+	}
+
+	if userUUID, err := uuid.Parse(userID); err == nil {
+		record.CreatedBy = &userUUID
+	}
+
+	if err := models.SaveOrganizationMemoryNamespace(record); err != nil {
+		log.Errorf("failed to save memory namespace %s for organization %s: %v", namespace, orgID, err)
+		return nil, status.Error(codes.Internal, "failed to update memory namespace")
+	}
+
+	saved, err := models.FindOrganizationMemoryNamespace(orgUUID, namespace)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to load memory namespace")
+	}
+
+	return &pb.UpdateMemoryNamespaceResponse{Namespace: serializeMemoryNamespace(*saved)}, nil
+}
+
+func DeleteMemoryNamespace(ctx context.Context, orgID, namespace string) (*pb.DeleteMemoryNamespaceResponse, error) {
+	settings, err := findMemoryNamespace(orgID, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := models.DeleteOrganizationMemoryNamespace(settings.OrganizationID, settings.Namespace); err != nil {
+		log.Errorf("failed to delete memory namespace %s for organization %s: %v", settings.Namespace, orgID, err)
+		return nil, status.Error(codes.Internal, "failed to delete memory namespace")
+	}
+
+	return &pb.DeleteMemoryNamespaceResponse{}, nil
+}
+
+func ListMemories(ctx context.Context, orgID, namespace string) (*pb.ListMemoriesResponse, error) {
+	settings, err := findMemoryNamespace(orgID, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := models.ListOrganizationMemoriesByNamespace(settings.OrganizationID, settings.Namespace)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list memories")
+	}
+
+	items := make([]*pb.Memory, 0, len(records))
+	for _, record := range records {
+		values, err := structpb.NewValue(record.Values.Data())
+		if err != nil {
+			return nil, status.Error(codes.Internal, "failed to serialize memory")
+		}
+
+		items = append(items, &pb.Memory{
+			Id:        record.ID.String(),
+			Namespace: record.Namespace,
+			Values:    values,
+			CreatedAt: timestamppb.New(record.CreatedAt),
+			UpdatedAt: timestamppb.New(record.UpdatedAt),
+		})
+	}
+
+	return &pb.ListMemoriesResponse{Items: items}, nil
+}
+
+func DeleteMemory(ctx context.Context, orgID, namespace, memoryID string) (*pb.DeleteMemoryResponse, error) {
+	settings, err := findMemoryNamespace(orgID, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	memoryUUID, err := uuid.Parse(memoryID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid memory_id")
+	}
+
+	if err := models.DeleteOrganizationMemory(settings.OrganizationID, memoryUUID); err != nil {
+		return nil, status.Error(codes.Internal, "failed to delete memory")
+	}
+
+	return &pb.DeleteMemoryResponse{}, nil
+}
+
+func findMemoryNamespace(orgID, namespace string) (*models.OrganizationMemoryNamespace, error) {
+	orgUUID, err := uuid.Parse(orgID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid organization id")
+	}
+
+	settings, err := models.FindOrganizationMemoryNamespace(orgUUID, strings.TrimSpace(namespace))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "memory namespace not found")
+		}
+
+		return nil, status.Error(codes.Internal, "failed to load memory namespace")
+	}
+
+	return settings, nil
+}
+
+func parseMemoryNamespaceCanvasAccess(orgID uuid.UUID, grants []*pb.MemoryNamespace_CanvasAccess) ([]models.OrganizationMemoryCanvasAccess, error) {
+	seen := make(map[uuid.UUID]bool, len(grants))
+	result := make([]models.OrganizationMemoryCanvasAccess, 0, len(grants))
+	for _, grant := range grants {
+		canvasID, err := uuid.Parse(grant.CanvasId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid canvas id %q", grant.CanvasId)
+		}
+
+		if seen[canvasID] {
+			return nil, status.Errorf(codes.InvalidArgument, "duplicate access for canvas %s", canvasID)
+		}
+		seen[canvasID] = true
+
+		if _, err := models.FindCanvas(orgID, canvasID); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, status.Errorf(codes.InvalidArgument, "canvas %s not found", canvasID)
+			}
+
+			return nil, status.Error(codes.Internal, "failed to load canvas")
+		}
+
+		access, err := accessFromProto(grant.Access)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, models.OrganizationMemoryCanvasAccess{
+			CanvasID: canvasID.String(),
+			Access:   access,
+		})
+	}
+
+	return result, nil
+}
+
+func accessFromProto(access pb.MemoryNamespace_Access) (string, error) {
+	switch access {
+	case pb.MemoryNamespace_ACCESS_NONE:
+		return models.OrganizationMemoryAccessNone, nil
+	case pb.MemoryNamespace_ACCESS_READ:
+		return models.OrganizationMemoryAccessRead, nil
+	case pb.MemoryNamespace_ACCESS_WRITE:
+		return models.OrganizationMemoryAccessWrite, nil
+	default:
+		return "", status.Errorf(codes.InvalidArgument, "invalid access %v", access)
+	}
+}
+
+func accessToProto(access string) pb.MemoryNamespace_Access {
+	switch access {
+	case models.OrganizationMemoryAccessRead:
+		return pb.MemoryNamespace_ACCESS_READ
+	case models.OrganizationMemoryAccessWrite:
+		return pb.MemoryNamespace_ACCESS_WRITE
+	default:
+		return pb.MemoryNamespace_ACCESS_NONE
+	}
+}
+
+func serializeMemoryNamespace(namespace models.OrganizationMemoryNamespace) *pb.MemoryNamespace {
+	canvasAccess := make([]*pb.MemoryNamespace_CanvasAccess, 0, len(namespace.CanvasAccess))
+	for _, grant := range namespace.CanvasAccess {
+		canvasAccess = append(canvasAccess, &pb.MemoryNamespace_CanvasAccess{
+			CanvasId: grant.CanvasID,
+			Access:   accessToProto(grant.Access),
+		})
+	}
+
+	return &pb.MemoryNamespace{
+		Namespace:     namespace.Namespace,
+		Description:   namespace.Description,
+		DefaultAccess: accessToProto(namespace.DefaultAccess),
+		CanvasAccess:  canvasAccess,
+		CreatedAt:     timestamppb.New(namespace.CreatedAt),
+		UpdatedAt:     timestamppb.New(namespace.UpdatedAt),
+	}
+}
@@ -0,0 +1,113 @@
+package organizations
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test__MemoryNamespaces(t *testing.T) {
+	r := support.Setup(t)
+	ctx := authentication.SetUserIdInMetadata(context.Background(), r.User.String())
+	orgID := r.Organization.ID.String()
+	canvas, _ := support.CreateCanvas(t, r.Organization.ID, r.User, []models.CanvasNode{}, []models.Edge{})
+
+	t.Run("invalid namespace -> error", func(t *testing.T) {
+		_, err := UpdateMemoryNamespace(ctx, orgID, r.User.String(), &pb.UpdateMemoryNamespaceRequest{
+			Namespace: "has spaces",
+		})
+
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+	})
+
+	t.Run("canvas from another organization -> error", func(t *testing.T) {
+		_, err := UpdateMemoryNamespace(ctx, orgID, r.User.String(), &pb.UpdateMemoryNamespaceRequest{
+			Namespace: "deployments",
+			CanvasAccess: []*pb.MemoryNamespace_CanvasAccess{
+				{CanvasId: uuid.NewString(), Access: pb.MemoryNamespace_ACCESS_WRITE},
+			},
+		})
+
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+	})
+
+	t.Run("duplicate canvas access -> error", func(t *testing.T) {
+		_, err := UpdateMemoryNamespace(ctx, orgID, r.User.String(), &pb.UpdateMemoryNamespaceRequest{
+			Namespace: "deployments",
+			CanvasAccess: []*pb.MemoryNamespace_CanvasAccess{
+				{CanvasId: canvas.ID.String(), Access: pb.MemoryNamespace_ACCESS_READ},
+				{CanvasId: canvas.ID.String(), Access: pb.MemoryNamespace_ACCESS_WRITE},
+			},
+		})
+
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+	})
+
+	t.Run("create, update, list and delete namespace", func(t *testing.T) {
+		response, err := UpdateMemoryNamespace(ctx, orgID, r.User.String(), &pb.UpdateMemoryNamespaceRequest{
+			Namespace:     "deployments",
+			Description:   "Deployments across canvases",
+			DefaultAccess: pb.MemoryNamespace_ACCESS_READ,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "deployments", response.Namespace.Namespace)
+		assert.Equal(t, pb.MemoryNamespace_ACCESS_READ, response.Namespace.DefaultAccess)
+		assert.Empty(t, response.Namespace.CanvasAccess)
+
+		//
+		// Replacing the namespace settings keeps a single namespace.
+		//
+		response, err = UpdateMemoryNamespace(ctx, orgID, r.User.String(), &pb.UpdateMemoryNamespaceRequest{
+			Namespace:     "deployments",
+			DefaultAccess: pb.MemoryNamespace_ACCESS_NONE,
+			CanvasAccess: []*pb.MemoryNamespace_CanvasAccess{
+				{CanvasId: canvas.ID.String(), Access: pb.MemoryNamespace_ACCESS_WRITE},
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, response.Namespace.CanvasAccess, 1)
+		assert.Equal(t, canvas.ID.String(), response.Namespace.CanvasAccess[0].CanvasId)
+		assert.Equal(t, pb.MemoryNamespace_ACCESS_WRITE, response.Namespace.CanvasAccess[0].Access)
+
+		listResponse, err := ListMemoryNamespaces(ctx, orgID)
+		require.NoError(t, err)
+		require.Len(t, listResponse.Namespaces, 1)
+		assert.Equal(t, "", listResponse.Namespaces[0].Description)
+
+		_, err = models.AddOrganizationMemoryInTransaction(database.Conn(), r.Organization.ID, "deployments", map[string]any{"service": "api"})
+		require.NoError(t, err)
+
+		memories, err := ListMemories(ctx, orgID, "deployments")
+		require.NoError(t, err)
+		require.Len(t, memories.Items, 1)
+		assert.Equal(t, "api", memories.Items[0].Values.GetStructValue().AsMap()["service"])
+
+		_, err = DeleteMemoryNamespace(ctx, orgID, "deployments")
+		require.NoError(t, err)
+
+		records, err := models.ListOrganizationMemoriesByNamespace(r.Organization.ID, "deployments")
+		require.NoError(t, err)
+		assert.Empty(t, records)
+
+		_, err = ListMemories(ctx, orgID, "deployments")
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+	})
+}
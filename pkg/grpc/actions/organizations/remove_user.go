@@ -46,6 +46,24 @@ func RemoveUser(ctx context.Context, authService authorization.Authorization, or
 		}
 	}
 
+	//
+	// Canvas roles are only granted to organization users,
+	// so they are removed together with the organization roles.
+	//
+	canvases, err := models.ListCanvases(orgID, false)
+	if err != nil {
+		log.Errorf("Error listing canvases for %s: %v", orgID, err)
+		return nil, status.Error(codes.Internal, "error removing canvas roles")
+	}
+
+	for _, canvas := range canvases {
+		err = authService.RemoveCanvasUserRole(canvas.ID.String(), user.ID.String())
+		if err != nil {
+			log.Errorf("Error removing canvas role on %s for %s: %v", canvas.ID.String(), user.ID.String(), err)
+			return nil, status.Error(codes.Internal, "error removing canvas role")
+		}
+	}
+
 	err = user.Delete()
 	if err != nil {
 		return nil, status.Error(codes.Internal, "error deleting user")
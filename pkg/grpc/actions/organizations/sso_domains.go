@@ -0,0 +1,119 @@
+package organizations
+
+import (
+	"context"
+	"errors"
+	"net"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+const ssoDomainLookupTimeout = 5 * time.Second
+
+var ssoDomainRegex = regexp.MustCompile(`^([a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?\.)+[a-z]{2,63}$`)
+
+// lookupTXT is replaced in tests.
+var lookupTXT = net.DefaultResolver.LookupTXT
+
+// updateSSODomains returns the domains of the organization for the requested list.
+// Domains already in the organization are kept, with their verification,
+// and the ones not verified yet are verified if their DNS TXT record exists.
+func updateSSODomains(ctx context.Context, organizationID string, requested []string) ([]models.OrganizationSSODomain, error) {
+	names := []string{}
+	for _, raw := range requested {
+		name := strings.TrimSuffix(strings.ToLower(strings.TrimSpace(raw)), ".")
+		if name == "" || slices.Contains(names, name) {
+			continue
+		}
+
+		if len(name) > 255 || !ssoDomainRegex.MatchString(name) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid domain %s", raw)
+		}
+
+		names = append(names, name)
+	}
+
+	existing, err := models.ListOrganizationSSODomains(organizationID)
+	if err != nil {
+		log.Errorf("Error listing SSO domains for organization %s: %v", organizationID, err)
+		return nil, status.Error(codes.Internal, "failed to load SSO domains")
+	}
+
+	domains := []models.OrganizationSSODomain{}
+	for _, name := range names {
+		domain, err := findOrNewSSODomain(existing, name)
+		if err != nil {
+			return nil, err
+		}
+
+		if !domain.IsVerified() {
+			err = verifySSODomain(ctx, organizationID, &domain)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		domains = append(domains, domain)
+	}
+
+	return domains, nil
+}
+
+func findOrNewSSODomain(existing []models.OrganizationSSODomain, name string) (models.OrganizationSSODomain, error) {
+	for _, domain := range existing {
+		if domain.Domain == name {
+			return domain, nil
+		}
+	}
+
+	token, err := crypto.Base64String(24)
+	if err != nil {
+		return models.OrganizationSSODomain{}, status.Error(codes.Internal, "failed to generate domain verification token")
+	}
+
+	return models.OrganizationSSODomain{
+		Domain:            name,
+		VerificationToken: token,
+		CreatedAt:         time.Now(),
+	}, nil
+}
+
+// verifySSODomain marks the domain as verified if its DNS TXT record has the verification value,
+// and no other organization verified it already. Domains that cannot be verified yet are kept unverified.
+func verifySSODomain(ctx context.Context, organizationID string, domain *models.OrganizationSSODomain) error {
+	lookupCtx, cancel := context.WithTimeout(ctx, ssoDomainLookupTimeout)
+	defer cancel()
+
+	records, err := lookupTXT(lookupCtx, domain.VerificationRecord())
+	if err != nil {
+		log.Infof("SSO domain %s of organization %s not verified: %v", domain.Domain, organizationID, err)
+		return nil
+	}
+
+	if !slices.Contains(records, domain.VerificationValue()) {
+		return nil
+	}
+
+	verified, err := models.FindVerifiedSSODomain(domain.Domain)
+	if err == nil {
+		if verified.OrganizationID.String() != organizationID {
+			return status.Errorf(codes.FailedPrecondition, "domain %s is verified by another organization", domain.Domain)
+		}
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.Errorf("Error checking SSO domain %s: %v", domain.Domain, err)
+		return status.Errorf(codes.Internal, "failed to verify domain %s", domain.Domain)
+	}
+
+	now := time.Now()
+	domain.VerifiedAt = &now
+	return nil
+}
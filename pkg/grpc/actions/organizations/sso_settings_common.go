@@ -0,0 +1,74 @@
+package organizations
+
+import (
+	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func serializeSSOSettings(baseURL string, settings *models.OrganizationSSOSettings, domains []models.OrganizationSSODomain) *pb.SSOSettings {
+	organizationID := settings.OrganizationID.String()
+
+	oidc := &pb.SSOOIDCSettings{
+		ClientSecretConfigured: len(settings.OIDCClientSecretCiphertext) > 0,
+		RedirectUrl:            authentication.OIDCRedirectURL(baseURL, organizationID),
+	}
+
+	if settings.OIDCIssuerURL != nil {
+		oidc.IssuerUrl = *settings.OIDCIssuerURL
+	}
+	if settings.OIDCClientID != nil {
+		oidc.ClientId = *settings.OIDCClientID
+	}
+
+	metadataURL := authentication.SAMLMetadataURL(baseURL, organizationID)
+	saml := &pb.SSOSAMLSettings{
+		EntityId:    metadataURL,
+		AcsUrl:      authentication.SAMLACSURL(baseURL, organizationID),
+		MetadataUrl: metadataURL,
+	}
+
+	if settings.SAMLIdPMetadataURL != nil {
+		saml.IdpMetadataUrl = *settings.SAMLIdPMetadataURL
+	}
+	if settings.SAMLIdPMetadata != nil {
+		saml.IdpMetadata = *settings.SAMLIdPMetadata
+	}
+
+	serialized := &pb.SSOSettings{
+		OrganizationId:  organizationID,
+		Protocol:        settings.Protocol,
+		Enabled:         settings.Enabled,
+		Enforced:        settings.Enforced,
+		JitProvisioning: settings.JITProvisioning,
+		Oidc:            oidc,
+		Saml:            saml,
+		LoginUrl:        authentication.SSOLoginURL(baseURL, organizationID),
+		Domains:         []*pb.SSODomain{},
+	}
+
+	for _, domain := range domains {
+		serializedDomain := &pb.SSODomain{
+			Domain:             domain.Domain,
+			Verified:           domain.IsVerified(),
+			VerificationRecord: domain.VerificationRecord(),
+			VerificationValue:  domain.VerificationValue(),
+		}
+
+		if domain.VerifiedAt != nil {
+			serializedDomain.VerifiedAt = timestamppb.New(*domain.VerifiedAt)
+		}
+
+		serialized.Domains = append(serialized.Domains, serializedDomain)
+	}
+
+	if !settings.UpdatedAt.IsZero() {
+		serialized.UpdatedAt = timestamppb.New(settings.UpdatedAt)
+	}
+	if settings.UpdatedBy != nil {
+		serialized.UpdatedBy = settings.UpdatedBy.String()
+	}
+
+	return serialized
+}
@@ -0,0 +1,273 @@
+package organizations
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const testSSOBaseURL = "https://superplane.example.com"
+
+const testSAMLIdPMetadata = `<EntityDescriptor xmlns="urn:oasis:names:tc:SAML:2.0:metadata" entityID="https://idp.example.com/metadata">
+  <IDPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+    <SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="https://idp.example.com/sso"/>
+  </IDPSSODescriptor>
+</EntityDescriptor>`
+
+func newTestOIDCIssuer(t *testing.T) *httptest.Server {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/.well-known/openid-configuration" {
+			http.NotFound(w, r)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"issuer":                 server.URL,
+			"authorization_endpoint": server.URL + "/authorize",
+			"token_endpoint":         server.URL + "/token",
+			"jwks_uri":               server.URL + "/keys",
+		})
+	}))
+
+	t.Cleanup(server.Close)
+	return server
+}
+
+func Test__GetSSOSettings(t *testing.T) {
+	r := support.Setup(t)
+	orgID := r.Organization.ID.String()
+
+	resp, err := GetSSOSettings(testSSOBaseURL, orgID)
+	require.NoError(t, err)
+	require.NotNil(t, resp.SsoSettings)
+
+	assert.Equal(t, orgID, resp.SsoSettings.OrganizationId)
+	assert.Equal(t, models.SSOProtocolOIDC, resp.SsoSettings.Protocol)
+	assert.False(t, resp.SsoSettings.Enabled)
+	assert.False(t, resp.SsoSettings.Enforced)
+	assert.Equal(t, testSSOBaseURL+"/auth/sso/"+orgID, resp.SsoSettings.LoginUrl)
+	assert.Equal(t, testSSOBaseURL+"/auth/sso/"+orgID+"/oidc/callback", resp.SsoSettings.Oidc.RedirectUrl)
+	assert.Equal(t, testSSOBaseURL+"/auth/sso/"+orgID+"/saml/acs", resp.SsoSettings.Saml.AcsUrl)
+	assert.Equal(t, testSSOBaseURL+"/auth/sso/"+orgID+"/saml/metadata", resp.SsoSettings.Saml.EntityId)
+}
+
+func Test__UpdateSSOSettings(t *testing.T) {
+	r := support.Setup(t)
+	ctx := context.Background()
+	orgID := r.Organization.ID.String()
+
+	t.Run("invalid protocol returns error", func(t *testing.T) {
+		_, err := UpdateSSOSettings(ctx, r.Encryptor, testSSOBaseURL, orgID, r.User.String(), &pb.UpdateSSOSettingsRequest{
+			Protocol: "ldap",
+		})
+
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("enforcing without enabling returns error", func(t *testing.T) {
+		_, err := UpdateSSOSettings(ctx, r.Encryptor, testSSOBaseURL, orgID, r.User.String(), &pb.UpdateSSOSettingsRequest{
+			Protocol: models.SSOProtocolOIDC,
+			Enforced: true,
+		})
+
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("enabling OIDC without client secret returns error", func(t *testing.T) {
+		issuer := newTestOIDCIssuer(t)
+		_, err := UpdateSSOSettings(ctx, r.Encryptor, testSSOBaseURL, orgID, r.User.String(), &pb.UpdateSSOSettingsRequest{
+			Protocol: models.SSOProtocolOIDC,
+			Enabled:  true,
+			Oidc:     &pb.SSOOIDCSettings{IssuerUrl: issuer.URL, ClientId: "client-id"},
+		})
+
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("enabling OIDC with unreachable issuer returns error", func(t *testing.T) {
+		issuer := newTestOIDCIssuer(t)
+		_, err := UpdateSSOSettings(ctx, r.Encryptor, testSSOBaseURL, orgID, r.User.String(), &pb.UpdateSSOSettingsRequest{
+			Protocol: models.SSOProtocolOIDC,
+			Enabled:  true,
+			Oidc:     &pb.SSOOIDCSettings{IssuerUrl: issuer.URL + "/other", ClientId: "client-id", ClientSecret: "secret"},
+		})
+
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("enabling OIDC stores encrypted client secret", func(t *testing.T) {
+		issuer := newTestOIDCIssuer(t)
+		resp, err := UpdateSSOSettings(ctx, r.Encryptor, testSSOBaseURL, orgID, r.User.String(), &pb.UpdateSSOSettingsRequest{
+			Protocol:        models.SSOProtocolOIDC,
+			Enabled:         true,
+			Enforced:        true,
+			JitProvisioning: true,
+			Oidc:            &pb.SSOOIDCSettings{IssuerUrl: issuer.URL, ClientId: "client-id", ClientSecret: "secret"},
+		})
+
+		require.NoError(t, err)
+		assert.True(t, resp.SsoSettings.Enabled)
+		assert.True(t, resp.SsoSettings.Enforced)
+		assert.True(t, resp.SsoSettings.JitProvisioning)
+		assert.Equal(t, issuer.URL, resp.SsoSettings.Oidc.IssuerUrl)
+		assert.Equal(t, "client-id", resp.SsoSettings.Oidc.ClientId)
+		assert.True(t, resp.SsoSettings.Oidc.ClientSecretConfigured)
+		assert.Empty(t, resp.SsoSettings.Oidc.ClientSecret)
+		assert.Equal(t, r.User.String(), resp.SsoSettings.UpdatedBy)
+
+		settings, err := models.FindOrganizationSSOSettings(orgID)
+		require.NoError(t, err)
+
+		secret, err := r.Encryptor.Decrypt(ctx, settings.OIDCClientSecretCiphertext, []byte(orgID))
+		require.NoError(t, err)
+		assert.Equal(t, "secret", string(secret))
+
+		//
+		// Updating without a client secret keeps the current one.
+		//
+		resp, err = UpdateSSOSettings(ctx, r.Encryptor, testSSOBaseURL, orgID, r.User.String(), &pb.UpdateSSOSettingsRequest{
+			Protocol: models.SSOProtocolOIDC,
+			Enabled:  true,
+			Oidc:     &pb.SSOOIDCSettings{IssuerUrl: issuer.URL, ClientId: "client-id"},
+		})
+
+		require.NoError(t, err)
+		assert.False(t, resp.SsoSettings.Enforced)
+		assert.True(t, resp.SsoSettings.Oidc.ClientSecretConfigured)
+	})
+
+	t.Run("enabling SAML with invalid metadata returns error", func(t *testing.T) {
+		_, err := UpdateSSOSettings(ctx, r.Encryptor, testSSOBaseURL, orgID, r.User.String(), &pb.UpdateSSOSettingsRequest{
+			Protocol: models.SSOProtocolSAML,
+			Enabled:  true,
+			Saml:     &pb.SSOSAMLSettings{IdpMetadata: "<not-metadata/>"},
+		})
+
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("enabling SAML fetches metadata from URL", func(t *testing.T) {
+		metadataServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/xml")
+			_, _ = w.Write([]byte(testSAMLIdPMetadata))
+		}))
+		defer metadataServer.Close()
+
+		resp, err := UpdateSSOSettings(ctx, r.Encryptor, testSSOBaseURL, orgID, r.User.String(), &pb.UpdateSSOSettingsRequest{
+			Protocol: models.SSOProtocolSAML,
+			Enabled:  true,
+			Saml:     &pb.SSOSAMLSettings{IdpMetadataUrl: metadataServer.URL},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, models.SSOProtocolSAML, resp.SsoSettings.Protocol)
+		assert.Equal(t, metadataServer.URL, resp.SsoSettings.Saml.IdpMetadataUrl)
+		assert.Equal(t, testSAMLIdPMetadata, resp.SsoSettings.Saml.IdpMetadata)
+	})
+}
+
+func Test__UpdateSSOSettings_Domains(t *testing.T) {
+	r := support.Setup(t)
+	ctx := context.Background()
+	orgID := r.Organization.ID.String()
+
+	records := map[string][]string{}
+	originalLookupTXT := lookupTXT
+	lookupTXT = func(ctx context.Context, name string) ([]string, error) {
+		values, ok := records[name]
+		if !ok {
+			return nil, &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+		}
+
+		return values, nil
+	}
+
+	t.Cleanup(func() { lookupTXT = originalLookupTXT })
+
+	t.Run("invalid domain returns error", func(t *testing.T) {
+		_, err := UpdateSSOSettings(ctx, r.Encryptor, testSSOBaseURL, orgID, r.User.String(), &pb.UpdateSSOSettingsRequest{
+			Domains: []string{"not a domain"},
+		})
+
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	var verificationValue string
+	t.Run("new domains are not verified without the DNS record", func(t *testing.T) {
+		resp, err := UpdateSSOSettings(ctx, r.Encryptor, testSSOBaseURL, orgID, r.User.String(), &pb.UpdateSSOSettingsRequest{
+			Domains: []string{"Example.com."},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, resp.SsoSettings.Domains, 1)
+		assert.Equal(t, "example.com", resp.SsoSettings.Domains[0].Domain)
+		assert.False(t, resp.SsoSettings.Domains[0].Verified)
+		assert.Equal(t, "_superplane-verification.example.com", resp.SsoSettings.Domains[0].VerificationRecord)
+		assert.NotEmpty(t, resp.SsoSettings.Domains[0].VerificationValue)
+		verificationValue = resp.SsoSettings.Domains[0].VerificationValue
+	})
+
+	t.Run("domains are verified with the DNS record", func(t *testing.T) {
+		records["_superplane-verification.example.com"] = []string{"other", verificationValue}
+
+		resp, err := UpdateSSOSettings(ctx, r.Encryptor, testSSOBaseURL, orgID, r.User.String(), &pb.UpdateSSOSettingsRequest{
+			Domains: []string{"example.com"},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, resp.SsoSettings.Domains, 1)
+		assert.True(t, resp.SsoSettings.Domains[0].Verified)
+		assert.Equal(t, verificationValue, resp.SsoSettings.Domains[0].VerificationValue)
+
+		verified, err := models.IsOrganizationSSODomainVerified(orgID, "example.com")
+		require.NoError(t, err)
+		assert.True(t, verified)
+	})
+
+	t.Run("domains verified by another organization cannot be verified", func(t *testing.T) {
+		other := support.CreateOrganization(t, r, r.User)
+		resp, err := UpdateSSOSettings(ctx, r.Encryptor, testSSOBaseURL, other.ID.String(), "", &pb.UpdateSSOSettingsRequest{
+			Domains: []string{"example.com"},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, resp.SsoSettings.Domains, 1)
+		records["_superplane-verification.example.com"] = []string{resp.SsoSettings.Domains[0].VerificationValue}
+
+		_, err = UpdateSSOSettings(ctx, r.Encryptor, testSSOBaseURL, other.ID.String(), "", &pb.UpdateSSOSettingsRequest{
+			Domains: []string{"example.com"},
+		})
+
+		require.Error(t, err)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("removed domains are deleted", func(t *testing.T) {
+		resp, err := UpdateSSOSettings(ctx, r.Encryptor, testSSOBaseURL, orgID, r.User.String(), &pb.UpdateSSOSettingsRequest{})
+		require.NoError(t, err)
+		assert.Empty(t, resp.SsoSettings.Domains)
+
+		verified, err := models.IsOrganizationSSODomainVerified(orgID, "example.com")
+		require.NoError(t, err)
+		assert.False(t, verified)
+	})
+}
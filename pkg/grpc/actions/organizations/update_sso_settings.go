@@ -0,0 +1,268 @@
+package organizations
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/crewjam/saml"
+	"github.com/crewjam/saml/samlsp"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+const maxSAMLMetadataSize = 1024 * 1024
+
+func UpdateSSOSettings(
+	ctx context.Context,
+	encryptor crypto.Encryptor,
+	baseURL string,
+	orgID string,
+	requesterUserID string,
+	req *pb.UpdateSSOSettingsRequest,
+) (*pb.UpdateSSOSettingsResponse, error) {
+	protocol := req.Protocol
+	if protocol == "" {
+		protocol = models.SSOProtocolOIDC
+	}
+
+	if protocol != models.SSOProtocolOIDC && protocol != models.SSOProtocolSAML {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid protocol %s: must be %s or %s", protocol, models.SSOProtocolOIDC, models.SSOProtocolSAML)
+	}
+
+	if req.Enforced && !req.Enabled {
+		return nil, status.Error(codes.InvalidArgument, "SSO must be enabled to be enforced")
+	}
+
+	updatedBy, err := optionalUUID(requesterUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := findOrganizationSSOSettings(orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	settings.Protocol = protocol
+	settings.Enabled = req.Enabled
+	settings.Enforced = req.Enforced
+	settings.JITProvisioning = req.JitProvisioning
+
+	err = updateOIDCSettings(ctx, encryptor, settings, req.Oidc, req.Enabled && protocol == models.SSOProtocolOIDC)
+	if err != nil {
+		return nil, err
+	}
+
+	err = updateSAMLSettings(ctx, settings, req.Saml, req.Enabled && protocol == models.SSOProtocolSAML)
+	if err != nil {
+		return nil, err
+	}
+
+	domains, err := updateSSODomains(ctx, orgID, req.Domains)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if settings.CreatedAt.IsZero() {
+		settings.CreatedAt = now
+	}
+
+	settings.UpdatedBy = updatedBy
+	settings.UpdatedAt = now
+
+	err = database.Conn().Transaction(func(tx *gorm.DB) error {
+		err := models.UpsertOrganizationSSOSettingsInTransaction(tx, settings)
+		if err != nil {
+			return err
+		}
+
+		return models.ReplaceOrganizationSSODomainsInTransaction(tx, settings.OrganizationID, domains)
+	})
+
+	if err != nil {
+		log.Errorf("Error updating SSO settings for organization %s: %v", orgID, err)
+		return nil, status.Error(codes.Internal, "failed to update SSO settings")
+	}
+
+	return &pb.UpdateSSOSettingsResponse{
+		SsoSettings: serializeSSOSettings(baseURL, settings, domains),
+	}, nil
+}
+
+// updateOIDCSettings updates the OIDC issuer of the organization.
+// An empty client secret keeps the current one, and if the settings are in use,
+// the issuer must be reachable, so misconfigurations are found before members try to log in.
+func updateOIDCSettings(ctx context.Context, encryptor crypto.Encryptor, settings *models.OrganizationSSOSettings, oidcSettings *pb.SSOOIDCSettings, validate bool) error {
+	if oidcSettings == nil {
+		oidcSettings = &pb.SSOOIDCSettings{}
+	}
+
+	issuerURL := strings.TrimRight(strings.TrimSpace(oidcSettings.IssuerUrl), "/")
+	clientID := strings.TrimSpace(oidcSettings.ClientId)
+	clientSecret := strings.TrimSpace(oidcSettings.ClientSecret)
+
+	settings.OIDCIssuerURL = optionalString(issuerURL)
+	settings.OIDCClientID = optionalString(clientID)
+
+	if clientSecret != "" {
+		ciphertext, err := encryptor.Encrypt(ctx, []byte(clientSecret), []byte(settings.OrganizationID.String()))
+		if err != nil {
+			return status.Error(codes.Internal, "failed to encrypt client secret")
+		}
+
+		settings.OIDCClientSecretCiphertext = ciphertext
+	}
+
+	if !validate {
+		return nil
+	}
+
+	if issuerURL == "" {
+		return status.Error(codes.InvalidArgument, "OIDC issuer URL is required")
+	}
+
+	if err := validateHTTPURL(issuerURL); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid OIDC issuer URL: %v", err)
+	}
+
+	if clientID == "" {
+		return status.Error(codes.InvalidArgument, "OIDC client ID is required")
+	}
+
+	if len(settings.OIDCClientSecretCiphertext) == 0 {
+		return status.Error(codes.InvalidArgument, "OIDC client secret is required")
+	}
+
+	discoveryCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	_, err := oidc.NewProvider(discoveryCtx, issuerURL)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "failed to discover OIDC issuer %s: %v", issuerURL, err)
+	}
+
+	return nil
+}
+
+// updateSAMLSettings updates the SAML identity provider of the organization.
+// Metadata given by URL is fetched and stored when the settings are updated,
+// so logins do not depend on the metadata URL being available.
+func updateSAMLSettings(ctx context.Context, settings *models.OrganizationSSOSettings, samlSettings *pb.SSOSAMLSettings, validate bool) error {
+	if samlSettings == nil {
+		samlSettings = &pb.SSOSAMLSettings{}
+	}
+
+	metadataURL := strings.TrimSpace(samlSettings.IdpMetadataUrl)
+	metadata := strings.TrimSpace(samlSettings.IdpMetadata)
+
+	settings.SAMLIdPMetadataURL = optionalString(metadataURL)
+	if !validate {
+		settings.SAMLIdPMetadata = optionalString(metadata)
+		return nil
+	}
+
+	if metadataURL != "" {
+		if err := validateHTTPURL(metadataURL); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid SAML metadata URL: %v", err)
+		}
+
+		fetched, err := fetchSAMLMetadata(ctx, metadataURL)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "failed to fetch SAML metadata from %s: %v", metadataURL, err)
+		}
+
+		metadata = fetched
+	}
+
+	if metadata == "" {
+		return status.Error(codes.InvalidArgument, "SAML identity provider metadata or metadata URL is required")
+	}
+
+	err := validateSAMLMetadata([]byte(metadata))
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid SAML metadata: %v", err)
+	}
+
+	settings.SAMLIdPMetadata = &metadata
+	return nil
+}
+
+func validateSAMLMetadata(metadata []byte) error {
+	descriptor, err := samlsp.ParseMetadata(metadata)
+	if err != nil {
+		return err
+	}
+
+	sp := saml.ServiceProvider{IDPMetadata: descriptor}
+	if sp.GetSSOBindingLocation(saml.HTTPRedirectBinding) == "" {
+		return fmt.Errorf("identity provider has no single sign-on service with the HTTP-Redirect binding")
+	}
+
+	return nil
+}
+
+func fetchSAMLMetadata(ctx context.Context, metadataURL string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, metadataURL, nil)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("status %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxSAMLMetadataSize))
+	if err != nil {
+		return "", err
+	}
+
+	return string(body), nil
+}
+
+func validateHTTPURL(raw string) error {
+	parsed, err := url.Parse(raw)
+	if err != nil {
+		return err
+	}
+
+	if parsed.Scheme != "https" && parsed.Scheme != "http" {
+		return fmt.Errorf("scheme must be http or https")
+	}
+
+	if parsed.Host == "" {
+		return fmt.Errorf("host is required")
+	}
+
+	return nil
+}
+
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+
+	return &value
+}
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/google/uuid"
 	log "github.com/sirupsen/logrus"
@@ -40,12 +42,25 @@ func CreateSecret(ctx context.Context, encryptor crypto.Encryptor, domainType st
 		return nil, status.Error(codes.InvalidArgument, "invalid provider")
 	}
 
-	data, err := prepareSecretData(ctx, encryptor, spec)
+	data, err := prepareSecretData(ctx, encryptor, domainType, domainID, spec)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	secret, err := models.CreateSecret(spec.Metadata.Name, provider, userID, domainType, uuid.MustParse(domainID), data)
+	allowedCanvasIDs, err := validateAllowedCanvasIDs(domainType, domainID, spec.Spec.AllowedCanvasIds)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	secret, err := models.CreateSecretWithAllowedCanvases(
+		spec.Metadata.Name,
+		provider,
+		userID,
+		domainType,
+		uuid.MustParse(domainID),
+		data,
+		allowedCanvasIDs,
+	)
 	if err != nil {
 		if errors.Is(err, models.ErrNameAlreadyUsed) {
 			return nil, status.Error(codes.InvalidArgument, err.Error())
@@ -67,6 +82,12 @@ func protoToSecretProvider(provider pb.Secret_Provider) string {
 	switch provider {
 	case pb.Secret_PROVIDER_LOCAL:
 		return secrets.ProviderLocal
+	case pb.Secret_PROVIDER_VAULT:
+		return secrets.ProviderVault
+	case pb.Secret_PROVIDER_FILE:
+		return secrets.ProviderFile
+	case pb.Secret_PROVIDER_ENV:
+		return secrets.ProviderEnv
 	default:
 		return ""
 	}
@@ -76,12 +97,18 @@ func secretProviderToProto(provider string) pb.Secret_Provider {
 	switch provider {
 	case secrets.ProviderLocal:
 		return pb.Secret_PROVIDER_LOCAL
+	case secrets.ProviderVault:
+		return pb.Secret_PROVIDER_VAULT
+	case secrets.ProviderFile:
+		return pb.Secret_PROVIDER_FILE
+	case secrets.ProviderEnv:
+		return pb.Secret_PROVIDER_ENV
 	default:
 		return pb.Secret_PROVIDER_UNKNOWN
 	}
 }
 
-func prepareSecretData(ctx context.Context, encryptor crypto.Encryptor, secret *pb.Secret) ([]byte, error) {
+func prepareSecretData(ctx context.Context, encryptor crypto.Encryptor, domainType, domainID string, secret *pb.Secret) ([]byte, error) {
 	if secret.Spec == nil {
 		return nil, fmt.Errorf("missing secret spec")
 	}
@@ -103,11 +130,189 @@ func prepareSecretData(ctx context.Context, encryptor crypto.Encryptor, secret *
 
 		return encrypted, nil
 
+	case pb.Secret_PROVIDER_VAULT:
+		if secret.Spec.Vault == nil {
+			return nil, fmt.Errorf("missing vault reference")
+		}
+
+		reference := secrets.VaultReference{
+			Mount: strings.Trim(secret.Spec.Vault.Mount, "/"),
+			Path:  strings.Trim(secret.Spec.Vault.Path, "/"),
+		}
+
+		if err := reference.Validate(); err != nil {
+			return nil, err
+		}
+
+		if err := checkVaultReference(domainType, domainID, reference); err != nil {
+			return nil, err
+		}
+
+		//
+		// Only the location of the secret in Vault is stored,
+		// so it is not encrypted.
+		//
+		return json.Marshal(reference)
+
+	case pb.Secret_PROVIDER_FILE:
+		if secret.Spec.File == nil {
+			return nil, fmt.Errorf("missing file reference")
+		}
+
+		reference := secrets.FileReference{
+			Path: strings.Trim(secret.Spec.File.Path, "/"),
+		}
+
+		if err := reference.Validate(); err != nil {
+			return nil, err
+		}
+
+		if err := checkFileReference(domainType, domainID, reference); err != nil {
+			return nil, err
+		}
+
+		return json.Marshal(reference)
+
+	case pb.Secret_PROVIDER_ENV:
+		if secret.Spec.Env == nil {
+			return nil, fmt.Errorf("missing env reference")
+		}
+
+		reference := secrets.EnvReference{
+			Variables: secret.Spec.Env.Variables,
+		}
+
+		if err := reference.Validate(); err != nil {
+			return nil, err
+		}
+
+		if err := checkEnvReference(domainType, domainID, reference); err != nil {
+			return nil, err
+		}
+
+		return json.Marshal(reference)
+
 	default:
 		return nil, fmt.Errorf("provider not supported")
 	}
 }
 
+// checkVaultReference checks that the organization of the secret
+// can read the referenced Vault secret.
+func checkVaultReference(domainType, domainID string, reference secrets.VaultReference) error {
+	policy, err := secrets.NewVaultPolicyFromEnv()
+	if err != nil {
+		return err
+	}
+
+	organizationID, err := secretOrganizationID(domainType, domainID)
+	if err != nil {
+		return err
+	}
+
+	return policy.Check(organizationID, reference)
+}
+
+// checkFileReference checks that the organization of the secret
+// can read the referenced directory.
+func checkFileReference(domainType, domainID string, reference secrets.FileReference) error {
+	backend, err := secrets.NewFileBackendFromEnv()
+	if err != nil {
+		return err
+	}
+
+	if backend == nil {
+		return fmt.Errorf("file secrets are not enabled")
+	}
+
+	organizationID, err := secretOrganizationID(domainType, domainID)
+	if err != nil {
+		return err
+	}
+
+	return backend.Check(organizationID, reference)
+}
+
+// checkEnvReference checks that the organization of the secret
+// can read the referenced environment variables.
+func checkEnvReference(domainType, domainID string, reference secrets.EnvReference) error {
+	backend := secrets.NewEnvBackendFromEnv()
+	if backend == nil {
+		return fmt.Errorf("env secrets are not enabled")
+	}
+
+	organizationID, err := secretOrganizationID(domainType, domainID)
+	if err != nil {
+		return err
+	}
+
+	return backend.Check(organizationID, reference)
+}
+
+// secretOrganizationID returns the organization a secret
+// of the domain belongs to, directly or through its canvas.
+func secretOrganizationID(domainType, domainID string) (string, error) {
+	if domainType != models.DomainTypeCanvas {
+		return domainID, nil
+	}
+
+	canvas, err := models.FindCanvasWithoutOrgScope(uuid.MustParse(domainID))
+	if err != nil {
+		return "", fmt.Errorf("canvas not found")
+	}
+
+	return canvas.OrganizationID.String(), nil
+}
+
+// validateAllowedCanvasIDs checks that the canvases an organization secret
+// is shared with belong to the organization, and removes duplicates.
+func validateAllowedCanvasIDs(domainType, domainID string, canvasIDs []string) ([]string, error) {
+	allowed := []string{}
+	if len(canvasIDs) == 0 {
+		return allowed, nil
+	}
+
+	if domainType != models.DomainTypeOrganization {
+		return nil, fmt.Errorf("only organization secrets can be shared with canvases")
+	}
+
+	organizationID := uuid.MustParse(domainID)
+	for _, value := range canvasIDs {
+		canvasID, err := uuid.Parse(strings.TrimSpace(value))
+		if err != nil {
+			return nil, fmt.Errorf("invalid canvas id %s", value)
+		}
+
+		if slices.Contains(allowed, canvasID.String()) {
+			continue
+		}
+
+		_, err = models.FindCanvas(organizationID, canvasID)
+		if err != nil {
+			return nil, fmt.Errorf("canvas %s not found", canvasID)
+		}
+
+		allowed = append(allowed, canvasID.String())
+	}
+
+	return allowed, nil
+}
+
+// requester returns the ID of the user making the request, if there is one.
+func requester(ctx context.Context) *uuid.UUID {
+	userID, ok := authentication.GetUserIdFromMetadata(ctx)
+	if !ok {
+		return nil
+	}
+
+	parsed, err := uuid.Parse(userID)
+	if err != nil {
+		return nil
+	}
+
+	return &parsed
+}
+
 // decryptSecretData decrypts a secret's stored data and returns the key-value map.
 func decryptSecretData(ctx context.Context, encryptor crypto.Encryptor, secret models.Secret) (map[string]string, error) {
 	data, err := encryptor.Decrypt(ctx, secret.Data, []byte(secret.Name))
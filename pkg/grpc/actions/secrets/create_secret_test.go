@@ -2,6 +2,7 @@ package secrets
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/google/uuid"
@@ -73,6 +74,276 @@ func Test__CreateSecret(t *testing.T) {
 		require.Equal(t, map[string]string{"test": "***"}, response.Secret.Spec.Local.Data)
 	})
 
+	t.Run("vault secret is created", func(t *testing.T) {
+		secret := &protos.Secret{
+			Metadata: &protos.Secret_Metadata{
+				Name: support.RandomName("secret"),
+			},
+			Spec: &protos.Secret_Spec{
+				Provider: protos.Secret_PROVIDER_VAULT,
+				Vault: &protos.Secret_Vault{
+					Mount: "/secret/",
+					Path:  "superplane/" + r.Organization.ID.String() + "/github",
+				},
+			},
+		}
+
+		response, err := CreateSecret(ctx, encryptor, models.DomainTypeOrganization, r.Organization.ID.String(), secret)
+		require.NoError(t, err)
+		assert.Equal(t, protos.Secret_PROVIDER_VAULT, response.Secret.Spec.Provider)
+		require.NotNil(t, response.Secret.Spec.Vault)
+		assert.Equal(t, "secret", response.Secret.Spec.Vault.Mount)
+		assert.Equal(t, "superplane/"+r.Organization.ID.String()+"/github", response.Secret.Spec.Vault.Path)
+		assert.Nil(t, response.Secret.Spec.Local)
+	})
+
+	t.Run("vault secret outside of the organization prefix -> error", func(t *testing.T) {
+		for _, reference := range []*protos.Secret_Vault{
+			{Mount: "secret", Path: "superplane/" + uuid.NewString() + "/github"},
+			{Mount: "secret", Path: "superplane/" + r.Organization.ID.String() + "/../other/github"},
+			{Mount: "other", Path: "superplane/" + r.Organization.ID.String() + "/github"},
+		} {
+			secret := &protos.Secret{
+				Metadata: &protos.Secret_Metadata{
+					Name: support.RandomName("secret"),
+				},
+				Spec: &protos.Secret_Spec{
+					Provider: protos.Secret_PROVIDER_VAULT,
+					Vault:    reference,
+				},
+			}
+
+			_, err := CreateSecret(ctx, encryptor, models.DomainTypeOrganization, r.Organization.ID.String(), secret)
+			s, ok := status.FromError(err)
+			assert.True(t, ok)
+			assert.Equal(t, codes.InvalidArgument, s.Code(), reference.String())
+		}
+	})
+
+	t.Run("vault secret without path -> error", func(t *testing.T) {
+		secret := &protos.Secret{
+			Metadata: &protos.Secret_Metadata{
+				Name: support.RandomName("secret"),
+			},
+			Spec: &protos.Secret_Spec{
+				Provider: protos.Secret_PROVIDER_VAULT,
+				Vault:    &protos.Secret_Vault{Mount: "secret"},
+			},
+		}
+
+		_, err := CreateSecret(ctx, encryptor, models.DomainTypeOrganization, r.Organization.ID.String(), secret)
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+		assert.Equal(t, "vault path is required", s.Message())
+	})
+
+	t.Run("env secret is created", func(t *testing.T) {
+		t.Setenv("SECRETS_ENV_PREFIX", "SUPERPLANE_SECRET_")
+		variable := "SUPERPLANE_SECRET_" + strings.ToUpper(strings.ReplaceAll(r.Organization.ID.String(), "-", "_")) + "_GITHUB_TOKEN"
+		secret := &protos.Secret{
+			Metadata: &protos.Secret_Metadata{
+				Name: support.RandomName("secret"),
+			},
+			Spec: &protos.Secret_Spec{
+				Provider: protos.Secret_PROVIDER_ENV,
+				Env: &protos.Secret_Env{
+					Variables: map[string]string{"token": variable},
+				},
+			},
+		}
+
+		response, err := CreateSecret(ctx, encryptor, models.DomainTypeOrganization, r.Organization.ID.String(), secret)
+		require.NoError(t, err)
+		assert.Equal(t, protos.Secret_PROVIDER_ENV, response.Secret.Spec.Provider)
+		require.NotNil(t, response.Secret.Spec.Env)
+		assert.Equal(t, map[string]string{"token": variable}, response.Secret.Spec.Env.Variables)
+	})
+
+	t.Run("env secret outside of the organization prefix -> error", func(t *testing.T) {
+		t.Setenv("SECRETS_ENV_PREFIX", "SUPERPLANE_SECRET_")
+		otherOrganization := strings.ToUpper(strings.ReplaceAll(uuid.NewString(), "-", "_"))
+		for _, variable := range []string{"SUPERPLANE_SECRET_GITHUB_TOKEN", "SUPERPLANE_SECRET_" + otherOrganization + "_GITHUB_TOKEN"} {
+			secret := &protos.Secret{
+				Metadata: &protos.Secret_Metadata{
+					Name: support.RandomName("secret"),
+				},
+				Spec: &protos.Secret_Spec{
+					Provider: protos.Secret_PROVIDER_ENV,
+					Env: &protos.Secret_Env{
+						Variables: map[string]string{"token": variable},
+					},
+				},
+			}
+
+			_, err := CreateSecret(ctx, encryptor, models.DomainTypeOrganization, r.Organization.ID.String(), secret)
+			s, ok := status.FromError(err)
+			assert.True(t, ok)
+			assert.Equal(t, codes.InvalidArgument, s.Code(), variable)
+		}
+	})
+
+	t.Run("env secret without env provider -> error", func(t *testing.T) {
+		t.Setenv("SECRETS_ENV_PREFIX", "")
+		secret := &protos.Secret{
+			Metadata: &protos.Secret_Metadata{
+				Name: support.RandomName("secret"),
+			},
+			Spec: &protos.Secret_Spec{
+				Provider: protos.Secret_PROVIDER_ENV,
+				Env: &protos.Secret_Env{
+					Variables: map[string]string{"token": "SUPERPLANE_SECRET_GITHUB_TOKEN"},
+				},
+			},
+		}
+
+		_, err := CreateSecret(ctx, encryptor, models.DomainTypeOrganization, r.Organization.ID.String(), secret)
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+		assert.Equal(t, "env secrets are not enabled", s.Message())
+	})
+
+	t.Run("file secret is created", func(t *testing.T) {
+		t.Setenv("SECRETS_FILE_ROOT", t.TempDir())
+		secret := &protos.Secret{
+			Metadata: &protos.Secret_Metadata{
+				Name: support.RandomName("secret"),
+			},
+			Spec: &protos.Secret_Spec{
+				Provider: protos.Secret_PROVIDER_FILE,
+				File:     &protos.Secret_File{Path: r.Organization.ID.String() + "/github"},
+			},
+		}
+
+		response, err := CreateSecret(ctx, encryptor, models.DomainTypeOrganization, r.Organization.ID.String(), secret)
+		require.NoError(t, err)
+		require.NotNil(t, response.Secret.Spec.File)
+		assert.Equal(t, r.Organization.ID.String()+"/github", response.Secret.Spec.File.Path)
+	})
+
+	t.Run("file secret outside of the organization directory -> error", func(t *testing.T) {
+		t.Setenv("SECRETS_FILE_ROOT", t.TempDir())
+		for _, path := range []string{"github", uuid.NewString() + "/github", r.Organization.ID.String()} {
+			secret := &protos.Secret{
+				Metadata: &protos.Secret_Metadata{
+					Name: support.RandomName("secret"),
+				},
+				Spec: &protos.Secret_Spec{
+					Provider: protos.Secret_PROVIDER_FILE,
+					File:     &protos.Secret_File{Path: path},
+				},
+			}
+
+			_, err := CreateSecret(ctx, encryptor, models.DomainTypeOrganization, r.Organization.ID.String(), secret)
+			s, ok := status.FromError(err)
+			assert.True(t, ok)
+			assert.Equal(t, codes.InvalidArgument, s.Code(), path)
+		}
+	})
+
+	t.Run("file secret outside of the secrets directory -> error", func(t *testing.T) {
+		secret := &protos.Secret{
+			Metadata: &protos.Secret_Metadata{
+				Name: support.RandomName("secret"),
+			},
+			Spec: &protos.Secret_Spec{
+				Provider: protos.Secret_PROVIDER_FILE,
+				File:     &protos.Secret_File{Path: "../etc"},
+			},
+		}
+
+		_, err := CreateSecret(ctx, encryptor, models.DomainTypeOrganization, r.Organization.ID.String(), secret)
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+		assert.Equal(t, "file path must be relative to the secrets directory", s.Message())
+	})
+
+	t.Run("organization secret shared with canvases", func(t *testing.T) {
+		canvas, _ := support.CreateCanvas(t, r.Organization.ID, r.User, []models.CanvasNode{}, []models.Edge{})
+		secret := &protos.Secret{
+			Metadata: &protos.Secret_Metadata{
+				Name: support.RandomName("secret"),
+			},
+			Spec: &protos.Secret_Spec{
+				Provider: protos.Secret_PROVIDER_LOCAL,
+				Local: &protos.Secret_Local{
+					Data: map[string]string{"test": "test"},
+				},
+				AllowedCanvasIds: []string{canvas.ID.String(), canvas.ID.String()},
+			},
+		}
+
+		response, err := CreateSecret(ctx, encryptor, models.DomainTypeOrganization, r.Organization.ID.String(), secret)
+		require.NoError(t, err)
+		assert.Equal(t, []string{canvas.ID.String()}, response.Secret.Spec.AllowedCanvasIds)
+	})
+
+	t.Run("unknown canvas in allowed canvases -> error", func(t *testing.T) {
+		canvasID := uuid.NewString()
+		secret := &protos.Secret{
+			Metadata: &protos.Secret_Metadata{
+				Name: support.RandomName("secret"),
+			},
+			Spec: &protos.Secret_Spec{
+				Provider: protos.Secret_PROVIDER_LOCAL,
+				Local: &protos.Secret_Local{
+					Data: map[string]string{"test": "test"},
+				},
+				AllowedCanvasIds: []string{canvasID},
+			},
+		}
+
+		_, err := CreateSecret(ctx, encryptor, models.DomainTypeOrganization, r.Organization.ID.String(), secret)
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+		assert.Equal(t, "canvas "+canvasID+" not found", s.Message())
+	})
+
+	t.Run("canvas secret is created", func(t *testing.T) {
+		canvas, _ := support.CreateCanvas(t, r.Organization.ID, r.User, []models.CanvasNode{}, []models.Edge{})
+		secret := &protos.Secret{
+			Metadata: &protos.Secret_Metadata{
+				Name: support.RandomName("secret"),
+			},
+			Spec: &protos.Secret_Spec{
+				Provider: protos.Secret_PROVIDER_LOCAL,
+				Local: &protos.Secret_Local{
+					Data: map[string]string{"test": "test"},
+				},
+			},
+		}
+
+		response, err := CreateSecret(ctx, encryptor, models.DomainTypeCanvas, canvas.ID.String(), secret)
+		require.NoError(t, err)
+		assert.Equal(t, authpb.DomainType_DOMAIN_TYPE_CANVAS, response.Secret.Metadata.DomainType)
+		assert.Equal(t, canvas.ID.String(), response.Secret.Metadata.DomainId)
+	})
+
+	t.Run("canvas secret shared with canvases -> error", func(t *testing.T) {
+		canvas, _ := support.CreateCanvas(t, r.Organization.ID, r.User, []models.CanvasNode{}, []models.Edge{})
+		secret := &protos.Secret{
+			Metadata: &protos.Secret_Metadata{
+				Name: support.RandomName("secret"),
+			},
+			Spec: &protos.Secret_Spec{
+				Provider: protos.Secret_PROVIDER_LOCAL,
+				Local: &protos.Secret_Local{
+					Data: map[string]string{"test": "test"},
+				},
+				AllowedCanvasIds: []string{canvas.ID.String()},
+			},
+		}
+
+		_, err := CreateSecret(ctx, encryptor, models.DomainTypeCanvas, canvas.ID.String(), secret)
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+		assert.Equal(t, "only organization secrets can be shared with canvases", s.Message())
+	})
+
 	t.Run("name already used", func(t *testing.T) {
 		name := support.RandomName("secret")
 		ctx := authentication.SetUserIdInMetadata(context.Background(), uuid.NewString())
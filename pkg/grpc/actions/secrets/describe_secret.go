@@ -9,6 +9,7 @@ import (
 	"github.com/superplanehq/superplane/pkg/grpc/actions"
 	"github.com/superplanehq/superplane/pkg/models"
 	pb "github.com/superplanehq/superplane/pkg/protos/secrets"
+	"github.com/superplanehq/superplane/pkg/secrets"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -40,14 +41,16 @@ func DescribeSecret(ctx context.Context, encryptor crypto.Encryptor, domainType,
 func serializeSecret(ctx context.Context, encryptor crypto.Encryptor, secret models.Secret) (*pb.Secret, error) {
 	s := &pb.Secret{
 		Metadata: &pb.Secret_Metadata{
-			Id:         secret.ID.String(),
-			Name:       secret.Name,
-			DomainType: actions.DomainTypeToProto(secret.DomainType),
-			DomainId:   secret.DomainID.String(),
-			CreatedAt:  timestamppb.New(*secret.CreatedAt),
+			Id:            secret.ID.String(),
+			Name:          secret.Name,
+			DomainType:    actions.DomainTypeToProto(secret.DomainType),
+			DomainId:      secret.DomainID.String(),
+			CreatedAt:     timestamppb.New(*secret.CreatedAt),
+			ActiveVersion: int32(secret.ActiveVersion),
 		},
 		Spec: &pb.Secret_Spec{
-			Provider: secretProviderToProto(secret.Provider),
+			Provider:         secretProviderToProto(secret.Provider),
+			AllowedCanvasIds: secret.AllowedCanvasIDs,
 		},
 	}
 
@@ -61,6 +64,37 @@ func serializeSecret(ctx context.Context, encryptor crypto.Encryptor, secret mod
 		s.Spec.Local = local
 		return s, nil
 
+	case pb.Secret_PROVIDER_VAULT:
+		reference, err := secrets.ParseVaultReference(secret.Data)
+		if err != nil {
+			return nil, err
+		}
+
+		s.Spec.Vault = &pb.Secret_Vault{
+			Mount: reference.Mount,
+			Path:  reference.Path,
+		}
+
+		return s, nil
+
+	case pb.Secret_PROVIDER_FILE:
+		reference, err := secrets.ParseFileReference(secret.Data)
+		if err != nil {
+			return nil, err
+		}
+
+		s.Spec.File = &pb.Secret_File{Path: reference.Path}
+		return s, nil
+
+	case pb.Secret_PROVIDER_ENV:
+		reference, err := secrets.ParseEnvReference(secret.Data)
+		if err != nil {
+			return nil, err
+		}
+
+		s.Spec.Env = &pb.Secret_Env{Variables: reference.Variables}
+		return s, nil
+
 	default:
 		return s, nil
 	}
@@ -0,0 +1,61 @@
+package secrets
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/grpc/actions"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/secrets"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ListSecretVersions(ctx context.Context, domainType, domainID, idOrName string) (*pb.ListSecretVersionsResponse, error) {
+	err := actions.ValidateUUIDs(idOrName)
+	var secret *models.Secret
+	if err != nil {
+		secret, err = models.FindSecretByName(domainType, uuid.MustParse(domainID), idOrName)
+	} else {
+		secret, err = models.FindSecretByID(domainType, uuid.MustParse(domainID), idOrName)
+	}
+
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "secret not found")
+	}
+
+	versions, err := models.ListSecretVersions(secret.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list secret versions")
+	}
+
+	response := &pb.ListSecretVersionsResponse{
+		Versions: make([]*pb.SecretVersion, 0, len(versions)),
+	}
+
+	for _, version := range versions {
+		response.Versions = append(response.Versions, serializeSecretVersion(secret, version))
+	}
+
+	return response, nil
+}
+
+func serializeSecretVersion(secret *models.Secret, version models.SecretVersion) *pb.SecretVersion {
+	s := &pb.SecretVersion{
+		Version:   int32(version.Version),
+		Reason:    version.Reason,
+		CreatedAt: timestamppb.New(version.CreatedAt),
+		Active:    version.Version == secret.ActiveVersion,
+	}
+
+	if version.CreatedBy != nil {
+		s.CreatedBy = version.CreatedBy.String()
+	}
+
+	if version.ActivatedAt != nil {
+		s.ActivatedAt = timestamppb.New(*version.ActivatedAt)
+	}
+
+	return s
+}
@@ -0,0 +1,60 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/grpc/actions"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/secrets"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func RollbackSecret(ctx context.Context, encryptor crypto.Encryptor, domainType, domainID, idOrName string, version int) (*pb.RollbackSecretResponse, error) {
+	if version <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "version is required")
+	}
+
+	err := actions.ValidateUUIDs(idOrName)
+	var secret *models.Secret
+	if err != nil {
+		secret, err = models.FindSecretByName(domainType, uuid.MustParse(domainID), idOrName)
+	} else {
+		secret, err = models.FindSecretByID(domainType, uuid.MustParse(domainID), idOrName)
+	}
+
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "secret not found")
+	}
+
+	if secret.ActiveVersion == version {
+		return nil, status.Errorf(codes.FailedPrecondition, "version %d is already active", version)
+	}
+
+	err = database.Conn().Transaction(func(tx *gorm.DB) error {
+		_, err := secret.ActivateVersionInTransaction(tx, version)
+		return err
+	})
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "version %d not found", version)
+		}
+
+		log.Errorf("failed to roll back secret %s to version %d: %v", secret.ID, version, err)
+		return nil, status.Error(codes.Internal, "failed to roll back secret")
+	}
+
+	s, err := serializeSecret(ctx, encryptor, *secret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RollbackSecretResponse{Secret: s}, nil
+}
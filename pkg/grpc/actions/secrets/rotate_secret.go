@@ -0,0 +1,63 @@
+package secrets
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/grpc/actions"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/secrets"
+	"github.com/superplanehq/superplane/pkg/secrets"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func RotateSecret(ctx context.Context, encryptor crypto.Encryptor, domainType, domainID, idOrName string, data map[string]string) (*pb.RotateSecretResponse, error) {
+	if len(data) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one key is required")
+	}
+
+	for key := range data {
+		if key == "" {
+			return nil, status.Error(codes.InvalidArgument, "key name is required")
+		}
+	}
+
+	err := actions.ValidateUUIDs(idOrName)
+	var secret *models.Secret
+	if err != nil {
+		secret, err = models.FindSecretByName(domainType, uuid.MustParse(domainID), idOrName)
+	} else {
+		secret, err = models.FindSecretByID(domainType, uuid.MustParse(domainID), idOrName)
+	}
+
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "secret not found")
+	}
+
+	if secret.Provider != secrets.ProviderLocal {
+		return nil, status.Errorf(codes.FailedPrecondition, "values of %s secrets are rotated by the provider", secret.Provider)
+	}
+
+	rotatedBy := requester(ctx)
+	err = database.Conn().Transaction(func(tx *gorm.DB) error {
+		_, err := secrets.RotateLocalSecret(ctx, tx, encryptor, secret, data, rotatedBy)
+		return err
+	})
+
+	if err != nil {
+		log.Errorf("failed to rotate secret %s: %v", secret.ID, err)
+		return nil, status.Error(codes.Internal, "failed to rotate secret")
+	}
+
+	s, err := serializeSecret(ctx, encryptor, *secret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RotateSecretResponse{Secret: s}, nil
+}
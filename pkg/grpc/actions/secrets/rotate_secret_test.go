@@ -0,0 +1,98 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/secrets"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test__RotateSecret(t *testing.T) {
+	r := support.SetupWithOptions(t, support.SetupOptions{})
+	encryptor := &crypto.NoOpEncryptor{}
+	ctx := authentication.SetUserIdInMetadata(context.Background(), r.User.String())
+	orgID := r.Organization.ID.String()
+
+	data, _ := json.Marshal(map[string]string{"token": "v1"})
+	_, err := models.CreateSecret("rotated", secrets.ProviderLocal, r.User.String(), models.DomainTypeOrganization, r.Organization.ID, data)
+	require.NoError(t, err)
+
+	t.Run("no keys -> error", func(t *testing.T) {
+		_, err := RotateSecret(ctx, encryptor, models.DomainTypeOrganization, orgID, "rotated", map[string]string{})
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+	})
+
+	t.Run("rotation creates and activates a new version", func(t *testing.T) {
+		response, err := RotateSecret(ctx, encryptor, models.DomainTypeOrganization, orgID, "rotated", map[string]string{"token": "v2"})
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), response.Secret.Metadata.ActiveVersion)
+		assert.Equal(t, map[string]string{"token": "v2"}, response.Secret.Spec.Local.Data)
+
+		versions, err := ListSecretVersions(ctx, models.DomainTypeOrganization, orgID, "rotated")
+		require.NoError(t, err)
+		require.Len(t, versions.Versions, 2)
+		assert.Equal(t, int32(2), versions.Versions[0].Version)
+		assert.Equal(t, models.SecretVersionReasonRotated, versions.Versions[0].Reason)
+		assert.Equal(t, r.User.String(), versions.Versions[0].CreatedBy)
+		assert.True(t, versions.Versions[0].Active)
+		assert.Equal(t, int32(1), versions.Versions[1].Version)
+		assert.Equal(t, models.SecretVersionReasonCreated, versions.Versions[1].Reason)
+		assert.False(t, versions.Versions[1].Active)
+	})
+
+	t.Run("rollback activates the previous version", func(t *testing.T) {
+		response, err := RollbackSecret(ctx, encryptor, models.DomainTypeOrganization, orgID, "rotated", 1)
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), response.Secret.Metadata.ActiveVersion)
+		assert.Equal(t, map[string]string{"token": "v1"}, response.Secret.Spec.Local.Data)
+
+		versions, err := ListSecretVersions(ctx, models.DomainTypeOrganization, orgID, "rotated")
+		require.NoError(t, err)
+		require.Len(t, versions.Versions, 2)
+		assert.True(t, versions.Versions[1].Active)
+		assert.NotNil(t, versions.Versions[1].ActivatedAt)
+	})
+
+	t.Run("updates after a rollback create a new version", func(t *testing.T) {
+		response, err := SetSecretKey(ctx, encryptor, models.DomainTypeOrganization, orgID, "rotated", "user", "bot")
+		require.NoError(t, err)
+		assert.Equal(t, int32(3), response.Secret.Metadata.ActiveVersion)
+		assert.Equal(t, map[string]string{"token": "v1", "user": "bot"}, response.Secret.Spec.Local.Data)
+	})
+
+	t.Run("rollback to unknown version -> error", func(t *testing.T) {
+		_, err := RollbackSecret(ctx, encryptor, models.DomainTypeOrganization, orgID, "rotated", 10)
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+	})
+
+	t.Run("rollback to active version -> error", func(t *testing.T) {
+		_, err := RollbackSecret(ctx, encryptor, models.DomainTypeOrganization, orgID, "rotated", 3)
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.FailedPrecondition, s.Code())
+	})
+
+	t.Run("external secrets cannot be rotated", func(t *testing.T) {
+		reference, _ := json.Marshal(secrets.VaultReference{Mount: "secret", Path: "app"})
+		_, err := models.CreateSecret("external", secrets.ProviderVault, r.User.String(), models.DomainTypeOrganization, r.Organization.ID, reference)
+		require.NoError(t, err)
+
+		_, err = RotateSecret(ctx, encryptor, models.DomainTypeOrganization, orgID, "external", map[string]string{"token": "v2"})
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.FailedPrecondition, s.Code())
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/superplanehq/superplane/pkg/grpc/actions"
 	"github.com/superplanehq/superplane/pkg/models"
 	pb "github.com/superplanehq/superplane/pkg/protos/secrets"
+	"github.com/superplanehq/superplane/pkg/secrets"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -28,6 +29,10 @@ func SetSecretKey(ctx context.Context, encryptor crypto.Encryptor, domainType, d
 		return nil, status.Error(codes.InvalidArgument, "secret not found")
 	}
 
+	if secret.Provider != secrets.ProviderLocal {
+		return nil, status.Errorf(codes.FailedPrecondition, "keys of %s secrets are managed by the provider", secret.Provider)
+	}
+
 	data, err := decryptSecretData(ctx, encryptor, *secret)
 	if err != nil {
 		return nil, err
@@ -43,13 +48,13 @@ func SetSecretKey(ctx context.Context, encryptor crypto.Encryptor, domainType, d
 		return nil, err
 	}
 
-	updated, err := secret.UpdateData(encrypted)
+	updatedBy := requester(ctx)
+	_, err = secret.UpdateData(encrypted, updatedBy, models.SecretVersionReasonUpdated)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
-	updated.Data = encrypted
 
-	s, err := serializeSecret(ctx, encryptor, *updated)
+	s, err := serializeSecret(ctx, encryptor, *secret)
 	if err != nil {
 		return nil, err
 	}
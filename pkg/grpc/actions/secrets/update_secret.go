@@ -2,6 +2,7 @@ package secrets
 
 import (
 	"context"
+	"slices"
 
 	"github.com/google/uuid"
 	"github.com/superplanehq/superplane/pkg/crypto"
@@ -12,7 +13,7 @@ import (
 	"google.golang.org/grpc/status"
 )
 
-func UpdateSecret(ctx context.Context, encryptor crypto.Encryptor, domainType, domainID, idOrName string, spec *pb.Secret) (*pb.UpdateSecretResponse, error) {
+func UpdateSecret(ctx context.Context, encryptor crypto.Encryptor, domainType, domainID, idOrName string, spec *pb.Secret, updateAllowedCanvasIDs bool) (*pb.UpdateSecretResponse, error) {
 	err := actions.ValidateUUIDs(idOrName)
 	var secret *models.Secret
 	if err != nil {
@@ -42,16 +43,36 @@ func UpdateSecret(ctx context.Context, encryptor crypto.Encryptor, domainType, d
 		return nil, status.Error(codes.InvalidArgument, "cannot update provider")
 	}
 
-	data, err := prepareSecretData(ctx, encryptor, spec)
+	data, err := prepareSecretData(ctx, encryptor, domainType, domainID, spec)
 	if err != nil {
-		return nil, err
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	//
+	// The canvases allowed to use the secret are only replaced when requested,
+	// since clients updating the values of a secret do not send them.
+	//
+	allowedCanvasIDs := secret.AllowedCanvasIDs
+	if updateAllowedCanvasIDs {
+		allowedCanvasIDs, err = validateAllowedCanvasIDs(domainType, domainID, spec.Spec.AllowedCanvasIds)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 	}
 
-	secret, err = secret.UpdateData(data)
+	updatedBy := requester(ctx)
+	_, err = secret.UpdateData(data, updatedBy, models.SecretVersionReasonUpdated)
 	if err != nil {
 		return nil, err
 	}
 
+	if !slices.Equal(secret.AllowedCanvasIDs, allowedCanvasIDs) {
+		err = secret.UpdateAllowedCanvasIDs(allowedCanvasIDs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	s, err := serializeSecret(ctx, encryptor, *secret)
 	if err != nil {
 		return nil, err
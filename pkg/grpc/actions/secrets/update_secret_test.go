@@ -28,7 +28,7 @@ func Test__UpdateSecret(t *testing.T) {
 	require.NoError(t, err)
 
 	t.Run("secret does not exist -> error", func(t *testing.T) {
-		_, err := UpdateSecret(context.Background(), encryptor, models.DomainTypeOrganization, r.Organization.ID.String(), "test2", &protos.Secret{})
+		_, err := UpdateSecret(context.Background(), encryptor, models.DomainTypeOrganization, r.Organization.ID.String(), "test2", &protos.Secret{}, false)
 		s, ok := status.FromError(err)
 		assert.True(t, ok)
 		assert.Equal(t, codes.InvalidArgument, s.Code())
@@ -51,7 +51,7 @@ func Test__UpdateSecret(t *testing.T) {
 			},
 		}
 
-		response, err := UpdateSecret(context.Background(), encryptor, models.DomainTypeOrganization, r.Organization.ID.String(), "test", secret)
+		response, err := UpdateSecret(context.Background(), encryptor, models.DomainTypeOrganization, r.Organization.ID.String(), "test", secret, false)
 		require.NoError(t, err)
 		require.NotNil(t, response)
 		require.NotNil(t, response.Secret)
@@ -61,4 +61,29 @@ func Test__UpdateSecret(t *testing.T) {
 		require.NotNil(t, response.Secret.Spec.Local)
 		require.Equal(t, map[string]string{"test": "***", "test2": "***"}, response.Secret.Spec.Local.Data)
 	})
+
+	t.Run("updating secret data keeps allowed canvases", func(t *testing.T) {
+		canvas, _ := support.CreateCanvas(t, r.Organization.ID, r.User, []models.CanvasNode{}, []models.Edge{})
+		_, err := models.CreateSecretWithAllowedCanvases("restricted", secrets.ProviderLocal, uuid.NewString(), models.DomainTypeOrganization, r.Organization.ID, data, []string{canvas.ID.String()})
+		require.NoError(t, err)
+
+		secret := &protos.Secret{
+			Metadata: &protos.Secret_Metadata{Name: "restricted"},
+			Spec: &protos.Secret_Spec{
+				Provider: protos.Secret_PROVIDER_LOCAL,
+				Local:    &protos.Secret_Local{Data: map[string]string{"test": "updated"}},
+			},
+		}
+
+		response, err := UpdateSecret(context.Background(), encryptor, models.DomainTypeOrganization, r.Organization.ID.String(), "restricted", secret, false)
+		require.NoError(t, err)
+		assert.Equal(t, []string{canvas.ID.String()}, response.Secret.Spec.AllowedCanvasIds)
+
+		//
+		// Allowed canvases are only replaced when requested.
+		//
+		response, err = UpdateSecret(context.Background(), encryptor, models.DomainTypeOrganization, r.Organization.ID.String(), "restricted", secret, true)
+		require.NoError(t, err)
+		assert.Empty(t, response.Secret.Spec.AllowedCanvasIds)
+	})
 }
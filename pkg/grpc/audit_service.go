@@ -0,0 +1,22 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/superplanehq/superplane/pkg/authorization"
+	"github.com/superplanehq/superplane/pkg/grpc/actions/audit"
+	pb "github.com/superplanehq/superplane/pkg/protos/audit"
+)
+
+type AuditService struct {
+	pb.UnimplementedAuditServer
+}
+
+func NewAuditService() *AuditService {
+	return &AuditService{}
+}
+
+func (s *AuditService) ListAuditEvents(ctx context.Context, req *pb.ListAuditEventsRequest) (*pb.ListAuditEventsResponse, error) {
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return audit.ListAuditEvents(ctx, organizationID, req)
+}
@@ -0,0 +1,150 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/superplanehq/superplane/pkg/audit"
+	"github.com/superplanehq/superplane/pkg/authorization"
+	pbAuth "github.com/superplanehq/superplane/pkg/protos/authorization"
+	pbBlueprints "github.com/superplanehq/superplane/pkg/protos/blueprints"
+	pbCanvases "github.com/superplanehq/superplane/pkg/protos/canvases"
+	pbGroups "github.com/superplanehq/superplane/pkg/protos/groups"
+	organizationPb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	pbRoles "github.com/superplanehq/superplane/pkg/protos/roles"
+	secretPb "github.com/superplanehq/superplane/pkg/protos/secrets"
+	pbServiceAccounts "github.com/superplanehq/superplane/pkg/protos/service_accounts"
+	"google.golang.org/protobuf/proto"
+)
+
+type auditStateServices struct {
+	organizations   *OrganizationService
+	groups          *GroupsService
+	roles           *RoleService
+	secrets         *SecretService
+	blueprints      *BlueprintService
+	canvases        *CanvasService
+	serviceAccounts *ServiceAccountsService
+}
+
+type idRequest interface {
+	GetId() string
+}
+
+type domainRequest interface {
+	GetDomainType() pbAuth.DomainType
+	GetDomainId() string
+}
+
+// newAuditStateLoaders describes the resources changed by calls,
+// the same way the API describes them, so the audit log
+// records their previous state with the same redaction.
+// Calls without the fields a resource is described by are recorded without it.
+func newAuditStateLoaders(services auditStateServices) map[string]audit.StateLoader {
+	return map[string]audit.StateLoader{
+		"org": func(ctx context.Context, request any) (proto.Message, error) {
+			organizationID, _ := ctx.Value(authorization.OrganizationContextKey).(string)
+			return services.organizations.DescribeOrganization(ctx, &organizationPb.DescribeOrganizationRequest{Id: organizationID})
+		},
+
+		"integrations": func(ctx context.Context, request any) (proto.Message, error) {
+			r, ok := request.(interface{ GetIntegrationId() string })
+			if !ok || r.GetIntegrationId() == "" {
+				return nil, nil
+			}
+
+			organizationID, _ := ctx.Value(authorization.OrganizationContextKey).(string)
+			return services.organizations.DescribeIntegration(ctx, &organizationPb.DescribeIntegrationRequest{
+				Id:            organizationID,
+				IntegrationId: r.GetIntegrationId(),
+			})
+		},
+
+		"groups": func(ctx context.Context, request any) (proto.Message, error) {
+			r, ok := request.(interface {
+				domainRequest
+				GetGroupName() string
+			})
+
+			if !ok || r.GetGroupName() == "" {
+				return nil, nil
+			}
+
+			return services.groups.DescribeGroup(ctx, &pbGroups.DescribeGroupRequest{
+				DomainType: r.GetDomainType(),
+				DomainId:   r.GetDomainId(),
+				GroupName:  r.GetGroupName(),
+			})
+		},
+
+		"roles": func(ctx context.Context, request any) (proto.Message, error) {
+			r, ok := request.(interface {
+				domainRequest
+				GetRoleName() string
+			})
+
+			if !ok || r.GetRoleName() == "" {
+				return nil, nil
+			}
+
+			return services.roles.DescribeRole(ctx, &pbRoles.DescribeRoleRequest{
+				DomainType: r.GetDomainType(),
+				DomainId:   r.GetDomainId(),
+				RoleName:   r.GetRoleName(),
+			})
+		},
+
+		"secrets": func(ctx context.Context, request any) (proto.Message, error) {
+			r, ok := request.(interface {
+				domainRequest
+				GetIdOrName() string
+			})
+
+			if !ok || r.GetIdOrName() == "" {
+				return nil, nil
+			}
+
+			return services.secrets.DescribeSecret(ctx, &secretPb.DescribeSecretRequest{
+				DomainType: r.GetDomainType(),
+				DomainId:   r.GetDomainId(),
+				IdOrName:   r.GetIdOrName(),
+			})
+		},
+
+		"blueprints": func(ctx context.Context, request any) (proto.Message, error) {
+			r, ok := request.(idRequest)
+			if !ok || r.GetId() == "" {
+				return nil, nil
+			}
+
+			return services.blueprints.DescribeBlueprint(ctx, &pbBlueprints.DescribeBlueprintRequest{Id: r.GetId()})
+		},
+
+		//
+		// Most canvas requests reference the canvas by canvas_id,
+		// and only the ones for the canvas itself use id.
+		//
+		"canvases": func(ctx context.Context, request any) (proto.Message, error) {
+			canvasID := ""
+			if r, ok := request.(interface{ GetCanvasId() string }); ok {
+				canvasID = r.GetCanvasId()
+			} else if r, ok := request.(idRequest); ok {
+				canvasID = r.GetId()
+			}
+
+			if canvasID == "" {
+				return nil, nil
+			}
+
+			return services.canvases.DescribeCanvas(ctx, &pbCanvases.DescribeCanvasRequest{Id: canvasID})
+		},
+
+		"service_accounts": func(ctx context.Context, request any) (proto.Message, error) {
+			r, ok := request.(idRequest)
+			if !ok || r.GetId() == "" {
+				return nil, nil
+			}
+
+			return services.serviceAccounts.DescribeServiceAccount(ctx, &pbServiceAccounts.DescribeServiceAccountRequest{Id: r.GetId()})
+		},
+	}
+}
@@ -50,6 +50,9 @@ func (s *CanvasService) UpdateCanvas(ctx context.Context, req *pb.UpdateCanvasRe
 		req.Description,
 		req.VersioningEnabled,
 		req.ChangeRequestApprovalConfig,
+		req.ChangeRequestCheckConfig,
+		req.NodeActionPermissionsConfig,
+		req.Environment,
 	)
 }
 
@@ -61,6 +64,16 @@ func (s *CanvasService) CreateCanvas(ctx context.Context, req *pb.CreateCanvasRe
 	return canvases.CreateCanvasWithAutoLayout(ctx, s.registry, organizationID, req.Canvas, req.AutoLayout)
 }
 
+func (s *CanvasService) ExportCanvas(ctx context.Context, req *pb.ExportCanvasRequest) (*pb.ExportCanvasResponse, error) {
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.ExportCanvas(ctx, s.registry, organizationID, req.Id)
+}
+
+func (s *CanvasService) ImportCanvas(ctx context.Context, req *pb.ImportCanvasRequest) (*pb.ImportCanvasResponse, error) {
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.ImportCanvas(ctx, s.authService, s.registry, organizationID, req)
+}
+
 func (s *CanvasService) CreateCanvasVersion(ctx context.Context, req *pb.CreateCanvasVersionRequest) (*pb.CreateCanvasVersionResponse, error) {
 	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
 	return canvases.CreateCanvasVersion(ctx, organizationID, req.CanvasId)
@@ -95,10 +108,29 @@ func (s *CanvasService) UpdateCanvasVersion(ctx context.Context, req *pb.UpdateC
 	)
 }
 
+func (s *CanvasService) DiffCanvasVersions(ctx context.Context, req *pb.DiffCanvasVersionsRequest) (*pb.DiffCanvasVersionsResponse, error) {
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.DiffCanvasVersions(ctx, organizationID, req.CanvasId, req.VersionId, req.BaseVersionId)
+}
+
+func (s *CanvasService) RollbackCanvasVersion(ctx context.Context, req *pb.RollbackCanvasVersionRequest) (*pb.RollbackCanvasVersionResponse, error) {
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.RollbackCanvasVersion(
+		ctx,
+		s.encryptor,
+		s.registry,
+		organizationID,
+		req.CanvasId,
+		req.VersionId,
+		s.webhookBaseURL,
+	)
+}
+
 func (s *CanvasService) CreateCanvasChangeRequest(ctx context.Context, req *pb.CreateCanvasChangeRequestRequest) (*pb.CreateCanvasChangeRequestResponse, error) {
 	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
 	return canvases.CreateCanvasChangeRequestWithMetadata(
 		ctx,
+		s.registry,
 		organizationID,
 		req.CanvasId,
 		req.VersionId,
@@ -210,6 +242,7 @@ func (s *CanvasService) EmitNodeEvent(ctx context.Context, req *pb.EmitNodeEvent
 
 	return canvases.EmitNodeEvent(
 		ctx,
+		s.authService,
 		uuid.MustParse(organizationID),
 		canvasID,
 		req.NodeId,
@@ -293,6 +326,76 @@ func (s *CanvasService) DeleteCanvasMemory(ctx context.Context, req *pb.DeleteCa
 	return canvases.DeleteCanvasMemory(ctx, s.registry, organizationID, req.CanvasId, req.MemoryId)
 }
 
+func (s *CanvasService) ListCanvasMemoryNamespaces(ctx context.Context, req *pb.ListCanvasMemoryNamespacesRequest) (*pb.ListCanvasMemoryNamespacesResponse, error) {
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.ListCanvasMemoryNamespaces(ctx, organizationID, req.CanvasId)
+}
+
+func (s *CanvasService) UpdateCanvasMemoryNamespace(ctx context.Context, req *pb.UpdateCanvasMemoryNamespaceRequest) (*pb.UpdateCanvasMemoryNamespaceResponse, error) {
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.UpdateCanvasMemoryNamespace(ctx, organizationID, req)
+}
+
+func (s *CanvasService) DeleteCanvasMemoryNamespace(ctx context.Context, req *pb.DeleteCanvasMemoryNamespaceRequest) (*pb.DeleteCanvasMemoryNamespaceResponse, error) {
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.DeleteCanvasMemoryNamespace(ctx, organizationID, req.CanvasId, req.Namespace)
+}
+
+func (s *CanvasService) DescribeCanvasGitSync(ctx context.Context, req *pb.DescribeCanvasGitSyncRequest) (*pb.DescribeCanvasGitSyncResponse, error) {
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.DescribeCanvasGitSync(ctx, organizationID, req.CanvasId)
+}
+
+func (s *CanvasService) UpdateCanvasGitSync(ctx context.Context, req *pb.UpdateCanvasGitSyncRequest) (*pb.UpdateCanvasGitSyncResponse, error) {
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.UpdateCanvasGitSync(ctx, organizationID, req)
+}
+
+func (s *CanvasService) DeleteCanvasGitSync(ctx context.Context, req *pb.DeleteCanvasGitSyncRequest) (*pb.DeleteCanvasGitSyncResponse, error) {
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.DeleteCanvasGitSync(ctx, organizationID, req.CanvasId)
+}
+
+func (s *CanvasService) ListCanvasMembers(ctx context.Context, req *pb.ListCanvasMembersRequest) (*pb.ListCanvasMembersResponse, error) {
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.ListCanvasMembers(ctx, s.authService, organizationID, req.CanvasId)
+}
+
+func (s *CanvasService) UpdateCanvasMember(ctx context.Context, req *pb.UpdateCanvasMemberRequest) (*pb.UpdateCanvasMemberResponse, error) {
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.UpdateCanvasMember(ctx, s.authService, organizationID, req.CanvasId, req.UserId, req.Role)
+}
+
+func (s *CanvasService) RemoveCanvasMember(ctx context.Context, req *pb.RemoveCanvasMemberRequest) (*pb.RemoveCanvasMemberResponse, error) {
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.RemoveCanvasMember(ctx, s.authService, organizationID, req.CanvasId, req.UserId)
+}
+
+func (s *CanvasService) ListCanvasGroups(ctx context.Context, req *pb.ListCanvasGroupsRequest) (*pb.ListCanvasGroupsResponse, error) {
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.ListCanvasGroups(ctx, s.authService, organizationID, req.CanvasId)
+}
+
+func (s *CanvasService) UpdateCanvasGroup(ctx context.Context, req *pb.UpdateCanvasGroupRequest) (*pb.UpdateCanvasGroupResponse, error) {
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.UpdateCanvasGroup(ctx, s.authService, organizationID, req.CanvasId, req.GroupName, req.Role)
+}
+
+func (s *CanvasService) RemoveCanvasGroup(ctx context.Context, req *pb.RemoveCanvasGroupRequest) (*pb.RemoveCanvasGroupResponse, error) {
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.RemoveCanvasGroup(ctx, s.authService, organizationID, req.CanvasId, req.GroupName)
+}
+
+func (s *CanvasService) ValidateExpression(ctx context.Context, req *pb.ValidateExpressionRequest) (*pb.ValidateExpressionResponse, error) {
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.ValidateExpression(ctx, s.registry, organizationID, req)
+}
+
+func (s *CanvasService) CompleteExpression(ctx context.Context, req *pb.CompleteExpressionRequest) (*pb.CompleteExpressionResponse, error) {
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.CompleteExpression(ctx, s.registry, organizationID, req)
+}
+
 func (s *CanvasService) ListEventExecutions(ctx context.Context, req *pb.ListEventExecutionsRequest) (*pb.ListEventExecutionsResponse, error) {
 	return canvases.ListEventExecutions(ctx, s.registry, req.CanvasId, req.EventId)
 }
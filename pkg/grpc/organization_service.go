@@ -112,6 +112,26 @@ func (s *OrganizationService) UpdateAgentSettings(
 	return organizations.UpdateAgentSettings(orgID, req.AgentModeEnabled, userID)
 }
 
+func (s *OrganizationService) GetSSOSettings(
+	ctx context.Context,
+	req *pb.GetSSOSettingsRequest,
+) (*pb.GetSSOSettingsResponse, error) {
+	orgID := ctx.Value(authorization.DomainIdContextKey).(string)
+	return organizations.GetSSOSettings(s.baseURL, orgID)
+}
+
+func (s *OrganizationService) UpdateSSOSettings(
+	ctx context.Context,
+	req *pb.UpdateSSOSettingsRequest,
+) (*pb.UpdateSSOSettingsResponse, error) {
+	orgID := ctx.Value(authorization.DomainIdContextKey).(string)
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return organizations.UpdateSSOSettings(ctx, s.encryptor, s.baseURL, orgID, userID, req)
+}
+
 func (s *OrganizationService) SetAgentOpenAIKey(
 	ctx context.Context,
 	req *pb.SetAgentOpenAIKeyRequest,
@@ -200,6 +220,36 @@ func (s *OrganizationService) DeleteIntegration(ctx context.Context, req *pb.Del
 	return organizations.DeleteIntegration(ctx, orgID, req.IntegrationId)
 }
 
+func (s *OrganizationService) ListMemoryNamespaces(ctx context.Context, req *pb.ListMemoryNamespacesRequest) (*pb.ListMemoryNamespacesResponse, error) {
+	orgID := ctx.Value(authorization.DomainIdContextKey).(string)
+	return organizations.ListMemoryNamespaces(ctx, orgID)
+}
+
+func (s *OrganizationService) UpdateMemoryNamespace(ctx context.Context, req *pb.UpdateMemoryNamespaceRequest) (*pb.UpdateMemoryNamespaceResponse, error) {
+	orgID := ctx.Value(authorization.DomainIdContextKey).(string)
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return organizations.UpdateMemoryNamespace(ctx, orgID, userID, req)
+}
+
+func (s *OrganizationService) DeleteMemoryNamespace(ctx context.Context, req *pb.DeleteMemoryNamespaceRequest) (*pb.DeleteMemoryNamespaceResponse, error) {
+	orgID := ctx.Value(authorization.DomainIdContextKey).(string)
+	return organizations.DeleteMemoryNamespace(ctx, orgID, req.Namespace)
+}
+
+func (s *OrganizationService) ListMemories(ctx context.Context, req *pb.ListMemoriesRequest) (*pb.ListMemoriesResponse, error) {
+	orgID := ctx.Value(authorization.DomainIdContextKey).(string)
+	return organizations.ListMemories(ctx, orgID, req.Namespace)
+}
+
+func (s *OrganizationService) DeleteMemory(ctx context.Context, req *pb.DeleteMemoryRequest) (*pb.DeleteMemoryResponse, error) {
+	orgID := ctx.Value(authorization.DomainIdContextKey).(string)
+	return organizations.DeleteMemory(ctx, orgID, req.Namespace, req.MemoryId)
+}
+
 func accountIDFromContext(ctx context.Context) (string, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
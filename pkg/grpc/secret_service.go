@@ -30,7 +30,7 @@ func (s *SecretService) CreateSecret(ctx context.Context, req *pb.CreateSecretRe
 func (s *SecretService) UpdateSecret(ctx context.Context, req *pb.UpdateSecretRequest) (*pb.UpdateSecretResponse, error) {
 	domainType := ctx.Value(authorization.DomainTypeContextKey).(string)
 	domainId := ctx.Value(authorization.DomainIdContextKey).(string)
-	return secrets.UpdateSecret(ctx, s.encryptor, domainType, domainId, req.IdOrName, req.Secret)
+	return secrets.UpdateSecret(ctx, s.encryptor, domainType, domainId, req.IdOrName, req.Secret, req.UpdateAllowedCanvasIds)
 }
 
 func (s *SecretService) DescribeSecret(ctx context.Context, req *pb.DescribeSecretRequest) (*pb.DescribeSecretResponse, error) {
@@ -68,3 +68,21 @@ func (s *SecretService) UpdateSecretName(ctx context.Context, req *pb.UpdateSecr
 	domainId := ctx.Value(authorization.DomainIdContextKey).(string)
 	return secrets.UpdateSecretName(ctx, s.encryptor, domainType, domainId, req.IdOrName, req.Name)
 }
+
+func (s *SecretService) RotateSecret(ctx context.Context, req *pb.RotateSecretRequest) (*pb.RotateSecretResponse, error) {
+	domainType := ctx.Value(authorization.DomainTypeContextKey).(string)
+	domainId := ctx.Value(authorization.DomainIdContextKey).(string)
+	return secrets.RotateSecret(ctx, s.encryptor, domainType, domainId, req.IdOrName, req.Data)
+}
+
+func (s *SecretService) ListSecretVersions(ctx context.Context, req *pb.ListSecretVersionsRequest) (*pb.ListSecretVersionsResponse, error) {
+	domainType := ctx.Value(authorization.DomainTypeContextKey).(string)
+	domainId := ctx.Value(authorization.DomainIdContextKey).(string)
+	return secrets.ListSecretVersions(ctx, domainType, domainId, req.IdOrName)
+}
+
+func (s *SecretService) RollbackSecret(ctx context.Context, req *pb.RollbackSecretRequest) (*pb.RollbackSecretResponse, error) {
+	domainType := ctx.Value(authorization.DomainTypeContextKey).(string)
+	domainId := ctx.Value(authorization.DomainIdContextKey).(string)
+	return secrets.RollbackSecret(ctx, s.encryptor, domainType, domainId, req.IdOrName, int(req.Version))
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/superplanehq/superplane/pkg/authorization"
 	"github.com/superplanehq/superplane/pkg/crypto"
 	"github.com/superplanehq/superplane/pkg/oidc"
+	pbAudit "github.com/superplanehq/superplane/pkg/protos/audit"
 	pbBlueprints "github.com/superplanehq/superplane/pkg/protos/blueprints"
 	pbCanvases "github.com/superplanehq/superplane/pkg/protos/canvases"
 	pbComponents "github.com/superplanehq/superplane/pkg/protos/components"
@@ -69,10 +70,11 @@ func RunServer(baseURL, webhooksBaseURL, basePath string, encryptor crypto.Encry
 		recovery.WithRecoveryHandler(customFunc),
 	}
 
+	authorizationInterceptor := authorization.NewAuthorizationInterceptor(authService)
 	grpcServer := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
 			recovery.UnaryServerInterceptor(opts...),
-			authorization.NewAuthorizationInterceptor(authService).UnaryInterceptor(),
+			authorizationInterceptor.UnaryInterceptor(),
 			sanitizeErrorUnaryInterceptor(),
 		),
 		grpc.ChainStreamInterceptor(
@@ -128,6 +130,19 @@ func RunServer(baseURL, webhooksBaseURL, basePath string, encryptor crypto.Encry
 	serviceAccountsService := NewServiceAccountsService(authService)
 	pbServiceAccounts.RegisterServiceAccountsServer(grpcServer, serviceAccountsService)
 
+	auditService := NewAuditService()
+	pbAudit.RegisterAuditServer(grpcServer, auditService)
+
+	authorizationInterceptor.WithStateLoaders(newAuditStateLoaders(auditStateServices{
+		organizations:   organizationService,
+		groups:          groupService,
+		roles:           roleService,
+		secrets:         secretsService,
+		blueprints:      blueprintService,
+		canvases:        canvasService,
+		serviceAccounts: serviceAccountsService,
+	}))
+
 	reflection.Register(grpcServer)
 
 	//
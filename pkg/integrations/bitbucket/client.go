@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"strings"
 
 	"github.com/superplanehq/superplane/pkg/core"
 )
@@ -251,3 +253,34 @@ func (c *Client) DeleteWebhook(workspace, repoSlug, webhookUID string) error {
 
 	return nil
 }
+
+// GetFileContent returns the raw contents of a file,
+// at the tip of a branch. The repository is given by its full name.
+func (c *Client) GetFileContent(repository, branch, path string) ([]byte, error) {
+	apiURL := fmt.Sprintf("%s/repositories/%s/src/%s/%s", baseURL, repository, url.PathEscape(branch), strings.TrimPrefix(path, "/"))
+
+	req, err := http.NewRequest("GET", apiURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	c.setAuthHeaders(req)
+
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, string(body))
+	}
+
+	return body, nil
+}
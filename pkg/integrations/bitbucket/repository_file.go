@@ -0,0 +1,36 @@
+package bitbucket
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+func (b *Bitbucket) ReadRepositoryFile(ctx core.RepositoryFileContext) ([]byte, error) {
+	metadata := Metadata{}
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &metadata); err != nil {
+		return nil, fmt.Errorf("failed to decode integration metadata: %w", err)
+	}
+
+	client, err := NewClient(metadata.AuthType, ctx.HTTP, ctx.Integration)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	//
+	// Repositories can be given by their full name (<workspace>/<slug>),
+	// or just by their slug, for repositories in the integration workspace.
+	//
+	repository := ctx.Repository
+	if !strings.Contains(repository, "/") {
+		if metadata.Workspace == nil {
+			return nil, fmt.Errorf("workspace is missing from the integration metadata")
+		}
+
+		repository = metadata.Workspace.Slug + "/" + repository
+	}
+
+	return client.GetFileContent(repository, ctx.Branch, ctx.Path)
+}
@@ -0,0 +1,70 @@
+package bitbucket
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__Bitbucket__ReadRepositoryFile(t *testing.T) {
+	b := &Bitbucket{}
+	integrationCtx := &contexts.IntegrationContext{
+		Configuration: map[string]any{
+			"token": "token",
+		},
+		Metadata: Metadata{
+			AuthType:  AuthTypeWorkspaceAccessToken,
+			Workspace: &WorkspaceMetadata{Slug: "superplane"},
+		},
+	}
+
+	t.Run("repository slug uses the integration workspace", func(t *testing.T) {
+		httpCtx := &contexts.HTTPContext{
+			Responses: []*http.Response{
+				{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader("kind: Canvas\n")),
+				},
+			},
+		}
+
+		content, err := b.ReadRepositoryFile(core.RepositoryFileContext{
+			HTTP:        httpCtx,
+			Integration: integrationCtx,
+			Repository:  "canvases",
+			Branch:      "main",
+			Path:        "deploy.yaml",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "kind: Canvas\n", string(content))
+		require.Len(t, httpCtx.Requests, 1)
+		assert.Equal(t, "https://api.bitbucket.org/2.0/repositories/superplane/canvases/src/main/deploy.yaml", httpCtx.Requests[0].URL.String())
+		assert.Equal(t, "Bearer token", httpCtx.Requests[0].Header.Get("Authorization"))
+	})
+
+	t.Run("file not found -> error", func(t *testing.T) {
+		_, err := b.ReadRepositoryFile(core.RepositoryFileContext{
+			HTTP: &contexts.HTTPContext{
+				Responses: []*http.Response{
+					{
+						StatusCode: http.StatusNotFound,
+						Body:       io.NopCloser(strings.NewReader(`{"type":"error"}`)),
+					},
+				},
+			},
+			Integration: integrationCtx,
+			Repository:  "superplane/canvases",
+			Branch:      "main",
+			Path:        "missing.yaml",
+		})
+
+		require.ErrorContains(t, err, "unexpected status code 404")
+	})
+}
@@ -0,0 +1,56 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+func (g *GitHub) ReadRepositoryFile(ctx core.RepositoryFileContext) ([]byte, error) {
+	metadata := Metadata{}
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &metadata); err != nil {
+		return nil, fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewClient(ctx.Integration, metadata.GitHubApp.ID, metadata.InstallationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GitHub client: %w", err)
+	}
+
+	//
+	// Repositories can be given as <owner>/<name>,
+	// or just <name> for repositories of the installation owner.
+	//
+	owner, repository, found := strings.Cut(ctx.Repository, "/")
+	if !found {
+		owner = metadata.Owner
+		repository = ctx.Repository
+	}
+
+	file, _, _, err := client.Repositories.GetContents(
+		context.Background(),
+		owner,
+		repository,
+		ctx.Path,
+		&github.RepositoryContentGetOptions{Ref: ctx.Branch},
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s from %s/%s: %w", ctx.Path, owner, repository, err)
+	}
+
+	if file == nil {
+		return nil, fmt.Errorf("%s is not a file", ctx.Path)
+	}
+
+	content, err := file.GetContent()
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", ctx.Path, err)
+	}
+
+	return []byte(content), nil
+}
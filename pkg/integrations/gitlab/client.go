@@ -8,6 +8,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strings"
 
 	"github.com/superplanehq/superplane/pkg/core"
 )
@@ -446,6 +447,36 @@ func (c *Client) ListPipelines(projectID string) ([]Pipeline, error) {
 	})
 }
 
+// GetRawFile returns the raw contents of a file, at the tip of a branch.
+// The project can be given by its ID or its full path.
+func (c *Client) GetRawFile(projectID, ref, filePath string) ([]byte, error) {
+	apiURL := fmt.Sprintf(
+		"%s/api/%s/projects/%s/repository/files/%s/raw?ref=%s",
+		c.baseURL,
+		apiVersion,
+		url.PathEscape(projectID),
+		url.PathEscape(strings.TrimPrefix(filePath, "/")),
+		url.QueryEscape(ref),
+	)
+
+	req, err := http.NewRequest(http.MethodGet, apiURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get file: status %d, response: %s", resp.StatusCode, readResponseBody(resp))
+	}
+
+	return io.ReadAll(resp.Body)
+}
+
 func readResponseBody(resp *http.Response) string {
 	body, err := io.ReadAll(io.LimitReader(resp.Body, 4096))
 	if err != nil {
@@ -0,0 +1,16 @@
+package gitlab
+
+import (
+	"fmt"
+
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+func (g *GitLab) ReadRepositoryFile(ctx core.RepositoryFileContext) ([]byte, error) {
+	client, err := NewClient(ctx.HTTP, ctx.Integration)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	return client.GetRawFile(ctx.Repository, ctx.Branch, ctx.Path)
+}
@@ -0,0 +1,64 @@
+package gitlab
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__GitLab__ReadRepositoryFile(t *testing.T) {
+	g := &GitLab{}
+	integrationCtx := &contexts.IntegrationContext{
+		Configuration: map[string]any{
+			"baseUrl":     "https://gitlab.com",
+			"groupId":     "123",
+			"authType":    AuthTypePersonalAccessToken,
+			"accessToken": "token",
+		},
+	}
+
+	t.Run("returns file contents", func(t *testing.T) {
+		httpCtx := &contexts.HTTPContext{
+			Responses: []*http.Response{
+				GitlabMockResponse(http.StatusOK, "kind: Canvas\n"),
+			},
+		}
+
+		content, err := g.ReadRepositoryFile(core.RepositoryFileContext{
+			HTTP:        httpCtx,
+			Integration: integrationCtx,
+			Repository:  "group/project",
+			Branch:      "main",
+			Path:        "canvases/deploy.yaml",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "kind: Canvas\n", string(content))
+		require.Len(t, httpCtx.Requests, 1)
+		assert.Equal(
+			t,
+			"https://gitlab.com/api/v4/projects/group%2Fproject/repository/files/canvases%2Fdeploy.yaml/raw?ref=main",
+			httpCtx.Requests[0].URL.String(),
+		)
+	})
+
+	t.Run("file not found -> error", func(t *testing.T) {
+		_, err := g.ReadRepositoryFile(core.RepositoryFileContext{
+			HTTP: &contexts.HTTPContext{
+				Responses: []*http.Response{
+					GitlabMockResponse(http.StatusNotFound, `{"message":"404 File Not Found"}`),
+				},
+			},
+			Integration: integrationCtx,
+			Repository:  "group/project",
+			Branch:      "main",
+			Path:        "missing.yaml",
+		})
+
+		require.ErrorContains(t, err, "status 404")
+	})
+}
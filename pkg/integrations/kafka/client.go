@@ -0,0 +1,258 @@
+package kafka
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/segmentio/kafka-go/sasl"
+	"github.com/segmentio/kafka-go/sasl/plain"
+	"github.com/segmentio/kafka-go/sasl/scram"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const (
+	DialTimeout   = 10 * time.Second
+	ReadTimeout   = 10 * time.Second
+	WriteTimeout  = 10 * time.Second
+	MaxBatchBytes = 10 * 1024 * 1024
+)
+
+// Client is the subset of Kafka operations used by the integration.
+type Client interface {
+	Ping() error
+	Partitions(topic string) ([]int, error)
+	Consumer(topic, groupID, startFrom string) Consumer
+	Write(message kafka.Message) error
+	Close() error
+}
+
+// Consumer reads the messages of a topic as a member of a consumer group.
+// Offsets are only committed to the group with CommitMessages.
+type Consumer interface {
+	FetchMessage(ctx context.Context) (kafka.Message, error)
+	CommitMessages(ctx context.Context, messages ...kafka.Message) error
+	Close() error
+}
+
+type ConnectionConfig struct {
+	Brokers []string
+	SASL    sasl.Mechanism
+	TLS     *tls.Config
+}
+
+type connector func(config ConnectionConfig) (Client, error)
+
+var connect connector = func(config ConnectionConfig) (Client, error) {
+	return &brokerClient{
+		brokers: config.Brokers,
+		dialer: &kafka.Dialer{
+			Timeout:       DialTimeout,
+			DualStack:     true,
+			SASLMechanism: config.SASL,
+			TLS:           config.TLS,
+		},
+		transport: &kafka.Transport{
+			DialTimeout: DialTimeout,
+			SASL:        config.SASL,
+			TLS:         config.TLS,
+		},
+	}, nil
+}
+
+func NewClient(ctx core.IntegrationContext) (Client, error) {
+	config, err := connectionConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect(*config)
+}
+
+func connectionConfig(ctx core.IntegrationContext) (*ConnectionConfig, error) {
+	brokers, err := ctx.GetConfig("brokers")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get brokers: %w", err)
+	}
+
+	config := &ConnectionConfig{Brokers: parseBrokers(string(brokers))}
+	if len(config.Brokers) == 0 {
+		return nil, fmt.Errorf("at least one broker is required")
+	}
+
+	// Optional fields
+	mechanism, _ := ctx.GetConfig("saslMechanism")
+	username, _ := ctx.GetConfig("username")
+	password, _ := ctx.GetConfig("password")
+	useTLS, _ := ctx.GetConfig("useTLS")
+	caCertificate, _ := ctx.GetConfig("caCertificate")
+
+	config.SASL, err = saslMechanism(string(mechanism), string(username), string(password))
+	if err != nil {
+		return nil, err
+	}
+
+	if string(useTLS) != "true" {
+		return config, nil
+	}
+
+	config.TLS = &tls.Config{MinVersion: tls.VersionTLS12}
+	if len(strings.TrimSpace(string(caCertificate))) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCertificate) {
+			return nil, fmt.Errorf("invalid CA certificate")
+		}
+
+		config.TLS.RootCAs = pool
+	}
+
+	return config, nil
+}
+
+func saslMechanism(mechanism, username, password string) (sasl.Mechanism, error) {
+	switch mechanism {
+	case "", SASLMechanismNone:
+		return nil, nil
+	case SASLMechanismPlain:
+		return plain.Mechanism{Username: username, Password: password}, nil
+	case SASLMechanismScramSHA256:
+		return scram.Mechanism(scram.SHA256, username, password)
+	case SASLMechanismScramSHA512:
+		return scram.Mechanism(scram.SHA512, username, password)
+	default:
+		return nil, fmt.Errorf("unsupported SASL mechanism: %s", mechanism)
+	}
+}
+
+func parseBrokers(brokers string) []string {
+	result := []string{}
+	for _, broker := range strings.Split(brokers, ",") {
+		broker = strings.TrimSpace(broker)
+		if broker != "" {
+			result = append(result, broker)
+		}
+	}
+
+	return result
+}
+
+type brokerClient struct {
+	brokers   []string
+	dialer    *kafka.Dialer
+	transport *kafka.Transport
+}
+
+// dial tries the bootstrap brokers in order,
+// returning the first connection that succeeds.
+func (c *brokerClient) dial(fn func(ctx context.Context, address string) (*kafka.Conn, error)) (*kafka.Conn, error) {
+	var errs []error
+	for _, broker := range c.brokers {
+		ctx, cancel := context.WithTimeout(context.Background(), DialTimeout)
+		conn, err := fn(ctx, broker)
+		cancel()
+
+		if err == nil {
+			return conn, nil
+		}
+
+		errs = append(errs, fmt.Errorf("%s: %w", broker, err))
+	}
+
+	return nil, errors.Join(errs...)
+}
+
+func (c *brokerClient) leader(topic string, partition int) (*kafka.Conn, error) {
+	return c.dial(func(ctx context.Context, address string) (*kafka.Conn, error) {
+		return c.dialer.DialLeader(ctx, "tcp", address, topic, partition)
+	})
+}
+
+func (c *brokerClient) Ping() error {
+	conn, err := c.dial(func(ctx context.Context, address string) (*kafka.Conn, error) {
+		return c.dialer.DialContext(ctx, "tcp", address)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	defer conn.Close()
+	_, err = conn.Brokers()
+	return err
+}
+
+func (c *brokerClient) Partitions(topic string) ([]int, error) {
+	conn, err := c.dial(func(ctx context.Context, address string) (*kafka.Conn, error) {
+		return c.dialer.DialContext(ctx, "tcp", address)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer conn.Close()
+
+	partitions, err := conn.ReadPartitions(topic)
+	if err != nil {
+		return nil, fmt.Errorf("error reading partitions for topic %s: %w", topic, err)
+	}
+
+	if len(partitions) == 0 {
+		return nil, fmt.Errorf("topic %s not found", topic)
+	}
+
+	ids := make([]int, 0, len(partitions))
+	for _, partition := range partitions {
+		ids = append(ids, partition.ID)
+	}
+
+	sort.Ints(ids)
+	return ids, nil
+}
+
+// Consumer joins the consumer group for the topic.
+// Groups without committed offsets start reading from the end of the partitions,
+// or from the first message still retained, if startFrom is earliest.
+func (c *brokerClient) Consumer(topic, groupID, startFrom string) Consumer {
+	startOffset := kafka.LastOffset
+	if startFrom == StartFromEarliest {
+		startOffset = kafka.FirstOffset
+	}
+
+	return kafka.NewReader(kafka.ReaderConfig{
+		Brokers:     c.brokers,
+		GroupID:     groupID,
+		Topic:       topic,
+		Dialer:      c.dialer,
+		StartOffset: startOffset,
+		MinBytes:    1,
+		MaxBytes:    MaxBatchBytes,
+		MaxWait:     ReadTimeout,
+	})
+}
+
+func (c *brokerClient) Write(message kafka.Message) error {
+	writer := &kafka.Writer{
+		Addr:         kafka.TCP(c.brokers...),
+		Balancer:     &kafka.Hash{},
+		RequiredAcks: kafka.RequireAll,
+		Transport:    c.transport,
+	}
+
+	defer writer.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), WriteTimeout)
+	defer cancel()
+	return writer.WriteMessages(ctx, message)
+}
+
+func (c *brokerClient) Close() error {
+	c.transport.CloseIdleConnections()
+	return nil
+}
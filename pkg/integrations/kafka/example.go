@@ -0,0 +1,28 @@
+package kafka
+
+import (
+	_ "embed"
+	"sync"
+
+	"github.com/superplanehq/superplane/pkg/utils"
+)
+
+//go:embed example_data_on_message.json
+var exampleDataOnMessageBytes []byte
+
+var exampleDataOnMessageOnce sync.Once
+var exampleDataOnMessage map[string]any
+
+//go:embed example_output_produce_message.json
+var exampleOutputProduceMessageBytes []byte
+
+var exampleOutputProduceMessageOnce sync.Once
+var exampleOutputProduceMessage map[string]any
+
+func (t *OnMessage) ExampleData() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleDataOnMessageOnce, exampleDataOnMessageBytes, &exampleDataOnMessage)
+}
+
+func (c *ProduceMessage) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputProduceMessageOnce, exampleOutputProduceMessageBytes, &exampleOutputProduceMessage)
+}
@@ -0,0 +1,195 @@
+package kafka
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/registry"
+)
+
+const (
+	SASLMechanismNone        = "none"
+	SASLMechanismPlain       = "plain"
+	SASLMechanismScramSHA256 = "scram-sha-256"
+	SASLMechanismScramSHA512 = "scram-sha-512"
+)
+
+var saslMechanisms = []string{
+	SASLMechanismNone,
+	SASLMechanismPlain,
+	SASLMechanismScramSHA256,
+	SASLMechanismScramSHA512,
+}
+
+func init() {
+	registry.RegisterIntegration("kafka", &Kafka{})
+}
+
+type Kafka struct{}
+
+type Configuration struct {
+	Brokers       string `json:"brokers" mapstructure:"brokers"`
+	SASLMechanism string `json:"saslMechanism" mapstructure:"saslMechanism"`
+	Username      string `json:"username" mapstructure:"username"`
+	Password      string `json:"password" mapstructure:"password"`
+}
+
+func (k *Kafka) Name() string {
+	return "kafka"
+}
+
+func (k *Kafka) Label() string {
+	return "Kafka"
+}
+
+func (k *Kafka) Icon() string {
+	return "kafka"
+}
+
+func (k *Kafka) Description() string {
+	return "Consume and produce messages on Kafka topics"
+}
+
+func (k *Kafka) Instructions() string {
+	return `To set up the Kafka integration:
+
+1. Create a user for SuperPlane in your Kafka cluster, if it uses SASL authentication
+2. Grant the user **Describe** and **Read** permissions on the topics you want to consume from, and **Write** permissions on the topics you want to produce to
+3. Fill in the connection details below`
+}
+
+func (k *Kafka) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:        "brokers",
+			Label:       "Brokers",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Description: "Comma-separated list of bootstrap brokers, e.g. kafka-1:9092,kafka-2:9092",
+		},
+		{
+			Name:     "saslMechanism",
+			Label:    "SASL Mechanism",
+			Type:     configuration.FieldTypeSelect,
+			Required: true,
+			Default:  SASLMechanismNone,
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "None", Value: SASLMechanismNone},
+						{Label: "PLAIN", Value: SASLMechanismPlain},
+						{Label: "SCRAM-SHA-256", Value: SASLMechanismScramSHA256},
+						{Label: "SCRAM-SHA-512", Value: SASLMechanismScramSHA512},
+					},
+				},
+			},
+		},
+		{
+			Name:        "username",
+			Label:       "Username",
+			Type:        configuration.FieldTypeString,
+			Required:    false,
+			Description: "SASL username",
+			VisibilityConditions: []configuration.VisibilityCondition{
+				{Field: "saslMechanism", Values: []string{SASLMechanismPlain, SASLMechanismScramSHA256, SASLMechanismScramSHA512}},
+			},
+		},
+		{
+			Name:        "password",
+			Label:       "Password",
+			Type:        configuration.FieldTypeString,
+			Required:    false,
+			Sensitive:   true,
+			Description: "SASL password",
+			VisibilityConditions: []configuration.VisibilityCondition{
+				{Field: "saslMechanism", Values: []string{SASLMechanismPlain, SASLMechanismScramSHA256, SASLMechanismScramSHA512}},
+			},
+		},
+		{
+			Name:        "useTLS",
+			Label:       "Use TLS",
+			Type:        configuration.FieldTypeBool,
+			Required:    false,
+			Default:     false,
+			Description: "Connect to the brokers using TLS",
+		},
+		{
+			Name:        "caCertificate",
+			Label:       "CA Certificate",
+			Type:        configuration.FieldTypeText,
+			Required:    false,
+			Description: "PEM-encoded CA certificate used to verify the brokers. Leave empty to use the system CAs.",
+			VisibilityConditions: []configuration.VisibilityCondition{
+				{Field: "useTLS", Values: []string{"true"}},
+			},
+		},
+	}
+}
+
+func (k *Kafka) Components() []core.Component {
+	return []core.Component{
+		&ProduceMessage{},
+	}
+}
+
+func (k *Kafka) Triggers() []core.Trigger {
+	return []core.Trigger{
+		&OnMessage{},
+	}
+}
+
+func (k *Kafka) Cleanup(ctx core.IntegrationCleanupContext) error {
+	return nil
+}
+
+func (k *Kafka) Sync(ctx core.SyncContext) error {
+	config := Configuration{}
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %v", err)
+	}
+
+	if len(parseBrokers(config.Brokers)) == 0 {
+		return fmt.Errorf("at least one broker is required")
+	}
+
+	if config.SASLMechanism != "" && !slices.Contains(saslMechanisms, config.SASLMechanism) {
+		return fmt.Errorf("unsupported SASL mechanism: %s", config.SASLMechanism)
+	}
+
+	if config.SASLMechanism != "" && config.SASLMechanism != SASLMechanismNone && config.Username == "" {
+		return fmt.Errorf("username is required for SASL authentication")
+	}
+
+	client, err := NewClient(ctx.Integration)
+	if err != nil {
+		return fmt.Errorf("failed to connect to Kafka: %w", err)
+	}
+
+	defer client.Close()
+
+	if err := client.Ping(); err != nil {
+		return fmt.Errorf("failed to connect to Kafka: %w", err)
+	}
+
+	ctx.Integration.Ready()
+	return nil
+}
+
+func (k *Kafka) HandleRequest(ctx core.HTTPRequestContext) {
+	// no-op
+}
+
+func (k *Kafka) ListResources(resourceType string, ctx core.ListResourcesContext) ([]core.IntegrationResource, error) {
+	return []core.IntegrationResource{}, nil
+}
+
+func (k *Kafka) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (k *Kafka) HandleAction(ctx core.IntegrationActionContext) error {
+	return nil
+}
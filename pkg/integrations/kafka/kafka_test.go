@@ -0,0 +1,102 @@
+package kafka
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__Kafka__Sync(t *testing.T) {
+	integration := &Kafka{}
+
+	t.Run("missing brokers -> error", func(t *testing.T) {
+		err := integration.Sync(core.SyncContext{
+			Configuration: map[string]any{"brokers": " , "},
+			Integration:   &contexts.IntegrationContext{},
+		})
+
+		require.ErrorContains(t, err, "at least one broker is required")
+	})
+
+	t.Run("unsupported SASL mechanism -> error", func(t *testing.T) {
+		err := integration.Sync(core.SyncContext{
+			Configuration: map[string]any{"brokers": "kafka:9092", "saslMechanism": "gssapi"},
+			Integration:   &contexts.IntegrationContext{},
+		})
+
+		require.ErrorContains(t, err, "unsupported SASL mechanism")
+	})
+
+	t.Run("SASL without username -> error", func(t *testing.T) {
+		err := integration.Sync(core.SyncContext{
+			Configuration: map[string]any{"brokers": "kafka:9092", "saslMechanism": SASLMechanismPlain},
+			Integration:   &contexts.IntegrationContext{},
+		})
+
+		require.ErrorContains(t, err, "username is required")
+	})
+
+	t.Run("connection fails -> error", func(t *testing.T) {
+		useFakeClient(t, &fakeClient{PingErr: errConnectionRefused}, nil)
+		integrationCtx := &contexts.IntegrationContext{Configuration: integrationConfig()}
+		err := integration.Sync(core.SyncContext{
+			Configuration: integrationConfig(),
+			Integration:   integrationCtx,
+		})
+
+		require.ErrorContains(t, err, "connection refused")
+		assert.NotEqual(t, "ready", integrationCtx.State)
+	})
+
+	t.Run("connection succeeds -> ready", func(t *testing.T) {
+		client := &fakeClient{}
+		useFakeClient(t, client, nil)
+		integrationCtx := &contexts.IntegrationContext{Configuration: integrationConfig()}
+		err := integration.Sync(core.SyncContext{
+			Configuration: integrationConfig(),
+			Integration:   integrationCtx,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "ready", integrationCtx.State)
+		assert.True(t, client.Closed)
+		assert.Equal(t, []string{"kafka-1:9092", "kafka-2:9092"}, client.Config.Brokers)
+		assert.Nil(t, client.Config.SASL)
+		assert.Nil(t, client.Config.TLS)
+	})
+}
+
+func Test__Kafka__ConnectionConfig(t *testing.T) {
+	t.Run("SASL and TLS", func(t *testing.T) {
+		config, err := connectionConfig(&contexts.IntegrationContext{
+			Configuration: map[string]any{
+				"brokers":       "kafka:9093",
+				"saslMechanism": SASLMechanismScramSHA512,
+				"username":      "superplane",
+				"password":      "secret",
+				"useTLS":        "true",
+			},
+		})
+
+		require.NoError(t, err)
+		require.NotNil(t, config.SASL)
+		assert.Equal(t, "SCRAM-SHA-512", config.SASL.Name())
+		require.NotNil(t, config.TLS)
+		assert.Nil(t, config.TLS.RootCAs)
+	})
+
+	t.Run("invalid CA certificate -> error", func(t *testing.T) {
+		_, err := connectionConfig(&contexts.IntegrationContext{
+			Configuration: map[string]any{
+				"brokers":       "kafka:9093",
+				"useTLS":        "true",
+				"caCertificate": "not a certificate",
+			},
+		})
+
+		require.ErrorContains(t, err, "invalid CA certificate")
+	})
+}
@@ -0,0 +1,236 @@
+package kafka
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/segmentio/kafka-go"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const (
+	OnMessageTriggerName      = "kafka.onMessage"
+	OnMessageEmittedEventType = "kafka.message"
+	OnMessageReceiveAction    = "receive"
+
+	StartFromLatest   = "latest"
+	StartFromEarliest = "earliest"
+)
+
+type OnMessage struct{}
+
+type OnMessageConfiguration struct {
+	Topic     string `json:"topic" mapstructure:"topic"`
+	GroupID   string `json:"groupId" mapstructure:"groupId"`
+	StartFrom string `json:"startFrom" mapstructure:"startFrom"`
+}
+
+// OnMessageMetadata holds the topic and the consumer group the trigger consumes with.
+// Managed groups are created for the trigger when no group is configured.
+type OnMessageMetadata struct {
+	Topic   string `json:"topic" mapstructure:"topic"`
+	GroupID string `json:"groupId" mapstructure:"groupId"`
+	Managed bool   `json:"managed" mapstructure:"managed"`
+}
+
+func (t *OnMessage) Name() string {
+	return OnMessageTriggerName
+}
+
+func (t *OnMessage) Label() string {
+	return "On Message"
+}
+
+func (t *OnMessage) Description() string {
+	return "Trigger a workflow when a message is published to a Kafka topic"
+}
+
+func (t *OnMessage) Documentation() string {
+	return `The On Message trigger starts a workflow execution for each message published to a Kafka topic.
+
+## Use Cases
+
+- **Deploy pipelines**: Start workflows from deploy events flowing through Kafka
+- **Event-driven workflows**: React to domain events published by your services
+- **System integration**: Connect systems already using Kafka without exposing HTTP endpoints
+
+## How It Works
+
+- Messages are consumed as they are published, as a member of a Kafka consumer group.
+- **Consumer Group** sets the group to join. Triggers and other consumers in the same group share the messages of the topic. If empty, a group is created for the trigger, and kept when the configuration changes.
+- Offsets are committed to the consumer group after the event for the message is stored, so messages are not skipped when SuperPlane restarts. A message may be emitted again if SuperPlane stops before its offset is committed.
+- If the event cannot be stored because the database is unavailable, the message is read again later. Messages that can never be stored, like messages for a deleted trigger, are logged and skipped, so they do not block the partition.
+- **Start From** controls where the group starts reading when it has no committed offsets: only new messages, or all the messages still retained in the topic.
+
+## Event Data
+
+Each event contains:
+- ` + "`value`" + `: The message value, parsed as JSON when possible
+- ` + "`key`" + `: The message key
+- ` + "`headers`" + `: Message headers
+- ` + "`topic`" + `, ` + "`partition`" + ` and ` + "`offset`" + `: Where the message is stored
+- ` + "`timestamp`" + `: When the message was produced`
+}
+
+func (t *OnMessage) Icon() string {
+	return "kafka"
+}
+
+func (t *OnMessage) Color() string {
+	return "gray"
+}
+
+func (t *OnMessage) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:        "topic",
+			Label:       "Topic",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Description: "Topic to consume messages from",
+		},
+		{
+			Name:        "groupId",
+			Label:       "Consumer Group",
+			Type:        configuration.FieldTypeString,
+			Required:    false,
+			Description: "Consumer group to join. Leave empty to use a group for this trigger",
+		},
+		{
+			Name:        "startFrom",
+			Label:       "Start From",
+			Type:        configuration.FieldTypeSelect,
+			Required:    true,
+			Default:     StartFromLatest,
+			Description: "Where to start reading when the consumer group has no committed offsets",
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "New messages", Value: StartFromLatest},
+						{Label: "Earliest retained message", Value: StartFromEarliest},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (t *OnMessage) Setup(ctx core.TriggerContext) error {
+	var config OnMessageConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.Topic == "" {
+		return fmt.Errorf("topic is required")
+	}
+
+	if config.StartFrom != "" && config.StartFrom != StartFromLatest && config.StartFrom != StartFromEarliest {
+		return fmt.Errorf("invalid start from: %s", config.StartFrom)
+	}
+
+	var metadata OnMessageMetadata
+	if err := mapstructure.Decode(ctx.Metadata.Get(), &metadata); err != nil {
+		return fmt.Errorf("failed to decode metadata: %w", err)
+	}
+
+	desired := OnMessageMetadata{
+		Topic:   config.Topic,
+		GroupID: config.GroupID,
+	}
+
+	//
+	// If no consumer group is given, we manage one for the trigger.
+	// The same group is kept across configuration changes,
+	// so the offsets committed so far are not lost.
+	//
+	if desired.GroupID == "" {
+		desired.Managed = true
+		desired.GroupID = metadata.GroupID
+		if !metadata.Managed || metadata.GroupID == "" {
+			desired.GroupID = "superplane." + uuid.NewString()
+		}
+	}
+
+	if desired == metadata {
+		return nil
+	}
+
+	client, err := NewClient(ctx.Integration)
+	if err != nil {
+		return fmt.Errorf("failed to connect to Kafka: %w", err)
+	}
+
+	defer client.Close()
+
+	if _, err := client.Partitions(desired.Topic); err != nil {
+		return err
+	}
+
+	if err := ctx.Metadata.Set(desired); err != nil {
+		return fmt.Errorf("failed to set metadata: %w", err)
+	}
+
+	return nil
+}
+
+func (t *OnMessage) Actions() []core.Action {
+	return []core.Action{
+		{
+			Name:           OnMessageReceiveAction,
+			UserAccessible: false,
+		},
+	}
+}
+
+// HandleAction is called by the Kafka consumer worker for every message fetched,
+// with the payload built by BuildMessagePayload as parameters,
+// in the transaction that must be committed before the message offset is.
+func (t *OnMessage) HandleAction(ctx core.TriggerActionContext) (map[string]any, error) {
+	switch ctx.Name {
+	case OnMessageReceiveAction:
+		return nil, ctx.Events.Emit(OnMessageEmittedEventType, ctx.Parameters)
+	}
+
+	return nil, fmt.Errorf("action %s not supported", ctx.Name)
+}
+
+// BuildMessagePayload builds the event payload for a fetched message.
+func BuildMessagePayload(message kafka.Message) map[string]any {
+	var value any
+	if err := json.Unmarshal(message.Value, &value); err != nil {
+		value = string(message.Value)
+	}
+
+	headers := map[string]any{}
+	for _, header := range message.Headers {
+		headers[header.Key] = string(header.Value)
+	}
+
+	payload := map[string]any{
+		"topic":     message.Topic,
+		"partition": message.Partition,
+		"offset":    message.Offset,
+		"key":       string(message.Key),
+		"headers":   headers,
+		"value":     value,
+	}
+
+	if !message.Time.IsZero() {
+		payload["timestamp"] = message.Time.UTC().Format(time.RFC3339)
+	}
+
+	return payload
+}
+
+func (t *OnMessage) HandleWebhook(ctx core.WebhookRequestContext) (int, *core.WebhookResponseBody, error) {
+	return 200, nil, nil
+}
+
+func (t *OnMessage) Cleanup(ctx core.TriggerContext) error {
+	return nil
+}
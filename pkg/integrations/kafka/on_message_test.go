@@ -0,0 +1,187 @@
+package kafka
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/test/support/contexts"
+)
+
+type failingEventContext struct{}
+
+func (f *failingEventContext) Emit(payloadType string, payload any) error {
+	return fmt.Errorf("database is down")
+}
+
+func Test__OnMessage__Setup(t *testing.T) {
+	trigger := &OnMessage{}
+
+	t.Run("topic is required", func(t *testing.T) {
+		err := trigger.Setup(core.TriggerContext{
+			Configuration: map[string]any{"startFrom": StartFromLatest},
+			Metadata:      &contexts.MetadataContext{},
+			Integration:   &contexts.IntegrationContext{Configuration: integrationConfig()},
+		})
+
+		require.ErrorContains(t, err, "topic is required")
+	})
+
+	t.Run("no consumer group -> managed group is created", func(t *testing.T) {
+		client := &fakeClient{PartitionID: []int{0, 1}}
+		useFakeClient(t, client, nil)
+		metadata := &contexts.MetadataContext{}
+
+		err := trigger.Setup(core.TriggerContext{
+			Configuration: map[string]any{"topic": "deployments", "startFrom": StartFromLatest},
+			Metadata:      metadata,
+			Integration:   &contexts.IntegrationContext{Configuration: integrationConfig()},
+		})
+
+		require.NoError(t, err)
+		m, ok := metadata.Metadata.(OnMessageMetadata)
+		require.True(t, ok)
+		assert.Equal(t, "deployments", m.Topic)
+		assert.True(t, m.Managed)
+		assert.Contains(t, m.GroupID, "superplane.")
+		assert.True(t, client.Closed)
+	})
+
+	t.Run("changing topic keeps managed group", func(t *testing.T) {
+		useFakeClient(t, &fakeClient{PartitionID: []int{0}}, nil)
+		metadata := &contexts.MetadataContext{
+			Metadata: OnMessageMetadata{Topic: "deployments", GroupID: "superplane.existing", Managed: true},
+		}
+
+		err := trigger.Setup(core.TriggerContext{
+			Configuration: map[string]any{"topic": "releases", "startFrom": StartFromLatest},
+			Metadata:      metadata,
+			Integration:   &contexts.IntegrationContext{Configuration: integrationConfig()},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, OnMessageMetadata{Topic: "releases", GroupID: "superplane.existing", Managed: true}, metadata.Metadata)
+	})
+
+	t.Run("consumer group is configured", func(t *testing.T) {
+		useFakeClient(t, &fakeClient{PartitionID: []int{0}}, nil)
+		metadata := &contexts.MetadataContext{
+			Metadata: OnMessageMetadata{Topic: "deployments", GroupID: "superplane.existing", Managed: true},
+		}
+
+		err := trigger.Setup(core.TriggerContext{
+			Configuration: map[string]any{"topic": "deployments", "groupId": "deployers", "startFrom": StartFromEarliest},
+			Metadata:      metadata,
+			Integration:   &contexts.IntegrationContext{Configuration: integrationConfig()},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, OnMessageMetadata{Topic: "deployments", GroupID: "deployers"}, metadata.Metadata)
+	})
+
+	t.Run("unknown topic -> error", func(t *testing.T) {
+		useFakeClient(t, &fakeClient{PartitionsErr: fmt.Errorf("unknown topic")}, nil)
+		metadata := &contexts.MetadataContext{}
+
+		err := trigger.Setup(core.TriggerContext{
+			Configuration: map[string]any{"topic": "deployments", "startFrom": StartFromLatest},
+			Metadata:      metadata,
+			Integration:   &contexts.IntegrationContext{Configuration: integrationConfig()},
+		})
+
+		require.ErrorContains(t, err, "unknown topic")
+		assert.Nil(t, metadata.Metadata)
+	})
+
+	t.Run("unchanged configuration does not connect", func(t *testing.T) {
+		useFakeClient(t, &fakeClient{}, errConnectionRefused)
+		existing := OnMessageMetadata{Topic: "deployments", GroupID: "deployers"}
+		metadata := &contexts.MetadataContext{Metadata: existing}
+
+		err := trigger.Setup(core.TriggerContext{
+			Configuration: map[string]any{"topic": "deployments", "groupId": "deployers", "startFrom": StartFromEarliest},
+			Metadata:      metadata,
+			Integration:   &contexts.IntegrationContext{Configuration: integrationConfig()},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, existing, metadata.Metadata)
+	})
+}
+
+func Test__OnMessage__Receive(t *testing.T) {
+	trigger := &OnMessage{}
+	logger := logrus.NewEntry(logrus.New())
+
+	t.Run("emits event for message", func(t *testing.T) {
+		message := messages("deployments", 2, 41, 1)[0]
+		message.Headers = []kafka.Header{{Key: "source", Value: []byte("ci")}}
+
+		events := &contexts.EventContext{}
+		_, err := trigger.HandleAction(core.TriggerActionContext{
+			Name:          OnMessageReceiveAction,
+			Parameters:    BuildMessagePayload(message),
+			Logger:        logger,
+			Configuration: map[string]any{"topic": "deployments", "startFrom": StartFromLatest},
+			Metadata:      &contexts.MetadataContext{Metadata: OnMessageMetadata{Topic: "deployments", GroupID: "deployers"}},
+			Events:        events,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, 1, events.Count())
+		assert.Equal(t, OnMessageEmittedEventType, events.Payloads[0].Type)
+
+		payload := events.Payloads[0].Data.(map[string]any)
+		assert.Equal(t, "deployments", payload["topic"])
+		assert.Equal(t, 2, payload["partition"])
+		assert.Equal(t, int64(41), payload["offset"])
+		assert.Equal(t, "key-0", payload["key"])
+		assert.Equal(t, map[string]any{"n": float64(0)}, payload["value"])
+		assert.Equal(t, map[string]any{"source": "ci"}, payload["headers"])
+	})
+
+	t.Run("plain text value", func(t *testing.T) {
+		payload := BuildMessagePayload(kafka.Message{Value: []byte("plain text")})
+		assert.Equal(t, "plain text", payload["value"])
+	})
+
+	t.Run("event errors are returned, so the offset is not committed", func(t *testing.T) {
+		_, err := trigger.HandleAction(core.TriggerActionContext{
+			Name:       OnMessageReceiveAction,
+			Parameters: BuildMessagePayload(kafka.Message{Value: []byte("{}")}),
+			Logger:     logger,
+			Events:     &failingEventContext{},
+		})
+
+		require.ErrorContains(t, err, "database is down")
+	})
+}
+
+func Test__Client__Consumer(t *testing.T) {
+	client, err := connect(ConnectionConfig{Brokers: []string{"localhost:9092"}})
+	require.NoError(t, err)
+
+	t.Run("joins consumer group", func(t *testing.T) {
+		reader, ok := client.Consumer("deployments", "deployers", StartFromLatest).(*kafka.Reader)
+		require.True(t, ok)
+		defer reader.Close()
+
+		config := reader.Config()
+		assert.Equal(t, "deployments", config.Topic)
+		assert.Equal(t, "deployers", config.GroupID)
+		assert.Equal(t, kafka.LastOffset, config.StartOffset)
+		assert.Zero(t, config.CommitInterval)
+	})
+
+	t.Run("earliest -> starts from first offset", func(t *testing.T) {
+		reader, ok := client.Consumer("deployments", "deployers", StartFromEarliest).(*kafka.Reader)
+		require.True(t, ok)
+		defer reader.Close()
+
+		assert.Equal(t, kafka.FirstOffset, reader.Config().StartOffset)
+	})
+}
@@ -0,0 +1,296 @@
+package kafka
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/segmentio/kafka-go"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const (
+	ProduceMessageFormatJSON = "json"
+	ProduceMessageFormatText = "text"
+)
+
+type ProduceMessage struct{}
+
+type ProduceMessageConfiguration struct {
+	Topic   string   `json:"topic" mapstructure:"topic"`
+	Key     string   `json:"key" mapstructure:"key"`
+	Format  string   `json:"format" mapstructure:"format"`
+	JSON    *any     `json:"json" mapstructure:"json"`
+	Text    *string  `json:"text" mapstructure:"text"`
+	Headers []Header `json:"headers" mapstructure:"headers"`
+}
+
+type Header struct {
+	Name  string `json:"name" mapstructure:"name"`
+	Value string `json:"value" mapstructure:"value"`
+}
+
+func (c *ProduceMessage) Name() string {
+	return "kafka.produceMessage"
+}
+
+func (c *ProduceMessage) Label() string {
+	return "Produce Message"
+}
+
+func (c *ProduceMessage) Description() string {
+	return "Produce a message to a Kafka topic"
+}
+
+func (c *ProduceMessage) Documentation() string {
+	return `The Produce Message component produces a message to a Kafka topic.
+
+## Use Cases
+
+- **Deploy events**: Publish deployment results for other systems to consume
+- **Event-driven systems**: Notify your services about workflow results
+- **Auditing**: Record workflow activity in a Kafka topic
+
+## Configuration
+
+- **Topic**: The topic to produce to
+- **Key**: Optional message key. Messages with the same key always go to the same partition, so they are consumed in order.
+- **Message Format**: Produce a JSON object or plain text
+- **Headers**: Optional message headers
+
+The key, headers and message support expressions, so they can be built from the payloads of previous nodes.
+
+## Output
+
+Returns the topic and key of the produced message.`
+}
+
+func (c *ProduceMessage) Icon() string {
+	return "kafka"
+}
+
+func (c *ProduceMessage) Color() string {
+	return "gray"
+}
+
+func (c *ProduceMessage) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *ProduceMessage) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:        "topic",
+			Label:       "Topic",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Description: "Topic to produce to",
+		},
+		{
+			Name:        "key",
+			Label:       "Key",
+			Type:        configuration.FieldTypeString,
+			Required:    false,
+			Togglable:   true,
+			Description: "Message key, used to pick the partition",
+		},
+		{
+			Name:     "format",
+			Label:    "Message Format",
+			Type:     configuration.FieldTypeSelect,
+			Required: true,
+			Default:  ProduceMessageFormatJSON,
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Value: ProduceMessageFormatJSON, Label: "JSON"},
+						{Value: ProduceMessageFormatText, Label: "Text"},
+					},
+				},
+			},
+		},
+		{
+			Name:     "json",
+			Label:    "JSON Message",
+			Type:     configuration.FieldTypeObject,
+			Required: false,
+			Default:  map[string]any{},
+			VisibilityConditions: []configuration.VisibilityCondition{
+				{Field: "format", Values: []string{ProduceMessageFormatJSON}},
+			},
+		},
+		{
+			Name:     "text",
+			Label:    "Text Message",
+			Type:     configuration.FieldTypeText,
+			Required: false,
+			VisibilityConditions: []configuration.VisibilityCondition{
+				{Field: "format", Values: []string{ProduceMessageFormatText}},
+			},
+		},
+		{
+			Name:        "headers",
+			Label:       "Headers",
+			Type:        configuration.FieldTypeList,
+			Required:    false,
+			Togglable:   true,
+			Description: "Headers to send with the message",
+			TypeOptions: &configuration.TypeOptions{
+				List: &configuration.ListTypeOptions{
+					ItemLabel: "Header",
+					ItemDefinition: &configuration.ListItemDefinition{
+						Type: configuration.FieldTypeObject,
+						Schema: []configuration.Field{
+							{
+								Name:     "name",
+								Type:     configuration.FieldTypeString,
+								Label:    "Header Name",
+								Required: true,
+							},
+							{
+								Name:     "value",
+								Type:     configuration.FieldTypeString,
+								Label:    "Header Value",
+								Required: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (c *ProduceMessage) Setup(ctx core.SetupContext) error {
+	var config ProduceMessageConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	return config.validate()
+}
+
+func (c ProduceMessageConfiguration) validate() error {
+	if c.Topic == "" {
+		return fmt.Errorf("topic is required")
+	}
+
+	if c.Format == "" {
+		return fmt.Errorf("format is required")
+	}
+
+	if c.Format == ProduceMessageFormatJSON && c.JSON == nil {
+		return fmt.Errorf("JSON message is required")
+	}
+
+	if c.Format == ProduceMessageFormatText && c.Text == nil {
+		return fmt.Errorf("text message is required")
+	}
+
+	for _, header := range c.Headers {
+		if header.Name == "" {
+			return fmt.Errorf("header name is required")
+		}
+	}
+
+	return nil
+}
+
+func (c *ProduceMessage) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *ProduceMessage) Execute(ctx core.ExecutionContext) error {
+	var config ProduceMessageConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if err := config.validate(); err != nil {
+		return err
+	}
+
+	message, err := buildMessage(config)
+	if err != nil {
+		return err
+	}
+
+	client, err := NewClient(ctx.Integration)
+	if err != nil {
+		return fmt.Errorf("failed to connect to Kafka: %w", err)
+	}
+
+	defer client.Close()
+
+	err = client.Write(message)
+	if err != nil {
+		return fmt.Errorf("failed to produce message: %w", err)
+	}
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"kafka.message.produced",
+		[]any{
+			map[string]any{
+				"topic":      config.Topic,
+				"key":        config.Key,
+				"producedAt": message.Time.Format(time.RFC3339),
+			},
+		},
+	)
+}
+
+func buildMessage(config ProduceMessageConfiguration) (kafka.Message, error) {
+	message := kafka.Message{
+		Topic: config.Topic,
+		Time:  time.Now().UTC(),
+	}
+
+	if config.Key != "" {
+		message.Key = []byte(config.Key)
+	}
+
+	if config.Format == ProduceMessageFormatText {
+		message.Value = []byte(*config.Text)
+	} else {
+		value, err := json.Marshal(*config.JSON)
+		if err != nil {
+			return message, fmt.Errorf("failed to marshal JSON message: %w", err)
+		}
+
+		message.Value = value
+	}
+
+	for _, header := range config.Headers {
+		message.Headers = append(message.Headers, kafka.Header{
+			Key:   header.Name,
+			Value: []byte(header.Value),
+		})
+	}
+
+	return message, nil
+}
+
+func (c *ProduceMessage) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *ProduceMessage) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *ProduceMessage) HandleWebhook(ctx core.WebhookRequestContext) (int, *core.WebhookResponseBody, error) {
+	return http.StatusOK, nil, nil
+}
+
+func (c *ProduceMessage) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *ProduceMessage) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,124 @@
+package kafka
+
+import (
+	"testing"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__ProduceMessage__Setup(t *testing.T) {
+	component := &ProduceMessage{}
+
+	t.Run("missing topic -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Configuration: map[string]any{"format": "json", "json": map[string]any{}},
+		})
+
+		require.ErrorContains(t, err, "topic is required")
+	})
+
+	t.Run("missing text -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Configuration: map[string]any{"topic": "deployments", "format": "text"},
+		})
+
+		require.ErrorContains(t, err, "text message is required")
+	})
+
+	t.Run("header without name -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Configuration: map[string]any{
+				"topic":   "deployments",
+				"format":  "text",
+				"text":    "hello",
+				"headers": []map[string]any{{"name": "", "value": "x"}},
+			},
+		})
+
+		require.ErrorContains(t, err, "header name is required")
+	})
+
+	t.Run("valid configuration", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Configuration: map[string]any{"topic": "deployments", "format": "json", "json": map[string]any{"a": 1}},
+		})
+
+		require.NoError(t, err)
+	})
+}
+
+func Test__ProduceMessage__Execute(t *testing.T) {
+	component := &ProduceMessage{}
+
+	t.Run("produces JSON message with key and headers", func(t *testing.T) {
+		client := &fakeClient{}
+		useFakeClient(t, client, nil)
+		state := &contexts.ExecutionStateContext{KVs: map[string]string{}}
+
+		err := component.Execute(core.ExecutionContext{
+			Configuration: map[string]any{
+				"topic":  "deployments",
+				"key":    "api",
+				"format": "json",
+				"json":   map[string]any{"service": "api"},
+				"headers": []map[string]any{
+					{"name": "source", "value": "superplane"},
+				},
+			},
+			Integration:    &contexts.IntegrationContext{Configuration: integrationConfig()},
+			ExecutionState: state,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, client.Written, 1)
+
+		written := client.Written[0]
+		assert.Equal(t, "deployments", written.Topic)
+		assert.Equal(t, "api", string(written.Key))
+		assert.Equal(t, `{"service":"api"}`, string(written.Value))
+		assert.Equal(t, []kafka.Header{{Key: "source", Value: []byte("superplane")}}, written.Headers)
+
+		assert.True(t, state.Passed)
+		assert.Equal(t, "kafka.message.produced", state.Type)
+		assert.True(t, client.Closed)
+	})
+
+	t.Run("produces text message without key", func(t *testing.T) {
+		client := &fakeClient{}
+		useFakeClient(t, client, nil)
+		state := &contexts.ExecutionStateContext{KVs: map[string]string{}}
+
+		err := component.Execute(core.ExecutionContext{
+			Configuration: map[string]any{
+				"topic":  "deployments",
+				"format": "text",
+				"text":   "hello",
+			},
+			Integration:    &contexts.IntegrationContext{Configuration: integrationConfig()},
+			ExecutionState: state,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, client.Written, 1)
+		assert.Nil(t, client.Written[0].Key)
+		assert.Equal(t, "hello", string(client.Written[0].Value))
+	})
+
+	t.Run("write error -> error", func(t *testing.T) {
+		useFakeClient(t, &fakeClient{WriteErr: errConnectionRefused}, nil)
+		state := &contexts.ExecutionStateContext{KVs: map[string]string{}}
+
+		err := component.Execute(core.ExecutionContext{
+			Configuration:  map[string]any{"topic": "deployments", "format": "text", "text": "hello"},
+			Integration:    &contexts.IntegrationContext{Configuration: integrationConfig()},
+			ExecutionState: state,
+		})
+
+		require.ErrorContains(t, err, "failed to produce message")
+		assert.False(t, state.Finished)
+	})
+}
@@ -0,0 +1,90 @@
+package kafka
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/segmentio/kafka-go"
+)
+
+type fakeClient struct {
+	Config        ConnectionConfig
+	PartitionID   []int
+	PartitionsErr error
+	Written       []kafka.Message
+	PingErr       error
+	WriteErr      error
+	Closed        bool
+}
+
+func (c *fakeClient) Ping() error {
+	return c.PingErr
+}
+
+func (c *fakeClient) Partitions(topic string) ([]int, error) {
+	if c.PartitionsErr != nil {
+		return nil, c.PartitionsErr
+	}
+
+	return c.PartitionID, nil
+}
+
+func (c *fakeClient) Consumer(topic, groupID, startFrom string) Consumer {
+	return nil
+}
+
+func (c *fakeClient) Write(message kafka.Message) error {
+	if c.WriteErr != nil {
+		return c.WriteErr
+	}
+
+	c.Written = append(c.Written, message)
+	return nil
+}
+
+func (c *fakeClient) Close() error {
+	c.Closed = true
+	return nil
+}
+
+// useFakeClient replaces the connector for the duration of the test.
+// If connectErr is set, connecting fails with it.
+func useFakeClient(t *testing.T, client *fakeClient, connectErr error) {
+	original := connect
+	connect = func(config ConnectionConfig) (Client, error) {
+		if connectErr != nil {
+			return nil, connectErr
+		}
+
+		client.Config = config
+		return client, nil
+	}
+
+	t.Cleanup(func() { connect = original })
+}
+
+// messages builds n messages for a partition, starting at the given offset.
+func messages(topic string, partition int, first int64, n int) []kafka.Message {
+	result := []kafka.Message{}
+	for i := 0; i < n; i++ {
+		result = append(result, kafka.Message{
+			Topic:     topic,
+			Partition: partition,
+			Offset:    first + int64(i),
+			Key:       []byte(fmt.Sprintf("key-%d", i)),
+			Value:     []byte(fmt.Sprintf(`{"n":%d}`, i)),
+		})
+	}
+
+	return result
+}
+
+func integrationConfig() map[string]any {
+	return map[string]any{
+		"brokers":       "kafka-1:9092, kafka-2:9092",
+		"saslMechanism": SASLMechanismNone,
+		"useTLS":        "false",
+	}
+}
+
+var errConnectionRefused = fmt.Errorf("connection refused")
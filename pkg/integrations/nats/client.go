@@ -0,0 +1,263 @@
+package nats
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"strings"
+	"time"
+
+	natsio "github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/nats-io/nkeys"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const (
+	ConnectionName = "SuperPlane"
+	DialTimeout    = 10 * time.Second
+	RequestTimeout = 10 * time.Second
+)
+
+// Client is the subset of NATS and JetStream operations used by the integration.
+type Client interface {
+	Ping() error
+	Publish(msg *natsio.Msg) error
+	PublishJetStream(msg *natsio.Msg) (*jetstream.PubAck, error)
+	CreateOrUpdateConsumer(stream string, config jetstream.ConsumerConfig) error
+	CheckConsumer(stream, consumer string) error
+	DeleteConsumer(stream, consumer string) error
+	Fetch(stream, consumer string, max int) ([]jetstream.Msg, error)
+	Subscribe(subject, queue string, handler natsio.MsgHandler) (*natsio.Subscription, error)
+	Close()
+}
+
+type connector func(servers string, options []natsio.Option) (Client, error)
+
+var connect connector = func(servers string, options []natsio.Option) (Client, error) {
+	conn, err := natsio.Connect(servers, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	js, err := jetstream.New(conn)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	return &connectionClient{conn: conn, js: js}, nil
+}
+
+// NewClient connects to the NATS servers configured in the integration.
+// Additional options can be given to tune the connection,
+// e.g. for long-lived connections that should always reconnect.
+func NewClient(ctx core.IntegrationContext, extra ...natsio.Option) (Client, error) {
+	servers, options, err := connectionOptions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect(servers, append(options, extra...))
+}
+
+func connectionOptions(ctx core.IntegrationContext) (string, []natsio.Option, error) {
+	serversConfig, err := ctx.GetConfig("servers")
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to get servers: %w", err)
+	}
+
+	servers := parseServers(string(serversConfig))
+	if len(servers) == 0 {
+		return "", nil, fmt.Errorf("at least one server is required")
+	}
+
+	options := []natsio.Option{
+		natsio.Name(ConnectionName),
+		natsio.Timeout(DialTimeout),
+	}
+
+	// Optional fields
+	authentication, _ := ctx.GetConfig("authentication")
+	useTLS, _ := ctx.GetConfig("useTLS")
+	caCertificate, _ := ctx.GetConfig("caCertificate")
+
+	authOption, err := authenticationOption(ctx, string(authentication))
+	if err != nil {
+		return "", nil, err
+	}
+
+	if authOption != nil {
+		options = append(options, authOption)
+	}
+
+	if string(useTLS) == "true" {
+		tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+		if len(strings.TrimSpace(string(caCertificate))) > 0 {
+			pool := x509.NewCertPool()
+			if !pool.AppendCertsFromPEM(caCertificate) {
+				return "", nil, fmt.Errorf("invalid CA certificate")
+			}
+
+			tlsConfig.RootCAs = pool
+		}
+
+		options = append(options, natsio.Secure(tlsConfig))
+	}
+
+	return strings.Join(servers, ","), options, nil
+}
+
+func authenticationOption(ctx core.IntegrationContext, authentication string) (natsio.Option, error) {
+	switch authentication {
+	case "", AuthenticationNone:
+		return nil, nil
+
+	case AuthenticationToken:
+		token, err := ctx.GetConfig("token")
+		if err != nil {
+			return nil, fmt.Errorf("failed to get token: %w", err)
+		}
+
+		return natsio.Token(string(token)), nil
+
+	case AuthenticationUserPassword:
+		username, err := ctx.GetConfig("username")
+		if err != nil {
+			return nil, fmt.Errorf("failed to get username: %w", err)
+		}
+
+		password, err := ctx.GetConfig("password")
+		if err != nil {
+			return nil, fmt.Errorf("failed to get password: %w", err)
+		}
+
+		return natsio.UserInfo(string(username), string(password)), nil
+
+	case AuthenticationCredentials:
+		credentials, err := ctx.GetConfig("credentials")
+		if err != nil {
+			return nil, fmt.Errorf("failed to get credentials: %w", err)
+		}
+
+		return credentialsOption(credentials)
+
+	default:
+		return nil, fmt.Errorf("unsupported authentication: %s", authentication)
+	}
+}
+
+// credentialsOption uses the contents of a .creds file,
+// since the integration does not have a file to point the client to.
+func credentialsOption(credentials []byte) (natsio.Option, error) {
+	jwt, err := nkeys.ParseDecoratedJWT(credentials)
+	if err != nil {
+		return nil, fmt.Errorf("invalid credentials: %w", err)
+	}
+
+	keyPair, err := nkeys.ParseDecoratedNKey(credentials)
+	if err != nil {
+		return nil, fmt.Errorf("invalid credentials: %w", err)
+	}
+
+	seed, err := keyPair.Seed()
+	if err != nil {
+		return nil, fmt.Errorf("invalid credentials: %w", err)
+	}
+
+	return natsio.UserJWTAndSeed(jwt, string(seed)), nil
+}
+
+func parseServers(servers string) []string {
+	result := []string{}
+	for _, server := range strings.Split(servers, ",") {
+		server = strings.TrimSpace(server)
+		if server != "" {
+			result = append(result, server)
+		}
+	}
+
+	return result
+}
+
+type connectionClient struct {
+	conn *natsio.Conn
+	js   jetstream.JetStream
+}
+
+func (c *connectionClient) Ping() error {
+	return c.conn.FlushTimeout(RequestTimeout)
+}
+
+func (c *connectionClient) Publish(msg *natsio.Msg) error {
+	if err := c.conn.PublishMsg(msg); err != nil {
+		return err
+	}
+
+	//
+	// Core NATS publishing is asynchronous,
+	// so we flush to know the message reached the server.
+	//
+	return c.conn.FlushTimeout(RequestTimeout)
+}
+
+func (c *connectionClient) PublishJetStream(msg *natsio.Msg) (*jetstream.PubAck, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), RequestTimeout)
+	defer cancel()
+	return c.js.PublishMsg(ctx, msg)
+}
+
+func (c *connectionClient) CreateOrUpdateConsumer(stream string, config jetstream.ConsumerConfig) error {
+	ctx, cancel := context.WithTimeout(context.Background(), RequestTimeout)
+	defer cancel()
+	_, err := c.js.CreateOrUpdateConsumer(ctx, stream, config)
+	return err
+}
+
+func (c *connectionClient) CheckConsumer(stream, consumer string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), RequestTimeout)
+	defer cancel()
+	_, err := c.js.Consumer(ctx, stream, consumer)
+	return err
+}
+
+func (c *connectionClient) DeleteConsumer(stream, consumer string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), RequestTimeout)
+	defer cancel()
+	return c.js.DeleteConsumer(ctx, stream, consumer)
+}
+
+func (c *connectionClient) Fetch(stream, consumer string, max int) ([]jetstream.Msg, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), RequestTimeout)
+	defer cancel()
+
+	cons, err := c.js.Consumer(ctx, stream, consumer)
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := cons.FetchNoWait(max)
+	if err != nil {
+		return nil, err
+	}
+
+	messages := []jetstream.Msg{}
+	for msg := range batch.Messages() {
+		messages = append(messages, msg)
+	}
+
+	return messages, batch.Error()
+}
+
+func (c *connectionClient) Subscribe(subject, queue string, handler natsio.MsgHandler) (*natsio.Subscription, error) {
+	if queue == "" {
+		return c.conn.Subscribe(subject, handler)
+	}
+
+	return c.conn.QueueSubscribe(subject, queue, handler)
+}
+
+func (c *connectionClient) Close() {
+	c.conn.Close()
+}
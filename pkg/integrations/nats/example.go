@@ -0,0 +1,28 @@
+package nats
+
+import (
+	_ "embed"
+	"sync"
+
+	"github.com/superplanehq/superplane/pkg/utils"
+)
+
+//go:embed example_data_on_message.json
+var exampleDataOnMessageBytes []byte
+
+var exampleDataOnMessageOnce sync.Once
+var exampleDataOnMessage map[string]any
+
+//go:embed example_output_publish_message.json
+var exampleOutputPublishMessageBytes []byte
+
+var exampleOutputPublishMessageOnce sync.Once
+var exampleOutputPublishMessage map[string]any
+
+func (t *OnMessage) ExampleData() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleDataOnMessageOnce, exampleDataOnMessageBytes, &exampleDataOnMessage)
+}
+
+func (c *PublishMessage) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputPublishMessageOnce, exampleOutputPublishMessageBytes, &exampleOutputPublishMessage)
+}
@@ -0,0 +1,230 @@
+package nats
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/registry"
+)
+
+const (
+	AuthenticationNone         = "none"
+	AuthenticationToken        = "token"
+	AuthenticationUserPassword = "userPassword"
+	AuthenticationCredentials  = "credentials"
+)
+
+var authenticationTypes = []string{
+	AuthenticationNone,
+	AuthenticationToken,
+	AuthenticationUserPassword,
+	AuthenticationCredentials,
+}
+
+func init() {
+	registry.RegisterIntegration("nats", &NATS{})
+}
+
+type NATS struct{}
+
+type Configuration struct {
+	Servers        string `json:"servers" mapstructure:"servers"`
+	Authentication string `json:"authentication" mapstructure:"authentication"`
+	Token          string `json:"token" mapstructure:"token"`
+	Username       string `json:"username" mapstructure:"username"`
+	Password       string `json:"password" mapstructure:"password"`
+	Credentials    string `json:"credentials" mapstructure:"credentials"`
+}
+
+func (n *NATS) Name() string {
+	return "nats"
+}
+
+func (n *NATS) Label() string {
+	return "NATS"
+}
+
+func (n *NATS) Icon() string {
+	return "nats"
+}
+
+func (n *NATS) Description() string {
+	return "Subscribe and publish messages on NATS subjects and JetStream streams"
+}
+
+func (n *NATS) Instructions() string {
+	return `To set up the NATS integration:
+
+1. Create a user for SuperPlane in your NATS server or account, allowed to publish and subscribe on the subjects you want to use
+2. To consume from JetStream, also allow the user to manage consumers on the streams you want to use
+3. Fill in the connection details below`
+}
+
+func (n *NATS) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:        "servers",
+			Label:       "Servers",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Description: "Comma-separated list of server URLs, e.g. nats://nats-1:4222,nats://nats-2:4222",
+		},
+		{
+			Name:     "authentication",
+			Label:    "Authentication",
+			Type:     configuration.FieldTypeSelect,
+			Required: true,
+			Default:  AuthenticationNone,
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "None", Value: AuthenticationNone},
+						{Label: "Token", Value: AuthenticationToken},
+						{Label: "Username and password", Value: AuthenticationUserPassword},
+						{Label: "Credentials file", Value: AuthenticationCredentials},
+					},
+				},
+			},
+		},
+		{
+			Name:        "token",
+			Label:       "Token",
+			Type:        configuration.FieldTypeString,
+			Required:    false,
+			Sensitive:   true,
+			Description: "Authentication token",
+			VisibilityConditions: []configuration.VisibilityCondition{
+				{Field: "authentication", Values: []string{AuthenticationToken}},
+			},
+		},
+		{
+			Name:        "username",
+			Label:       "Username",
+			Type:        configuration.FieldTypeString,
+			Required:    false,
+			Description: "Username used to connect to NATS",
+			VisibilityConditions: []configuration.VisibilityCondition{
+				{Field: "authentication", Values: []string{AuthenticationUserPassword}},
+			},
+		},
+		{
+			Name:        "password",
+			Label:       "Password",
+			Type:        configuration.FieldTypeString,
+			Required:    false,
+			Sensitive:   true,
+			Description: "Password used to connect to NATS",
+			VisibilityConditions: []configuration.VisibilityCondition{
+				{Field: "authentication", Values: []string{AuthenticationUserPassword}},
+			},
+		},
+		{
+			Name:        "credentials",
+			Label:       "Credentials",
+			Type:        configuration.FieldTypeText,
+			Required:    false,
+			Sensitive:   true,
+			Description: "Contents of the .creds file, with the user JWT and NKey seed",
+			VisibilityConditions: []configuration.VisibilityCondition{
+				{Field: "authentication", Values: []string{AuthenticationCredentials}},
+			},
+		},
+		{
+			Name:        "useTLS",
+			Label:       "Use TLS",
+			Type:        configuration.FieldTypeBool,
+			Required:    false,
+			Default:     false,
+			Description: "Require TLS when connecting to the servers",
+		},
+		{
+			Name:        "caCertificate",
+			Label:       "CA Certificate",
+			Type:        configuration.FieldTypeText,
+			Required:    false,
+			Description: "PEM-encoded CA certificate used to verify the servers. Leave empty to use the system CAs.",
+			VisibilityConditions: []configuration.VisibilityCondition{
+				{Field: "useTLS", Values: []string{"true"}},
+			},
+		},
+	}
+}
+
+func (n *NATS) Components() []core.Component {
+	return []core.Component{
+		&PublishMessage{},
+	}
+}
+
+func (n *NATS) Triggers() []core.Trigger {
+	return []core.Trigger{
+		&OnMessage{},
+	}
+}
+
+func (n *NATS) Cleanup(ctx core.IntegrationCleanupContext) error {
+	return nil
+}
+
+func (n *NATS) Sync(ctx core.SyncContext) error {
+	config := Configuration{}
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %v", err)
+	}
+
+	if len(parseServers(config.Servers)) == 0 {
+		return fmt.Errorf("at least one server is required")
+	}
+
+	if config.Authentication != "" && !slices.Contains(authenticationTypes, config.Authentication) {
+		return fmt.Errorf("unsupported authentication: %s", config.Authentication)
+	}
+
+	switch config.Authentication {
+	case AuthenticationToken:
+		if config.Token == "" {
+			return fmt.Errorf("token is required")
+		}
+	case AuthenticationUserPassword:
+		if config.Username == "" {
+			return fmt.Errorf("username is required")
+		}
+	case AuthenticationCredentials:
+		if config.Credentials == "" {
+			return fmt.Errorf("credentials are required")
+		}
+	}
+
+	client, err := NewClient(ctx.Integration)
+	if err != nil {
+		return fmt.Errorf("failed to connect to NATS: %w", err)
+	}
+
+	defer client.Close()
+
+	if err := client.Ping(); err != nil {
+		return fmt.Errorf("failed to connect to NATS: %w", err)
+	}
+
+	ctx.Integration.Ready()
+	return nil
+}
+
+func (n *NATS) HandleRequest(ctx core.HTTPRequestContext) {
+	// no-op
+}
+
+func (n *NATS) ListResources(resourceType string, ctx core.ListResourcesContext) ([]core.IntegrationResource, error) {
+	return []core.IntegrationResource{}, nil
+}
+
+func (n *NATS) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (n *NATS) HandleAction(ctx core.IntegrationActionContext) error {
+	return nil
+}
@@ -0,0 +1,100 @@
+package nats
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__NATS__Sync(t *testing.T) {
+	integration := &NATS{}
+
+	t.Run("missing servers -> error", func(t *testing.T) {
+		err := integration.Sync(core.SyncContext{
+			Configuration: map[string]any{"servers": ""},
+			Integration:   &contexts.IntegrationContext{},
+		})
+
+		require.ErrorContains(t, err, "at least one server is required")
+	})
+
+	t.Run("token authentication without token -> error", func(t *testing.T) {
+		err := integration.Sync(core.SyncContext{
+			Configuration: map[string]any{"servers": "nats://nats:4222", "authentication": AuthenticationToken},
+			Integration:   &contexts.IntegrationContext{},
+		})
+
+		require.ErrorContains(t, err, "token is required")
+	})
+
+	t.Run("connection fails -> error", func(t *testing.T) {
+		useFakeClient(t, &fakeClient{}, errConnectionRefused)
+		integrationCtx := &contexts.IntegrationContext{Configuration: integrationConfig()}
+		err := integration.Sync(core.SyncContext{
+			Configuration: integrationConfig(),
+			Integration:   integrationCtx,
+		})
+
+		require.ErrorContains(t, err, "connection refused")
+		assert.NotEqual(t, "ready", integrationCtx.State)
+	})
+
+	t.Run("connection succeeds -> ready", func(t *testing.T) {
+		client := &fakeClient{}
+		useFakeClient(t, client, nil)
+		integrationCtx := &contexts.IntegrationContext{Configuration: integrationConfig()}
+		err := integration.Sync(core.SyncContext{
+			Configuration: integrationConfig(),
+			Integration:   integrationCtx,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "ready", integrationCtx.State)
+		assert.Equal(t, "nats://nats-1:4222,nats://nats-2:4222", client.Servers)
+		assert.True(t, client.Closed)
+	})
+}
+
+func Test__NATS__ConnectionOptions(t *testing.T) {
+	t.Run("invalid credentials -> error", func(t *testing.T) {
+		_, _, err := connectionOptions(&contexts.IntegrationContext{
+			Configuration: map[string]any{
+				"servers":        "nats://nats:4222",
+				"authentication": AuthenticationCredentials,
+				"credentials":    "not a creds file",
+			},
+		})
+
+		require.ErrorContains(t, err, "invalid credentials")
+	})
+
+	t.Run("invalid CA certificate -> error", func(t *testing.T) {
+		_, _, err := connectionOptions(&contexts.IntegrationContext{
+			Configuration: map[string]any{
+				"servers":       "tls://nats:4222",
+				"useTLS":        "true",
+				"caCertificate": "not a certificate",
+			},
+		})
+
+		require.ErrorContains(t, err, "invalid CA certificate")
+	})
+
+	t.Run("username and password", func(t *testing.T) {
+		servers, options, err := connectionOptions(&contexts.IntegrationContext{
+			Configuration: map[string]any{
+				"servers":        "nats://nats:4222",
+				"authentication": AuthenticationUserPassword,
+				"username":       "superplane",
+				"password":       "secret",
+			},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "nats://nats:4222", servers)
+		assert.Len(t, options, 3)
+	})
+}
@@ -0,0 +1,448 @@
+package nats
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	natsio "github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const (
+	OnMessageTriggerName      = "nats.onMessage"
+	OnMessageEmittedEventType = "nats.message"
+
+	OnMessageReceiveAction          = "receive"
+	OnMessageReceiveJetStreamAction = "receiveJetStream"
+	OnMessagePollInterval           = 10 * time.Second
+	OnMessageMaxPerPoll             = 100
+
+	ModeCore      = "core"
+	ModeJetStream = "jetstream"
+
+	DeliverNew = "new"
+	DeliverAll = "all"
+)
+
+type OnMessage struct{}
+
+type OnMessageConfiguration struct {
+	Mode          string `json:"mode" mapstructure:"mode"`
+	Subject       string `json:"subject" mapstructure:"subject"`
+	QueueGroup    string `json:"queueGroup" mapstructure:"queueGroup"`
+	Stream        string `json:"stream" mapstructure:"stream"`
+	Consumer      string `json:"consumer" mapstructure:"consumer"`
+	DeliverPolicy string `json:"deliverPolicy" mapstructure:"deliverPolicy"`
+}
+
+type OnMessageMetadata struct {
+	Mode       string `json:"mode" mapstructure:"mode"`
+	Subject    string `json:"subject" mapstructure:"subject"`
+	QueueGroup string `json:"queueGroup,omitempty" mapstructure:"queueGroup"`
+	Stream     string `json:"stream,omitempty" mapstructure:"stream"`
+	Consumer   string `json:"consumer,omitempty" mapstructure:"consumer"`
+	Managed    bool   `json:"managed,omitempty" mapstructure:"managed"`
+}
+
+func (t *OnMessage) Name() string {
+	return OnMessageTriggerName
+}
+
+func (t *OnMessage) Label() string {
+	return "On Message"
+}
+
+func (t *OnMessage) Description() string {
+	return "Trigger a workflow when a message is published to a NATS subject"
+}
+
+func (t *OnMessage) Documentation() string {
+	return `The On Message trigger starts a workflow execution for each message published to a NATS subject.
+
+## Use Cases
+
+- **Edge services**: Start workflows from events published by services already on NATS
+- **Event-driven workflows**: React to domain events without exposing HTTP endpoints
+- **Durable processing**: Consume JetStream streams without losing messages while SuperPlane is down
+
+## Modes
+
+### Core NATS
+
+SuperPlane keeps a subscription to the subject, which can use wildcards like ` + "`deploys.>`" + `.
+Messages are only received while SuperPlane is connected, as with any core NATS subscriber.
+All SuperPlane instances subscribe using the same queue group, so each message triggers a single execution.
+
+### JetStream
+
+Messages are consumed from a stream using a durable pull consumer, and acknowledged only after the workflow event is stored.
+If storing the event fails, the message is redelivered, so a message can start more than one execution, but is never lost.
+
+- If a **Consumer** is given, that existing durable consumer is used.
+- Otherwise, SuperPlane creates a durable consumer for the trigger, filtered by the **Subject**, and removes it when the trigger is deleted.
+- The consumer is checked for new messages every 10 seconds, and up to 100 messages are processed at a time.
+
+## Event Data
+
+Each event contains:
+- ` + "`data`" + `: The message data, parsed as JSON when possible
+- ` + "`subject`" + `: The subject the message was published to
+- ` + "`headers`" + `: Message headers
+- ` + "`stream`" + `, ` + "`sequence`" + `, ` + "`deliveries`" + ` and ` + "`timestamp`" + `: JetStream message details, in JetStream mode`
+}
+
+func (t *OnMessage) Icon() string {
+	return "nats"
+}
+
+func (t *OnMessage) Color() string {
+	return "gray"
+}
+
+func (t *OnMessage) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "mode",
+			Label:    "Mode",
+			Type:     configuration.FieldTypeSelect,
+			Required: true,
+			Default:  ModeCore,
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "Core NATS", Value: ModeCore},
+						{Label: "JetStream", Value: ModeJetStream},
+					},
+				},
+			},
+		},
+		{
+			Name:        "subject",
+			Label:       "Subject",
+			Type:        configuration.FieldTypeString,
+			Required:    false,
+			Description: "Subject to subscribe to. Wildcards are supported.",
+		},
+		{
+			Name:        "queueGroup",
+			Label:       "Queue Group",
+			Type:        configuration.FieldTypeString,
+			Required:    false,
+			Togglable:   true,
+			Description: "Queue group to subscribe with. Leave empty to use one dedicated to this trigger.",
+			VisibilityConditions: []configuration.VisibilityCondition{
+				{Field: "mode", Values: []string{ModeCore}},
+			},
+		},
+		{
+			Name:        "stream",
+			Label:       "Stream",
+			Type:        configuration.FieldTypeString,
+			Required:    false,
+			Description: "JetStream stream to consume from",
+			VisibilityConditions: []configuration.VisibilityCondition{
+				{Field: "mode", Values: []string{ModeJetStream}},
+			},
+		},
+		{
+			Name:        "consumer",
+			Label:       "Consumer",
+			Type:        configuration.FieldTypeString,
+			Required:    false,
+			Togglable:   true,
+			Description: "Existing durable consumer to use. Leave empty to let SuperPlane create one.",
+			VisibilityConditions: []configuration.VisibilityCondition{
+				{Field: "mode", Values: []string{ModeJetStream}},
+			},
+		},
+		{
+			Name:        "deliverPolicy",
+			Label:       "Start From",
+			Type:        configuration.FieldTypeSelect,
+			Required:    false,
+			Default:     DeliverNew,
+			Description: "Where the consumer created by SuperPlane starts reading the stream",
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "New messages", Value: DeliverNew},
+						{Label: "All messages in the stream", Value: DeliverAll},
+					},
+				},
+			},
+			VisibilityConditions: []configuration.VisibilityCondition{
+				{Field: "mode", Values: []string{ModeJetStream}},
+			},
+		},
+	}
+}
+
+func (c OnMessageConfiguration) validate() error {
+	switch c.Mode {
+	case ModeCore:
+		if c.Subject == "" {
+			return fmt.Errorf("subject is required")
+		}
+
+	case ModeJetStream:
+		if c.Stream == "" {
+			return fmt.Errorf("stream is required")
+		}
+
+		if c.Consumer == "" && c.Subject == "" {
+			return fmt.Errorf("subject or consumer is required")
+		}
+
+		if c.DeliverPolicy != "" && c.DeliverPolicy != DeliverNew && c.DeliverPolicy != DeliverAll {
+			return fmt.Errorf("invalid start from: %s", c.DeliverPolicy)
+		}
+
+	default:
+		return fmt.Errorf("invalid mode: %s", c.Mode)
+	}
+
+	if strings.ContainsAny(c.Subject, " \t\r\n") {
+		return fmt.Errorf("subject cannot contain whitespace")
+	}
+
+	return nil
+}
+
+func (t *OnMessage) Setup(ctx core.TriggerContext) error {
+	var config OnMessageConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if err := config.validate(); err != nil {
+		return err
+	}
+
+	var metadata OnMessageMetadata
+	if err := mapstructure.Decode(ctx.Metadata.Get(), &metadata); err != nil {
+		return fmt.Errorf("failed to decode metadata: %w", err)
+	}
+
+	desired := desiredMetadata(config, metadata)
+	if desired == metadata {
+		return nil
+	}
+
+	//
+	// Subscriptions and JetStream consumers are used by the NATS subscription worker,
+	// which picks up the new metadata. We only need to connect here
+	// if a JetStream consumer needs to be created, checked, or removed.
+	//
+	if desired.Mode == ModeJetStream || metadata.Managed {
+		client, err := NewClient(ctx.Integration)
+		if err != nil {
+			return fmt.Errorf("failed to connect to NATS: %w", err)
+		}
+
+		defer client.Close()
+
+		if metadata.Managed && (metadata.Stream != desired.Stream || metadata.Consumer != desired.Consumer) {
+			if err := client.DeleteConsumer(metadata.Stream, metadata.Consumer); err != nil {
+				ctx.Logger.Warnf("error deleting consumer %s on stream %s: %v", metadata.Consumer, metadata.Stream, err)
+			}
+		}
+
+		if desired.Mode == ModeJetStream {
+			if err := setupConsumer(client, config, desired); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := ctx.Metadata.Set(desired); err != nil {
+		return fmt.Errorf("failed to set metadata: %w", err)
+	}
+
+	return nil
+}
+
+func desiredMetadata(config OnMessageConfiguration, current OnMessageMetadata) OnMessageMetadata {
+	if config.Mode == ModeCore {
+		return OnMessageMetadata{
+			Mode:       ModeCore,
+			Subject:    config.Subject,
+			QueueGroup: config.QueueGroup,
+		}
+	}
+
+	desired := OnMessageMetadata{
+		Mode:     ModeJetStream,
+		Subject:  config.Subject,
+		Stream:   config.Stream,
+		Consumer: config.Consumer,
+	}
+
+	//
+	// If no consumer is given, we manage one for the trigger.
+	// The same consumer is kept while the stream does not change,
+	// so its position in the stream is not lost when the subject changes.
+	//
+	if desired.Consumer == "" {
+		desired.Managed = true
+		desired.Consumer = current.Consumer
+		if !current.Managed || current.Stream != desired.Stream || current.Consumer == "" {
+			desired.Consumer = "superplane-" + uuid.NewString()
+		}
+	}
+
+	return desired
+}
+
+func setupConsumer(client Client, config OnMessageConfiguration, metadata OnMessageMetadata) error {
+	if !metadata.Managed {
+		err := client.CheckConsumer(metadata.Stream, metadata.Consumer)
+		if err != nil {
+			return fmt.Errorf("error finding consumer %s on stream %s: %w", metadata.Consumer, metadata.Stream, err)
+		}
+
+		return nil
+	}
+
+	deliverPolicy := jetstream.DeliverNewPolicy
+	if config.DeliverPolicy == DeliverAll {
+		deliverPolicy = jetstream.DeliverAllPolicy
+	}
+
+	err := client.CreateOrUpdateConsumer(metadata.Stream, jetstream.ConsumerConfig{
+		Durable:       metadata.Consumer,
+		Description:   "Consumer for SuperPlane NATS trigger",
+		FilterSubject: metadata.Subject,
+		AckPolicy:     jetstream.AckExplicitPolicy,
+		DeliverPolicy: deliverPolicy,
+	})
+
+	if err != nil {
+		return fmt.Errorf("error creating consumer on stream %s: %w", metadata.Stream, err)
+	}
+
+	return nil
+}
+
+func (t *OnMessage) Actions() []core.Action {
+	return []core.Action{
+		{
+			Name:           OnMessageReceiveAction,
+			UserAccessible: false,
+		},
+		{
+			Name:           OnMessageReceiveJetStreamAction,
+			UserAccessible: false,
+		},
+	}
+}
+
+// HandleAction is called by the NATS subscription worker for every message received,
+// with the payload built by BuildMessagePayload or BuildJetStreamPayload as parameters.
+// JetStream messages are acknowledged after the transaction of the action is committed.
+func (t *OnMessage) HandleAction(ctx core.TriggerActionContext) (map[string]any, error) {
+	switch ctx.Name {
+	case OnMessageReceiveAction:
+		return nil, t.receive(ctx, ModeCore)
+	case OnMessageReceiveJetStreamAction:
+		return nil, t.receive(ctx, ModeJetStream)
+	}
+
+	return nil, fmt.Errorf("action %s not supported", ctx.Name)
+}
+
+// receive emits a message received in the given mode.
+// The worker picks up mode changes on its next sync,
+// so messages received in the previous mode are ignored until then.
+func (t *OnMessage) receive(ctx core.TriggerActionContext, mode string) error {
+	var metadata OnMessageMetadata
+	if err := mapstructure.Decode(ctx.Metadata.Get(), &metadata); err != nil {
+		return fmt.Errorf("failed to decode metadata: %w", err)
+	}
+
+	if metadata.Mode != mode {
+		ctx.Logger.Infof("ignoring %s message received while in %s mode", mode, metadata.Mode)
+		return nil
+	}
+
+	return ctx.Events.Emit(OnMessageEmittedEventType, ctx.Parameters)
+}
+
+// BuildMessagePayload builds the event payload for a core NATS message.
+func BuildMessagePayload(msg *natsio.Msg) map[string]any {
+	return buildPayload(msg.Subject, msg.Header, msg.Data)
+}
+
+// BuildJetStreamPayload builds the event payload for a JetStream message.
+func BuildJetStreamPayload(msg jetstream.Msg) map[string]any {
+	payload := buildPayload(msg.Subject(), msg.Headers(), msg.Data())
+
+	metadata, err := msg.Metadata()
+	if err != nil {
+		return payload
+	}
+
+	payload["stream"] = metadata.Stream
+	payload["sequence"] = metadata.Sequence.Stream
+	payload["deliveries"] = metadata.NumDelivered
+	if !metadata.Timestamp.IsZero() {
+		payload["timestamp"] = metadata.Timestamp.UTC().Format(time.RFC3339)
+	}
+
+	return payload
+}
+
+func buildPayload(subject string, header natsio.Header, data []byte) map[string]any {
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		value = string(data)
+	}
+
+	//
+	// Headers can have multiple values,
+	// but most of the time they only have one,
+	// so we don't make everyone deal with lists.
+	//
+	headers := map[string]any{}
+	for key, values := range header {
+		if len(values) == 1 {
+			headers[key] = values[0]
+		} else {
+			headers[key] = values
+		}
+	}
+
+	return map[string]any{
+		"subject": subject,
+		"headers": headers,
+		"data":    value,
+	}
+}
+
+func (t *OnMessage) HandleWebhook(ctx core.WebhookRequestContext) (int, *core.WebhookResponseBody, error) {
+	return 200, nil, nil
+}
+
+func (t *OnMessage) Cleanup(ctx core.TriggerContext) error {
+	var metadata OnMessageMetadata
+	if err := mapstructure.Decode(ctx.Metadata.Get(), &metadata); err != nil {
+		return fmt.Errorf("failed to decode metadata: %w", err)
+	}
+
+	if !metadata.Managed || metadata.Consumer == "" {
+		return nil
+	}
+
+	client, err := NewClient(ctx.Integration)
+	if err != nil {
+		return fmt.Errorf("failed to connect to NATS: %w", err)
+	}
+
+	defer client.Close()
+	return client.DeleteConsumer(metadata.Stream, metadata.Consumer)
+}
@@ -0,0 +1,270 @@
+package nats
+
+import (
+	"testing"
+
+	natsio "github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__OnMessage__Setup(t *testing.T) {
+	trigger := &OnMessage{}
+	logger := logrus.NewEntry(logrus.New())
+
+	t.Run("core mode requires subject", func(t *testing.T) {
+		err := trigger.Setup(core.TriggerContext{
+			Configuration: map[string]any{"mode": ModeCore},
+			Metadata:      &contexts.MetadataContext{},
+			Requests:      &contexts.RequestContext{},
+		})
+
+		require.ErrorContains(t, err, "subject is required")
+	})
+
+	t.Run("jetstream mode requires stream", func(t *testing.T) {
+		err := trigger.Setup(core.TriggerContext{
+			Configuration: map[string]any{"mode": ModeJetStream, "subject": "deploys.>"},
+			Metadata:      &contexts.MetadataContext{},
+			Requests:      &contexts.RequestContext{},
+		})
+
+		require.ErrorContains(t, err, "stream is required")
+	})
+
+	t.Run("core mode only sets metadata", func(t *testing.T) {
+		useFakeClient(t, &fakeClient{}, errConnectionRefused)
+		metadata := &contexts.MetadataContext{}
+		requests := &contexts.RequestContext{}
+
+		err := trigger.Setup(core.TriggerContext{
+			Logger:        logger,
+			Configuration: map[string]any{"mode": ModeCore, "subject": "deploys.>", "queueGroup": "deployers"},
+			Metadata:      metadata,
+			Integration:   &contexts.IntegrationContext{Configuration: integrationConfig()},
+			Requests:      requests,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, OnMessageMetadata{Mode: ModeCore, Subject: "deploys.>", QueueGroup: "deployers"}, metadata.Metadata)
+		assert.Empty(t, requests.Action)
+	})
+
+	t.Run("jetstream mode creates durable consumer", func(t *testing.T) {
+		client := &fakeClient{}
+		useFakeClient(t, client, nil)
+		metadata := &contexts.MetadataContext{}
+		requests := &contexts.RequestContext{}
+
+		err := trigger.Setup(core.TriggerContext{
+			Logger: logger,
+			Configuration: map[string]any{
+				"mode":          ModeJetStream,
+				"subject":       "deploys.>",
+				"stream":        "DEPLOYS",
+				"deliverPolicy": DeliverAll,
+			},
+			Metadata:    metadata,
+			Integration: &contexts.IntegrationContext{Configuration: integrationConfig()},
+			Requests:    requests,
+		})
+
+		require.NoError(t, err)
+		m := metadata.Metadata.(OnMessageMetadata)
+		assert.True(t, m.Managed)
+		assert.Equal(t, "DEPLOYS", m.Stream)
+		assert.Contains(t, m.Consumer, "superplane-")
+
+		require.Len(t, client.Created, 1)
+		assert.Equal(t, m.Consumer, client.Created[0].Durable)
+		assert.Equal(t, "deploys.>", client.Created[0].FilterSubject)
+		assert.Equal(t, jetstream.AckExplicitPolicy, client.Created[0].AckPolicy)
+		assert.Equal(t, jetstream.DeliverAllPolicy, client.Created[0].DeliverPolicy)
+
+		assert.Empty(t, requests.Action)
+	})
+
+	t.Run("changing subject keeps managed consumer", func(t *testing.T) {
+		client := &fakeClient{}
+		useFakeClient(t, client, nil)
+		existing := OnMessageMetadata{Mode: ModeJetStream, Subject: "deploys.>", Stream: "DEPLOYS", Consumer: "superplane-abc", Managed: true}
+		metadata := &contexts.MetadataContext{Metadata: existing}
+
+		err := trigger.Setup(core.TriggerContext{
+			Logger:        logger,
+			Configuration: map[string]any{"mode": ModeJetStream, "subject": "deploys.production.>", "stream": "DEPLOYS"},
+			Metadata:      metadata,
+			Integration:   &contexts.IntegrationContext{Configuration: integrationConfig()},
+			Requests:      &contexts.RequestContext{},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "superplane-abc", metadata.Metadata.(OnMessageMetadata).Consumer)
+		assert.Empty(t, client.Deleted)
+		require.Len(t, client.Created, 1)
+		assert.Equal(t, "deploys.production.>", client.Created[0].FilterSubject)
+	})
+
+	t.Run("existing consumer is only checked", func(t *testing.T) {
+		client := &fakeClient{}
+		useFakeClient(t, client, nil)
+		existing := OnMessageMetadata{Mode: ModeJetStream, Subject: "deploys.>", Stream: "DEPLOYS", Consumer: "superplane-abc", Managed: true}
+		metadata := &contexts.MetadataContext{Metadata: existing}
+
+		err := trigger.Setup(core.TriggerContext{
+			Logger:        logger,
+			Configuration: map[string]any{"mode": ModeJetStream, "stream": "DEPLOYS", "consumer": "deployer"},
+			Metadata:      metadata,
+			Integration:   &contexts.IntegrationContext{Configuration: integrationConfig()},
+			Requests:      &contexts.RequestContext{},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, OnMessageMetadata{Mode: ModeJetStream, Stream: "DEPLOYS", Consumer: "deployer"}, metadata.Metadata)
+		assert.Empty(t, client.Created)
+		assert.Equal(t, []consumerRef{{Stream: "DEPLOYS", Consumer: "deployer"}}, client.Checked)
+		assert.Equal(t, []consumerRef{{Stream: "DEPLOYS", Consumer: "superplane-abc"}}, client.Deleted)
+	})
+
+	t.Run("switching to core mode removes managed consumer", func(t *testing.T) {
+		client := &fakeClient{}
+		useFakeClient(t, client, nil)
+		existing := OnMessageMetadata{Mode: ModeJetStream, Subject: "deploys.>", Stream: "DEPLOYS", Consumer: "superplane-abc", Managed: true}
+		metadata := &contexts.MetadataContext{Metadata: existing}
+
+		err := trigger.Setup(core.TriggerContext{
+			Logger:        logger,
+			Configuration: map[string]any{"mode": ModeCore, "subject": "deploys.>"},
+			Metadata:      metadata,
+			Integration:   &contexts.IntegrationContext{Configuration: integrationConfig()},
+			Requests:      &contexts.RequestContext{},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, OnMessageMetadata{Mode: ModeCore, Subject: "deploys.>"}, metadata.Metadata)
+		assert.Equal(t, []consumerRef{{Stream: "DEPLOYS", Consumer: "superplane-abc"}}, client.Deleted)
+	})
+}
+
+func Test__OnMessage__Receive(t *testing.T) {
+	trigger := &OnMessage{}
+	logger := logrus.NewEntry(logrus.New())
+	msg := natsio.NewMsg("deploys.production.api")
+	msg.Data = []byte(`{"service":"api"}`)
+	msg.Header.Add("Source", "ci")
+	msg.Header.Add("Tag", "a")
+	msg.Header.Add("Tag", "b")
+
+	t.Run("core mode emits message", func(t *testing.T) {
+		events := &contexts.EventContext{}
+
+		_, err := trigger.HandleAction(core.TriggerActionContext{
+			Name:       OnMessageReceiveAction,
+			Logger:     logger,
+			Parameters: BuildMessagePayload(msg),
+			Metadata:   &contexts.MetadataContext{Metadata: OnMessageMetadata{Mode: ModeCore, Subject: "deploys.>"}},
+			Events:     events,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, 1, events.Count())
+
+		payload := events.Payloads[0].Data.(map[string]any)
+		assert.Equal(t, "deploys.production.api", payload["subject"])
+		assert.Equal(t, map[string]any{"service": "api"}, payload["data"])
+		assert.Equal(t, map[string]any{"Source": "ci", "Tag": []string{"a", "b"}}, payload["headers"])
+	})
+
+	t.Run("jetstream mode emits jetstream message", func(t *testing.T) {
+		events := &contexts.EventContext{}
+		jetStreamMsg := &fakeMsg{
+			subject:  "deploys.production.api",
+			data:     []byte(`{"service":"api"}`),
+			header:   natsio.Header{"Source": []string{"ci"}},
+			sequence: 7,
+		}
+
+		_, err := trigger.HandleAction(core.TriggerActionContext{
+			Name:       OnMessageReceiveJetStreamAction,
+			Logger:     logger,
+			Parameters: BuildJetStreamPayload(jetStreamMsg),
+			Metadata:   &contexts.MetadataContext{Metadata: OnMessageMetadata{Mode: ModeJetStream, Stream: "DEPLOYS", Consumer: "superplane-abc"}},
+			Events:     events,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, 1, events.Count())
+
+		payload := events.Payloads[0].Data.(map[string]any)
+		assert.Equal(t, "deploys.production.api", payload["subject"])
+		assert.Equal(t, map[string]any{"service": "api"}, payload["data"])
+		assert.Equal(t, map[string]any{"Source": "ci"}, payload["headers"])
+		assert.Equal(t, "DEPLOYS", payload["stream"])
+		assert.Equal(t, uint64(7), payload["sequence"])
+		assert.Equal(t, "2026-01-10T10:00:00Z", payload["timestamp"])
+	})
+
+	t.Run("core mode ignores jetstream message", func(t *testing.T) {
+		events := &contexts.EventContext{}
+
+		_, err := trigger.HandleAction(core.TriggerActionContext{
+			Name:       OnMessageReceiveJetStreamAction,
+			Logger:     logger,
+			Parameters: BuildJetStreamPayload(&fakeMsg{subject: "deploys.production.api", data: []byte("{}")}),
+			Metadata:   &contexts.MetadataContext{Metadata: OnMessageMetadata{Mode: ModeCore, Subject: "deploys.>"}},
+			Events:     events,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 0, events.Count())
+	})
+
+	t.Run("jetstream mode ignores core message", func(t *testing.T) {
+		events := &contexts.EventContext{}
+
+		_, err := trigger.HandleAction(core.TriggerActionContext{
+			Name:       OnMessageReceiveAction,
+			Logger:     logger,
+			Parameters: BuildMessagePayload(msg),
+			Metadata:   &contexts.MetadataContext{Metadata: OnMessageMetadata{Mode: ModeJetStream, Stream: "DEPLOYS"}},
+			Events:     events,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 0, events.Count())
+	})
+}
+
+func Test__OnMessage__Cleanup(t *testing.T) {
+	trigger := &OnMessage{}
+
+	t.Run("deletes managed consumer", func(t *testing.T) {
+		client := &fakeClient{}
+		useFakeClient(t, client, nil)
+
+		err := trigger.Cleanup(core.TriggerContext{
+			Metadata:    &contexts.MetadataContext{Metadata: OnMessageMetadata{Mode: ModeJetStream, Stream: "DEPLOYS", Consumer: "superplane-abc", Managed: true}},
+			Integration: &contexts.IntegrationContext{Configuration: integrationConfig()},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []consumerRef{{Stream: "DEPLOYS", Consumer: "superplane-abc"}}, client.Deleted)
+	})
+
+	t.Run("keeps existing consumer", func(t *testing.T) {
+		client := &fakeClient{}
+		useFakeClient(t, client, nil)
+
+		err := trigger.Cleanup(core.TriggerContext{
+			Metadata:    &contexts.MetadataContext{Metadata: OnMessageMetadata{Mode: ModeJetStream, Stream: "DEPLOYS", Consumer: "deployer"}},
+			Integration: &contexts.IntegrationContext{Configuration: integrationConfig()},
+		})
+
+		require.NoError(t, err)
+		assert.Empty(t, client.Deleted)
+	})
+}
@@ -0,0 +1,316 @@
+package nats
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	natsio "github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const (
+	PublishMessageFormatJSON = "json"
+	PublishMessageFormatText = "text"
+)
+
+type PublishMessage struct{}
+
+type PublishMessageConfiguration struct {
+	Subject   string   `json:"subject" mapstructure:"subject"`
+	Format    string   `json:"format" mapstructure:"format"`
+	JSON      *any     `json:"json" mapstructure:"json"`
+	Text      *string  `json:"text" mapstructure:"text"`
+	Headers   []Header `json:"headers" mapstructure:"headers"`
+	JetStream bool     `json:"jetStream" mapstructure:"jetStream"`
+	MessageID string   `json:"messageId" mapstructure:"messageId"`
+}
+
+type Header struct {
+	Name  string `json:"name" mapstructure:"name"`
+	Value string `json:"value" mapstructure:"value"`
+}
+
+func (c *PublishMessage) Name() string {
+	return "nats.publishMessage"
+}
+
+func (c *PublishMessage) Label() string {
+	return "Publish Message"
+}
+
+func (c *PublishMessage) Description() string {
+	return "Publish a message to a NATS subject"
+}
+
+func (c *PublishMessage) Documentation() string {
+	return `The Publish Message component publishes a message to a NATS subject.
+
+## Use Cases
+
+- **Edge services**: Notify services listening on NATS about workflow results
+- **Task dispatching**: Publish jobs consumed by your workers
+- **Event sourcing**: Store workflow events in a JetStream stream
+
+## Configuration
+
+- **Subject**: The subject to publish to
+- **Message Format**: Publish a JSON object or plain text
+- **Headers**: Optional message headers
+- **Wait for JetStream**: Wait for the stream capturing the subject to acknowledge the message. The execution fails if no stream captures it.
+- **Message ID**: Optional ID used by JetStream to discard duplicate messages
+
+## Output
+
+Returns the subject of the published message. When waiting for JetStream, also returns the stream and the sequence number of the message.`
+}
+
+func (c *PublishMessage) Icon() string {
+	return "nats"
+}
+
+func (c *PublishMessage) Color() string {
+	return "gray"
+}
+
+func (c *PublishMessage) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *PublishMessage) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:        "subject",
+			Label:       "Subject",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Description: "Subject to publish to",
+		},
+		{
+			Name:     "format",
+			Label:    "Message Format",
+			Type:     configuration.FieldTypeSelect,
+			Required: true,
+			Default:  PublishMessageFormatJSON,
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Value: PublishMessageFormatJSON, Label: "JSON"},
+						{Value: PublishMessageFormatText, Label: "Text"},
+					},
+				},
+			},
+		},
+		{
+			Name:     "json",
+			Label:    "JSON Message",
+			Type:     configuration.FieldTypeObject,
+			Required: false,
+			Default:  map[string]any{},
+			VisibilityConditions: []configuration.VisibilityCondition{
+				{Field: "format", Values: []string{PublishMessageFormatJSON}},
+			},
+		},
+		{
+			Name:     "text",
+			Label:    "Text Message",
+			Type:     configuration.FieldTypeText,
+			Required: false,
+			VisibilityConditions: []configuration.VisibilityCondition{
+				{Field: "format", Values: []string{PublishMessageFormatText}},
+			},
+		},
+		{
+			Name:        "headers",
+			Label:       "Headers",
+			Type:        configuration.FieldTypeList,
+			Required:    false,
+			Togglable:   true,
+			Description: "Headers to send with the message",
+			TypeOptions: &configuration.TypeOptions{
+				List: &configuration.ListTypeOptions{
+					ItemLabel: "Header",
+					ItemDefinition: &configuration.ListItemDefinition{
+						Type: configuration.FieldTypeObject,
+						Schema: []configuration.Field{
+							{
+								Name:     "name",
+								Type:     configuration.FieldTypeString,
+								Label:    "Header Name",
+								Required: true,
+							},
+							{
+								Name:     "value",
+								Type:     configuration.FieldTypeString,
+								Label:    "Header Value",
+								Required: true,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:        "jetStream",
+			Label:       "Wait for JetStream",
+			Type:        configuration.FieldTypeBool,
+			Required:    false,
+			Default:     false,
+			Description: "Wait for a JetStream stream to acknowledge the message",
+		},
+		{
+			Name:        "messageId",
+			Label:       "Message ID",
+			Type:        configuration.FieldTypeString,
+			Required:    false,
+			Togglable:   true,
+			Description: "ID used by JetStream to discard duplicate messages",
+			VisibilityConditions: []configuration.VisibilityCondition{
+				{Field: "jetStream", Values: []string{"true"}},
+			},
+		},
+	}
+}
+
+func (c *PublishMessage) Setup(ctx core.SetupContext) error {
+	var config PublishMessageConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	return config.validate()
+}
+
+func (c PublishMessageConfiguration) validate() error {
+	if c.Subject == "" {
+		return fmt.Errorf("subject is required")
+	}
+
+	if strings.ContainsAny(c.Subject, " \t\r\n*>") {
+		return fmt.Errorf("subject cannot contain whitespace or wildcards")
+	}
+
+	if c.Format == "" {
+		return fmt.Errorf("format is required")
+	}
+
+	if c.Format == PublishMessageFormatJSON && c.JSON == nil {
+		return fmt.Errorf("JSON message is required")
+	}
+
+	if c.Format == PublishMessageFormatText && c.Text == nil {
+		return fmt.Errorf("text message is required")
+	}
+
+	for _, header := range c.Headers {
+		if header.Name == "" {
+			return fmt.Errorf("header name is required")
+		}
+	}
+
+	return nil
+}
+
+func (c *PublishMessage) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *PublishMessage) Execute(ctx core.ExecutionContext) error {
+	var config PublishMessageConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if err := config.validate(); err != nil {
+		return err
+	}
+
+	msg, err := buildMsg(config)
+	if err != nil {
+		return err
+	}
+
+	client, err := NewClient(ctx.Integration)
+	if err != nil {
+		return fmt.Errorf("failed to connect to NATS: %w", err)
+	}
+
+	defer client.Close()
+
+	output := map[string]any{
+		"subject":     config.Subject,
+		"publishedAt": time.Now().UTC().Format(time.RFC3339),
+	}
+
+	if config.JetStream {
+		ack, err := client.PublishJetStream(msg)
+		if err != nil {
+			return fmt.Errorf("failed to publish message: %w", err)
+		}
+
+		output["stream"] = ack.Stream
+		output["sequence"] = ack.Sequence
+		output["duplicate"] = ack.Duplicate
+	} else {
+		if err := client.Publish(msg); err != nil {
+			return fmt.Errorf("failed to publish message: %w", err)
+		}
+	}
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"nats.message.published",
+		[]any{output},
+	)
+}
+
+func buildMsg(config PublishMessageConfiguration) (*natsio.Msg, error) {
+	msg := natsio.NewMsg(config.Subject)
+
+	if config.Format == PublishMessageFormatText {
+		msg.Data = []byte(*config.Text)
+	} else {
+		data, err := json.Marshal(*config.JSON)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal JSON message: %w", err)
+		}
+
+		msg.Data = data
+	}
+
+	for _, header := range config.Headers {
+		msg.Header.Add(header.Name, header.Value)
+	}
+
+	if config.JetStream && config.MessageID != "" {
+		msg.Header.Set(jetstream.MsgIDHeader, config.MessageID)
+	}
+
+	return msg, nil
+}
+
+func (c *PublishMessage) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *PublishMessage) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *PublishMessage) HandleWebhook(ctx core.WebhookRequestContext) (int, *core.WebhookResponseBody, error) {
+	return http.StatusOK, nil, nil
+}
+
+func (c *PublishMessage) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *PublishMessage) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
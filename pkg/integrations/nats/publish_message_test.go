@@ -0,0 +1,110 @@
+package nats
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__PublishMessage__Setup(t *testing.T) {
+	component := &PublishMessage{}
+
+	t.Run("missing subject -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Configuration: map[string]any{"format": "json", "json": map[string]any{}},
+		})
+
+		require.ErrorContains(t, err, "subject is required")
+	})
+
+	t.Run("wildcard subject -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Configuration: map[string]any{"subject": "deploys.>", "format": "json", "json": map[string]any{}},
+		})
+
+		require.ErrorContains(t, err, "subject cannot contain whitespace or wildcards")
+	})
+
+	t.Run("valid configuration", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Configuration: map[string]any{"subject": "deploys.production", "format": "text", "text": "hello"},
+		})
+
+		require.NoError(t, err)
+	})
+}
+
+func Test__PublishMessage__Execute(t *testing.T) {
+	component := &PublishMessage{}
+
+	t.Run("publishes core NATS message", func(t *testing.T) {
+		client := &fakeClient{}
+		useFakeClient(t, client, nil)
+		state := &contexts.ExecutionStateContext{KVs: map[string]string{}}
+
+		err := component.Execute(core.ExecutionContext{
+			Configuration: map[string]any{
+				"subject": "deploys.production",
+				"format":  "json",
+				"json":    map[string]any{"service": "api"},
+				"headers": []map[string]any{
+					{"name": "Source", "value": "superplane"},
+				},
+			},
+			Integration:    &contexts.IntegrationContext{Configuration: integrationConfig()},
+			ExecutionState: state,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, client.Published, 1)
+		assert.False(t, client.JetStream)
+		assert.Equal(t, "deploys.production", client.Published[0].Subject)
+		assert.Equal(t, `{"service":"api"}`, string(client.Published[0].Data))
+		assert.Equal(t, "superplane", client.Published[0].Header.Get("Source"))
+
+		assert.True(t, state.Passed)
+		assert.Equal(t, "nats.message.published", state.Type)
+		assert.True(t, client.Closed)
+	})
+
+	t.Run("publishes JetStream message with ID", func(t *testing.T) {
+		client := &fakeClient{}
+		useFakeClient(t, client, nil)
+		state := &contexts.ExecutionStateContext{KVs: map[string]string{}}
+
+		err := component.Execute(core.ExecutionContext{
+			Configuration: map[string]any{
+				"subject":   "deploys.production",
+				"format":    "text",
+				"text":      "hello",
+				"jetStream": true,
+				"messageId": "deploy-42",
+			},
+			Integration:    &contexts.IntegrationContext{Configuration: integrationConfig()},
+			ExecutionState: state,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, client.Published, 1)
+		assert.True(t, client.JetStream)
+		assert.Equal(t, "hello", string(client.Published[0].Data))
+		assert.Equal(t, "deploy-42", client.Published[0].Header.Get("Nats-Msg-Id"))
+	})
+
+	t.Run("publish error -> error", func(t *testing.T) {
+		useFakeClient(t, &fakeClient{PubErr: errConnectionRefused}, nil)
+		state := &contexts.ExecutionStateContext{KVs: map[string]string{}}
+
+		err := component.Execute(core.ExecutionContext{
+			Configuration:  map[string]any{"subject": "deploys.production", "format": "text", "text": "hello"},
+			Integration:    &contexts.IntegrationContext{Configuration: integrationConfig()},
+			ExecutionState: state,
+		})
+
+		require.ErrorContains(t, err, "failed to publish message")
+		assert.False(t, state.Finished)
+	})
+}
@@ -0,0 +1,137 @@
+package nats
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	natsio "github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+type consumerRef struct {
+	Stream   string
+	Consumer string
+}
+
+type fakeClient struct {
+	Servers   string
+	Options   []natsio.Option
+	Published []*natsio.Msg
+	Created   []jetstream.ConsumerConfig
+	Checked   []consumerRef
+	Deleted   []consumerRef
+	PingErr   error
+	PubErr    error
+	CheckErr  error
+	JetStream bool
+	Closed    bool
+}
+
+func (c *fakeClient) Ping() error {
+	return c.PingErr
+}
+
+func (c *fakeClient) Publish(msg *natsio.Msg) error {
+	if c.PubErr != nil {
+		return c.PubErr
+	}
+
+	c.Published = append(c.Published, msg)
+	return nil
+}
+
+func (c *fakeClient) PublishJetStream(msg *natsio.Msg) (*jetstream.PubAck, error) {
+	if c.PubErr != nil {
+		return nil, c.PubErr
+	}
+
+	c.JetStream = true
+	c.Published = append(c.Published, msg)
+	return &jetstream.PubAck{Stream: "DEPLOYS", Sequence: uint64(len(c.Published))}, nil
+}
+
+func (c *fakeClient) CreateOrUpdateConsumer(stream string, config jetstream.ConsumerConfig) error {
+	c.Created = append(c.Created, config)
+	return nil
+}
+
+func (c *fakeClient) CheckConsumer(stream, consumer string) error {
+	c.Checked = append(c.Checked, consumerRef{Stream: stream, Consumer: consumer})
+	return c.CheckErr
+}
+
+func (c *fakeClient) DeleteConsumer(stream, consumer string) error {
+	c.Deleted = append(c.Deleted, consumerRef{Stream: stream, Consumer: consumer})
+	return nil
+}
+
+func (c *fakeClient) Fetch(stream, consumer string, max int) ([]jetstream.Msg, error) {
+	return nil, fmt.Errorf("not supported")
+}
+
+func (c *fakeClient) Subscribe(subject, queue string, handler natsio.MsgHandler) (*natsio.Subscription, error) {
+	return nil, fmt.Errorf("not supported")
+}
+
+func (c *fakeClient) Close() {
+	c.Closed = true
+}
+
+// fakeMsg implements the parts of jetstream.Msg used to build payloads.
+type fakeMsg struct {
+	jetstream.Msg
+
+	subject  string
+	data     []byte
+	header   natsio.Header
+	sequence uint64
+}
+
+func (m *fakeMsg) Subject() string {
+	return m.subject
+}
+
+func (m *fakeMsg) Data() []byte {
+	return m.data
+}
+
+func (m *fakeMsg) Headers() natsio.Header {
+	return m.header
+}
+
+func (m *fakeMsg) Metadata() (*jetstream.MsgMetadata, error) {
+	return &jetstream.MsgMetadata{
+		Stream:       "DEPLOYS",
+		Sequence:     jetstream.SequencePair{Stream: m.sequence},
+		NumDelivered: 1,
+		Timestamp:    time.Date(2026, 1, 10, 10, 0, 0, 0, time.UTC),
+	}, nil
+}
+
+// useFakeClient replaces the connector for the duration of the test.
+// If connectErr is set, connecting fails with it.
+func useFakeClient(t *testing.T, client *fakeClient, connectErr error) {
+	original := connect
+	connect = func(servers string, options []natsio.Option) (Client, error) {
+		if connectErr != nil {
+			return nil, connectErr
+		}
+
+		client.Servers = servers
+		client.Options = options
+		return client, nil
+	}
+
+	t.Cleanup(func() { connect = original })
+}
+
+func integrationConfig() map[string]any {
+	return map[string]any{
+		"servers":        "nats://nats-1:4222, nats://nats-2:4222",
+		"authentication": AuthenticationNone,
+		"useTLS":         "false",
+	}
+}
+
+var errConnectionRefused = fmt.Errorf("connection refused")
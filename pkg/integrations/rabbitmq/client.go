@@ -0,0 +1,199 @@
+package rabbitmq
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const (
+	DialTimeout    = 10 * time.Second
+	PublishTimeout = 10 * time.Second
+)
+
+// Channel is the subset of *amqp.Channel used by the integration.
+type Channel interface {
+	QueueDeclare(name string, durable, autoDelete, exclusive, noWait bool, args amqp.Table) (amqp.Queue, error)
+	QueueDeclarePassive(name string, durable, autoDelete, exclusive, noWait bool, args amqp.Table) (amqp.Queue, error)
+	QueueBind(name, key, exchange string, noWait bool, args amqp.Table) error
+	QueueUnbind(name, key, exchange string, args amqp.Table) error
+	QueueDelete(name string, ifUnused, ifEmpty, noWait bool) (int, error)
+	Qos(prefetchCount, prefetchSize int, global bool) error
+	Consume(queue, consumer string, autoAck, exclusive, noLocal, noWait bool, args amqp.Table) (<-chan amqp.Delivery, error)
+	PublishWithContext(ctx context.Context, exchange, key string, mandatory, immediate bool, msg amqp.Publishing) error
+	Close() error
+}
+
+type dialer func(uri string, tlsConfig *tls.Config) (Channel, io.Closer, error)
+
+var dial dialer = func(uri string, tlsConfig *tls.Config) (Channel, io.Closer, error) {
+	conn, err := amqp.DialConfig(uri, amqp.Config{
+		TLSClientConfig: tlsConfig,
+		Dial:            amqp.DefaultDial(DialTimeout),
+	})
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	channel, err := conn.Channel()
+	if err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+
+	return channel, conn, nil
+}
+
+type Client struct {
+	channel Channel
+	conn    io.Closer
+}
+
+func NewClient(ctx core.IntegrationContext) (*Client, error) {
+	host, err := ctx.GetConfig("host")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get host: %w", err)
+	}
+
+	if len(host) == 0 {
+		return nil, fmt.Errorf("host is required")
+	}
+
+	portBytes, err := ctx.GetConfig("port")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get port: %w", err)
+	}
+
+	port, err := strconv.Atoi(string(portBytes))
+	if err != nil || port <= 0 || port > 65535 {
+		return nil, fmt.Errorf("port must be between 1 and 65535")
+	}
+
+	username, err := ctx.GetConfig("username")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get username: %w", err)
+	}
+
+	password, err := ctx.GetConfig("password")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get password: %w", err)
+	}
+
+	// Optional fields
+	vhost, _ := ctx.GetConfig("vhost")
+	useTLSBytes, _ := ctx.GetConfig("useTLS")
+	useTLS := string(useTLSBytes) == "true"
+
+	var tlsConfig *tls.Config
+	if useTLS {
+		tlsConfig = &tls.Config{
+			ServerName: string(host),
+			MinVersion: tls.VersionTLS12,
+		}
+	}
+
+	uri := buildURI(string(host), port, string(username), string(password), string(vhost), useTLS)
+	channel, conn, err := dial(uri, tlsConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{channel: channel, conn: conn}, nil
+}
+
+func buildURI(host string, port int, username, password, vhost string, useTLS bool) string {
+	scheme := "amqp"
+	if useTLS {
+		scheme = "amqps"
+	}
+
+	//
+	// The default virtual host is represented by an empty path.
+	// Other virtual hosts need to be escaped, since they can contain slashes.
+	//
+	path := ""
+	if vhost != "" && vhost != "/" {
+		path = url.PathEscape(vhost)
+	}
+
+	userInfo := url.UserPassword(username, password).String()
+	return fmt.Sprintf("%s://%s@%s/%s", scheme, userInfo, fmt.Sprintf("%s:%d", host, port), path)
+}
+
+func (c *Client) Close() {
+	if c.channel != nil {
+		_ = c.channel.Close()
+	}
+
+	if c.conn != nil {
+		_ = c.conn.Close()
+	}
+}
+
+// DeclareQueue declares a durable queue, and binds it to the exchange, if one is given.
+// Queues not managed by SuperPlane are only checked for existence,
+// since they might have been declared with different arguments.
+func (c *Client) DeclareQueue(queue string, managed bool, exchange, routingKey string) error {
+	var err error
+	if managed {
+		_, err = c.channel.QueueDeclare(queue, true, false, false, false, nil)
+	} else {
+		_, err = c.channel.QueueDeclarePassive(queue, true, false, false, false, nil)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error declaring queue %s: %w", queue, err)
+	}
+
+	if exchange == "" {
+		return nil
+	}
+
+	err = c.channel.QueueBind(queue, routingKey, exchange, false, nil)
+	if err != nil {
+		return fmt.Errorf("error binding queue %s to exchange %s: %w", queue, exchange, err)
+	}
+
+	return nil
+}
+
+func (c *Client) UnbindQueue(queue, exchange, routingKey string) error {
+	return c.channel.QueueUnbind(queue, routingKey, exchange, nil)
+}
+
+func (c *Client) DeleteQueue(queue string) error {
+	_, err := c.channel.QueueDelete(queue, false, false, false)
+	return err
+}
+
+// Consume starts delivering the messages of the queue.
+// At most prefetch messages are delivered before they are acknowledged.
+// The deliveries channel is closed when the client is closed, or the connection is lost.
+func (c *Client) Consume(queue string, autoAck bool, prefetch int) (<-chan amqp.Delivery, error) {
+	if !autoAck {
+		if err := c.channel.Qos(prefetch, 0, false); err != nil {
+			return nil, fmt.Errorf("error setting prefetch: %w", err)
+		}
+	}
+
+	deliveries, err := c.channel.Consume(queue, "", autoAck, false, false, false, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error consuming from queue %s: %w", queue, err)
+	}
+
+	return deliveries, nil
+}
+
+func (c *Client) Publish(exchange, routingKey string, msg amqp.Publishing) error {
+	ctx, cancel := context.WithTimeout(context.Background(), PublishTimeout)
+	defer cancel()
+	return c.channel.PublishWithContext(ctx, exchange, routingKey, false, false, msg)
+}
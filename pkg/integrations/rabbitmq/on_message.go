@@ -0,0 +1,315 @@
+package rabbitmq
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const (
+	OnMessageTriggerName      = "rabbitmq.onMessage"
+	OnMessageEmittedEventType = "rabbitmq.message"
+	OnMessageReceiveAction    = "receive"
+	OnMessagePrefetch         = 100
+
+	AckModeAfterEmit = "afterEmit"
+	AckModeOnReceive = "onReceive"
+)
+
+type OnMessage struct{}
+
+type OnMessageConfiguration struct {
+	Queue      string `json:"queue" mapstructure:"queue"`
+	Exchange   string `json:"exchange" mapstructure:"exchange"`
+	RoutingKey string `json:"routingKey" mapstructure:"routingKey"`
+	AckMode    string `json:"ackMode" mapstructure:"ackMode"`
+}
+
+type OnMessageMetadata struct {
+	Queue      string `json:"queue" mapstructure:"queue"`
+	Managed    bool   `json:"managed" mapstructure:"managed"`
+	Exchange   string `json:"exchange" mapstructure:"exchange"`
+	RoutingKey string `json:"routingKey" mapstructure:"routingKey"`
+}
+
+func (t *OnMessage) Name() string {
+	return OnMessageTriggerName
+}
+
+func (t *OnMessage) Label() string {
+	return "On Message"
+}
+
+func (t *OnMessage) Description() string {
+	return "Trigger a workflow when a message is received on a RabbitMQ queue"
+}
+
+func (t *OnMessage) Documentation() string {
+	return `The On Message trigger starts a workflow execution for each message received on a RabbitMQ queue.
+
+## Use Cases
+
+- **Event-driven workflows**: React to events published by your services
+- **Task processing**: Start workflows for jobs published to a work queue
+- **System integration**: Connect systems already using RabbitMQ without exposing HTTP endpoints
+
+## How It Works
+
+- If a **Queue** is given, messages are consumed from that existing queue.
+- Otherwise, SuperPlane declares a durable queue for the trigger, and removes it when the trigger is deleted.
+- If an **Exchange** is given, the queue is bound to it using the **Routing Key**.
+- SuperPlane keeps a consumer connected to the queue for each trigger, and messages are processed as they arrive.
+
+## Acknowledgement
+
+- **After event is emitted**: messages are acknowledged only after the workflow event is stored. If storing the event fails because the database is unavailable, the message is requeued, so a message can start more than one execution. Messages that can never be stored, like messages for a deleted trigger, are rejected without requeueing: configure a dead letter exchange on the queue to keep them. Up to 100 messages are delivered before they are acknowledged.
+- **On receive**: messages are acknowledged as soon as they are delivered, so they are never redelivered, but are lost if storing the event fails.
+
+## Event Data
+
+Each event contains:
+- ` + "`body`" + `: The message body, parsed as JSON when possible
+- ` + "`exchange`" + ` and ` + "`routingKey`" + `: Where the message was published
+- ` + "`headers`" + `: Message headers
+- ` + "`properties`" + `: Message properties like content type, message ID and correlation ID
+- ` + "`redelivered`" + `: Whether the message was delivered before`
+}
+
+func (t *OnMessage) Icon() string {
+	return "rabbitmq"
+}
+
+func (t *OnMessage) Color() string {
+	return "gray"
+}
+
+func (t *OnMessage) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:        "queue",
+			Label:       "Queue",
+			Type:        configuration.FieldTypeString,
+			Required:    false,
+			Description: "Existing queue to consume from. Leave empty to let SuperPlane declare one.",
+		},
+		{
+			Name:        "exchange",
+			Label:       "Exchange",
+			Type:        configuration.FieldTypeString,
+			Required:    false,
+			Description: "Exchange to bind the queue to",
+		},
+		{
+			Name:        "routingKey",
+			Label:       "Routing Key",
+			Type:        configuration.FieldTypeString,
+			Required:    false,
+			Default:     "#",
+			Description: "Routing key (or pattern, for topic exchanges) used to bind the queue",
+			VisibilityConditions: []configuration.VisibilityCondition{
+				{Field: "exchange", Values: []string{"*"}},
+			},
+		},
+		{
+			Name:     "ackMode",
+			Label:    "Acknowledge",
+			Type:     configuration.FieldTypeSelect,
+			Required: true,
+			Default:  AckModeAfterEmit,
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "After event is emitted", Value: AckModeAfterEmit},
+						{Label: "On receive", Value: AckModeOnReceive},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (t *OnMessage) Setup(ctx core.TriggerContext) error {
+	var config OnMessageConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.Queue == "" && config.Exchange == "" {
+		return fmt.Errorf("queue or exchange is required")
+	}
+
+	if config.AckMode != "" && config.AckMode != AckModeAfterEmit && config.AckMode != AckModeOnReceive {
+		return fmt.Errorf("invalid ack mode: %s", config.AckMode)
+	}
+
+	var metadata OnMessageMetadata
+	if err := mapstructure.Decode(ctx.Metadata.Get(), &metadata); err != nil {
+		return fmt.Errorf("failed to decode metadata: %w", err)
+	}
+
+	desired := OnMessageMetadata{
+		Queue:      config.Queue,
+		Exchange:   config.Exchange,
+		RoutingKey: config.RoutingKey,
+	}
+
+	//
+	// If no queue is given, we manage one for the trigger.
+	// The same queue is kept across configuration changes,
+	// so messages are not lost when the binding changes.
+	//
+	if desired.Queue == "" {
+		desired.Managed = true
+		desired.Queue = metadata.Queue
+		if !metadata.Managed || metadata.Queue == "" {
+			desired.Queue = "superplane." + uuid.NewString()
+		}
+	}
+
+	if desired != metadata {
+		client, err := NewClient(ctx.Integration)
+		if err != nil {
+			return fmt.Errorf("failed to connect to RabbitMQ: %w", err)
+		}
+
+		defer client.Close()
+
+		if metadata.Managed && metadata.Queue != desired.Queue {
+			if err := client.DeleteQueue(metadata.Queue); err != nil {
+				ctx.Logger.Warnf("error deleting queue %s: %v", metadata.Queue, err)
+			}
+		}
+
+		bindingChanged := metadata.Exchange != desired.Exchange || metadata.RoutingKey != desired.RoutingKey
+		if metadata.Queue == desired.Queue && metadata.Exchange != "" && bindingChanged {
+			if err := client.UnbindQueue(metadata.Queue, metadata.Exchange, metadata.RoutingKey); err != nil {
+				ctx.Logger.Warnf("error unbinding queue %s: %v", metadata.Queue, err)
+			}
+		}
+
+		err = client.DeclareQueue(desired.Queue, desired.Managed, desired.Exchange, desired.RoutingKey)
+		if err != nil {
+			return err
+		}
+
+		if err := ctx.Metadata.Set(desired); err != nil {
+			return fmt.Errorf("failed to set metadata: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (t *OnMessage) Actions() []core.Action {
+	return []core.Action{
+		{
+			Name:           OnMessageReceiveAction,
+			UserAccessible: false,
+		},
+	}
+}
+
+// HandleAction is called by the RabbitMQ consumer worker for every message delivered,
+// with the payload built by BuildMessagePayload as parameters,
+// in the transaction that must be committed before the message is acknowledged.
+func (t *OnMessage) HandleAction(ctx core.TriggerActionContext) (map[string]any, error) {
+	switch ctx.Name {
+	case OnMessageReceiveAction:
+		return nil, ctx.Events.Emit(OnMessageEmittedEventType, ctx.Parameters)
+	}
+
+	return nil, fmt.Errorf("action %s not supported", ctx.Name)
+}
+
+// BuildMessagePayload builds the event payload for a delivered message.
+func BuildMessagePayload(queue string, delivery *amqp.Delivery) map[string]any {
+	var body any
+	if err := json.Unmarshal(delivery.Body, &body); err != nil {
+		body = string(delivery.Body)
+	}
+
+	properties := map[string]any{
+		"contentType":   delivery.ContentType,
+		"messageId":     delivery.MessageId,
+		"correlationId": delivery.CorrelationId,
+		"replyTo":       delivery.ReplyTo,
+		"type":          delivery.Type,
+		"appId":         delivery.AppId,
+	}
+
+	if !delivery.Timestamp.IsZero() {
+		properties["timestamp"] = delivery.Timestamp.UTC().Format(time.RFC3339)
+	}
+
+	headers := map[string]any{}
+	for key, value := range delivery.Headers {
+		headers[key] = headerValue(value)
+	}
+
+	return map[string]any{
+		"queue":       queue,
+		"exchange":    delivery.Exchange,
+		"routingKey":  delivery.RoutingKey,
+		"redelivered": delivery.Redelivered,
+		"headers":     headers,
+		"properties":  properties,
+		"body":        body,
+	}
+}
+
+// AMQP header values can be of types that are not JSON friendly,
+// so we convert them into something that can be stored in an event.
+func headerValue(value any) any {
+	switch v := value.(type) {
+	case []byte:
+		return string(v)
+	case time.Time:
+		return v.UTC().Format(time.RFC3339)
+	case amqp.Table:
+		m := map[string]any{}
+		for key, item := range v {
+			m[key] = headerValue(item)
+		}
+		return m
+	case []any:
+		items := make([]any, 0, len(v))
+		for _, item := range v {
+			items = append(items, headerValue(item))
+		}
+		return items
+	case amqp.Decimal:
+		return float64(v.Value) / math.Pow10(int(v.Scale))
+	default:
+		return v
+	}
+}
+
+func (t *OnMessage) HandleWebhook(ctx core.WebhookRequestContext) (int, *core.WebhookResponseBody, error) {
+	return 200, nil, nil
+}
+
+func (t *OnMessage) Cleanup(ctx core.TriggerContext) error {
+	var metadata OnMessageMetadata
+	if err := mapstructure.Decode(ctx.Metadata.Get(), &metadata); err != nil {
+		return fmt.Errorf("failed to decode metadata: %w", err)
+	}
+
+	if !metadata.Managed || metadata.Queue == "" {
+		return nil
+	}
+
+	client, err := NewClient(ctx.Integration)
+	if err != nil {
+		return fmt.Errorf("failed to connect to RabbitMQ: %w", err)
+	}
+
+	defer client.Close()
+	return client.DeleteQueue(metadata.Queue)
+}
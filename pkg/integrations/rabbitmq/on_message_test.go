@@ -0,0 +1,220 @@
+package rabbitmq
+
+import (
+	"fmt"
+	"testing"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/test/support/contexts"
+)
+
+type failingEventContext struct{}
+
+func (f *failingEventContext) Emit(payloadType string, payload any) error {
+	return fmt.Errorf("database is down")
+}
+
+func Test__OnMessage__Setup(t *testing.T) {
+	trigger := &OnMessage{}
+	logger := logrus.NewEntry(logrus.New())
+
+	t.Run("queue or exchange is required", func(t *testing.T) {
+		err := trigger.Setup(core.TriggerContext{
+			Configuration: map[string]any{"ackMode": AckModeAfterEmit},
+			Metadata:      &contexts.MetadataContext{},
+			Integration:   &contexts.IntegrationContext{Configuration: integrationConfig()},
+		})
+
+		require.ErrorContains(t, err, "queue or exchange is required")
+	})
+
+	t.Run("declares and binds managed queue", func(t *testing.T) {
+		channel := &fakeChannel{}
+		useFakeChannel(t, channel, nil)
+		metadata := &contexts.MetadataContext{}
+
+		err := trigger.Setup(core.TriggerContext{
+			Logger:        logger,
+			Configuration: map[string]any{"exchange": "deployments", "routingKey": "deploy.*", "ackMode": AckModeAfterEmit},
+			Metadata:      metadata,
+			Integration:   &contexts.IntegrationContext{Configuration: integrationConfig()},
+		})
+
+		require.NoError(t, err)
+		m, ok := metadata.Metadata.(OnMessageMetadata)
+		require.True(t, ok)
+		assert.True(t, m.Managed)
+		assert.Contains(t, m.Queue, "superplane.")
+		assert.Equal(t, []string{m.Queue}, channel.Declared)
+		assert.Equal(t, []binding{{Queue: m.Queue, Exchange: "deployments", RoutingKey: "deploy.*"}}, channel.Bindings)
+	})
+
+	t.Run("changing binding keeps managed queue", func(t *testing.T) {
+		channel := &fakeChannel{}
+		useFakeChannel(t, channel, nil)
+		existing := OnMessageMetadata{Queue: "superplane.existing", Managed: true, Exchange: "deployments", RoutingKey: "deploy.*"}
+		metadata := &contexts.MetadataContext{Metadata: existing}
+
+		err := trigger.Setup(core.TriggerContext{
+			Logger:        logger,
+			Configuration: map[string]any{"exchange": "deployments", "routingKey": "deploy.production", "ackMode": AckModeAfterEmit},
+			Metadata:      metadata,
+			Integration:   &contexts.IntegrationContext{Configuration: integrationConfig()},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "superplane.existing", metadata.Metadata.(OnMessageMetadata).Queue)
+		assert.Empty(t, channel.Deleted)
+		assert.Equal(t, []binding{{Queue: "superplane.existing", Exchange: "deployments", RoutingKey: "deploy.*"}}, channel.Unbindings)
+		assert.Equal(t, []binding{{Queue: "superplane.existing", Exchange: "deployments", RoutingKey: "deploy.production"}}, channel.Bindings)
+	})
+
+	t.Run("unchanged configuration does not connect", func(t *testing.T) {
+		useFakeChannel(t, &fakeChannel{}, errConnectionRefused)
+		existing := OnMessageMetadata{Queue: "superplane.existing", Managed: true, Exchange: "deployments", RoutingKey: "#"}
+
+		err := trigger.Setup(core.TriggerContext{
+			Logger:        logger,
+			Configuration: map[string]any{"exchange": "deployments", "routingKey": "#", "ackMode": AckModeAfterEmit},
+			Metadata:      &contexts.MetadataContext{Metadata: existing},
+			Integration:   &contexts.IntegrationContext{Configuration: integrationConfig()},
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("existing queue is only checked", func(t *testing.T) {
+		channel := &fakeChannel{}
+		useFakeChannel(t, channel, nil)
+		existing := OnMessageMetadata{Queue: "superplane.existing", Managed: true}
+		metadata := &contexts.MetadataContext{Metadata: existing}
+
+		err := trigger.Setup(core.TriggerContext{
+			Logger:        logger,
+			Configuration: map[string]any{"queue": "jobs", "ackMode": AckModeAfterEmit},
+			Metadata:      metadata,
+			Integration:   &contexts.IntegrationContext{Configuration: integrationConfig()},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, OnMessageMetadata{Queue: "jobs"}, metadata.Metadata)
+		assert.Equal(t, []string{"jobs"}, channel.Passive)
+		assert.Empty(t, channel.Declared)
+		assert.Equal(t, []string{"superplane.existing"}, channel.Deleted)
+	})
+}
+
+func Test__OnMessage__Receive(t *testing.T) {
+	trigger := &OnMessage{}
+	logger := logrus.NewEntry(logrus.New())
+
+	t.Run("emits event for message", func(t *testing.T) {
+		delivery := amqp.Delivery{
+			DeliveryTag: 1,
+			Exchange:    "deployments",
+			RoutingKey:  "deploy.production",
+			ContentType: "application/json",
+			Headers:     amqp.Table{"source": []byte("ci")},
+			Body:        []byte(`{"service":"api"}`),
+		}
+
+		events := &contexts.EventContext{}
+		_, err := trigger.HandleAction(core.TriggerActionContext{
+			Name:          OnMessageReceiveAction,
+			Parameters:    BuildMessagePayload("jobs", &delivery),
+			Logger:        logger,
+			Configuration: map[string]any{"queue": "jobs", "ackMode": AckModeAfterEmit},
+			Metadata:      &contexts.MetadataContext{Metadata: OnMessageMetadata{Queue: "jobs"}},
+			Events:        events,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, 1, events.Count())
+		assert.Equal(t, OnMessageEmittedEventType, events.Payloads[0].Type)
+
+		payload := events.Payloads[0].Data.(map[string]any)
+		assert.Equal(t, "jobs", payload["queue"])
+		assert.Equal(t, map[string]any{"service": "api"}, payload["body"])
+		assert.Equal(t, "deploy.production", payload["routingKey"])
+		assert.Equal(t, map[string]any{"source": "ci"}, payload["headers"])
+	})
+
+	t.Run("plain text body", func(t *testing.T) {
+		payload := BuildMessagePayload("jobs", &amqp.Delivery{Body: []byte("plain text")})
+		assert.Equal(t, "plain text", payload["body"])
+	})
+
+	t.Run("event errors are returned, so the message is requeued", func(t *testing.T) {
+		_, err := trigger.HandleAction(core.TriggerActionContext{
+			Name:       OnMessageReceiveAction,
+			Parameters: BuildMessagePayload("jobs", &amqp.Delivery{Body: []byte("{}")}),
+			Logger:     logger,
+			Events:     &failingEventContext{},
+		})
+
+		require.ErrorContains(t, err, "database is down")
+	})
+}
+
+func Test__Client__Consume(t *testing.T) {
+	t.Run("limits messages not acknowledged yet", func(t *testing.T) {
+		channel := &fakeChannel{}
+		useFakeChannel(t, channel, nil)
+
+		client, err := NewClient(&contexts.IntegrationContext{Configuration: integrationConfig()})
+		require.NoError(t, err)
+
+		_, err = client.Consume("jobs", false, OnMessagePrefetch)
+		require.NoError(t, err)
+		assert.Equal(t, OnMessagePrefetch, channel.Prefetch)
+		assert.Equal(t, "jobs", channel.Consumed)
+		assert.False(t, channel.AutoAck)
+	})
+
+	t.Run("auto acknowledge on receive", func(t *testing.T) {
+		channel := &fakeChannel{}
+		useFakeChannel(t, channel, nil)
+
+		client, err := NewClient(&contexts.IntegrationContext{Configuration: integrationConfig()})
+		require.NoError(t, err)
+
+		_, err = client.Consume("jobs", true, OnMessagePrefetch)
+		require.NoError(t, err)
+		assert.Zero(t, channel.Prefetch)
+		assert.True(t, channel.AutoAck)
+	})
+}
+
+func Test__OnMessage__Cleanup(t *testing.T) {
+	trigger := &OnMessage{}
+
+	t.Run("deletes managed queue", func(t *testing.T) {
+		channel := &fakeChannel{}
+		useFakeChannel(t, channel, nil)
+
+		err := trigger.Cleanup(core.TriggerContext{
+			Metadata:    &contexts.MetadataContext{Metadata: OnMessageMetadata{Queue: "superplane.abc", Managed: true}},
+			Integration: &contexts.IntegrationContext{Configuration: integrationConfig()},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"superplane.abc"}, channel.Deleted)
+	})
+
+	t.Run("keeps existing queue", func(t *testing.T) {
+		channel := &fakeChannel{}
+		useFakeChannel(t, channel, nil)
+
+		err := trigger.Cleanup(core.TriggerContext{
+			Metadata:    &contexts.MetadataContext{Metadata: OnMessageMetadata{Queue: "jobs"}},
+			Integration: &contexts.IntegrationContext{Configuration: integrationConfig()},
+		})
+
+		require.NoError(t, err)
+		assert.Empty(t, channel.Deleted)
+	})
+}
@@ -0,0 +1,331 @@
+package rabbitmq
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const (
+	PublishMessageFormatJSON = "json"
+	PublishMessageFormatText = "text"
+)
+
+type PublishMessage struct{}
+
+type PublishMessageConfiguration struct {
+	Exchange      string   `json:"exchange" mapstructure:"exchange"`
+	RoutingKey    string   `json:"routingKey" mapstructure:"routingKey"`
+	Format        string   `json:"format" mapstructure:"format"`
+	JSON          *any     `json:"json" mapstructure:"json"`
+	Text          *string  `json:"text" mapstructure:"text"`
+	Headers       []Header `json:"headers" mapstructure:"headers"`
+	Persistent    bool     `json:"persistent" mapstructure:"persistent"`
+	MessageID     string   `json:"messageId" mapstructure:"messageId"`
+	CorrelationID string   `json:"correlationId" mapstructure:"correlationId"`
+}
+
+type Header struct {
+	Name  string `json:"name" mapstructure:"name"`
+	Value string `json:"value" mapstructure:"value"`
+}
+
+func (c *PublishMessage) Name() string {
+	return "rabbitmq.publishMessage"
+}
+
+func (c *PublishMessage) Label() string {
+	return "Publish Message"
+}
+
+func (c *PublishMessage) Description() string {
+	return "Publish a message to a RabbitMQ exchange"
+}
+
+func (c *PublishMessage) Documentation() string {
+	return `The Publish Message component publishes a message to a RabbitMQ exchange.
+
+## Use Cases
+
+- **Event-driven systems**: Notify your services about workflow results
+- **Task dispatching**: Publish jobs to work queues consumed by your workers
+- **Fan-out**: Broadcast messages through fanout or topic exchanges
+
+## Configuration
+
+- **Exchange**: The exchange to publish to. Leave empty to use the default exchange, where the routing key is the queue name.
+- **Routing Key**: The routing key for the message
+- **Message Format**: Publish a JSON object or plain text
+- **Headers**: Optional message headers
+- **Persistent**: Publish the message as persistent, so it survives broker restarts
+- **Message ID** and **Correlation ID**: Optional message properties
+
+## Output
+
+Returns the exchange, routing key and message ID of the published message.`
+}
+
+func (c *PublishMessage) Icon() string {
+	return "rabbitmq"
+}
+
+func (c *PublishMessage) Color() string {
+	return "gray"
+}
+
+func (c *PublishMessage) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *PublishMessage) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:        "exchange",
+			Label:       "Exchange",
+			Type:        configuration.FieldTypeString,
+			Required:    false,
+			Description: "Exchange to publish to. Leave empty for the default exchange.",
+		},
+		{
+			Name:        "routingKey",
+			Label:       "Routing Key",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Description: "Routing key for the message",
+		},
+		{
+			Name:     "format",
+			Label:    "Message Format",
+			Type:     configuration.FieldTypeSelect,
+			Required: true,
+			Default:  PublishMessageFormatJSON,
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Value: PublishMessageFormatJSON, Label: "JSON"},
+						{Value: PublishMessageFormatText, Label: "Text"},
+					},
+				},
+			},
+		},
+		{
+			Name:     "json",
+			Label:    "JSON Message",
+			Type:     configuration.FieldTypeObject,
+			Required: false,
+			Default:  map[string]any{},
+			VisibilityConditions: []configuration.VisibilityCondition{
+				{Field: "format", Values: []string{PublishMessageFormatJSON}},
+			},
+		},
+		{
+			Name:     "text",
+			Label:    "Text Message",
+			Type:     configuration.FieldTypeText,
+			Required: false,
+			VisibilityConditions: []configuration.VisibilityCondition{
+				{Field: "format", Values: []string{PublishMessageFormatText}},
+			},
+		},
+		{
+			Name:        "headers",
+			Label:       "Headers",
+			Type:        configuration.FieldTypeList,
+			Required:    false,
+			Togglable:   true,
+			Description: "Headers to send with the message",
+			TypeOptions: &configuration.TypeOptions{
+				List: &configuration.ListTypeOptions{
+					ItemLabel: "Header",
+					ItemDefinition: &configuration.ListItemDefinition{
+						Type: configuration.FieldTypeObject,
+						Schema: []configuration.Field{
+							{
+								Name:     "name",
+								Type:     configuration.FieldTypeString,
+								Label:    "Header Name",
+								Required: true,
+							},
+							{
+								Name:     "value",
+								Type:     configuration.FieldTypeString,
+								Label:    "Header Value",
+								Required: true,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:        "persistent",
+			Label:       "Persistent",
+			Type:        configuration.FieldTypeBool,
+			Required:    false,
+			Default:     true,
+			Description: "Persist the message to disk, so it survives broker restarts",
+		},
+		{
+			Name:        "messageId",
+			Label:       "Message ID",
+			Type:        configuration.FieldTypeString,
+			Required:    false,
+			Togglable:   true,
+			Description: "Message ID property. A random one is generated if not set.",
+		},
+		{
+			Name:        "correlationId",
+			Label:       "Correlation ID",
+			Type:        configuration.FieldTypeString,
+			Required:    false,
+			Togglable:   true,
+			Description: "Correlation ID property",
+		},
+	}
+}
+
+func (c *PublishMessage) Setup(ctx core.SetupContext) error {
+	var config PublishMessageConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	return config.validate()
+}
+
+func (c PublishMessageConfiguration) validate() error {
+	if c.RoutingKey == "" && c.Exchange == "" {
+		return fmt.Errorf("routing key is required")
+	}
+
+	if c.Format == "" {
+		return fmt.Errorf("format is required")
+	}
+
+	if c.Format == PublishMessageFormatJSON && c.JSON == nil {
+		return fmt.Errorf("JSON message is required")
+	}
+
+	if c.Format == PublishMessageFormatText && c.Text == nil {
+		return fmt.Errorf("text message is required")
+	}
+
+	for _, header := range c.Headers {
+		if header.Name == "" {
+			return fmt.Errorf("header name is required")
+		}
+	}
+
+	return nil
+}
+
+func (c *PublishMessage) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *PublishMessage) Execute(ctx core.ExecutionContext) error {
+	var config PublishMessageConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if err := config.validate(); err != nil {
+		return err
+	}
+
+	msg, err := buildPublishing(config)
+	if err != nil {
+		return err
+	}
+
+	client, err := NewClient(ctx.Integration)
+	if err != nil {
+		return fmt.Errorf("failed to connect to RabbitMQ: %w", err)
+	}
+
+	defer client.Close()
+
+	err = client.Publish(config.Exchange, config.RoutingKey, msg)
+	if err != nil {
+		return fmt.Errorf("failed to publish message: %w", err)
+	}
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"rabbitmq.message.published",
+		[]any{
+			map[string]any{
+				"exchange":    config.Exchange,
+				"routingKey":  config.RoutingKey,
+				"messageId":   msg.MessageId,
+				"publishedAt": msg.Timestamp.Format(time.RFC3339),
+			},
+		},
+	)
+}
+
+func buildPublishing(config PublishMessageConfiguration) (amqp.Publishing, error) {
+	msg := amqp.Publishing{
+		MessageId:     config.MessageID,
+		CorrelationId: config.CorrelationID,
+		Timestamp:     time.Now().UTC(),
+		DeliveryMode:  amqp.Transient,
+	}
+
+	if msg.MessageId == "" {
+		msg.MessageId = uuid.NewString()
+	}
+
+	if config.Persistent {
+		msg.DeliveryMode = amqp.Persistent
+	}
+
+	if config.Format == PublishMessageFormatText {
+		msg.ContentType = "text/plain"
+		msg.Body = []byte(*config.Text)
+	} else {
+		body, err := json.Marshal(*config.JSON)
+		if err != nil {
+			return msg, fmt.Errorf("failed to marshal JSON message: %w", err)
+		}
+
+		msg.ContentType = "application/json"
+		msg.Body = body
+	}
+
+	if len(config.Headers) > 0 {
+		msg.Headers = amqp.Table{}
+		for _, header := range config.Headers {
+			msg.Headers[header.Name] = header.Value
+		}
+	}
+
+	return msg, nil
+}
+
+func (c *PublishMessage) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *PublishMessage) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *PublishMessage) HandleWebhook(ctx core.WebhookRequestContext) (int, *core.WebhookResponseBody, error) {
+	return http.StatusOK, nil, nil
+}
+
+func (c *PublishMessage) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *PublishMessage) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,121 @@
+package rabbitmq
+
+import (
+	"testing"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__PublishMessage__Setup(t *testing.T) {
+	component := &PublishMessage{}
+
+	t.Run("missing routing key -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Configuration: map[string]any{"format": "json", "json": map[string]any{}},
+		})
+
+		require.ErrorContains(t, err, "routing key is required")
+	})
+
+	t.Run("missing text -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Configuration: map[string]any{"routingKey": "jobs", "format": "text"},
+		})
+
+		require.ErrorContains(t, err, "text message is required")
+	})
+
+	t.Run("valid configuration", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Configuration: map[string]any{"exchange": "events", "format": "json", "json": map[string]any{"a": 1}},
+		})
+
+		require.NoError(t, err)
+	})
+}
+
+func Test__PublishMessage__Execute(t *testing.T) {
+	component := &PublishMessage{}
+
+	t.Run("publishes JSON message", func(t *testing.T) {
+		channel := &fakeChannel{}
+		useFakeChannel(t, channel, nil)
+		state := &contexts.ExecutionStateContext{KVs: map[string]string{}}
+
+		err := component.Execute(core.ExecutionContext{
+			Configuration: map[string]any{
+				"exchange":      "deployments",
+				"routingKey":    "deploy.production",
+				"format":        "json",
+				"json":          map[string]any{"service": "api"},
+				"persistent":    true,
+				"correlationId": "abc",
+				"headers": []map[string]any{
+					{"name": "source", "value": "superplane"},
+				},
+			},
+			Integration:    &contexts.IntegrationContext{Configuration: integrationConfig()},
+			ExecutionState: state,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, channel.Published, 1)
+
+		published := channel.Published[0]
+		assert.Equal(t, "deployments", published.Exchange)
+		assert.Equal(t, "deploy.production", published.RoutingKey)
+		assert.Equal(t, `{"service":"api"}`, string(published.Message.Body))
+		assert.Equal(t, "application/json", published.Message.ContentType)
+		assert.Equal(t, amqp.Persistent, published.Message.DeliveryMode)
+		assert.Equal(t, "abc", published.Message.CorrelationId)
+		assert.NotEmpty(t, published.Message.MessageId)
+		assert.Equal(t, amqp.Table{"source": "superplane"}, published.Message.Headers)
+
+		assert.True(t, state.Passed)
+		assert.Equal(t, "rabbitmq.message.published", state.Type)
+		assert.True(t, channel.Closed)
+	})
+
+	t.Run("publishes text message", func(t *testing.T) {
+		channel := &fakeChannel{}
+		useFakeChannel(t, channel, nil)
+		state := &contexts.ExecutionStateContext{KVs: map[string]string{}}
+
+		err := component.Execute(core.ExecutionContext{
+			Configuration: map[string]any{
+				"routingKey": "jobs",
+				"format":     "text",
+				"text":       "hello",
+				"messageId":  "msg-1",
+			},
+			Integration:    &contexts.IntegrationContext{Configuration: integrationConfig()},
+			ExecutionState: state,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, channel.Published, 1)
+		assert.Equal(t, "", channel.Published[0].Exchange)
+		assert.Equal(t, "hello", string(channel.Published[0].Message.Body))
+		assert.Equal(t, "msg-1", channel.Published[0].Message.MessageId)
+		assert.Equal(t, amqp.Transient, channel.Published[0].Message.DeliveryMode)
+	})
+
+	t.Run("publish error -> error", func(t *testing.T) {
+		channel := &fakeChannel{PublishErr: errConnectionRefused}
+		useFakeChannel(t, channel, nil)
+		state := &contexts.ExecutionStateContext{KVs: map[string]string{}}
+
+		err := component.Execute(core.ExecutionContext{
+			Configuration:  map[string]any{"routingKey": "jobs", "format": "text", "text": "hello"},
+			Integration:    &contexts.IntegrationContext{Configuration: integrationConfig()},
+			ExecutionState: state,
+		})
+
+		require.ErrorContains(t, err, "failed to publish message")
+		assert.False(t, state.Finished)
+	})
+}
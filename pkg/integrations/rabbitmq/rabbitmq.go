@@ -0,0 +1,161 @@
+package rabbitmq
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/registry"
+)
+
+func init() {
+	registry.RegisterIntegration("rabbitmq", &RabbitMQ{})
+}
+
+type RabbitMQ struct{}
+
+type Configuration struct {
+	Host     string `json:"host" mapstructure:"host"`
+	Port     string `json:"port" mapstructure:"port"`
+	Username string `json:"username" mapstructure:"username"`
+	Password string `json:"password" mapstructure:"password"`
+	VHost    string `json:"vhost" mapstructure:"vhost"`
+}
+
+func (r *RabbitMQ) Name() string {
+	return "rabbitmq"
+}
+
+func (r *RabbitMQ) Label() string {
+	return "RabbitMQ"
+}
+
+func (r *RabbitMQ) Icon() string {
+	return "rabbitmq"
+}
+
+func (r *RabbitMQ) Description() string {
+	return "Consume and publish messages on RabbitMQ exchanges and queues"
+}
+
+func (r *RabbitMQ) Instructions() string {
+	return `To set up the RabbitMQ integration:
+
+1. Create a user for SuperPlane in your RabbitMQ server
+2. Grant the user **configure**, **write** and **read** permissions on the virtual host you want to use
+3. Fill in the connection details below`
+}
+
+func (r *RabbitMQ) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:        "host",
+			Label:       "Host",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Description: "RabbitMQ server hostname",
+		},
+		{
+			Name:        "port",
+			Label:       "Port",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Default:     "5672",
+			Description: "RabbitMQ server port (commonly 5672, or 5671 for TLS)",
+		},
+		{
+			Name:        "username",
+			Label:       "Username",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Description: "Username used to connect to RabbitMQ",
+		},
+		{
+			Name:        "password",
+			Label:       "Password",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Sensitive:   true,
+			Description: "Password used to connect to RabbitMQ",
+		},
+		{
+			Name:        "vhost",
+			Label:       "Virtual Host",
+			Type:        configuration.FieldTypeString,
+			Required:    false,
+			Default:     "/",
+			Description: "Virtual host to connect to",
+		},
+		{
+			Name:        "useTLS",
+			Label:       "Use TLS",
+			Type:        configuration.FieldTypeBool,
+			Required:    false,
+			Default:     false,
+			Description: "Connect using AMQPS",
+		},
+	}
+}
+
+func (r *RabbitMQ) Components() []core.Component {
+	return []core.Component{
+		&PublishMessage{},
+	}
+}
+
+func (r *RabbitMQ) Triggers() []core.Trigger {
+	return []core.Trigger{
+		&OnMessage{},
+	}
+}
+
+func (r *RabbitMQ) Cleanup(ctx core.IntegrationCleanupContext) error {
+	return nil
+}
+
+func (r *RabbitMQ) Sync(ctx core.SyncContext) error {
+	config := Configuration{}
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %v", err)
+	}
+
+	if config.Host == "" {
+		return fmt.Errorf("host is required")
+	}
+
+	port, err := strconv.Atoi(config.Port)
+	if err != nil || port <= 0 || port > 65535 {
+		return fmt.Errorf("port must be a number between 1 and 65535")
+	}
+
+	if config.Username == "" {
+		return fmt.Errorf("username is required")
+	}
+
+	client, err := NewClient(ctx.Integration)
+	if err != nil {
+		return fmt.Errorf("failed to connect to RabbitMQ: %w", err)
+	}
+
+	client.Close()
+	ctx.Integration.Ready()
+	return nil
+}
+
+func (r *RabbitMQ) HandleRequest(ctx core.HTTPRequestContext) {
+	// no-op
+}
+
+func (r *RabbitMQ) ListResources(resourceType string, ctx core.ListResourcesContext) ([]core.IntegrationResource, error) {
+	return []core.IntegrationResource{}, nil
+}
+
+func (r *RabbitMQ) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (r *RabbitMQ) HandleAction(ctx core.IntegrationActionContext) error {
+	return nil
+}
@@ -0,0 +1,136 @@
+package rabbitmq
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"testing"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+type binding struct {
+	Queue      string
+	Exchange   string
+	RoutingKey string
+}
+
+type publishedMessage struct {
+	Exchange   string
+	RoutingKey string
+	Message    amqp.Publishing
+}
+
+type fakeChannel struct {
+	URI        string
+	TLS        bool
+	Declared   []string
+	Passive    []string
+	Bindings   []binding
+	Unbindings []binding
+	Deleted    []string
+	Published  []publishedMessage
+	Deliveries []amqp.Delivery
+	Consumed   string
+	Prefetch   int
+	AutoAck    bool
+	Closed     bool
+	DeclareErr error
+	PublishErr error
+}
+
+func (c *fakeChannel) QueueDeclare(name string, durable, autoDelete, exclusive, noWait bool, args amqp.Table) (amqp.Queue, error) {
+	if c.DeclareErr != nil {
+		return amqp.Queue{}, c.DeclareErr
+	}
+
+	c.Declared = append(c.Declared, name)
+	return amqp.Queue{Name: name}, nil
+}
+
+func (c *fakeChannel) QueueDeclarePassive(name string, durable, autoDelete, exclusive, noWait bool, args amqp.Table) (amqp.Queue, error) {
+	if c.DeclareErr != nil {
+		return amqp.Queue{}, c.DeclareErr
+	}
+
+	c.Passive = append(c.Passive, name)
+	return amqp.Queue{Name: name}, nil
+}
+
+func (c *fakeChannel) QueueBind(name, key, exchange string, noWait bool, args amqp.Table) error {
+	c.Bindings = append(c.Bindings, binding{Queue: name, Exchange: exchange, RoutingKey: key})
+	return nil
+}
+
+func (c *fakeChannel) QueueUnbind(name, key, exchange string, args amqp.Table) error {
+	c.Unbindings = append(c.Unbindings, binding{Queue: name, Exchange: exchange, RoutingKey: key})
+	return nil
+}
+
+func (c *fakeChannel) QueueDelete(name string, ifUnused, ifEmpty, noWait bool) (int, error) {
+	c.Deleted = append(c.Deleted, name)
+	return 0, nil
+}
+
+func (c *fakeChannel) Qos(prefetchCount, prefetchSize int, global bool) error {
+	c.Prefetch = prefetchCount
+	return nil
+}
+
+func (c *fakeChannel) Consume(queue, consumer string, autoAck, exclusive, noLocal, noWait bool, args amqp.Table) (<-chan amqp.Delivery, error) {
+	c.Consumed = queue
+	c.AutoAck = autoAck
+
+	deliveries := make(chan amqp.Delivery, len(c.Deliveries))
+	for _, delivery := range c.Deliveries {
+		deliveries <- delivery
+	}
+
+	close(deliveries)
+	return deliveries, nil
+}
+
+func (c *fakeChannel) PublishWithContext(ctx context.Context, exchange, key string, mandatory, immediate bool, msg amqp.Publishing) error {
+	if c.PublishErr != nil {
+		return c.PublishErr
+	}
+
+	c.Published = append(c.Published, publishedMessage{Exchange: exchange, RoutingKey: key, Message: msg})
+	return nil
+}
+
+func (c *fakeChannel) Close() error {
+	c.Closed = true
+	return nil
+}
+
+// useFakeChannel replaces the dialer for the duration of the test.
+// If dialErr is set, connecting fails with it.
+func useFakeChannel(t *testing.T, channel *fakeChannel, dialErr error) {
+	original := dial
+	dial = func(uri string, tlsConfig *tls.Config) (Channel, io.Closer, error) {
+		if dialErr != nil {
+			return nil, nil, dialErr
+		}
+
+		channel.URI = uri
+		channel.TLS = tlsConfig != nil
+		return channel, io.NopCloser(nil), nil
+	}
+
+	t.Cleanup(func() { dial = original })
+}
+
+func integrationConfig() map[string]any {
+	return map[string]any{
+		"host":     "rabbitmq.example.com",
+		"port":     "5672",
+		"username": "superplane",
+		"password": "secret",
+		"vhost":    "/",
+		"useTLS":   "false",
+	}
+}
+
+var errConnectionRefused = fmt.Errorf("connection refused")
@@ -17,13 +17,24 @@ func NewSigner(secret string) *Signer {
 }
 
 func (s *Signer) Generate(subject string, duration time.Duration) (string, error) {
+	return s.GenerateWithClaims(subject, duration, nil)
+}
+
+// GenerateWithClaims generates a token with additional claims.
+// The registered claims (iat, nbf, exp and sub) cannot be overridden.
+func (s *Signer) GenerateWithClaims(subject string, duration time.Duration, additionalClaims map[string]any) (string, error) {
+	claims := jwt.MapClaims{}
+	for k, v := range additionalClaims {
+		claims[k] = v
+	}
+
 	now := time.Now()
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"iat": now.Unix(),
-		"nbf": now.Unix(),
-		"exp": now.Add(duration).Unix(),
-		"sub": subject,
-	})
+	claims["iat"] = now.Unix()
+	claims["nbf"] = now.Unix()
+	claims["exp"] = now.Add(duration).Unix()
+	claims["sub"] = subject
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 
 	tokenString, err := token.SignedString([]byte(s.Secret))
 	if err != nil {
@@ -0,0 +1,223 @@
+package kms
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+)
+
+const (
+	awsKMSTargetPrefix = "TrentService."
+
+	//
+	// An alias can be moved to a new key to rotate the master key,
+	// so the key it points to is read again once in a while.
+	//
+	awsKMSActiveKeyTTL = time.Minute
+)
+
+type AWSKMSProviderOptions struct {
+	// KeyID is the ID, ARN or alias of the KMS key used to wrap new data keys.
+	KeyID string
+
+	Region      string
+	Credentials aws.Credentials
+
+	// Endpoint overrides the regional KMS endpoint,
+	// to use a local stand-in like local-kms or LocalStack.
+	Endpoint string
+
+	HTTPClient *http.Client
+}
+
+// AWSKMSProvider wraps data keys with a key from AWS KMS.
+// Key IDs are the ARNs of the KMS keys, so data keys wrapped
+// before an alias was moved to a new key can be found and re-wrapped.
+type AWSKMSProvider struct {
+	options AWSKMSProviderOptions
+	signer  *v4.Signer
+	now     func() time.Time
+
+	mu                sync.Mutex
+	activeKeyID       string
+	activeKeyIDExpiry time.Time
+}
+
+func NewAWSKMSProvider(options AWSKMSProviderOptions) (*AWSKMSProvider, error) {
+	if options.KeyID == "" {
+		return nil, fmt.Errorf("KMS key ID is required")
+	}
+
+	if options.Region == "" {
+		return nil, fmt.Errorf("AWS region is required")
+	}
+
+	if options.Credentials.AccessKeyID == "" || options.Credentials.SecretAccessKey == "" {
+		return nil, fmt.Errorf("AWS credentials are required")
+	}
+
+	if options.Endpoint == "" {
+		options.Endpoint = fmt.Sprintf("https://kms.%s.amazonaws.com", options.Region)
+	}
+
+	if options.HTTPClient == nil {
+		options.HTTPClient = &http.Client{Timeout: 10 * time.Second}
+	}
+
+	options.Endpoint = strings.TrimSuffix(options.Endpoint, "/") + "/"
+
+	return &AWSKMSProvider{
+		options: options,
+		signer:  v4.NewSigner(),
+		now:     time.Now,
+	}, nil
+}
+
+func (p *AWSKMSProvider) ActiveKeyID(ctx context.Context) (string, error) {
+	p.mu.Lock()
+	if p.activeKeyID != "" && p.now().Before(p.activeKeyIDExpiry) {
+		activeKeyID := p.activeKeyID
+		p.mu.Unlock()
+		return activeKeyID, nil
+	}
+	p.mu.Unlock()
+
+	var response struct {
+		KeyMetadata struct {
+			Arn string `json:"Arn"`
+		} `json:"KeyMetadata"`
+	}
+
+	err := p.postJSON(ctx, "DescribeKey", map[string]string{"KeyId": p.options.KeyID}, &response)
+	if err != nil {
+		return "", err
+	}
+
+	if response.KeyMetadata.Arn == "" {
+		return "", fmt.Errorf("KMS key %s has no ARN", p.options.KeyID)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.activeKeyID = response.KeyMetadata.Arn
+	p.activeKeyIDExpiry = p.now().Add(awsKMSActiveKeyTTL)
+	return p.activeKeyID, nil
+}
+
+func (p *AWSKMSProvider) WrapKey(ctx context.Context, dataKey []byte) (string, []byte, error) {
+	var response struct {
+		CiphertextBlob string `json:"CiphertextBlob"`
+		KeyID          string `json:"KeyId"`
+	}
+
+	payload := map[string]string{
+		"KeyId":     p.options.KeyID,
+		"Plaintext": base64.StdEncoding.EncodeToString(dataKey),
+	}
+
+	err := p.postJSON(ctx, "Encrypt", payload, &response)
+	if err != nil {
+		return "", nil, err
+	}
+
+	wrapped, err := base64.StdEncoding.DecodeString(response.CiphertextBlob)
+	if err != nil {
+		return "", nil, fmt.Errorf("KMS returned an invalid ciphertext: %w", err)
+	}
+
+	if response.KeyID == "" {
+		return "", nil, fmt.Errorf("KMS returned no key ID")
+	}
+
+	return response.KeyID, wrapped, nil
+}
+
+func (p *AWSKMSProvider) UnwrapKey(ctx context.Context, keyID string, wrapped []byte) ([]byte, error) {
+	var response struct {
+		Plaintext string `json:"Plaintext"`
+	}
+
+	payload := map[string]string{
+		"KeyId":          keyID,
+		"CiphertextBlob": base64.StdEncoding.EncodeToString(wrapped),
+	}
+
+	err := p.postJSON(ctx, "Decrypt", payload, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	dataKey, err := base64.StdEncoding.DecodeString(response.Plaintext)
+	if err != nil {
+		return nil, fmt.Errorf("KMS returned an invalid plaintext: %w", err)
+	}
+
+	return dataKey, nil
+}
+
+func (p *AWSKMSProvider) postJSON(ctx context.Context, action string, payload any, out any) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.options.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	req.Header.Set("X-Amz-Target", awsKMSTargetPrefix+action)
+
+	hash := sha256.Sum256(body)
+	err = p.signer.SignHTTP(ctx, p.options.Credentials, req, hex.EncodeToString(hash[:]), "kms", p.options.Region, p.now())
+	if err != nil {
+		return fmt.Errorf("failed to sign request: %w", err)
+	}
+
+	res, err := p.options.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("KMS %s request failed: %w", action, err)
+	}
+
+	defer res.Body.Close()
+
+	responseBody, err := io.ReadAll(io.LimitReader(res.Body, 1024*1024))
+	if err != nil {
+		return fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		var errorResponse struct {
+			Type    string `json:"__type"`
+			Message string `json:"message"`
+		}
+
+		_ = json.Unmarshal(responseBody, &errorResponse)
+		if errorResponse.Type != "" {
+			return fmt.Errorf("KMS %s failed with %d: %s %s", action, res.StatusCode, errorResponse.Type, errorResponse.Message)
+		}
+
+		return fmt.Errorf("KMS %s failed with %d", action, res.StatusCode)
+	}
+
+	err = json.Unmarshal(responseBody, out)
+	if err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return nil
+}
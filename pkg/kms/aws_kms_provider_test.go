@@ -0,0 +1,91 @@
+package kms
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+func TestAWSKMSProviderWrapsAndUnwrapsKeys(t *testing.T) {
+	const keyARN = "arn:aws:kms:us-east-1:111122223333:key/1234"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=AKID/") {
+			t.Fatalf("request is not signed: %q", r.Header.Get("Authorization"))
+		}
+
+		var body map[string]string
+		_ = json.NewDecoder(r.Body).Decode(&body)
+
+		//
+		// Like local-kms, the fake only needs to round trip the values.
+		//
+		switch r.Header.Get("X-Amz-Target") {
+		case "TrentService.DescribeKey":
+			_ = json.NewEncoder(w).Encode(map[string]any{"KeyMetadata": map[string]any{"Arn": keyARN}})
+		case "TrentService.Encrypt":
+			if body["KeyId"] != "alias/superplane" {
+				t.Fatalf("unexpected key %s", body["KeyId"])
+			}
+
+			_ = json.NewEncoder(w).Encode(map[string]any{"KeyId": keyARN, "CiphertextBlob": body["Plaintext"]})
+		case "TrentService.Decrypt":
+			if body["KeyId"] != keyARN {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"__type": "IncorrectKeyException", "message": "wrong key"}`))
+				return
+			}
+
+			_ = json.NewEncoder(w).Encode(map[string]any{"KeyId": keyARN, "Plaintext": body["CiphertextBlob"]})
+		default:
+			t.Fatalf("unexpected target %s", r.Header.Get("X-Amz-Target"))
+		}
+	}))
+	defer server.Close()
+
+	provider, err := NewAWSKMSProvider(AWSKMSProviderOptions{
+		KeyID:       "alias/superplane",
+		Region:      "us-east-1",
+		Endpoint:    server.URL,
+		Credentials: aws.Credentials{AccessKeyID: "AKID", SecretAccessKey: "secret"},
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	dataKey := randomKey()
+	keyID, wrapped, err := provider.WrapKey(context.Background(), dataKey)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if keyID != keyARN {
+		t.Fatalf("expected key ARN to be used as key ID, got %s", keyID)
+	}
+
+	activeKeyID, err := provider.ActiveKeyID(context.Background())
+	if err != nil || activeKeyID != keyARN {
+		t.Fatalf("expected active key %s, got %q (%v)", keyARN, activeKeyID, err)
+	}
+
+	unwrapped, err := provider.UnwrapKey(context.Background(), keyID, wrapped)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !bytes.Equal(unwrapped, dataKey) {
+		t.Fatalf("unwrapped key does not match")
+	}
+
+	_, err = provider.UnwrapKey(context.Background(), "arn:aws:kms:us-east-1:111122223333:key/other", wrapped)
+	if err == nil || !strings.Contains(err.Error(), "IncorrectKeyException") {
+		t.Fatalf("expected KMS error, got %v", err)
+	}
+}
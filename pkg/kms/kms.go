@@ -0,0 +1,58 @@
+package kms
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/secrets"
+)
+
+const (
+	ProviderLocal        = "local"
+	ProviderVaultTransit = "vault-transit"
+	ProviderAWSKMS       = "aws-kms"
+)
+
+// NewProviderFromEnv configures the key encryption key provider
+// used for envelope encryption from the ENCRYPTION_KEK_* variables.
+// If ENCRYPTION_KEK_PROVIDER is not set, envelope encryption
+// is disabled and nil is returned.
+func NewProviderFromEnv() (crypto.KeyEncryptionKeyProvider, error) {
+	provider := os.Getenv("ENCRYPTION_KEK_PROVIDER")
+	switch provider {
+	case "":
+		return nil, nil
+
+	case ProviderLocal:
+		return NewLocalKeyfileProvider(os.Getenv("ENCRYPTION_KEK_KEYFILE"))
+
+	case ProviderVaultTransit:
+		client, err := secrets.NewVaultClientFromEnv()
+		if err != nil {
+			return nil, err
+		}
+
+		return NewVaultTransitProvider(
+			client,
+			os.Getenv("ENCRYPTION_KEK_VAULT_TRANSIT_MOUNT"),
+			os.Getenv("ENCRYPTION_KEK_VAULT_TRANSIT_KEY"),
+		)
+
+	case ProviderAWSKMS:
+		return NewAWSKMSProvider(AWSKMSProviderOptions{
+			KeyID:    os.Getenv("ENCRYPTION_KEK_AWS_KMS_KEY_ID"),
+			Endpoint: os.Getenv("ENCRYPTION_KEK_AWS_KMS_ENDPOINT"),
+			Region:   os.Getenv("AWS_REGION"),
+			Credentials: aws.Credentials{
+				AccessKeyID:     os.Getenv("AWS_ACCESS_KEY_ID"),
+				SecretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+				SessionToken:    os.Getenv("AWS_SESSION_TOKEN"),
+			},
+		})
+
+	default:
+		return nil, fmt.Errorf("unknown ENCRYPTION_KEK_PROVIDER %q", provider)
+	}
+}
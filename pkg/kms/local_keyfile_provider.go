@@ -0,0 +1,152 @@
+package kms
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/crypto"
+)
+
+// LocalKeyfile is the format of the file read by the LocalKeyfileProvider:
+//
+//	{
+//	  "active_key_id": "2026-10",
+//	  "keys": {
+//	    "2026-01": "<base64 encoded 32 byte key>",
+//	    "2026-10": "<base64 encoded 32 byte key>"
+//	  }
+//	}
+//
+// To rotate the master key, add a new key to the file and make it the active one.
+// Older keys must stay in the file until the re-encryption worker
+// has re-wrapped every data key with the new one.
+type LocalKeyfile struct {
+	ActiveKeyID string            `json:"active_key_id"`
+	Keys        map[string]string `json:"keys"`
+}
+
+// LocalKeyfileProvider wraps data keys with AES-GCM,
+// using keys read from a file on disk.
+// The file is read again when it changes, so keys
+// can be rotated without restarting SuperPlane.
+type LocalKeyfileProvider struct {
+	path string
+
+	mu          sync.Mutex
+	modTime     time.Time
+	activeKeyID string
+	keys        map[string][]byte
+}
+
+func NewLocalKeyfileProvider(path string) (*LocalKeyfileProvider, error) {
+	if path == "" {
+		return nil, fmt.Errorf("keyfile path is required")
+	}
+
+	provider := &LocalKeyfileProvider{path: path}
+	_, _, err := provider.load()
+	if err != nil {
+		return nil, err
+	}
+
+	return provider, nil
+}
+
+func (p *LocalKeyfileProvider) ActiveKeyID(ctx context.Context) (string, error) {
+	activeKeyID, _, err := p.load()
+	return activeKeyID, err
+}
+
+func (p *LocalKeyfileProvider) WrapKey(ctx context.Context, dataKey []byte) (string, []byte, error) {
+	activeKeyID, keys, err := p.load()
+	if err != nil {
+		return "", nil, err
+	}
+
+	wrapped, err := crypto.NewAESGCMEncryptor(keys[activeKeyID]).Encrypt(ctx, dataKey, []byte(activeKeyID))
+	if err != nil {
+		return "", nil, err
+	}
+
+	return activeKeyID, wrapped, nil
+}
+
+func (p *LocalKeyfileProvider) UnwrapKey(ctx context.Context, keyID string, wrapped []byte) ([]byte, error) {
+	_, keys, err := p.load()
+	if err != nil {
+		return nil, err
+	}
+
+	key, ok := keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("key %s not found in %s", keyID, p.path)
+	}
+
+	return crypto.NewAESGCMEncryptor(key).Decrypt(ctx, wrapped, []byte(keyID))
+}
+
+func (p *LocalKeyfileProvider) load() (string, map[string][]byte, error) {
+	info, err := os.Stat(p.path)
+	if err != nil {
+		return "", nil, fmt.Errorf("error reading keyfile: %w", err)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.keys != nil && info.ModTime().Equal(p.modTime) {
+		return p.activeKeyID, p.keys, nil
+	}
+
+	activeKeyID, keys, err := readLocalKeyfile(p.path)
+	if err != nil {
+		return "", nil, err
+	}
+
+	p.modTime = info.ModTime()
+	p.activeKeyID = activeKeyID
+	p.keys = keys
+	return activeKeyID, keys, nil
+}
+
+func readLocalKeyfile(path string) (string, map[string][]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", nil, fmt.Errorf("error reading keyfile: %w", err)
+	}
+
+	var keyfile LocalKeyfile
+	err = json.Unmarshal(data, &keyfile)
+	if err != nil {
+		return "", nil, fmt.Errorf("error parsing keyfile %s: %w", path, err)
+	}
+
+	keys := make(map[string][]byte, len(keyfile.Keys))
+	for keyID, encoded := range keyfile.Keys {
+		if keyID == "" || len(keyID) > 255 {
+			return "", nil, fmt.Errorf("invalid key ID %q in %s", keyID, path)
+		}
+
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return "", nil, fmt.Errorf("key %s in %s is not valid base64", keyID, path)
+		}
+
+		if len(key) != 32 {
+			return "", nil, fmt.Errorf("key %s in %s must be 32 bytes long", keyID, path)
+		}
+
+		keys[keyID] = key
+	}
+
+	if _, ok := keys[keyfile.ActiveKeyID]; !ok {
+		return "", nil, fmt.Errorf("active key %q not found in %s", keyfile.ActiveKeyID, path)
+	}
+
+	return keyfile.ActiveKeyID, keys, nil
+}
@@ -0,0 +1,115 @@
+package kms
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func writeKeyfile(t *testing.T, path, activeKeyID string, keys map[string][]byte) {
+	t.Helper()
+
+	keyfile := LocalKeyfile{ActiveKeyID: activeKeyID, Keys: map[string]string{}}
+	for keyID, key := range keys {
+		keyfile.Keys[keyID] = base64.StdEncoding.EncodeToString(key)
+	}
+
+	data, err := json.Marshal(keyfile)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func randomKey() []byte {
+	key := make([]byte, 32)
+	_, _ = rand.Read(key)
+	return key
+}
+
+func TestLocalKeyfileProviderWrapsAndUnwrapsKeys(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keys.json")
+	keys := map[string][]byte{"k1": randomKey()}
+	writeKeyfile(t, path, "k1", keys)
+
+	provider, err := NewLocalKeyfileProvider(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	dataKey := randomKey()
+	keyID, wrapped, err := provider.WrapKey(context.Background(), dataKey)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if keyID != "k1" || bytes.Contains(wrapped, dataKey) {
+		t.Fatalf("unexpected wrap result %s %x", keyID, wrapped)
+	}
+
+	unwrapped, err := provider.UnwrapKey(context.Background(), keyID, wrapped)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !bytes.Equal(unwrapped, dataKey) {
+		t.Fatalf("unwrapped key does not match")
+	}
+
+	//
+	// Rotating the active key in the file is picked up without a restart,
+	// and keys wrapped with the old one can still be unwrapped.
+	//
+	keys["k2"] = randomKey()
+	writeKeyfile(t, path, "k2", keys)
+	future := time.Now().Add(time.Minute)
+	if err := os.Chtimes(path, future, future); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	activeKeyID, err := provider.ActiveKeyID(context.Background())
+	if err != nil || activeKeyID != "k2" {
+		t.Fatalf("expected active key k2, got %q (%v)", activeKeyID, err)
+	}
+
+	unwrapped, err = provider.UnwrapKey(context.Background(), "k1", wrapped)
+	if err != nil || !bytes.Equal(unwrapped, dataKey) {
+		t.Fatalf("expected old key to still unwrap, got %v", err)
+	}
+
+	_, err = provider.UnwrapKey(context.Background(), "k2", wrapped)
+	if err == nil {
+		t.Fatalf("expected data key wrapped with k1 not to unwrap with k2")
+	}
+}
+
+func TestLocalKeyfileProviderRejectsInvalidKeyfiles(t *testing.T) {
+	dir := t.TempDir()
+
+	_, err := NewLocalKeyfileProvider(filepath.Join(dir, "missing.json"))
+	if err == nil {
+		t.Fatalf("expected error for missing keyfile")
+	}
+
+	path := filepath.Join(dir, "keys.json")
+	writeKeyfile(t, path, "k2", map[string][]byte{"k1": randomKey()})
+	_, err = NewLocalKeyfileProvider(path)
+	if err == nil {
+		t.Fatalf("expected error for missing active key")
+	}
+
+	writeKeyfile(t, path, "k1", map[string][]byte{"k1": []byte("short")})
+	_, err = NewLocalKeyfileProvider(path)
+	if err == nil {
+		t.Fatalf("expected error for short key")
+	}
+}
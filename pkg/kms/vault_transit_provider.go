@@ -0,0 +1,165 @@
+package kms
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/secrets"
+)
+
+const (
+	DefaultVaultTransitMount = "transit"
+
+	//
+	// The latest version of the Transit key is only needed
+	// to find data keys that must be re-wrapped, so it is fine
+	// to read it again only once in a while.
+	//
+	vaultTransitKeyVersionTTL = time.Minute
+)
+
+// VaultTransitProvider wraps data keys with a key
+// from the Transit secrets engine of a HashiCorp Vault server.
+// Key IDs have the form <key name>:v<version>, so data keys wrapped
+// before the Transit key was rotated can be found and re-wrapped.
+type VaultTransitProvider struct {
+	client *secrets.VaultClient
+	mount  string
+	key    string
+	now    func() time.Time
+
+	mu                sync.Mutex
+	activeKeyID       string
+	activeKeyIDExpiry time.Time
+}
+
+func NewVaultTransitProvider(client *secrets.VaultClient, mount, key string) (*VaultTransitProvider, error) {
+	if client == nil {
+		return nil, fmt.Errorf("vault is not configured")
+	}
+
+	if key == "" {
+		return nil, fmt.Errorf("transit key name is required")
+	}
+
+	if mount == "" {
+		mount = DefaultVaultTransitMount
+	}
+
+	return &VaultTransitProvider{
+		client: client,
+		mount:  mount,
+		key:    key,
+		now:    time.Now,
+	}, nil
+}
+
+func (p *VaultTransitProvider) ActiveKeyID(ctx context.Context) (string, error) {
+	p.mu.Lock()
+	if p.activeKeyID != "" && p.now().Before(p.activeKeyIDExpiry) {
+		activeKeyID := p.activeKeyID
+		p.mu.Unlock()
+		return activeKeyID, nil
+	}
+	p.mu.Unlock()
+
+	var response struct {
+		Data struct {
+			LatestVersion int `json:"latest_version"`
+		} `json:"data"`
+	}
+
+	err := p.client.Read(ctx, fmt.Sprintf("%s/keys/%s", p.mount, p.key), &response)
+	if err != nil {
+		return "", err
+	}
+
+	if response.Data.LatestVersion == 0 {
+		return "", fmt.Errorf("transit key %s has no versions", p.key)
+	}
+
+	activeKeyID := p.keyID(response.Data.LatestVersion)
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.activeKeyID = activeKeyID
+	p.activeKeyIDExpiry = p.now().Add(vaultTransitKeyVersionTTL)
+	return activeKeyID, nil
+}
+
+func (p *VaultTransitProvider) WrapKey(ctx context.Context, dataKey []byte) (string, []byte, error) {
+	var response struct {
+		Data struct {
+			Ciphertext string `json:"ciphertext"`
+		} `json:"data"`
+	}
+
+	body := map[string]string{
+		"plaintext": base64.StdEncoding.EncodeToString(dataKey),
+	}
+
+	err := p.client.Write(ctx, fmt.Sprintf("%s/encrypt/%s", p.mount, p.key), body, &response)
+	if err != nil {
+		return "", nil, err
+	}
+
+	version, err := ciphertextVersion(response.Data.Ciphertext)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return p.keyID(version), []byte(response.Data.Ciphertext), nil
+}
+
+func (p *VaultTransitProvider) UnwrapKey(ctx context.Context, keyID string, wrapped []byte) ([]byte, error) {
+	separator := strings.LastIndex(keyID, ":v")
+	if separator < 0 || keyID[:separator] != p.key {
+		return nil, fmt.Errorf("key %s does not belong to transit key %s", keyID, p.key)
+	}
+
+	var response struct {
+		Data struct {
+			Plaintext string `json:"plaintext"`
+		} `json:"data"`
+	}
+
+	body := map[string]string{
+		"ciphertext": string(wrapped),
+	}
+
+	err := p.client.Write(ctx, fmt.Sprintf("%s/decrypt/%s", p.mount, p.key), body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	dataKey, err := base64.StdEncoding.DecodeString(response.Data.Plaintext)
+	if err != nil {
+		return nil, fmt.Errorf("transit returned an invalid plaintext: %w", err)
+	}
+
+	return dataKey, nil
+}
+
+func (p *VaultTransitProvider) keyID(version int) string {
+	return fmt.Sprintf("%s:v%d", p.key, version)
+}
+
+// Transit ciphertexts have the form vault:v<version>:<base64 ciphertext>.
+func ciphertextVersion(ciphertext string) (int, error) {
+	parts := strings.SplitN(ciphertext, ":", 3)
+	if len(parts) != 3 || parts[0] != "vault" || !strings.HasPrefix(parts[1], "v") {
+		return 0, fmt.Errorf("transit returned an unexpected ciphertext")
+	}
+
+	version, err := strconv.Atoi(strings.TrimPrefix(parts[1], "v"))
+	if err != nil || version <= 0 {
+		return 0, fmt.Errorf("transit returned an unexpected ciphertext")
+	}
+
+	return version, nil
+}
@@ -0,0 +1,91 @@
+package kms
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/superplanehq/superplane/pkg/secrets"
+)
+
+func TestVaultTransitProviderWrapsAndUnwrapsKeys(t *testing.T) {
+	latestVersion := 1
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "root" {
+			t.Fatalf("unexpected token %q", r.Header.Get("X-Vault-Token"))
+		}
+
+		var body map[string]string
+		if r.Method == http.MethodPost {
+			_ = json.NewDecoder(r.Body).Decode(&body)
+		}
+
+		//
+		// The fake Transit engine "encrypts" by prefixing the plaintext
+		// with the key version, which is enough to check the round trip.
+		//
+		switch r.URL.Path {
+		case "/v1/transit/keys/superplane":
+			_ = json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"latest_version": latestVersion}})
+		case "/v1/transit/encrypt/superplane":
+			ciphertext := fmt.Sprintf("vault:v%d:%s", latestVersion, body["plaintext"])
+			_ = json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"ciphertext": ciphertext}})
+		case "/v1/transit/decrypt/superplane":
+			parts := strings.SplitN(body["ciphertext"], ":", 3)
+			_ = json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"plaintext": parts[2]}})
+		default:
+			t.Fatalf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := secrets.NewVaultClient(secrets.VaultClientOptions{Address: server.URL, Token: "root"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	provider, err := NewVaultTransitProvider(client, "", "superplane")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	dataKey := randomKey()
+	keyID, wrapped, err := provider.WrapKey(context.Background(), dataKey)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if keyID != "superplane:v1" || !strings.HasPrefix(string(wrapped), "vault:v1:") {
+		t.Fatalf("unexpected wrap result %s %s", keyID, wrapped)
+	}
+
+	activeKeyID, err := provider.ActiveKeyID(context.Background())
+	if err != nil || activeKeyID != "superplane:v1" {
+		t.Fatalf("expected active key superplane:v1, got %q (%v)", activeKeyID, err)
+	}
+
+	unwrapped, err := provider.UnwrapKey(context.Background(), keyID, wrapped)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !bytes.Equal(unwrapped, dataKey) {
+		t.Fatalf("unwrapped key does not match")
+	}
+
+	latestVersion = 2
+	keyID, _, err = provider.WrapKey(context.Background(), dataKey)
+	if err != nil || keyID != "superplane:v2" {
+		t.Fatalf("expected key superplane:v2 after rotation, got %q (%v)", keyID, err)
+	}
+
+	_, err = provider.UnwrapKey(context.Background(), "other:v1", wrapped)
+	if err == nil {
+		t.Fatalf("expected error for key of another transit key")
+	}
+}
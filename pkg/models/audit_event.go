@@ -0,0 +1,131 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// AuditEvent records a change made in an organization.
+// Audit events are append-only: the database rejects updates,
+// and they are only deleted after the retention period.
+type AuditEvent struct {
+	ID             uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	OrganizationID uuid.UUID
+	ActorID        *uuid.UUID
+	ActorType      string
+	ActorName      string
+	ActorEmail     string
+	ResourceType   string
+	ResourceID     string
+	ResourceName   string
+	Action         string
+	Method         string
+	DomainType     string
+	DomainID       string
+	Status         string
+	Request        datatypes.JSONType[map[string]any]
+	Response       datatypes.JSONType[map[string]any]
+	Before         datatypes.JSONType[map[string]any]
+	CreatedAt      *time.Time
+}
+
+func (AuditEvent) TableName() string {
+	return "audit_events"
+}
+
+type AuditEventFilters struct {
+	ResourceType string
+	ResourceID   string
+	Action       string
+	ActorID      *uuid.UUID
+}
+
+func CreateAuditEvent(event *AuditEvent) error {
+	return CreateAuditEventInTransaction(database.Conn(), event)
+}
+
+func CreateAuditEventInTransaction(tx *gorm.DB, event *AuditEvent) error {
+	if event.CreatedAt == nil {
+		now := time.Now()
+		event.CreatedAt = &now
+	}
+
+	return tx.Create(event).Error
+}
+
+func ListAuditEvents(organizationID uuid.UUID, filters AuditEventFilters, limit int, before *time.Time) ([]AuditEvent, error) {
+	var events []AuditEvent
+	query := filterAuditEvents(database.Conn(), organizationID, filters)
+
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	if before != nil {
+		query = query.Where("created_at < ?", before)
+	}
+
+	err := query.Order("created_at DESC").Find(&events).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+func CountAuditEvents(organizationID uuid.UUID, filters AuditEventFilters) (int64, error) {
+	var count int64
+	err := filterAuditEvents(database.Conn(), organizationID, filters).
+		Model(&AuditEvent{}).
+		Count(&count).
+		Error
+
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func filterAuditEvents(tx *gorm.DB, organizationID uuid.UUID, filters AuditEventFilters) *gorm.DB {
+	query := tx.Where("organization_id = ?", organizationID)
+
+	if filters.ResourceType != "" {
+		query = query.Where("resource_type = ?", filters.ResourceType)
+	}
+
+	if filters.ResourceID != "" {
+		query = query.Where("resource_id = ?", filters.ResourceID)
+	}
+
+	if filters.Action != "" {
+		query = query.Where("action = ?", filters.Action)
+	}
+
+	if filters.ActorID != nil {
+		query = query.Where("actor_id = ?", filters.ActorID)
+	}
+
+	return query
+}
+
+// DeleteAuditEventsOlderThan removes up to limit audit events created before the cutoff.
+func DeleteAuditEventsOlderThan(cutoff time.Time, limit int) (int64, error) {
+	result := database.Conn().Exec(
+		`DELETE FROM audit_events WHERE id IN (
+			SELECT id FROM audit_events WHERE created_at < ? LIMIT ?
+		)`,
+		cutoff,
+		limit,
+	)
+
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
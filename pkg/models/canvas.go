@@ -18,8 +18,11 @@ type Canvas struct {
 	IsTemplate             bool
 	VersioningEnabled      bool
 	ChangeRequestApprovers datatypes.JSONSlice[CanvasChangeRequestApprover]
+	ChangeRequestChecks    datatypes.JSONType[CanvasChangeRequestCheckConfig]
+	NodeActionPermissions  datatypes.JSONSlice[CanvasNodeActionPermissions]
 	Name                   string
 	Description            string
+	Environment            string
 	CreatedBy              *uuid.UUID
 	CreatedAt              *time.Time
 	UpdatedAt              *time.Time
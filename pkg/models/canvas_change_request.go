@@ -22,6 +22,14 @@ const (
 	CanvasChangeRequestApprovalStateApproved   = "approved"
 	CanvasChangeRequestApprovalStateRejected   = "rejected"
 	CanvasChangeRequestApprovalStateUnapproved = "unapproved"
+
+	CanvasChangeRequestCheckConfiguration       = "configuration"
+	CanvasChangeRequestCheckExpressions         = "expressions"
+	CanvasChangeRequestCheckForbiddenComponents = "forbidden-components"
+	CanvasChangeRequestCheckSimulation          = "simulation"
+
+	CanvasChangeRequestCheckStatusPassed = "passed"
+	CanvasChangeRequestCheckStatusFailed = "failed"
 )
 
 type CanvasChangeRequestApprover struct {
@@ -38,6 +46,25 @@ func DefaultCanvasChangeRequestApprovers() []CanvasChangeRequestApprover {
 	}
 }
 
+// CanvasChangeRequestCheckConfig configures the optional checks
+// run on the change requests of a canvas.
+type CanvasChangeRequestCheckConfig struct {
+	ForbiddenComponents []string `json:"forbiddenComponents,omitempty"`
+	SimulationEnabled   bool     `json:"simulationEnabled,omitempty"`
+}
+
+type CanvasChangeRequestCheck struct {
+	Name      string                            `json:"name"`
+	Status    string                            `json:"status"`
+	Problems  []CanvasChangeRequestCheckProblem `json:"problems,omitempty"`
+	CheckedAt *time.Time                        `json:"checkedAt,omitempty"`
+}
+
+type CanvasChangeRequestCheckProblem struct {
+	NodeID  string `json:"nodeId,omitempty"`
+	Message string `json:"message"`
+}
+
 type CanvasChangeRequest struct {
 	ID                 uuid.UUID
 	WorkflowID         uuid.UUID
@@ -49,6 +76,7 @@ type CanvasChangeRequest struct {
 	Status             string
 	ChangedNodeIDs     datatypes.JSONSlice[string]
 	ConflictingNodeIDs datatypes.JSONSlice[string]
+	Checks             datatypes.JSONSlice[CanvasChangeRequestCheck]
 	PublishedAt        *time.Time
 	CreatedAt          *time.Time
 	UpdatedAt          *time.Time
@@ -66,6 +94,22 @@ func (c *CanvasChangeRequest) IsConflicted() bool {
 	return len(c.ConflictingNodeIDs) > 0
 }
 
+// FailedChecks returns the names of the checks that did not pass.
+func (c *CanvasChangeRequest) FailedChecks() []string {
+	failed := []string{}
+	if c == nil {
+		return failed
+	}
+
+	for _, check := range c.Checks {
+		if check.Status != CanvasChangeRequestCheckStatusPassed {
+			failed = append(failed, check.Name)
+		}
+	}
+
+	return failed
+}
+
 type CanvasChangeRequestListOptions struct {
 	Limit        int
 	Before       *time.Time
@@ -97,6 +141,17 @@ func FindCanvasChangeRequestInTransaction(tx *gorm.DB, workflowID, changeRequest
 	return &request, nil
 }
 
+// UpdateCanvasChangeRequestChecks only saves the checks of a change request,
+// leaving the rest of the change request as it is in the database.
+func UpdateCanvasChangeRequestChecks(request *CanvasChangeRequest) error {
+	return database.Conn().
+		Model(&CanvasChangeRequest{}).
+		Where("workflow_id = ?", request.WorkflowID).
+		Where("id = ?", request.ID).
+		Update("checks", request.Checks).
+		Error
+}
+
 func FindCanvasChangeRequestByVersionInTransaction(tx *gorm.DB, workflowID, versionID uuid.UUID) (*CanvasChangeRequest, error) {
 	var request CanvasChangeRequest
 	err := tx.
@@ -0,0 +1,146 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// CanvasGitSync keeps a canvas in sync with a YAML file in a Git repository.
+// When the file changes, a change request is opened with its contents.
+// The file is read through the integration, if one is set,
+// or by cloning the repository URL directly.
+type CanvasGitSync struct {
+	ID                  uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	CanvasID            uuid.UUID
+	OrganizationID      uuid.UUID
+	IntegrationID       *uuid.UUID
+	Repository          string
+	Branch              string
+	Path                string
+	Enabled             bool
+	CreatedBy           uuid.UUID
+	LastContentDigest   string
+	LastLiveVersionID   *uuid.UUID
+	LastChangeRequestID *uuid.UUID
+	DriftDetected       bool
+	LastError           string
+	LastSyncedAt        *time.Time
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+}
+
+func (CanvasGitSync) TableName() string {
+	return "canvas_git_syncs"
+}
+
+func FindCanvasGitSyncInTransaction(tx *gorm.DB, canvasID uuid.UUID) (*CanvasGitSync, error) {
+	var sync CanvasGitSync
+	err := tx.
+		Where("canvas_id = ?", canvasID).
+		First(&sync).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &sync, nil
+}
+
+func FindCanvasGitSync(canvasID uuid.UUID) (*CanvasGitSync, error) {
+	return FindCanvasGitSyncInTransaction(database.Conn(), canvasID)
+}
+
+// ListCanvasGitSyncsDue returns the enabled syncs
+// which were not synced since the given time, oldest first.
+func ListCanvasGitSyncsDue(syncedBefore time.Time, limit int) ([]CanvasGitSync, error) {
+	var syncs []CanvasGitSync
+	err := database.Conn().
+		Joins("JOIN workflows ON workflows.id = canvas_git_syncs.canvas_id").
+		Where("canvas_git_syncs.enabled").
+		Where("workflows.deleted_at IS NULL").
+		Where("(canvas_git_syncs.last_synced_at IS NULL OR canvas_git_syncs.last_synced_at < ?)", syncedBefore).
+		Order("canvas_git_syncs.last_synced_at ASC NULLS FIRST").
+		Limit(limit).
+		Find(&syncs).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return syncs, nil
+}
+
+// LockCanvasGitSync locks the sync for the duration of the transaction,
+// skipping it if another worker is already processing it.
+func LockCanvasGitSync(tx *gorm.DB, id uuid.UUID) (*CanvasGitSync, error) {
+	var sync CanvasGitSync
+	err := tx.
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("id = ?", id).
+		First(&sync).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &sync, nil
+}
+
+// SaveCanvasGitSyncInTransaction creates or replaces the sync settings of a canvas.
+// Changing the source resets the sync status,
+// so the file is compared against the live canvas again.
+func SaveCanvasGitSyncInTransaction(tx *gorm.DB, sync *CanvasGitSync) error {
+	now := time.Now()
+	sync.UpdatedAt = now
+	if sync.CreatedAt.IsZero() {
+		sync.CreatedAt = now
+	}
+
+	return tx.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "canvas_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"integration_id",
+			"repository",
+			"branch",
+			"path",
+			"enabled",
+			"created_by",
+			"last_content_digest",
+			"last_live_version_id",
+			"drift_detected",
+			"last_error",
+			"last_synced_at",
+			"updated_at",
+		}),
+	}).Create(sync).Error
+}
+
+func (s *CanvasGitSync) SaveStatusInTransaction(tx *gorm.DB) error {
+	return tx.Model(s).Updates(map[string]any{
+		"last_content_digest":    s.LastContentDigest,
+		"last_live_version_id":   s.LastLiveVersionID,
+		"last_change_request_id": s.LastChangeRequestID,
+		"drift_detected":         s.DriftDetected,
+		"last_error":             s.LastError,
+		"last_synced_at":         s.LastSyncedAt,
+		"updated_at":             time.Now(),
+	}).Error
+}
+
+func (s *CanvasGitSync) SaveStatus() error {
+	return s.SaveStatusInTransaction(database.Conn())
+}
+
+func DeleteCanvasGitSync(canvasID uuid.UUID) error {
+	return database.Conn().
+		Where("canvas_id = ?", canvasID).
+		Delete(&CanvasGitSync{}).
+		Error
+}
@@ -3,32 +3,56 @@ package models
 import (
 	"encoding/json"
 	"fmt"
+	"reflect"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/superplanehq/superplane/pkg/database"
 	"gorm.io/datatypes"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type CanvasMemory struct {
-	ID        uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
-	CreatedAt time.Time
-	UpdatedAt time.Time
-	CanvasID  uuid.UUID
-	Namespace string
-	Values    datatypes.JSONType[any]
+	ID          uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	CanvasID    uuid.UUID
+	Namespace   string
+	Values      datatypes.JSONType[any]
+	IndexValues datatypes.JSONType[map[string]any]
 }
 
+// canvasMemoryNotExpiredSQL excludes records older than the TTL of their namespace.
+// Expired records are removed by the canvas cleanup worker,
+// but they should not be visible while they wait for it.
+const canvasMemoryNotExpiredSQL = `NOT EXISTS (
+	SELECT 1 FROM canvas_memory_namespaces n
+	WHERE n.canvas_id = canvas_memories.canvas_id
+		AND n.namespace = canvas_memories.namespace
+		AND n.ttl_seconds > 0
+		AND canvas_memories.updated_at < NOW() - make_interval(secs => n.ttl_seconds)
+)`
+
 func (CanvasMemory) TableName() string {
 	return "canvas_memories"
 }
 
 func AddCanvasMemoryInTransaction(tx *gorm.DB, canvasID uuid.UUID, namespace string, values any) error {
+	settings, err := findCanvasMemoryNamespaceSettings(tx, canvasID, namespace)
+	if err != nil {
+		return err
+	}
+
+	if err := settings.ValidateValues(values, false); err != nil {
+		return err
+	}
+
 	record := CanvasMemory{
-		CanvasID:  canvasID,
-		Namespace: namespace,
-		Values:    datatypes.NewJSONType(values),
+		CanvasID:    canvasID,
+		Namespace:   namespace,
+		Values:      datatypes.NewJSONType(values),
+		IndexValues: datatypes.NewJSONType(settings.IndexValues(values)),
 	}
 
 	return tx.Create(&record).Error
@@ -42,6 +66,7 @@ func ListCanvasMemoriesInTransaction(tx *gorm.DB, canvasID uuid.UUID) ([]CanvasM
 	var records []CanvasMemory
 	err := tx.
 		Where("canvas_id = ?", canvasID).
+		Where(canvasMemoryNotExpiredSQL).
 		Order("created_at DESC").
 		Find(&records).Error
 	if err != nil {
@@ -59,6 +84,7 @@ func ListCanvasMemoriesByNamespaceInTransaction(tx *gorm.DB, canvasID uuid.UUID,
 	var records []CanvasMemory
 	err := tx.
 		Where("canvas_id = ? AND namespace = ?", canvasID, namespace).
+		Where(canvasMemoryNotExpiredSQL).
 		Order("created_at DESC").
 		Find(&records).Error
 	if err != nil {
@@ -77,16 +103,14 @@ func ListCanvasMemoriesByNamespaceAndMatchesInTransaction(tx *gorm.DB, canvasID
 		return []CanvasMemory{}, fmt.Errorf("at least one match expression is required")
 	}
 
-	matchesJSON, err := json.Marshal(matches)
+	_, condition, args, err := canvasMemoryMatchesCondition(tx, canvasID, namespace, matches)
 	if err != nil {
 		return nil, err
 	}
 
 	var records []CanvasMemory
-
 	err = tx.
-		Where("canvas_id = ? AND namespace = ?", canvasID, namespace).
-		Where("values @> ?::jsonb", matchesJSON).
+		Where(condition, args...).
 		Order("created_at DESC").
 		Find(&records).
 		Error
@@ -107,16 +131,14 @@ func FindFirstCanvasMemoryByNamespaceAndMatchesInTransaction(tx *gorm.DB, canvas
 		return nil, fmt.Errorf("at least one match expression is required")
 	}
 
-	matchesJSON, err := json.Marshal(matches)
+	_, condition, args, err := canvasMemoryMatchesCondition(tx, canvasID, namespace, matches)
 	if err != nil {
 		return nil, err
 	}
 
 	var record CanvasMemory
-
 	err = tx.
-		Where("canvas_id = ? AND namespace = ?", canvasID, namespace).
-		Where("values @> ?::jsonb", matchesJSON).
+		Where(condition, args...).
 		Order("created_at DESC").
 		Limit(1).
 		First(&record).
@@ -154,7 +176,7 @@ func DeleteCanvasMemoriesByNamespaceAndMatchesInTransaction(
 		return []CanvasMemory{}, fmt.Errorf("at least one match expression is required")
 	}
 
-	matchesJSON, err := json.Marshal(matches)
+	_, condition, args, err := canvasMemoryMatchesCondition(tx, canvasID, namespace, matches)
 	if err != nil {
 		return nil, err
 	}
@@ -163,13 +185,11 @@ func DeleteCanvasMemoriesByNamespaceAndMatchesInTransaction(
 	err = tx.Raw(
 		`WITH deleted AS (
 			DELETE FROM canvas_memories
-			WHERE canvas_id = ? AND namespace = ? AND values @> ?::jsonb
+			WHERE `+condition+`
 			RETURNING *
 		)
 		SELECT * FROM deleted ORDER BY created_at DESC`,
-		canvasID,
-		namespace,
-		matchesJSON,
+		args...,
 	).Scan(&deletedRecords).Error
 	if err != nil {
 		return nil, err
@@ -196,28 +216,37 @@ func UpdateCanvasMemoriesByNamespaceAndMatchesInTransaction(
 		return []CanvasMemory{}, fmt.Errorf("at least one value expression is required")
 	}
 
-	matchesJSON, err := json.Marshal(matches)
+	settings, condition, conditionArgs, err := canvasMemoryMatchesCondition(tx, canvasID, namespace, matches)
 	if err != nil {
 		return nil, err
 	}
+
+	if err := settings.ValidateValues(values, true); err != nil {
+		return nil, err
+	}
+
 	valuesJSON, err := json.Marshal(values)
 	if err != nil {
 		return nil, err
 	}
 
+	set := "values = values || ?::jsonb, updated_at = NOW()"
+	args := []any{valuesJSON}
+	if settings != nil && len(settings.IndexedFields) > 0 {
+		set += ", index_values = " + canvasMemoryIndexValuesSQL("values || ?::jsonb")
+		args = append(args, valuesJSON, []string(settings.IndexedFields))
+	}
+
 	var updatedRecords []CanvasMemory
 	err = tx.Raw(
 		`WITH updated AS (
 			UPDATE canvas_memories
-			SET values = values || ?::jsonb, updated_at = NOW()
-			WHERE canvas_id = ? AND namespace = ? AND values @> ?::jsonb
+			SET `+set+`
+			WHERE `+condition+`
 			RETURNING *
 		)
 		SELECT * FROM updated ORDER BY created_at DESC`,
-		valuesJSON,
-		canvasID,
-		namespace,
-		matchesJSON,
+		append(args, conditionArgs...)...,
 	).Scan(&updatedRecords).Error
 	if err != nil {
 		return nil, err
@@ -229,3 +258,360 @@ func UpdateCanvasMemoriesByNamespaceAndMatchesInTransaction(
 func UpdateCanvasMemoriesByNamespaceAndMatches(canvasID uuid.UUID, namespace string, matches map[string]any, values map[string]any) ([]CanvasMemory, error) {
 	return UpdateCanvasMemoriesByNamespaceAndMatchesInTransaction(database.Conn(), canvasID, namespace, matches, values)
 }
+
+// IncrementCanvasMemoryInTransaction atomically adds delta to a numeric field
+// of the records matching all the matches, treating a missing field as zero.
+// When no record matches, one is created with the matches and the field set to delta.
+func IncrementCanvasMemoryInTransaction(
+	tx *gorm.DB,
+	canvasID uuid.UUID,
+	namespace string,
+	matches map[string]any,
+	field string,
+	delta float64,
+) ([]CanvasMemory, error) {
+	if err := validateMemoryFieldOperation(matches, field); err != nil {
+		return []CanvasMemory{}, err
+	}
+
+	settings, records, err := lockCanvasMemoriesByNamespaceAndMatches(tx, canvasID, namespace, matches)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(records) == 0 {
+		record, err := createCanvasMemoryInTransaction(tx, settings, canvasID, namespace, setMemoryField(matches, field, delta))
+		if err != nil {
+			return nil, err
+		}
+
+		return []CanvasMemory{*record}, nil
+	}
+
+	for i := range records {
+		values, err := incrementMemoryField(records[i].Values.Data(), field, delta)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := saveCanvasMemoryValuesInTransaction(tx, settings, &records[i], values); err != nil {
+			return nil, err
+		}
+	}
+
+	return records, nil
+}
+
+func IncrementCanvasMemory(canvasID uuid.UUID, namespace string, matches map[string]any, field string, delta float64) ([]CanvasMemory, error) {
+	var records []CanvasMemory
+	err := database.Conn().Transaction(func(tx *gorm.DB) error {
+		var err error
+		records, err = IncrementCanvasMemoryInTransaction(tx, canvasID, namespace, matches, field, delta)
+		return err
+	})
+
+	return records, err
+}
+
+// CompareAndSetCanvasMemoryInTransaction sets a field of the records matching all the matches
+// to value, but only if the field is currently equal to expected in every one of them.
+// A nil expected stands for a field that is not set, and a nil value removes the field.
+// When no record matches and expected is nil, one is created with the matches and the value.
+// It returns whether the value was set, and the records as they are after the operation.
+func CompareAndSetCanvasMemoryInTransaction(
+	tx *gorm.DB,
+	canvasID uuid.UUID,
+	namespace string,
+	matches map[string]any,
+	field string,
+	expected any,
+	value any,
+) (bool, []CanvasMemory, error) {
+	if err := validateMemoryFieldOperation(matches, field); err != nil {
+		return false, []CanvasMemory{}, err
+	}
+
+	settings, records, err := lockCanvasMemoriesByNamespaceAndMatches(tx, canvasID, namespace, matches)
+	if err != nil {
+		return false, nil, err
+	}
+
+	if len(records) == 0 {
+		if expected != nil {
+			return false, []CanvasMemory{}, nil
+		}
+
+		record, err := createCanvasMemoryInTransaction(tx, settings, canvasID, namespace, setMemoryField(matches, field, value))
+		if err != nil {
+			return false, nil, err
+		}
+
+		return true, []CanvasMemory{*record}, nil
+	}
+
+	for _, record := range records {
+		equal, err := memoryFieldEquals(record.Values.Data(), field, expected)
+		if err != nil {
+			return false, nil, err
+		}
+
+		if !equal {
+			return false, records, nil
+		}
+	}
+
+	for i := range records {
+		values := setMemoryField(records[i].Values.Data().(map[string]any), field, value)
+		if err := saveCanvasMemoryValuesInTransaction(tx, settings, &records[i], values); err != nil {
+			return false, nil, err
+		}
+	}
+
+	return true, records, nil
+}
+
+func CompareAndSetCanvasMemory(canvasID uuid.UUID, namespace string, matches map[string]any, field string, expected, value any) (bool, []CanvasMemory, error) {
+	var set bool
+	var records []CanvasMemory
+	err := database.Conn().Transaction(func(tx *gorm.DB) error {
+		var err error
+		set, records, err = CompareAndSetCanvasMemoryInTransaction(tx, canvasID, namespace, matches, field, expected, value)
+		return err
+	})
+
+	return set, records, err
+}
+
+// lockCanvasMemoriesByNamespaceAndMatches returns the records matching all the matches,
+// locked until the end of the transaction.
+func lockCanvasMemoriesByNamespaceAndMatches(
+	tx *gorm.DB,
+	canvasID uuid.UUID,
+	namespace string,
+	matches map[string]any,
+) (*CanvasMemoryNamespace, []CanvasMemory, error) {
+	if err := lockMemoryKey(tx, "canvas_memories", canvasID, namespace, matches); err != nil {
+		return nil, nil, err
+	}
+
+	settings, condition, args, err := canvasMemoryMatchesCondition(tx, canvasID, namespace, matches)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var records []CanvasMemory
+	err = tx.
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where(condition, args...).
+		Order("created_at DESC").
+		Find(&records).
+		Error
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return settings, records, nil
+}
+
+func createCanvasMemoryInTransaction(
+	tx *gorm.DB,
+	settings *CanvasMemoryNamespace,
+	canvasID uuid.UUID,
+	namespace string,
+	values map[string]any,
+) (*CanvasMemory, error) {
+	if err := settings.ValidateValues(values, false); err != nil {
+		return nil, err
+	}
+
+	record := CanvasMemory{
+		CanvasID:    canvasID,
+		Namespace:   namespace,
+		Values:      datatypes.NewJSONType[any](values),
+		IndexValues: datatypes.NewJSONType(settings.IndexValues(values)),
+	}
+
+	if err := tx.Create(&record).Error; err != nil {
+		return nil, err
+	}
+
+	return &record, nil
+}
+
+func saveCanvasMemoryValuesInTransaction(tx *gorm.DB, settings *CanvasMemoryNamespace, record *CanvasMemory, values map[string]any) error {
+	if err := settings.ValidateValues(values, false); err != nil {
+		return err
+	}
+
+	record.Values = datatypes.NewJSONType[any](values)
+	record.IndexValues = datatypes.NewJSONType(settings.IndexValues(values))
+	record.UpdatedAt = time.Now()
+
+	return tx.
+		Model(&CanvasMemory{}).
+		Where("id = ?", record.ID).
+		Updates(map[string]any{
+			"values":       record.Values,
+			"index_values": record.IndexValues,
+			"updated_at":   record.UpdatedAt,
+		}).
+		Error
+}
+
+func validateMemoryFieldOperation(matches map[string]any, field string) error {
+	if len(matches) == 0 {
+		return fmt.Errorf("at least one match expression is required")
+	}
+	if field == "" {
+		return fmt.Errorf("field is required")
+	}
+
+	return nil
+}
+
+// lockMemoryKey takes a transaction-scoped advisory lock on the namespace and matches
+// of a memory operation. Row locks alone are not enough when no record matches yet,
+// since two transactions could then both create one.
+func lockMemoryKey(tx *gorm.DB, table string, ownerID uuid.UUID, namespace string, matches map[string]any) error {
+	matchesJSON, err := json.Marshal(matches)
+	if err != nil {
+		return err
+	}
+
+	key := fmt.Sprintf("%s:%s:%s:%s", table, ownerID, namespace, matchesJSON)
+	return tx.Exec("SELECT pg_advisory_xact_lock(hashtextextended(?, 0))", key).Error
+}
+
+// incrementMemoryField returns a copy of the values with delta added to a numeric field.
+func incrementMemoryField(values any, field string, delta float64) (map[string]any, error) {
+	object, ok := values.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("memory record is not an object")
+	}
+
+	current := 0.0
+	if value, ok := object[field]; ok && value != nil {
+		number, ok := value.(float64)
+		if !ok {
+			return nil, fmt.Errorf("field %s is not a number", field)
+		}
+
+		current = number
+	}
+
+	return setMemoryField(object, field, current+delta), nil
+}
+
+// memoryFieldEquals compares a field of the values with the expected value.
+// A field that is not set is equal to nil.
+func memoryFieldEquals(values any, field string, expected any) (bool, error) {
+	object, ok := values.(map[string]any)
+	if !ok {
+		return false, fmt.Errorf("memory record is not an object")
+	}
+
+	return memoryValuesEqual(object[field], expected)
+}
+
+// setMemoryField returns a copy of the values with the field set,
+// or removed if the value is nil.
+func setMemoryField(values map[string]any, field string, value any) map[string]any {
+	result := make(map[string]any, len(values)+1)
+	for key, value := range values {
+		result[key] = value
+	}
+
+	if value == nil {
+		delete(result, field)
+	} else {
+		result[field] = value
+	}
+
+	return result
+}
+
+// memoryValuesEqual compares two values as they are stored,
+// so that, for example, an int and a float64 with the same value are equal.
+func memoryValuesEqual(a, b any) (bool, error) {
+	aJSON, err := json.Marshal(a)
+	if err != nil {
+		return false, err
+	}
+
+	bJSON, err := json.Marshal(b)
+	if err != nil {
+		return false, err
+	}
+
+	var aValue, bValue any
+	if err := json.Unmarshal(aJSON, &aValue); err != nil {
+		return false, err
+	}
+	if err := json.Unmarshal(bJSON, &bValue); err != nil {
+		return false, err
+	}
+
+	return reflect.DeepEqual(aValue, bValue), nil
+}
+
+// canvasMemoryMatchesCondition returns the condition selecting
+// the records of a namespace containing all the matches.
+// Matches on indexed fields also go through index_values,
+// which is much cheaper to search than the full values.
+func canvasMemoryMatchesCondition(
+	tx *gorm.DB,
+	canvasID uuid.UUID,
+	namespace string,
+	matches map[string]any,
+) (*CanvasMemoryNamespace, string, []any, error) {
+	settings, err := findCanvasMemoryNamespaceSettings(tx, canvasID, namespace)
+	if err != nil {
+		return nil, "", nil, err
+	}
+
+	matchesJSON, err := json.Marshal(matches)
+	if err != nil {
+		return nil, "", nil, err
+	}
+
+	condition := "canvas_id = ? AND namespace = ? AND values @> ?::jsonb AND " + canvasMemoryNotExpiredSQL
+	args := []any{canvasID, namespace, matchesJSON}
+
+	indexed := settings.IndexValues(matches)
+	if len(indexed) > 0 {
+		indexedJSON, err := json.Marshal(indexed)
+		if err != nil {
+			return nil, "", nil, err
+		}
+
+		condition += " AND index_values @> ?::jsonb"
+		args = append(args, indexedJSON)
+	}
+
+	return settings, condition, args, nil
+}
+
+// DeleteExpiredCanvasMemoriesInTransaction deletes up to limit records
+// older than the TTL of their namespace, and returns how many were deleted.
+func DeleteExpiredCanvasMemoriesInTransaction(tx *gorm.DB, limit int) (int64, error) {
+	result := tx.Exec(
+		`DELETE FROM canvas_memories WHERE id IN (
+			SELECT m.id FROM canvas_memories m
+			JOIN canvas_memory_namespaces n ON n.canvas_id = m.canvas_id AND n.namespace = m.namespace
+			WHERE n.ttl_seconds > 0 AND m.updated_at < NOW() - make_interval(secs => n.ttl_seconds)
+			LIMIT ?
+		)`,
+		limit,
+	)
+
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
+
+func DeleteExpiredCanvasMemories(limit int) (int64, error) {
+	return DeleteExpiredCanvasMemoriesInTransaction(database.Conn(), limit)
+}
@@ -0,0 +1,253 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	CanvasMemoryFieldTypeString  = "string"
+	CanvasMemoryFieldTypeNumber  = "number"
+	CanvasMemoryFieldTypeBoolean = "boolean"
+	CanvasMemoryFieldTypeObject  = "object"
+	CanvasMemoryFieldTypeList    = "list"
+)
+
+// CanvasMemoryNamespace holds the settings of a memory namespace in a canvas.
+// Namespaces without settings keep their records forever and accept any values.
+type CanvasMemoryNamespace struct {
+	ID            uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	CanvasID      uuid.UUID
+	Namespace     string
+	TTLSeconds    int `gorm:"column:ttl_seconds"`
+	Fields        datatypes.JSONSlice[CanvasMemoryField]
+	IndexedFields datatypes.JSONSlice[string]
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// CanvasMemoryField declares a field of the records in a namespace.
+type CanvasMemoryField struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Required bool   `json:"required,omitempty"`
+}
+
+func (CanvasMemoryNamespace) TableName() string {
+	return "canvas_memory_namespaces"
+}
+
+func IsValidCanvasMemoryFieldType(fieldType string) bool {
+	switch fieldType {
+	case CanvasMemoryFieldTypeString,
+		CanvasMemoryFieldTypeNumber,
+		CanvasMemoryFieldTypeBoolean,
+		CanvasMemoryFieldTypeObject,
+		CanvasMemoryFieldTypeList:
+		return true
+	default:
+		return false
+	}
+}
+
+func FindCanvasMemoryNamespaceInTransaction(tx *gorm.DB, canvasID uuid.UUID, namespace string) (*CanvasMemoryNamespace, error) {
+	var record CanvasMemoryNamespace
+	err := tx.
+		Where("canvas_id = ? AND namespace = ?", canvasID, namespace).
+		First(&record).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &record, nil
+}
+
+func ListCanvasMemoryNamespacesInTransaction(tx *gorm.DB, canvasID uuid.UUID) ([]CanvasMemoryNamespace, error) {
+	var records []CanvasMemoryNamespace
+	err := tx.
+		Where("canvas_id = ?", canvasID).
+		Order("namespace ASC").
+		Find(&records).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+func ListCanvasMemoryNamespaces(canvasID uuid.UUID) ([]CanvasMemoryNamespace, error) {
+	return ListCanvasMemoryNamespacesInTransaction(database.Conn(), canvasID)
+}
+
+// SaveCanvasMemoryNamespaceInTransaction creates or replaces the settings of a namespace,
+// and rebuilds the indexed values of the records already in it.
+func SaveCanvasMemoryNamespaceInTransaction(tx *gorm.DB, namespace *CanvasMemoryNamespace) error {
+	now := time.Now()
+	namespace.UpdatedAt = now
+	if namespace.CreatedAt.IsZero() {
+		namespace.CreatedAt = now
+	}
+
+	err := tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "canvas_id"}, {Name: "namespace"}},
+		DoUpdates: clause.AssignmentColumns([]string{"ttl_seconds", "fields", "indexed_fields", "updated_at"}),
+	}).Create(namespace).Error
+	if err != nil {
+		return err
+	}
+
+	return reindexCanvasMemoriesInTransaction(tx, namespace.CanvasID, namespace.Namespace, namespace.IndexedFields)
+}
+
+func SaveCanvasMemoryNamespace(namespace *CanvasMemoryNamespace) error {
+	return database.Conn().Transaction(func(tx *gorm.DB) error {
+		return SaveCanvasMemoryNamespaceInTransaction(tx, namespace)
+	})
+}
+
+// DeleteCanvasMemoryNamespaceInTransaction removes the settings of a namespace.
+// The records in the namespace are kept.
+func DeleteCanvasMemoryNamespaceInTransaction(tx *gorm.DB, canvasID uuid.UUID, namespace string) error {
+	err := tx.
+		Where("canvas_id = ? AND namespace = ?", canvasID, namespace).
+		Delete(&CanvasMemoryNamespace{}).
+		Error
+
+	if err != nil {
+		return err
+	}
+
+	return reindexCanvasMemoriesInTransaction(tx, canvasID, namespace, nil)
+}
+
+func DeleteCanvasMemoryNamespace(canvasID uuid.UUID, namespace string) error {
+	return database.Conn().Transaction(func(tx *gorm.DB) error {
+		return DeleteCanvasMemoryNamespaceInTransaction(tx, canvasID, namespace)
+	})
+}
+
+func reindexCanvasMemoriesInTransaction(tx *gorm.DB, canvasID uuid.UUID, namespace string, indexedFields []string) error {
+	if len(indexedFields) == 0 {
+		return tx.Exec(
+			`UPDATE canvas_memories SET index_values = '{}'::jsonb
+			WHERE canvas_id = ? AND namespace = ? AND index_values <> '{}'::jsonb`,
+			canvasID,
+			namespace,
+		).Error
+	}
+
+	return tx.Exec(
+		`UPDATE canvas_memories SET index_values = `+canvasMemoryIndexValuesSQL("values")+`
+		WHERE canvas_id = ? AND namespace = ? AND jsonb_typeof(values) = 'object'`,
+		indexedFields,
+		canvasID,
+		namespace,
+	).Error
+}
+
+// canvasMemoryIndexValuesSQL returns the SQL expression
+// picking the indexed fields out of a JSON object.
+// The indexed field names are its only parameter.
+func canvasMemoryIndexValuesSQL(valuesSQL string) string {
+	return `COALESCE((SELECT jsonb_object_agg(key, value) FROM jsonb_each(` + valuesSQL + `) WHERE key IN ?), '{}'::jsonb)`
+}
+
+// findCanvasMemoryNamespaceSettings returns nil for namespaces without settings.
+func findCanvasMemoryNamespaceSettings(tx *gorm.DB, canvasID uuid.UUID, namespace string) (*CanvasMemoryNamespace, error) {
+	settings, err := FindCanvasMemoryNamespaceInTransaction(tx, canvasID, namespace)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+// ValidateValues checks values against the declared fields.
+// For partial values, as in updates, required fields are not checked.
+func (n *CanvasMemoryNamespace) ValidateValues(values any, partial bool) error {
+	if n == nil || len(n.Fields) == 0 {
+		return nil
+	}
+
+	object, ok := values.(map[string]any)
+	if !ok {
+		return fmt.Errorf("namespace %s only accepts objects", n.Namespace)
+	}
+
+	for _, field := range n.Fields {
+		value, ok := object[field.Name]
+		if !ok || value == nil {
+			if field.Required && !partial {
+				return fmt.Errorf("field %s is required in namespace %s", field.Name, n.Namespace)
+			}
+
+			continue
+		}
+
+		if !canvasMemoryValueHasType(value, field.Type) {
+			return fmt.Errorf("field %s in namespace %s must be a %s", field.Name, n.Namespace, field.Type)
+		}
+	}
+
+	return nil
+}
+
+// IndexValues returns the indexed fields of the values.
+func (n *CanvasMemoryNamespace) IndexValues(values any) map[string]any {
+	result := map[string]any{}
+	if n == nil {
+		return result
+	}
+
+	object, ok := values.(map[string]any)
+	if !ok {
+		return result
+	}
+
+	for _, field := range n.IndexedFields {
+		if value, ok := object[field]; ok {
+			result[field] = value
+		}
+	}
+
+	return result
+}
+
+func canvasMemoryValueHasType(value any, fieldType string) bool {
+	kind := reflect.TypeOf(value).Kind()
+	switch fieldType {
+	case CanvasMemoryFieldTypeString:
+		return kind == reflect.String
+	case CanvasMemoryFieldTypeBoolean:
+		return kind == reflect.Bool
+	case CanvasMemoryFieldTypeObject:
+		return kind == reflect.Map
+	case CanvasMemoryFieldTypeList:
+		return kind == reflect.Slice || kind == reflect.Array
+	case CanvasMemoryFieldTypeNumber:
+		switch kind {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Float32, reflect.Float64:
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,67 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanvasMemoryNamespaceValidateValues(t *testing.T) {
+	namespace := &CanvasMemoryNamespace{
+		Namespace: "deployments",
+		Fields: []CanvasMemoryField{
+			{Name: "service", Type: CanvasMemoryFieldTypeString, Required: true},
+			{Name: "replicas", Type: CanvasMemoryFieldTypeNumber},
+			{Name: "tags", Type: CanvasMemoryFieldTypeList},
+		},
+	}
+
+	t.Run("namespace without settings accepts anything", func(t *testing.T) {
+		var settings *CanvasMemoryNamespace
+		assert.NoError(t, settings.ValidateValues("anything", false))
+	})
+
+	t.Run("valid values", func(t *testing.T) {
+		err := namespace.ValidateValues(map[string]any{
+			"service":  "api",
+			"replicas": 3,
+			"tags":     []any{"blue"},
+			"extra":    true,
+		}, false)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("missing required field", func(t *testing.T) {
+		err := namespace.ValidateValues(map[string]any{"replicas": 3.0}, false)
+		require.Error(t, err)
+		assert.Equal(t, "field service is required in namespace deployments", err.Error())
+	})
+
+	t.Run("required fields are not checked for partial values", func(t *testing.T) {
+		assert.NoError(t, namespace.ValidateValues(map[string]any{"replicas": 3.0}, true))
+	})
+
+	t.Run("wrong type", func(t *testing.T) {
+		err := namespace.ValidateValues(map[string]any{"service": "api", "replicas": "3"}, false)
+		require.Error(t, err)
+		assert.Equal(t, "field replicas in namespace deployments must be a number", err.Error())
+	})
+
+	t.Run("values must be an object", func(t *testing.T) {
+		assert.Error(t, namespace.ValidateValues([]any{"api"}, false))
+	})
+}
+
+func TestCanvasMemoryNamespaceIndexValues(t *testing.T) {
+	namespace := &CanvasMemoryNamespace{IndexedFields: []string{"service", "environment"}}
+
+	assert.Equal(t,
+		map[string]any{"service": "api"},
+		namespace.IndexValues(map[string]any{"service": "api", "version": "1.0.0"}),
+	)
+
+	var settings *CanvasMemoryNamespace
+	assert.Empty(t, settings.IndexValues(map[string]any{"service": "api"}))
+}
@@ -0,0 +1,57 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryValuesEqual(t *testing.T) {
+	equal := func(a, b any) bool {
+		result, err := memoryValuesEqual(a, b)
+		require.NoError(t, err)
+		return result
+	}
+
+	assert.True(t, equal(3, 3.0))
+	assert.True(t, equal("deploy-1", "deploy-1"))
+	assert.True(t, equal(nil, nil))
+	assert.True(t, equal(map[string]any{"a": 1, "b": []any{"x"}}, map[string]any{"b": []string{"x"}, "a": 1.0}))
+	assert.False(t, equal("3", 3))
+	assert.False(t, equal(nil, ""))
+	assert.False(t, equal(true, "true"))
+}
+
+func TestIncrementMemoryField(t *testing.T) {
+	t.Run("missing field starts from zero", func(t *testing.T) {
+		values, err := incrementMemoryField(map[string]any{"service": "api"}, "count", 2)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{"service": "api", "count": 2.0}, values)
+	})
+
+	t.Run("existing field is incremented without changing the original values", func(t *testing.T) {
+		original := map[string]any{"count": 3.0}
+		values, err := incrementMemoryField(original, "count", -1)
+		require.NoError(t, err)
+		assert.Equal(t, 2.0, values["count"])
+		assert.Equal(t, 3.0, original["count"])
+	})
+
+	t.Run("non-numeric field fails", func(t *testing.T) {
+		_, err := incrementMemoryField(map[string]any{"count": "3"}, "count", 1)
+		assert.EqualError(t, err, "field count is not a number")
+	})
+}
+
+func TestSetMemoryField(t *testing.T) {
+	assert.Equal(t,
+		map[string]any{"environment": "production", "holder": "deploy-1"},
+		setMemoryField(map[string]any{"environment": "production"}, "holder", "deploy-1"),
+	)
+
+	assert.Equal(t,
+		map[string]any{"environment": "production"},
+		setMemoryField(map[string]any{"environment": "production", "holder": "deploy-1"}, "holder", nil),
+	)
+}
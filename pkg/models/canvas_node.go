@@ -214,6 +214,41 @@ func ListReadyTriggers() ([]CanvasNode, error) {
 	return nodes, nil
 }
 
+func ListTriggerNodesByName(triggerName string) ([]CanvasNode, error) {
+	var nodes []CanvasNode
+	err := database.Conn().
+		Joins("JOIN workflows ON workflow_nodes.workflow_id = workflows.id").
+		Where("workflows.deleted_at IS NULL").
+		Where("workflow_nodes.type = ?", NodeTypeTrigger).
+		Where("workflow_nodes.ref->'trigger'->>'name' = ?", triggerName).
+		Find(&nodes).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return nodes, nil
+}
+
+func ListTriggerNodesForOrganizationInTransaction(tx *gorm.DB, organizationID uuid.UUID, triggerName string) ([]CanvasNode, error) {
+	var nodes []CanvasNode
+	err := tx.
+		Joins("JOIN workflows ON workflow_nodes.workflow_id = workflows.id").
+		Where("workflows.organization_id = ?", organizationID).
+		Where("workflows.deleted_at IS NULL").
+		Where("workflow_nodes.type = ?", NodeTypeTrigger).
+		Where("workflow_nodes.ref->'trigger'->>'name' = ?", triggerName).
+		Find(&nodes).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return nodes, nil
+}
+
 func LockCanvasNode(tx *gorm.DB, workflowID uuid.UUID, nodeId string) (*CanvasNode, error) {
 	var node CanvasNode
 
@@ -0,0 +1,24 @@
+package models
+
+// CanvasNodeActionPermissions restricts who can invoke actions,
+// cancel executions and emit events on a node of a canvas.
+// Users are allowed when they are listed directly,
+// belong to one of the organization groups or have one of the organization roles.
+type CanvasNodeActionPermissions struct {
+	NodeID string   `json:"nodeId"`
+	Users  []string `json:"users,omitempty"`
+	Groups []string `json:"groups,omitempty"`
+	Roles  []string `json:"roles,omitempty"`
+}
+
+// FindNodeActionPermissions returns the permissions configured for a node,
+// or nil if anyone with access to the canvas can run actions on it.
+func (c *Canvas) FindNodeActionPermissions(nodeID string) *CanvasNodeActionPermissions {
+	for _, permissions := range c.NodeActionPermissions {
+		if permissions.NodeID == nodeID {
+			return &permissions
+		}
+	}
+
+	return nil
+}
@@ -143,6 +143,35 @@ func ListPendingNodeExecutions() ([]CanvasNodeExecution, error) {
 	return executions, nil
 }
 
+// ListFinishedNodeExecutionsForTrigger lists the top-level executions finished since the given time
+// in organizations where a canvas has a trigger with the given name.
+func ListFinishedNodeExecutionsForTrigger(triggerName string, since time.Time) ([]CanvasNodeExecution, error) {
+	organizations := database.Conn().
+		Table("workflow_nodes").
+		Select("workflows.organization_id").
+		Joins("JOIN workflows ON workflow_nodes.workflow_id = workflows.id").
+		Where("workflows.deleted_at IS NULL").
+		Where("workflow_nodes.type = ?", NodeTypeTrigger).
+		Where("workflow_nodes.ref->'trigger'->>'name' = ?", triggerName)
+
+	var executions []CanvasNodeExecution
+	err := database.Conn().
+		Joins("JOIN workflows ON workflow_node_executions.workflow_id = workflows.id").
+		Where("workflows.organization_id IN (?)", organizations).
+		Where("workflow_node_executions.state = ?", CanvasNodeExecutionStateFinished).
+		Where("workflow_node_executions.parent_execution_id IS NULL").
+		Where("workflow_node_executions.updated_at > ?", since).
+		Order("workflow_node_executions.updated_at ASC").
+		Find(&executions).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return executions, nil
+}
+
 func ListNodeExecutions(workflowID uuid.UUID, nodeID string, states []string, results []string, limit int, beforeTime *time.Time) ([]CanvasNodeExecution, error) {
 	var executions []CanvasNodeExecution
 	query := database.Conn().
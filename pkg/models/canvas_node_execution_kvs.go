@@ -67,3 +67,20 @@ func FirstNodeExecutionByKVInTransaction(tx *gorm.DB, workflowID uuid.UUID, node
 
 	return &execution, nil
 }
+
+func NodeExecutionKVExistsInTransaction(tx *gorm.DB, executionID uuid.UUID, key, value string) (bool, error) {
+	var count int64
+
+	err := tx.
+		Model(&CanvasNodeExecutionKV{}).
+		Where("execution_id = ?", executionID).
+		Where("key = ? AND value = ?", key, value).
+		Count(&count).
+		Error
+
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
@@ -0,0 +1,91 @@
+package models
+
+import (
+	"regexp"
+	"strings"
+)
+
+var canvasVariableNameRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+var canvasEnvironmentRegex = regexp.MustCompile(`^[A-Za-z0-9_-]{0,64}$`)
+
+// CanvasVariable is a value defined once per canvas,
+// and available in expressions as vars.<name>.
+// Variables are stored in the canvas version,
+// so they change together with the nodes using them.
+type CanvasVariable struct {
+	Name        string                   `json:"name"`
+	Description string                   `json:"description,omitempty"`
+	Value       string                   `json:"value,omitempty"`
+	Secret      *CanvasVariableSecretRef `json:"secret,omitempty"`
+	Overrides   []CanvasVariableOverride `json:"overrides,omitempty"`
+}
+
+// CanvasVariableSecretRef points to a key of an organization secret.
+type CanvasVariableSecretRef struct {
+	Secret string `json:"secret"`
+	Key    string `json:"key"`
+}
+
+// CanvasVariableOverride replaces the value of a variable
+// for canvases running in a specific environment.
+type CanvasVariableOverride struct {
+	Environment string                   `json:"environment"`
+	Value       string                   `json:"value,omitempty"`
+	Secret      *CanvasVariableSecretRef `json:"secret,omitempty"`
+}
+
+func IsValidCanvasVariableName(name string) bool {
+	return canvasVariableNameRegex.MatchString(name)
+}
+
+// IsValidCanvasEnvironment reports whether the environment can be set on a canvas.
+// An empty environment means the canvas uses the default variable values.
+func IsValidCanvasEnvironment(environment string) bool {
+	return canvasEnvironmentRegex.MatchString(environment)
+}
+
+// ForEnvironment returns the variable with the override
+// for the environment applied, if there is one.
+func (v CanvasVariable) ForEnvironment(environment string) CanvasVariable {
+	environment = strings.TrimSpace(environment)
+	if environment == "" {
+		return v
+	}
+
+	for _, override := range v.Overrides {
+		if override.Environment != environment {
+			continue
+		}
+
+		v.Value = override.Value
+		v.Secret = override.Secret
+		return v
+	}
+
+	return v
+}
+
+// ExpressionValue returns the value of the variable in expressions.
+// Secret-backed variables are exposed as a reference to the secret key,
+// not as the secret value, so they never end up in resolved configurations.
+func (v CanvasVariable) ExpressionValue() any {
+	if v.Secret != nil {
+		return map[string]any{
+			"secret": v.Secret.Secret,
+			"key":    v.Secret.Key,
+		}
+	}
+
+	return v.Value
+}
+
+// CanvasVariablesForExpressions returns the vars namespace used in expressions,
+// resolving overrides for the environment of the canvas.
+func CanvasVariablesForExpressions(variables []CanvasVariable, environment string) map[string]any {
+	vars := make(map[string]any, len(variables))
+	for _, variable := range variables {
+		vars[variable.Name] = variable.ForEnvironment(environment).ExpressionValue()
+	}
+
+	return vars
+}
@@ -0,0 +1,69 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanvasVariablesForExpressions(t *testing.T) {
+	variables := []CanvasVariable{
+		{
+			Name:  "region",
+			Value: "us-east-1",
+			Overrides: []CanvasVariableOverride{
+				{Environment: "production", Value: "eu-west-1"},
+			},
+		},
+		{
+			Name:   "sshKey",
+			Secret: &CanvasVariableSecretRef{Secret: "staging-keys", Key: "ssh"},
+			Overrides: []CanvasVariableOverride{
+				{Environment: "production", Secret: &CanvasVariableSecretRef{Secret: "production-keys", Key: "ssh"}},
+			},
+		},
+	}
+
+	t.Run("default values are used without environment", func(t *testing.T) {
+		vars := CanvasVariablesForExpressions(variables, "")
+		assert.Equal(t, "us-east-1", vars["region"])
+		assert.Equal(t, map[string]any{"secret": "staging-keys", "key": "ssh"}, vars["sshKey"])
+	})
+
+	t.Run("overrides for the environment are applied", func(t *testing.T) {
+		vars := CanvasVariablesForExpressions(variables, "production")
+		assert.Equal(t, "eu-west-1", vars["region"])
+		assert.Equal(t, map[string]any{"secret": "production-keys", "key": "ssh"}, vars["sshKey"])
+	})
+
+	t.Run("environment without overrides uses default values", func(t *testing.T) {
+		vars := CanvasVariablesForExpressions(variables, "staging")
+		assert.Equal(t, "us-east-1", vars["region"])
+	})
+
+	t.Run("override can replace a secret with a plain value", func(t *testing.T) {
+		variable := CanvasVariable{
+			Name:      "token",
+			Secret:    &CanvasVariableSecretRef{Secret: "tokens", Key: "api"},
+			Overrides: []CanvasVariableOverride{{Environment: "dev", Value: "dev-token"}},
+		}
+
+		assert.Equal(t, "dev-token", variable.ForEnvironment("dev").ExpressionValue())
+	})
+}
+
+func TestIsValidCanvasVariableName(t *testing.T) {
+	assert.True(t, IsValidCanvasVariableName("region"))
+	assert.True(t, IsValidCanvasVariableName("_private2"))
+	assert.False(t, IsValidCanvasVariableName("2fa"))
+	assert.False(t, IsValidCanvasVariableName("my-var"))
+	assert.False(t, IsValidCanvasVariableName(""))
+}
+
+func TestIsValidCanvasEnvironment(t *testing.T) {
+	assert.True(t, IsValidCanvasEnvironment(""))
+	assert.True(t, IsValidCanvasEnvironment("production"))
+	assert.True(t, IsValidCanvasEnvironment("eu_west-1"))
+	assert.False(t, IsValidCanvasEnvironment("prod env"))
+	assert.False(t, IsValidCanvasEnvironment(string(make([]byte, 65))))
+}
@@ -21,6 +21,7 @@ type CanvasVersion struct {
 	PublishedAt *time.Time
 	Nodes       datatypes.JSONSlice[Node]
 	Edges       datatypes.JSONSlice[Edge]
+	Variables   datatypes.JSONSlice[CanvasVariable]
 	CreatedAt   *time.Time
 	UpdatedAt   *time.Time
 }
@@ -172,6 +173,7 @@ func CreatePublishedCanvasVersionInTransaction(
 	ownerID *uuid.UUID,
 	nodes []Node,
 	edges []Edge,
+	variables []CanvasVariable,
 ) (*CanvasVersion, error) {
 	canvas, err := lockCanvasForVersioningInTransaction(tx, workflowID)
 	if err != nil {
@@ -187,6 +189,7 @@ func CreatePublishedCanvasVersionInTransaction(
 		PublishedAt: &now,
 		Nodes:       datatypes.NewJSONSlice(nodes),
 		Edges:       datatypes.NewJSONSlice(edges),
+		Variables:   datatypes.NewJSONSlice(variables),
 		CreatedAt:   &now,
 		UpdatedAt:   &now,
 	}
@@ -211,6 +214,7 @@ func SaveCanvasDraftInTransaction(
 	userID uuid.UUID,
 	nodes []Node,
 	edges []Edge,
+	variables []CanvasVariable,
 ) (*CanvasVersion, error) {
 	_, err := lockCanvasForVersioningInTransaction(tx, workflowID)
 	if err != nil {
@@ -225,6 +229,7 @@ func SaveCanvasDraftInTransaction(
 		IsPublished: false,
 		Nodes:       datatypes.NewJSONSlice(nodes),
 		Edges:       datatypes.NewJSONSlice(edges),
+		Variables:   datatypes.NewJSONSlice(variables),
 		CreatedAt:   &now,
 		UpdatedAt:   &now,
 	}
@@ -260,6 +265,7 @@ func CreateOrResetCanvasDraftInTransaction(
 	userID uuid.UUID,
 	nodes []Node,
 	edges []Edge,
+	variables []CanvasVariable,
 ) (*CanvasVersion, error) {
 	canvas, err := lockCanvasForVersioningInTransaction(tx, workflowID)
 	if err != nil {
@@ -281,6 +287,7 @@ func CreateOrResetCanvasDraftInTransaction(
 		version.OwnerID = &userID
 		version.Nodes = datatypes.NewJSONSlice(nodes)
 		version.Edges = datatypes.NewJSONSlice(edges)
+		version.Variables = datatypes.NewJSONSlice(variables)
 		version.IsPublished = false
 		version.PublishedAt = nil
 		version.UpdatedAt = &now
@@ -308,6 +315,7 @@ func CreateOrResetCanvasDraftInTransaction(
 		IsPublished: false,
 		Nodes:       datatypes.NewJSONSlice(nodes),
 		Edges:       datatypes.NewJSONSlice(edges),
+		Variables:   datatypes.NewJSONSlice(variables),
 		CreatedAt:   &now,
 		UpdatedAt:   &now,
 	}
@@ -335,6 +343,7 @@ func CreateCanvasSnapshotVersionInTransaction(
 	ownerID uuid.UUID,
 	nodes []Node,
 	edges []Edge,
+	variables []CanvasVariable,
 ) (*CanvasVersion, error) {
 	if _, err := lockCanvasForVersioningInTransaction(tx, workflowID); err != nil {
 		return nil, err
@@ -348,6 +357,7 @@ func CreateCanvasSnapshotVersionInTransaction(
 		IsPublished: false,
 		Nodes:       datatypes.NewJSONSlice(nodes),
 		Edges:       datatypes.NewJSONSlice(edges),
+		Variables:   datatypes.NewJSONSlice(variables),
 		CreatedAt:   &now,
 		UpdatedAt:   &now,
 	}
@@ -432,3 +442,23 @@ func FindLiveCanvasSpecInTransaction(tx *gorm.DB, workflowID uuid.UUID) ([]Node,
 	edges := append([]Edge(nil), version.Edges...)
 	return nodes, edges, nil
 }
+
+// FindLiveCanvasExpressionVariablesInTransaction returns the vars namespace
+// used in the expressions of a canvas, from the variables of its live version.
+func FindLiveCanvasExpressionVariablesInTransaction(tx *gorm.DB, workflowID uuid.UUID) (map[string]any, error) {
+	canvas, err := FindCanvasWithoutOrgScopeInTransaction(tx, workflowID)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := FindLiveCanvasVersionByCanvasInTransaction(tx, canvas)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return map[string]any{}, nil
+		}
+
+		return nil, err
+	}
+
+	return CanvasVariablesForExpressions(version.Variables, canvas.Environment), nil
+}
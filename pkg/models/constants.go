@@ -5,22 +5,38 @@ import "fmt"
 const (
 	ProviderGitHub = "github"
 	ProviderGoogle = "google"
+	ProviderOIDC   = "oidc"
+	ProviderSAML   = "saml"
 
 	DomainTypeOrganization = "org"
+	DomainTypeCanvas       = "canvas"
 
 	DisplayNameOwner  = "Owner"
 	DisplayNameAdmin  = "Admin"
 	DisplayNameViewer = "Viewer"
 
+	DisplayNameEditor   = "Editor"
+	DisplayNameOperator = "Operator"
+
 	RoleOrgOwner  = "org_owner"
 	RoleOrgAdmin  = "org_admin"
 	RoleOrgViewer = "org_viewer"
 
+	RoleCanvasOwner    = "canvas_owner"
+	RoleCanvasEditor   = "canvas_editor"
+	RoleCanvasOperator = "canvas_operator"
+	RoleCanvasViewer   = "canvas_viewer"
+
 	// Role descriptions
 	DescOrgOwner  = "Complete control over the organization including settings and deletion"
 	DescOrgAdmin  = "Full management access to organization resources including canvases and users"
 	DescOrgViewer = "Read-only access to organization resources"
 
+	DescCanvasOwner    = "Complete control over the canvas including its members and deletion"
+	DescCanvasEditor   = "Can edit the canvas, in addition to operating it"
+	DescCanvasOperator = "Can run actions and approve executions on the canvas"
+	DescCanvasViewer   = "Read-only access to the canvas"
+
 	// Metadata descriptions
 	MetaDescOrgOwner  = "Full control over organization settings, billing, and member management."
 	MetaDescOrgAdmin  = "Can manage canvases, users, groups, and roles within the organization."
@@ -37,7 +53,7 @@ var (
 )
 
 func ValidateDomainType(domainType string) error {
-	if domainType != DomainTypeOrganization {
+	if domainType != DomainTypeOrganization && domainType != DomainTypeCanvas {
 		return fmt.Errorf("invalid domain type %s", domainType)
 	}
 	return nil
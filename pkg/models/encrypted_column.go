@@ -0,0 +1,152 @@
+package models
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+)
+
+const EncryptedColumnEncodingBase64 = "base64"
+
+// EncryptedColumn is a column holding values
+// encrypted with the application encryptor,
+// as bytea, or as text with the encoding of the values.
+type EncryptedColumn struct {
+	Table    string
+	Column   string
+	Encoding string
+
+	// AssociatedData is the SQL expression for the associated data
+	// the values were encrypted with.
+	AssociatedData string
+
+	// Condition restricts the rows holding encrypted values, if not all do.
+	Condition string
+}
+
+// EncryptedColumns lists the columns the re-encryption worker
+// re-wraps when the key encryption key is rotated.
+var EncryptedColumns = []EncryptedColumn{
+	{
+		Table:          "secrets",
+		Column:         "data",
+		AssociatedData: "secrets.name",
+		Condition:      "secrets.provider = 'local'",
+	},
+	{
+		Table:          "secret_versions",
+		Column:         "data",
+		AssociatedData: "(SELECT secrets.name FROM secrets WHERE secrets.id = secret_versions.secret_id)",
+		Condition:      "EXISTS (SELECT 1 FROM secrets WHERE secrets.id = secret_versions.secret_id AND secrets.provider = 'local')",
+	},
+	{
+		Table:          "webhooks",
+		Column:         "secret",
+		AssociatedData: "webhooks.id::text",
+	},
+	{
+		Table:          "app_installation_secrets",
+		Column:         "value",
+		AssociatedData: "app_installation_secrets.installation_id::text",
+	},
+	{
+		Table:          "organization_sso_settings",
+		Column:         "oidc_client_secret_ciphertext",
+		AssociatedData: "organization_sso_settings.organization_id::text",
+		Condition:      "organization_sso_settings.oidc_client_secret_ciphertext IS NOT NULL",
+	},
+	{
+		Table:          "organization_agent_settings",
+		Column:         "openai_api_key_ciphertext",
+		AssociatedData: "'agent_mode_openai_api_key'",
+		Condition:      "organization_agent_settings.openai_api_key_ciphertext IS NOT NULL",
+	},
+	{
+		Table:          "email_settings",
+		Column:         "smtp_password",
+		AssociatedData: "'smtp_password'",
+		Condition:      "email_settings.smtp_password IS NOT NULL",
+	},
+	{
+		Table:          "account_providers",
+		Column:         "access_token",
+		Encoding:       EncryptedColumnEncodingBase64,
+		AssociatedData: "account_providers.email",
+		Condition:      "account_providers.access_token IS NOT NULL AND account_providers.access_token <> ''",
+	},
+}
+
+type EncryptedValue struct {
+	ID             uuid.UUID
+	Data           []byte
+	AssociatedData string
+}
+
+// ListEncryptedValues returns the values of an encrypted column
+// for the rows with an ID greater than afterID, ordered by ID.
+func ListEncryptedValues(column EncryptedColumn, afterID uuid.UUID, limit int) ([]EncryptedValue, error) {
+	var values []EncryptedValue
+
+	query := database.Conn().
+		Table(column.Table).
+		Select(fmt.Sprintf("%s.id AS id, %s.%s AS data, %s AS associated_data", column.Table, column.Table, column.Column, column.AssociatedData)).
+		Where(fmt.Sprintf("%s.id > ?", column.Table), afterID)
+
+	if column.Condition != "" {
+		query = query.Where(column.Condition)
+	}
+
+	err := query.
+		Order(fmt.Sprintf("%s.id", column.Table)).
+		Limit(limit).
+		Scan(&values).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range values {
+		values[i].Data, err = column.decode(values[i].Data)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding value of %s: %w", values[i].ID, err)
+		}
+	}
+
+	return values, nil
+}
+
+// ReplaceEncryptedValue updates an encrypted value,
+// only if it was not changed since it was read.
+// It reports whether the value was replaced.
+func ReplaceEncryptedValue(column EncryptedColumn, id uuid.UUID, oldData, newData []byte) (bool, error) {
+	result := database.Conn().
+		Table(column.Table).
+		Where("id = ?", id).
+		Where(fmt.Sprintf("%s = ?", column.Column), column.encode(oldData)).
+		Update(column.Column, column.encode(newData))
+
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+func (c EncryptedColumn) decode(data []byte) ([]byte, error) {
+	if c.Encoding != EncryptedColumnEncodingBase64 {
+		return data, nil
+	}
+
+	return base64.StdEncoding.DecodeString(string(data))
+}
+
+func (c EncryptedColumn) encode(data []byte) any {
+	if c.Encoding != EncryptedColumnEncodingBase64 {
+		return data
+	}
+
+	return base64.StdEncoding.EncodeToString(data)
+}
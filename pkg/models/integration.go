@@ -87,6 +87,40 @@ func ListIntegrations(orgID uuid.UUID) ([]Integration, error) {
 	return integrations, nil
 }
 
+// ListIntegrationsAfter returns the integrations with an ID greater than afterID, ordered by ID.
+func ListIntegrationsAfter(afterID uuid.UUID, limit int) ([]Integration, error) {
+	var integrations []Integration
+	err := database.Conn().
+		Where("id > ?", afterID).
+		Order("id").
+		Limit(limit).
+		Find(&integrations).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return integrations, nil
+}
+
+// ReplaceIntegrationConfiguration updates the configuration of an integration,
+// only if it was not changed since it was read.
+// It reports whether the configuration was replaced.
+func ReplaceIntegrationConfiguration(id uuid.UUID, oldConfig, newConfig map[string]any) (bool, error) {
+	result := database.Conn().
+		Model(&Integration{}).
+		Where("id = ?", id).
+		Where("configuration = ?", datatypes.NewJSONType(oldConfig)).
+		Update("configuration", datatypes.NewJSONType(newConfig))
+
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
 func ListIntegrationWebhooks(tx *gorm.DB, integrationID uuid.UUID) ([]Webhook, error) {
 	var webhooks []Webhook
 	err := tx.
@@ -0,0 +1,326 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// OrganizationMemory is a record in an organization memory namespace.
+type OrganizationMemory struct {
+	ID             uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	OrganizationID uuid.UUID
+	Namespace      string
+	Values         datatypes.JSONType[any]
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func (OrganizationMemory) TableName() string {
+	return "organization_memories"
+}
+
+func AddOrganizationMemoryInTransaction(tx *gorm.DB, organizationID uuid.UUID, namespace string, values any) (*OrganizationMemory, error) {
+	record := OrganizationMemory{
+		OrganizationID: organizationID,
+		Namespace:      namespace,
+		Values:         datatypes.NewJSONType(values),
+	}
+
+	if err := tx.Create(&record).Error; err != nil {
+		return nil, err
+	}
+
+	return &record, nil
+}
+
+func ListOrganizationMemoriesByNamespace(organizationID uuid.UUID, namespace string) ([]OrganizationMemory, error) {
+	var records []OrganizationMemory
+	err := database.Conn().
+		Where("organization_id = ? AND namespace = ?", organizationID, namespace).
+		Order("created_at DESC").
+		Find(&records).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+func ListOrganizationMemoriesByNamespaceAndMatchesInTransaction(
+	tx *gorm.DB,
+	organizationID uuid.UUID,
+	namespace string,
+	matches map[string]any,
+) ([]OrganizationMemory, error) {
+	condition, args, err := organizationMemoryMatchesCondition(organizationID, namespace, matches)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []OrganizationMemory
+	err = tx.
+		Where(condition, args...).
+		Order("created_at DESC").
+		Find(&records).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+func FindFirstOrganizationMemoryByNamespaceAndMatchesInTransaction(
+	tx *gorm.DB,
+	organizationID uuid.UUID,
+	namespace string,
+	matches map[string]any,
+) (*OrganizationMemory, error) {
+	records, err := ListOrganizationMemoriesByNamespaceAndMatchesInTransaction(tx, organizationID, namespace, matches)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	return &records[0], nil
+}
+
+func UpdateOrganizationMemoriesByNamespaceAndMatchesInTransaction(
+	tx *gorm.DB,
+	organizationID uuid.UUID,
+	namespace string,
+	matches map[string]any,
+	values map[string]any,
+) ([]OrganizationMemory, error) {
+	if len(values) == 0 {
+		return []OrganizationMemory{}, fmt.Errorf("at least one value expression is required")
+	}
+
+	condition, conditionArgs, err := organizationMemoryMatchesCondition(organizationID, namespace, matches)
+	if err != nil {
+		return nil, err
+	}
+
+	valuesJSON, err := json.Marshal(values)
+	if err != nil {
+		return nil, err
+	}
+
+	var updatedRecords []OrganizationMemory
+	err = tx.Raw(
+		`WITH updated AS (
+			UPDATE organization_memories
+			SET values = values || ?::jsonb, updated_at = NOW()
+			WHERE `+condition+`
+			RETURNING *
+		)
+		SELECT * FROM updated ORDER BY created_at DESC`,
+		append([]any{valuesJSON}, conditionArgs...)...,
+	).Scan(&updatedRecords).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return updatedRecords, nil
+}
+
+func DeleteOrganizationMemoriesByNamespaceAndMatchesInTransaction(
+	tx *gorm.DB,
+	organizationID uuid.UUID,
+	namespace string,
+	matches map[string]any,
+) ([]OrganizationMemory, error) {
+	condition, args, err := organizationMemoryMatchesCondition(organizationID, namespace, matches)
+	if err != nil {
+		return nil, err
+	}
+
+	var deletedRecords []OrganizationMemory
+	err = tx.Raw(
+		`WITH deleted AS (
+			DELETE FROM organization_memories
+			WHERE `+condition+`
+			RETURNING *
+		)
+		SELECT * FROM deleted ORDER BY created_at DESC`,
+		args...,
+	).Scan(&deletedRecords).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return deletedRecords, nil
+}
+
+func DeleteOrganizationMemory(organizationID, memoryID uuid.UUID) error {
+	return database.Conn().
+		Where("organization_id = ? AND id = ?", organizationID, memoryID).
+		Delete(&OrganizationMemory{}).
+		Error
+}
+
+// IncrementOrganizationMemoryInTransaction works like IncrementCanvasMemoryInTransaction,
+// for the records of an organization memory namespace.
+func IncrementOrganizationMemoryInTransaction(
+	tx *gorm.DB,
+	organizationID uuid.UUID,
+	namespace string,
+	matches map[string]any,
+	field string,
+	delta float64,
+) ([]OrganizationMemory, error) {
+	if err := validateMemoryFieldOperation(matches, field); err != nil {
+		return []OrganizationMemory{}, err
+	}
+
+	records, err := lockOrganizationMemoriesByNamespaceAndMatches(tx, organizationID, namespace, matches)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(records) == 0 {
+		record, err := AddOrganizationMemoryInTransaction(tx, organizationID, namespace, setMemoryField(matches, field, delta))
+		if err != nil {
+			return nil, err
+		}
+
+		return []OrganizationMemory{*record}, nil
+	}
+
+	for i := range records {
+		values, err := incrementMemoryField(records[i].Values.Data(), field, delta)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := saveOrganizationMemoryValuesInTransaction(tx, &records[i], values); err != nil {
+			return nil, err
+		}
+	}
+
+	return records, nil
+}
+
+// CompareAndSetOrganizationMemoryInTransaction works like CompareAndSetCanvasMemoryInTransaction,
+// for the records of an organization memory namespace.
+func CompareAndSetOrganizationMemoryInTransaction(
+	tx *gorm.DB,
+	organizationID uuid.UUID,
+	namespace string,
+	matches map[string]any,
+	field string,
+	expected any,
+	value any,
+) (bool, []OrganizationMemory, error) {
+	if err := validateMemoryFieldOperation(matches, field); err != nil {
+		return false, []OrganizationMemory{}, err
+	}
+
+	records, err := lockOrganizationMemoriesByNamespaceAndMatches(tx, organizationID, namespace, matches)
+	if err != nil {
+		return false, nil, err
+	}
+
+	if len(records) == 0 {
+		if expected != nil {
+			return false, []OrganizationMemory{}, nil
+		}
+
+		record, err := AddOrganizationMemoryInTransaction(tx, organizationID, namespace, setMemoryField(matches, field, value))
+		if err != nil {
+			return false, nil, err
+		}
+
+		return true, []OrganizationMemory{*record}, nil
+	}
+
+	for _, record := range records {
+		equal, err := memoryFieldEquals(record.Values.Data(), field, expected)
+		if err != nil {
+			return false, nil, err
+		}
+
+		if !equal {
+			return false, records, nil
+		}
+	}
+
+	for i := range records {
+		values := setMemoryField(records[i].Values.Data().(map[string]any), field, value)
+		if err := saveOrganizationMemoryValuesInTransaction(tx, &records[i], values); err != nil {
+			return false, nil, err
+		}
+	}
+
+	return true, records, nil
+}
+
+func lockOrganizationMemoriesByNamespaceAndMatches(
+	tx *gorm.DB,
+	organizationID uuid.UUID,
+	namespace string,
+	matches map[string]any,
+) ([]OrganizationMemory, error) {
+	if err := lockMemoryKey(tx, "organization_memories", organizationID, namespace, matches); err != nil {
+		return nil, err
+	}
+
+	condition, args, err := organizationMemoryMatchesCondition(organizationID, namespace, matches)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []OrganizationMemory
+	err = tx.
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where(condition, args...).
+		Order("created_at DESC").
+		Find(&records).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+func saveOrganizationMemoryValuesInTransaction(tx *gorm.DB, record *OrganizationMemory, values map[string]any) error {
+	record.Values = datatypes.NewJSONType[any](values)
+	record.UpdatedAt = time.Now()
+
+	return tx.
+		Model(&OrganizationMemory{}).
+		Where("id = ?", record.ID).
+		Updates(map[string]any{
+			"values":     record.Values,
+			"updated_at": record.UpdatedAt,
+		}).
+		Error
+}
+
+func organizationMemoryMatchesCondition(organizationID uuid.UUID, namespace string, matches map[string]any) (string, []any, error) {
+	if len(matches) == 0 {
+		return "", nil, fmt.Errorf("at least one match expression is required")
+	}
+
+	matchesJSON, err := json.Marshal(matches)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return "organization_id = ? AND namespace = ? AND values @> ?::jsonb", []any{organizationID, namespace, matchesJSON}, nil
+}
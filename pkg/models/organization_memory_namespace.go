@@ -0,0 +1,171 @@
+package models
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	OrganizationMemoryAccessNone  = "none"
+	OrganizationMemoryAccessRead  = "read"
+	OrganizationMemoryAccessWrite = "write"
+)
+
+// OrganizationMemoryNamespacePrefix marks the namespaces used by canvas memory operations
+// that refer to an organization memory namespace instead of a canvas one.
+const OrganizationMemoryNamespacePrefix = "org/"
+
+var organizationMemoryNamespaceRegex = regexp.MustCompile(`^[A-Za-z0-9_.-]{1,128}$`)
+
+// OrganizationMemoryNamespace is a memory namespace shared by the canvases of an organization.
+// Canvases only have the access granted to them explicitly, or the default access.
+type OrganizationMemoryNamespace struct {
+	ID             uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	OrganizationID uuid.UUID
+	Namespace      string
+	Description    string
+	DefaultAccess  string
+	CanvasAccess   datatypes.JSONSlice[OrganizationMemoryCanvasAccess]
+	CreatedBy      *uuid.UUID
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// OrganizationMemoryCanvasAccess grants a canvas access to an organization memory namespace.
+type OrganizationMemoryCanvasAccess struct {
+	CanvasID string `json:"canvasId"`
+	Access   string `json:"access"`
+}
+
+func (OrganizationMemoryNamespace) TableName() string {
+	return "organization_memory_namespaces"
+}
+
+func IsValidOrganizationMemoryAccess(access string) bool {
+	switch access {
+	case OrganizationMemoryAccessNone, OrganizationMemoryAccessRead, OrganizationMemoryAccessWrite:
+		return true
+	default:
+		return false
+	}
+}
+
+func IsValidOrganizationMemoryNamespace(namespace string) bool {
+	return organizationMemoryNamespaceRegex.MatchString(namespace)
+}
+
+// ParseOrganizationMemoryNamespace returns the organization namespace
+// a canvas memory namespace refers to, if it has the organization prefix.
+func ParseOrganizationMemoryNamespace(namespace string) (string, bool) {
+	if !strings.HasPrefix(namespace, OrganizationMemoryNamespacePrefix) {
+		return "", false
+	}
+
+	return strings.TrimPrefix(namespace, OrganizationMemoryNamespacePrefix), true
+}
+
+// AccessForCanvas returns the access a canvas has to the namespace.
+func (n *OrganizationMemoryNamespace) AccessForCanvas(canvasID uuid.UUID) string {
+	for _, grant := range n.CanvasAccess {
+		if grant.CanvasID == canvasID.String() {
+			return grant.Access
+		}
+	}
+
+	if n.DefaultAccess == "" {
+		return OrganizationMemoryAccessNone
+	}
+
+	return n.DefaultAccess
+}
+
+func (n *OrganizationMemoryNamespace) CanvasCanRead(canvasID uuid.UUID) bool {
+	access := n.AccessForCanvas(canvasID)
+	return access == OrganizationMemoryAccessRead || access == OrganizationMemoryAccessWrite
+}
+
+func (n *OrganizationMemoryNamespace) CanvasCanWrite(canvasID uuid.UUID) bool {
+	return n.AccessForCanvas(canvasID) == OrganizationMemoryAccessWrite
+}
+
+func FindOrganizationMemoryNamespaceInTransaction(tx *gorm.DB, organizationID uuid.UUID, namespace string) (*OrganizationMemoryNamespace, error) {
+	var record OrganizationMemoryNamespace
+	err := tx.
+		Where("organization_id = ? AND namespace = ?", organizationID, namespace).
+		First(&record).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &record, nil
+}
+
+func FindOrganizationMemoryNamespace(organizationID uuid.UUID, namespace string) (*OrganizationMemoryNamespace, error) {
+	return FindOrganizationMemoryNamespaceInTransaction(database.Conn(), organizationID, namespace)
+}
+
+func ListOrganizationMemoryNamespaces(organizationID uuid.UUID) ([]OrganizationMemoryNamespace, error) {
+	var records []OrganizationMemoryNamespace
+	err := database.Conn().
+		Where("organization_id = ?", organizationID).
+		Order("namespace ASC").
+		Find(&records).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+// SaveOrganizationMemoryNamespaceInTransaction creates or replaces the settings of a namespace.
+// The creator of a namespace is kept when it is replaced.
+func SaveOrganizationMemoryNamespaceInTransaction(tx *gorm.DB, namespace *OrganizationMemoryNamespace) error {
+	now := time.Now()
+	namespace.UpdatedAt = now
+	if namespace.CreatedAt.IsZero() {
+		namespace.CreatedAt = now
+	}
+
+	return tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "organization_id"}, {Name: "namespace"}},
+		DoUpdates: clause.AssignmentColumns([]string{"description", "default_access", "canvas_access", "updated_at"}),
+	}).Create(namespace).Error
+}
+
+func SaveOrganizationMemoryNamespace(namespace *OrganizationMemoryNamespace) error {
+	return SaveOrganizationMemoryNamespaceInTransaction(database.Conn(), namespace)
+}
+
+// DeleteOrganizationMemoryNamespaceInTransaction removes a namespace and all its records.
+func DeleteOrganizationMemoryNamespaceInTransaction(tx *gorm.DB, organizationID uuid.UUID, namespace string) error {
+	err := tx.
+		Where("organization_id = ? AND namespace = ?", organizationID, namespace).
+		Delete(&OrganizationMemory{}).
+		Error
+
+	if err != nil {
+		return err
+	}
+
+	return tx.
+		Where("organization_id = ? AND namespace = ?", organizationID, namespace).
+		Delete(&OrganizationMemoryNamespace{}).
+		Error
+}
+
+func DeleteOrganizationMemoryNamespace(organizationID uuid.UUID, namespace string) error {
+	return database.Conn().Transaction(func(tx *gorm.DB) error {
+		return DeleteOrganizationMemoryNamespaceInTransaction(tx, organizationID, namespace)
+	})
+}
@@ -0,0 +1,62 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrganizationMemoryNamespaceAccess(t *testing.T) {
+	reader := uuid.New()
+	writer := uuid.New()
+	blocked := uuid.New()
+	other := uuid.New()
+
+	namespace := &OrganizationMemoryNamespace{
+		Namespace:     "release-locks",
+		DefaultAccess: OrganizationMemoryAccessRead,
+		CanvasAccess: []OrganizationMemoryCanvasAccess{
+			{CanvasID: reader.String(), Access: OrganizationMemoryAccessRead},
+			{CanvasID: writer.String(), Access: OrganizationMemoryAccessWrite},
+			{CanvasID: blocked.String(), Access: OrganizationMemoryAccessNone},
+		},
+	}
+
+	assert.True(t, namespace.CanvasCanRead(reader))
+	assert.False(t, namespace.CanvasCanWrite(reader))
+
+	assert.True(t, namespace.CanvasCanRead(writer))
+	assert.True(t, namespace.CanvasCanWrite(writer))
+
+	assert.False(t, namespace.CanvasCanRead(blocked))
+	assert.False(t, namespace.CanvasCanWrite(blocked))
+
+	t.Run("canvases without grants have the default access", func(t *testing.T) {
+		assert.True(t, namespace.CanvasCanRead(other))
+		assert.False(t, namespace.CanvasCanWrite(other))
+	})
+
+	t.Run("empty default access means no access", func(t *testing.T) {
+		namespace := &OrganizationMemoryNamespace{Namespace: "release-locks"}
+		assert.Equal(t, OrganizationMemoryAccessNone, namespace.AccessForCanvas(other))
+		assert.False(t, namespace.CanvasCanRead(other))
+	})
+}
+
+func TestParseOrganizationMemoryNamespace(t *testing.T) {
+	name, ok := ParseOrganizationMemoryNamespace("org/release-locks")
+	assert.True(t, ok)
+	assert.Equal(t, "release-locks", name)
+
+	_, ok = ParseOrganizationMemoryNamespace("release-locks")
+	assert.False(t, ok)
+}
+
+func TestIsValidOrganizationMemoryNamespace(t *testing.T) {
+	assert.True(t, IsValidOrganizationMemoryNamespace("release-locks"))
+	assert.True(t, IsValidOrganizationMemoryNamespace("deploys.v2_prod"))
+	assert.False(t, IsValidOrganizationMemoryNamespace(""))
+	assert.False(t, IsValidOrganizationMemoryNamespace("org/locks"))
+	assert.False(t, IsValidOrganizationMemoryNamespace("release locks"))
+}
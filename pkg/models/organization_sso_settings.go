@@ -0,0 +1,196 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	SSOProtocolOIDC = "oidc"
+	SSOProtocolSAML = "saml"
+)
+
+// OrganizationSSOSettings configures single sign-on for an organization,
+// with a generic OIDC issuer or a SAML 2.0 identity provider.
+type OrganizationSSOSettings struct {
+	ID                         uuid.UUID `gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	OrganizationID             uuid.UUID `gorm:"type:uuid;uniqueIndex"`
+	Protocol                   string
+	Enabled                    bool
+	Enforced                   bool
+	JITProvisioning            bool       `gorm:"column:jit_provisioning"`
+	OIDCIssuerURL              *string    `gorm:"column:oidc_issuer_url"`
+	OIDCClientID               *string    `gorm:"column:oidc_client_id"`
+	OIDCClientSecretCiphertext []byte     `gorm:"column:oidc_client_secret_ciphertext"`
+	SAMLIdPMetadataURL         *string    `gorm:"column:saml_idp_metadata_url"`
+	SAMLIdPMetadata            *string    `gorm:"column:saml_idp_metadata"`
+	UpdatedBy                  *uuid.UUID `gorm:"type:uuid"`
+	CreatedAt                  time.Time
+	UpdatedAt                  time.Time
+}
+
+func (s *OrganizationSSOSettings) TableName() string {
+	return "organization_sso_settings"
+}
+
+// IsEnforced reports whether members must sign in with SSO to use the organization.
+func (s *OrganizationSSOSettings) IsEnforced() bool {
+	return s.Enabled && s.Enforced
+}
+
+func FindOrganizationSSOSettings(organizationID string) (*OrganizationSSOSettings, error) {
+	return FindOrganizationSSOSettingsInTransaction(database.Conn(), organizationID)
+}
+
+func FindOrganizationSSOSettingsInTransaction(tx *gorm.DB, organizationID string) (*OrganizationSSOSettings, error) {
+	var settings OrganizationSSOSettings
+
+	err := tx.
+		Where("organization_id = ?", organizationID).
+		First(&settings).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &settings, nil
+}
+
+func UpsertOrganizationSSOSettings(settings *OrganizationSSOSettings) error {
+	return UpsertOrganizationSSOSettingsInTransaction(database.Conn(), settings)
+}
+
+func UpsertOrganizationSSOSettingsInTransaction(tx *gorm.DB, settings *OrganizationSSOSettings) error {
+	return tx.
+		Clauses(
+			clause.OnConflict{
+				Columns: []clause.Column{{Name: "organization_id"}},
+				DoUpdates: clause.AssignmentColumns([]string{
+					"protocol",
+					"enabled",
+					"enforced",
+					"jit_provisioning",
+					"oidc_issuer_url",
+					"oidc_client_id",
+					"oidc_client_secret_ciphertext",
+					"saml_idp_metadata_url",
+					"saml_idp_metadata",
+					"updated_by",
+					"updated_at",
+				}),
+			},
+		).
+		Create(settings).
+		Error
+}
+
+// OrganizationSSODomain is an email domain claimed by an organization for single sign-on.
+// SSO logins only match or create accounts with emails in the verified domains of the organization,
+// so its identity provider cannot sign in as accounts of other domains.
+type OrganizationSSODomain struct {
+	ID                uuid.UUID `gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	OrganizationID    uuid.UUID `gorm:"type:uuid"`
+	Domain            string
+	VerificationToken string
+	VerifiedAt        *time.Time
+	CreatedAt         time.Time
+}
+
+func (d *OrganizationSSODomain) TableName() string {
+	return "organization_sso_domains"
+}
+
+func (d *OrganizationSSODomain) IsVerified() bool {
+	return d.VerifiedAt != nil
+}
+
+// VerificationRecord is the name of the DNS TXT record that verifies the domain.
+func (d *OrganizationSSODomain) VerificationRecord() string {
+	return "_superplane-verification." + d.Domain
+}
+
+// VerificationValue is the value the DNS TXT record must have to verify the domain.
+func (d *OrganizationSSODomain) VerificationValue() string {
+	return "superplane-verification=" + d.VerificationToken
+}
+
+func ListOrganizationSSODomains(organizationID string) ([]OrganizationSSODomain, error) {
+	var domains []OrganizationSSODomain
+
+	err := database.Conn().
+		Where("organization_id = ?", organizationID).
+		Order("domain ASC").
+		Find(&domains).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	return domains, nil
+}
+
+// FindVerifiedSSODomain returns the domain, if some organization verified it.
+func FindVerifiedSSODomain(domain string) (*OrganizationSSODomain, error) {
+	var ssoDomain OrganizationSSODomain
+
+	err := database.Conn().
+		Where("domain = ?", domain).
+		Where("verified_at IS NOT NULL").
+		First(&ssoDomain).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &ssoDomain, nil
+}
+
+func IsOrganizationSSODomainVerified(organizationID, domain string) (bool, error) {
+	var count int64
+
+	err := database.Conn().
+		Model(&OrganizationSSODomain{}).
+		Where("organization_id = ?", organizationID).
+		Where("domain = ?", domain).
+		Where("verified_at IS NOT NULL").
+		Count(&count).
+		Error
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// ReplaceOrganizationSSODomainsInTransaction saves the domains of the organization,
+// deleting the ones not in the list.
+func ReplaceOrganizationSSODomainsInTransaction(tx *gorm.DB, organizationID uuid.UUID, domains []OrganizationSSODomain) error {
+	names := []string{}
+	for _, domain := range domains {
+		names = append(names, domain.Domain)
+	}
+
+	query := tx.Where("organization_id = ?", organizationID)
+	if len(names) > 0 {
+		query = query.Where("domain NOT IN ?", names)
+	}
+
+	err := query.Delete(&OrganizationSSODomain{}).Error
+	if err != nil {
+		return err
+	}
+
+	for i := range domains {
+		domains[i].OrganizationID = organizationID
+		err := tx.Save(&domains[i]).Error
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
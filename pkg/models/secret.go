@@ -1,47 +1,188 @@
 package models
 
 import (
+	"errors"
+	"slices"
 	"strings"
 	"time"
 
 	uuid "github.com/google/uuid"
 	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/datatypes"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
 
+var ErrSecretNotAllowedForCanvas = errors.New("secret is not available to this canvas")
+
 type Secret struct {
-	ID         uuid.UUID `gorm:"primary_key;default:uuid_generate_v4()"`
-	DomainType string
-	DomainID   uuid.UUID
-	Name       string
-	CreatedAt  *time.Time
-	CreatedBy  uuid.UUID
-	UpdatedAt  *time.Time
-	Provider   string
-	Data       []byte
+	ID            uuid.UUID `gorm:"primary_key;default:uuid_generate_v4()"`
+	DomainType    string
+	DomainID      uuid.UUID
+	Name          string
+	CreatedAt     *time.Time
+	CreatedBy     uuid.UUID
+	UpdatedAt     *time.Time
+	Provider      string
+	Data          []byte
+	ActiveVersion int
+
+	//
+	// Canvases that can read an organization secret.
+	// If empty, all canvases of the organization can read it.
+	//
+	AllowedCanvasIDs datatypes.JSONSlice[string]
 }
 
 type SecretData struct {
 	Local map[string]string `json:"local"`
 }
 
-func (s *Secret) UpdateData(data []byte) (*Secret, error) {
+// UpdateData stores the data as a new version of the secret and activates it.
+func (s *Secret) UpdateData(data []byte, updatedBy *uuid.UUID, reason string) (*SecretVersion, error) {
+	var version *SecretVersion
+	err := database.Conn().Transaction(func(tx *gorm.DB) error {
+		var err error
+		version, err = s.UpdateDataInTransaction(tx, data, updatedBy, reason)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return version, nil
+}
+
+func (s *Secret) UpdateDataInTransaction(tx *gorm.DB, data []byte, updatedBy *uuid.UUID, reason string) (*SecretVersion, error) {
+	//
+	// The secret row is locked, so concurrent updates
+	// do not try to create the same version.
+	//
+	err := tx.
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id").
+		Where("id = ?", s.ID).
+		Take(&Secret{}).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	var latest int
+	err = tx.
+		Model(&SecretVersion{}).
+		Select("COALESCE(MAX(version), 0)").
+		Where("secret_id = ?", s.ID).
+		Scan(&latest).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	version := SecretVersion{
+		SecretID:    s.ID,
+		Version:     latest + 1,
+		Data:        data,
+		Reason:      reason,
+		CreatedBy:   updatedBy,
+		CreatedAt:   now,
+		ActivatedAt: &now,
+	}
+
+	err = tx.Create(&version).Error
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.activateInTransaction(tx, &version, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return &version, nil
+}
+
+// ActivateVersionInTransaction makes a previous version the current data of the secret.
+func (s *Secret) ActivateVersionInTransaction(tx *gorm.DB, number int) (*SecretVersion, error) {
+	version, err := FindSecretVersionInTransaction(tx, s.ID, number)
+	if err != nil {
+		return nil, err
+	}
+
 	now := time.Now()
+	err = tx.
+		Model(version).
+		Update("activated_at", &now).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	version.ActivatedAt = &now
+	err = s.activateInTransaction(tx, version, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return version, nil
+}
+
+func (s *Secret) activateInTransaction(tx *gorm.DB, version *SecretVersion, now time.Time) error {
+	err := tx.
+		Model(s).
+		Where("id = ?", s.ID).
+		Updates(map[string]any{
+			"data":           version.Data,
+			"active_version": version.Version,
+			"updated_at":     &now,
+		}).
+		Error
+
+	if err != nil {
+		return err
+	}
+
+	s.Data = version.Data
+	s.ActiveVersion = version.Version
+	s.UpdatedAt = &now
+	return nil
+}
+
+// AllowsCanvas reports whether executions of a canvas can read the secret.
+// Canvas secrets are only available to their canvas.
+func (s *Secret) AllowsCanvas(canvasID uuid.UUID) bool {
+	if s.DomainType == DomainTypeCanvas {
+		return s.DomainID == canvasID
+	}
+
+	return len(s.AllowedCanvasIDs) == 0 || slices.Contains(s.AllowedCanvasIDs, canvasID.String())
+}
+
+func (s *Secret) UpdateAllowedCanvasIDs(canvasIDs []string) error {
+	now := time.Now()
+	allowed := datatypes.NewJSONSlice(canvasIDs)
 
 	err := database.Conn().
 		Model(s).
-		Clauses(clause.Returning{}).
 		Where("id = ?", s.ID).
-		Update("data", data).
-		Update("updated_at", &now).
+		Updates(map[string]any{
+			"allowed_canvas_ids": allowed,
+			"updated_at":         &now,
+		}).
 		Error
 
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	return s, nil
+	s.AllowedCanvasIDs = allowed
+	s.UpdatedAt = &now
+	return nil
 }
 
 func (s *Secret) UpdateName(name string) (*Secret, error) {
@@ -92,6 +233,30 @@ func FindSecretByNameInTransaction(tx *gorm.DB, domainType string, domainID uuid
 	return &secret, nil
 }
 
+// FindSecretForCanvasInTransaction finds a secret that executions of a canvas can read.
+// Secrets of the canvas take precedence over organization secrets with the same name.
+func FindSecretForCanvasInTransaction(tx *gorm.DB, organizationID, canvasID uuid.UUID, name string) (*Secret, error) {
+	secret, err := FindSecretByNameInTransaction(tx, DomainTypeCanvas, canvasID, name)
+	if err == nil {
+		return secret, nil
+	}
+
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	secret, err = FindSecretByNameInTransaction(tx, DomainTypeOrganization, organizationID, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if !secret.AllowsCanvas(canvasID) {
+		return nil, ErrSecretNotAllowedForCanvas
+	}
+
+	return secret, nil
+}
+
 func FindSecretByID(domainType string, domainID uuid.UUID, id string) (*Secret, error) {
 	return FindSecretByIDInTransaction(database.Conn(), domainType, domainID, id)
 }
@@ -114,23 +279,50 @@ func FindSecretByIDInTransaction(tx *gorm.DB, domainType string, domainID uuid.U
 }
 
 func CreateSecret(name, provider, requesterID, domainType string, domainID uuid.UUID, data []byte) (*Secret, error) {
+	return CreateSecretWithAllowedCanvases(name, provider, requesterID, domainType, domainID, data, nil)
+}
+
+func CreateSecretWithAllowedCanvases(name, provider, requesterID, domainType string, domainID uuid.UUID, data []byte, allowedCanvasIDs []string) (*Secret, error) {
+	if allowedCanvasIDs == nil {
+		allowedCanvasIDs = []string{}
+	}
+
 	now := time.Now()
+	createdBy := uuid.MustParse(requesterID)
 
 	secret := Secret{
-		Name:       name,
-		DomainType: domainType,
-		DomainID:   domainID,
-		CreatedAt:  &now,
-		CreatedBy:  uuid.MustParse(requesterID),
-		UpdatedAt:  &now,
-		Provider:   provider,
-		Data:       data,
+		Name:             name,
+		DomainType:       domainType,
+		DomainID:         domainID,
+		CreatedAt:        &now,
+		CreatedBy:        createdBy,
+		UpdatedAt:        &now,
+		Provider:         provider,
+		Data:             data,
+		ActiveVersion:    1,
+		AllowedCanvasIDs: datatypes.NewJSONSlice(allowedCanvasIDs),
 	}
 
-	err := database.Conn().
-		Clauses(clause.Returning{}).
-		Create(&secret).
-		Error
+	err := database.Conn().Transaction(func(tx *gorm.DB) error {
+		err := tx.
+			Clauses(clause.Returning{}).
+			Create(&secret).
+			Error
+
+		if err != nil {
+			return err
+		}
+
+		return tx.Create(&SecretVersion{
+			SecretID:    secret.ID,
+			Version:     1,
+			Data:        data,
+			Reason:      SecretVersionReasonCreated,
+			CreatedBy:   &createdBy,
+			CreatedAt:   now,
+			ActivatedAt: &now,
+		}).Error
+	})
 
 	if err == nil {
 		return &secret, nil
@@ -158,3 +350,13 @@ func ListSecrets(domainType string, domainID uuid.UUID) ([]Secret, error) {
 
 	return secrets, nil
 }
+
+// DeleteCanvasSecretsInTransaction deletes the secrets of a canvas.
+// Their versions are deleted with them.
+func DeleteCanvasSecretsInTransaction(tx *gorm.DB, canvasID uuid.UUID) error {
+	return tx.
+		Where("domain_type = ?", DomainTypeCanvas).
+		Where("domain_id = ?", canvasID).
+		Delete(&Secret{}).
+		Error
+}
@@ -0,0 +1,64 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/gorm"
+)
+
+const (
+	SecretVersionReasonCreated = "created"
+	SecretVersionReasonUpdated = "updated"
+	SecretVersionReasonRotated = "rotated"
+)
+
+// SecretVersion keeps the data a secret had at some point,
+// so a secret can be rolled back after a bad update or rotation.
+// Data is stored the same way as in the secret itself.
+type SecretVersion struct {
+	ID          uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	SecretID    uuid.UUID
+	Version     int
+	Data        []byte
+	Reason      string
+	CreatedBy   *uuid.UUID
+	CreatedAt   time.Time
+	ActivatedAt *time.Time
+}
+
+func (SecretVersion) TableName() string {
+	return "secret_versions"
+}
+
+func FindSecretVersionInTransaction(tx *gorm.DB, secretID uuid.UUID, version int) (*SecretVersion, error) {
+	var secretVersion SecretVersion
+	err := tx.
+		Where("secret_id = ?", secretID).
+		Where("version = ?", version).
+		First(&secretVersion).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &secretVersion, nil
+}
+
+// ListSecretVersions returns the versions of a secret, newest first.
+func ListSecretVersions(secretID uuid.UUID) ([]SecretVersion, error) {
+	var versions []SecretVersion
+	err := database.Conn().
+		Where("secret_id = ?", secretID).
+		Order("version DESC").
+		Find(&versions).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return versions, nil
+}
@@ -263,6 +263,133 @@ func (a *CanvasVersionAPIService) CanvasesDescribeCanvasVersionExecute(r ApiCanv
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+type ApiCanvasesDiffCanvasVersionsRequest struct {
+	ctx           context.Context
+	ApiService    *CanvasVersionAPIService
+	canvasId      string
+	versionId     string
+	baseVersionId *string
+}
+
+// Version to compare with. Defaults to the live version.
+func (r ApiCanvasesDiffCanvasVersionsRequest) BaseVersionId(baseVersionId string) ApiCanvasesDiffCanvasVersionsRequest {
+	r.baseVersionId = &baseVersionId
+	return r
+}
+
+func (r ApiCanvasesDiffCanvasVersionsRequest) Execute() (*CanvasesDiffCanvasVersionsResponse, *http.Response, error) {
+	return r.ApiService.CanvasesDiffCanvasVersionsExecute(r)
+}
+
+/*
+CanvasesDiffCanvasVersions Diff canvas versions
+
+Compares the nodes, edges and variables of a canvas version with another version, or with the live version if no base version is given
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param canvasId
+	@param versionId
+	@return ApiCanvasesDiffCanvasVersionsRequest
+*/
+func (a *CanvasVersionAPIService) CanvasesDiffCanvasVersions(ctx context.Context, canvasId string, versionId string) ApiCanvasesDiffCanvasVersionsRequest {
+	return ApiCanvasesDiffCanvasVersionsRequest{
+		ApiService: a,
+		ctx:        ctx,
+		canvasId:   canvasId,
+		versionId:  versionId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return CanvasesDiffCanvasVersionsResponse
+func (a *CanvasVersionAPIService) CanvasesDiffCanvasVersionsExecute(r ApiCanvasesDiffCanvasVersionsRequest) (*CanvasesDiffCanvasVersionsResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *CanvasesDiffCanvasVersionsResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CanvasVersionAPIService.CanvasesDiffCanvasVersions")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/canvases/{canvasId}/versions/{versionId}/diff"
+	localVarPath = strings.Replace(localVarPath, "{"+"canvasId"+"}", url.PathEscape(parameterValueToString(r.canvasId, "canvasId")), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"versionId"+"}", url.PathEscape(parameterValueToString(r.versionId, "versionId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.baseVersionId != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "baseVersionId", r.baseVersionId, "", "")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 type ApiCanvasesListCanvasVersionsRequest struct {
 	ctx        context.Context
 	ApiService *CanvasVersionAPIService
@@ -393,6 +520,133 @@ func (a *CanvasVersionAPIService) CanvasesListCanvasVersionsExecute(r ApiCanvase
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+type ApiCanvasesRollbackCanvasVersionRequest struct {
+	ctx        context.Context
+	ApiService *CanvasVersionAPIService
+	canvasId   string
+	versionId  string
+	body       *map[string]interface{}
+}
+
+func (r ApiCanvasesRollbackCanvasVersionRequest) Body(body map[string]interface{}) ApiCanvasesRollbackCanvasVersionRequest {
+	r.body = &body
+	return r
+}
+
+func (r ApiCanvasesRollbackCanvasVersionRequest) Execute() (*CanvasesRollbackCanvasVersionResponse, *http.Response, error) {
+	return r.ApiService.CanvasesRollbackCanvasVersionExecute(r)
+}
+
+/*
+CanvasesRollbackCanvasVersion Rollback canvas version
+
+Publishes a new live version of a canvas with the nodes, edges and variables of an older published version
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param canvasId
+	@param versionId
+	@return ApiCanvasesRollbackCanvasVersionRequest
+*/
+func (a *CanvasVersionAPIService) CanvasesRollbackCanvasVersion(ctx context.Context, canvasId string, versionId string) ApiCanvasesRollbackCanvasVersionRequest {
+	return ApiCanvasesRollbackCanvasVersionRequest{
+		ApiService: a,
+		ctx:        ctx,
+		canvasId:   canvasId,
+		versionId:  versionId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return CanvasesRollbackCanvasVersionResponse
+func (a *CanvasVersionAPIService) CanvasesRollbackCanvasVersionExecute(r ApiCanvasesRollbackCanvasVersionRequest) (*CanvasesRollbackCanvasVersionResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPost
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *CanvasesRollbackCanvasVersionResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CanvasVersionAPIService.CanvasesRollbackCanvasVersion")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/canvases/{canvasId}/versions/{versionId}/rollback"
+	localVarPath = strings.Replace(localVarPath, "{"+"canvasId"+"}", url.PathEscape(parameterValueToString(r.canvasId, "canvasId")), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"versionId"+"}", url.PathEscape(parameterValueToString(r.versionId, "versionId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.body == nil {
+		return localVarReturnValue, nil, reportError("body is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.body
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 type ApiCanvasesUpdateCanvasVersionRequest struct {
 	ctx        context.Context
 	ApiService *CanvasVersionAPIService
@@ -24,6 +24,7 @@ type CanvasesCanvasChangeRequest struct {
 	Version   *CanvasesCanvasVersion                `json:"version,omitempty"`
 	Diff      *CanvasesCanvasChangeRequestDiff      `json:"diff,omitempty"`
 	Approvals []CanvasesCanvasChangeRequestApproval `json:"approvals,omitempty"`
+	Checks    []CanvasesCanvasChangeRequestCheck    `json:"checks,omitempty"`
 }
 
 // NewCanvasesCanvasChangeRequest instantiates a new CanvasesCanvasChangeRequest object
@@ -171,6 +172,38 @@ func (o *CanvasesCanvasChangeRequest) SetApprovals(v []CanvasesCanvasChangeReque
 	o.Approvals = v
 }
 
+// GetChecks returns the Checks field value if set, zero value otherwise.
+func (o *CanvasesCanvasChangeRequest) GetChecks() []CanvasesCanvasChangeRequestCheck {
+	if o == nil || IsNil(o.Checks) {
+		var ret []CanvasesCanvasChangeRequestCheck
+		return ret
+	}
+	return o.Checks
+}
+
+// GetChecksOk returns a tuple with the Checks field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CanvasesCanvasChangeRequest) GetChecksOk() ([]CanvasesCanvasChangeRequestCheck, bool) {
+	if o == nil || IsNil(o.Checks) {
+		return nil, false
+	}
+	return o.Checks, true
+}
+
+// HasChecks returns a boolean if a field has been set.
+func (o *CanvasesCanvasChangeRequest) HasChecks() bool {
+	if o != nil && !IsNil(o.Checks) {
+		return true
+	}
+
+	return false
+}
+
+// SetChecks gets a reference to the given []CanvasesCanvasChangeRequestCheck and assigns it to the Checks field.
+func (o *CanvasesCanvasChangeRequest) SetChecks(v []CanvasesCanvasChangeRequestCheck) {
+	o.Checks = v
+}
+
 func (o CanvasesCanvasChangeRequest) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -193,6 +226,9 @@ func (o CanvasesCanvasChangeRequest) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Approvals) {
 		toSerialize["approvals"] = o.Approvals
 	}
+	if !IsNil(o.Checks) {
+		toSerialize["checks"] = o.Checks
+	}
 	return toSerialize, nil
 }
 
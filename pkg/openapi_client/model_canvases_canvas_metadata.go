@@ -31,6 +31,10 @@ type CanvasesCanvasMetadata struct {
 	IsTemplate                  *bool                                      `json:"isTemplate,omitempty"`
 	VersioningEnabled           *bool                                      `json:"versioningEnabled,omitempty"`
 	ChangeRequestApprovalConfig *CanvasesCanvasChangeRequestApprovalConfig `json:"changeRequestApprovalConfig,omitempty"`
+	// Environment the canvas runs in, like staging or production. Variable overrides for this environment are applied.
+	Environment                 *string                                    `json:"environment,omitempty"`
+	ChangeRequestCheckConfig    *CanvasesCanvasChangeRequestCheckConfig    `json:"changeRequestCheckConfig,omitempty"`
+	NodeActionPermissionsConfig *CanvasesCanvasNodeActionPermissionsConfig `json:"nodeActionPermissionsConfig,omitempty"`
 }
 
 // NewCanvasesCanvasMetadata instantiates a new CanvasesCanvasMetadata object
@@ -370,6 +374,102 @@ func (o *CanvasesCanvasMetadata) SetChangeRequestApprovalConfig(v CanvasesCanvas
 	o.ChangeRequestApprovalConfig = &v
 }
 
+// GetEnvironment returns the Environment field value if set, zero value otherwise.
+func (o *CanvasesCanvasMetadata) GetEnvironment() string {
+	if o == nil || IsNil(o.Environment) {
+		var ret string
+		return ret
+	}
+	return *o.Environment
+}
+
+// GetEnvironmentOk returns a tuple with the Environment field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CanvasesCanvasMetadata) GetEnvironmentOk() (*string, bool) {
+	if o == nil || IsNil(o.Environment) {
+		return nil, false
+	}
+	return o.Environment, true
+}
+
+// HasEnvironment returns a boolean if a field has been set.
+func (o *CanvasesCanvasMetadata) HasEnvironment() bool {
+	if o != nil && !IsNil(o.Environment) {
+		return true
+	}
+
+	return false
+}
+
+// SetEnvironment gets a reference to the given string and assigns it to the Environment field.
+func (o *CanvasesCanvasMetadata) SetEnvironment(v string) {
+	o.Environment = &v
+}
+
+// GetChangeRequestCheckConfig returns the ChangeRequestCheckConfig field value if set, zero value otherwise.
+func (o *CanvasesCanvasMetadata) GetChangeRequestCheckConfig() CanvasesCanvasChangeRequestCheckConfig {
+	if o == nil || IsNil(o.ChangeRequestCheckConfig) {
+		var ret CanvasesCanvasChangeRequestCheckConfig
+		return ret
+	}
+	return *o.ChangeRequestCheckConfig
+}
+
+// GetChangeRequestCheckConfigOk returns a tuple with the ChangeRequestCheckConfig field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CanvasesCanvasMetadata) GetChangeRequestCheckConfigOk() (*CanvasesCanvasChangeRequestCheckConfig, bool) {
+	if o == nil || IsNil(o.ChangeRequestCheckConfig) {
+		return nil, false
+	}
+	return o.ChangeRequestCheckConfig, true
+}
+
+// HasChangeRequestCheckConfig returns a boolean if a field has been set.
+func (o *CanvasesCanvasMetadata) HasChangeRequestCheckConfig() bool {
+	if o != nil && !IsNil(o.ChangeRequestCheckConfig) {
+		return true
+	}
+
+	return false
+}
+
+// SetChangeRequestCheckConfig gets a reference to the given CanvasesCanvasChangeRequestCheckConfig and assigns it to the ChangeRequestCheckConfig field.
+func (o *CanvasesCanvasMetadata) SetChangeRequestCheckConfig(v CanvasesCanvasChangeRequestCheckConfig) {
+	o.ChangeRequestCheckConfig = &v
+}
+
+// GetNodeActionPermissionsConfig returns the NodeActionPermissionsConfig field value if set, zero value otherwise.
+func (o *CanvasesCanvasMetadata) GetNodeActionPermissionsConfig() CanvasesCanvasNodeActionPermissionsConfig {
+	if o == nil || IsNil(o.NodeActionPermissionsConfig) {
+		var ret CanvasesCanvasNodeActionPermissionsConfig
+		return ret
+	}
+	return *o.NodeActionPermissionsConfig
+}
+
+// GetNodeActionPermissionsConfigOk returns a tuple with the NodeActionPermissionsConfig field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CanvasesCanvasMetadata) GetNodeActionPermissionsConfigOk() (*CanvasesCanvasNodeActionPermissionsConfig, bool) {
+	if o == nil || IsNil(o.NodeActionPermissionsConfig) {
+		return nil, false
+	}
+	return o.NodeActionPermissionsConfig, true
+}
+
+// HasNodeActionPermissionsConfig returns a boolean if a field has been set.
+func (o *CanvasesCanvasMetadata) HasNodeActionPermissionsConfig() bool {
+	if o != nil && !IsNil(o.NodeActionPermissionsConfig) {
+		return true
+	}
+
+	return false
+}
+
+// SetNodeActionPermissionsConfig gets a reference to the given CanvasesCanvasNodeActionPermissionsConfig and assigns it to the NodeActionPermissionsConfig field.
+func (o *CanvasesCanvasMetadata) SetNodeActionPermissionsConfig(v CanvasesCanvasNodeActionPermissionsConfig) {
+	o.NodeActionPermissionsConfig = &v
+}
+
 func (o CanvasesCanvasMetadata) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -410,6 +510,15 @@ func (o CanvasesCanvasMetadata) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.ChangeRequestApprovalConfig) {
 		toSerialize["changeRequestApprovalConfig"] = o.ChangeRequestApprovalConfig
 	}
+	if !IsNil(o.Environment) {
+		toSerialize["environment"] = o.Environment
+	}
+	if !IsNil(o.ChangeRequestCheckConfig) {
+		toSerialize["changeRequestCheckConfig"] = o.ChangeRequestCheckConfig
+	}
+	if !IsNil(o.NodeActionPermissionsConfig) {
+		toSerialize["nodeActionPermissionsConfig"] = o.NodeActionPermissionsConfig
+	}
 	return toSerialize, nil
 }
 
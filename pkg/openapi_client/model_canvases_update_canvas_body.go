@@ -24,6 +24,9 @@ type CanvasesUpdateCanvasBody struct {
 	Description                 *string                                    `json:"description,omitempty"`
 	VersioningEnabled           *bool                                      `json:"versioningEnabled,omitempty"`
 	ChangeRequestApprovalConfig *CanvasesCanvasChangeRequestApprovalConfig `json:"changeRequestApprovalConfig,omitempty"`
+	Environment                 *string                                    `json:"environment,omitempty"`
+	ChangeRequestCheckConfig    *CanvasesCanvasChangeRequestCheckConfig    `json:"changeRequestCheckConfig,omitempty"`
+	NodeActionPermissionsConfig *CanvasesCanvasNodeActionPermissionsConfig `json:"nodeActionPermissionsConfig,omitempty"`
 }
 
 // NewCanvasesUpdateCanvasBody instantiates a new CanvasesUpdateCanvasBody object
@@ -171,6 +174,102 @@ func (o *CanvasesUpdateCanvasBody) SetChangeRequestApprovalConfig(v CanvasesCanv
 	o.ChangeRequestApprovalConfig = &v
 }
 
+// GetEnvironment returns the Environment field value if set, zero value otherwise.
+func (o *CanvasesUpdateCanvasBody) GetEnvironment() string {
+	if o == nil || IsNil(o.Environment) {
+		var ret string
+		return ret
+	}
+	return *o.Environment
+}
+
+// GetEnvironmentOk returns a tuple with the Environment field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CanvasesUpdateCanvasBody) GetEnvironmentOk() (*string, bool) {
+	if o == nil || IsNil(o.Environment) {
+		return nil, false
+	}
+	return o.Environment, true
+}
+
+// HasEnvironment returns a boolean if a field has been set.
+func (o *CanvasesUpdateCanvasBody) HasEnvironment() bool {
+	if o != nil && !IsNil(o.Environment) {
+		return true
+	}
+
+	return false
+}
+
+// SetEnvironment gets a reference to the given string and assigns it to the Environment field.
+func (o *CanvasesUpdateCanvasBody) SetEnvironment(v string) {
+	o.Environment = &v
+}
+
+// GetChangeRequestCheckConfig returns the ChangeRequestCheckConfig field value if set, zero value otherwise.
+func (o *CanvasesUpdateCanvasBody) GetChangeRequestCheckConfig() CanvasesCanvasChangeRequestCheckConfig {
+	if o == nil || IsNil(o.ChangeRequestCheckConfig) {
+		var ret CanvasesCanvasChangeRequestCheckConfig
+		return ret
+	}
+	return *o.ChangeRequestCheckConfig
+}
+
+// GetChangeRequestCheckConfigOk returns a tuple with the ChangeRequestCheckConfig field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CanvasesUpdateCanvasBody) GetChangeRequestCheckConfigOk() (*CanvasesCanvasChangeRequestCheckConfig, bool) {
+	if o == nil || IsNil(o.ChangeRequestCheckConfig) {
+		return nil, false
+	}
+	return o.ChangeRequestCheckConfig, true
+}
+
+// HasChangeRequestCheckConfig returns a boolean if a field has been set.
+func (o *CanvasesUpdateCanvasBody) HasChangeRequestCheckConfig() bool {
+	if o != nil && !IsNil(o.ChangeRequestCheckConfig) {
+		return true
+	}
+
+	return false
+}
+
+// SetChangeRequestCheckConfig gets a reference to the given CanvasesCanvasChangeRequestCheckConfig and assigns it to the ChangeRequestCheckConfig field.
+func (o *CanvasesUpdateCanvasBody) SetChangeRequestCheckConfig(v CanvasesCanvasChangeRequestCheckConfig) {
+	o.ChangeRequestCheckConfig = &v
+}
+
+// GetNodeActionPermissionsConfig returns the NodeActionPermissionsConfig field value if set, zero value otherwise.
+func (o *CanvasesUpdateCanvasBody) GetNodeActionPermissionsConfig() CanvasesCanvasNodeActionPermissionsConfig {
+	if o == nil || IsNil(o.NodeActionPermissionsConfig) {
+		var ret CanvasesCanvasNodeActionPermissionsConfig
+		return ret
+	}
+	return *o.NodeActionPermissionsConfig
+}
+
+// GetNodeActionPermissionsConfigOk returns a tuple with the NodeActionPermissionsConfig field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CanvasesUpdateCanvasBody) GetNodeActionPermissionsConfigOk() (*CanvasesCanvasNodeActionPermissionsConfig, bool) {
+	if o == nil || IsNil(o.NodeActionPermissionsConfig) {
+		return nil, false
+	}
+	return o.NodeActionPermissionsConfig, true
+}
+
+// HasNodeActionPermissionsConfig returns a boolean if a field has been set.
+func (o *CanvasesUpdateCanvasBody) HasNodeActionPermissionsConfig() bool {
+	if o != nil && !IsNil(o.NodeActionPermissionsConfig) {
+		return true
+	}
+
+	return false
+}
+
+// SetNodeActionPermissionsConfig gets a reference to the given CanvasesCanvasNodeActionPermissionsConfig and assigns it to the NodeActionPermissionsConfig field.
+func (o *CanvasesUpdateCanvasBody) SetNodeActionPermissionsConfig(v CanvasesCanvasNodeActionPermissionsConfig) {
+	o.NodeActionPermissionsConfig = &v
+}
+
 func (o CanvasesUpdateCanvasBody) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -193,6 +292,15 @@ func (o CanvasesUpdateCanvasBody) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.ChangeRequestApprovalConfig) {
 		toSerialize["changeRequestApprovalConfig"] = o.ChangeRequestApprovalConfig
 	}
+	if !IsNil(o.Environment) {
+		toSerialize["environment"] = o.Environment
+	}
+	if !IsNil(o.ChangeRequestCheckConfig) {
+		toSerialize["changeRequestCheckConfig"] = o.ChangeRequestCheckConfig
+	}
+	if !IsNil(o.NodeActionPermissionsConfig) {
+		toSerialize["nodeActionPermissionsConfig"] = o.NodeActionPermissionsConfig
+	}
 	return toSerialize, nil
 }
 
@@ -23,12 +23,18 @@ type SecretProvider string
 const (
 	SECRETPROVIDER_PROVIDER_UNKNOWN SecretProvider = "PROVIDER_UNKNOWN"
 	SECRETPROVIDER_PROVIDER_LOCAL   SecretProvider = "PROVIDER_LOCAL"
+	SECRETPROVIDER_PROVIDER_VAULT   SecretProvider = "PROVIDER_VAULT"
+	SECRETPROVIDER_PROVIDER_FILE    SecretProvider = "PROVIDER_FILE"
+	SECRETPROVIDER_PROVIDER_ENV     SecretProvider = "PROVIDER_ENV"
 )
 
 // All allowed values of SecretProvider enum
 var AllowedSecretProviderEnumValues = []SecretProvider{
 	"PROVIDER_UNKNOWN",
 	"PROVIDER_LOCAL",
+	"PROVIDER_VAULT",
+	"PROVIDER_FILE",
+	"PROVIDER_ENV",
 }
 
 func (v *SecretProvider) UnmarshalJSON(src []byte) error {
@@ -21,11 +21,12 @@ var _ MappedNullable = &SecretsSecretMetadata{}
 
 // SecretsSecretMetadata struct for SecretsSecretMetadata
 type SecretsSecretMetadata struct {
-	Id         *string                  `json:"id,omitempty"`
-	Name       *string                  `json:"name,omitempty"`
-	DomainType *AuthorizationDomainType `json:"domainType,omitempty"`
-	DomainId   *string                  `json:"domainId,omitempty"`
-	CreatedAt  *time.Time               `json:"createdAt,omitempty"`
+	Id            *string                  `json:"id,omitempty"`
+	Name          *string                  `json:"name,omitempty"`
+	DomainType    *AuthorizationDomainType `json:"domainType,omitempty"`
+	DomainId      *string                  `json:"domainId,omitempty"`
+	CreatedAt     *time.Time               `json:"createdAt,omitempty"`
+	ActiveVersion *int32                   `json:"activeVersion,omitempty"`
 }
 
 // NewSecretsSecretMetadata instantiates a new SecretsSecretMetadata object
@@ -209,6 +210,38 @@ func (o *SecretsSecretMetadata) SetCreatedAt(v time.Time) {
 	o.CreatedAt = &v
 }
 
+// GetActiveVersion returns the ActiveVersion field value if set, zero value otherwise.
+func (o *SecretsSecretMetadata) GetActiveVersion() int32 {
+	if o == nil || IsNil(o.ActiveVersion) {
+		var ret int32
+		return ret
+	}
+	return *o.ActiveVersion
+}
+
+// GetActiveVersionOk returns a tuple with the ActiveVersion field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *SecretsSecretMetadata) GetActiveVersionOk() (*int32, bool) {
+	if o == nil || IsNil(o.ActiveVersion) {
+		return nil, false
+	}
+	return o.ActiveVersion, true
+}
+
+// HasActiveVersion returns a boolean if a field has been set.
+func (o *SecretsSecretMetadata) HasActiveVersion() bool {
+	if o != nil && !IsNil(o.ActiveVersion) {
+		return true
+	}
+
+	return false
+}
+
+// SetActiveVersion gets a reference to the given int32 and assigns it to the ActiveVersion field.
+func (o *SecretsSecretMetadata) SetActiveVersion(v int32) {
+	o.ActiveVersion = &v
+}
+
 func (o SecretsSecretMetadata) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -234,6 +267,9 @@ func (o SecretsSecretMetadata) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.CreatedAt) {
 		toSerialize["createdAt"] = o.CreatedAt
 	}
+	if !IsNil(o.ActiveVersion) {
+		toSerialize["activeVersion"] = o.ActiveVersion
+	}
 	return toSerialize, nil
 }
 
@@ -22,6 +22,11 @@ var _ MappedNullable = &SecretsSecretSpec{}
 type SecretsSecretSpec struct {
 	Provider *SecretProvider `json:"provider,omitempty"`
 	Local    *SecretLocal    `json:"local,omitempty"`
+	Vault    *SecretVault    `json:"vault,omitempty"`
+	File     *SecretFile     `json:"file,omitempty"`
+	Env      *SecretEnv      `json:"env,omitempty"`
+	// Canvases that can read an organization secret. If empty, all canvases of the organization can read it. Canvas secrets are only available to their canvas.
+	AllowedCanvasIds []string `json:"allowedCanvasIds,omitempty"`
 }
 
 // NewSecretsSecretSpec instantiates a new SecretsSecretSpec object
@@ -109,6 +114,134 @@ func (o *SecretsSecretSpec) SetLocal(v SecretLocal) {
 	o.Local = &v
 }
 
+// GetVault returns the Vault field value if set, zero value otherwise.
+func (o *SecretsSecretSpec) GetVault() SecretVault {
+	if o == nil || IsNil(o.Vault) {
+		var ret SecretVault
+		return ret
+	}
+	return *o.Vault
+}
+
+// GetVaultOk returns a tuple with the Vault field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *SecretsSecretSpec) GetVaultOk() (*SecretVault, bool) {
+	if o == nil || IsNil(o.Vault) {
+		return nil, false
+	}
+	return o.Vault, true
+}
+
+// HasVault returns a boolean if a field has been set.
+func (o *SecretsSecretSpec) HasVault() bool {
+	if o != nil && !IsNil(o.Vault) {
+		return true
+	}
+
+	return false
+}
+
+// SetVault gets a reference to the given SecretVault and assigns it to the Vault field.
+func (o *SecretsSecretSpec) SetVault(v SecretVault) {
+	o.Vault = &v
+}
+
+// GetFile returns the File field value if set, zero value otherwise.
+func (o *SecretsSecretSpec) GetFile() SecretFile {
+	if o == nil || IsNil(o.File) {
+		var ret SecretFile
+		return ret
+	}
+	return *o.File
+}
+
+// GetFileOk returns a tuple with the File field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *SecretsSecretSpec) GetFileOk() (*SecretFile, bool) {
+	if o == nil || IsNil(o.File) {
+		return nil, false
+	}
+	return o.File, true
+}
+
+// HasFile returns a boolean if a field has been set.
+func (o *SecretsSecretSpec) HasFile() bool {
+	if o != nil && !IsNil(o.File) {
+		return true
+	}
+
+	return false
+}
+
+// SetFile gets a reference to the given SecretFile and assigns it to the File field.
+func (o *SecretsSecretSpec) SetFile(v SecretFile) {
+	o.File = &v
+}
+
+// GetEnv returns the Env field value if set, zero value otherwise.
+func (o *SecretsSecretSpec) GetEnv() SecretEnv {
+	if o == nil || IsNil(o.Env) {
+		var ret SecretEnv
+		return ret
+	}
+	return *o.Env
+}
+
+// GetEnvOk returns a tuple with the Env field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *SecretsSecretSpec) GetEnvOk() (*SecretEnv, bool) {
+	if o == nil || IsNil(o.Env) {
+		return nil, false
+	}
+	return o.Env, true
+}
+
+// HasEnv returns a boolean if a field has been set.
+func (o *SecretsSecretSpec) HasEnv() bool {
+	if o != nil && !IsNil(o.Env) {
+		return true
+	}
+
+	return false
+}
+
+// SetEnv gets a reference to the given SecretEnv and assigns it to the Env field.
+func (o *SecretsSecretSpec) SetEnv(v SecretEnv) {
+	o.Env = &v
+}
+
+// GetAllowedCanvasIds returns the AllowedCanvasIds field value if set, zero value otherwise.
+func (o *SecretsSecretSpec) GetAllowedCanvasIds() []string {
+	if o == nil || IsNil(o.AllowedCanvasIds) {
+		var ret []string
+		return ret
+	}
+	return o.AllowedCanvasIds
+}
+
+// GetAllowedCanvasIdsOk returns a tuple with the AllowedCanvasIds field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *SecretsSecretSpec) GetAllowedCanvasIdsOk() ([]string, bool) {
+	if o == nil || IsNil(o.AllowedCanvasIds) {
+		return nil, false
+	}
+	return o.AllowedCanvasIds, true
+}
+
+// HasAllowedCanvasIds returns a boolean if a field has been set.
+func (o *SecretsSecretSpec) HasAllowedCanvasIds() bool {
+	if o != nil && !IsNil(o.AllowedCanvasIds) {
+		return true
+	}
+
+	return false
+}
+
+// SetAllowedCanvasIds gets a reference to the given []string and assigns it to the AllowedCanvasIds field.
+func (o *SecretsSecretSpec) SetAllowedCanvasIds(v []string) {
+	o.AllowedCanvasIds = v
+}
+
 func (o SecretsSecretSpec) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -125,6 +258,18 @@ func (o SecretsSecretSpec) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Local) {
 		toSerialize["local"] = o.Local
 	}
+	if !IsNil(o.Vault) {
+		toSerialize["vault"] = o.Vault
+	}
+	if !IsNil(o.File) {
+		toSerialize["file"] = o.File
+	}
+	if !IsNil(o.Env) {
+		toSerialize["env"] = o.Env
+	}
+	if !IsNil(o.AllowedCanvasIds) {
+		toSerialize["allowedCanvasIds"] = o.AllowedCanvasIds
+	}
 	return toSerialize, nil
 }
 
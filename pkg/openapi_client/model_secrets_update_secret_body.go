@@ -23,6 +23,8 @@ type SecretsUpdateSecretBody struct {
 	Secret     *SecretsSecret           `json:"secret,omitempty"`
 	DomainType *AuthorizationDomainType `json:"domainType,omitempty"`
 	DomainId   *string                  `json:"domainId,omitempty"`
+	// The canvases allowed to use the secret are only replaced when this is set, so clients updating the secret values keep them.
+	UpdateAllowedCanvasIds *bool `json:"updateAllowedCanvasIds,omitempty"`
 }
 
 // NewSecretsUpdateSecretBody instantiates a new SecretsUpdateSecretBody object
@@ -142,6 +144,38 @@ func (o *SecretsUpdateSecretBody) SetDomainId(v string) {
 	o.DomainId = &v
 }
 
+// GetUpdateAllowedCanvasIds returns the UpdateAllowedCanvasIds field value if set, zero value otherwise.
+func (o *SecretsUpdateSecretBody) GetUpdateAllowedCanvasIds() bool {
+	if o == nil || IsNil(o.UpdateAllowedCanvasIds) {
+		var ret bool
+		return ret
+	}
+	return *o.UpdateAllowedCanvasIds
+}
+
+// GetUpdateAllowedCanvasIdsOk returns a tuple with the UpdateAllowedCanvasIds field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *SecretsUpdateSecretBody) GetUpdateAllowedCanvasIdsOk() (*bool, bool) {
+	if o == nil || IsNil(o.UpdateAllowedCanvasIds) {
+		return nil, false
+	}
+	return o.UpdateAllowedCanvasIds, true
+}
+
+// HasUpdateAllowedCanvasIds returns a boolean if a field has been set.
+func (o *SecretsUpdateSecretBody) HasUpdateAllowedCanvasIds() bool {
+	if o != nil && !IsNil(o.UpdateAllowedCanvasIds) {
+		return true
+	}
+
+	return false
+}
+
+// SetUpdateAllowedCanvasIds gets a reference to the given bool and assigns it to the UpdateAllowedCanvasIds field.
+func (o *SecretsUpdateSecretBody) SetUpdateAllowedCanvasIds(v bool) {
+	o.UpdateAllowedCanvasIds = &v
+}
+
 func (o SecretsUpdateSecretBody) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -161,6 +195,9 @@ func (o SecretsUpdateSecretBody) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.DomainId) {
 		toSerialize["domainId"] = o.DomainId
 	}
+	if !IsNil(o.UpdateAllowedCanvasIds) {
+		toSerialize["updateAllowedCanvasIds"] = o.UpdateAllowedCanvasIds
+	}
 	return toSerialize, nil
 }
 
@@ -10,6 +10,7 @@ import (
 	_struct "github.com/golang/protobuf/ptypes/struct"
 	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	blueprints "github.com/superplanehq/superplane/pkg/protos/blueprints"
 	components "github.com/superplanehq/superplane/pkg/protos/components"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -176,7 +177,59 @@ func (x ActOnCanvasChangeRequestRequest_Action) Number() protoreflect.EnumNumber
 
 // Deprecated: Use ActOnCanvasChangeRequestRequest_Action.Descriptor instead.
 func (ActOnCanvasChangeRequestRequest_Action) EnumDescriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{23, 0}
+	return file_canvases_proto_rawDescGZIP(), []int{27, 0}
+}
+
+type CanvasVersionDiff_ChangeType int32
+
+const (
+	CanvasVersionDiff_CHANGE_TYPE_UNSPECIFIED CanvasVersionDiff_ChangeType = 0
+	CanvasVersionDiff_CHANGE_TYPE_ADDED       CanvasVersionDiff_ChangeType = 1
+	CanvasVersionDiff_CHANGE_TYPE_REMOVED     CanvasVersionDiff_ChangeType = 2
+	CanvasVersionDiff_CHANGE_TYPE_MODIFIED    CanvasVersionDiff_ChangeType = 3
+)
+
+// Enum value maps for CanvasVersionDiff_ChangeType.
+var (
+	CanvasVersionDiff_ChangeType_name = map[int32]string{
+		0: "CHANGE_TYPE_UNSPECIFIED",
+		1: "CHANGE_TYPE_ADDED",
+		2: "CHANGE_TYPE_REMOVED",
+		3: "CHANGE_TYPE_MODIFIED",
+	}
+	CanvasVersionDiff_ChangeType_value = map[string]int32{
+		"CHANGE_TYPE_UNSPECIFIED": 0,
+		"CHANGE_TYPE_ADDED":       1,
+		"CHANGE_TYPE_REMOVED":     2,
+		"CHANGE_TYPE_MODIFIED":    3,
+	}
+)
+
+func (x CanvasVersionDiff_ChangeType) Enum() *CanvasVersionDiff_ChangeType {
+	p := new(CanvasVersionDiff_ChangeType)
+	*p = x
+	return p
+}
+
+func (x CanvasVersionDiff_ChangeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CanvasVersionDiff_ChangeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_canvases_proto_enumTypes[3].Descriptor()
+}
+
+func (CanvasVersionDiff_ChangeType) Type() protoreflect.EnumType {
+	return &file_canvases_proto_enumTypes[3]
+}
+
+func (x CanvasVersionDiff_ChangeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CanvasVersionDiff_ChangeType.Descriptor instead.
+func (CanvasVersionDiff_ChangeType) EnumDescriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{42, 0}
 }
 
 type CanvasChangeRequestApprover_Type int32
@@ -215,11 +268,11 @@ func (x CanvasChangeRequestApprover_Type) String() string {
 }
 
 func (CanvasChangeRequestApprover_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_canvases_proto_enumTypes[3].Descriptor()
+	return file_canvases_proto_enumTypes[4].Descriptor()
 }
 
 func (CanvasChangeRequestApprover_Type) Type() protoreflect.EnumType {
-	return &file_canvases_proto_enumTypes[3]
+	return &file_canvases_proto_enumTypes[4]
 }
 
 func (x CanvasChangeRequestApprover_Type) Number() protoreflect.EnumNumber {
@@ -228,7 +281,56 @@ func (x CanvasChangeRequestApprover_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CanvasChangeRequestApprover_Type.Descriptor instead.
 func (CanvasChangeRequestApprover_Type) EnumDescriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{33, 0}
+	return file_canvases_proto_rawDescGZIP(), []int{44, 0}
+}
+
+type CanvasChangeRequestCheck_Status int32
+
+const (
+	CanvasChangeRequestCheck_STATUS_UNSPECIFIED CanvasChangeRequestCheck_Status = 0
+	CanvasChangeRequestCheck_STATUS_PASSED      CanvasChangeRequestCheck_Status = 1
+	CanvasChangeRequestCheck_STATUS_FAILED      CanvasChangeRequestCheck_Status = 2
+)
+
+// Enum value maps for CanvasChangeRequestCheck_Status.
+var (
+	CanvasChangeRequestCheck_Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "STATUS_PASSED",
+		2: "STATUS_FAILED",
+	}
+	CanvasChangeRequestCheck_Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"STATUS_PASSED":      1,
+		"STATUS_FAILED":      2,
+	}
+)
+
+func (x CanvasChangeRequestCheck_Status) Enum() *CanvasChangeRequestCheck_Status {
+	p := new(CanvasChangeRequestCheck_Status)
+	*p = x
+	return p
+}
+
+func (x CanvasChangeRequestCheck_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CanvasChangeRequestCheck_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_canvases_proto_enumTypes[5].Descriptor()
+}
+
+func (CanvasChangeRequestCheck_Status) Type() protoreflect.EnumType {
+	return &file_canvases_proto_enumTypes[5]
+}
+
+func (x CanvasChangeRequestCheck_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CanvasChangeRequestCheck_Status.Descriptor instead.
+func (CanvasChangeRequestCheck_Status) EnumDescriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{49, 0}
 }
 
 type CanvasChangeRequestApproval_State int32
@@ -267,11 +369,11 @@ func (x CanvasChangeRequestApproval_State) String() string {
 }
 
 func (CanvasChangeRequestApproval_State) Descriptor() protoreflect.EnumDescriptor {
-	return file_canvases_proto_enumTypes[4].Descriptor()
+	return file_canvases_proto_enumTypes[6].Descriptor()
 }
 
 func (CanvasChangeRequestApproval_State) Type() protoreflect.EnumType {
-	return &file_canvases_proto_enumTypes[4]
+	return &file_canvases_proto_enumTypes[6]
 }
 
 func (x CanvasChangeRequestApproval_State) Number() protoreflect.EnumNumber {
@@ -280,7 +382,7 @@ func (x CanvasChangeRequestApproval_State) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CanvasChangeRequestApproval_State.Descriptor instead.
 func (CanvasChangeRequestApproval_State) EnumDescriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{35, 0}
+	return file_canvases_proto_rawDescGZIP(), []int{50, 0}
 }
 
 type CanvasChangeRequest_Status int32
@@ -319,11 +421,11 @@ func (x CanvasChangeRequest_Status) String() string {
 }
 
 func (CanvasChangeRequest_Status) Descriptor() protoreflect.EnumDescriptor {
-	return file_canvases_proto_enumTypes[5].Descriptor()
+	return file_canvases_proto_enumTypes[7].Descriptor()
 }
 
 func (CanvasChangeRequest_Status) Type() protoreflect.EnumType {
-	return &file_canvases_proto_enumTypes[5]
+	return &file_canvases_proto_enumTypes[7]
 }
 
 func (x CanvasChangeRequest_Status) Number() protoreflect.EnumNumber {
@@ -332,7 +434,7 @@ func (x CanvasChangeRequest_Status) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CanvasChangeRequest_Status.Descriptor instead.
 func (CanvasChangeRequest_Status) EnumDescriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{36, 0}
+	return file_canvases_proto_rawDescGZIP(), []int{51, 0}
 }
 
 type CanvasNodeExecution_State int32
@@ -371,11 +473,11 @@ func (x CanvasNodeExecution_State) String() string {
 }
 
 func (CanvasNodeExecution_State) Descriptor() protoreflect.EnumDescriptor {
-	return file_canvases_proto_enumTypes[6].Descriptor()
+	return file_canvases_proto_enumTypes[8].Descriptor()
 }
 
 func (CanvasNodeExecution_State) Type() protoreflect.EnumType {
-	return &file_canvases_proto_enumTypes[6]
+	return &file_canvases_proto_enumTypes[8]
 }
 
 func (x CanvasNodeExecution_State) Number() protoreflect.EnumNumber {
@@ -384,7 +486,7 @@ func (x CanvasNodeExecution_State) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CanvasNodeExecution_State.Descriptor instead.
 func (CanvasNodeExecution_State) EnumDescriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{51, 0}
+	return file_canvases_proto_rawDescGZIP(), []int{66, 0}
 }
 
 type CanvasNodeExecution_Result int32
@@ -423,11 +525,11 @@ func (x CanvasNodeExecution_Result) String() string {
 }
 
 func (CanvasNodeExecution_Result) Descriptor() protoreflect.EnumDescriptor {
-	return file_canvases_proto_enumTypes[7].Descriptor()
+	return file_canvases_proto_enumTypes[9].Descriptor()
 }
 
 func (CanvasNodeExecution_Result) Type() protoreflect.EnumType {
-	return &file_canvases_proto_enumTypes[7]
+	return &file_canvases_proto_enumTypes[9]
 }
 
 func (x CanvasNodeExecution_Result) Number() protoreflect.EnumNumber {
@@ -436,7 +538,7 @@ func (x CanvasNodeExecution_Result) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CanvasNodeExecution_Result.Descriptor instead.
 func (CanvasNodeExecution_Result) EnumDescriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{51, 1}
+	return file_canvases_proto_rawDescGZIP(), []int{66, 1}
 }
 
 type CanvasNodeExecution_ResultReason int32
@@ -472,11 +574,11 @@ func (x CanvasNodeExecution_ResultReason) String() string {
 }
 
 func (CanvasNodeExecution_ResultReason) Descriptor() protoreflect.EnumDescriptor {
-	return file_canvases_proto_enumTypes[8].Descriptor()
+	return file_canvases_proto_enumTypes[10].Descriptor()
 }
 
 func (CanvasNodeExecution_ResultReason) Type() protoreflect.EnumType {
-	return &file_canvases_proto_enumTypes[8]
+	return &file_canvases_proto_enumTypes[10]
 }
 
 func (x CanvasNodeExecution_ResultReason) Number() protoreflect.EnumNumber {
@@ -485,7 +587,111 @@ func (x CanvasNodeExecution_ResultReason) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CanvasNodeExecution_ResultReason.Descriptor instead.
 func (CanvasNodeExecution_ResultReason) EnumDescriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{51, 2}
+	return file_canvases_proto_rawDescGZIP(), []int{66, 2}
+}
+
+type ExpressionDiagnostic_Severity int32
+
+const (
+	ExpressionDiagnostic_SEVERITY_UNSPECIFIED ExpressionDiagnostic_Severity = 0
+	ExpressionDiagnostic_SEVERITY_ERROR       ExpressionDiagnostic_Severity = 1
+	ExpressionDiagnostic_SEVERITY_WARNING     ExpressionDiagnostic_Severity = 2
+)
+
+// Enum value maps for ExpressionDiagnostic_Severity.
+var (
+	ExpressionDiagnostic_Severity_name = map[int32]string{
+		0: "SEVERITY_UNSPECIFIED",
+		1: "SEVERITY_ERROR",
+		2: "SEVERITY_WARNING",
+	}
+	ExpressionDiagnostic_Severity_value = map[string]int32{
+		"SEVERITY_UNSPECIFIED": 0,
+		"SEVERITY_ERROR":       1,
+		"SEVERITY_WARNING":     2,
+	}
+)
+
+func (x ExpressionDiagnostic_Severity) Enum() *ExpressionDiagnostic_Severity {
+	p := new(ExpressionDiagnostic_Severity)
+	*p = x
+	return p
+}
+
+func (x ExpressionDiagnostic_Severity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExpressionDiagnostic_Severity) Descriptor() protoreflect.EnumDescriptor {
+	return file_canvases_proto_enumTypes[11].Descriptor()
+}
+
+func (ExpressionDiagnostic_Severity) Type() protoreflect.EnumType {
+	return &file_canvases_proto_enumTypes[11]
+}
+
+func (x ExpressionDiagnostic_Severity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExpressionDiagnostic_Severity.Descriptor instead.
+func (ExpressionDiagnostic_Severity) EnumDescriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{109, 0}
+}
+
+type ExpressionCompletion_Kind int32
+
+const (
+	ExpressionCompletion_KIND_UNSPECIFIED ExpressionCompletion_Kind = 0
+	ExpressionCompletion_KIND_FUNCTION    ExpressionCompletion_Kind = 1
+	ExpressionCompletion_KIND_VARIABLE    ExpressionCompletion_Kind = 2
+	ExpressionCompletion_KIND_NODE        ExpressionCompletion_Kind = 3
+	ExpressionCompletion_KIND_FIELD       ExpressionCompletion_Kind = 4
+)
+
+// Enum value maps for ExpressionCompletion_Kind.
+var (
+	ExpressionCompletion_Kind_name = map[int32]string{
+		0: "KIND_UNSPECIFIED",
+		1: "KIND_FUNCTION",
+		2: "KIND_VARIABLE",
+		3: "KIND_NODE",
+		4: "KIND_FIELD",
+	}
+	ExpressionCompletion_Kind_value = map[string]int32{
+		"KIND_UNSPECIFIED": 0,
+		"KIND_FUNCTION":    1,
+		"KIND_VARIABLE":    2,
+		"KIND_NODE":        3,
+		"KIND_FIELD":       4,
+	}
+)
+
+func (x ExpressionCompletion_Kind) Enum() *ExpressionCompletion_Kind {
+	p := new(ExpressionCompletion_Kind)
+	*p = x
+	return p
+}
+
+func (x ExpressionCompletion_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExpressionCompletion_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_canvases_proto_enumTypes[12].Descriptor()
+}
+
+func (ExpressionCompletion_Kind) Type() protoreflect.EnumType {
+	return &file_canvases_proto_enumTypes[12]
+}
+
+func (x ExpressionCompletion_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExpressionCompletion_Kind.Descriptor instead.
+func (ExpressionCompletion_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{112, 0}
 }
 
 type ListCanvasesRequest struct {
@@ -671,6 +877,9 @@ type UpdateCanvasRequest struct {
 	Description                 *string                            `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
 	VersioningEnabled           *bool                              `protobuf:"varint,4,opt,name=versioning_enabled,json=versioningEnabled,proto3,oneof" json:"versioning_enabled,omitempty"`
 	ChangeRequestApprovalConfig *CanvasChangeRequestApprovalConfig `protobuf:"bytes,5,opt,name=change_request_approval_config,json=changeRequestApprovalConfig,proto3,oneof" json:"change_request_approval_config,omitempty"`
+	Environment                 *string                            `protobuf:"bytes,6,opt,name=environment,proto3,oneof" json:"environment,omitempty"`
+	ChangeRequestCheckConfig    *CanvasChangeRequestCheckConfig    `protobuf:"bytes,7,opt,name=change_request_check_config,json=changeRequestCheckConfig,proto3,oneof" json:"change_request_check_config,omitempty"`
+	NodeActionPermissionsConfig *CanvasNodeActionPermissionsConfig `protobuf:"bytes,8,opt,name=node_action_permissions_config,json=nodeActionPermissionsConfig,proto3,oneof" json:"node_action_permissions_config,omitempty"`
 	unknownFields               protoimpl.UnknownFields
 	sizeCache                   protoimpl.SizeCache
 }
@@ -740,6 +949,27 @@ func (x *UpdateCanvasRequest) GetChangeRequestApprovalConfig() *CanvasChangeRequ
 	return nil
 }
 
+func (x *UpdateCanvasRequest) GetEnvironment() string {
+	if x != nil && x.Environment != nil {
+		return *x.Environment
+	}
+	return ""
+}
+
+func (x *UpdateCanvasRequest) GetChangeRequestCheckConfig() *CanvasChangeRequestCheckConfig {
+	if x != nil {
+		return x.ChangeRequestCheckConfig
+	}
+	return nil
+}
+
+func (x *UpdateCanvasRequest) GetNodeActionPermissionsConfig() *CanvasNodeActionPermissionsConfig {
+	if x != nil {
+		return x.NodeActionPermissionsConfig
+	}
+	return nil
+}
+
 type UpdateCanvasResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Canvas        *Canvas                `protobuf:"bytes,1,opt,name=canvas,proto3" json:"canvas,omitempty"`
@@ -1364,30 +1594,30 @@ func (x *UpdateCanvasVersionResponse) GetVersion() *CanvasVersion {
 	return nil
 }
 
-type CreateCanvasChangeRequestRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	VersionId     string                 `protobuf:"bytes,2,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
-	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+type DiffCanvasVersionsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId  string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	VersionId string                 `protobuf:"bytes,2,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	// Version to compare with. Defaults to the live version.
+	BaseVersionId string `protobuf:"bytes,3,opt,name=base_version_id,json=baseVersionId,proto3" json:"base_version_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCanvasChangeRequestRequest) Reset() {
-	*x = CreateCanvasChangeRequestRequest{}
+func (x *DiffCanvasVersionsRequest) Reset() {
+	*x = DiffCanvasVersionsRequest{}
 	mi := &file_canvases_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCanvasChangeRequestRequest) String() string {
+func (x *DiffCanvasVersionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCanvasChangeRequestRequest) ProtoMessage() {}
+func (*DiffCanvasVersionsRequest) ProtoMessage() {}
 
-func (x *CreateCanvasChangeRequestRequest) ProtoReflect() protoreflect.Message {
+func (x *DiffCanvasVersionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1399,60 +1629,53 @@ func (x *CreateCanvasChangeRequestRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCanvasChangeRequestRequest.ProtoReflect.Descriptor instead.
-func (*CreateCanvasChangeRequestRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DiffCanvasVersionsRequest.ProtoReflect.Descriptor instead.
+func (*DiffCanvasVersionsRequest) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *CreateCanvasChangeRequestRequest) GetCanvasId() string {
+func (x *DiffCanvasVersionsRequest) GetCanvasId() string {
 	if x != nil {
 		return x.CanvasId
 	}
 	return ""
 }
 
-func (x *CreateCanvasChangeRequestRequest) GetVersionId() string {
+func (x *DiffCanvasVersionsRequest) GetVersionId() string {
 	if x != nil {
 		return x.VersionId
 	}
 	return ""
 }
 
-func (x *CreateCanvasChangeRequestRequest) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
-}
-
-func (x *CreateCanvasChangeRequestRequest) GetDescription() string {
+func (x *DiffCanvasVersionsRequest) GetBaseVersionId() string {
 	if x != nil {
-		return x.Description
+		return x.BaseVersionId
 	}
 	return ""
 }
 
-type CreateCanvasChangeRequestResponse struct {
+type DiffCanvasVersionsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ChangeRequest *CanvasChangeRequest   `protobuf:"bytes,1,opt,name=change_request,json=changeRequest,proto3" json:"change_request,omitempty"`
+	Diff          *CanvasVersionDiff     `protobuf:"bytes,1,opt,name=diff,proto3" json:"diff,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCanvasChangeRequestResponse) Reset() {
-	*x = CreateCanvasChangeRequestResponse{}
+func (x *DiffCanvasVersionsResponse) Reset() {
+	*x = DiffCanvasVersionsResponse{}
 	mi := &file_canvases_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCanvasChangeRequestResponse) String() string {
+func (x *DiffCanvasVersionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCanvasChangeRequestResponse) ProtoMessage() {}
+func (*DiffCanvasVersionsResponse) ProtoMessage() {}
 
-func (x *CreateCanvasChangeRequestResponse) ProtoReflect() protoreflect.Message {
+func (x *DiffCanvasVersionsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1464,44 +1687,40 @@ func (x *CreateCanvasChangeRequestResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCanvasChangeRequestResponse.ProtoReflect.Descriptor instead.
-func (*CreateCanvasChangeRequestResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DiffCanvasVersionsResponse.ProtoReflect.Descriptor instead.
+func (*DiffCanvasVersionsResponse) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *CreateCanvasChangeRequestResponse) GetChangeRequest() *CanvasChangeRequest {
+func (x *DiffCanvasVersionsResponse) GetDiff() *CanvasVersionDiff {
 	if x != nil {
-		return x.ChangeRequest
+		return x.Diff
 	}
 	return nil
 }
 
-type ListCanvasChangeRequestsRequest struct {
+type RollbackCanvasVersionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	Limit         uint32                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	Before        *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=before,proto3" json:"before,omitempty"`
-	StatusFilter  string                 `protobuf:"bytes,4,opt,name=status_filter,json=statusFilter,proto3" json:"status_filter,omitempty"`
-	OnlyMine      bool                   `protobuf:"varint,5,opt,name=only_mine,json=onlyMine,proto3" json:"only_mine,omitempty"`
-	Query         string                 `protobuf:"bytes,6,opt,name=query,proto3" json:"query,omitempty"`
+	VersionId     string                 `protobuf:"bytes,2,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCanvasChangeRequestsRequest) Reset() {
-	*x = ListCanvasChangeRequestsRequest{}
+func (x *RollbackCanvasVersionRequest) Reset() {
+	*x = RollbackCanvasVersionRequest{}
 	mi := &file_canvases_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCanvasChangeRequestsRequest) String() string {
+func (x *RollbackCanvasVersionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCanvasChangeRequestsRequest) ProtoMessage() {}
+func (*RollbackCanvasVersionRequest) ProtoMessage() {}
 
-func (x *ListCanvasChangeRequestsRequest) ProtoReflect() protoreflect.Message {
+func (x *RollbackCanvasVersionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1513,77 +1732,46 @@ func (x *ListCanvasChangeRequestsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCanvasChangeRequestsRequest.ProtoReflect.Descriptor instead.
-func (*ListCanvasChangeRequestsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RollbackCanvasVersionRequest.ProtoReflect.Descriptor instead.
+func (*RollbackCanvasVersionRequest) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *ListCanvasChangeRequestsRequest) GetCanvasId() string {
+func (x *RollbackCanvasVersionRequest) GetCanvasId() string {
 	if x != nil {
 		return x.CanvasId
 	}
 	return ""
 }
 
-func (x *ListCanvasChangeRequestsRequest) GetLimit() uint32 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
-}
-
-func (x *ListCanvasChangeRequestsRequest) GetBefore() *timestamp.Timestamp {
-	if x != nil {
-		return x.Before
-	}
-	return nil
-}
-
-func (x *ListCanvasChangeRequestsRequest) GetStatusFilter() string {
-	if x != nil {
-		return x.StatusFilter
-	}
-	return ""
-}
-
-func (x *ListCanvasChangeRequestsRequest) GetOnlyMine() bool {
-	if x != nil {
-		return x.OnlyMine
-	}
-	return false
-}
-
-func (x *ListCanvasChangeRequestsRequest) GetQuery() string {
+func (x *RollbackCanvasVersionRequest) GetVersionId() string {
 	if x != nil {
-		return x.Query
+		return x.VersionId
 	}
 	return ""
 }
 
-type ListCanvasChangeRequestsResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	ChangeRequests []*CanvasChangeRequest `protobuf:"bytes,1,rep,name=change_requests,json=changeRequests,proto3" json:"change_requests,omitempty"`
-	TotalCount     uint32                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	HasNextPage    bool                   `protobuf:"varint,3,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
-	LastTimestamp  *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=last_timestamp,json=lastTimestamp,proto3" json:"last_timestamp,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type RollbackCanvasVersionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       *CanvasVersion         `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCanvasChangeRequestsResponse) Reset() {
-	*x = ListCanvasChangeRequestsResponse{}
+func (x *RollbackCanvasVersionResponse) Reset() {
+	*x = RollbackCanvasVersionResponse{}
 	mi := &file_canvases_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCanvasChangeRequestsResponse) String() string {
+func (x *RollbackCanvasVersionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCanvasChangeRequestsResponse) ProtoMessage() {}
+func (*RollbackCanvasVersionResponse) ProtoMessage() {}
 
-func (x *ListCanvasChangeRequestsResponse) ProtoReflect() protoreflect.Message {
+func (x *RollbackCanvasVersionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1595,61 +1783,42 @@ func (x *ListCanvasChangeRequestsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCanvasChangeRequestsResponse.ProtoReflect.Descriptor instead.
-func (*ListCanvasChangeRequestsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RollbackCanvasVersionResponse.ProtoReflect.Descriptor instead.
+func (*RollbackCanvasVersionResponse) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *ListCanvasChangeRequestsResponse) GetChangeRequests() []*CanvasChangeRequest {
+func (x *RollbackCanvasVersionResponse) GetVersion() *CanvasVersion {
 	if x != nil {
-		return x.ChangeRequests
+		return x.Version
 	}
 	return nil
 }
 
-func (x *ListCanvasChangeRequestsResponse) GetTotalCount() uint32 {
-	if x != nil {
-		return x.TotalCount
-	}
-	return 0
-}
-
-func (x *ListCanvasChangeRequestsResponse) GetHasNextPage() bool {
-	if x != nil {
-		return x.HasNextPage
-	}
-	return false
-}
-
-func (x *ListCanvasChangeRequestsResponse) GetLastTimestamp() *timestamp.Timestamp {
-	if x != nil {
-		return x.LastTimestamp
-	}
-	return nil
-}
-
-type DescribeCanvasChangeRequestRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	CanvasId        string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	ChangeRequestId string                 `protobuf:"bytes,2,opt,name=change_request_id,json=changeRequestId,proto3" json:"change_request_id,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type CreateCanvasChangeRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	VersionId     string                 `protobuf:"bytes,2,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DescribeCanvasChangeRequestRequest) Reset() {
-	*x = DescribeCanvasChangeRequestRequest{}
+func (x *CreateCanvasChangeRequestRequest) Reset() {
+	*x = CreateCanvasChangeRequestRequest{}
 	mi := &file_canvases_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DescribeCanvasChangeRequestRequest) String() string {
+func (x *CreateCanvasChangeRequestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DescribeCanvasChangeRequestRequest) ProtoMessage() {}
+func (*CreateCanvasChangeRequestRequest) ProtoMessage() {}
 
-func (x *DescribeCanvasChangeRequestRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateCanvasChangeRequestRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1661,46 +1830,60 @@ func (x *DescribeCanvasChangeRequestRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DescribeCanvasChangeRequestRequest.ProtoReflect.Descriptor instead.
-func (*DescribeCanvasChangeRequestRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateCanvasChangeRequestRequest.ProtoReflect.Descriptor instead.
+func (*CreateCanvasChangeRequestRequest) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *DescribeCanvasChangeRequestRequest) GetCanvasId() string {
+func (x *CreateCanvasChangeRequestRequest) GetCanvasId() string {
 	if x != nil {
 		return x.CanvasId
 	}
 	return ""
 }
 
-func (x *DescribeCanvasChangeRequestRequest) GetChangeRequestId() string {
+func (x *CreateCanvasChangeRequestRequest) GetVersionId() string {
 	if x != nil {
-		return x.ChangeRequestId
+		return x.VersionId
 	}
 	return ""
 }
 
-type DescribeCanvasChangeRequestResponse struct {
+func (x *CreateCanvasChangeRequestRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateCanvasChangeRequestRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type CreateCanvasChangeRequestResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ChangeRequest *CanvasChangeRequest   `protobuf:"bytes,1,opt,name=change_request,json=changeRequest,proto3" json:"change_request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DescribeCanvasChangeRequestResponse) Reset() {
-	*x = DescribeCanvasChangeRequestResponse{}
+func (x *CreateCanvasChangeRequestResponse) Reset() {
+	*x = CreateCanvasChangeRequestResponse{}
 	mi := &file_canvases_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DescribeCanvasChangeRequestResponse) String() string {
+func (x *CreateCanvasChangeRequestResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DescribeCanvasChangeRequestResponse) ProtoMessage() {}
+func (*CreateCanvasChangeRequestResponse) ProtoMessage() {}
 
-func (x *DescribeCanvasChangeRequestResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateCanvasChangeRequestResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1712,41 +1895,44 @@ func (x *DescribeCanvasChangeRequestResponse) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DescribeCanvasChangeRequestResponse.ProtoReflect.Descriptor instead.
-func (*DescribeCanvasChangeRequestResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateCanvasChangeRequestResponse.ProtoReflect.Descriptor instead.
+func (*CreateCanvasChangeRequestResponse) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *DescribeCanvasChangeRequestResponse) GetChangeRequest() *CanvasChangeRequest {
+func (x *CreateCanvasChangeRequestResponse) GetChangeRequest() *CanvasChangeRequest {
 	if x != nil {
 		return x.ChangeRequest
 	}
 	return nil
 }
 
-type ActOnCanvasChangeRequestRequest struct {
-	state           protoimpl.MessageState                 `protogen:"open.v1"`
-	CanvasId        string                                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	ChangeRequestId string                                 `protobuf:"bytes,2,opt,name=change_request_id,json=changeRequestId,proto3" json:"change_request_id,omitempty"`
-	Action          ActOnCanvasChangeRequestRequest_Action `protobuf:"varint,3,opt,name=action,proto3,enum=Superplane.Canvases.ActOnCanvasChangeRequestRequest_Action" json:"action,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type ListCanvasChangeRequestsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	Limit         uint32                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Before        *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=before,proto3" json:"before,omitempty"`
+	StatusFilter  string                 `protobuf:"bytes,4,opt,name=status_filter,json=statusFilter,proto3" json:"status_filter,omitempty"`
+	OnlyMine      bool                   `protobuf:"varint,5,opt,name=only_mine,json=onlyMine,proto3" json:"only_mine,omitempty"`
+	Query         string                 `protobuf:"bytes,6,opt,name=query,proto3" json:"query,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ActOnCanvasChangeRequestRequest) Reset() {
-	*x = ActOnCanvasChangeRequestRequest{}
+func (x *ListCanvasChangeRequestsRequest) Reset() {
+	*x = ListCanvasChangeRequestsRequest{}
 	mi := &file_canvases_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ActOnCanvasChangeRequestRequest) String() string {
+func (x *ListCanvasChangeRequestsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActOnCanvasChangeRequestRequest) ProtoMessage() {}
+func (*ListCanvasChangeRequestsRequest) ProtoMessage() {}
 
-func (x *ActOnCanvasChangeRequestRequest) ProtoReflect() protoreflect.Message {
+func (x *ListCanvasChangeRequestsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1758,53 +1944,77 @@ func (x *ActOnCanvasChangeRequestRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActOnCanvasChangeRequestRequest.ProtoReflect.Descriptor instead.
-func (*ActOnCanvasChangeRequestRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListCanvasChangeRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListCanvasChangeRequestsRequest) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *ActOnCanvasChangeRequestRequest) GetCanvasId() string {
+func (x *ListCanvasChangeRequestsRequest) GetCanvasId() string {
 	if x != nil {
 		return x.CanvasId
 	}
 	return ""
 }
 
-func (x *ActOnCanvasChangeRequestRequest) GetChangeRequestId() string {
+func (x *ListCanvasChangeRequestsRequest) GetLimit() uint32 {
 	if x != nil {
-		return x.ChangeRequestId
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListCanvasChangeRequestsRequest) GetBefore() *timestamp.Timestamp {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *ListCanvasChangeRequestsRequest) GetStatusFilter() string {
+	if x != nil {
+		return x.StatusFilter
 	}
 	return ""
 }
 
-func (x *ActOnCanvasChangeRequestRequest) GetAction() ActOnCanvasChangeRequestRequest_Action {
+func (x *ListCanvasChangeRequestsRequest) GetOnlyMine() bool {
 	if x != nil {
-		return x.Action
+		return x.OnlyMine
 	}
-	return ActOnCanvasChangeRequestRequest_ACTION_UNSPECIFIED
+	return false
 }
 
-type ActOnCanvasChangeRequestResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ChangeRequest *CanvasChangeRequest   `protobuf:"bytes,1,opt,name=change_request,json=changeRequest,proto3" json:"change_request,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ListCanvasChangeRequestsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
 }
 
-func (x *ActOnCanvasChangeRequestResponse) Reset() {
-	*x = ActOnCanvasChangeRequestResponse{}
+type ListCanvasChangeRequestsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ChangeRequests []*CanvasChangeRequest `protobuf:"bytes,1,rep,name=change_requests,json=changeRequests,proto3" json:"change_requests,omitempty"`
+	TotalCount     uint32                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	HasNextPage    bool                   `protobuf:"varint,3,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
+	LastTimestamp  *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=last_timestamp,json=lastTimestamp,proto3" json:"last_timestamp,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListCanvasChangeRequestsResponse) Reset() {
+	*x = ListCanvasChangeRequestsResponse{}
 	mi := &file_canvases_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ActOnCanvasChangeRequestResponse) String() string {
+func (x *ListCanvasChangeRequestsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActOnCanvasChangeRequestResponse) ProtoMessage() {}
+func (*ListCanvasChangeRequestsResponse) ProtoMessage() {}
 
-func (x *ActOnCanvasChangeRequestResponse) ProtoReflect() protoreflect.Message {
+func (x *ListCanvasChangeRequestsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1816,42 +2026,61 @@ func (x *ActOnCanvasChangeRequestResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActOnCanvasChangeRequestResponse.ProtoReflect.Descriptor instead.
-func (*ActOnCanvasChangeRequestResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListCanvasChangeRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListCanvasChangeRequestsResponse) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *ActOnCanvasChangeRequestResponse) GetChangeRequest() *CanvasChangeRequest {
+func (x *ListCanvasChangeRequestsResponse) GetChangeRequests() []*CanvasChangeRequest {
 	if x != nil {
-		return x.ChangeRequest
+		return x.ChangeRequests
 	}
 	return nil
 }
 
-type ResolveCanvasChangeRequestRequest struct {
+func (x *ListCanvasChangeRequestsResponse) GetTotalCount() uint32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListCanvasChangeRequestsResponse) GetHasNextPage() bool {
+	if x != nil {
+		return x.HasNextPage
+	}
+	return false
+}
+
+func (x *ListCanvasChangeRequestsResponse) GetLastTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastTimestamp
+	}
+	return nil
+}
+
+type DescribeCanvasChangeRequestRequest struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	CanvasId        string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
 	ChangeRequestId string                 `protobuf:"bytes,2,opt,name=change_request_id,json=changeRequestId,proto3" json:"change_request_id,omitempty"`
-	Canvas          *Canvas                `protobuf:"bytes,3,opt,name=canvas,proto3" json:"canvas,omitempty"`
-	AutoLayout      *CanvasAutoLayout      `protobuf:"bytes,4,opt,name=auto_layout,json=autoLayout,proto3" json:"auto_layout,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
-func (x *ResolveCanvasChangeRequestRequest) Reset() {
-	*x = ResolveCanvasChangeRequestRequest{}
+func (x *DescribeCanvasChangeRequestRequest) Reset() {
+	*x = DescribeCanvasChangeRequestRequest{}
 	mi := &file_canvases_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResolveCanvasChangeRequestRequest) String() string {
+func (x *DescribeCanvasChangeRequestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResolveCanvasChangeRequestRequest) ProtoMessage() {}
+func (*DescribeCanvasChangeRequestRequest) ProtoMessage() {}
 
-func (x *ResolveCanvasChangeRequestRequest) ProtoReflect() protoreflect.Message {
+func (x *DescribeCanvasChangeRequestRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1863,61 +2092,46 @@ func (x *ResolveCanvasChangeRequestRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResolveCanvasChangeRequestRequest.ProtoReflect.Descriptor instead.
-func (*ResolveCanvasChangeRequestRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DescribeCanvasChangeRequestRequest.ProtoReflect.Descriptor instead.
+func (*DescribeCanvasChangeRequestRequest) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *ResolveCanvasChangeRequestRequest) GetCanvasId() string {
+func (x *DescribeCanvasChangeRequestRequest) GetCanvasId() string {
 	if x != nil {
 		return x.CanvasId
 	}
 	return ""
 }
 
-func (x *ResolveCanvasChangeRequestRequest) GetChangeRequestId() string {
+func (x *DescribeCanvasChangeRequestRequest) GetChangeRequestId() string {
 	if x != nil {
 		return x.ChangeRequestId
 	}
 	return ""
 }
 
-func (x *ResolveCanvasChangeRequestRequest) GetCanvas() *Canvas {
-	if x != nil {
-		return x.Canvas
-	}
-	return nil
-}
-
-func (x *ResolveCanvasChangeRequestRequest) GetAutoLayout() *CanvasAutoLayout {
-	if x != nil {
-		return x.AutoLayout
-	}
-	return nil
-}
-
-type ResolveCanvasChangeRequestResponse struct {
+type DescribeCanvasChangeRequestResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Version       *CanvasVersion         `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
-	ChangeRequest *CanvasChangeRequest   `protobuf:"bytes,2,opt,name=change_request,json=changeRequest,proto3" json:"change_request,omitempty"`
+	ChangeRequest *CanvasChangeRequest   `protobuf:"bytes,1,opt,name=change_request,json=changeRequest,proto3" json:"change_request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResolveCanvasChangeRequestResponse) Reset() {
-	*x = ResolveCanvasChangeRequestResponse{}
+func (x *DescribeCanvasChangeRequestResponse) Reset() {
+	*x = DescribeCanvasChangeRequestResponse{}
 	mi := &file_canvases_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResolveCanvasChangeRequestResponse) String() string {
+func (x *DescribeCanvasChangeRequestResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResolveCanvasChangeRequestResponse) ProtoMessage() {}
+func (*DescribeCanvasChangeRequestResponse) ProtoMessage() {}
 
-func (x *ResolveCanvasChangeRequestResponse) ProtoReflect() protoreflect.Message {
+func (x *DescribeCanvasChangeRequestResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1929,46 +2143,41 @@ func (x *ResolveCanvasChangeRequestResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResolveCanvasChangeRequestResponse.ProtoReflect.Descriptor instead.
-func (*ResolveCanvasChangeRequestResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DescribeCanvasChangeRequestResponse.ProtoReflect.Descriptor instead.
+func (*DescribeCanvasChangeRequestResponse) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *ResolveCanvasChangeRequestResponse) GetVersion() *CanvasVersion {
+func (x *DescribeCanvasChangeRequestResponse) GetChangeRequest() *CanvasChangeRequest {
 	if x != nil {
-		return x.Version
+		return x.ChangeRequest
 	}
 	return nil
 }
 
-func (x *ResolveCanvasChangeRequestResponse) GetChangeRequest() *CanvasChangeRequest {
-	if x != nil {
-		return x.ChangeRequest
-	}
-	return nil
-}
-
-type DeleteCanvasRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ActOnCanvasChangeRequestRequest struct {
+	state           protoimpl.MessageState                 `protogen:"open.v1"`
+	CanvasId        string                                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	ChangeRequestId string                                 `protobuf:"bytes,2,opt,name=change_request_id,json=changeRequestId,proto3" json:"change_request_id,omitempty"`
+	Action          ActOnCanvasChangeRequestRequest_Action `protobuf:"varint,3,opt,name=action,proto3,enum=Superplane.Canvases.ActOnCanvasChangeRequestRequest_Action" json:"action,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *DeleteCanvasRequest) Reset() {
-	*x = DeleteCanvasRequest{}
+func (x *ActOnCanvasChangeRequestRequest) Reset() {
+	*x = ActOnCanvasChangeRequestRequest{}
 	mi := &file_canvases_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCanvasRequest) String() string {
+func (x *ActOnCanvasChangeRequestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCanvasRequest) ProtoMessage() {}
+func (*ActOnCanvasChangeRequestRequest) ProtoMessage() {}
 
-func (x *DeleteCanvasRequest) ProtoReflect() protoreflect.Message {
+func (x *ActOnCanvasChangeRequestRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1980,38 +2189,53 @@ func (x *DeleteCanvasRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCanvasRequest.ProtoReflect.Descriptor instead.
-func (*DeleteCanvasRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ActOnCanvasChangeRequestRequest.ProtoReflect.Descriptor instead.
+func (*ActOnCanvasChangeRequestRequest) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *DeleteCanvasRequest) GetId() string {
+func (x *ActOnCanvasChangeRequestRequest) GetCanvasId() string {
 	if x != nil {
-		return x.Id
+		return x.CanvasId
 	}
 	return ""
 }
 
-type DeleteCanvasResponse struct {
+func (x *ActOnCanvasChangeRequestRequest) GetChangeRequestId() string {
+	if x != nil {
+		return x.ChangeRequestId
+	}
+	return ""
+}
+
+func (x *ActOnCanvasChangeRequestRequest) GetAction() ActOnCanvasChangeRequestRequest_Action {
+	if x != nil {
+		return x.Action
+	}
+	return ActOnCanvasChangeRequestRequest_ACTION_UNSPECIFIED
+}
+
+type ActOnCanvasChangeRequestResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChangeRequest *CanvasChangeRequest   `protobuf:"bytes,1,opt,name=change_request,json=changeRequest,proto3" json:"change_request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteCanvasResponse) Reset() {
-	*x = DeleteCanvasResponse{}
+func (x *ActOnCanvasChangeRequestResponse) Reset() {
+	*x = ActOnCanvasChangeRequestResponse{}
 	mi := &file_canvases_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCanvasResponse) String() string {
+func (x *ActOnCanvasChangeRequestResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCanvasResponse) ProtoMessage() {}
+func (*ActOnCanvasChangeRequestResponse) ProtoMessage() {}
 
-func (x *DeleteCanvasResponse) ProtoReflect() protoreflect.Message {
+func (x *ActOnCanvasChangeRequestResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2023,33 +2247,42 @@ func (x *DeleteCanvasResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCanvasResponse.ProtoReflect.Descriptor instead.
-func (*DeleteCanvasResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ActOnCanvasChangeRequestResponse.ProtoReflect.Descriptor instead.
+func (*ActOnCanvasChangeRequestResponse) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{28}
 }
 
-type UserRef struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ActOnCanvasChangeRequestResponse) GetChangeRequest() *CanvasChangeRequest {
+	if x != nil {
+		return x.ChangeRequest
+	}
+	return nil
 }
 
-func (x *UserRef) Reset() {
-	*x = UserRef{}
+type ResolveCanvasChangeRequestRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId        string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	ChangeRequestId string                 `protobuf:"bytes,2,opt,name=change_request_id,json=changeRequestId,proto3" json:"change_request_id,omitempty"`
+	Canvas          *Canvas                `protobuf:"bytes,3,opt,name=canvas,proto3" json:"canvas,omitempty"`
+	AutoLayout      *CanvasAutoLayout      `protobuf:"bytes,4,opt,name=auto_layout,json=autoLayout,proto3" json:"auto_layout,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ResolveCanvasChangeRequestRequest) Reset() {
+	*x = ResolveCanvasChangeRequestRequest{}
 	mi := &file_canvases_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserRef) String() string {
+func (x *ResolveCanvasChangeRequestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserRef) ProtoMessage() {}
+func (*ResolveCanvasChangeRequestRequest) ProtoMessage() {}
 
-func (x *UserRef) ProtoReflect() protoreflect.Message {
+func (x *ResolveCanvasChangeRequestRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2061,48 +2294,61 @@ func (x *UserRef) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserRef.ProtoReflect.Descriptor instead.
-func (*UserRef) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResolveCanvasChangeRequestRequest.ProtoReflect.Descriptor instead.
+func (*ResolveCanvasChangeRequestRequest) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *UserRef) GetId() string {
+func (x *ResolveCanvasChangeRequestRequest) GetCanvasId() string {
 	if x != nil {
-		return x.Id
+		return x.CanvasId
 	}
 	return ""
 }
 
-func (x *UserRef) GetName() string {
+func (x *ResolveCanvasChangeRequestRequest) GetChangeRequestId() string {
 	if x != nil {
-		return x.Name
+		return x.ChangeRequestId
 	}
 	return ""
 }
 
-type Canvas struct {
+func (x *ResolveCanvasChangeRequestRequest) GetCanvas() *Canvas {
+	if x != nil {
+		return x.Canvas
+	}
+	return nil
+}
+
+func (x *ResolveCanvasChangeRequestRequest) GetAutoLayout() *CanvasAutoLayout {
+	if x != nil {
+		return x.AutoLayout
+	}
+	return nil
+}
+
+type ResolveCanvasChangeRequestResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Metadata      *Canvas_Metadata       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Spec          *Canvas_Spec           `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
-	Status        *Canvas_Status         `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Version       *CanvasVersion         `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	ChangeRequest *CanvasChangeRequest   `protobuf:"bytes,2,opt,name=change_request,json=changeRequest,proto3" json:"change_request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Canvas) Reset() {
-	*x = Canvas{}
+func (x *ResolveCanvasChangeRequestResponse) Reset() {
+	*x = ResolveCanvasChangeRequestResponse{}
 	mi := &file_canvases_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Canvas) String() string {
+func (x *ResolveCanvasChangeRequestResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Canvas) ProtoMessage() {}
+func (*ResolveCanvasChangeRequestResponse) ProtoMessage() {}
 
-func (x *Canvas) ProtoReflect() protoreflect.Message {
+func (x *ResolveCanvasChangeRequestResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2114,54 +2360,46 @@ func (x *Canvas) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Canvas.ProtoReflect.Descriptor instead.
-func (*Canvas) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResolveCanvasChangeRequestResponse.ProtoReflect.Descriptor instead.
+func (*ResolveCanvasChangeRequestResponse) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *Canvas) GetMetadata() *Canvas_Metadata {
-	if x != nil {
-		return x.Metadata
-	}
-	return nil
-}
-
-func (x *Canvas) GetSpec() *Canvas_Spec {
+func (x *ResolveCanvasChangeRequestResponse) GetVersion() *CanvasVersion {
 	if x != nil {
-		return x.Spec
+		return x.Version
 	}
 	return nil
 }
 
-func (x *Canvas) GetStatus() *Canvas_Status {
+func (x *ResolveCanvasChangeRequestResponse) GetChangeRequest() *CanvasChangeRequest {
 	if x != nil {
-		return x.Status
+		return x.ChangeRequest
 	}
 	return nil
 }
 
-type CanvasVersion struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Metadata      *CanvasVersion_Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Spec          *Canvas_Spec            `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+type DeleteCanvasRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CanvasVersion) Reset() {
-	*x = CanvasVersion{}
+func (x *DeleteCanvasRequest) Reset() {
+	*x = DeleteCanvasRequest{}
 	mi := &file_canvases_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CanvasVersion) String() string {
+func (x *DeleteCanvasRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CanvasVersion) ProtoMessage() {}
+func (*DeleteCanvasRequest) ProtoMessage() {}
 
-func (x *CanvasVersion) ProtoReflect() protoreflect.Message {
+func (x *DeleteCanvasRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2173,47 +2411,38 @@ func (x *CanvasVersion) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CanvasVersion.ProtoReflect.Descriptor instead.
-func (*CanvasVersion) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteCanvasRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCanvasRequest) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *CanvasVersion) GetMetadata() *CanvasVersion_Metadata {
-	if x != nil {
-		return x.Metadata
-	}
-	return nil
-}
-
-func (x *CanvasVersion) GetSpec() *Canvas_Spec {
+func (x *DeleteCanvasRequest) GetId() string {
 	if x != nil {
-		return x.Spec
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type CanvasChangeRequestDiff struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	ChangedNodeIds     []string               `protobuf:"bytes,1,rep,name=changed_node_ids,json=changedNodeIds,proto3" json:"changed_node_ids,omitempty"`
-	ConflictingNodeIds []string               `protobuf:"bytes,2,rep,name=conflicting_node_ids,json=conflictingNodeIds,proto3" json:"conflicting_node_ids,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type DeleteCanvasResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CanvasChangeRequestDiff) Reset() {
-	*x = CanvasChangeRequestDiff{}
+func (x *DeleteCanvasResponse) Reset() {
+	*x = DeleteCanvasResponse{}
 	mi := &file_canvases_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CanvasChangeRequestDiff) String() string {
+func (x *DeleteCanvasResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CanvasChangeRequestDiff) ProtoMessage() {}
+func (*DeleteCanvasResponse) ProtoMessage() {}
 
-func (x *CanvasChangeRequestDiff) ProtoReflect() protoreflect.Message {
+func (x *DeleteCanvasResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2225,48 +2454,40 @@ func (x *CanvasChangeRequestDiff) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CanvasChangeRequestDiff.ProtoReflect.Descriptor instead.
-func (*CanvasChangeRequestDiff) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteCanvasResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCanvasResponse) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *CanvasChangeRequestDiff) GetChangedNodeIds() []string {
-	if x != nil {
-		return x.ChangedNodeIds
-	}
-	return nil
-}
-
-func (x *CanvasChangeRequestDiff) GetConflictingNodeIds() []string {
-	if x != nil {
-		return x.ConflictingNodeIds
-	}
-	return nil
-}
-
-type CanvasChangeRequestApprover struct {
-	state         protoimpl.MessageState           `protogen:"open.v1"`
-	Type          CanvasChangeRequestApprover_Type `protobuf:"varint,1,opt,name=type,proto3,enum=Superplane.Canvases.CanvasChangeRequestApprover_Type" json:"type,omitempty"`
-	UserId        string                           `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	RoleName      string                           `protobuf:"bytes,3,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+// A canvas bundle describes a canvas in a way that does not depend on
+// the organization it was exported from. Blueprints used by the canvas are included,
+// and nodes reference them by the IDs in the bundle. Integrations and secrets
+// are referenced by name, and secret values are never included.
+type CanvasBundle struct {
+	state         protoimpl.MessageState               `protogen:"open.v1"`
+	Metadata      *CanvasBundle_Metadata               `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Spec          *Canvas_Spec                         `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Blueprints    []*blueprints.Blueprint              `protobuf:"bytes,3,rep,name=blueprints,proto3" json:"blueprints,omitempty"`
+	Integrations  []*CanvasBundle_IntegrationReference `protobuf:"bytes,4,rep,name=integrations,proto3" json:"integrations,omitempty"`
+	Secrets       []*CanvasBundle_SecretReference      `protobuf:"bytes,5,rep,name=secrets,proto3" json:"secrets,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CanvasChangeRequestApprover) Reset() {
-	*x = CanvasChangeRequestApprover{}
+func (x *CanvasBundle) Reset() {
+	*x = CanvasBundle{}
 	mi := &file_canvases_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CanvasChangeRequestApprover) String() string {
+func (x *CanvasBundle) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CanvasChangeRequestApprover) ProtoMessage() {}
+func (*CanvasBundle) ProtoMessage() {}
 
-func (x *CanvasChangeRequestApprover) ProtoReflect() protoreflect.Message {
+func (x *CanvasBundle) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2278,53 +2499,67 @@ func (x *CanvasChangeRequestApprover) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CanvasChangeRequestApprover.ProtoReflect.Descriptor instead.
-func (*CanvasChangeRequestApprover) Descriptor() ([]byte, []int) {
+// Deprecated: Use CanvasBundle.ProtoReflect.Descriptor instead.
+func (*CanvasBundle) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *CanvasChangeRequestApprover) GetType() CanvasChangeRequestApprover_Type {
+func (x *CanvasBundle) GetMetadata() *CanvasBundle_Metadata {
 	if x != nil {
-		return x.Type
+		return x.Metadata
 	}
-	return CanvasChangeRequestApprover_TYPE_UNSPECIFIED
+	return nil
 }
 
-func (x *CanvasChangeRequestApprover) GetUserId() string {
+func (x *CanvasBundle) GetSpec() *Canvas_Spec {
 	if x != nil {
-		return x.UserId
+		return x.Spec
 	}
-	return ""
+	return nil
 }
 
-func (x *CanvasChangeRequestApprover) GetRoleName() string {
+func (x *CanvasBundle) GetBlueprints() []*blueprints.Blueprint {
 	if x != nil {
-		return x.RoleName
+		return x.Blueprints
 	}
-	return ""
+	return nil
 }
 
-type CanvasChangeRequestApprovalConfig struct {
-	state         protoimpl.MessageState         `protogen:"open.v1"`
-	Items         []*CanvasChangeRequestApprover `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+func (x *CanvasBundle) GetIntegrations() []*CanvasBundle_IntegrationReference {
+	if x != nil {
+		return x.Integrations
+	}
+	return nil
+}
+
+func (x *CanvasBundle) GetSecrets() []*CanvasBundle_SecretReference {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
+type ExportCanvasRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CanvasChangeRequestApprovalConfig) Reset() {
-	*x = CanvasChangeRequestApprovalConfig{}
+func (x *ExportCanvasRequest) Reset() {
+	*x = ExportCanvasRequest{}
 	mi := &file_canvases_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CanvasChangeRequestApprovalConfig) String() string {
+func (x *ExportCanvasRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CanvasChangeRequestApprovalConfig) ProtoMessage() {}
+func (*ExportCanvasRequest) ProtoMessage() {}
 
-func (x *CanvasChangeRequestApprovalConfig) ProtoReflect() protoreflect.Message {
+func (x *ExportCanvasRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2336,43 +2571,39 @@ func (x *CanvasChangeRequestApprovalConfig) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CanvasChangeRequestApprovalConfig.ProtoReflect.Descriptor instead.
-func (*CanvasChangeRequestApprovalConfig) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExportCanvasRequest.ProtoReflect.Descriptor instead.
+func (*ExportCanvasRequest) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *CanvasChangeRequestApprovalConfig) GetItems() []*CanvasChangeRequestApprover {
+func (x *ExportCanvasRequest) GetId() string {
 	if x != nil {
-		return x.Items
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type CanvasChangeRequestApproval struct {
-	state         protoimpl.MessageState            `protogen:"open.v1"`
-	Actor         *UserRef                          `protobuf:"bytes,1,opt,name=actor,proto3" json:"actor,omitempty"`
-	Approver      *CanvasChangeRequestApprover      `protobuf:"bytes,2,opt,name=approver,proto3" json:"approver,omitempty"`
-	State         CanvasChangeRequestApproval_State `protobuf:"varint,3,opt,name=state,proto3,enum=Superplane.Canvases.CanvasChangeRequestApproval_State" json:"state,omitempty"`
-	CreatedAt     *timestamp.Timestamp              `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	InvalidatedAt *timestamp.Timestamp              `protobuf:"bytes,5,opt,name=invalidated_at,json=invalidatedAt,proto3" json:"invalidated_at,omitempty"`
+type ExportCanvasResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bundle        *CanvasBundle          `protobuf:"bytes,1,opt,name=bundle,proto3" json:"bundle,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CanvasChangeRequestApproval) Reset() {
-	*x = CanvasChangeRequestApproval{}
+func (x *ExportCanvasResponse) Reset() {
+	*x = ExportCanvasResponse{}
 	mi := &file_canvases_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CanvasChangeRequestApproval) String() string {
+func (x *ExportCanvasResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CanvasChangeRequestApproval) ProtoMessage() {}
+func (*ExportCanvasResponse) ProtoMessage() {}
 
-func (x *CanvasChangeRequestApproval) ProtoReflect() protoreflect.Message {
+func (x *ExportCanvasResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2384,70 +2615,44 @@ func (x *CanvasChangeRequestApproval) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CanvasChangeRequestApproval.ProtoReflect.Descriptor instead.
-func (*CanvasChangeRequestApproval) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExportCanvasResponse.ProtoReflect.Descriptor instead.
+func (*ExportCanvasResponse) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *CanvasChangeRequestApproval) GetActor() *UserRef {
-	if x != nil {
-		return x.Actor
-	}
-	return nil
-}
-
-func (x *CanvasChangeRequestApproval) GetApprover() *CanvasChangeRequestApprover {
-	if x != nil {
-		return x.Approver
-	}
-	return nil
-}
-
-func (x *CanvasChangeRequestApproval) GetState() CanvasChangeRequestApproval_State {
-	if x != nil {
-		return x.State
-	}
-	return CanvasChangeRequestApproval_STATE_UNSPECIFIED
-}
-
-func (x *CanvasChangeRequestApproval) GetCreatedAt() *timestamp.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
-}
-
-func (x *CanvasChangeRequestApproval) GetInvalidatedAt() *timestamp.Timestamp {
+func (x *ExportCanvasResponse) GetBundle() *CanvasBundle {
 	if x != nil {
-		return x.InvalidatedAt
+		return x.Bundle
 	}
 	return nil
 }
 
-type CanvasChangeRequest struct {
-	state         protoimpl.MessageState         `protogen:"open.v1"`
-	Metadata      *CanvasChangeRequest_Metadata  `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Version       *CanvasVersion                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
-	Diff          *CanvasChangeRequestDiff       `protobuf:"bytes,3,opt,name=diff,proto3" json:"diff,omitempty"`
-	Approvals     []*CanvasChangeRequestApproval `protobuf:"bytes,4,rep,name=approvals,proto3" json:"approvals,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ImportCanvasRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Bundle *CanvasBundle          `protobuf:"bytes,1,opt,name=bundle,proto3" json:"bundle,omitempty"`
+	// Name for the new canvas. Defaults to the name in the bundle.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Integrations of the organization to use in place of the ones in the bundle.
+	// Integrations not mapped are matched by name.
+	IntegrationMappings []*ImportCanvasRequest_IntegrationMapping `protobuf:"bytes,3,rep,name=integration_mappings,json=integrationMappings,proto3" json:"integration_mappings,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *CanvasChangeRequest) Reset() {
-	*x = CanvasChangeRequest{}
+func (x *ImportCanvasRequest) Reset() {
+	*x = ImportCanvasRequest{}
 	mi := &file_canvases_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CanvasChangeRequest) String() string {
+func (x *ImportCanvasRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CanvasChangeRequest) ProtoMessage() {}
+func (*ImportCanvasRequest) ProtoMessage() {}
 
-func (x *CanvasChangeRequest) ProtoReflect() protoreflect.Message {
+func (x *ImportCanvasRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2459,63 +2664,55 @@ func (x *CanvasChangeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CanvasChangeRequest.ProtoReflect.Descriptor instead.
-func (*CanvasChangeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ImportCanvasRequest.ProtoReflect.Descriptor instead.
+func (*ImportCanvasRequest) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *CanvasChangeRequest) GetMetadata() *CanvasChangeRequest_Metadata {
-	if x != nil {
-		return x.Metadata
-	}
-	return nil
-}
-
-func (x *CanvasChangeRequest) GetVersion() *CanvasVersion {
+func (x *ImportCanvasRequest) GetBundle() *CanvasBundle {
 	if x != nil {
-		return x.Version
+		return x.Bundle
 	}
 	return nil
 }
 
-func (x *CanvasChangeRequest) GetDiff() *CanvasChangeRequestDiff {
+func (x *ImportCanvasRequest) GetName() string {
 	if x != nil {
-		return x.Diff
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *CanvasChangeRequest) GetApprovals() []*CanvasChangeRequestApproval {
+func (x *ImportCanvasRequest) GetIntegrationMappings() []*ImportCanvasRequest_IntegrationMapping {
 	if x != nil {
-		return x.Approvals
+		return x.IntegrationMappings
 	}
 	return nil
 }
 
-type ListNodeEventsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	NodeId        string                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	Limit         uint32                 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	Before        *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=before,proto3" json:"before,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ImportCanvasResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Canvas *Canvas                `protobuf:"bytes,1,opt,name=canvas,proto3" json:"canvas,omitempty"`
+	// Secrets referenced by the canvas that do not exist in the organization.
+	MissingSecrets []string `protobuf:"bytes,2,rep,name=missing_secrets,json=missingSecrets,proto3" json:"missing_secrets,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ListNodeEventsRequest) Reset() {
-	*x = ListNodeEventsRequest{}
+func (x *ImportCanvasResponse) Reset() {
+	*x = ImportCanvasResponse{}
 	mi := &file_canvases_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListNodeEventsRequest) String() string {
+func (x *ImportCanvasResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListNodeEventsRequest) ProtoMessage() {}
+func (*ImportCanvasResponse) ProtoMessage() {}
 
-func (x *ListNodeEventsRequest) ProtoReflect() protoreflect.Message {
+func (x *ImportCanvasResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2527,63 +2724,47 @@ func (x *ListNodeEventsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListNodeEventsRequest.ProtoReflect.Descriptor instead.
-func (*ListNodeEventsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ImportCanvasResponse.ProtoReflect.Descriptor instead.
+func (*ImportCanvasResponse) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *ListNodeEventsRequest) GetCanvasId() string {
-	if x != nil {
-		return x.CanvasId
-	}
-	return ""
-}
-
-func (x *ListNodeEventsRequest) GetNodeId() string {
-	if x != nil {
-		return x.NodeId
-	}
-	return ""
-}
-
-func (x *ListNodeEventsRequest) GetLimit() uint32 {
+func (x *ImportCanvasResponse) GetCanvas() *Canvas {
 	if x != nil {
-		return x.Limit
+		return x.Canvas
 	}
-	return 0
+	return nil
 }
 
-func (x *ListNodeEventsRequest) GetBefore() *timestamp.Timestamp {
+func (x *ImportCanvasResponse) GetMissingSecrets() []string {
 	if x != nil {
-		return x.Before
+		return x.MissingSecrets
 	}
 	return nil
 }
 
-type ListNodeEventsResponse struct {
+type UserRef struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Events        []*CanvasEvent         `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
-	TotalCount    uint32                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	HasNextPage   bool                   `protobuf:"varint,3,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
-	LastTimestamp *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=last_timestamp,json=lastTimestamp,proto3" json:"last_timestamp,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListNodeEventsResponse) Reset() {
-	*x = ListNodeEventsResponse{}
+func (x *UserRef) Reset() {
+	*x = UserRef{}
 	mi := &file_canvases_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListNodeEventsResponse) String() string {
+func (x *UserRef) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListNodeEventsResponse) ProtoMessage() {}
+func (*UserRef) ProtoMessage() {}
 
-func (x *ListNodeEventsResponse) ProtoReflect() protoreflect.Message {
+func (x *UserRef) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2595,63 +2776,48 @@ func (x *ListNodeEventsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListNodeEventsResponse.ProtoReflect.Descriptor instead.
-func (*ListNodeEventsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserRef.ProtoReflect.Descriptor instead.
+func (*UserRef) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *ListNodeEventsResponse) GetEvents() []*CanvasEvent {
-	if x != nil {
-		return x.Events
-	}
-	return nil
-}
-
-func (x *ListNodeEventsResponse) GetTotalCount() uint32 {
-	if x != nil {
-		return x.TotalCount
-	}
-	return 0
-}
-
-func (x *ListNodeEventsResponse) GetHasNextPage() bool {
+func (x *UserRef) GetId() string {
 	if x != nil {
-		return x.HasNextPage
+		return x.Id
 	}
-	return false
+	return ""
 }
 
-func (x *ListNodeEventsResponse) GetLastTimestamp() *timestamp.Timestamp {
+func (x *UserRef) GetName() string {
 	if x != nil {
-		return x.LastTimestamp
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type EmitNodeEventRequest struct {
+type Canvas struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	NodeId        string                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	Channel       string                 `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"`
-	Data          *_struct.Struct        `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	Metadata      *Canvas_Metadata       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Spec          *Canvas_Spec           `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Status        *Canvas_Status         `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EmitNodeEventRequest) Reset() {
-	*x = EmitNodeEventRequest{}
+func (x *Canvas) Reset() {
+	*x = Canvas{}
 	mi := &file_canvases_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EmitNodeEventRequest) String() string {
+func (x *Canvas) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EmitNodeEventRequest) ProtoMessage() {}
+func (*Canvas) ProtoMessage() {}
 
-func (x *EmitNodeEventRequest) ProtoReflect() protoreflect.Message {
+func (x *Canvas) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2663,60 +2829,60 @@ func (x *EmitNodeEventRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EmitNodeEventRequest.ProtoReflect.Descriptor instead.
-func (*EmitNodeEventRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Canvas.ProtoReflect.Descriptor instead.
+func (*Canvas) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *EmitNodeEventRequest) GetCanvasId() string {
-	if x != nil {
-		return x.CanvasId
-	}
-	return ""
-}
-
-func (x *EmitNodeEventRequest) GetNodeId() string {
+func (x *Canvas) GetMetadata() *Canvas_Metadata {
 	if x != nil {
-		return x.NodeId
+		return x.Metadata
 	}
-	return ""
+	return nil
 }
 
-func (x *EmitNodeEventRequest) GetChannel() string {
+func (x *Canvas) GetSpec() *Canvas_Spec {
 	if x != nil {
-		return x.Channel
+		return x.Spec
 	}
-	return ""
+	return nil
 }
 
-func (x *EmitNodeEventRequest) GetData() *_struct.Struct {
+func (x *Canvas) GetStatus() *Canvas_Status {
 	if x != nil {
-		return x.Data
+		return x.Status
 	}
 	return nil
 }
 
-type EmitNodeEventResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+// Variables are available in expressions as vars.<name>.
+// Secret-backed variables resolve to a reference to the secret key,
+// to be used in secret fields, and never to the secret value.
+type CanvasVariable struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Name          string                     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                     `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Value         string                     `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Secret        *CanvasVariable_SecretRef  `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"`
+	Overrides     []*CanvasVariable_Override `protobuf:"bytes,5,rep,name=overrides,proto3" json:"overrides,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EmitNodeEventResponse) Reset() {
-	*x = EmitNodeEventResponse{}
+func (x *CanvasVariable) Reset() {
+	*x = CanvasVariable{}
 	mi := &file_canvases_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EmitNodeEventResponse) String() string {
+func (x *CanvasVariable) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EmitNodeEventResponse) ProtoMessage() {}
+func (*CanvasVariable) ProtoMessage() {}
 
-func (x *EmitNodeEventResponse) ProtoReflect() protoreflect.Message {
+func (x *CanvasVariable) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2728,42 +2894,68 @@ func (x *EmitNodeEventResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EmitNodeEventResponse.ProtoReflect.Descriptor instead.
-func (*EmitNodeEventResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CanvasVariable.ProtoReflect.Descriptor instead.
+func (*CanvasVariable) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *EmitNodeEventResponse) GetEventId() string {
+func (x *CanvasVariable) GetName() string {
 	if x != nil {
-		return x.EventId
+		return x.Name
 	}
 	return ""
 }
 
-type ListNodeQueueItemsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	NodeId        string                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	Limit         uint32                 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	Before        *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=before,proto3" json:"before,omitempty"`
+func (x *CanvasVariable) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CanvasVariable) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *CanvasVariable) GetSecret() *CanvasVariable_SecretRef {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+func (x *CanvasVariable) GetOverrides() []*CanvasVariable_Override {
+	if x != nil {
+		return x.Overrides
+	}
+	return nil
+}
+
+type CanvasVersion struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Metadata      *CanvasVersion_Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Spec          *Canvas_Spec            `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListNodeQueueItemsRequest) Reset() {
-	*x = ListNodeQueueItemsRequest{}
+func (x *CanvasVersion) Reset() {
+	*x = CanvasVersion{}
 	mi := &file_canvases_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListNodeQueueItemsRequest) String() string {
+func (x *CanvasVersion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListNodeQueueItemsRequest) ProtoMessage() {}
+func (*CanvasVersion) ProtoMessage() {}
 
-func (x *ListNodeQueueItemsRequest) ProtoReflect() protoreflect.Message {
+func (x *CanvasVersion) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2775,63 +2967,50 @@ func (x *ListNodeQueueItemsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListNodeQueueItemsRequest.ProtoReflect.Descriptor instead.
-func (*ListNodeQueueItemsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CanvasVersion.ProtoReflect.Descriptor instead.
+func (*CanvasVersion) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *ListNodeQueueItemsRequest) GetCanvasId() string {
-	if x != nil {
-		return x.CanvasId
-	}
-	return ""
-}
-
-func (x *ListNodeQueueItemsRequest) GetNodeId() string {
-	if x != nil {
-		return x.NodeId
-	}
-	return ""
-}
-
-func (x *ListNodeQueueItemsRequest) GetLimit() uint32 {
+func (x *CanvasVersion) GetMetadata() *CanvasVersion_Metadata {
 	if x != nil {
-		return x.Limit
+		return x.Metadata
 	}
-	return 0
+	return nil
 }
 
-func (x *ListNodeQueueItemsRequest) GetBefore() *timestamp.Timestamp {
+func (x *CanvasVersion) GetSpec() *Canvas_Spec {
 	if x != nil {
-		return x.Before
+		return x.Spec
 	}
 	return nil
 }
 
-type ListNodeQueueItemsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*CanvasNodeQueueItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	TotalCount    uint32                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	HasNextPage   bool                   `protobuf:"varint,3,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
-	LastTimestamp *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=last_timestamp,json=lastTimestamp,proto3" json:"last_timestamp,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type CanvasVersionDiff struct {
+	state           protoimpl.MessageState              `protogen:"open.v1"`
+	BaseVersionId   string                              `protobuf:"bytes,1,opt,name=base_version_id,json=baseVersionId,proto3" json:"base_version_id,omitempty"`
+	TargetVersionId string                              `protobuf:"bytes,2,opt,name=target_version_id,json=targetVersionId,proto3" json:"target_version_id,omitempty"`
+	Nodes           []*CanvasVersionDiff_NodeChange     `protobuf:"bytes,3,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Edges           []*CanvasVersionDiff_EdgeChange     `protobuf:"bytes,4,rep,name=edges,proto3" json:"edges,omitempty"`
+	Variables       []*CanvasVersionDiff_VariableChange `protobuf:"bytes,5,rep,name=variables,proto3" json:"variables,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *ListNodeQueueItemsResponse) Reset() {
-	*x = ListNodeQueueItemsResponse{}
+func (x *CanvasVersionDiff) Reset() {
+	*x = CanvasVersionDiff{}
 	mi := &file_canvases_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListNodeQueueItemsResponse) String() string {
+func (x *CanvasVersionDiff) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListNodeQueueItemsResponse) ProtoMessage() {}
+func (*CanvasVersionDiff) ProtoMessage() {}
 
-func (x *ListNodeQueueItemsResponse) ProtoReflect() protoreflect.Message {
+func (x *CanvasVersionDiff) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2843,62 +3022,68 @@ func (x *ListNodeQueueItemsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListNodeQueueItemsResponse.ProtoReflect.Descriptor instead.
-func (*ListNodeQueueItemsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CanvasVersionDiff.ProtoReflect.Descriptor instead.
+func (*CanvasVersionDiff) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *ListNodeQueueItemsResponse) GetItems() []*CanvasNodeQueueItem {
+func (x *CanvasVersionDiff) GetBaseVersionId() string {
 	if x != nil {
-		return x.Items
+		return x.BaseVersionId
 	}
-	return nil
+	return ""
 }
 
-func (x *ListNodeQueueItemsResponse) GetTotalCount() uint32 {
+func (x *CanvasVersionDiff) GetTargetVersionId() string {
 	if x != nil {
-		return x.TotalCount
+		return x.TargetVersionId
 	}
-	return 0
+	return ""
 }
 
-func (x *ListNodeQueueItemsResponse) GetHasNextPage() bool {
+func (x *CanvasVersionDiff) GetNodes() []*CanvasVersionDiff_NodeChange {
 	if x != nil {
-		return x.HasNextPage
+		return x.Nodes
 	}
-	return false
+	return nil
 }
 
-func (x *ListNodeQueueItemsResponse) GetLastTimestamp() *timestamp.Timestamp {
+func (x *CanvasVersionDiff) GetEdges() []*CanvasVersionDiff_EdgeChange {
 	if x != nil {
-		return x.LastTimestamp
+		return x.Edges
 	}
 	return nil
 }
 
-type DeleteNodeQueueItemRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	NodeId        string                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	ItemId        string                 `protobuf:"bytes,3,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CanvasVersionDiff) GetVariables() []*CanvasVersionDiff_VariableChange {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
 }
 
-func (x *DeleteNodeQueueItemRequest) Reset() {
-	*x = DeleteNodeQueueItemRequest{}
+type CanvasChangeRequestDiff struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ChangedNodeIds     []string               `protobuf:"bytes,1,rep,name=changed_node_ids,json=changedNodeIds,proto3" json:"changed_node_ids,omitempty"`
+	ConflictingNodeIds []string               `protobuf:"bytes,2,rep,name=conflicting_node_ids,json=conflictingNodeIds,proto3" json:"conflicting_node_ids,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CanvasChangeRequestDiff) Reset() {
+	*x = CanvasChangeRequestDiff{}
 	mi := &file_canvases_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteNodeQueueItemRequest) String() string {
+func (x *CanvasChangeRequestDiff) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteNodeQueueItemRequest) ProtoMessage() {}
+func (*CanvasChangeRequestDiff) ProtoMessage() {}
 
-func (x *DeleteNodeQueueItemRequest) ProtoReflect() protoreflect.Message {
+func (x *CanvasChangeRequestDiff) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2910,52 +3095,48 @@ func (x *DeleteNodeQueueItemRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteNodeQueueItemRequest.ProtoReflect.Descriptor instead.
-func (*DeleteNodeQueueItemRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CanvasChangeRequestDiff.ProtoReflect.Descriptor instead.
+func (*CanvasChangeRequestDiff) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *DeleteNodeQueueItemRequest) GetCanvasId() string {
-	if x != nil {
-		return x.CanvasId
-	}
-	return ""
-}
-
-func (x *DeleteNodeQueueItemRequest) GetNodeId() string {
+func (x *CanvasChangeRequestDiff) GetChangedNodeIds() []string {
 	if x != nil {
-		return x.NodeId
+		return x.ChangedNodeIds
 	}
-	return ""
+	return nil
 }
 
-func (x *DeleteNodeQueueItemRequest) GetItemId() string {
+func (x *CanvasChangeRequestDiff) GetConflictingNodeIds() []string {
 	if x != nil {
-		return x.ItemId
+		return x.ConflictingNodeIds
 	}
-	return ""
+	return nil
 }
 
-type DeleteNodeQueueItemResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type CanvasChangeRequestApprover struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	Type          CanvasChangeRequestApprover_Type `protobuf:"varint,1,opt,name=type,proto3,enum=Superplane.Canvases.CanvasChangeRequestApprover_Type" json:"type,omitempty"`
+	UserId        string                           `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RoleName      string                           `protobuf:"bytes,3,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteNodeQueueItemResponse) Reset() {
-	*x = DeleteNodeQueueItemResponse{}
+func (x *CanvasChangeRequestApprover) Reset() {
+	*x = CanvasChangeRequestApprover{}
 	mi := &file_canvases_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteNodeQueueItemResponse) String() string {
+func (x *CanvasChangeRequestApprover) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteNodeQueueItemResponse) ProtoMessage() {}
+func (*CanvasChangeRequestApprover) ProtoMessage() {}
 
-func (x *DeleteNodeQueueItemResponse) ProtoReflect() protoreflect.Message {
+func (x *CanvasChangeRequestApprover) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2967,34 +3148,53 @@ func (x *DeleteNodeQueueItemResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteNodeQueueItemResponse.ProtoReflect.Descriptor instead.
-func (*DeleteNodeQueueItemResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CanvasChangeRequestApprover.ProtoReflect.Descriptor instead.
+func (*CanvasChangeRequestApprover) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{44}
 }
 
-type UpdateNodePauseRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	NodeId        string                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	Paused        bool                   `protobuf:"varint,3,opt,name=paused,proto3" json:"paused,omitempty"`
+func (x *CanvasChangeRequestApprover) GetType() CanvasChangeRequestApprover_Type {
+	if x != nil {
+		return x.Type
+	}
+	return CanvasChangeRequestApprover_TYPE_UNSPECIFIED
+}
+
+func (x *CanvasChangeRequestApprover) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CanvasChangeRequestApprover) GetRoleName() string {
+	if x != nil {
+		return x.RoleName
+	}
+	return ""
+}
+
+type CanvasChangeRequestApprovalConfig struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Items         []*CanvasChangeRequestApprover `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateNodePauseRequest) Reset() {
-	*x = UpdateNodePauseRequest{}
+func (x *CanvasChangeRequestApprovalConfig) Reset() {
+	*x = CanvasChangeRequestApprovalConfig{}
 	mi := &file_canvases_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateNodePauseRequest) String() string {
+func (x *CanvasChangeRequestApprovalConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateNodePauseRequest) ProtoMessage() {}
+func (*CanvasChangeRequestApprovalConfig) ProtoMessage() {}
 
-func (x *UpdateNodePauseRequest) ProtoReflect() protoreflect.Message {
+func (x *CanvasChangeRequestApprovalConfig) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3006,53 +3206,46 @@ func (x *UpdateNodePauseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateNodePauseRequest.ProtoReflect.Descriptor instead.
-func (*UpdateNodePauseRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CanvasChangeRequestApprovalConfig.ProtoReflect.Descriptor instead.
+func (*CanvasChangeRequestApprovalConfig) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *UpdateNodePauseRequest) GetCanvasId() string {
-	if x != nil {
-		return x.CanvasId
-	}
-	return ""
-}
-
-func (x *UpdateNodePauseRequest) GetNodeId() string {
-	if x != nil {
-		return x.NodeId
-	}
-	return ""
-}
-
-func (x *UpdateNodePauseRequest) GetPaused() bool {
+func (x *CanvasChangeRequestApprovalConfig) GetItems() []*CanvasChangeRequestApprover {
 	if x != nil {
-		return x.Paused
+		return x.Items
 	}
-	return false
+	return nil
 }
 
-type UpdateNodePauseResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Node          *components.Node       `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Configures the optional checks run on change requests.
+// Configuration and expression checks always run.
+type CanvasChangeRequestCheckConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Components and triggers that cannot be added to the canvas.
+	// Patterns like "github.*" are supported.
+	ForbiddenComponents []string `protobuf:"bytes,1,rep,name=forbidden_components,json=forbiddenComponents,proto3" json:"forbidden_components,omitempty"`
+	// Simulates the flow of events from the triggers of the canvas,
+	// failing on nodes that cannot be reached and on unknown output channels.
+	SimulationEnabled bool `protobuf:"varint,2,opt,name=simulation_enabled,json=simulationEnabled,proto3" json:"simulation_enabled,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *UpdateNodePauseResponse) Reset() {
-	*x = UpdateNodePauseResponse{}
+func (x *CanvasChangeRequestCheckConfig) Reset() {
+	*x = CanvasChangeRequestCheckConfig{}
 	mi := &file_canvases_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateNodePauseResponse) String() string {
+func (x *CanvasChangeRequestCheckConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateNodePauseResponse) ProtoMessage() {}
+func (*CanvasChangeRequestCheckConfig) ProtoMessage() {}
 
-func (x *UpdateNodePauseResponse) ProtoReflect() protoreflect.Message {
+func (x *CanvasChangeRequestCheckConfig) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3064,44 +3257,52 @@ func (x *UpdateNodePauseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateNodePauseResponse.ProtoReflect.Descriptor instead.
-func (*UpdateNodePauseResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CanvasChangeRequestCheckConfig.ProtoReflect.Descriptor instead.
+func (*CanvasChangeRequestCheckConfig) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *UpdateNodePauseResponse) GetNode() *components.Node {
+func (x *CanvasChangeRequestCheckConfig) GetForbiddenComponents() []string {
 	if x != nil {
-		return x.Node
+		return x.ForbiddenComponents
 	}
 	return nil
 }
 
-type ListNodeExecutionsRequest struct {
-	state         protoimpl.MessageState       `protogen:"open.v1"`
-	CanvasId      string                       `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	NodeId        string                       `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	States        []CanvasNodeExecution_State  `protobuf:"varint,3,rep,packed,name=states,proto3,enum=Superplane.Canvases.CanvasNodeExecution_State" json:"states,omitempty"`
-	Results       []CanvasNodeExecution_Result `protobuf:"varint,4,rep,packed,name=results,proto3,enum=Superplane.Canvases.CanvasNodeExecution_Result" json:"results,omitempty"`
-	Limit         uint32                       `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
-	Before        *timestamp.Timestamp         `protobuf:"bytes,6,opt,name=before,proto3" json:"before,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
+func (x *CanvasChangeRequestCheckConfig) GetSimulationEnabled() bool {
+	if x != nil {
+		return x.SimulationEnabled
+	}
+	return false
+}
 
-func (x *ListNodeExecutionsRequest) Reset() {
-	*x = ListNodeExecutionsRequest{}
+// Restricts who can invoke actions, cancel executions and emit events on a node.
+// Users need to be listed directly, belong to one of the groups
+// or have one of the organization roles.
+type CanvasNodeActionPermissions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	UserIds       []string               `protobuf:"bytes,2,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	Groups        []string               `protobuf:"bytes,3,rep,name=groups,proto3" json:"groups,omitempty"`
+	Roles         []string               `protobuf:"bytes,4,rep,name=roles,proto3" json:"roles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasNodeActionPermissions) Reset() {
+	*x = CanvasNodeActionPermissions{}
 	mi := &file_canvases_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListNodeExecutionsRequest) String() string {
+func (x *CanvasNodeActionPermissions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListNodeExecutionsRequest) ProtoMessage() {}
+func (*CanvasNodeActionPermissions) ProtoMessage() {}
 
-func (x *ListNodeExecutionsRequest) ProtoReflect() protoreflect.Message {
+func (x *CanvasNodeActionPermissions) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3113,77 +3314,62 @@ func (x *ListNodeExecutionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListNodeExecutionsRequest.ProtoReflect.Descriptor instead.
-func (*ListNodeExecutionsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CanvasNodeActionPermissions.ProtoReflect.Descriptor instead.
+func (*CanvasNodeActionPermissions) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *ListNodeExecutionsRequest) GetCanvasId() string {
-	if x != nil {
-		return x.CanvasId
-	}
-	return ""
-}
-
-func (x *ListNodeExecutionsRequest) GetNodeId() string {
+func (x *CanvasNodeActionPermissions) GetNodeId() string {
 	if x != nil {
 		return x.NodeId
 	}
 	return ""
 }
 
-func (x *ListNodeExecutionsRequest) GetStates() []CanvasNodeExecution_State {
+func (x *CanvasNodeActionPermissions) GetUserIds() []string {
 	if x != nil {
-		return x.States
+		return x.UserIds
 	}
 	return nil
 }
 
-func (x *ListNodeExecutionsRequest) GetResults() []CanvasNodeExecution_Result {
+func (x *CanvasNodeActionPermissions) GetGroups() []string {
 	if x != nil {
-		return x.Results
+		return x.Groups
 	}
 	return nil
 }
 
-func (x *ListNodeExecutionsRequest) GetLimit() uint32 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
-}
-
-func (x *ListNodeExecutionsRequest) GetBefore() *timestamp.Timestamp {
+func (x *CanvasNodeActionPermissions) GetRoles() []string {
 	if x != nil {
-		return x.Before
+		return x.Roles
 	}
 	return nil
 }
 
-type ListNodeExecutionsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Executions    []*CanvasNodeExecution `protobuf:"bytes,1,rep,name=executions,proto3" json:"executions,omitempty"`
-	TotalCount    uint32                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	HasNextPage   bool                   `protobuf:"varint,3,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
-	LastTimestamp *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=last_timestamp,json=lastTimestamp,proto3" json:"last_timestamp,omitempty"`
+// Nodes without permissions are open to everyone
+// with permission to run actions on the canvas.
+type CanvasNodeActionPermissionsConfig struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Items         []*CanvasNodeActionPermissions `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListNodeExecutionsResponse) Reset() {
-	*x = ListNodeExecutionsResponse{}
+func (x *CanvasNodeActionPermissionsConfig) Reset() {
+	*x = CanvasNodeActionPermissionsConfig{}
 	mi := &file_canvases_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListNodeExecutionsResponse) String() string {
+func (x *CanvasNodeActionPermissionsConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListNodeExecutionsResponse) ProtoMessage() {}
+func (*CanvasNodeActionPermissionsConfig) ProtoMessage() {}
 
-func (x *ListNodeExecutionsResponse) ProtoReflect() protoreflect.Message {
+func (x *CanvasNodeActionPermissionsConfig) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3195,61 +3381,44 @@ func (x *ListNodeExecutionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListNodeExecutionsResponse.ProtoReflect.Descriptor instead.
-func (*ListNodeExecutionsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CanvasNodeActionPermissionsConfig.ProtoReflect.Descriptor instead.
+func (*CanvasNodeActionPermissionsConfig) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *ListNodeExecutionsResponse) GetExecutions() []*CanvasNodeExecution {
-	if x != nil {
-		return x.Executions
-	}
-	return nil
-}
-
-func (x *ListNodeExecutionsResponse) GetTotalCount() uint32 {
-	if x != nil {
-		return x.TotalCount
-	}
-	return 0
-}
-
-func (x *ListNodeExecutionsResponse) GetHasNextPage() bool {
-	if x != nil {
-		return x.HasNextPage
-	}
-	return false
-}
-
-func (x *ListNodeExecutionsResponse) GetLastTimestamp() *timestamp.Timestamp {
+func (x *CanvasNodeActionPermissionsConfig) GetItems() []*CanvasNodeActionPermissions {
 	if x != nil {
-		return x.LastTimestamp
+		return x.Items
 	}
 	return nil
 }
 
-type ListChildExecutionsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	ExecutionId   string                 `protobuf:"bytes,2,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+// Result of an automated check run on a change request.
+// Change requests can only be published when all checks passed.
+type CanvasChangeRequestCheck struct {
+	state         protoimpl.MessageState              `protogen:"open.v1"`
+	Name          string                              `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Status        CanvasChangeRequestCheck_Status     `protobuf:"varint,2,opt,name=status,proto3,enum=Superplane.Canvases.CanvasChangeRequestCheck_Status" json:"status,omitempty"`
+	Problems      []*CanvasChangeRequestCheck_Problem `protobuf:"bytes,3,rep,name=problems,proto3" json:"problems,omitempty"`
+	CheckedAt     *timestamp.Timestamp                `protobuf:"bytes,4,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListChildExecutionsRequest) Reset() {
-	*x = ListChildExecutionsRequest{}
+func (x *CanvasChangeRequestCheck) Reset() {
+	*x = CanvasChangeRequestCheck{}
 	mi := &file_canvases_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListChildExecutionsRequest) String() string {
+func (x *CanvasChangeRequestCheck) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListChildExecutionsRequest) ProtoMessage() {}
+func (*CanvasChangeRequestCheck) ProtoMessage() {}
 
-func (x *ListChildExecutionsRequest) ProtoReflect() protoreflect.Message {
+func (x *CanvasChangeRequestCheck) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3261,46 +3430,64 @@ func (x *ListChildExecutionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListChildExecutionsRequest.ProtoReflect.Descriptor instead.
-func (*ListChildExecutionsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CanvasChangeRequestCheck.ProtoReflect.Descriptor instead.
+func (*CanvasChangeRequestCheck) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *ListChildExecutionsRequest) GetCanvasId() string {
+func (x *CanvasChangeRequestCheck) GetName() string {
 	if x != nil {
-		return x.CanvasId
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ListChildExecutionsRequest) GetExecutionId() string {
+func (x *CanvasChangeRequestCheck) GetStatus() CanvasChangeRequestCheck_Status {
 	if x != nil {
-		return x.ExecutionId
+		return x.Status
 	}
-	return ""
+	return CanvasChangeRequestCheck_STATUS_UNSPECIFIED
 }
 
-type ListChildExecutionsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Executions    []*CanvasNodeExecution `protobuf:"bytes,1,rep,name=executions,proto3" json:"executions,omitempty"`
+func (x *CanvasChangeRequestCheck) GetProblems() []*CanvasChangeRequestCheck_Problem {
+	if x != nil {
+		return x.Problems
+	}
+	return nil
+}
+
+func (x *CanvasChangeRequestCheck) GetCheckedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return nil
+}
+
+type CanvasChangeRequestApproval struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Actor         *UserRef                          `protobuf:"bytes,1,opt,name=actor,proto3" json:"actor,omitempty"`
+	Approver      *CanvasChangeRequestApprover      `protobuf:"bytes,2,opt,name=approver,proto3" json:"approver,omitempty"`
+	State         CanvasChangeRequestApproval_State `protobuf:"varint,3,opt,name=state,proto3,enum=Superplane.Canvases.CanvasChangeRequestApproval_State" json:"state,omitempty"`
+	CreatedAt     *timestamp.Timestamp              `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	InvalidatedAt *timestamp.Timestamp              `protobuf:"bytes,5,opt,name=invalidated_at,json=invalidatedAt,proto3" json:"invalidated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListChildExecutionsResponse) Reset() {
-	*x = ListChildExecutionsResponse{}
+func (x *CanvasChangeRequestApproval) Reset() {
+	*x = CanvasChangeRequestApproval{}
 	mi := &file_canvases_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListChildExecutionsResponse) String() string {
+func (x *CanvasChangeRequestApproval) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListChildExecutionsResponse) ProtoMessage() {}
+func (*CanvasChangeRequestApproval) ProtoMessage() {}
 
-func (x *ListChildExecutionsResponse) ProtoReflect() protoreflect.Message {
+func (x *CanvasChangeRequestApproval) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3312,56 +3499,71 @@ func (x *ListChildExecutionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListChildExecutionsResponse.ProtoReflect.Descriptor instead.
-func (*ListChildExecutionsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CanvasChangeRequestApproval.ProtoReflect.Descriptor instead.
+func (*CanvasChangeRequestApproval) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *ListChildExecutionsResponse) GetExecutions() []*CanvasNodeExecution {
+func (x *CanvasChangeRequestApproval) GetActor() *UserRef {
 	if x != nil {
-		return x.Executions
+		return x.Actor
 	}
 	return nil
 }
 
-type CanvasNodeExecution struct {
-	state               protoimpl.MessageState           `protogen:"open.v1"`
-	Id                  string                           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CanvasId            string                           `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	NodeId              string                           `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	ParentExecutionId   string                           `protobuf:"bytes,4,opt,name=parent_execution_id,json=parentExecutionId,proto3" json:"parent_execution_id,omitempty"`
-	PreviousExecutionId string                           `protobuf:"bytes,5,opt,name=previous_execution_id,json=previousExecutionId,proto3" json:"previous_execution_id,omitempty"`
-	State               CanvasNodeExecution_State        `protobuf:"varint,6,opt,name=state,proto3,enum=Superplane.Canvases.CanvasNodeExecution_State" json:"state,omitempty"`
-	Result              CanvasNodeExecution_Result       `protobuf:"varint,7,opt,name=result,proto3,enum=Superplane.Canvases.CanvasNodeExecution_Result" json:"result,omitempty"`
-	ResultReason        CanvasNodeExecution_ResultReason `protobuf:"varint,8,opt,name=result_reason,json=resultReason,proto3,enum=Superplane.Canvases.CanvasNodeExecution_ResultReason" json:"result_reason,omitempty"`
-	ResultMessage       string                           `protobuf:"bytes,9,opt,name=result_message,json=resultMessage,proto3" json:"result_message,omitempty"`
-	Input               *_struct.Struct                  `protobuf:"bytes,10,opt,name=input,proto3" json:"input,omitempty"`
-	Outputs             *_struct.Struct                  `protobuf:"bytes,11,opt,name=outputs,proto3" json:"outputs,omitempty"`
-	CreatedAt           *timestamp.Timestamp             `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt           *timestamp.Timestamp             `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	Metadata            *_struct.Struct                  `protobuf:"bytes,14,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Configuration       *_struct.Struct                  `protobuf:"bytes,15,opt,name=configuration,proto3" json:"configuration,omitempty"`
-	ChildExecutions     []*CanvasNodeExecution           `protobuf:"bytes,16,rep,name=child_executions,json=childExecutions,proto3" json:"child_executions,omitempty"`
-	RootEvent           *CanvasEvent                     `protobuf:"bytes,17,opt,name=root_event,json=rootEvent,proto3" json:"root_event,omitempty"`
-	CancelledBy         *UserRef                         `protobuf:"bytes,18,opt,name=cancelled_by,json=cancelledBy,proto3" json:"cancelled_by,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+func (x *CanvasChangeRequestApproval) GetApprover() *CanvasChangeRequestApprover {
+	if x != nil {
+		return x.Approver
+	}
+	return nil
 }
 
-func (x *CanvasNodeExecution) Reset() {
-	*x = CanvasNodeExecution{}
+func (x *CanvasChangeRequestApproval) GetState() CanvasChangeRequestApproval_State {
+	if x != nil {
+		return x.State
+	}
+	return CanvasChangeRequestApproval_STATE_UNSPECIFIED
+}
+
+func (x *CanvasChangeRequestApproval) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CanvasChangeRequestApproval) GetInvalidatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.InvalidatedAt
+	}
+	return nil
+}
+
+type CanvasChangeRequest struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Metadata      *CanvasChangeRequest_Metadata  `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Version       *CanvasVersion                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Diff          *CanvasChangeRequestDiff       `protobuf:"bytes,3,opt,name=diff,proto3" json:"diff,omitempty"`
+	Approvals     []*CanvasChangeRequestApproval `protobuf:"bytes,4,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	Checks        []*CanvasChangeRequestCheck    `protobuf:"bytes,5,rep,name=checks,proto3" json:"checks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasChangeRequest) Reset() {
+	*x = CanvasChangeRequest{}
 	mi := &file_canvases_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CanvasNodeExecution) String() string {
+func (x *CanvasChangeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CanvasNodeExecution) ProtoMessage() {}
+func (*CanvasChangeRequest) ProtoMessage() {}
 
-func (x *CanvasNodeExecution) ProtoReflect() protoreflect.Message {
+func (x *CanvasChangeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3373,164 +3575,139 @@ func (x *CanvasNodeExecution) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CanvasNodeExecution.ProtoReflect.Descriptor instead.
-func (*CanvasNodeExecution) Descriptor() ([]byte, []int) {
+// Deprecated: Use CanvasChangeRequest.ProtoReflect.Descriptor instead.
+func (*CanvasChangeRequest) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *CanvasNodeExecution) GetId() string {
+func (x *CanvasChangeRequest) GetMetadata() *CanvasChangeRequest_Metadata {
 	if x != nil {
-		return x.Id
+		return x.Metadata
 	}
-	return ""
+	return nil
 }
 
-func (x *CanvasNodeExecution) GetCanvasId() string {
+func (x *CanvasChangeRequest) GetVersion() *CanvasVersion {
 	if x != nil {
-		return x.CanvasId
+		return x.Version
 	}
-	return ""
+	return nil
 }
 
-func (x *CanvasNodeExecution) GetNodeId() string {
+func (x *CanvasChangeRequest) GetDiff() *CanvasChangeRequestDiff {
 	if x != nil {
-		return x.NodeId
+		return x.Diff
 	}
-	return ""
+	return nil
 }
 
-func (x *CanvasNodeExecution) GetParentExecutionId() string {
+func (x *CanvasChangeRequest) GetApprovals() []*CanvasChangeRequestApproval {
 	if x != nil {
-		return x.ParentExecutionId
+		return x.Approvals
 	}
-	return ""
+	return nil
 }
 
-func (x *CanvasNodeExecution) GetPreviousExecutionId() string {
+func (x *CanvasChangeRequest) GetChecks() []*CanvasChangeRequestCheck {
 	if x != nil {
-		return x.PreviousExecutionId
+		return x.Checks
 	}
-	return ""
+	return nil
 }
 
-func (x *CanvasNodeExecution) GetState() CanvasNodeExecution_State {
-	if x != nil {
-		return x.State
-	}
-	return CanvasNodeExecution_STATE_UNKNOWN
+type ListNodeEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Limit         uint32                 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Before        *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=before,proto3" json:"before,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CanvasNodeExecution) GetResult() CanvasNodeExecution_Result {
-	if x != nil {
-		return x.Result
-	}
-	return CanvasNodeExecution_RESULT_UNKNOWN
+func (x *ListNodeEventsRequest) Reset() {
+	*x = ListNodeEventsRequest{}
+	mi := &file_canvases_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CanvasNodeExecution) GetResultReason() CanvasNodeExecution_ResultReason {
-	if x != nil {
-		return x.ResultReason
-	}
-	return CanvasNodeExecution_RESULT_REASON_OK
-}
-
-func (x *CanvasNodeExecution) GetResultMessage() string {
-	if x != nil {
-		return x.ResultMessage
-	}
-	return ""
-}
-
-func (x *CanvasNodeExecution) GetInput() *_struct.Struct {
-	if x != nil {
-		return x.Input
-	}
-	return nil
-}
-
-func (x *CanvasNodeExecution) GetOutputs() *_struct.Struct {
-	if x != nil {
-		return x.Outputs
-	}
-	return nil
+func (x *ListNodeEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *CanvasNodeExecution) GetCreatedAt() *timestamp.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
-}
+func (*ListNodeEventsRequest) ProtoMessage() {}
 
-func (x *CanvasNodeExecution) GetUpdatedAt() *timestamp.Timestamp {
+func (x *ListNodeEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[52]
 	if x != nil {
-		return x.UpdatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *CanvasNodeExecution) GetMetadata() *_struct.Struct {
-	if x != nil {
-		return x.Metadata
-	}
-	return nil
+// Deprecated: Use ListNodeEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListNodeEventsRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *CanvasNodeExecution) GetConfiguration() *_struct.Struct {
+func (x *ListNodeEventsRequest) GetCanvasId() string {
 	if x != nil {
-		return x.Configuration
+		return x.CanvasId
 	}
-	return nil
+	return ""
 }
 
-func (x *CanvasNodeExecution) GetChildExecutions() []*CanvasNodeExecution {
+func (x *ListNodeEventsRequest) GetNodeId() string {
 	if x != nil {
-		return x.ChildExecutions
+		return x.NodeId
 	}
-	return nil
+	return ""
 }
 
-func (x *CanvasNodeExecution) GetRootEvent() *CanvasEvent {
+func (x *ListNodeEventsRequest) GetLimit() uint32 {
 	if x != nil {
-		return x.RootEvent
+		return x.Limit
 	}
-	return nil
+	return 0
 }
 
-func (x *CanvasNodeExecution) GetCancelledBy() *UserRef {
+func (x *ListNodeEventsRequest) GetBefore() *timestamp.Timestamp {
 	if x != nil {
-		return x.CancelledBy
+		return x.Before
 	}
 	return nil
 }
 
-type CanvasNodeQueueItem struct {
+type ListNodeEventsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CanvasId      string                 `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	NodeId        string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	Input         *_struct.Struct        `protobuf:"bytes,4,opt,name=input,proto3" json:"input,omitempty"`
-	RootEvent     *CanvasEvent           `protobuf:"bytes,5,opt,name=root_event,json=rootEvent,proto3" json:"root_event,omitempty"`
-	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Events        []*CanvasEvent         `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	TotalCount    uint32                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	HasNextPage   bool                   `protobuf:"varint,3,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
+	LastTimestamp *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=last_timestamp,json=lastTimestamp,proto3" json:"last_timestamp,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CanvasNodeQueueItem) Reset() {
-	*x = CanvasNodeQueueItem{}
-	mi := &file_canvases_proto_msgTypes[52]
+func (x *ListNodeEventsResponse) Reset() {
+	*x = ListNodeEventsResponse{}
+	mi := &file_canvases_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CanvasNodeQueueItem) String() string {
+func (x *ListNodeEventsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CanvasNodeQueueItem) ProtoMessage() {}
+func (*ListNodeEventsResponse) ProtoMessage() {}
 
-func (x *CanvasNodeQueueItem) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[52]
+func (x *ListNodeEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3541,78 +3718,64 @@ func (x *CanvasNodeQueueItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CanvasNodeQueueItem.ProtoReflect.Descriptor instead.
-func (*CanvasNodeQueueItem) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{52}
-}
-
-func (x *CanvasNodeQueueItem) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *CanvasNodeQueueItem) GetCanvasId() string {
-	if x != nil {
-		return x.CanvasId
-	}
-	return ""
+// Deprecated: Use ListNodeEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListNodeEventsResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *CanvasNodeQueueItem) GetNodeId() string {
+func (x *ListNodeEventsResponse) GetEvents() []*CanvasEvent {
 	if x != nil {
-		return x.NodeId
+		return x.Events
 	}
-	return ""
+	return nil
 }
 
-func (x *CanvasNodeQueueItem) GetInput() *_struct.Struct {
+func (x *ListNodeEventsResponse) GetTotalCount() uint32 {
 	if x != nil {
-		return x.Input
+		return x.TotalCount
 	}
-	return nil
+	return 0
 }
 
-func (x *CanvasNodeQueueItem) GetRootEvent() *CanvasEvent {
+func (x *ListNodeEventsResponse) GetHasNextPage() bool {
 	if x != nil {
-		return x.RootEvent
+		return x.HasNextPage
 	}
-	return nil
+	return false
 }
 
-func (x *CanvasNodeQueueItem) GetCreatedAt() *timestamp.Timestamp {
+func (x *ListNodeEventsResponse) GetLastTimestamp() *timestamp.Timestamp {
 	if x != nil {
-		return x.CreatedAt
+		return x.LastTimestamp
 	}
 	return nil
 }
 
-type InvokeNodeExecutionActionRequest struct {
+type EmitNodeEventRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	ExecutionId   string                 `protobuf:"bytes,2,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
-	ActionName    string                 `protobuf:"bytes,3,opt,name=action_name,json=actionName,proto3" json:"action_name,omitempty"`
-	Parameters    *_struct.Struct        `protobuf:"bytes,4,opt,name=parameters,proto3" json:"parameters,omitempty"`
+	NodeId        string                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Channel       string                 `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"`
+	Data          *_struct.Struct        `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InvokeNodeExecutionActionRequest) Reset() {
-	*x = InvokeNodeExecutionActionRequest{}
-	mi := &file_canvases_proto_msgTypes[53]
+func (x *EmitNodeEventRequest) Reset() {
+	*x = EmitNodeEventRequest{}
+	mi := &file_canvases_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InvokeNodeExecutionActionRequest) String() string {
+func (x *EmitNodeEventRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InvokeNodeExecutionActionRequest) ProtoMessage() {}
+func (*EmitNodeEventRequest) ProtoMessage() {}
 
-func (x *InvokeNodeExecutionActionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[53]
+func (x *EmitNodeEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3623,60 +3786,61 @@ func (x *InvokeNodeExecutionActionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InvokeNodeExecutionActionRequest.ProtoReflect.Descriptor instead.
-func (*InvokeNodeExecutionActionRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use EmitNodeEventRequest.ProtoReflect.Descriptor instead.
+func (*EmitNodeEventRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *InvokeNodeExecutionActionRequest) GetCanvasId() string {
+func (x *EmitNodeEventRequest) GetCanvasId() string {
 	if x != nil {
 		return x.CanvasId
 	}
 	return ""
 }
 
-func (x *InvokeNodeExecutionActionRequest) GetExecutionId() string {
+func (x *EmitNodeEventRequest) GetNodeId() string {
 	if x != nil {
-		return x.ExecutionId
+		return x.NodeId
 	}
 	return ""
 }
 
-func (x *InvokeNodeExecutionActionRequest) GetActionName() string {
+func (x *EmitNodeEventRequest) GetChannel() string {
 	if x != nil {
-		return x.ActionName
+		return x.Channel
 	}
 	return ""
 }
 
-func (x *InvokeNodeExecutionActionRequest) GetParameters() *_struct.Struct {
+func (x *EmitNodeEventRequest) GetData() *_struct.Struct {
 	if x != nil {
-		return x.Parameters
+		return x.Data
 	}
 	return nil
 }
 
-type InvokeNodeExecutionActionResponse struct {
+type EmitNodeEventResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InvokeNodeExecutionActionResponse) Reset() {
-	*x = InvokeNodeExecutionActionResponse{}
-	mi := &file_canvases_proto_msgTypes[54]
+func (x *EmitNodeEventResponse) Reset() {
+	*x = EmitNodeEventResponse{}
+	mi := &file_canvases_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InvokeNodeExecutionActionResponse) String() string {
+func (x *EmitNodeEventResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InvokeNodeExecutionActionResponse) ProtoMessage() {}
+func (*EmitNodeEventResponse) ProtoMessage() {}
 
-func (x *InvokeNodeExecutionActionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[54]
+func (x *EmitNodeEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3687,36 +3851,43 @@ func (x *InvokeNodeExecutionActionResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InvokeNodeExecutionActionResponse.ProtoReflect.Descriptor instead.
-func (*InvokeNodeExecutionActionResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use EmitNodeEventResponse.ProtoReflect.Descriptor instead.
+func (*EmitNodeEventResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{55}
 }
 
-type InvokeNodeTriggerActionRequest struct {
+func (x *EmitNodeEventResponse) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+type ListNodeQueueItemsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
 	NodeId        string                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	ActionName    string                 `protobuf:"bytes,3,opt,name=action_name,json=actionName,proto3" json:"action_name,omitempty"`
-	Parameters    *_struct.Struct        `protobuf:"bytes,4,opt,name=parameters,proto3" json:"parameters,omitempty"`
+	Limit         uint32                 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Before        *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=before,proto3" json:"before,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InvokeNodeTriggerActionRequest) Reset() {
-	*x = InvokeNodeTriggerActionRequest{}
-	mi := &file_canvases_proto_msgTypes[55]
+func (x *ListNodeQueueItemsRequest) Reset() {
+	*x = ListNodeQueueItemsRequest{}
+	mi := &file_canvases_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InvokeNodeTriggerActionRequest) String() string {
+func (x *ListNodeQueueItemsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InvokeNodeTriggerActionRequest) ProtoMessage() {}
+func (*ListNodeQueueItemsRequest) ProtoMessage() {}
 
-func (x *InvokeNodeTriggerActionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[55]
+func (x *ListNodeQueueItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3727,61 +3898,64 @@ func (x *InvokeNodeTriggerActionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InvokeNodeTriggerActionRequest.ProtoReflect.Descriptor instead.
-func (*InvokeNodeTriggerActionRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use ListNodeQueueItemsRequest.ProtoReflect.Descriptor instead.
+func (*ListNodeQueueItemsRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *InvokeNodeTriggerActionRequest) GetCanvasId() string {
+func (x *ListNodeQueueItemsRequest) GetCanvasId() string {
 	if x != nil {
 		return x.CanvasId
 	}
 	return ""
 }
 
-func (x *InvokeNodeTriggerActionRequest) GetNodeId() string {
+func (x *ListNodeQueueItemsRequest) GetNodeId() string {
 	if x != nil {
 		return x.NodeId
 	}
 	return ""
 }
 
-func (x *InvokeNodeTriggerActionRequest) GetActionName() string {
+func (x *ListNodeQueueItemsRequest) GetLimit() uint32 {
 	if x != nil {
-		return x.ActionName
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-func (x *InvokeNodeTriggerActionRequest) GetParameters() *_struct.Struct {
+func (x *ListNodeQueueItemsRequest) GetBefore() *timestamp.Timestamp {
 	if x != nil {
-		return x.Parameters
+		return x.Before
 	}
 	return nil
 }
 
-type InvokeNodeTriggerActionResponse struct {
+type ListNodeQueueItemsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Result        *_struct.Struct        `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Items         []*CanvasNodeQueueItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	TotalCount    uint32                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	HasNextPage   bool                   `protobuf:"varint,3,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
+	LastTimestamp *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=last_timestamp,json=lastTimestamp,proto3" json:"last_timestamp,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InvokeNodeTriggerActionResponse) Reset() {
-	*x = InvokeNodeTriggerActionResponse{}
-	mi := &file_canvases_proto_msgTypes[56]
+func (x *ListNodeQueueItemsResponse) Reset() {
+	*x = ListNodeQueueItemsResponse{}
+	mi := &file_canvases_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InvokeNodeTriggerActionResponse) String() string {
+func (x *ListNodeQueueItemsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InvokeNodeTriggerActionResponse) ProtoMessage() {}
+func (*ListNodeQueueItemsResponse) ProtoMessage() {}
 
-func (x *InvokeNodeTriggerActionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[56]
+func (x *ListNodeQueueItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3792,42 +3966,63 @@ func (x *InvokeNodeTriggerActionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InvokeNodeTriggerActionResponse.ProtoReflect.Descriptor instead.
-func (*InvokeNodeTriggerActionResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use ListNodeQueueItemsResponse.ProtoReflect.Descriptor instead.
+func (*ListNodeQueueItemsResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *InvokeNodeTriggerActionResponse) GetResult() *_struct.Struct {
+func (x *ListNodeQueueItemsResponse) GetItems() []*CanvasNodeQueueItem {
 	if x != nil {
-		return x.Result
+		return x.Items
 	}
 	return nil
 }
 
-type ListCanvasEventsRequest struct {
+func (x *ListNodeQueueItemsResponse) GetTotalCount() uint32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListNodeQueueItemsResponse) GetHasNextPage() bool {
+	if x != nil {
+		return x.HasNextPage
+	}
+	return false
+}
+
+func (x *ListNodeQueueItemsResponse) GetLastTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastTimestamp
+	}
+	return nil
+}
+
+type DeleteNodeQueueItemRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	Limit         uint32                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	Before        *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=before,proto3" json:"before,omitempty"`
+	NodeId        string                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	ItemId        string                 `protobuf:"bytes,3,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCanvasEventsRequest) Reset() {
-	*x = ListCanvasEventsRequest{}
-	mi := &file_canvases_proto_msgTypes[57]
+func (x *DeleteNodeQueueItemRequest) Reset() {
+	*x = DeleteNodeQueueItemRequest{}
+	mi := &file_canvases_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCanvasEventsRequest) String() string {
+func (x *DeleteNodeQueueItemRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCanvasEventsRequest) ProtoMessage() {}
+func (*DeleteNodeQueueItemRequest) ProtoMessage() {}
 
-func (x *ListCanvasEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[57]
+func (x *DeleteNodeQueueItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3838,57 +4033,53 @@ func (x *ListCanvasEventsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCanvasEventsRequest.ProtoReflect.Descriptor instead.
-func (*ListCanvasEventsRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{57}
+// Deprecated: Use DeleteNodeQueueItemRequest.ProtoReflect.Descriptor instead.
+func (*DeleteNodeQueueItemRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *ListCanvasEventsRequest) GetCanvasId() string {
+func (x *DeleteNodeQueueItemRequest) GetCanvasId() string {
 	if x != nil {
 		return x.CanvasId
 	}
 	return ""
 }
 
-func (x *ListCanvasEventsRequest) GetLimit() uint32 {
+func (x *DeleteNodeQueueItemRequest) GetNodeId() string {
 	if x != nil {
-		return x.Limit
+		return x.NodeId
 	}
-	return 0
+	return ""
 }
 
-func (x *ListCanvasEventsRequest) GetBefore() *timestamp.Timestamp {
+func (x *DeleteNodeQueueItemRequest) GetItemId() string {
 	if x != nil {
-		return x.Before
+		return x.ItemId
 	}
-	return nil
+	return ""
 }
 
-type ListCanvasEventsResponse struct {
-	state         protoimpl.MessageState       `protogen:"open.v1"`
-	Events        []*CanvasEventWithExecutions `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
-	TotalCount    uint32                       `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	HasNextPage   bool                         `protobuf:"varint,3,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
-	LastTimestamp *timestamp.Timestamp         `protobuf:"bytes,4,opt,name=last_timestamp,json=lastTimestamp,proto3" json:"last_timestamp,omitempty"`
+type DeleteNodeQueueItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCanvasEventsResponse) Reset() {
-	*x = ListCanvasEventsResponse{}
-	mi := &file_canvases_proto_msgTypes[58]
+func (x *DeleteNodeQueueItemResponse) Reset() {
+	*x = DeleteNodeQueueItemResponse{}
+	mi := &file_canvases_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCanvasEventsResponse) String() string {
+func (x *DeleteNodeQueueItemResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCanvasEventsResponse) ProtoMessage() {}
+func (*DeleteNodeQueueItemResponse) ProtoMessage() {}
 
-func (x *ListCanvasEventsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[58]
+func (x *DeleteNodeQueueItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3899,63 +4090,93 @@ func (x *ListCanvasEventsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCanvasEventsResponse.ProtoReflect.Descriptor instead.
-func (*ListCanvasEventsResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{58}
+// Deprecated: Use DeleteNodeQueueItemResponse.ProtoReflect.Descriptor instead.
+func (*DeleteNodeQueueItemResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *ListCanvasEventsResponse) GetEvents() []*CanvasEventWithExecutions {
+type UpdateNodePauseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Paused        bool                   `protobuf:"varint,3,opt,name=paused,proto3" json:"paused,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNodePauseRequest) Reset() {
+	*x = UpdateNodePauseRequest{}
+	mi := &file_canvases_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNodePauseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNodePauseRequest) ProtoMessage() {}
+
+func (x *UpdateNodePauseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[60]
 	if x != nil {
-		return x.Events
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *ListCanvasEventsResponse) GetTotalCount() uint32 {
+// Deprecated: Use UpdateNodePauseRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNodePauseRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *UpdateNodePauseRequest) GetCanvasId() string {
 	if x != nil {
-		return x.TotalCount
+		return x.CanvasId
 	}
-	return 0
+	return ""
 }
 
-func (x *ListCanvasEventsResponse) GetHasNextPage() bool {
+func (x *UpdateNodePauseRequest) GetNodeId() string {
 	if x != nil {
-		return x.HasNextPage
+		return x.NodeId
 	}
-	return false
+	return ""
 }
 
-func (x *ListCanvasEventsResponse) GetLastTimestamp() *timestamp.Timestamp {
+func (x *UpdateNodePauseRequest) GetPaused() bool {
 	if x != nil {
-		return x.LastTimestamp
+		return x.Paused
 	}
-	return nil
+	return false
 }
 
-type CanvasMemory struct {
+type UpdateNodePauseResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	Values        *_struct.Value         `protobuf:"bytes,3,opt,name=values,proto3" json:"values,omitempty"`
+	Node          *components.Node       `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CanvasMemory) Reset() {
-	*x = CanvasMemory{}
-	mi := &file_canvases_proto_msgTypes[59]
+func (x *UpdateNodePauseResponse) Reset() {
+	*x = UpdateNodePauseResponse{}
+	mi := &file_canvases_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CanvasMemory) String() string {
+func (x *UpdateNodePauseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CanvasMemory) ProtoMessage() {}
+func (*UpdateNodePauseResponse) ProtoMessage() {}
 
-func (x *CanvasMemory) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[59]
+func (x *UpdateNodePauseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3966,54 +4187,45 @@ func (x *CanvasMemory) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CanvasMemory.ProtoReflect.Descriptor instead.
-func (*CanvasMemory) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{59}
-}
-
-func (x *CanvasMemory) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *CanvasMemory) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
-	}
-	return ""
+// Deprecated: Use UpdateNodePauseResponse.ProtoReflect.Descriptor instead.
+func (*UpdateNodePauseResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *CanvasMemory) GetValues() *_struct.Value {
+func (x *UpdateNodePauseResponse) GetNode() *components.Node {
 	if x != nil {
-		return x.Values
+		return x.Node
 	}
 	return nil
 }
 
-type ListCanvasMemoriesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+type ListNodeExecutionsRequest struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	CanvasId      string                       `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	NodeId        string                       `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	States        []CanvasNodeExecution_State  `protobuf:"varint,3,rep,packed,name=states,proto3,enum=Superplane.Canvases.CanvasNodeExecution_State" json:"states,omitempty"`
+	Results       []CanvasNodeExecution_Result `protobuf:"varint,4,rep,packed,name=results,proto3,enum=Superplane.Canvases.CanvasNodeExecution_Result" json:"results,omitempty"`
+	Limit         uint32                       `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	Before        *timestamp.Timestamp         `protobuf:"bytes,6,opt,name=before,proto3" json:"before,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCanvasMemoriesRequest) Reset() {
-	*x = ListCanvasMemoriesRequest{}
-	mi := &file_canvases_proto_msgTypes[60]
+func (x *ListNodeExecutionsRequest) Reset() {
+	*x = ListNodeExecutionsRequest{}
+	mi := &file_canvases_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCanvasMemoriesRequest) String() string {
+func (x *ListNodeExecutionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCanvasMemoriesRequest) ProtoMessage() {}
+func (*ListNodeExecutionsRequest) ProtoMessage() {}
 
-func (x *ListCanvasMemoriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[60]
+func (x *ListNodeExecutionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4024,40 +4236,3265 @@ func (x *ListCanvasMemoriesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCanvasMemoriesRequest.ProtoReflect.Descriptor instead.
-func (*ListCanvasMemoriesRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{60}
+// Deprecated: Use ListNodeExecutionsRequest.ProtoReflect.Descriptor instead.
+func (*ListNodeExecutionsRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ListNodeExecutionsRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *ListNodeExecutionsRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *ListNodeExecutionsRequest) GetStates() []CanvasNodeExecution_State {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+func (x *ListNodeExecutionsRequest) GetResults() []CanvasNodeExecution_Result {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *ListNodeExecutionsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListNodeExecutionsRequest) GetBefore() *timestamp.Timestamp {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+type ListNodeExecutionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Executions    []*CanvasNodeExecution `protobuf:"bytes,1,rep,name=executions,proto3" json:"executions,omitempty"`
+	TotalCount    uint32                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	HasNextPage   bool                   `protobuf:"varint,3,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
+	LastTimestamp *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=last_timestamp,json=lastTimestamp,proto3" json:"last_timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNodeExecutionsResponse) Reset() {
+	*x = ListNodeExecutionsResponse{}
+	mi := &file_canvases_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNodeExecutionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNodeExecutionsResponse) ProtoMessage() {}
+
+func (x *ListNodeExecutionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNodeExecutionsResponse.ProtoReflect.Descriptor instead.
+func (*ListNodeExecutionsResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListNodeExecutionsResponse) GetExecutions() []*CanvasNodeExecution {
+	if x != nil {
+		return x.Executions
+	}
+	return nil
+}
+
+func (x *ListNodeExecutionsResponse) GetTotalCount() uint32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListNodeExecutionsResponse) GetHasNextPage() bool {
+	if x != nil {
+		return x.HasNextPage
+	}
+	return false
+}
+
+func (x *ListNodeExecutionsResponse) GetLastTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastTimestamp
+	}
+	return nil
+}
+
+type ListChildExecutionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	ExecutionId   string                 `protobuf:"bytes,2,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChildExecutionsRequest) Reset() {
+	*x = ListChildExecutionsRequest{}
+	mi := &file_canvases_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChildExecutionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChildExecutionsRequest) ProtoMessage() {}
+
+func (x *ListChildExecutionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChildExecutionsRequest.ProtoReflect.Descriptor instead.
+func (*ListChildExecutionsRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListChildExecutionsRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *ListChildExecutionsRequest) GetExecutionId() string {
+	if x != nil {
+		return x.ExecutionId
+	}
+	return ""
+}
+
+type ListChildExecutionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Executions    []*CanvasNodeExecution `protobuf:"bytes,1,rep,name=executions,proto3" json:"executions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChildExecutionsResponse) Reset() {
+	*x = ListChildExecutionsResponse{}
+	mi := &file_canvases_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChildExecutionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChildExecutionsResponse) ProtoMessage() {}
+
+func (x *ListChildExecutionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChildExecutionsResponse.ProtoReflect.Descriptor instead.
+func (*ListChildExecutionsResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ListChildExecutionsResponse) GetExecutions() []*CanvasNodeExecution {
+	if x != nil {
+		return x.Executions
+	}
+	return nil
+}
+
+type CanvasNodeExecution struct {
+	state               protoimpl.MessageState           `protogen:"open.v1"`
+	Id                  string                           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CanvasId            string                           `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	NodeId              string                           `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	ParentExecutionId   string                           `protobuf:"bytes,4,opt,name=parent_execution_id,json=parentExecutionId,proto3" json:"parent_execution_id,omitempty"`
+	PreviousExecutionId string                           `protobuf:"bytes,5,opt,name=previous_execution_id,json=previousExecutionId,proto3" json:"previous_execution_id,omitempty"`
+	State               CanvasNodeExecution_State        `protobuf:"varint,6,opt,name=state,proto3,enum=Superplane.Canvases.CanvasNodeExecution_State" json:"state,omitempty"`
+	Result              CanvasNodeExecution_Result       `protobuf:"varint,7,opt,name=result,proto3,enum=Superplane.Canvases.CanvasNodeExecution_Result" json:"result,omitempty"`
+	ResultReason        CanvasNodeExecution_ResultReason `protobuf:"varint,8,opt,name=result_reason,json=resultReason,proto3,enum=Superplane.Canvases.CanvasNodeExecution_ResultReason" json:"result_reason,omitempty"`
+	ResultMessage       string                           `protobuf:"bytes,9,opt,name=result_message,json=resultMessage,proto3" json:"result_message,omitempty"`
+	Input               *_struct.Struct                  `protobuf:"bytes,10,opt,name=input,proto3" json:"input,omitempty"`
+	Outputs             *_struct.Struct                  `protobuf:"bytes,11,opt,name=outputs,proto3" json:"outputs,omitempty"`
+	CreatedAt           *timestamp.Timestamp             `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt           *timestamp.Timestamp             `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Metadata            *_struct.Struct                  `protobuf:"bytes,14,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Configuration       *_struct.Struct                  `protobuf:"bytes,15,opt,name=configuration,proto3" json:"configuration,omitempty"`
+	ChildExecutions     []*CanvasNodeExecution           `protobuf:"bytes,16,rep,name=child_executions,json=childExecutions,proto3" json:"child_executions,omitempty"`
+	RootEvent           *CanvasEvent                     `protobuf:"bytes,17,opt,name=root_event,json=rootEvent,proto3" json:"root_event,omitempty"`
+	CancelledBy         *UserRef                         `protobuf:"bytes,18,opt,name=cancelled_by,json=cancelledBy,proto3" json:"cancelled_by,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CanvasNodeExecution) Reset() {
+	*x = CanvasNodeExecution{}
+	mi := &file_canvases_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasNodeExecution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasNodeExecution) ProtoMessage() {}
+
+func (x *CanvasNodeExecution) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasNodeExecution.ProtoReflect.Descriptor instead.
+func (*CanvasNodeExecution) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *CanvasNodeExecution) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CanvasNodeExecution) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *CanvasNodeExecution) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *CanvasNodeExecution) GetParentExecutionId() string {
+	if x != nil {
+		return x.ParentExecutionId
+	}
+	return ""
+}
+
+func (x *CanvasNodeExecution) GetPreviousExecutionId() string {
+	if x != nil {
+		return x.PreviousExecutionId
+	}
+	return ""
+}
+
+func (x *CanvasNodeExecution) GetState() CanvasNodeExecution_State {
+	if x != nil {
+		return x.State
+	}
+	return CanvasNodeExecution_STATE_UNKNOWN
+}
+
+func (x *CanvasNodeExecution) GetResult() CanvasNodeExecution_Result {
+	if x != nil {
+		return x.Result
+	}
+	return CanvasNodeExecution_RESULT_UNKNOWN
+}
+
+func (x *CanvasNodeExecution) GetResultReason() CanvasNodeExecution_ResultReason {
+	if x != nil {
+		return x.ResultReason
+	}
+	return CanvasNodeExecution_RESULT_REASON_OK
+}
+
+func (x *CanvasNodeExecution) GetResultMessage() string {
+	if x != nil {
+		return x.ResultMessage
+	}
+	return ""
+}
+
+func (x *CanvasNodeExecution) GetInput() *_struct.Struct {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *CanvasNodeExecution) GetOutputs() *_struct.Struct {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
+func (x *CanvasNodeExecution) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CanvasNodeExecution) GetUpdatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *CanvasNodeExecution) GetMetadata() *_struct.Struct {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *CanvasNodeExecution) GetConfiguration() *_struct.Struct {
+	if x != nil {
+		return x.Configuration
+	}
+	return nil
+}
+
+func (x *CanvasNodeExecution) GetChildExecutions() []*CanvasNodeExecution {
+	if x != nil {
+		return x.ChildExecutions
+	}
+	return nil
+}
+
+func (x *CanvasNodeExecution) GetRootEvent() *CanvasEvent {
+	if x != nil {
+		return x.RootEvent
+	}
+	return nil
+}
+
+func (x *CanvasNodeExecution) GetCancelledBy() *UserRef {
+	if x != nil {
+		return x.CancelledBy
+	}
+	return nil
+}
+
+type CanvasNodeQueueItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CanvasId      string                 `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Input         *_struct.Struct        `protobuf:"bytes,4,opt,name=input,proto3" json:"input,omitempty"`
+	RootEvent     *CanvasEvent           `protobuf:"bytes,5,opt,name=root_event,json=rootEvent,proto3" json:"root_event,omitempty"`
+	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasNodeQueueItem) Reset() {
+	*x = CanvasNodeQueueItem{}
+	mi := &file_canvases_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasNodeQueueItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasNodeQueueItem) ProtoMessage() {}
+
+func (x *CanvasNodeQueueItem) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasNodeQueueItem.ProtoReflect.Descriptor instead.
+func (*CanvasNodeQueueItem) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *CanvasNodeQueueItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CanvasNodeQueueItem) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *CanvasNodeQueueItem) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *CanvasNodeQueueItem) GetInput() *_struct.Struct {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *CanvasNodeQueueItem) GetRootEvent() *CanvasEvent {
+	if x != nil {
+		return x.RootEvent
+	}
+	return nil
+}
+
+func (x *CanvasNodeQueueItem) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type InvokeNodeExecutionActionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	ExecutionId   string                 `protobuf:"bytes,2,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	ActionName    string                 `protobuf:"bytes,3,opt,name=action_name,json=actionName,proto3" json:"action_name,omitempty"`
+	Parameters    *_struct.Struct        `protobuf:"bytes,4,opt,name=parameters,proto3" json:"parameters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvokeNodeExecutionActionRequest) Reset() {
+	*x = InvokeNodeExecutionActionRequest{}
+	mi := &file_canvases_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvokeNodeExecutionActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvokeNodeExecutionActionRequest) ProtoMessage() {}
+
+func (x *InvokeNodeExecutionActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvokeNodeExecutionActionRequest.ProtoReflect.Descriptor instead.
+func (*InvokeNodeExecutionActionRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *InvokeNodeExecutionActionRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *InvokeNodeExecutionActionRequest) GetExecutionId() string {
+	if x != nil {
+		return x.ExecutionId
+	}
+	return ""
+}
+
+func (x *InvokeNodeExecutionActionRequest) GetActionName() string {
+	if x != nil {
+		return x.ActionName
+	}
+	return ""
+}
+
+func (x *InvokeNodeExecutionActionRequest) GetParameters() *_struct.Struct {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+type InvokeNodeExecutionActionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvokeNodeExecutionActionResponse) Reset() {
+	*x = InvokeNodeExecutionActionResponse{}
+	mi := &file_canvases_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvokeNodeExecutionActionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvokeNodeExecutionActionResponse) ProtoMessage() {}
+
+func (x *InvokeNodeExecutionActionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvokeNodeExecutionActionResponse.ProtoReflect.Descriptor instead.
+func (*InvokeNodeExecutionActionResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{69}
+}
+
+type InvokeNodeTriggerActionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	ActionName    string                 `protobuf:"bytes,3,opt,name=action_name,json=actionName,proto3" json:"action_name,omitempty"`
+	Parameters    *_struct.Struct        `protobuf:"bytes,4,opt,name=parameters,proto3" json:"parameters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvokeNodeTriggerActionRequest) Reset() {
+	*x = InvokeNodeTriggerActionRequest{}
+	mi := &file_canvases_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvokeNodeTriggerActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvokeNodeTriggerActionRequest) ProtoMessage() {}
+
+func (x *InvokeNodeTriggerActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvokeNodeTriggerActionRequest.ProtoReflect.Descriptor instead.
+func (*InvokeNodeTriggerActionRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *InvokeNodeTriggerActionRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *InvokeNodeTriggerActionRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *InvokeNodeTriggerActionRequest) GetActionName() string {
+	if x != nil {
+		return x.ActionName
+	}
+	return ""
+}
+
+func (x *InvokeNodeTriggerActionRequest) GetParameters() *_struct.Struct {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+type InvokeNodeTriggerActionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *_struct.Struct        `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvokeNodeTriggerActionResponse) Reset() {
+	*x = InvokeNodeTriggerActionResponse{}
+	mi := &file_canvases_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvokeNodeTriggerActionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvokeNodeTriggerActionResponse) ProtoMessage() {}
+
+func (x *InvokeNodeTriggerActionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvokeNodeTriggerActionResponse.ProtoReflect.Descriptor instead.
+func (*InvokeNodeTriggerActionResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *InvokeNodeTriggerActionResponse) GetResult() *_struct.Struct {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type ListCanvasEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	Limit         uint32                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Before        *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=before,proto3" json:"before,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCanvasEventsRequest) Reset() {
+	*x = ListCanvasEventsRequest{}
+	mi := &file_canvases_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCanvasEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCanvasEventsRequest) ProtoMessage() {}
+
+func (x *ListCanvasEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCanvasEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListCanvasEventsRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ListCanvasEventsRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *ListCanvasEventsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListCanvasEventsRequest) GetBefore() *timestamp.Timestamp {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+type ListCanvasEventsResponse struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Events        []*CanvasEventWithExecutions `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	TotalCount    uint32                       `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	HasNextPage   bool                         `protobuf:"varint,3,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
+	LastTimestamp *timestamp.Timestamp         `protobuf:"bytes,4,opt,name=last_timestamp,json=lastTimestamp,proto3" json:"last_timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCanvasEventsResponse) Reset() {
+	*x = ListCanvasEventsResponse{}
+	mi := &file_canvases_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCanvasEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCanvasEventsResponse) ProtoMessage() {}
+
+func (x *ListCanvasEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCanvasEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListCanvasEventsResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ListCanvasEventsResponse) GetEvents() []*CanvasEventWithExecutions {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListCanvasEventsResponse) GetTotalCount() uint32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListCanvasEventsResponse) GetHasNextPage() bool {
+	if x != nil {
+		return x.HasNextPage
+	}
+	return false
+}
+
+func (x *ListCanvasEventsResponse) GetLastTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastTimestamp
+	}
+	return nil
+}
+
+type CanvasMemory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Values        *_struct.Value         `protobuf:"bytes,3,opt,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasMemory) Reset() {
+	*x = CanvasMemory{}
+	mi := &file_canvases_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasMemory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasMemory) ProtoMessage() {}
+
+func (x *CanvasMemory) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasMemory.ProtoReflect.Descriptor instead.
+func (*CanvasMemory) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *CanvasMemory) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CanvasMemory) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *CanvasMemory) GetValues() *_struct.Value {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type ListCanvasMemoriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCanvasMemoriesRequest) Reset() {
+	*x = ListCanvasMemoriesRequest{}
+	mi := &file_canvases_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCanvasMemoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCanvasMemoriesRequest) ProtoMessage() {}
+
+func (x *ListCanvasMemoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCanvasMemoriesRequest.ProtoReflect.Descriptor instead.
+func (*ListCanvasMemoriesRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ListCanvasMemoriesRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+type ListCanvasMemoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*CanvasMemory        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCanvasMemoriesResponse) Reset() {
+	*x = ListCanvasMemoriesResponse{}
+	mi := &file_canvases_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCanvasMemoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCanvasMemoriesResponse) ProtoMessage() {}
+
+func (x *ListCanvasMemoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCanvasMemoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListCanvasMemoriesResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ListCanvasMemoriesResponse) GetItems() []*CanvasMemory {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type DeleteCanvasMemoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	MemoryId      string                 `protobuf:"bytes,2,opt,name=memory_id,json=memoryId,proto3" json:"memory_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCanvasMemoryRequest) Reset() {
+	*x = DeleteCanvasMemoryRequest{}
+	mi := &file_canvases_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCanvasMemoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCanvasMemoryRequest) ProtoMessage() {}
+
+func (x *DeleteCanvasMemoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCanvasMemoryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCanvasMemoryRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *DeleteCanvasMemoryRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *DeleteCanvasMemoryRequest) GetMemoryId() string {
+	if x != nil {
+		return x.MemoryId
+	}
+	return ""
+}
+
+type DeleteCanvasMemoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCanvasMemoryResponse) Reset() {
+	*x = DeleteCanvasMemoryResponse{}
+	mi := &file_canvases_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCanvasMemoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCanvasMemoryResponse) ProtoMessage() {}
+
+func (x *DeleteCanvasMemoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCanvasMemoryResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCanvasMemoryResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{78}
+}
+
+// Memory namespaces without configuration keep records forever and accept any values.
+// Records older than ttl_seconds are removed, and if fields are declared,
+// new records are validated against them. Matches on indexed fields are
+// served by an index, which keeps lookups fast in large namespaces.
+type CanvasMemoryNamespace struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Namespace     string                         `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	TtlSeconds    int32                          `protobuf:"varint,2,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	Fields        []*CanvasMemoryNamespace_Field `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"`
+	IndexedFields []string                       `protobuf:"bytes,4,rep,name=indexed_fields,json=indexedFields,proto3" json:"indexed_fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasMemoryNamespace) Reset() {
+	*x = CanvasMemoryNamespace{}
+	mi := &file_canvases_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasMemoryNamespace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasMemoryNamespace) ProtoMessage() {}
+
+func (x *CanvasMemoryNamespace) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasMemoryNamespace.ProtoReflect.Descriptor instead.
+func (*CanvasMemoryNamespace) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *CanvasMemoryNamespace) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *CanvasMemoryNamespace) GetTtlSeconds() int32 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+func (x *CanvasMemoryNamespace) GetFields() []*CanvasMemoryNamespace_Field {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *CanvasMemoryNamespace) GetIndexedFields() []string {
+	if x != nil {
+		return x.IndexedFields
+	}
+	return nil
+}
+
+type ListCanvasMemoryNamespacesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCanvasMemoryNamespacesRequest) Reset() {
+	*x = ListCanvasMemoryNamespacesRequest{}
+	mi := &file_canvases_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCanvasMemoryNamespacesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCanvasMemoryNamespacesRequest) ProtoMessage() {}
+
+func (x *ListCanvasMemoryNamespacesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCanvasMemoryNamespacesRequest.ProtoReflect.Descriptor instead.
+func (*ListCanvasMemoryNamespacesRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ListCanvasMemoryNamespacesRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+type ListCanvasMemoryNamespacesResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Namespaces    []*CanvasMemoryNamespace `protobuf:"bytes,1,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCanvasMemoryNamespacesResponse) Reset() {
+	*x = ListCanvasMemoryNamespacesResponse{}
+	mi := &file_canvases_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCanvasMemoryNamespacesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCanvasMemoryNamespacesResponse) ProtoMessage() {}
+
+func (x *ListCanvasMemoryNamespacesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCanvasMemoryNamespacesResponse.ProtoReflect.Descriptor instead.
+func (*ListCanvasMemoryNamespacesResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ListCanvasMemoryNamespacesResponse) GetNamespaces() []*CanvasMemoryNamespace {
+	if x != nil {
+		return x.Namespaces
+	}
+	return nil
+}
+
+type UpdateCanvasMemoryNamespaceRequest struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	CanvasId      string                         `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	Namespace     string                         `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	TtlSeconds    int32                          `protobuf:"varint,3,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	Fields        []*CanvasMemoryNamespace_Field `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
+	IndexedFields []string                       `protobuf:"bytes,5,rep,name=indexed_fields,json=indexedFields,proto3" json:"indexed_fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCanvasMemoryNamespaceRequest) Reset() {
+	*x = UpdateCanvasMemoryNamespaceRequest{}
+	mi := &file_canvases_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCanvasMemoryNamespaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCanvasMemoryNamespaceRequest) ProtoMessage() {}
+
+func (x *UpdateCanvasMemoryNamespaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCanvasMemoryNamespaceRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCanvasMemoryNamespaceRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *UpdateCanvasMemoryNamespaceRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *UpdateCanvasMemoryNamespaceRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *UpdateCanvasMemoryNamespaceRequest) GetTtlSeconds() int32 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+func (x *UpdateCanvasMemoryNamespaceRequest) GetFields() []*CanvasMemoryNamespace_Field {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *UpdateCanvasMemoryNamespaceRequest) GetIndexedFields() []string {
+	if x != nil {
+		return x.IndexedFields
+	}
+	return nil
+}
+
+type UpdateCanvasMemoryNamespaceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     *CanvasMemoryNamespace `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCanvasMemoryNamespaceResponse) Reset() {
+	*x = UpdateCanvasMemoryNamespaceResponse{}
+	mi := &file_canvases_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCanvasMemoryNamespaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCanvasMemoryNamespaceResponse) ProtoMessage() {}
+
+func (x *UpdateCanvasMemoryNamespaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCanvasMemoryNamespaceResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCanvasMemoryNamespaceResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *UpdateCanvasMemoryNamespaceResponse) GetNamespace() *CanvasMemoryNamespace {
+	if x != nil {
+		return x.Namespace
+	}
+	return nil
+}
+
+type DeleteCanvasMemoryNamespaceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCanvasMemoryNamespaceRequest) Reset() {
+	*x = DeleteCanvasMemoryNamespaceRequest{}
+	mi := &file_canvases_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCanvasMemoryNamespaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCanvasMemoryNamespaceRequest) ProtoMessage() {}
+
+func (x *DeleteCanvasMemoryNamespaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCanvasMemoryNamespaceRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCanvasMemoryNamespaceRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *DeleteCanvasMemoryNamespaceRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *DeleteCanvasMemoryNamespaceRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type DeleteCanvasMemoryNamespaceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCanvasMemoryNamespaceResponse) Reset() {
+	*x = DeleteCanvasMemoryNamespaceResponse{}
+	mi := &file_canvases_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCanvasMemoryNamespaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCanvasMemoryNamespaceResponse) ProtoMessage() {}
+
+func (x *DeleteCanvasMemoryNamespaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCanvasMemoryNamespaceResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCanvasMemoryNamespaceResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{85}
+}
+
+// The canvas file is read through the integration, if integration_id is set.
+// Otherwise, repository is a Git URL, cloned directly.
+type CanvasGitSync struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	IntegrationId string                 `protobuf:"bytes,2,opt,name=integration_id,json=integrationId,proto3" json:"integration_id,omitempty"`
+	Repository    string                 `protobuf:"bytes,3,opt,name=repository,proto3" json:"repository,omitempty"`
+	Branch        string                 `protobuf:"bytes,4,opt,name=branch,proto3" json:"branch,omitempty"`
+	Path          string                 `protobuf:"bytes,5,opt,name=path,proto3" json:"path,omitempty"`
+	Enabled       bool                   `protobuf:"varint,6,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Status        *CanvasGitSync_Status  `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasGitSync) Reset() {
+	*x = CanvasGitSync{}
+	mi := &file_canvases_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasGitSync) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasGitSync) ProtoMessage() {}
+
+func (x *CanvasGitSync) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasGitSync.ProtoReflect.Descriptor instead.
+func (*CanvasGitSync) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *CanvasGitSync) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *CanvasGitSync) GetIntegrationId() string {
+	if x != nil {
+		return x.IntegrationId
+	}
+	return ""
+}
+
+func (x *CanvasGitSync) GetRepository() string {
+	if x != nil {
+		return x.Repository
+	}
+	return ""
+}
+
+func (x *CanvasGitSync) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+func (x *CanvasGitSync) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *CanvasGitSync) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *CanvasGitSync) GetStatus() *CanvasGitSync_Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type DescribeCanvasGitSyncRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DescribeCanvasGitSyncRequest) Reset() {
+	*x = DescribeCanvasGitSyncRequest{}
+	mi := &file_canvases_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeCanvasGitSyncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeCanvasGitSyncRequest) ProtoMessage() {}
+
+func (x *DescribeCanvasGitSyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeCanvasGitSyncRequest.ProtoReflect.Descriptor instead.
+func (*DescribeCanvasGitSyncRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *DescribeCanvasGitSyncRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+type DescribeCanvasGitSyncResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sync          *CanvasGitSync         `protobuf:"bytes,1,opt,name=sync,proto3" json:"sync,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DescribeCanvasGitSyncResponse) Reset() {
+	*x = DescribeCanvasGitSyncResponse{}
+	mi := &file_canvases_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeCanvasGitSyncResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeCanvasGitSyncResponse) ProtoMessage() {}
+
+func (x *DescribeCanvasGitSyncResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeCanvasGitSyncResponse.ProtoReflect.Descriptor instead.
+func (*DescribeCanvasGitSyncResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *DescribeCanvasGitSyncResponse) GetSync() *CanvasGitSync {
+	if x != nil {
+		return x.Sync
+	}
+	return nil
+}
+
+type UpdateCanvasGitSyncRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	IntegrationId string                 `protobuf:"bytes,2,opt,name=integration_id,json=integrationId,proto3" json:"integration_id,omitempty"`
+	Repository    string                 `protobuf:"bytes,3,opt,name=repository,proto3" json:"repository,omitempty"`
+	Branch        string                 `protobuf:"bytes,4,opt,name=branch,proto3" json:"branch,omitempty"`
+	Path          string                 `protobuf:"bytes,5,opt,name=path,proto3" json:"path,omitempty"`
+	Enabled       bool                   `protobuf:"varint,6,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCanvasGitSyncRequest) Reset() {
+	*x = UpdateCanvasGitSyncRequest{}
+	mi := &file_canvases_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCanvasGitSyncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCanvasGitSyncRequest) ProtoMessage() {}
+
+func (x *UpdateCanvasGitSyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCanvasGitSyncRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCanvasGitSyncRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *UpdateCanvasGitSyncRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *UpdateCanvasGitSyncRequest) GetIntegrationId() string {
+	if x != nil {
+		return x.IntegrationId
+	}
+	return ""
+}
+
+func (x *UpdateCanvasGitSyncRequest) GetRepository() string {
+	if x != nil {
+		return x.Repository
+	}
+	return ""
+}
+
+func (x *UpdateCanvasGitSyncRequest) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+func (x *UpdateCanvasGitSyncRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *UpdateCanvasGitSyncRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type UpdateCanvasGitSyncResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sync          *CanvasGitSync         `protobuf:"bytes,1,opt,name=sync,proto3" json:"sync,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCanvasGitSyncResponse) Reset() {
+	*x = UpdateCanvasGitSyncResponse{}
+	mi := &file_canvases_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCanvasGitSyncResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCanvasGitSyncResponse) ProtoMessage() {}
+
+func (x *UpdateCanvasGitSyncResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCanvasGitSyncResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCanvasGitSyncResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *UpdateCanvasGitSyncResponse) GetSync() *CanvasGitSync {
+	if x != nil {
+		return x.Sync
+	}
+	return nil
+}
+
+type DeleteCanvasGitSyncRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCanvasGitSyncRequest) Reset() {
+	*x = DeleteCanvasGitSyncRequest{}
+	mi := &file_canvases_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCanvasGitSyncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCanvasGitSyncRequest) ProtoMessage() {}
+
+func (x *DeleteCanvasGitSyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCanvasGitSyncRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCanvasGitSyncRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *DeleteCanvasGitSyncRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+type DeleteCanvasGitSyncResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCanvasGitSyncResponse) Reset() {
+	*x = DeleteCanvasGitSyncResponse{}
+	mi := &file_canvases_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCanvasGitSyncResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCanvasGitSyncResponse) ProtoMessage() {}
+
+func (x *DeleteCanvasGitSyncResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCanvasGitSyncResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCanvasGitSyncResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{92}
+}
+
+// A user with a role on a canvas.
+// Canvas roles are granted in addition to organization roles:
+// canvas_viewer, canvas_operator, canvas_editor and canvas_owner.
+type CanvasMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserName      string                 `protobuf:"bytes,2,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	UserEmail     string                 `protobuf:"bytes,3,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
+	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasMember) Reset() {
+	*x = CanvasMember{}
+	mi := &file_canvases_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasMember) ProtoMessage() {}
+
+func (x *CanvasMember) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasMember.ProtoReflect.Descriptor instead.
+func (*CanvasMember) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *CanvasMember) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CanvasMember) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *CanvasMember) GetUserEmail() string {
+	if x != nil {
+		return x.UserEmail
+	}
+	return ""
+}
+
+func (x *CanvasMember) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+// An organization group with a role on a canvas.
+// All members of the group get the role on the canvas.
+type CanvasGroup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupName     string                 `protobuf:"bytes,1,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasGroup) Reset() {
+	*x = CanvasGroup{}
+	mi := &file_canvases_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasGroup) ProtoMessage() {}
+
+func (x *CanvasGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasGroup.ProtoReflect.Descriptor instead.
+func (*CanvasGroup) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *CanvasGroup) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *CanvasGroup) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *CanvasGroup) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type ListCanvasMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCanvasMembersRequest) Reset() {
+	*x = ListCanvasMembersRequest{}
+	mi := &file_canvases_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCanvasMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCanvasMembersRequest) ProtoMessage() {}
+
+func (x *ListCanvasMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCanvasMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListCanvasMembersRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *ListCanvasMembersRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+type ListCanvasMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []*CanvasMember        `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCanvasMembersResponse) Reset() {
+	*x = ListCanvasMembersResponse{}
+	mi := &file_canvases_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCanvasMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCanvasMembersResponse) ProtoMessage() {}
+
+func (x *ListCanvasMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCanvasMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListCanvasMembersResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *ListCanvasMembersResponse) GetMembers() []*CanvasMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type UpdateCanvasMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCanvasMemberRequest) Reset() {
+	*x = UpdateCanvasMemberRequest{}
+	mi := &file_canvases_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCanvasMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCanvasMemberRequest) ProtoMessage() {}
+
+func (x *UpdateCanvasMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCanvasMemberRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCanvasMemberRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *UpdateCanvasMemberRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *UpdateCanvasMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateCanvasMemberRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type UpdateCanvasMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *CanvasMember          `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCanvasMemberResponse) Reset() {
+	*x = UpdateCanvasMemberResponse{}
+	mi := &file_canvases_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCanvasMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCanvasMemberResponse) ProtoMessage() {}
+
+func (x *UpdateCanvasMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCanvasMemberResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCanvasMemberResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *UpdateCanvasMemberResponse) GetMember() *CanvasMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+type RemoveCanvasMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveCanvasMemberRequest) Reset() {
+	*x = RemoveCanvasMemberRequest{}
+	mi := &file_canvases_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveCanvasMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCanvasMemberRequest) ProtoMessage() {}
+
+func (x *RemoveCanvasMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCanvasMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCanvasMemberRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *RemoveCanvasMemberRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *RemoveCanvasMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RemoveCanvasMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveCanvasMemberResponse) Reset() {
+	*x = RemoveCanvasMemberResponse{}
+	mi := &file_canvases_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveCanvasMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCanvasMemberResponse) ProtoMessage() {}
+
+func (x *RemoveCanvasMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCanvasMemberResponse.ProtoReflect.Descriptor instead.
+func (*RemoveCanvasMemberResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{100}
+}
+
+type ListCanvasGroupsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCanvasGroupsRequest) Reset() {
+	*x = ListCanvasGroupsRequest{}
+	mi := &file_canvases_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCanvasGroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCanvasGroupsRequest) ProtoMessage() {}
+
+func (x *ListCanvasGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCanvasGroupsRequest.ProtoReflect.Descriptor instead.
+func (*ListCanvasGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *ListCanvasGroupsRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+type ListCanvasGroupsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Groups        []*CanvasGroup         `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCanvasGroupsResponse) Reset() {
+	*x = ListCanvasGroupsResponse{}
+	mi := &file_canvases_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCanvasGroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCanvasGroupsResponse) ProtoMessage() {}
+
+func (x *ListCanvasGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCanvasGroupsResponse.ProtoReflect.Descriptor instead.
+func (*ListCanvasGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *ListCanvasGroupsResponse) GetGroups() []*CanvasGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type UpdateCanvasGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	GroupName     string                 `protobuf:"bytes,2,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCanvasGroupRequest) Reset() {
+	*x = UpdateCanvasGroupRequest{}
+	mi := &file_canvases_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCanvasGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCanvasGroupRequest) ProtoMessage() {}
+
+func (x *UpdateCanvasGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCanvasGroupRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCanvasGroupRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *UpdateCanvasGroupRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *UpdateCanvasGroupRequest) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *UpdateCanvasGroupRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type UpdateCanvasGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         *CanvasGroup           `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCanvasGroupResponse) Reset() {
+	*x = UpdateCanvasGroupResponse{}
+	mi := &file_canvases_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCanvasGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCanvasGroupResponse) ProtoMessage() {}
+
+func (x *UpdateCanvasGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCanvasGroupResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCanvasGroupResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *UpdateCanvasGroupResponse) GetGroup() *CanvasGroup {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+type RemoveCanvasGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	GroupName     string                 `protobuf:"bytes,2,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveCanvasGroupRequest) Reset() {
+	*x = RemoveCanvasGroupRequest{}
+	mi := &file_canvases_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveCanvasGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCanvasGroupRequest) ProtoMessage() {}
+
+func (x *RemoveCanvasGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCanvasGroupRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCanvasGroupRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *RemoveCanvasGroupRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *RemoveCanvasGroupRequest) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+type RemoveCanvasGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveCanvasGroupResponse) Reset() {
+	*x = RemoveCanvasGroupResponse{}
+	mi := &file_canvases_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveCanvasGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCanvasGroupResponse) ProtoMessage() {}
+
+func (x *RemoveCanvasGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCanvasGroupResponse.ProtoReflect.Descriptor instead.
+func (*RemoveCanvasGroupResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{106}
+}
+
+// Expressions are validated against the live canvas,
+// or against a version, if version_id is set.
+// The expression can be an expression field value,
+// or a text with template expressions between double braces.
+type ValidateExpressionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Expression    string                 `protobuf:"bytes,3,opt,name=expression,proto3" json:"expression,omitempty"`
+	VersionId     string                 `protobuf:"bytes,4,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateExpressionRequest) Reset() {
+	*x = ValidateExpressionRequest{}
+	mi := &file_canvases_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateExpressionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateExpressionRequest) ProtoMessage() {}
+
+func (x *ValidateExpressionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateExpressionRequest.ProtoReflect.Descriptor instead.
+func (*ValidateExpressionRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *ValidateExpressionRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *ValidateExpressionRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *ValidateExpressionRequest) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *ValidateExpressionRequest) GetVersionId() string {
+	if x != nil {
+		return x.VersionId
+	}
+	return ""
+}
+
+type ValidateExpressionResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Valid         bool                    `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Diagnostics   []*ExpressionDiagnostic `protobuf:"bytes,2,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateExpressionResponse) Reset() {
+	*x = ValidateExpressionResponse{}
+	mi := &file_canvases_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateExpressionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateExpressionResponse) ProtoMessage() {}
+
+func (x *ValidateExpressionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateExpressionResponse.ProtoReflect.Descriptor instead.
+func (*ValidateExpressionResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *ValidateExpressionResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidateExpressionResponse) GetDiagnostics() []*ExpressionDiagnostic {
+	if x != nil {
+		return x.Diagnostics
+	}
+	return nil
+}
+
+type ExpressionDiagnostic struct {
+	state    protoimpl.MessageState        `protogen:"open.v1"`
+	Severity ExpressionDiagnostic_Severity `protobuf:"varint,1,opt,name=severity,proto3,enum=Superplane.Canvases.ExpressionDiagnostic_Severity" json:"severity,omitempty"`
+	Message  string                        `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// Character offsets of the problem in the expression.
+	From          int32 `protobuf:"varint,3,opt,name=from,proto3" json:"from,omitempty"`
+	To            int32 `protobuf:"varint,4,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpressionDiagnostic) Reset() {
+	*x = ExpressionDiagnostic{}
+	mi := &file_canvases_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpressionDiagnostic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpressionDiagnostic) ProtoMessage() {}
+
+func (x *ExpressionDiagnostic) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpressionDiagnostic.ProtoReflect.Descriptor instead.
+func (*ExpressionDiagnostic) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *ExpressionDiagnostic) GetSeverity() ExpressionDiagnostic_Severity {
+	if x != nil {
+		return x.Severity
+	}
+	return ExpressionDiagnostic_SEVERITY_UNSPECIFIED
+}
+
+func (x *ExpressionDiagnostic) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ExpressionDiagnostic) GetFrom() int32 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *ExpressionDiagnostic) GetTo() int32 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+type CompleteExpressionRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId   string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	NodeId     string                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Expression string                 `protobuf:"bytes,3,opt,name=expression,proto3" json:"expression,omitempty"`
+	// Character offset of the cursor in the expression.
+	// Defaults to the end of the expression.
+	Cursor        *int32 `protobuf:"varint,4,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	VersionId     string `protobuf:"bytes,5,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteExpressionRequest) Reset() {
+	*x = CompleteExpressionRequest{}
+	mi := &file_canvases_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteExpressionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteExpressionRequest) ProtoMessage() {}
+
+func (x *CompleteExpressionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteExpressionRequest.ProtoReflect.Descriptor instead.
+func (*CompleteExpressionRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *CompleteExpressionRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *CompleteExpressionRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *CompleteExpressionRequest) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *CompleteExpressionRequest) GetCursor() int32 {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return 0
+}
+
+func (x *CompleteExpressionRequest) GetVersionId() string {
+	if x != nil {
+		return x.VersionId
+	}
+	return ""
+}
+
+type CompleteExpressionResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Completions   []*ExpressionCompletion `protobuf:"bytes,1,rep,name=completions,proto3" json:"completions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteExpressionResponse) Reset() {
+	*x = CompleteExpressionResponse{}
+	mi := &file_canvases_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteExpressionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteExpressionResponse) ProtoMessage() {}
+
+func (x *CompleteExpressionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteExpressionResponse.ProtoReflect.Descriptor instead.
+func (*CompleteExpressionResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *CompleteExpressionResponse) GetCompletions() []*ExpressionCompletion {
+	if x != nil {
+		return x.Completions
+	}
+	return nil
+}
+
+type ExpressionCompletion struct {
+	state  protoimpl.MessageState    `protogen:"open.v1"`
+	Label  string                    `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Kind   ExpressionCompletion_Kind `protobuf:"varint,2,opt,name=kind,proto3,enum=Superplane.Canvases.ExpressionCompletion_Kind" json:"kind,omitempty"`
+	Detail string                    `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty"`
+	// Text to insert, replacing the `replace` characters before the cursor.
+	InsertText    string `protobuf:"bytes,4,opt,name=insert_text,json=insertText,proto3" json:"insert_text,omitempty"`
+	Replace       int32  `protobuf:"varint,5,opt,name=replace,proto3" json:"replace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpressionCompletion) Reset() {
+	*x = ExpressionCompletion{}
+	mi := &file_canvases_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpressionCompletion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpressionCompletion) ProtoMessage() {}
+
+func (x *ExpressionCompletion) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpressionCompletion.ProtoReflect.Descriptor instead.
+func (*ExpressionCompletion) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *ExpressionCompletion) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *ExpressionCompletion) GetKind() ExpressionCompletion_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return ExpressionCompletion_KIND_UNSPECIFIED
+}
+
+func (x *ExpressionCompletion) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *ExpressionCompletion) GetInsertText() string {
+	if x != nil {
+		return x.InsertText
+	}
+	return ""
+}
+
+func (x *ExpressionCompletion) GetReplace() int32 {
+	if x != nil {
+		return x.Replace
+	}
+	return 0
+}
+
+type CanvasEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CanvasId      string                 `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Channel       string                 `protobuf:"bytes,4,opt,name=channel,proto3" json:"channel,omitempty"`
+	CustomName    string                 `protobuf:"bytes,5,opt,name=custom_name,json=customName,proto3" json:"custom_name,omitempty"`
+	Data          *_struct.Struct        `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
+	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasEvent) Reset() {
+	*x = CanvasEvent{}
+	mi := &file_canvases_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasEvent) ProtoMessage() {}
+
+func (x *CanvasEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasEvent.ProtoReflect.Descriptor instead.
+func (*CanvasEvent) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *CanvasEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CanvasEvent) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *CanvasEvent) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *CanvasEvent) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *CanvasEvent) GetCustomName() string {
+	if x != nil {
+		return x.CustomName
+	}
+	return ""
+}
+
+func (x *CanvasEvent) GetData() *_struct.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *CanvasEvent) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CanvasEventWithExecutions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CanvasId      string                 `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Channel       string                 `protobuf:"bytes,4,opt,name=channel,proto3" json:"channel,omitempty"`
+	Data          *_struct.Struct        `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Executions    []*CanvasNodeExecution `protobuf:"bytes,7,rep,name=executions,proto3" json:"executions,omitempty"`
+	CustomName    string                 `protobuf:"bytes,8,opt,name=custom_name,json=customName,proto3" json:"custom_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasEventWithExecutions) Reset() {
+	*x = CanvasEventWithExecutions{}
+	mi := &file_canvases_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasEventWithExecutions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasEventWithExecutions) ProtoMessage() {}
+
+func (x *CanvasEventWithExecutions) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasEventWithExecutions.ProtoReflect.Descriptor instead.
+func (*CanvasEventWithExecutions) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *CanvasEventWithExecutions) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CanvasEventWithExecutions) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *CanvasEventWithExecutions) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *CanvasEventWithExecutions) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *CanvasEventWithExecutions) GetData() *_struct.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *CanvasEventWithExecutions) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CanvasEventWithExecutions) GetExecutions() []*CanvasNodeExecution {
+	if x != nil {
+		return x.Executions
+	}
+	return nil
+}
+
+func (x *CanvasEventWithExecutions) GetCustomName() string {
+	if x != nil {
+		return x.CustomName
+	}
+	return ""
+}
+
+type ListEventExecutionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventExecutionsRequest) Reset() {
+	*x = ListEventExecutionsRequest{}
+	mi := &file_canvases_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventExecutionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventExecutionsRequest) ProtoMessage() {}
+
+func (x *ListEventExecutionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventExecutionsRequest.ProtoReflect.Descriptor instead.
+func (*ListEventExecutionsRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *ListEventExecutionsRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *ListEventExecutionsRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+type ListEventExecutionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Executions    []*CanvasNodeExecution `protobuf:"bytes,1,rep,name=executions,proto3" json:"executions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventExecutionsResponse) Reset() {
+	*x = ListEventExecutionsResponse{}
+	mi := &file_canvases_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventExecutionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventExecutionsResponse) ProtoMessage() {}
+
+func (x *ListEventExecutionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventExecutionsResponse.ProtoReflect.Descriptor instead.
+func (*ListEventExecutionsResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *ListEventExecutionsResponse) GetExecutions() []*CanvasNodeExecution {
+	if x != nil {
+		return x.Executions
+	}
+	return nil
+}
+
+type CancelExecutionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	ExecutionId   string                 `protobuf:"bytes,2,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelExecutionRequest) Reset() {
+	*x = CancelExecutionRequest{}
+	mi := &file_canvases_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelExecutionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelExecutionRequest) ProtoMessage() {}
+
+func (x *CancelExecutionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelExecutionRequest.ProtoReflect.Descriptor instead.
+func (*CancelExecutionRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *CancelExecutionRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
 }
 
-func (x *ListCanvasMemoriesRequest) GetCanvasId() string {
+func (x *CancelExecutionRequest) GetExecutionId() string {
 	if x != nil {
-		return x.CanvasId
+		return x.ExecutionId
 	}
 	return ""
 }
 
-type ListCanvasMemoriesResponse struct {
+type CancelExecutionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*CanvasMemory        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCanvasMemoriesResponse) Reset() {
-	*x = ListCanvasMemoriesResponse{}
-	mi := &file_canvases_proto_msgTypes[61]
+func (x *CancelExecutionResponse) Reset() {
+	*x = CancelExecutionResponse{}
+	mi := &file_canvases_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCanvasMemoriesResponse) String() string {
+func (x *CancelExecutionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCanvasMemoriesResponse) ProtoMessage() {}
+func (*CancelExecutionResponse) ProtoMessage() {}
 
-func (x *ListCanvasMemoriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[61]
+func (x *CancelExecutionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4068,41 +7505,34 @@ func (x *ListCanvasMemoriesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCanvasMemoriesResponse.ProtoReflect.Descriptor instead.
-func (*ListCanvasMemoriesResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{61}
-}
-
-func (x *ListCanvasMemoriesResponse) GetItems() []*CanvasMemory {
-	if x != nil {
-		return x.Items
-	}
-	return nil
+// Deprecated: Use CancelExecutionResponse.ProtoReflect.Descriptor instead.
+func (*CancelExecutionResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{118}
 }
 
-type DeleteCanvasMemoryRequest struct {
+type ResolveExecutionErrorsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	MemoryId      string                 `protobuf:"bytes,2,opt,name=memory_id,json=memoryId,proto3" json:"memory_id,omitempty"`
+	ExecutionIds  []string               `protobuf:"bytes,2,rep,name=execution_ids,json=executionIds,proto3" json:"execution_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteCanvasMemoryRequest) Reset() {
-	*x = DeleteCanvasMemoryRequest{}
-	mi := &file_canvases_proto_msgTypes[62]
+func (x *ResolveExecutionErrorsRequest) Reset() {
+	*x = ResolveExecutionErrorsRequest{}
+	mi := &file_canvases_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCanvasMemoryRequest) String() string {
+func (x *ResolveExecutionErrorsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCanvasMemoryRequest) ProtoMessage() {}
+func (*ResolveExecutionErrorsRequest) ProtoMessage() {}
 
-func (x *DeleteCanvasMemoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[62]
+func (x *ResolveExecutionErrorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4113,46 +7543,46 @@ func (x *DeleteCanvasMemoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCanvasMemoryRequest.ProtoReflect.Descriptor instead.
-func (*DeleteCanvasMemoryRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use ResolveExecutionErrorsRequest.ProtoReflect.Descriptor instead.
+func (*ResolveExecutionErrorsRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{119}
 }
 
-func (x *DeleteCanvasMemoryRequest) GetCanvasId() string {
+func (x *ResolveExecutionErrorsRequest) GetCanvasId() string {
 	if x != nil {
 		return x.CanvasId
 	}
 	return ""
 }
 
-func (x *DeleteCanvasMemoryRequest) GetMemoryId() string {
+func (x *ResolveExecutionErrorsRequest) GetExecutionIds() []string {
 	if x != nil {
-		return x.MemoryId
+		return x.ExecutionIds
 	}
-	return ""
+	return nil
 }
 
-type DeleteCanvasMemoryResponse struct {
+type ResolveExecutionErrorsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteCanvasMemoryResponse) Reset() {
-	*x = DeleteCanvasMemoryResponse{}
-	mi := &file_canvases_proto_msgTypes[63]
+func (x *ResolveExecutionErrorsResponse) Reset() {
+	*x = ResolveExecutionErrorsResponse{}
+	mi := &file_canvases_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCanvasMemoryResponse) String() string {
+func (x *ResolveExecutionErrorsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCanvasMemoryResponse) ProtoMessage() {}
+func (*ResolveExecutionErrorsResponse) ProtoMessage() {}
 
-func (x *DeleteCanvasMemoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[63]
+func (x *ResolveExecutionErrorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4163,39 +7593,36 @@ func (x *DeleteCanvasMemoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCanvasMemoryResponse.ProtoReflect.Descriptor instead.
-func (*DeleteCanvasMemoryResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{63}
+// Deprecated: Use ResolveExecutionErrorsResponse.ProtoReflect.Descriptor instead.
+func (*ResolveExecutionErrorsResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{120}
 }
 
-type CanvasEvent struct {
+type CanvasNodeEventMessage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	CanvasId      string                 `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
 	NodeId        string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	Channel       string                 `protobuf:"bytes,4,opt,name=channel,proto3" json:"channel,omitempty"`
-	CustomName    string                 `protobuf:"bytes,5,opt,name=custom_name,json=customName,proto3" json:"custom_name,omitempty"`
-	Data          *_struct.Struct        `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
-	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CanvasEvent) Reset() {
-	*x = CanvasEvent{}
-	mi := &file_canvases_proto_msgTypes[64]
+func (x *CanvasNodeEventMessage) Reset() {
+	*x = CanvasNodeEventMessage{}
+	mi := &file_canvases_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CanvasEvent) String() string {
+func (x *CanvasNodeEventMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CanvasEvent) ProtoMessage() {}
+func (*CanvasNodeEventMessage) ProtoMessage() {}
 
-func (x *CanvasEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[64]
+func (x *CanvasNodeEventMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4206,89 +7633,132 @@ func (x *CanvasEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CanvasEvent.ProtoReflect.Descriptor instead.
-func (*CanvasEvent) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{64}
+// Deprecated: Use CanvasNodeEventMessage.ProtoReflect.Descriptor instead.
+func (*CanvasNodeEventMessage) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{121}
 }
 
-func (x *CanvasEvent) GetId() string {
+func (x *CanvasNodeEventMessage) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *CanvasEvent) GetCanvasId() string {
+func (x *CanvasNodeEventMessage) GetCanvasId() string {
 	if x != nil {
 		return x.CanvasId
 	}
 	return ""
 }
 
-func (x *CanvasEvent) GetNodeId() string {
+func (x *CanvasNodeEventMessage) GetNodeId() string {
 	if x != nil {
 		return x.NodeId
 	}
 	return ""
 }
 
-func (x *CanvasEvent) GetChannel() string {
+func (x *CanvasNodeEventMessage) GetTimestamp() *timestamp.Timestamp {
 	if x != nil {
-		return x.Channel
+		return x.Timestamp
+	}
+	return nil
+}
+
+type CanvasNodeExecutionMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CanvasId      string                 `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasNodeExecutionMessage) Reset() {
+	*x = CanvasNodeExecutionMessage{}
+	mi := &file_canvases_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasNodeExecutionMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasNodeExecutionMessage) ProtoMessage() {}
+
+func (x *CanvasNodeExecutionMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasNodeExecutionMessage.ProtoReflect.Descriptor instead.
+func (*CanvasNodeExecutionMessage) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *CanvasNodeExecutionMessage) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-func (x *CanvasEvent) GetCustomName() string {
+func (x *CanvasNodeExecutionMessage) GetCanvasId() string {
 	if x != nil {
-		return x.CustomName
+		return x.CanvasId
 	}
 	return ""
 }
 
-func (x *CanvasEvent) GetData() *_struct.Struct {
+func (x *CanvasNodeExecutionMessage) GetNodeId() string {
 	if x != nil {
-		return x.Data
+		return x.NodeId
 	}
-	return nil
+	return ""
 }
 
-func (x *CanvasEvent) GetCreatedAt() *timestamp.Timestamp {
+func (x *CanvasNodeExecutionMessage) GetTimestamp() *timestamp.Timestamp {
 	if x != nil {
-		return x.CreatedAt
+		return x.Timestamp
 	}
 	return nil
 }
 
-type CanvasEventWithExecutions struct {
+type CanvasNodeQueueItemMessage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	CanvasId      string                 `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
 	NodeId        string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	Channel       string                 `protobuf:"bytes,4,opt,name=channel,proto3" json:"channel,omitempty"`
-	Data          *_struct.Struct        `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
-	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Executions    []*CanvasNodeExecution `protobuf:"bytes,7,rep,name=executions,proto3" json:"executions,omitempty"`
-	CustomName    string                 `protobuf:"bytes,8,opt,name=custom_name,json=customName,proto3" json:"custom_name,omitempty"`
+	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CanvasEventWithExecutions) Reset() {
-	*x = CanvasEventWithExecutions{}
-	mi := &file_canvases_proto_msgTypes[65]
+func (x *CanvasNodeQueueItemMessage) Reset() {
+	*x = CanvasNodeQueueItemMessage{}
+	mi := &file_canvases_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CanvasEventWithExecutions) String() string {
+func (x *CanvasNodeQueueItemMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CanvasEventWithExecutions) ProtoMessage() {}
+func (*CanvasNodeQueueItemMessage) ProtoMessage() {}
 
-func (x *CanvasEventWithExecutions) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[65]
+func (x *CanvasNodeQueueItemMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4299,90 +7769,123 @@ func (x *CanvasEventWithExecutions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CanvasEventWithExecutions.ProtoReflect.Descriptor instead.
-func (*CanvasEventWithExecutions) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{65}
+// Deprecated: Use CanvasNodeQueueItemMessage.ProtoReflect.Descriptor instead.
+func (*CanvasNodeQueueItemMessage) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{123}
 }
 
-func (x *CanvasEventWithExecutions) GetId() string {
+func (x *CanvasNodeQueueItemMessage) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *CanvasEventWithExecutions) GetCanvasId() string {
+func (x *CanvasNodeQueueItemMessage) GetCanvasId() string {
 	if x != nil {
 		return x.CanvasId
 	}
 	return ""
 }
 
-func (x *CanvasEventWithExecutions) GetNodeId() string {
+func (x *CanvasNodeQueueItemMessage) GetNodeId() string {
 	if x != nil {
 		return x.NodeId
 	}
 	return ""
 }
 
-func (x *CanvasEventWithExecutions) GetChannel() string {
+func (x *CanvasNodeQueueItemMessage) GetTimestamp() *timestamp.Timestamp {
 	if x != nil {
-		return x.Channel
+		return x.Timestamp
 	}
-	return ""
+	return nil
 }
 
-func (x *CanvasEventWithExecutions) GetData() *_struct.Struct {
+type CanvasMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CanvasId      string                 `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasMessage) Reset() {
+	*x = CanvasMessage{}
+	mi := &file_canvases_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasMessage) ProtoMessage() {}
+
+func (x *CanvasMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[124]
 	if x != nil {
-		return x.Data
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *CanvasEventWithExecutions) GetCreatedAt() *timestamp.Timestamp {
+// Deprecated: Use CanvasMessage.ProtoReflect.Descriptor instead.
+func (*CanvasMessage) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *CanvasMessage) GetId() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *CanvasEventWithExecutions) GetExecutions() []*CanvasNodeExecution {
+func (x *CanvasMessage) GetCanvasId() string {
 	if x != nil {
-		return x.Executions
+		return x.CanvasId
 	}
-	return nil
+	return ""
 }
 
-func (x *CanvasEventWithExecutions) GetCustomName() string {
+func (x *CanvasMessage) GetTimestamp() *timestamp.Timestamp {
 	if x != nil {
-		return x.CustomName
+		return x.Timestamp
 	}
-	return ""
+	return nil
 }
 
-type ListEventExecutionsRequest struct {
+type CanvasVersionMessage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	VersionId     string                 `protobuf:"bytes,2,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListEventExecutionsRequest) Reset() {
-	*x = ListEventExecutionsRequest{}
-	mi := &file_canvases_proto_msgTypes[66]
+func (x *CanvasVersionMessage) Reset() {
+	*x = CanvasVersionMessage{}
+	mi := &file_canvases_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEventExecutionsRequest) String() string {
+func (x *CanvasVersionMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEventExecutionsRequest) ProtoMessage() {}
+func (*CanvasVersionMessage) ProtoMessage() {}
 
-func (x *ListEventExecutionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[66]
+func (x *CanvasVersionMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4393,47 +7896,55 @@ func (x *ListEventExecutionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEventExecutionsRequest.ProtoReflect.Descriptor instead.
-func (*ListEventExecutionsRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{66}
+// Deprecated: Use CanvasVersionMessage.ProtoReflect.Descriptor instead.
+func (*CanvasVersionMessage) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{125}
 }
 
-func (x *ListEventExecutionsRequest) GetCanvasId() string {
+func (x *CanvasVersionMessage) GetCanvasId() string {
 	if x != nil {
 		return x.CanvasId
 	}
 	return ""
 }
 
-func (x *ListEventExecutionsRequest) GetEventId() string {
+func (x *CanvasVersionMessage) GetVersionId() string {
 	if x != nil {
-		return x.EventId
+		return x.VersionId
 	}
 	return ""
 }
 
-type ListEventExecutionsResponse struct {
+func (x *CanvasVersionMessage) GetTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type CanvasBundle_Metadata struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Executions    []*CanvasNodeExecution `protobuf:"bytes,1,rep,name=executions,proto3" json:"executions,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListEventExecutionsResponse) Reset() {
-	*x = ListEventExecutionsResponse{}
-	mi := &file_canvases_proto_msgTypes[67]
+func (x *CanvasBundle_Metadata) Reset() {
+	*x = CanvasBundle_Metadata{}
+	mi := &file_canvases_proto_msgTypes[126]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEventExecutionsResponse) String() string {
+func (x *CanvasBundle_Metadata) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEventExecutionsResponse) ProtoMessage() {}
+func (*CanvasBundle_Metadata) ProtoMessage() {}
 
-func (x *ListEventExecutionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[67]
+func (x *CanvasBundle_Metadata) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[126]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4444,41 +7955,48 @@ func (x *ListEventExecutionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEventExecutionsResponse.ProtoReflect.Descriptor instead.
-func (*ListEventExecutionsResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{67}
+// Deprecated: Use CanvasBundle_Metadata.ProtoReflect.Descriptor instead.
+func (*CanvasBundle_Metadata) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{33, 0}
 }
 
-func (x *ListEventExecutionsResponse) GetExecutions() []*CanvasNodeExecution {
+func (x *CanvasBundle_Metadata) GetName() string {
 	if x != nil {
-		return x.Executions
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type CancelExecutionRequest struct {
+func (x *CanvasBundle_Metadata) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type CanvasBundle_IntegrationReference struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	ExecutionId   string                 `protobuf:"bytes,2,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelExecutionRequest) Reset() {
-	*x = CancelExecutionRequest{}
-	mi := &file_canvases_proto_msgTypes[68]
+func (x *CanvasBundle_IntegrationReference) Reset() {
+	*x = CanvasBundle_IntegrationReference{}
+	mi := &file_canvases_proto_msgTypes[127]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelExecutionRequest) String() string {
+func (x *CanvasBundle_IntegrationReference) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelExecutionRequest) ProtoMessage() {}
+func (*CanvasBundle_IntegrationReference) ProtoMessage() {}
 
-func (x *CancelExecutionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[68]
+func (x *CanvasBundle_IntegrationReference) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[127]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4489,46 +8007,48 @@ func (x *CancelExecutionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelExecutionRequest.ProtoReflect.Descriptor instead.
-func (*CancelExecutionRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{68}
+// Deprecated: Use CanvasBundle_IntegrationReference.ProtoReflect.Descriptor instead.
+func (*CanvasBundle_IntegrationReference) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{33, 1}
 }
 
-func (x *CancelExecutionRequest) GetCanvasId() string {
+func (x *CanvasBundle_IntegrationReference) GetName() string {
 	if x != nil {
-		return x.CanvasId
+		return x.Name
 	}
 	return ""
 }
 
-func (x *CancelExecutionRequest) GetExecutionId() string {
+func (x *CanvasBundle_IntegrationReference) GetType() string {
 	if x != nil {
-		return x.ExecutionId
+		return x.Type
 	}
 	return ""
 }
 
-type CancelExecutionResponse struct {
+type CanvasBundle_SecretReference struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Keys          []string               `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelExecutionResponse) Reset() {
-	*x = CancelExecutionResponse{}
-	mi := &file_canvases_proto_msgTypes[69]
+func (x *CanvasBundle_SecretReference) Reset() {
+	*x = CanvasBundle_SecretReference{}
+	mi := &file_canvases_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelExecutionResponse) String() string {
+func (x *CanvasBundle_SecretReference) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelExecutionResponse) ProtoMessage() {}
+func (*CanvasBundle_SecretReference) ProtoMessage() {}
 
-func (x *CancelExecutionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[69]
+func (x *CanvasBundle_SecretReference) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4539,34 +8059,48 @@ func (x *CancelExecutionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelExecutionResponse.ProtoReflect.Descriptor instead.
-func (*CancelExecutionResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{69}
+// Deprecated: Use CanvasBundle_SecretReference.ProtoReflect.Descriptor instead.
+func (*CanvasBundle_SecretReference) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{33, 2}
 }
 
-type ResolveExecutionErrorsRequest struct {
+func (x *CanvasBundle_SecretReference) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CanvasBundle_SecretReference) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type ImportCanvasRequest_IntegrationMapping struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	ExecutionIds  []string               `protobuf:"bytes,2,rep,name=execution_ids,json=executionIds,proto3" json:"execution_ids,omitempty"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResolveExecutionErrorsRequest) Reset() {
-	*x = ResolveExecutionErrorsRequest{}
-	mi := &file_canvases_proto_msgTypes[70]
+func (x *ImportCanvasRequest_IntegrationMapping) Reset() {
+	*x = ImportCanvasRequest_IntegrationMapping{}
+	mi := &file_canvases_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResolveExecutionErrorsRequest) String() string {
+func (x *ImportCanvasRequest_IntegrationMapping) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResolveExecutionErrorsRequest) ProtoMessage() {}
+func (*ImportCanvasRequest_IntegrationMapping) ProtoMessage() {}
 
-func (x *ResolveExecutionErrorsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[70]
+func (x *ImportCanvasRequest_IntegrationMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4577,46 +8111,61 @@ func (x *ResolveExecutionErrorsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResolveExecutionErrorsRequest.ProtoReflect.Descriptor instead.
-func (*ResolveExecutionErrorsRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{70}
+// Deprecated: Use ImportCanvasRequest_IntegrationMapping.ProtoReflect.Descriptor instead.
+func (*ImportCanvasRequest_IntegrationMapping) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{36, 0}
 }
 
-func (x *ResolveExecutionErrorsRequest) GetCanvasId() string {
+func (x *ImportCanvasRequest_IntegrationMapping) GetFrom() string {
 	if x != nil {
-		return x.CanvasId
+		return x.From
 	}
 	return ""
 }
 
-func (x *ResolveExecutionErrorsRequest) GetExecutionIds() []string {
+func (x *ImportCanvasRequest_IntegrationMapping) GetTo() string {
 	if x != nil {
-		return x.ExecutionIds
+		return x.To
 	}
-	return nil
+	return ""
 }
 
-type ResolveExecutionErrorsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type Canvas_Metadata struct {
+	state                       protoimpl.MessageState             `protogen:"open.v1"`
+	Id                          string                             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrganizationId              string                             `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Name                        string                             `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description                 string                             `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt                   *timestamp.Timestamp               `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt                   *timestamp.Timestamp               `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedBy                   *UserRef                           `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	IsTemplate                  bool                               `protobuf:"varint,8,opt,name=is_template,json=isTemplate,proto3" json:"is_template,omitempty"`
+	VersioningEnabled           bool                               `protobuf:"varint,9,opt,name=versioning_enabled,json=versioningEnabled,proto3" json:"versioning_enabled,omitempty"`
+	ChangeRequestApprovalConfig *CanvasChangeRequestApprovalConfig `protobuf:"bytes,10,opt,name=change_request_approval_config,json=changeRequestApprovalConfig,proto3" json:"change_request_approval_config,omitempty"`
+	// Environment the canvas runs in, like staging or production.
+	// Variable overrides for this environment are applied.
+	Environment                 string                             `protobuf:"bytes,11,opt,name=environment,proto3" json:"environment,omitempty"`
+	ChangeRequestCheckConfig    *CanvasChangeRequestCheckConfig    `protobuf:"bytes,12,opt,name=change_request_check_config,json=changeRequestCheckConfig,proto3" json:"change_request_check_config,omitempty"`
+	NodeActionPermissionsConfig *CanvasNodeActionPermissionsConfig `protobuf:"bytes,13,opt,name=node_action_permissions_config,json=nodeActionPermissionsConfig,proto3" json:"node_action_permissions_config,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
 }
 
-func (x *ResolveExecutionErrorsResponse) Reset() {
-	*x = ResolveExecutionErrorsResponse{}
-	mi := &file_canvases_proto_msgTypes[71]
+func (x *Canvas_Metadata) Reset() {
+	*x = Canvas_Metadata{}
+	mi := &file_canvases_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResolveExecutionErrorsResponse) String() string {
+func (x *Canvas_Metadata) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResolveExecutionErrorsResponse) ProtoMessage() {}
+func (*Canvas_Metadata) ProtoMessage() {}
 
-func (x *ResolveExecutionErrorsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[71]
+func (x *Canvas_Metadata) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4627,104 +8176,126 @@ func (x *ResolveExecutionErrorsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResolveExecutionErrorsResponse.ProtoReflect.Descriptor instead.
-func (*ResolveExecutionErrorsResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{71}
+// Deprecated: Use Canvas_Metadata.ProtoReflect.Descriptor instead.
+func (*Canvas_Metadata) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{39, 0}
 }
 
-type CanvasNodeEventMessage struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CanvasId      string                 `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	NodeId        string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Canvas_Metadata) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
 }
 
-func (x *CanvasNodeEventMessage) Reset() {
-	*x = CanvasNodeEventMessage{}
-	mi := &file_canvases_proto_msgTypes[72]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Canvas_Metadata) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
 }
 
-func (x *CanvasNodeEventMessage) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Canvas_Metadata) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (*CanvasNodeEventMessage) ProtoMessage() {}
+func (x *Canvas_Metadata) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
 
-func (x *CanvasNodeEventMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[72]
+func (x *Canvas_Metadata) GetCreatedAt() *timestamp.Timestamp {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.CreatedAt
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use CanvasNodeEventMessage.ProtoReflect.Descriptor instead.
-func (*CanvasNodeEventMessage) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{72}
+func (x *Canvas_Metadata) GetUpdatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
 }
 
-func (x *CanvasNodeEventMessage) GetId() string {
+func (x *Canvas_Metadata) GetCreatedBy() *UserRef {
 	if x != nil {
-		return x.Id
+		return x.CreatedBy
 	}
-	return ""
+	return nil
 }
 
-func (x *CanvasNodeEventMessage) GetCanvasId() string {
+func (x *Canvas_Metadata) GetIsTemplate() bool {
 	if x != nil {
-		return x.CanvasId
+		return x.IsTemplate
 	}
-	return ""
+	return false
 }
 
-func (x *CanvasNodeEventMessage) GetNodeId() string {
+func (x *Canvas_Metadata) GetVersioningEnabled() bool {
 	if x != nil {
-		return x.NodeId
+		return x.VersioningEnabled
+	}
+	return false
+}
+
+func (x *Canvas_Metadata) GetChangeRequestApprovalConfig() *CanvasChangeRequestApprovalConfig {
+	if x != nil {
+		return x.ChangeRequestApprovalConfig
+	}
+	return nil
+}
+
+func (x *Canvas_Metadata) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
 	}
 	return ""
 }
 
-func (x *CanvasNodeEventMessage) GetTimestamp() *timestamp.Timestamp {
+func (x *Canvas_Metadata) GetChangeRequestCheckConfig() *CanvasChangeRequestCheckConfig {
 	if x != nil {
-		return x.Timestamp
+		return x.ChangeRequestCheckConfig
 	}
 	return nil
 }
 
-type CanvasNodeExecutionMessage struct {
+func (x *Canvas_Metadata) GetNodeActionPermissionsConfig() *CanvasNodeActionPermissionsConfig {
+	if x != nil {
+		return x.NodeActionPermissionsConfig
+	}
+	return nil
+}
+
+type Canvas_Spec struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CanvasId      string                 `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	NodeId        string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Nodes         []*components.Node     `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Edges         []*components.Edge     `protobuf:"bytes,2,rep,name=edges,proto3" json:"edges,omitempty"`
+	Variables     []*CanvasVariable      `protobuf:"bytes,3,rep,name=variables,proto3" json:"variables,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CanvasNodeExecutionMessage) Reset() {
-	*x = CanvasNodeExecutionMessage{}
-	mi := &file_canvases_proto_msgTypes[73]
+func (x *Canvas_Spec) Reset() {
+	*x = Canvas_Spec{}
+	mi := &file_canvases_proto_msgTypes[131]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CanvasNodeExecutionMessage) String() string {
+func (x *Canvas_Spec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CanvasNodeExecutionMessage) ProtoMessage() {}
+func (*Canvas_Spec) ProtoMessage() {}
 
-func (x *CanvasNodeExecutionMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[73]
+func (x *Canvas_Spec) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[131]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4735,64 +8306,56 @@ func (x *CanvasNodeExecutionMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CanvasNodeExecutionMessage.ProtoReflect.Descriptor instead.
-func (*CanvasNodeExecutionMessage) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{73}
-}
-
-func (x *CanvasNodeExecutionMessage) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use Canvas_Spec.ProtoReflect.Descriptor instead.
+func (*Canvas_Spec) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{39, 1}
 }
 
-func (x *CanvasNodeExecutionMessage) GetCanvasId() string {
+func (x *Canvas_Spec) GetNodes() []*components.Node {
 	if x != nil {
-		return x.CanvasId
+		return x.Nodes
 	}
-	return ""
+	return nil
 }
 
-func (x *CanvasNodeExecutionMessage) GetNodeId() string {
+func (x *Canvas_Spec) GetEdges() []*components.Edge {
 	if x != nil {
-		return x.NodeId
+		return x.Edges
 	}
-	return ""
+	return nil
 }
 
-func (x *CanvasNodeExecutionMessage) GetTimestamp() *timestamp.Timestamp {
+func (x *Canvas_Spec) GetVariables() []*CanvasVariable {
 	if x != nil {
-		return x.Timestamp
+		return x.Variables
 	}
 	return nil
 }
 
-type CanvasNodeQueueItemMessage struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CanvasId      string                 `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	NodeId        string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type Canvas_Status struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	LastExecutions []*CanvasNodeExecution `protobuf:"bytes,1,rep,name=last_executions,json=lastExecutions,proto3" json:"last_executions,omitempty"`
+	NextQueueItems []*CanvasNodeQueueItem `protobuf:"bytes,2,rep,name=next_queue_items,json=nextQueueItems,proto3" json:"next_queue_items,omitempty"`
+	LastEvents     []*CanvasEvent         `protobuf:"bytes,3,rep,name=last_events,json=lastEvents,proto3" json:"last_events,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *CanvasNodeQueueItemMessage) Reset() {
-	*x = CanvasNodeQueueItemMessage{}
-	mi := &file_canvases_proto_msgTypes[74]
+func (x *Canvas_Status) Reset() {
+	*x = Canvas_Status{}
+	mi := &file_canvases_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CanvasNodeQueueItemMessage) String() string {
+func (x *Canvas_Status) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CanvasNodeQueueItemMessage) ProtoMessage() {}
+func (*Canvas_Status) ProtoMessage() {}
 
-func (x *CanvasNodeQueueItemMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[74]
+func (x *Canvas_Status) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4803,63 +8366,55 @@ func (x *CanvasNodeQueueItemMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CanvasNodeQueueItemMessage.ProtoReflect.Descriptor instead.
-func (*CanvasNodeQueueItemMessage) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{74}
-}
-
-func (x *CanvasNodeQueueItemMessage) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use Canvas_Status.ProtoReflect.Descriptor instead.
+func (*Canvas_Status) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{39, 2}
 }
 
-func (x *CanvasNodeQueueItemMessage) GetCanvasId() string {
+func (x *Canvas_Status) GetLastExecutions() []*CanvasNodeExecution {
 	if x != nil {
-		return x.CanvasId
+		return x.LastExecutions
 	}
-	return ""
+	return nil
 }
 
-func (x *CanvasNodeQueueItemMessage) GetNodeId() string {
+func (x *Canvas_Status) GetNextQueueItems() []*CanvasNodeQueueItem {
 	if x != nil {
-		return x.NodeId
+		return x.NextQueueItems
 	}
-	return ""
+	return nil
 }
 
-func (x *CanvasNodeQueueItemMessage) GetTimestamp() *timestamp.Timestamp {
+func (x *Canvas_Status) GetLastEvents() []*CanvasEvent {
 	if x != nil {
-		return x.Timestamp
+		return x.LastEvents
 	}
 	return nil
 }
 
-type CanvasMessage struct {
+type CanvasVariable_SecretRef struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CanvasId      string                 `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Secret        string                 `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CanvasMessage) Reset() {
-	*x = CanvasMessage{}
-	mi := &file_canvases_proto_msgTypes[75]
+func (x *CanvasVariable_SecretRef) Reset() {
+	*x = CanvasVariable_SecretRef{}
+	mi := &file_canvases_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CanvasMessage) String() string {
+func (x *CanvasVariable_SecretRef) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CanvasMessage) ProtoMessage() {}
+func (*CanvasVariable_SecretRef) ProtoMessage() {}
 
-func (x *CanvasMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[75]
+func (x *CanvasVariable_SecretRef) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4870,56 +8425,49 @@ func (x *CanvasMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CanvasMessage.ProtoReflect.Descriptor instead.
-func (*CanvasMessage) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{75}
+// Deprecated: Use CanvasVariable_SecretRef.ProtoReflect.Descriptor instead.
+func (*CanvasVariable_SecretRef) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{40, 0}
 }
 
-func (x *CanvasMessage) GetId() string {
+func (x *CanvasVariable_SecretRef) GetSecret() string {
 	if x != nil {
-		return x.Id
+		return x.Secret
 	}
 	return ""
 }
 
-func (x *CanvasMessage) GetCanvasId() string {
+func (x *CanvasVariable_SecretRef) GetKey() string {
 	if x != nil {
-		return x.CanvasId
+		return x.Key
 	}
 	return ""
 }
 
-func (x *CanvasMessage) GetTimestamp() *timestamp.Timestamp {
-	if x != nil {
-		return x.Timestamp
-	}
-	return nil
-}
-
-type CanvasVersionMessage struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	VersionId     string                 `protobuf:"bytes,2,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
-	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+type CanvasVariable_Override struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Environment   string                    `protobuf:"bytes,1,opt,name=environment,proto3" json:"environment,omitempty"`
+	Value         string                    `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Secret        *CanvasVariable_SecretRef `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CanvasVersionMessage) Reset() {
-	*x = CanvasVersionMessage{}
-	mi := &file_canvases_proto_msgTypes[76]
+func (x *CanvasVariable_Override) Reset() {
+	*x = CanvasVariable_Override{}
+	mi := &file_canvases_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CanvasVersionMessage) String() string {
+func (x *CanvasVariable_Override) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CanvasVersionMessage) ProtoMessage() {}
+func (*CanvasVariable_Override) ProtoMessage() {}
 
-func (x *CanvasVersionMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[76]
+func (x *CanvasVariable_Override) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4930,63 +8478,60 @@ func (x *CanvasVersionMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CanvasVersionMessage.ProtoReflect.Descriptor instead.
-func (*CanvasVersionMessage) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{76}
+// Deprecated: Use CanvasVariable_Override.ProtoReflect.Descriptor instead.
+func (*CanvasVariable_Override) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{40, 1}
 }
 
-func (x *CanvasVersionMessage) GetCanvasId() string {
+func (x *CanvasVariable_Override) GetEnvironment() string {
 	if x != nil {
-		return x.CanvasId
+		return x.Environment
 	}
 	return ""
 }
 
-func (x *CanvasVersionMessage) GetVersionId() string {
+func (x *CanvasVariable_Override) GetValue() string {
 	if x != nil {
-		return x.VersionId
+		return x.Value
 	}
 	return ""
 }
 
-func (x *CanvasVersionMessage) GetTimestamp() *timestamp.Timestamp {
+func (x *CanvasVariable_Override) GetSecret() *CanvasVariable_SecretRef {
 	if x != nil {
-		return x.Timestamp
+		return x.Secret
 	}
 	return nil
 }
 
-type Canvas_Metadata struct {
-	state                       protoimpl.MessageState             `protogen:"open.v1"`
-	Id                          string                             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	OrganizationId              string                             `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	Name                        string                             `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Description                 string                             `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	CreatedAt                   *timestamp.Timestamp               `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt                   *timestamp.Timestamp               `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	CreatedBy                   *UserRef                           `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	IsTemplate                  bool                               `protobuf:"varint,8,opt,name=is_template,json=isTemplate,proto3" json:"is_template,omitempty"`
-	VersioningEnabled           bool                               `protobuf:"varint,9,opt,name=versioning_enabled,json=versioningEnabled,proto3" json:"versioning_enabled,omitempty"`
-	ChangeRequestApprovalConfig *CanvasChangeRequestApprovalConfig `protobuf:"bytes,10,opt,name=change_request_approval_config,json=changeRequestApprovalConfig,proto3" json:"change_request_approval_config,omitempty"`
-	unknownFields               protoimpl.UnknownFields
-	sizeCache                   protoimpl.SizeCache
+type CanvasVersion_Metadata struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CanvasId      string                 `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	Owner         *UserRef               `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	IsPublished   bool                   `protobuf:"varint,6,opt,name=is_published,json=isPublished,proto3" json:"is_published,omitempty"`
+	PublishedAt   *timestamp.Timestamp   `protobuf:"bytes,7,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
+	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamp.Timestamp   `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Canvas_Metadata) Reset() {
-	*x = Canvas_Metadata{}
-	mi := &file_canvases_proto_msgTypes[77]
+func (x *CanvasVersion_Metadata) Reset() {
+	*x = CanvasVersion_Metadata{}
+	mi := &file_canvases_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Canvas_Metadata) String() string {
+func (x *CanvasVersion_Metadata) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Canvas_Metadata) ProtoMessage() {}
+func (*CanvasVersion_Metadata) ProtoMessage() {}
 
-func (x *Canvas_Metadata) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[77]
+func (x *CanvasVersion_Metadata) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4997,104 +8542,147 @@ func (x *Canvas_Metadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Canvas_Metadata.ProtoReflect.Descriptor instead.
-func (*Canvas_Metadata) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{30, 0}
+// Deprecated: Use CanvasVersion_Metadata.ProtoReflect.Descriptor instead.
+func (*CanvasVersion_Metadata) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{41, 0}
 }
 
-func (x *Canvas_Metadata) GetId() string {
+func (x *CanvasVersion_Metadata) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Canvas_Metadata) GetOrganizationId() string {
+func (x *CanvasVersion_Metadata) GetCanvasId() string {
 	if x != nil {
-		return x.OrganizationId
+		return x.CanvasId
 	}
 	return ""
 }
 
-func (x *Canvas_Metadata) GetName() string {
+func (x *CanvasVersion_Metadata) GetOwner() *UserRef {
 	if x != nil {
-		return x.Name
+		return x.Owner
 	}
-	return ""
+	return nil
 }
 
-func (x *Canvas_Metadata) GetDescription() string {
+func (x *CanvasVersion_Metadata) GetIsPublished() bool {
 	if x != nil {
-		return x.Description
+		return x.IsPublished
 	}
-	return ""
+	return false
 }
 
-func (x *Canvas_Metadata) GetCreatedAt() *timestamp.Timestamp {
+func (x *CanvasVersion_Metadata) GetPublishedAt() *timestamp.Timestamp {
 	if x != nil {
-		return x.CreatedAt
+		return x.PublishedAt
 	}
 	return nil
 }
 
-func (x *Canvas_Metadata) GetUpdatedAt() *timestamp.Timestamp {
+func (x *CanvasVersion_Metadata) GetCreatedAt() *timestamp.Timestamp {
 	if x != nil {
-		return x.UpdatedAt
+		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *Canvas_Metadata) GetCreatedBy() *UserRef {
+func (x *CanvasVersion_Metadata) GetUpdatedAt() *timestamp.Timestamp {
 	if x != nil {
-		return x.CreatedBy
+		return x.UpdatedAt
 	}
 	return nil
 }
 
-func (x *Canvas_Metadata) GetIsTemplate() bool {
+// Values are JSON encoded, and empty
+// when the field is not set on that side.
+type CanvasVersionDiff_FieldChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Before        string                 `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	After         string                 `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasVersionDiff_FieldChange) Reset() {
+	*x = CanvasVersionDiff_FieldChange{}
+	mi := &file_canvases_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasVersionDiff_FieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasVersionDiff_FieldChange) ProtoMessage() {}
+
+func (x *CanvasVersionDiff_FieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[136]
 	if x != nil {
-		return x.IsTemplate
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *Canvas_Metadata) GetVersioningEnabled() bool {
+// Deprecated: Use CanvasVersionDiff_FieldChange.ProtoReflect.Descriptor instead.
+func (*CanvasVersionDiff_FieldChange) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{42, 0}
+}
+
+func (x *CanvasVersionDiff_FieldChange) GetPath() string {
 	if x != nil {
-		return x.VersioningEnabled
+		return x.Path
 	}
-	return false
+	return ""
 }
 
-func (x *Canvas_Metadata) GetChangeRequestApprovalConfig() *CanvasChangeRequestApprovalConfig {
+func (x *CanvasVersionDiff_FieldChange) GetBefore() string {
 	if x != nil {
-		return x.ChangeRequestApprovalConfig
+		return x.Before
 	}
-	return nil
+	return ""
 }
 
-type Canvas_Spec struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Nodes         []*components.Node     `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
-	Edges         []*components.Edge     `protobuf:"bytes,2,rep,name=edges,proto3" json:"edges,omitempty"`
+func (x *CanvasVersionDiff_FieldChange) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+type CanvasVersionDiff_NodeChange struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	NodeId        string                           `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	NodeName      string                           `protobuf:"bytes,2,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	Type          CanvasVersionDiff_ChangeType     `protobuf:"varint,3,opt,name=type,proto3,enum=Superplane.Canvases.CanvasVersionDiff_ChangeType" json:"type,omitempty"`
+	Fields        []*CanvasVersionDiff_FieldChange `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Canvas_Spec) Reset() {
-	*x = Canvas_Spec{}
-	mi := &file_canvases_proto_msgTypes[78]
+func (x *CanvasVersionDiff_NodeChange) Reset() {
+	*x = CanvasVersionDiff_NodeChange{}
+	mi := &file_canvases_proto_msgTypes[137]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Canvas_Spec) String() string {
+func (x *CanvasVersionDiff_NodeChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Canvas_Spec) ProtoMessage() {}
+func (*CanvasVersionDiff_NodeChange) ProtoMessage() {}
 
-func (x *Canvas_Spec) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[78]
+func (x *CanvasVersionDiff_NodeChange) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[137]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5105,49 +8693,64 @@ func (x *Canvas_Spec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Canvas_Spec.ProtoReflect.Descriptor instead.
-func (*Canvas_Spec) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{30, 1}
+// Deprecated: Use CanvasVersionDiff_NodeChange.ProtoReflect.Descriptor instead.
+func (*CanvasVersionDiff_NodeChange) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{42, 1}
 }
 
-func (x *Canvas_Spec) GetNodes() []*components.Node {
+func (x *CanvasVersionDiff_NodeChange) GetNodeId() string {
 	if x != nil {
-		return x.Nodes
+		return x.NodeId
 	}
-	return nil
+	return ""
 }
 
-func (x *Canvas_Spec) GetEdges() []*components.Edge {
+func (x *CanvasVersionDiff_NodeChange) GetNodeName() string {
 	if x != nil {
-		return x.Edges
+		return x.NodeName
+	}
+	return ""
+}
+
+func (x *CanvasVersionDiff_NodeChange) GetType() CanvasVersionDiff_ChangeType {
+	if x != nil {
+		return x.Type
+	}
+	return CanvasVersionDiff_CHANGE_TYPE_UNSPECIFIED
+}
+
+func (x *CanvasVersionDiff_NodeChange) GetFields() []*CanvasVersionDiff_FieldChange {
+	if x != nil {
+		return x.Fields
 	}
 	return nil
 }
 
-type Canvas_Status struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	LastExecutions []*CanvasNodeExecution `protobuf:"bytes,1,rep,name=last_executions,json=lastExecutions,proto3" json:"last_executions,omitempty"`
-	NextQueueItems []*CanvasNodeQueueItem `protobuf:"bytes,2,rep,name=next_queue_items,json=nextQueueItems,proto3" json:"next_queue_items,omitempty"`
-	LastEvents     []*CanvasEvent         `protobuf:"bytes,3,rep,name=last_events,json=lastEvents,proto3" json:"last_events,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type CanvasVersionDiff_EdgeChange struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Type          CanvasVersionDiff_ChangeType `protobuf:"varint,1,opt,name=type,proto3,enum=Superplane.Canvases.CanvasVersionDiff_ChangeType" json:"type,omitempty"`
+	SourceId      string                       `protobuf:"bytes,2,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	TargetId      string                       `protobuf:"bytes,3,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Channel       string                       `protobuf:"bytes,4,opt,name=channel,proto3" json:"channel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Canvas_Status) Reset() {
-	*x = Canvas_Status{}
-	mi := &file_canvases_proto_msgTypes[79]
+func (x *CanvasVersionDiff_EdgeChange) Reset() {
+	*x = CanvasVersionDiff_EdgeChange{}
+	mi := &file_canvases_proto_msgTypes[138]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Canvas_Status) String() string {
+func (x *CanvasVersionDiff_EdgeChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Canvas_Status) ProtoMessage() {}
+func (*CanvasVersionDiff_EdgeChange) ProtoMessage() {}
 
-func (x *Canvas_Status) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[79]
+func (x *CanvasVersionDiff_EdgeChange) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[138]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5158,60 +8761,63 @@ func (x *Canvas_Status) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Canvas_Status.ProtoReflect.Descriptor instead.
-func (*Canvas_Status) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{30, 2}
+// Deprecated: Use CanvasVersionDiff_EdgeChange.ProtoReflect.Descriptor instead.
+func (*CanvasVersionDiff_EdgeChange) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{42, 2}
 }
 
-func (x *Canvas_Status) GetLastExecutions() []*CanvasNodeExecution {
+func (x *CanvasVersionDiff_EdgeChange) GetType() CanvasVersionDiff_ChangeType {
 	if x != nil {
-		return x.LastExecutions
+		return x.Type
 	}
-	return nil
+	return CanvasVersionDiff_CHANGE_TYPE_UNSPECIFIED
 }
 
-func (x *Canvas_Status) GetNextQueueItems() []*CanvasNodeQueueItem {
+func (x *CanvasVersionDiff_EdgeChange) GetSourceId() string {
 	if x != nil {
-		return x.NextQueueItems
+		return x.SourceId
 	}
-	return nil
+	return ""
 }
 
-func (x *Canvas_Status) GetLastEvents() []*CanvasEvent {
+func (x *CanvasVersionDiff_EdgeChange) GetTargetId() string {
 	if x != nil {
-		return x.LastEvents
+		return x.TargetId
 	}
-	return nil
+	return ""
 }
 
-type CanvasVersion_Metadata struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CanvasId      string                 `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	Owner         *UserRef               `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
-	IsPublished   bool                   `protobuf:"varint,6,opt,name=is_published,json=isPublished,proto3" json:"is_published,omitempty"`
-	PublishedAt   *timestamp.Timestamp   `protobuf:"bytes,7,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
-	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamp.Timestamp   `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+func (x *CanvasVersionDiff_EdgeChange) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+type CanvasVersionDiff_VariableChange struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	Name          string                           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type          CanvasVersionDiff_ChangeType     `protobuf:"varint,2,opt,name=type,proto3,enum=Superplane.Canvases.CanvasVersionDiff_ChangeType" json:"type,omitempty"`
+	Fields        []*CanvasVersionDiff_FieldChange `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CanvasVersion_Metadata) Reset() {
-	*x = CanvasVersion_Metadata{}
-	mi := &file_canvases_proto_msgTypes[80]
+func (x *CanvasVersionDiff_VariableChange) Reset() {
+	*x = CanvasVersionDiff_VariableChange{}
+	mi := &file_canvases_proto_msgTypes[139]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CanvasVersion_Metadata) String() string {
+func (x *CanvasVersionDiff_VariableChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CanvasVersion_Metadata) ProtoMessage() {}
+func (*CanvasVersionDiff_VariableChange) ProtoMessage() {}
 
-func (x *CanvasVersion_Metadata) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[80]
+func (x *CanvasVersionDiff_VariableChange) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[139]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5222,58 +8828,82 @@ func (x *CanvasVersion_Metadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CanvasVersion_Metadata.ProtoReflect.Descriptor instead.
-func (*CanvasVersion_Metadata) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{31, 0}
+// Deprecated: Use CanvasVersionDiff_VariableChange.ProtoReflect.Descriptor instead.
+func (*CanvasVersionDiff_VariableChange) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{42, 3}
 }
 
-func (x *CanvasVersion_Metadata) GetId() string {
+func (x *CanvasVersionDiff_VariableChange) GetName() string {
 	if x != nil {
-		return x.Id
+		return x.Name
 	}
 	return ""
 }
 
-func (x *CanvasVersion_Metadata) GetCanvasId() string {
+func (x *CanvasVersionDiff_VariableChange) GetType() CanvasVersionDiff_ChangeType {
 	if x != nil {
-		return x.CanvasId
+		return x.Type
 	}
-	return ""
+	return CanvasVersionDiff_CHANGE_TYPE_UNSPECIFIED
 }
 
-func (x *CanvasVersion_Metadata) GetOwner() *UserRef {
+func (x *CanvasVersionDiff_VariableChange) GetFields() []*CanvasVersionDiff_FieldChange {
 	if x != nil {
-		return x.Owner
+		return x.Fields
 	}
 	return nil
 }
 
-func (x *CanvasVersion_Metadata) GetIsPublished() bool {
-	if x != nil {
-		return x.IsPublished
-	}
-	return false
+type CanvasChangeRequestCheck_Problem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CanvasVersion_Metadata) GetPublishedAt() *timestamp.Timestamp {
+func (x *CanvasChangeRequestCheck_Problem) Reset() {
+	*x = CanvasChangeRequestCheck_Problem{}
+	mi := &file_canvases_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasChangeRequestCheck_Problem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasChangeRequestCheck_Problem) ProtoMessage() {}
+
+func (x *CanvasChangeRequestCheck_Problem) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[140]
 	if x != nil {
-		return x.PublishedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *CanvasVersion_Metadata) GetCreatedAt() *timestamp.Timestamp {
+// Deprecated: Use CanvasChangeRequestCheck_Problem.ProtoReflect.Descriptor instead.
+func (*CanvasChangeRequestCheck_Problem) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{49, 0}
+}
+
+func (x *CanvasChangeRequestCheck_Problem) GetNodeId() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.NodeId
 	}
-	return nil
+	return ""
 }
 
-func (x *CanvasVersion_Metadata) GetUpdatedAt() *timestamp.Timestamp {
+func (x *CanvasChangeRequestCheck_Problem) GetMessage() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
 type CanvasChangeRequest_Metadata struct {
@@ -5296,7 +8926,7 @@ type CanvasChangeRequest_Metadata struct {
 
 func (x *CanvasChangeRequest_Metadata) Reset() {
 	*x = CanvasChangeRequest_Metadata{}
-	mi := &file_canvases_proto_msgTypes[81]
+	mi := &file_canvases_proto_msgTypes[141]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5308,7 +8938,7 @@ func (x *CanvasChangeRequest_Metadata) String() string {
 func (*CanvasChangeRequest_Metadata) ProtoMessage() {}
 
 func (x *CanvasChangeRequest_Metadata) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[81]
+	mi := &file_canvases_proto_msgTypes[141]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5321,7 +8951,7 @@ func (x *CanvasChangeRequest_Metadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CanvasChangeRequest_Metadata.ProtoReflect.Descriptor instead.
 func (*CanvasChangeRequest_Metadata) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{36, 0}
+	return file_canvases_proto_rawDescGZIP(), []int{51, 0}
 }
 
 func (x *CanvasChangeRequest_Metadata) GetId() string {
@@ -5408,11 +9038,150 @@ func (x *CanvasChangeRequest_Metadata) GetIsConflicted() bool {
 	return false
 }
 
+type CanvasMemoryNamespace_Field struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// One of string, number, boolean, object or list.
+	Type          string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Required      bool   `protobuf:"varint,3,opt,name=required,proto3" json:"required,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasMemoryNamespace_Field) Reset() {
+	*x = CanvasMemoryNamespace_Field{}
+	mi := &file_canvases_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasMemoryNamespace_Field) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasMemoryNamespace_Field) ProtoMessage() {}
+
+func (x *CanvasMemoryNamespace_Field) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasMemoryNamespace_Field.ProtoReflect.Descriptor instead.
+func (*CanvasMemoryNamespace_Field) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{79, 0}
+}
+
+func (x *CanvasMemoryNamespace_Field) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CanvasMemoryNamespace_Field) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CanvasMemoryNamespace_Field) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+type CanvasGitSync_Status struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	LastSyncedAt        *timestamp.Timestamp   `protobuf:"bytes,1,opt,name=last_synced_at,json=lastSyncedAt,proto3" json:"last_synced_at,omitempty"`
+	ContentDigest       string                 `protobuf:"bytes,2,opt,name=content_digest,json=contentDigest,proto3" json:"content_digest,omitempty"`
+	LastChangeRequestId string                 `protobuf:"bytes,3,opt,name=last_change_request_id,json=lastChangeRequestId,proto3" json:"last_change_request_id,omitempty"`
+	// The live canvas was changed outside of the repository,
+	// and no longer matches the file.
+	DriftDetected bool   `protobuf:"varint,4,opt,name=drift_detected,json=driftDetected,proto3" json:"drift_detected,omitempty"`
+	LastError     string `protobuf:"bytes,5,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasGitSync_Status) Reset() {
+	*x = CanvasGitSync_Status{}
+	mi := &file_canvases_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasGitSync_Status) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasGitSync_Status) ProtoMessage() {}
+
+func (x *CanvasGitSync_Status) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasGitSync_Status.ProtoReflect.Descriptor instead.
+func (*CanvasGitSync_Status) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{86, 0}
+}
+
+func (x *CanvasGitSync_Status) GetLastSyncedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastSyncedAt
+	}
+	return nil
+}
+
+func (x *CanvasGitSync_Status) GetContentDigest() string {
+	if x != nil {
+		return x.ContentDigest
+	}
+	return ""
+}
+
+func (x *CanvasGitSync_Status) GetLastChangeRequestId() string {
+	if x != nil {
+		return x.LastChangeRequestId
+	}
+	return ""
+}
+
+func (x *CanvasGitSync_Status) GetDriftDetected() bool {
+	if x != nil {
+		return x.DriftDetected
+	}
+	return false
+}
+
+func (x *CanvasGitSync_Status) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
 var File_canvases_proto protoreflect.FileDescriptor
 
 const file_canvases_proto_rawDesc = "" +
 	"\n" +
-	"\x0ecanvases.proto\x12\x13Superplane.Canvases\x1a\x10components.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"B\n" +
+	"\x0ecanvases.proto\x12\x13Superplane.Canvases\x1a\x10components.proto\x1a\x10blueprints.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"B\n" +
 	"\x13ListCanvasesRequest\x12+\n" +
 	"\x11include_templates\x18\x01 \x01(\bR\x10includeTemplates\"O\n" +
 	"\x14ListCanvasesResponse\x127\n" +
@@ -5420,17 +9189,23 @@ const file_canvases_proto_rawDesc = "" +
 	"\x15DescribeCanvasRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"M\n" +
 	"\x16DescribeCanvasResponse\x123\n" +
-	"\x06canvas\x18\x01 \x01(\v2\x1b.Superplane.Canvases.CanvasR\x06canvas\"\xef\x02\n" +
+	"\x06canvas\x18\x01 \x01(\v2\x1b.Superplane.Canvases.CanvasR\x06canvas\"\xe5\x05\n" +
 	"\x13UpdateCanvasRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
 	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01\x122\n" +
 	"\x12versioning_enabled\x18\x04 \x01(\bH\x02R\x11versioningEnabled\x88\x01\x01\x12\x80\x01\n" +
-	"\x1echange_request_approval_config\x18\x05 \x01(\v26.Superplane.Canvases.CanvasChangeRequestApprovalConfigH\x03R\x1bchangeRequestApprovalConfig\x88\x01\x01B\a\n" +
+	"\x1echange_request_approval_config\x18\x05 \x01(\v26.Superplane.Canvases.CanvasChangeRequestApprovalConfigH\x03R\x1bchangeRequestApprovalConfig\x88\x01\x01\x12%\n" +
+	"\venvironment\x18\x06 \x01(\tH\x04R\venvironment\x88\x01\x01\x12w\n" +
+	"\x1bchange_request_check_config\x18\a \x01(\v23.Superplane.Canvases.CanvasChangeRequestCheckConfigH\x05R\x18changeRequestCheckConfig\x88\x01\x01\x12\x80\x01\n" +
+	"\x1enode_action_permissions_config\x18\b \x01(\v26.Superplane.Canvases.CanvasNodeActionPermissionsConfigH\x06R\x1bnodeActionPermissionsConfig\x88\x01\x01B\a\n" +
 	"\x05_nameB\x0e\n" +
 	"\f_descriptionB\x15\n" +
 	"\x13_versioning_enabledB!\n" +
-	"\x1f_change_request_approval_config\"K\n" +
+	"\x1f_change_request_approval_configB\x0e\n" +
+	"\f_environmentB\x1e\n" +
+	"\x1c_change_request_check_configB!\n" +
+	"\x1f_node_action_permissions_config\"K\n" +
 	"\x14UpdateCanvasResponse\x123\n" +
 	"\x06canvas\x18\x01 \x01(\v2\x1b.Superplane.Canvases.CanvasR\x06canvas\"\x92\x01\n" +
 	"\x13CreateCanvasRequest\x123\n" +
@@ -5478,6 +9253,19 @@ const file_canvases_proto_rawDesc = "" +
 	"\vauto_layout\x18\x04 \x01(\v2%.Superplane.Canvases.CanvasAutoLayoutR\n" +
 	"autoLayout\"[\n" +
 	"\x1bUpdateCanvasVersionResponse\x12<\n" +
+	"\aversion\x18\x01 \x01(\v2\".Superplane.Canvases.CanvasVersionR\aversion\"\x7f\n" +
+	"\x19DiffCanvasVersionsRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12\x1d\n" +
+	"\n" +
+	"version_id\x18\x02 \x01(\tR\tversionId\x12&\n" +
+	"\x0fbase_version_id\x18\x03 \x01(\tR\rbaseVersionId\"X\n" +
+	"\x1aDiffCanvasVersionsResponse\x12:\n" +
+	"\x04diff\x18\x01 \x01(\v2&.Superplane.Canvases.CanvasVersionDiffR\x04diff\"Z\n" +
+	"\x1cRollbackCanvasVersionRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12\x1d\n" +
+	"\n" +
+	"version_id\x18\x02 \x01(\tR\tversionId\"]\n" +
+	"\x1dRollbackCanvasVersionResponse\x12<\n" +
 	"\aversion\x18\x01 \x01(\v2\".Superplane.Canvases.CanvasVersionR\aversion\"\x96\x01\n" +
 	" CreateCanvasChangeRequestRequest\x12\x1b\n" +
 	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12\x1d\n" +
@@ -5529,14 +9317,46 @@ const file_canvases_proto_rawDesc = "" +
 	"\x0echange_request\x18\x02 \x01(\v2(.Superplane.Canvases.CanvasChangeRequestR\rchangeRequest\"%\n" +
 	"\x13DeleteCanvasRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"\x16\n" +
-	"\x14DeleteCanvasResponse\"-\n" +
+	"\x14DeleteCanvasResponse\"\xb4\x04\n" +
+	"\fCanvasBundle\x12F\n" +
+	"\bmetadata\x18\x01 \x01(\v2*.Superplane.Canvases.CanvasBundle.MetadataR\bmetadata\x124\n" +
+	"\x04spec\x18\x02 \x01(\v2 .Superplane.Canvases.Canvas.SpecR\x04spec\x12@\n" +
+	"\n" +
+	"blueprints\x18\x03 \x03(\v2 .Superplane.Blueprints.BlueprintR\n" +
+	"blueprints\x12Z\n" +
+	"\fintegrations\x18\x04 \x03(\v26.Superplane.Canvases.CanvasBundle.IntegrationReferenceR\fintegrations\x12K\n" +
+	"\asecrets\x18\x05 \x03(\v21.Superplane.Canvases.CanvasBundle.SecretReferenceR\asecrets\x1a@\n" +
+	"\bMetadata\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x1a>\n" +
+	"\x14IntegrationReference\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x1a9\n" +
+	"\x0fSecretReference\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04keys\x18\x02 \x03(\tR\x04keys\"%\n" +
+	"\x13ExportCanvasRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"Q\n" +
+	"\x14ExportCanvasResponse\x129\n" +
+	"\x06bundle\x18\x01 \x01(\v2!.Superplane.Canvases.CanvasBundleR\x06bundle\"\x8e\x02\n" +
+	"\x13ImportCanvasRequest\x129\n" +
+	"\x06bundle\x18\x01 \x01(\v2!.Superplane.Canvases.CanvasBundleR\x06bundle\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12n\n" +
+	"\x14integration_mappings\x18\x03 \x03(\v2;.Superplane.Canvases.ImportCanvasRequest.IntegrationMappingR\x13integrationMappings\x1a8\n" +
+	"\x12IntegrationMapping\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\"t\n" +
+	"\x14ImportCanvasResponse\x123\n" +
+	"\x06canvas\x18\x01 \x01(\v2\x1b.Superplane.Canvases.CanvasR\x06canvas\x12'\n" +
+	"\x0fmissing_secrets\x18\x02 \x03(\tR\x0emissingSecrets\"-\n" +
 	"\aUserRef\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\"\x9b\b\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\xf2\n" +
+	"\n" +
 	"\x06Canvas\x12@\n" +
 	"\bmetadata\x18\x01 \x01(\v2$.Superplane.Canvases.Canvas.MetadataR\bmetadata\x124\n" +
 	"\x04spec\x18\x02 \x01(\v2 .Superplane.Canvases.Canvas.SpecR\x04spec\x12:\n" +
-	"\x06status\x18\x03 \x01(\v2\".Superplane.Canvases.Canvas.StatusR\x06status\x1a\xf9\x03\n" +
+	"\x06status\x18\x03 \x01(\v2\".Superplane.Canvases.Canvas.StatusR\x06status\x1a\x8c\x06\n" +
 	"\bMetadata\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
 	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x12\n" +
@@ -5552,15 +9372,32 @@ const file_canvases_proto_rawDesc = "" +
 	"isTemplate\x12-\n" +
 	"\x12versioning_enabled\x18\t \x01(\bR\x11versioningEnabled\x12{\n" +
 	"\x1echange_request_approval_config\x18\n" +
-	" \x01(\v26.Superplane.Canvases.CanvasChangeRequestApprovalConfigR\x1bchangeRequestApprovalConfig\x1al\n" +
+	" \x01(\v26.Superplane.Canvases.CanvasChangeRequestApprovalConfigR\x1bchangeRequestApprovalConfig\x12 \n" +
+	"\venvironment\x18\v \x01(\tR\venvironment\x12r\n" +
+	"\x1bchange_request_check_config\x18\f \x01(\v23.Superplane.Canvases.CanvasChangeRequestCheckConfigR\x18changeRequestCheckConfig\x12{\n" +
+	"\x1enode_action_permissions_config\x18\r \x01(\v26.Superplane.Canvases.CanvasNodeActionPermissionsConfigR\x1bnodeActionPermissionsConfig\x1a\xaf\x01\n" +
 	"\x04Spec\x121\n" +
 	"\x05nodes\x18\x01 \x03(\v2\x1b.Superplane.Components.NodeR\x05nodes\x121\n" +
-	"\x05edges\x18\x02 \x03(\v2\x1b.Superplane.Components.EdgeR\x05edges\x1a\xf2\x01\n" +
+	"\x05edges\x18\x02 \x03(\v2\x1b.Superplane.Components.EdgeR\x05edges\x12A\n" +
+	"\tvariables\x18\x03 \x03(\v2#.Superplane.Canvases.CanvasVariableR\tvariables\x1a\xf2\x01\n" +
 	"\x06Status\x12Q\n" +
 	"\x0flast_executions\x18\x01 \x03(\v2(.Superplane.Canvases.CanvasNodeExecutionR\x0elastExecutions\x12R\n" +
 	"\x10next_queue_items\x18\x02 \x03(\v2(.Superplane.Canvases.CanvasNodeQueueItemR\x0enextQueueItems\x12A\n" +
 	"\vlast_events\x18\x03 \x03(\v2 .Superplane.Canvases.CanvasEventR\n" +
-	"lastEvents\"\xd4\x03\n" +
+	"lastEvents\"\xb2\x03\n" +
+	"\x0eCanvasVariable\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\x12E\n" +
+	"\x06secret\x18\x04 \x01(\v2-.Superplane.Canvases.CanvasVariable.SecretRefR\x06secret\x12J\n" +
+	"\toverrides\x18\x05 \x03(\v2,.Superplane.Canvases.CanvasVariable.OverrideR\toverrides\x1a5\n" +
+	"\tSecretRef\x12\x16\n" +
+	"\x06secret\x18\x01 \x01(\tR\x06secret\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x1a\x89\x01\n" +
+	"\bOverride\x12 \n" +
+	"\venvironment\x18\x01 \x01(\tR\venvironment\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12E\n" +
+	"\x06secret\x18\x03 \x01(\v2-.Superplane.Canvases.CanvasVariable.SecretRefR\x06secret\"\xd4\x03\n" +
 	"\rCanvasVersion\x12G\n" +
 	"\bmetadata\x18\x01 \x01(\v2+.Superplane.Canvases.CanvasVersion.MetadataR\bmetadata\x124\n" +
 	"\x04spec\x18\x02 \x01(\v2 .Superplane.Canvases.Canvas.SpecR\x04spec\x1a\xc3\x02\n" +
@@ -5573,7 +9410,39 @@ const file_canvases_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"u\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xd0\b\n" +
+	"\x11CanvasVersionDiff\x12&\n" +
+	"\x0fbase_version_id\x18\x01 \x01(\tR\rbaseVersionId\x12*\n" +
+	"\x11target_version_id\x18\x02 \x01(\tR\x0ftargetVersionId\x12G\n" +
+	"\x05nodes\x18\x03 \x03(\v21.Superplane.Canvases.CanvasVersionDiff.NodeChangeR\x05nodes\x12G\n" +
+	"\x05edges\x18\x04 \x03(\v21.Superplane.Canvases.CanvasVersionDiff.EdgeChangeR\x05edges\x12S\n" +
+	"\tvariables\x18\x05 \x03(\v25.Superplane.Canvases.CanvasVersionDiff.VariableChangeR\tvariables\x1aO\n" +
+	"\vFieldChange\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x16\n" +
+	"\x06before\x18\x02 \x01(\tR\x06before\x12\x14\n" +
+	"\x05after\x18\x03 \x01(\tR\x05after\x1a\xd5\x01\n" +
+	"\n" +
+	"NodeChange\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\tR\x06nodeId\x12\x1b\n" +
+	"\tnode_name\x18\x02 \x01(\tR\bnodeName\x12E\n" +
+	"\x04type\x18\x03 \x01(\x0e21.Superplane.Canvases.CanvasVersionDiff.ChangeTypeR\x04type\x12J\n" +
+	"\x06fields\x18\x04 \x03(\v22.Superplane.Canvases.CanvasVersionDiff.FieldChangeR\x06fields\x1a\xa7\x01\n" +
+	"\n" +
+	"EdgeChange\x12E\n" +
+	"\x04type\x18\x01 \x01(\x0e21.Superplane.Canvases.CanvasVersionDiff.ChangeTypeR\x04type\x12\x1b\n" +
+	"\tsource_id\x18\x02 \x01(\tR\bsourceId\x12\x1b\n" +
+	"\ttarget_id\x18\x03 \x01(\tR\btargetId\x12\x18\n" +
+	"\achannel\x18\x04 \x01(\tR\achannel\x1a\xb7\x01\n" +
+	"\x0eVariableChange\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12E\n" +
+	"\x04type\x18\x02 \x01(\x0e21.Superplane.Canvases.CanvasVersionDiff.ChangeTypeR\x04type\x12J\n" +
+	"\x06fields\x18\x03 \x03(\v22.Superplane.Canvases.CanvasVersionDiff.FieldChangeR\x06fields\"s\n" +
+	"\n" +
+	"ChangeType\x12\x1b\n" +
+	"\x17CHANGE_TYPE_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11CHANGE_TYPE_ADDED\x10\x01\x12\x17\n" +
+	"\x13CHANGE_TYPE_REMOVED\x10\x02\x12\x18\n" +
+	"\x14CHANGE_TYPE_MODIFIED\x10\x03\"u\n" +
 	"\x17CanvasChangeRequestDiff\x12(\n" +
 	"\x10changed_node_ids\x18\x01 \x03(\tR\x0echangedNodeIds\x120\n" +
 	"\x14conflicting_node_ids\x18\x02 \x03(\tR\x12conflictingNodeIds\"\xeb\x01\n" +
@@ -5587,7 +9456,30 @@ const file_canvases_proto_rawDesc = "" +
 	"\tTYPE_USER\x10\x02\x12\r\n" +
 	"\tTYPE_ROLE\x10\x03\"k\n" +
 	"!CanvasChangeRequestApprovalConfig\x12F\n" +
-	"\x05items\x18\x01 \x03(\v20.Superplane.Canvases.CanvasChangeRequestApproverR\x05items\"\xc9\x03\n" +
+	"\x05items\x18\x01 \x03(\v20.Superplane.Canvases.CanvasChangeRequestApproverR\x05items\"\x82\x01\n" +
+	"\x1eCanvasChangeRequestCheckConfig\x121\n" +
+	"\x14forbidden_components\x18\x01 \x03(\tR\x13forbiddenComponents\x12-\n" +
+	"\x12simulation_enabled\x18\x02 \x01(\bR\x11simulationEnabled\"\x7f\n" +
+	"\x1bCanvasNodeActionPermissions\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\tR\x06nodeId\x12\x19\n" +
+	"\buser_ids\x18\x02 \x03(\tR\auserIds\x12\x16\n" +
+	"\x06groups\x18\x03 \x03(\tR\x06groups\x12\x14\n" +
+	"\x05roles\x18\x04 \x03(\tR\x05roles\"k\n" +
+	"!CanvasNodeActionPermissionsConfig\x12F\n" +
+	"\x05items\x18\x01 \x03(\v20.Superplane.Canvases.CanvasNodeActionPermissionsR\x05items\"\x90\x03\n" +
+	"\x18CanvasChangeRequestCheck\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12L\n" +
+	"\x06status\x18\x02 \x01(\x0e24.Superplane.Canvases.CanvasChangeRequestCheck.StatusR\x06status\x12Q\n" +
+	"\bproblems\x18\x03 \x03(\v25.Superplane.Canvases.CanvasChangeRequestCheck.ProblemR\bproblems\x129\n" +
+	"\n" +
+	"checked_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcheckedAt\x1a<\n" +
+	"\aProblem\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\tR\x06nodeId\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"F\n" +
+	"\x06Status\x12\x16\n" +
+	"\x12STATUS_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\rSTATUS_PASSED\x10\x01\x12\x11\n" +
+	"\rSTATUS_FAILED\x10\x02\"\xc9\x03\n" +
 	"\x1bCanvasChangeRequestApproval\x122\n" +
 	"\x05actor\x18\x01 \x01(\v2\x1c.Superplane.Canvases.UserRefR\x05actor\x12L\n" +
 	"\bapprover\x18\x02 \x01(\v20.Superplane.Canvases.CanvasChangeRequestApproverR\bapprover\x12L\n" +
@@ -5599,12 +9491,13 @@ const file_canvases_proto_rawDesc = "" +
 	"\x11STATE_UNSPECIFIED\x10\x00\x12\x12\n" +
 	"\x0eSTATE_APPROVED\x10\x01\x12\x12\n" +
 	"\x0eSTATE_REJECTED\x10\x02\x12\x14\n" +
-	"\x10STATE_UNAPPROVED\x10\x03\"\xa9\a\n" +
+	"\x10STATE_UNAPPROVED\x10\x03\"\xf0\a\n" +
 	"\x13CanvasChangeRequest\x12M\n" +
 	"\bmetadata\x18\x01 \x01(\v21.Superplane.Canvases.CanvasChangeRequest.MetadataR\bmetadata\x12<\n" +
 	"\aversion\x18\x02 \x01(\v2\".Superplane.Canvases.CanvasVersionR\aversion\x12@\n" +
 	"\x04diff\x18\x03 \x01(\v2,.Superplane.Canvases.CanvasChangeRequestDiffR\x04diff\x12N\n" +
-	"\tapprovals\x18\x04 \x03(\v20.Superplane.Canvases.CanvasChangeRequestApprovalR\tapprovals\x1a\x94\x04\n" +
+	"\tapprovals\x18\x04 \x03(\v20.Superplane.Canvases.CanvasChangeRequestApprovalR\tapprovals\x12E\n" +
+	"\x06checks\x18\x05 \x03(\v2-.Superplane.Canvases.CanvasChangeRequestCheckR\x06checks\x1a\x94\x04\n" +
 	"\bMetadata\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\tcanvas_id\x18\x02 \x01(\tR\bcanvasId\x12\x1d\n" +
@@ -5776,7 +9669,158 @@ const file_canvases_proto_rawDesc = "" +
 	"\x19DeleteCanvasMemoryRequest\x12\x1b\n" +
 	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12\x1b\n" +
 	"\tmemory_id\x18\x02 \x01(\tR\bmemoryId\"\x1c\n" +
-	"\x1aDeleteCanvasMemoryResponse\"\xf6\x01\n" +
+	"\x1aDeleteCanvasMemoryResponse\"\x94\x02\n" +
+	"\x15CanvasMemoryNamespace\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x1f\n" +
+	"\vttl_seconds\x18\x02 \x01(\x05R\n" +
+	"ttlSeconds\x12H\n" +
+	"\x06fields\x18\x03 \x03(\v20.Superplane.Canvases.CanvasMemoryNamespace.FieldR\x06fields\x12%\n" +
+	"\x0eindexed_fields\x18\x04 \x03(\tR\rindexedFields\x1aK\n" +
+	"\x05Field\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1a\n" +
+	"\brequired\x18\x03 \x01(\bR\brequired\"@\n" +
+	"!ListCanvasMemoryNamespacesRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\"p\n" +
+	"\"ListCanvasMemoryNamespacesResponse\x12J\n" +
+	"\n" +
+	"namespaces\x18\x01 \x03(\v2*.Superplane.Canvases.CanvasMemoryNamespaceR\n" +
+	"namespaces\"\xf1\x01\n" +
+	"\"UpdateCanvasMemoryNamespaceRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x1f\n" +
+	"\vttl_seconds\x18\x03 \x01(\x05R\n" +
+	"ttlSeconds\x12H\n" +
+	"\x06fields\x18\x04 \x03(\v20.Superplane.Canvases.CanvasMemoryNamespace.FieldR\x06fields\x12%\n" +
+	"\x0eindexed_fields\x18\x05 \x03(\tR\rindexedFields\"o\n" +
+	"#UpdateCanvasMemoryNamespaceResponse\x12H\n" +
+	"\tnamespace\x18\x01 \x01(\v2*.Superplane.Canvases.CanvasMemoryNamespaceR\tnamespace\"_\n" +
+	"\"DeleteCanvasMemoryNamespaceRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\"%\n" +
+	"#DeleteCanvasMemoryNamespaceResponse\"\xeb\x03\n" +
+	"\rCanvasGitSync\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12%\n" +
+	"\x0eintegration_id\x18\x02 \x01(\tR\rintegrationId\x12\x1e\n" +
+	"\n" +
+	"repository\x18\x03 \x01(\tR\n" +
+	"repository\x12\x16\n" +
+	"\x06branch\x18\x04 \x01(\tR\x06branch\x12\x12\n" +
+	"\x04path\x18\x05 \x01(\tR\x04path\x12\x18\n" +
+	"\aenabled\x18\x06 \x01(\bR\aenabled\x12A\n" +
+	"\x06status\x18\a \x01(\v2).Superplane.Canvases.CanvasGitSync.StatusR\x06status\x1a\xec\x01\n" +
+	"\x06Status\x12@\n" +
+	"\x0elast_synced_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\flastSyncedAt\x12%\n" +
+	"\x0econtent_digest\x18\x02 \x01(\tR\rcontentDigest\x123\n" +
+	"\x16last_change_request_id\x18\x03 \x01(\tR\x13lastChangeRequestId\x12%\n" +
+	"\x0edrift_detected\x18\x04 \x01(\bR\rdriftDetected\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\x05 \x01(\tR\tlastError\";\n" +
+	"\x1cDescribeCanvasGitSyncRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\"W\n" +
+	"\x1dDescribeCanvasGitSyncResponse\x126\n" +
+	"\x04sync\x18\x01 \x01(\v2\".Superplane.Canvases.CanvasGitSyncR\x04sync\"\xc6\x01\n" +
+	"\x1aUpdateCanvasGitSyncRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12%\n" +
+	"\x0eintegration_id\x18\x02 \x01(\tR\rintegrationId\x12\x1e\n" +
+	"\n" +
+	"repository\x18\x03 \x01(\tR\n" +
+	"repository\x12\x16\n" +
+	"\x06branch\x18\x04 \x01(\tR\x06branch\x12\x12\n" +
+	"\x04path\x18\x05 \x01(\tR\x04path\x12\x18\n" +
+	"\aenabled\x18\x06 \x01(\bR\aenabled\"U\n" +
+	"\x1bUpdateCanvasGitSyncResponse\x126\n" +
+	"\x04sync\x18\x01 \x01(\v2\".Superplane.Canvases.CanvasGitSyncR\x04sync\"9\n" +
+	"\x1aDeleteCanvasGitSyncRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\"\x1d\n" +
+	"\x1bDeleteCanvasGitSyncResponse\"w\n" +
+	"\fCanvasMember\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tuser_name\x18\x02 \x01(\tR\buserName\x12\x1d\n" +
+	"\n" +
+	"user_email\x18\x03 \x01(\tR\tuserEmail\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\"c\n" +
+	"\vCanvasGroup\x12\x1d\n" +
+	"\n" +
+	"group_name\x18\x01 \x01(\tR\tgroupName\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\"7\n" +
+	"\x18ListCanvasMembersRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\"X\n" +
+	"\x19ListCanvasMembersResponse\x12;\n" +
+	"\amembers\x18\x01 \x03(\v2!.Superplane.Canvases.CanvasMemberR\amembers\"e\n" +
+	"\x19UpdateCanvasMemberRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\"W\n" +
+	"\x1aUpdateCanvasMemberResponse\x129\n" +
+	"\x06member\x18\x01 \x01(\v2!.Superplane.Canvases.CanvasMemberR\x06member\"Q\n" +
+	"\x19RemoveCanvasMemberRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x1c\n" +
+	"\x1aRemoveCanvasMemberResponse\"6\n" +
+	"\x17ListCanvasGroupsRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\"T\n" +
+	"\x18ListCanvasGroupsResponse\x128\n" +
+	"\x06groups\x18\x01 \x03(\v2 .Superplane.Canvases.CanvasGroupR\x06groups\"j\n" +
+	"\x18UpdateCanvasGroupRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12\x1d\n" +
+	"\n" +
+	"group_name\x18\x02 \x01(\tR\tgroupName\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\"S\n" +
+	"\x19UpdateCanvasGroupResponse\x126\n" +
+	"\x05group\x18\x01 \x01(\v2 .Superplane.Canvases.CanvasGroupR\x05group\"V\n" +
+	"\x18RemoveCanvasGroupRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12\x1d\n" +
+	"\n" +
+	"group_name\x18\x02 \x01(\tR\tgroupName\"\x1b\n" +
+	"\x19RemoveCanvasGroupResponse\"\x90\x01\n" +
+	"\x19ValidateExpressionRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12\x17\n" +
+	"\anode_id\x18\x02 \x01(\tR\x06nodeId\x12\x1e\n" +
+	"\n" +
+	"expression\x18\x03 \x01(\tR\n" +
+	"expression\x12\x1d\n" +
+	"\n" +
+	"version_id\x18\x04 \x01(\tR\tversionId\"\x7f\n" +
+	"\x1aValidateExpressionResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12K\n" +
+	"\vdiagnostics\x18\x02 \x03(\v2).Superplane.Canvases.ExpressionDiagnosticR\vdiagnostics\"\xf4\x01\n" +
+	"\x14ExpressionDiagnostic\x12N\n" +
+	"\bseverity\x18\x01 \x01(\x0e22.Superplane.Canvases.ExpressionDiagnostic.SeverityR\bseverity\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x12\n" +
+	"\x04from\x18\x03 \x01(\x05R\x04from\x12\x0e\n" +
+	"\x02to\x18\x04 \x01(\x05R\x02to\"N\n" +
+	"\bSeverity\x12\x18\n" +
+	"\x14SEVERITY_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eSEVERITY_ERROR\x10\x01\x12\x14\n" +
+	"\x10SEVERITY_WARNING\x10\x02\"\xb8\x01\n" +
+	"\x19CompleteExpressionRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12\x17\n" +
+	"\anode_id\x18\x02 \x01(\tR\x06nodeId\x12\x1e\n" +
+	"\n" +
+	"expression\x18\x03 \x01(\tR\n" +
+	"expression\x12\x1b\n" +
+	"\x06cursor\x18\x04 \x01(\x05H\x00R\x06cursor\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"version_id\x18\x05 \x01(\tR\tversionIdB\t\n" +
+	"\a_cursor\"i\n" +
+	"\x1aCompleteExpressionResponse\x12K\n" +
+	"\vcompletions\x18\x01 \x03(\v2).Superplane.Canvases.ExpressionCompletionR\vcompletions\"\xa6\x02\n" +
+	"\x14ExpressionCompletion\x12\x14\n" +
+	"\x05label\x18\x01 \x01(\tR\x05label\x12B\n" +
+	"\x04kind\x18\x02 \x01(\x0e2..Superplane.Canvases.ExpressionCompletion.KindR\x04kind\x12\x16\n" +
+	"\x06detail\x18\x03 \x01(\tR\x06detail\x12\x1f\n" +
+	"\vinsert_text\x18\x04 \x01(\tR\n" +
+	"insertText\x12\x18\n" +
+	"\areplace\x18\x05 \x01(\x05R\areplace\"a\n" +
+	"\x04Kind\x12\x14\n" +
+	"\x10KIND_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\rKIND_FUNCTION\x10\x01\x12\x11\n" +
+	"\rKIND_VARIABLE\x10\x02\x12\r\n" +
+	"\tKIND_NODE\x10\x03\x12\x0e\n" +
+	"\n" +
+	"KIND_FIELD\x10\x04\"\xf6\x01\n" +
 	"\vCanvasEvent\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\tcanvas_id\x18\x02 \x01(\tR\bcanvasId\x12\x17\n" +
@@ -5838,7 +9882,7 @@ const file_canvases_proto_rawDesc = "" +
 	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12\x1d\n" +
 	"\n" +
 	"version_id\x18\x02 \x01(\tR\tversionId\x128\n" +
-	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp2\xad>\n" +
+	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp2\x81i\n" +
 	"\bCanvases\x12\xb7\x01\n" +
 	"\fListCanvases\x12(.Superplane.Canvases.ListCanvasesRequest\x1a).Superplane.Canvases.ListCanvasesResponse\"R\x92A7\n" +
 	"\x06Canvas\x12\rList canvases\x1a\x1eReturns a list of all canvases\x82\xd3\xe4\x93\x02\x12\x12\x10/api/v1/canvases\x12\xb0\x01\n" +
@@ -5855,7 +9899,11 @@ const file_canvases_proto_rawDesc = "" +
 	"\x15DescribeCanvasVersion\x121.Superplane.Canvases.DescribeCanvasVersionRequest\x1a2.Superplane.Canvases.DescribeCanvasVersionResponse\"\x87\x01\x92AJ\n" +
 	"\rCanvasVersion\x12\x17Describe canvas version\x1a Returns one canvas version by ID\x82\xd3\xe4\x93\x024\x122/api/v1/canvases/{canvas_id}/versions/{version_id}\x12\x82\x03\n" +
 	"\x13UpdateCanvasVersion\x12/.Superplane.Canvases.UpdateCanvasVersionRequest\x1a0.Superplane.Canvases.UpdateCanvasVersionResponse\"\x87\x02\x92A\x9a\x01\n" +
-	"\rCanvasVersion\x12\x15Update canvas version\x1arUpdates a user-owned canvas version; if version_id is omitted, updates the live canvas when versioning is disabled\x82\xd3\xe4\x93\x02c:\x01*Z*:\x01*\x1a%/api/v1/canvases/{canvas_id}/versions\x1a2/api/v1/canvases/{canvas_id}/versions/{version_id}\x12\xaf\x02\n" +
+	"\rCanvasVersion\x12\x15Update canvas version\x1arUpdates a user-owned canvas version; if version_id is omitted, updates the live canvas when versioning is disabled\x82\xd3\xe4\x93\x02c:\x01*Z*:\x01*\x1a%/api/v1/canvases/{canvas_id}/versions\x1a2/api/v1/canvases/{canvas_id}/versions/{version_id}\x12\xe9\x02\n" +
+	"\x12DiffCanvasVersions\x12..Superplane.Canvases.DiffCanvasVersionsRequest\x1a/.Superplane.Canvases.DiffCanvasVersionsResponse\"\xf1\x01\x92A\xae\x01\n" +
+	"\rCanvasVersion\x12\x14Diff canvas versions\x1a\x86\x01Compares the nodes, edges and variables of a canvas version with another version, or with the live version if no base version is given\x82\xd3\xe4\x93\x029\x127/api/v1/canvases/{canvas_id}/versions/{version_id}/diff\x12\xdf\x02\n" +
+	"\x15RollbackCanvasVersion\x121.Superplane.Canvases.RollbackCanvasVersionRequest\x1a2.Superplane.Canvases.RollbackCanvasVersionResponse\"\xde\x01\x92A\x94\x01\n" +
+	"\rCanvasVersion\x12\x17Rollback canvas version\x1ajPublishes a new live version of a canvas with the nodes, edges and variables of an older published version\x82\xd3\xe4\x93\x02@:\x01*\";/api/v1/canvases/{canvas_id}/versions/{version_id}/rollback\x12\xaf\x02\n" +
 	"\x19CreateCanvasChangeRequest\x125.Superplane.Canvases.CreateCanvasChangeRequestRequest\x1a6.Superplane.Canvases.CreateCanvasChangeRequestResponse\"\xa2\x01\x92Ah\n" +
 	"\x13CanvasChangeRequest\x12\x1cCreate canvas change request\x1a3Creates a change request for a draft canvas version\x82\xd3\xe4\x93\x021:\x01*\",/api/v1/canvases/{canvas_id}/change-requests\x12\x97\x02\n" +
 	"\x18ListCanvasChangeRequests\x124.Superplane.Canvases.ListCanvasChangeRequestsRequest\x1a5.Superplane.Canvases.ListCanvasChangeRequestsResponse\"\x8d\x01\x92AV\n" +
@@ -5867,7 +9915,11 @@ const file_canvases_proto_rawDesc = "" +
 	"\x1aResolveCanvasChangeRequest\x126.Superplane.Canvases.ResolveCanvasChangeRequestRequest\x1a7.Superplane.Canvases.ResolveCanvasChangeRequestResponse\"\xd7\x01\x92A\x80\x01\n" +
 	"\x13CanvasChangeRequest\x12'Resolve canvas change request conflicts\x1a@Updates a change request version with a conflict-resolved result\x82\xd3\xe4\x93\x02M:\x01*2H/api/v1/canvases/{canvas_id}/change-requests/{change_request_id}/resolve\x12\xb8\x01\n" +
 	"\fDeleteCanvas\x12(.Superplane.Canvases.DeleteCanvasRequest\x1a).Superplane.Canvases.DeleteCanvasResponse\"S\x92A3\n" +
-	"\x06Canvas\x12\rDelete canvas\x1a\x1aDeletes an existing canvas\x82\xd3\xe4\x93\x02\x17*\x15/api/v1/canvases/{id}\x12\x8a\x02\n" +
+	"\x06Canvas\x12\rDelete canvas\x1a\x1aDeletes an existing canvas\x82\xd3\xe4\x93\x02\x17*\x15/api/v1/canvases/{id}\x12\x8f\x02\n" +
+	"\fExportCanvas\x12(.Superplane.Canvases.ExportCanvasRequest\x1a).Superplane.Canvases.ExportCanvasResponse\"\xa9\x01\x92A\x81\x01\n" +
+	"\x06Canvas\x12\rExport canvas\x1ahReturns a portable bundle of the live version of a canvas, which can be imported in another organization\x82\xd3\xe4\x93\x02\x1e\x12\x1c/api/v1/canvases/{id}/export\x12\x82\x02\n" +
+	"\fImportCanvas\x12(.Superplane.Canvases.ImportCanvasRequest\x1a).Superplane.Canvases.ImportCanvasResponse\"\x9c\x01\x92Aw\n" +
+	"\x06Canvas\x12\rImport canvas\x1a^Creates a canvas from a bundle, creating the blueprints it uses and remapping its integrations\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/api/v1/canvases/import\x12\x8a\x02\n" +
 	"\x12ListNodeQueueItems\x12..Superplane.Canvases.ListNodeQueueItemsRequest\x1a/.Superplane.Canvases.ListNodeQueueItemsResponse\"\x92\x01\x92AU\n" +
 	"\n" +
 	"CanvasNode\x12\x1cList items in a node's queue\x1a)Returns a list of items in a node's queue\x82\xd3\xe4\x93\x024\x122/api/v1/canvases/{canvas_id}/nodes/{node_id}/queue\x12\x9a\x02\n" +
@@ -5902,7 +9954,35 @@ const file_canvases_proto_rawDesc = "" +
 	"\x12ListCanvasMemories\x12..Superplane.Canvases.ListCanvasMemoriesRequest\x1a/.Superplane.Canvases.ListCanvasMemoriesResponse\"}\x92AO\n" +
 	"\x06Canvas\x12\x14List canvas memories\x1a/Returns append-only memory records for a canvas\x82\xd3\xe4\x93\x02%\x12#/api/v1/canvases/{canvas_id}/memory\x12\x85\x02\n" +
 	"\x12DeleteCanvasMemory\x12..Superplane.Canvases.DeleteCanvasMemoryRequest\x1a/.Superplane.Canvases.DeleteCanvasMemoryResponse\"\x8d\x01\x92AS\n" +
-	"\x06Canvas\x12\x1aDelete canvas memory entry\x1a-Deletes one memory record by ID from a canvas\x82\xd3\xe4\x93\x021*//api/v1/canvases/{canvas_id}/memory/{memory_id}\x12\xa4\x02\n" +
+	"\x06Canvas\x12\x1aDelete canvas memory entry\x1a-Deletes one memory record by ID from a canvas\x82\xd3\xe4\x93\x021*//api/v1/canvases/{canvas_id}/memory/{memory_id}\x12\xca\x02\n" +
+	"\x1aListCanvasMemoryNamespaces\x126.Superplane.Canvases.ListCanvasMemoryNamespacesRequest\x1a7.Superplane.Canvases.ListCanvasMemoryNamespacesResponse\"\xba\x01\x92A\x80\x01\n" +
+	"\x06Canvas\x12\x1dList canvas memory namespaces\x1aWReturns the TTL, schema and indexed fields configured for memory namespaces in a canvas\x82\xd3\xe4\x93\x020\x12./api/v1/canvases/{canvas_id}/memory-namespaces\x12\xd4\x02\n" +
+	"\x1bUpdateCanvasMemoryNamespace\x127.Superplane.Canvases.UpdateCanvasMemoryNamespaceRequest\x1a8.Superplane.Canvases.UpdateCanvasMemoryNamespaceResponse\"\xc1\x01\x92Ay\n" +
+	"\x06Canvas\x12\x1eUpdate canvas memory namespace\x1aOConfigures the TTL, schema and indexed fields of a memory namespace in a canvas\x82\xd3\xe4\x93\x02?:\x01*\x1a:/api/v1/canvases/{canvas_id}/memory-namespaces/{namespace}\x12\xd4\x02\n" +
+	"\x1bDeleteCanvasMemoryNamespace\x127.Superplane.Canvases.DeleteCanvasMemoryNamespaceRequest\x1a8.Superplane.Canvases.DeleteCanvasMemoryNamespaceResponse\"\xc1\x01\x92A|\n" +
+	"\x06Canvas\x12\x1eDelete canvas memory namespace\x1aRRemoves the configuration of a memory namespace in a canvas. Its records are kept.\x82\xd3\xe4\x93\x02<*:/api/v1/canvases/{canvas_id}/memory-namespaces/{namespace}\x12\xa8\x02\n" +
+	"\x15DescribeCanvasGitSync\x121.Superplane.Canvases.DescribeCanvasGitSyncRequest\x1a2.Superplane.Canvases.DescribeCanvasGitSyncResponse\"\xa7\x01\x92Aw\n" +
+	"\x06Canvas\x12\x18Describe canvas Git sync\x1aSReturns the Git repository a canvas is synced from, and the status of the last sync\x82\xd3\xe4\x93\x02'\x12%/api/v1/canvases/{canvas_id}/git-sync\x12\xcb\x02\n" +
+	"\x13UpdateCanvasGitSync\x12/.Superplane.Canvases.UpdateCanvasGitSyncRequest\x1a0.Superplane.Canvases.UpdateCanvasGitSyncResponse\"\xd0\x01\x92A\x9c\x01\n" +
+	"\x06Canvas\x12\x16Update canvas Git sync\x1azConfigures the Git repository, branch and file a canvas is synced from. Changes to the file are opened as change requests.\x82\xd3\xe4\x93\x02*:\x01*\x1a%/api/v1/canvases/{canvas_id}/git-sync\x12\xfb\x01\n" +
+	"\x13DeleteCanvasGitSync\x12/.Superplane.Canvases.DeleteCanvasGitSyncRequest\x1a0.Superplane.Canvases.DeleteCanvasGitSyncResponse\"\x80\x01\x92AP\n" +
+	"\x06Canvas\x12\x16Delete canvas Git sync\x1a.Stops syncing a canvas from its Git repository\x82\xd3\xe4\x93\x02'*%/api/v1/canvases/{canvas_id}/git-sync\x12\xeb\x01\n" +
+	"\x11ListCanvasMembers\x12-.Superplane.Canvases.ListCanvasMembersRequest\x1a..Superplane.Canvases.ListCanvasMembersResponse\"w\x92AH\n" +
+	"\x06Canvas\x12\x13List canvas members\x1a)Returns the users with a role on a canvas\x82\xd3\xe4\x93\x02&\x12$/api/v1/canvases/{canvas_id}/members\x12\xb1\x02\n" +
+	"\x12UpdateCanvasMember\x12..Superplane.Canvases.UpdateCanvasMemberRequest\x1a/.Superplane.Canvases.UpdateCanvasMemberResponse\"\xb9\x01\x92A}\n" +
+	"\x06Canvas\x12\x14Update canvas member\x1a]Grants a role on a canvas to a user of the organization, replacing their previous canvas role\x82\xd3\xe4\x93\x023:\x01*\x1a./api/v1/canvases/{canvas_id}/members/{user_id}\x12\x9d\x02\n" +
+	"\x12RemoveCanvasMember\x12..Superplane.Canvases.RemoveCanvasMemberRequest\x1a/.Superplane.Canvases.RemoveCanvasMemberResponse\"\xa5\x01\x92Al\n" +
+	"\x06Canvas\x12\x14Remove canvas member\x1aLRemoves the role of a user on a canvas. Organization roles are not affected.\x82\xd3\xe4\x93\x020*./api/v1/canvases/{canvas_id}/members/{user_id}\x12\xf5\x01\n" +
+	"\x10ListCanvasGroups\x12,.Superplane.Canvases.ListCanvasGroupsRequest\x1a-.Superplane.Canvases.ListCanvasGroupsResponse\"\x83\x01\x92AU\n" +
+	"\x06Canvas\x12\x12List canvas groups\x1a7Returns the organization groups with a role on a canvas\x82\xd3\xe4\x93\x02%\x12#/api/v1/canvases/{canvas_id}/groups\x12\xb8\x02\n" +
+	"\x11UpdateCanvasGroup\x12-.Superplane.Canvases.UpdateCanvasGroupRequest\x1a..Superplane.Canvases.UpdateCanvasGroupResponse\"\xc3\x01\x92A\x84\x01\n" +
+	"\x06Canvas\x12\x13Update canvas group\x1aeGrants a role on a canvas to all members of an organization group, replacing its previous canvas role\x82\xd3\xe4\x93\x025:\x01*\x1a0/api/v1/canvases/{canvas_id}/groups/{group_name}\x12\x84\x02\n" +
+	"\x11RemoveCanvasGroup\x12-.Superplane.Canvases.RemoveCanvasGroupRequest\x1a..Superplane.Canvases.RemoveCanvasGroupResponse\"\x8f\x01\x92AT\n" +
+	"\x06Canvas\x12\x13Remove canvas group\x1a5Removes the role of an organization group on a canvas\x82\xd3\xe4\x93\x022*0/api/v1/canvases/{canvas_id}/groups/{group_name}\x12\xc0\x02\n" +
+	"\x12ValidateExpression\x12..Superplane.Canvases.ValidateExpressionRequest\x1a/.Superplane.Canvases.ValidateExpressionResponse\"\xc8\x01\x92A\x88\x01\n" +
+	"\x06Canvas\x12\x13Validate expression\x1aiType-checks an expression used in a node configuration against the example payloads of its upstream nodes\x82\xd3\xe4\x93\x026:\x01*\"1/api/v1/canvases/{canvas_id}/expressions/validate\x12\xbb\x02\n" +
+	"\x12CompleteExpression\x12..Superplane.Canvases.CompleteExpressionRequest\x1a/.Superplane.Canvases.CompleteExpressionResponse\"\xc3\x01\x92A\x83\x01\n" +
+	"\x06Canvas\x12\x13Complete expression\x1adReturns completion candidates for an expression used in a node configuration, at the cursor position\x82\xd3\xe4\x93\x026:\x01*\"1/api/v1/canvases/{canvas_id}/expressions/complete\x12\xa4\x02\n" +
 	"\x13ListEventExecutions\x12/.Superplane.Canvases.ListEventExecutionsRequest\x1a0.Superplane.Canvases.ListEventExecutionsResponse\"\xa9\x01\x92Ae\n" +
 	"\vCanvasEvent\x12\x15List event executions\x1a?Returns a list of all node executions triggered by a root event\x82\xd3\xe4\x93\x02;\x129/api/v1/canvases/{canvas_id}/events/{event_id}/executionsB\xc8\x01\x92A\x8c\x01\x12b\n" +
 	"\x17Superplane Canvases API\x12\x1bAPI for Superplane canvases\"%\n" +
@@ -5920,280 +10000,431 @@ func file_canvases_proto_rawDescGZIP() []byte {
 	return file_canvases_proto_rawDescData
 }
 
-var file_canvases_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
-var file_canvases_proto_msgTypes = make([]protoimpl.MessageInfo, 82)
+var file_canvases_proto_enumTypes = make([]protoimpl.EnumInfo, 13)
+var file_canvases_proto_msgTypes = make([]protoimpl.MessageInfo, 144)
 var file_canvases_proto_goTypes = []any{
-	(CanvasAutoLayout_Algorithm)(0),             // 0: Superplane.Canvases.CanvasAutoLayout.Algorithm
-	(CanvasAutoLayout_Scope)(0),                 // 1: Superplane.Canvases.CanvasAutoLayout.Scope
-	(ActOnCanvasChangeRequestRequest_Action)(0), // 2: Superplane.Canvases.ActOnCanvasChangeRequestRequest.Action
-	(CanvasChangeRequestApprover_Type)(0),       // 3: Superplane.Canvases.CanvasChangeRequestApprover.Type
-	(CanvasChangeRequestApproval_State)(0),      // 4: Superplane.Canvases.CanvasChangeRequestApproval.State
-	(CanvasChangeRequest_Status)(0),             // 5: Superplane.Canvases.CanvasChangeRequest.Status
-	(CanvasNodeExecution_State)(0),              // 6: Superplane.Canvases.CanvasNodeExecution.State
-	(CanvasNodeExecution_Result)(0),             // 7: Superplane.Canvases.CanvasNodeExecution.Result
-	(CanvasNodeExecution_ResultReason)(0),       // 8: Superplane.Canvases.CanvasNodeExecution.ResultReason
-	(*ListCanvasesRequest)(nil),                 // 9: Superplane.Canvases.ListCanvasesRequest
-	(*ListCanvasesResponse)(nil),                // 10: Superplane.Canvases.ListCanvasesResponse
-	(*DescribeCanvasRequest)(nil),               // 11: Superplane.Canvases.DescribeCanvasRequest
-	(*DescribeCanvasResponse)(nil),              // 12: Superplane.Canvases.DescribeCanvasResponse
-	(*UpdateCanvasRequest)(nil),                 // 13: Superplane.Canvases.UpdateCanvasRequest
-	(*UpdateCanvasResponse)(nil),                // 14: Superplane.Canvases.UpdateCanvasResponse
-	(*CreateCanvasRequest)(nil),                 // 15: Superplane.Canvases.CreateCanvasRequest
-	(*CreateCanvasResponse)(nil),                // 16: Superplane.Canvases.CreateCanvasResponse
-	(*CanvasAutoLayout)(nil),                    // 17: Superplane.Canvases.CanvasAutoLayout
-	(*CreateCanvasVersionRequest)(nil),          // 18: Superplane.Canvases.CreateCanvasVersionRequest
-	(*CreateCanvasVersionResponse)(nil),         // 19: Superplane.Canvases.CreateCanvasVersionResponse
-	(*ListCanvasVersionsRequest)(nil),           // 20: Superplane.Canvases.ListCanvasVersionsRequest
-	(*ListCanvasVersionsResponse)(nil),          // 21: Superplane.Canvases.ListCanvasVersionsResponse
-	(*DescribeCanvasVersionRequest)(nil),        // 22: Superplane.Canvases.DescribeCanvasVersionRequest
-	(*DescribeCanvasVersionResponse)(nil),       // 23: Superplane.Canvases.DescribeCanvasVersionResponse
-	(*UpdateCanvasVersionRequest)(nil),          // 24: Superplane.Canvases.UpdateCanvasVersionRequest
-	(*UpdateCanvasVersionResponse)(nil),         // 25: Superplane.Canvases.UpdateCanvasVersionResponse
-	(*CreateCanvasChangeRequestRequest)(nil),    // 26: Superplane.Canvases.CreateCanvasChangeRequestRequest
-	(*CreateCanvasChangeRequestResponse)(nil),   // 27: Superplane.Canvases.CreateCanvasChangeRequestResponse
-	(*ListCanvasChangeRequestsRequest)(nil),     // 28: Superplane.Canvases.ListCanvasChangeRequestsRequest
-	(*ListCanvasChangeRequestsResponse)(nil),    // 29: Superplane.Canvases.ListCanvasChangeRequestsResponse
-	(*DescribeCanvasChangeRequestRequest)(nil),  // 30: Superplane.Canvases.DescribeCanvasChangeRequestRequest
-	(*DescribeCanvasChangeRequestResponse)(nil), // 31: Superplane.Canvases.DescribeCanvasChangeRequestResponse
-	(*ActOnCanvasChangeRequestRequest)(nil),     // 32: Superplane.Canvases.ActOnCanvasChangeRequestRequest
-	(*ActOnCanvasChangeRequestResponse)(nil),    // 33: Superplane.Canvases.ActOnCanvasChangeRequestResponse
-	(*ResolveCanvasChangeRequestRequest)(nil),   // 34: Superplane.Canvases.ResolveCanvasChangeRequestRequest
-	(*ResolveCanvasChangeRequestResponse)(nil),  // 35: Superplane.Canvases.ResolveCanvasChangeRequestResponse
-	(*DeleteCanvasRequest)(nil),                 // 36: Superplane.Canvases.DeleteCanvasRequest
-	(*DeleteCanvasResponse)(nil),                // 37: Superplane.Canvases.DeleteCanvasResponse
-	(*UserRef)(nil),                             // 38: Superplane.Canvases.UserRef
-	(*Canvas)(nil),                              // 39: Superplane.Canvases.Canvas
-	(*CanvasVersion)(nil),                       // 40: Superplane.Canvases.CanvasVersion
-	(*CanvasChangeRequestDiff)(nil),             // 41: Superplane.Canvases.CanvasChangeRequestDiff
-	(*CanvasChangeRequestApprover)(nil),         // 42: Superplane.Canvases.CanvasChangeRequestApprover
-	(*CanvasChangeRequestApprovalConfig)(nil),   // 43: Superplane.Canvases.CanvasChangeRequestApprovalConfig
-	(*CanvasChangeRequestApproval)(nil),         // 44: Superplane.Canvases.CanvasChangeRequestApproval
-	(*CanvasChangeRequest)(nil),                 // 45: Superplane.Canvases.CanvasChangeRequest
-	(*ListNodeEventsRequest)(nil),               // 46: Superplane.Canvases.ListNodeEventsRequest
-	(*ListNodeEventsResponse)(nil),              // 47: Superplane.Canvases.ListNodeEventsResponse
-	(*EmitNodeEventRequest)(nil),                // 48: Superplane.Canvases.EmitNodeEventRequest
-	(*EmitNodeEventResponse)(nil),               // 49: Superplane.Canvases.EmitNodeEventResponse
-	(*ListNodeQueueItemsRequest)(nil),           // 50: Superplane.Canvases.ListNodeQueueItemsRequest
-	(*ListNodeQueueItemsResponse)(nil),          // 51: Superplane.Canvases.ListNodeQueueItemsResponse
-	(*DeleteNodeQueueItemRequest)(nil),          // 52: Superplane.Canvases.DeleteNodeQueueItemRequest
-	(*DeleteNodeQueueItemResponse)(nil),         // 53: Superplane.Canvases.DeleteNodeQueueItemResponse
-	(*UpdateNodePauseRequest)(nil),              // 54: Superplane.Canvases.UpdateNodePauseRequest
-	(*UpdateNodePauseResponse)(nil),             // 55: Superplane.Canvases.UpdateNodePauseResponse
-	(*ListNodeExecutionsRequest)(nil),           // 56: Superplane.Canvases.ListNodeExecutionsRequest
-	(*ListNodeExecutionsResponse)(nil),          // 57: Superplane.Canvases.ListNodeExecutionsResponse
-	(*ListChildExecutionsRequest)(nil),          // 58: Superplane.Canvases.ListChildExecutionsRequest
-	(*ListChildExecutionsResponse)(nil),         // 59: Superplane.Canvases.ListChildExecutionsResponse
-	(*CanvasNodeExecution)(nil),                 // 60: Superplane.Canvases.CanvasNodeExecution
-	(*CanvasNodeQueueItem)(nil),                 // 61: Superplane.Canvases.CanvasNodeQueueItem
-	(*InvokeNodeExecutionActionRequest)(nil),    // 62: Superplane.Canvases.InvokeNodeExecutionActionRequest
-	(*InvokeNodeExecutionActionResponse)(nil),   // 63: Superplane.Canvases.InvokeNodeExecutionActionResponse
-	(*InvokeNodeTriggerActionRequest)(nil),      // 64: Superplane.Canvases.InvokeNodeTriggerActionRequest
-	(*InvokeNodeTriggerActionResponse)(nil),     // 65: Superplane.Canvases.InvokeNodeTriggerActionResponse
-	(*ListCanvasEventsRequest)(nil),             // 66: Superplane.Canvases.ListCanvasEventsRequest
-	(*ListCanvasEventsResponse)(nil),            // 67: Superplane.Canvases.ListCanvasEventsResponse
-	(*CanvasMemory)(nil),                        // 68: Superplane.Canvases.CanvasMemory
-	(*ListCanvasMemoriesRequest)(nil),           // 69: Superplane.Canvases.ListCanvasMemoriesRequest
-	(*ListCanvasMemoriesResponse)(nil),          // 70: Superplane.Canvases.ListCanvasMemoriesResponse
-	(*DeleteCanvasMemoryRequest)(nil),           // 71: Superplane.Canvases.DeleteCanvasMemoryRequest
-	(*DeleteCanvasMemoryResponse)(nil),          // 72: Superplane.Canvases.DeleteCanvasMemoryResponse
-	(*CanvasEvent)(nil),                         // 73: Superplane.Canvases.CanvasEvent
-	(*CanvasEventWithExecutions)(nil),           // 74: Superplane.Canvases.CanvasEventWithExecutions
-	(*ListEventExecutionsRequest)(nil),          // 75: Superplane.Canvases.ListEventExecutionsRequest
-	(*ListEventExecutionsResponse)(nil),         // 76: Superplane.Canvases.ListEventExecutionsResponse
-	(*CancelExecutionRequest)(nil),              // 77: Superplane.Canvases.CancelExecutionRequest
-	(*CancelExecutionResponse)(nil),             // 78: Superplane.Canvases.CancelExecutionResponse
-	(*ResolveExecutionErrorsRequest)(nil),       // 79: Superplane.Canvases.ResolveExecutionErrorsRequest
-	(*ResolveExecutionErrorsResponse)(nil),      // 80: Superplane.Canvases.ResolveExecutionErrorsResponse
-	(*CanvasNodeEventMessage)(nil),              // 81: Superplane.Canvases.CanvasNodeEventMessage
-	(*CanvasNodeExecutionMessage)(nil),          // 82: Superplane.Canvases.CanvasNodeExecutionMessage
-	(*CanvasNodeQueueItemMessage)(nil),          // 83: Superplane.Canvases.CanvasNodeQueueItemMessage
-	(*CanvasMessage)(nil),                       // 84: Superplane.Canvases.CanvasMessage
-	(*CanvasVersionMessage)(nil),                // 85: Superplane.Canvases.CanvasVersionMessage
-	(*Canvas_Metadata)(nil),                     // 86: Superplane.Canvases.Canvas.Metadata
-	(*Canvas_Spec)(nil),                         // 87: Superplane.Canvases.Canvas.Spec
-	(*Canvas_Status)(nil),                       // 88: Superplane.Canvases.Canvas.Status
-	(*CanvasVersion_Metadata)(nil),              // 89: Superplane.Canvases.CanvasVersion.Metadata
-	(*CanvasChangeRequest_Metadata)(nil),        // 90: Superplane.Canvases.CanvasChangeRequest.Metadata
-	(*timestamp.Timestamp)(nil),                 // 91: google.protobuf.Timestamp
-	(*_struct.Struct)(nil),                      // 92: google.protobuf.Struct
-	(*components.Node)(nil),                     // 93: Superplane.Components.Node
-	(*_struct.Value)(nil),                       // 94: google.protobuf.Value
-	(*components.Edge)(nil),                     // 95: Superplane.Components.Edge
+	(CanvasAutoLayout_Algorithm)(0),                // 0: Superplane.Canvases.CanvasAutoLayout.Algorithm
+	(CanvasAutoLayout_Scope)(0),                    // 1: Superplane.Canvases.CanvasAutoLayout.Scope
+	(ActOnCanvasChangeRequestRequest_Action)(0),    // 2: Superplane.Canvases.ActOnCanvasChangeRequestRequest.Action
+	(CanvasVersionDiff_ChangeType)(0),              // 3: Superplane.Canvases.CanvasVersionDiff.ChangeType
+	(CanvasChangeRequestApprover_Type)(0),          // 4: Superplane.Canvases.CanvasChangeRequestApprover.Type
+	(CanvasChangeRequestCheck_Status)(0),           // 5: Superplane.Canvases.CanvasChangeRequestCheck.Status
+	(CanvasChangeRequestApproval_State)(0),         // 6: Superplane.Canvases.CanvasChangeRequestApproval.State
+	(CanvasChangeRequest_Status)(0),                // 7: Superplane.Canvases.CanvasChangeRequest.Status
+	(CanvasNodeExecution_State)(0),                 // 8: Superplane.Canvases.CanvasNodeExecution.State
+	(CanvasNodeExecution_Result)(0),                // 9: Superplane.Canvases.CanvasNodeExecution.Result
+	(CanvasNodeExecution_ResultReason)(0),          // 10: Superplane.Canvases.CanvasNodeExecution.ResultReason
+	(ExpressionDiagnostic_Severity)(0),             // 11: Superplane.Canvases.ExpressionDiagnostic.Severity
+	(ExpressionCompletion_Kind)(0),                 // 12: Superplane.Canvases.ExpressionCompletion.Kind
+	(*ListCanvasesRequest)(nil),                    // 13: Superplane.Canvases.ListCanvasesRequest
+	(*ListCanvasesResponse)(nil),                   // 14: Superplane.Canvases.ListCanvasesResponse
+	(*DescribeCanvasRequest)(nil),                  // 15: Superplane.Canvases.DescribeCanvasRequest
+	(*DescribeCanvasResponse)(nil),                 // 16: Superplane.Canvases.DescribeCanvasResponse
+	(*UpdateCanvasRequest)(nil),                    // 17: Superplane.Canvases.UpdateCanvasRequest
+	(*UpdateCanvasResponse)(nil),                   // 18: Superplane.Canvases.UpdateCanvasResponse
+	(*CreateCanvasRequest)(nil),                    // 19: Superplane.Canvases.CreateCanvasRequest
+	(*CreateCanvasResponse)(nil),                   // 20: Superplane.Canvases.CreateCanvasResponse
+	(*CanvasAutoLayout)(nil),                       // 21: Superplane.Canvases.CanvasAutoLayout
+	(*CreateCanvasVersionRequest)(nil),             // 22: Superplane.Canvases.CreateCanvasVersionRequest
+	(*CreateCanvasVersionResponse)(nil),            // 23: Superplane.Canvases.CreateCanvasVersionResponse
+	(*ListCanvasVersionsRequest)(nil),              // 24: Superplane.Canvases.ListCanvasVersionsRequest
+	(*ListCanvasVersionsResponse)(nil),             // 25: Superplane.Canvases.ListCanvasVersionsResponse
+	(*DescribeCanvasVersionRequest)(nil),           // 26: Superplane.Canvases.DescribeCanvasVersionRequest
+	(*DescribeCanvasVersionResponse)(nil),          // 27: Superplane.Canvases.DescribeCanvasVersionResponse
+	(*UpdateCanvasVersionRequest)(nil),             // 28: Superplane.Canvases.UpdateCanvasVersionRequest
+	(*UpdateCanvasVersionResponse)(nil),            // 29: Superplane.Canvases.UpdateCanvasVersionResponse
+	(*DiffCanvasVersionsRequest)(nil),              // 30: Superplane.Canvases.DiffCanvasVersionsRequest
+	(*DiffCanvasVersionsResponse)(nil),             // 31: Superplane.Canvases.DiffCanvasVersionsResponse
+	(*RollbackCanvasVersionRequest)(nil),           // 32: Superplane.Canvases.RollbackCanvasVersionRequest
+	(*RollbackCanvasVersionResponse)(nil),          // 33: Superplane.Canvases.RollbackCanvasVersionResponse
+	(*CreateCanvasChangeRequestRequest)(nil),       // 34: Superplane.Canvases.CreateCanvasChangeRequestRequest
+	(*CreateCanvasChangeRequestResponse)(nil),      // 35: Superplane.Canvases.CreateCanvasChangeRequestResponse
+	(*ListCanvasChangeRequestsRequest)(nil),        // 36: Superplane.Canvases.ListCanvasChangeRequestsRequest
+	(*ListCanvasChangeRequestsResponse)(nil),       // 37: Superplane.Canvases.ListCanvasChangeRequestsResponse
+	(*DescribeCanvasChangeRequestRequest)(nil),     // 38: Superplane.Canvases.DescribeCanvasChangeRequestRequest
+	(*DescribeCanvasChangeRequestResponse)(nil),    // 39: Superplane.Canvases.DescribeCanvasChangeRequestResponse
+	(*ActOnCanvasChangeRequestRequest)(nil),        // 40: Superplane.Canvases.ActOnCanvasChangeRequestRequest
+	(*ActOnCanvasChangeRequestResponse)(nil),       // 41: Superplane.Canvases.ActOnCanvasChangeRequestResponse
+	(*ResolveCanvasChangeRequestRequest)(nil),      // 42: Superplane.Canvases.ResolveCanvasChangeRequestRequest
+	(*ResolveCanvasChangeRequestResponse)(nil),     // 43: Superplane.Canvases.ResolveCanvasChangeRequestResponse
+	(*DeleteCanvasRequest)(nil),                    // 44: Superplane.Canvases.DeleteCanvasRequest
+	(*DeleteCanvasResponse)(nil),                   // 45: Superplane.Canvases.DeleteCanvasResponse
+	(*CanvasBundle)(nil),                           // 46: Superplane.Canvases.CanvasBundle
+	(*ExportCanvasRequest)(nil),                    // 47: Superplane.Canvases.ExportCanvasRequest
+	(*ExportCanvasResponse)(nil),                   // 48: Superplane.Canvases.ExportCanvasResponse
+	(*ImportCanvasRequest)(nil),                    // 49: Superplane.Canvases.ImportCanvasRequest
+	(*ImportCanvasResponse)(nil),                   // 50: Superplane.Canvases.ImportCanvasResponse
+	(*UserRef)(nil),                                // 51: Superplane.Canvases.UserRef
+	(*Canvas)(nil),                                 // 52: Superplane.Canvases.Canvas
+	(*CanvasVariable)(nil),                         // 53: Superplane.Canvases.CanvasVariable
+	(*CanvasVersion)(nil),                          // 54: Superplane.Canvases.CanvasVersion
+	(*CanvasVersionDiff)(nil),                      // 55: Superplane.Canvases.CanvasVersionDiff
+	(*CanvasChangeRequestDiff)(nil),                // 56: Superplane.Canvases.CanvasChangeRequestDiff
+	(*CanvasChangeRequestApprover)(nil),            // 57: Superplane.Canvases.CanvasChangeRequestApprover
+	(*CanvasChangeRequestApprovalConfig)(nil),      // 58: Superplane.Canvases.CanvasChangeRequestApprovalConfig
+	(*CanvasChangeRequestCheckConfig)(nil),         // 59: Superplane.Canvases.CanvasChangeRequestCheckConfig
+	(*CanvasNodeActionPermissions)(nil),            // 60: Superplane.Canvases.CanvasNodeActionPermissions
+	(*CanvasNodeActionPermissionsConfig)(nil),      // 61: Superplane.Canvases.CanvasNodeActionPermissionsConfig
+	(*CanvasChangeRequestCheck)(nil),               // 62: Superplane.Canvases.CanvasChangeRequestCheck
+	(*CanvasChangeRequestApproval)(nil),            // 63: Superplane.Canvases.CanvasChangeRequestApproval
+	(*CanvasChangeRequest)(nil),                    // 64: Superplane.Canvases.CanvasChangeRequest
+	(*ListNodeEventsRequest)(nil),                  // 65: Superplane.Canvases.ListNodeEventsRequest
+	(*ListNodeEventsResponse)(nil),                 // 66: Superplane.Canvases.ListNodeEventsResponse
+	(*EmitNodeEventRequest)(nil),                   // 67: Superplane.Canvases.EmitNodeEventRequest
+	(*EmitNodeEventResponse)(nil),                  // 68: Superplane.Canvases.EmitNodeEventResponse
+	(*ListNodeQueueItemsRequest)(nil),              // 69: Superplane.Canvases.ListNodeQueueItemsRequest
+	(*ListNodeQueueItemsResponse)(nil),             // 70: Superplane.Canvases.ListNodeQueueItemsResponse
+	(*DeleteNodeQueueItemRequest)(nil),             // 71: Superplane.Canvases.DeleteNodeQueueItemRequest
+	(*DeleteNodeQueueItemResponse)(nil),            // 72: Superplane.Canvases.DeleteNodeQueueItemResponse
+	(*UpdateNodePauseRequest)(nil),                 // 73: Superplane.Canvases.UpdateNodePauseRequest
+	(*UpdateNodePauseResponse)(nil),                // 74: Superplane.Canvases.UpdateNodePauseResponse
+	(*ListNodeExecutionsRequest)(nil),              // 75: Superplane.Canvases.ListNodeExecutionsRequest
+	(*ListNodeExecutionsResponse)(nil),             // 76: Superplane.Canvases.ListNodeExecutionsResponse
+	(*ListChildExecutionsRequest)(nil),             // 77: Superplane.Canvases.ListChildExecutionsRequest
+	(*ListChildExecutionsResponse)(nil),            // 78: Superplane.Canvases.ListChildExecutionsResponse
+	(*CanvasNodeExecution)(nil),                    // 79: Superplane.Canvases.CanvasNodeExecution
+	(*CanvasNodeQueueItem)(nil),                    // 80: Superplane.Canvases.CanvasNodeQueueItem
+	(*InvokeNodeExecutionActionRequest)(nil),       // 81: Superplane.Canvases.InvokeNodeExecutionActionRequest
+	(*InvokeNodeExecutionActionResponse)(nil),      // 82: Superplane.Canvases.InvokeNodeExecutionActionResponse
+	(*InvokeNodeTriggerActionRequest)(nil),         // 83: Superplane.Canvases.InvokeNodeTriggerActionRequest
+	(*InvokeNodeTriggerActionResponse)(nil),        // 84: Superplane.Canvases.InvokeNodeTriggerActionResponse
+	(*ListCanvasEventsRequest)(nil),                // 85: Superplane.Canvases.ListCanvasEventsRequest
+	(*ListCanvasEventsResponse)(nil),               // 86: Superplane.Canvases.ListCanvasEventsResponse
+	(*CanvasMemory)(nil),                           // 87: Superplane.Canvases.CanvasMemory
+	(*ListCanvasMemoriesRequest)(nil),              // 88: Superplane.Canvases.ListCanvasMemoriesRequest
+	(*ListCanvasMemoriesResponse)(nil),             // 89: Superplane.Canvases.ListCanvasMemoriesResponse
+	(*DeleteCanvasMemoryRequest)(nil),              // 90: Superplane.Canvases.DeleteCanvasMemoryRequest
+	(*DeleteCanvasMemoryResponse)(nil),             // 91: Superplane.Canvases.DeleteCanvasMemoryResponse
+	(*CanvasMemoryNamespace)(nil),                  // 92: Superplane.Canvases.CanvasMemoryNamespace
+	(*ListCanvasMemoryNamespacesRequest)(nil),      // 93: Superplane.Canvases.ListCanvasMemoryNamespacesRequest
+	(*ListCanvasMemoryNamespacesResponse)(nil),     // 94: Superplane.Canvases.ListCanvasMemoryNamespacesResponse
+	(*UpdateCanvasMemoryNamespaceRequest)(nil),     // 95: Superplane.Canvases.UpdateCanvasMemoryNamespaceRequest
+	(*UpdateCanvasMemoryNamespaceResponse)(nil),    // 96: Superplane.Canvases.UpdateCanvasMemoryNamespaceResponse
+	(*DeleteCanvasMemoryNamespaceRequest)(nil),     // 97: Superplane.Canvases.DeleteCanvasMemoryNamespaceRequest
+	(*DeleteCanvasMemoryNamespaceResponse)(nil),    // 98: Superplane.Canvases.DeleteCanvasMemoryNamespaceResponse
+	(*CanvasGitSync)(nil),                          // 99: Superplane.Canvases.CanvasGitSync
+	(*DescribeCanvasGitSyncRequest)(nil),           // 100: Superplane.Canvases.DescribeCanvasGitSyncRequest
+	(*DescribeCanvasGitSyncResponse)(nil),          // 101: Superplane.Canvases.DescribeCanvasGitSyncResponse
+	(*UpdateCanvasGitSyncRequest)(nil),             // 102: Superplane.Canvases.UpdateCanvasGitSyncRequest
+	(*UpdateCanvasGitSyncResponse)(nil),            // 103: Superplane.Canvases.UpdateCanvasGitSyncResponse
+	(*DeleteCanvasGitSyncRequest)(nil),             // 104: Superplane.Canvases.DeleteCanvasGitSyncRequest
+	(*DeleteCanvasGitSyncResponse)(nil),            // 105: Superplane.Canvases.DeleteCanvasGitSyncResponse
+	(*CanvasMember)(nil),                           // 106: Superplane.Canvases.CanvasMember
+	(*CanvasGroup)(nil),                            // 107: Superplane.Canvases.CanvasGroup
+	(*ListCanvasMembersRequest)(nil),               // 108: Superplane.Canvases.ListCanvasMembersRequest
+	(*ListCanvasMembersResponse)(nil),              // 109: Superplane.Canvases.ListCanvasMembersResponse
+	(*UpdateCanvasMemberRequest)(nil),              // 110: Superplane.Canvases.UpdateCanvasMemberRequest
+	(*UpdateCanvasMemberResponse)(nil),             // 111: Superplane.Canvases.UpdateCanvasMemberResponse
+	(*RemoveCanvasMemberRequest)(nil),              // 112: Superplane.Canvases.RemoveCanvasMemberRequest
+	(*RemoveCanvasMemberResponse)(nil),             // 113: Superplane.Canvases.RemoveCanvasMemberResponse
+	(*ListCanvasGroupsRequest)(nil),                // 114: Superplane.Canvases.ListCanvasGroupsRequest
+	(*ListCanvasGroupsResponse)(nil),               // 115: Superplane.Canvases.ListCanvasGroupsResponse
+	(*UpdateCanvasGroupRequest)(nil),               // 116: Superplane.Canvases.UpdateCanvasGroupRequest
+	(*UpdateCanvasGroupResponse)(nil),              // 117: Superplane.Canvases.UpdateCanvasGroupResponse
+	(*RemoveCanvasGroupRequest)(nil),               // 118: Superplane.Canvases.RemoveCanvasGroupRequest
+	(*RemoveCanvasGroupResponse)(nil),              // 119: Superplane.Canvases.RemoveCanvasGroupResponse
+	(*ValidateExpressionRequest)(nil),              // 120: Superplane.Canvases.ValidateExpressionRequest
+	(*ValidateExpressionResponse)(nil),             // 121: Superplane.Canvases.ValidateExpressionResponse
+	(*ExpressionDiagnostic)(nil),                   // 122: Superplane.Canvases.ExpressionDiagnostic
+	(*CompleteExpressionRequest)(nil),              // 123: Superplane.Canvases.CompleteExpressionRequest
+	(*CompleteExpressionResponse)(nil),             // 124: Superplane.Canvases.CompleteExpressionResponse
+	(*ExpressionCompletion)(nil),                   // 125: Superplane.Canvases.ExpressionCompletion
+	(*CanvasEvent)(nil),                            // 126: Superplane.Canvases.CanvasEvent
+	(*CanvasEventWithExecutions)(nil),              // 127: Superplane.Canvases.CanvasEventWithExecutions
+	(*ListEventExecutionsRequest)(nil),             // 128: Superplane.Canvases.ListEventExecutionsRequest
+	(*ListEventExecutionsResponse)(nil),            // 129: Superplane.Canvases.ListEventExecutionsResponse
+	(*CancelExecutionRequest)(nil),                 // 130: Superplane.Canvases.CancelExecutionRequest
+	(*CancelExecutionResponse)(nil),                // 131: Superplane.Canvases.CancelExecutionResponse
+	(*ResolveExecutionErrorsRequest)(nil),          // 132: Superplane.Canvases.ResolveExecutionErrorsRequest
+	(*ResolveExecutionErrorsResponse)(nil),         // 133: Superplane.Canvases.ResolveExecutionErrorsResponse
+	(*CanvasNodeEventMessage)(nil),                 // 134: Superplane.Canvases.CanvasNodeEventMessage
+	(*CanvasNodeExecutionMessage)(nil),             // 135: Superplane.Canvases.CanvasNodeExecutionMessage
+	(*CanvasNodeQueueItemMessage)(nil),             // 136: Superplane.Canvases.CanvasNodeQueueItemMessage
+	(*CanvasMessage)(nil),                          // 137: Superplane.Canvases.CanvasMessage
+	(*CanvasVersionMessage)(nil),                   // 138: Superplane.Canvases.CanvasVersionMessage
+	(*CanvasBundle_Metadata)(nil),                  // 139: Superplane.Canvases.CanvasBundle.Metadata
+	(*CanvasBundle_IntegrationReference)(nil),      // 140: Superplane.Canvases.CanvasBundle.IntegrationReference
+	(*CanvasBundle_SecretReference)(nil),           // 141: Superplane.Canvases.CanvasBundle.SecretReference
+	(*ImportCanvasRequest_IntegrationMapping)(nil), // 142: Superplane.Canvases.ImportCanvasRequest.IntegrationMapping
+	(*Canvas_Metadata)(nil),                        // 143: Superplane.Canvases.Canvas.Metadata
+	(*Canvas_Spec)(nil),                            // 144: Superplane.Canvases.Canvas.Spec
+	(*Canvas_Status)(nil),                          // 145: Superplane.Canvases.Canvas.Status
+	(*CanvasVariable_SecretRef)(nil),               // 146: Superplane.Canvases.CanvasVariable.SecretRef
+	(*CanvasVariable_Override)(nil),                // 147: Superplane.Canvases.CanvasVariable.Override
+	(*CanvasVersion_Metadata)(nil),                 // 148: Superplane.Canvases.CanvasVersion.Metadata
+	(*CanvasVersionDiff_FieldChange)(nil),          // 149: Superplane.Canvases.CanvasVersionDiff.FieldChange
+	(*CanvasVersionDiff_NodeChange)(nil),           // 150: Superplane.Canvases.CanvasVersionDiff.NodeChange
+	(*CanvasVersionDiff_EdgeChange)(nil),           // 151: Superplane.Canvases.CanvasVersionDiff.EdgeChange
+	(*CanvasVersionDiff_VariableChange)(nil),       // 152: Superplane.Canvases.CanvasVersionDiff.VariableChange
+	(*CanvasChangeRequestCheck_Problem)(nil),       // 153: Superplane.Canvases.CanvasChangeRequestCheck.Problem
+	(*CanvasChangeRequest_Metadata)(nil),           // 154: Superplane.Canvases.CanvasChangeRequest.Metadata
+	(*CanvasMemoryNamespace_Field)(nil),            // 155: Superplane.Canvases.CanvasMemoryNamespace.Field
+	(*CanvasGitSync_Status)(nil),                   // 156: Superplane.Canvases.CanvasGitSync.Status
+	(*timestamp.Timestamp)(nil),                    // 157: google.protobuf.Timestamp
+	(*blueprints.Blueprint)(nil),                   // 158: Superplane.Blueprints.Blueprint
+	(*_struct.Struct)(nil),                         // 159: google.protobuf.Struct
+	(*components.Node)(nil),                        // 160: Superplane.Components.Node
+	(*_struct.Value)(nil),                          // 161: google.protobuf.Value
+	(*components.Edge)(nil),                        // 162: Superplane.Components.Edge
 }
 var file_canvases_proto_depIdxs = []int32{
-	39,  // 0: Superplane.Canvases.ListCanvasesResponse.canvases:type_name -> Superplane.Canvases.Canvas
-	39,  // 1: Superplane.Canvases.DescribeCanvasResponse.canvas:type_name -> Superplane.Canvases.Canvas
-	43,  // 2: Superplane.Canvases.UpdateCanvasRequest.change_request_approval_config:type_name -> Superplane.Canvases.CanvasChangeRequestApprovalConfig
-	39,  // 3: Superplane.Canvases.UpdateCanvasResponse.canvas:type_name -> Superplane.Canvases.Canvas
-	39,  // 4: Superplane.Canvases.CreateCanvasRequest.canvas:type_name -> Superplane.Canvases.Canvas
-	17,  // 5: Superplane.Canvases.CreateCanvasRequest.auto_layout:type_name -> Superplane.Canvases.CanvasAutoLayout
-	39,  // 6: Superplane.Canvases.CreateCanvasResponse.canvas:type_name -> Superplane.Canvases.Canvas
-	0,   // 7: Superplane.Canvases.CanvasAutoLayout.algorithm:type_name -> Superplane.Canvases.CanvasAutoLayout.Algorithm
-	1,   // 8: Superplane.Canvases.CanvasAutoLayout.scope:type_name -> Superplane.Canvases.CanvasAutoLayout.Scope
-	40,  // 9: Superplane.Canvases.CreateCanvasVersionResponse.version:type_name -> Superplane.Canvases.CanvasVersion
-	91,  // 10: Superplane.Canvases.ListCanvasVersionsRequest.before:type_name -> google.protobuf.Timestamp
-	40,  // 11: Superplane.Canvases.ListCanvasVersionsResponse.versions:type_name -> Superplane.Canvases.CanvasVersion
-	91,  // 12: Superplane.Canvases.ListCanvasVersionsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
-	40,  // 13: Superplane.Canvases.DescribeCanvasVersionResponse.version:type_name -> Superplane.Canvases.CanvasVersion
-	39,  // 14: Superplane.Canvases.UpdateCanvasVersionRequest.canvas:type_name -> Superplane.Canvases.Canvas
-	17,  // 15: Superplane.Canvases.UpdateCanvasVersionRequest.auto_layout:type_name -> Superplane.Canvases.CanvasAutoLayout
-	40,  // 16: Superplane.Canvases.UpdateCanvasVersionResponse.version:type_name -> Superplane.Canvases.CanvasVersion
-	45,  // 17: Superplane.Canvases.CreateCanvasChangeRequestResponse.change_request:type_name -> Superplane.Canvases.CanvasChangeRequest
-	91,  // 18: Superplane.Canvases.ListCanvasChangeRequestsRequest.before:type_name -> google.protobuf.Timestamp
-	45,  // 19: Superplane.Canvases.ListCanvasChangeRequestsResponse.change_requests:type_name -> Superplane.Canvases.CanvasChangeRequest
-	91,  // 20: Superplane.Canvases.ListCanvasChangeRequestsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
-	45,  // 21: Superplane.Canvases.DescribeCanvasChangeRequestResponse.change_request:type_name -> Superplane.Canvases.CanvasChangeRequest
-	2,   // 22: Superplane.Canvases.ActOnCanvasChangeRequestRequest.action:type_name -> Superplane.Canvases.ActOnCanvasChangeRequestRequest.Action
-	45,  // 23: Superplane.Canvases.ActOnCanvasChangeRequestResponse.change_request:type_name -> Superplane.Canvases.CanvasChangeRequest
-	39,  // 24: Superplane.Canvases.ResolveCanvasChangeRequestRequest.canvas:type_name -> Superplane.Canvases.Canvas
-	17,  // 25: Superplane.Canvases.ResolveCanvasChangeRequestRequest.auto_layout:type_name -> Superplane.Canvases.CanvasAutoLayout
-	40,  // 26: Superplane.Canvases.ResolveCanvasChangeRequestResponse.version:type_name -> Superplane.Canvases.CanvasVersion
-	45,  // 27: Superplane.Canvases.ResolveCanvasChangeRequestResponse.change_request:type_name -> Superplane.Canvases.CanvasChangeRequest
-	86,  // 28: Superplane.Canvases.Canvas.metadata:type_name -> Superplane.Canvases.Canvas.Metadata
-	87,  // 29: Superplane.Canvases.Canvas.spec:type_name -> Superplane.Canvases.Canvas.Spec
-	88,  // 30: Superplane.Canvases.Canvas.status:type_name -> Superplane.Canvases.Canvas.Status
-	89,  // 31: Superplane.Canvases.CanvasVersion.metadata:type_name -> Superplane.Canvases.CanvasVersion.Metadata
-	87,  // 32: Superplane.Canvases.CanvasVersion.spec:type_name -> Superplane.Canvases.Canvas.Spec
-	3,   // 33: Superplane.Canvases.CanvasChangeRequestApprover.type:type_name -> Superplane.Canvases.CanvasChangeRequestApprover.Type
-	42,  // 34: Superplane.Canvases.CanvasChangeRequestApprovalConfig.items:type_name -> Superplane.Canvases.CanvasChangeRequestApprover
-	38,  // 35: Superplane.Canvases.CanvasChangeRequestApproval.actor:type_name -> Superplane.Canvases.UserRef
-	42,  // 36: Superplane.Canvases.CanvasChangeRequestApproval.approver:type_name -> Superplane.Canvases.CanvasChangeRequestApprover
-	4,   // 37: Superplane.Canvases.CanvasChangeRequestApproval.state:type_name -> Superplane.Canvases.CanvasChangeRequestApproval.State
-	91,  // 38: Superplane.Canvases.CanvasChangeRequestApproval.created_at:type_name -> google.protobuf.Timestamp
-	91,  // 39: Superplane.Canvases.CanvasChangeRequestApproval.invalidated_at:type_name -> google.protobuf.Timestamp
-	90,  // 40: Superplane.Canvases.CanvasChangeRequest.metadata:type_name -> Superplane.Canvases.CanvasChangeRequest.Metadata
-	40,  // 41: Superplane.Canvases.CanvasChangeRequest.version:type_name -> Superplane.Canvases.CanvasVersion
-	41,  // 42: Superplane.Canvases.CanvasChangeRequest.diff:type_name -> Superplane.Canvases.CanvasChangeRequestDiff
-	44,  // 43: Superplane.Canvases.CanvasChangeRequest.approvals:type_name -> Superplane.Canvases.CanvasChangeRequestApproval
-	91,  // 44: Superplane.Canvases.ListNodeEventsRequest.before:type_name -> google.protobuf.Timestamp
-	73,  // 45: Superplane.Canvases.ListNodeEventsResponse.events:type_name -> Superplane.Canvases.CanvasEvent
-	91,  // 46: Superplane.Canvases.ListNodeEventsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
-	92,  // 47: Superplane.Canvases.EmitNodeEventRequest.data:type_name -> google.protobuf.Struct
-	91,  // 48: Superplane.Canvases.ListNodeQueueItemsRequest.before:type_name -> google.protobuf.Timestamp
-	61,  // 49: Superplane.Canvases.ListNodeQueueItemsResponse.items:type_name -> Superplane.Canvases.CanvasNodeQueueItem
-	91,  // 50: Superplane.Canvases.ListNodeQueueItemsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
-	93,  // 51: Superplane.Canvases.UpdateNodePauseResponse.node:type_name -> Superplane.Components.Node
-	6,   // 52: Superplane.Canvases.ListNodeExecutionsRequest.states:type_name -> Superplane.Canvases.CanvasNodeExecution.State
-	7,   // 53: Superplane.Canvases.ListNodeExecutionsRequest.results:type_name -> Superplane.Canvases.CanvasNodeExecution.Result
-	91,  // 54: Superplane.Canvases.ListNodeExecutionsRequest.before:type_name -> google.protobuf.Timestamp
-	60,  // 55: Superplane.Canvases.ListNodeExecutionsResponse.executions:type_name -> Superplane.Canvases.CanvasNodeExecution
-	91,  // 56: Superplane.Canvases.ListNodeExecutionsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
-	60,  // 57: Superplane.Canvases.ListChildExecutionsResponse.executions:type_name -> Superplane.Canvases.CanvasNodeExecution
-	6,   // 58: Superplane.Canvases.CanvasNodeExecution.state:type_name -> Superplane.Canvases.CanvasNodeExecution.State
-	7,   // 59: Superplane.Canvases.CanvasNodeExecution.result:type_name -> Superplane.Canvases.CanvasNodeExecution.Result
-	8,   // 60: Superplane.Canvases.CanvasNodeExecution.result_reason:type_name -> Superplane.Canvases.CanvasNodeExecution.ResultReason
-	92,  // 61: Superplane.Canvases.CanvasNodeExecution.input:type_name -> google.protobuf.Struct
-	92,  // 62: Superplane.Canvases.CanvasNodeExecution.outputs:type_name -> google.protobuf.Struct
-	91,  // 63: Superplane.Canvases.CanvasNodeExecution.created_at:type_name -> google.protobuf.Timestamp
-	91,  // 64: Superplane.Canvases.CanvasNodeExecution.updated_at:type_name -> google.protobuf.Timestamp
-	92,  // 65: Superplane.Canvases.CanvasNodeExecution.metadata:type_name -> google.protobuf.Struct
-	92,  // 66: Superplane.Canvases.CanvasNodeExecution.configuration:type_name -> google.protobuf.Struct
-	60,  // 67: Superplane.Canvases.CanvasNodeExecution.child_executions:type_name -> Superplane.Canvases.CanvasNodeExecution
-	73,  // 68: Superplane.Canvases.CanvasNodeExecution.root_event:type_name -> Superplane.Canvases.CanvasEvent
-	38,  // 69: Superplane.Canvases.CanvasNodeExecution.cancelled_by:type_name -> Superplane.Canvases.UserRef
-	92,  // 70: Superplane.Canvases.CanvasNodeQueueItem.input:type_name -> google.protobuf.Struct
-	73,  // 71: Superplane.Canvases.CanvasNodeQueueItem.root_event:type_name -> Superplane.Canvases.CanvasEvent
-	91,  // 72: Superplane.Canvases.CanvasNodeQueueItem.created_at:type_name -> google.protobuf.Timestamp
-	92,  // 73: Superplane.Canvases.InvokeNodeExecutionActionRequest.parameters:type_name -> google.protobuf.Struct
-	92,  // 74: Superplane.Canvases.InvokeNodeTriggerActionRequest.parameters:type_name -> google.protobuf.Struct
-	92,  // 75: Superplane.Canvases.InvokeNodeTriggerActionResponse.result:type_name -> google.protobuf.Struct
-	91,  // 76: Superplane.Canvases.ListCanvasEventsRequest.before:type_name -> google.protobuf.Timestamp
-	74,  // 77: Superplane.Canvases.ListCanvasEventsResponse.events:type_name -> Superplane.Canvases.CanvasEventWithExecutions
-	91,  // 78: Superplane.Canvases.ListCanvasEventsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
-	94,  // 79: Superplane.Canvases.CanvasMemory.values:type_name -> google.protobuf.Value
-	68,  // 80: Superplane.Canvases.ListCanvasMemoriesResponse.items:type_name -> Superplane.Canvases.CanvasMemory
-	92,  // 81: Superplane.Canvases.CanvasEvent.data:type_name -> google.protobuf.Struct
-	91,  // 82: Superplane.Canvases.CanvasEvent.created_at:type_name -> google.protobuf.Timestamp
-	92,  // 83: Superplane.Canvases.CanvasEventWithExecutions.data:type_name -> google.protobuf.Struct
-	91,  // 84: Superplane.Canvases.CanvasEventWithExecutions.created_at:type_name -> google.protobuf.Timestamp
-	60,  // 85: Superplane.Canvases.CanvasEventWithExecutions.executions:type_name -> Superplane.Canvases.CanvasNodeExecution
-	60,  // 86: Superplane.Canvases.ListEventExecutionsResponse.executions:type_name -> Superplane.Canvases.CanvasNodeExecution
-	91,  // 87: Superplane.Canvases.CanvasNodeEventMessage.timestamp:type_name -> google.protobuf.Timestamp
-	91,  // 88: Superplane.Canvases.CanvasNodeExecutionMessage.timestamp:type_name -> google.protobuf.Timestamp
-	91,  // 89: Superplane.Canvases.CanvasNodeQueueItemMessage.timestamp:type_name -> google.protobuf.Timestamp
-	91,  // 90: Superplane.Canvases.CanvasMessage.timestamp:type_name -> google.protobuf.Timestamp
-	91,  // 91: Superplane.Canvases.CanvasVersionMessage.timestamp:type_name -> google.protobuf.Timestamp
-	91,  // 92: Superplane.Canvases.Canvas.Metadata.created_at:type_name -> google.protobuf.Timestamp
-	91,  // 93: Superplane.Canvases.Canvas.Metadata.updated_at:type_name -> google.protobuf.Timestamp
-	38,  // 94: Superplane.Canvases.Canvas.Metadata.created_by:type_name -> Superplane.Canvases.UserRef
-	43,  // 95: Superplane.Canvases.Canvas.Metadata.change_request_approval_config:type_name -> Superplane.Canvases.CanvasChangeRequestApprovalConfig
-	93,  // 96: Superplane.Canvases.Canvas.Spec.nodes:type_name -> Superplane.Components.Node
-	95,  // 97: Superplane.Canvases.Canvas.Spec.edges:type_name -> Superplane.Components.Edge
-	60,  // 98: Superplane.Canvases.Canvas.Status.last_executions:type_name -> Superplane.Canvases.CanvasNodeExecution
-	61,  // 99: Superplane.Canvases.Canvas.Status.next_queue_items:type_name -> Superplane.Canvases.CanvasNodeQueueItem
-	73,  // 100: Superplane.Canvases.Canvas.Status.last_events:type_name -> Superplane.Canvases.CanvasEvent
-	38,  // 101: Superplane.Canvases.CanvasVersion.Metadata.owner:type_name -> Superplane.Canvases.UserRef
-	91,  // 102: Superplane.Canvases.CanvasVersion.Metadata.published_at:type_name -> google.protobuf.Timestamp
-	91,  // 103: Superplane.Canvases.CanvasVersion.Metadata.created_at:type_name -> google.protobuf.Timestamp
-	91,  // 104: Superplane.Canvases.CanvasVersion.Metadata.updated_at:type_name -> google.protobuf.Timestamp
-	38,  // 105: Superplane.Canvases.CanvasChangeRequest.Metadata.owner:type_name -> Superplane.Canvases.UserRef
-	5,   // 106: Superplane.Canvases.CanvasChangeRequest.Metadata.status:type_name -> Superplane.Canvases.CanvasChangeRequest.Status
-	91,  // 107: Superplane.Canvases.CanvasChangeRequest.Metadata.published_at:type_name -> google.protobuf.Timestamp
-	91,  // 108: Superplane.Canvases.CanvasChangeRequest.Metadata.created_at:type_name -> google.protobuf.Timestamp
-	91,  // 109: Superplane.Canvases.CanvasChangeRequest.Metadata.updated_at:type_name -> google.protobuf.Timestamp
-	9,   // 110: Superplane.Canvases.Canvases.ListCanvases:input_type -> Superplane.Canvases.ListCanvasesRequest
-	15,  // 111: Superplane.Canvases.Canvases.CreateCanvas:input_type -> Superplane.Canvases.CreateCanvasRequest
-	11,  // 112: Superplane.Canvases.Canvases.DescribeCanvas:input_type -> Superplane.Canvases.DescribeCanvasRequest
-	13,  // 113: Superplane.Canvases.Canvases.UpdateCanvas:input_type -> Superplane.Canvases.UpdateCanvasRequest
-	18,  // 114: Superplane.Canvases.Canvases.CreateCanvasVersion:input_type -> Superplane.Canvases.CreateCanvasVersionRequest
-	20,  // 115: Superplane.Canvases.Canvases.ListCanvasVersions:input_type -> Superplane.Canvases.ListCanvasVersionsRequest
-	22,  // 116: Superplane.Canvases.Canvases.DescribeCanvasVersion:input_type -> Superplane.Canvases.DescribeCanvasVersionRequest
-	24,  // 117: Superplane.Canvases.Canvases.UpdateCanvasVersion:input_type -> Superplane.Canvases.UpdateCanvasVersionRequest
-	26,  // 118: Superplane.Canvases.Canvases.CreateCanvasChangeRequest:input_type -> Superplane.Canvases.CreateCanvasChangeRequestRequest
-	28,  // 119: Superplane.Canvases.Canvases.ListCanvasChangeRequests:input_type -> Superplane.Canvases.ListCanvasChangeRequestsRequest
-	30,  // 120: Superplane.Canvases.Canvases.DescribeCanvasChangeRequest:input_type -> Superplane.Canvases.DescribeCanvasChangeRequestRequest
-	32,  // 121: Superplane.Canvases.Canvases.ActOnCanvasChangeRequest:input_type -> Superplane.Canvases.ActOnCanvasChangeRequestRequest
-	34,  // 122: Superplane.Canvases.Canvases.ResolveCanvasChangeRequest:input_type -> Superplane.Canvases.ResolveCanvasChangeRequestRequest
-	36,  // 123: Superplane.Canvases.Canvases.DeleteCanvas:input_type -> Superplane.Canvases.DeleteCanvasRequest
-	50,  // 124: Superplane.Canvases.Canvases.ListNodeQueueItems:input_type -> Superplane.Canvases.ListNodeQueueItemsRequest
-	52,  // 125: Superplane.Canvases.Canvases.DeleteNodeQueueItem:input_type -> Superplane.Canvases.DeleteNodeQueueItemRequest
-	54,  // 126: Superplane.Canvases.Canvases.UpdateNodePause:input_type -> Superplane.Canvases.UpdateNodePauseRequest
-	56,  // 127: Superplane.Canvases.Canvases.ListNodeExecutions:input_type -> Superplane.Canvases.ListNodeExecutionsRequest
-	46,  // 128: Superplane.Canvases.Canvases.ListNodeEvents:input_type -> Superplane.Canvases.ListNodeEventsRequest
-	48,  // 129: Superplane.Canvases.Canvases.EmitNodeEvent:input_type -> Superplane.Canvases.EmitNodeEventRequest
-	62,  // 130: Superplane.Canvases.Canvases.InvokeNodeExecutionAction:input_type -> Superplane.Canvases.InvokeNodeExecutionActionRequest
-	64,  // 131: Superplane.Canvases.Canvases.InvokeNodeTriggerAction:input_type -> Superplane.Canvases.InvokeNodeTriggerActionRequest
-	58,  // 132: Superplane.Canvases.Canvases.ListChildExecutions:input_type -> Superplane.Canvases.ListChildExecutionsRequest
-	77,  // 133: Superplane.Canvases.Canvases.CancelExecution:input_type -> Superplane.Canvases.CancelExecutionRequest
-	79,  // 134: Superplane.Canvases.Canvases.ResolveExecutionErrors:input_type -> Superplane.Canvases.ResolveExecutionErrorsRequest
-	66,  // 135: Superplane.Canvases.Canvases.ListCanvasEvents:input_type -> Superplane.Canvases.ListCanvasEventsRequest
-	69,  // 136: Superplane.Canvases.Canvases.ListCanvasMemories:input_type -> Superplane.Canvases.ListCanvasMemoriesRequest
-	71,  // 137: Superplane.Canvases.Canvases.DeleteCanvasMemory:input_type -> Superplane.Canvases.DeleteCanvasMemoryRequest
-	75,  // 138: Superplane.Canvases.Canvases.ListEventExecutions:input_type -> Superplane.Canvases.ListEventExecutionsRequest
-	10,  // 139: Superplane.Canvases.Canvases.ListCanvases:output_type -> Superplane.Canvases.ListCanvasesResponse
-	16,  // 140: Superplane.Canvases.Canvases.CreateCanvas:output_type -> Superplane.Canvases.CreateCanvasResponse
-	12,  // 141: Superplane.Canvases.Canvases.DescribeCanvas:output_type -> Superplane.Canvases.DescribeCanvasResponse
-	14,  // 142: Superplane.Canvases.Canvases.UpdateCanvas:output_type -> Superplane.Canvases.UpdateCanvasResponse
-	19,  // 143: Superplane.Canvases.Canvases.CreateCanvasVersion:output_type -> Superplane.Canvases.CreateCanvasVersionResponse
-	21,  // 144: Superplane.Canvases.Canvases.ListCanvasVersions:output_type -> Superplane.Canvases.ListCanvasVersionsResponse
-	23,  // 145: Superplane.Canvases.Canvases.DescribeCanvasVersion:output_type -> Superplane.Canvases.DescribeCanvasVersionResponse
-	25,  // 146: Superplane.Canvases.Canvases.UpdateCanvasVersion:output_type -> Superplane.Canvases.UpdateCanvasVersionResponse
-	27,  // 147: Superplane.Canvases.Canvases.CreateCanvasChangeRequest:output_type -> Superplane.Canvases.CreateCanvasChangeRequestResponse
-	29,  // 148: Superplane.Canvases.Canvases.ListCanvasChangeRequests:output_type -> Superplane.Canvases.ListCanvasChangeRequestsResponse
-	31,  // 149: Superplane.Canvases.Canvases.DescribeCanvasChangeRequest:output_type -> Superplane.Canvases.DescribeCanvasChangeRequestResponse
-	33,  // 150: Superplane.Canvases.Canvases.ActOnCanvasChangeRequest:output_type -> Superplane.Canvases.ActOnCanvasChangeRequestResponse
-	35,  // 151: Superplane.Canvases.Canvases.ResolveCanvasChangeRequest:output_type -> Superplane.Canvases.ResolveCanvasChangeRequestResponse
-	37,  // 152: Superplane.Canvases.Canvases.DeleteCanvas:output_type -> Superplane.Canvases.DeleteCanvasResponse
-	51,  // 153: Superplane.Canvases.Canvases.ListNodeQueueItems:output_type -> Superplane.Canvases.ListNodeQueueItemsResponse
-	53,  // 154: Superplane.Canvases.Canvases.DeleteNodeQueueItem:output_type -> Superplane.Canvases.DeleteNodeQueueItemResponse
-	55,  // 155: Superplane.Canvases.Canvases.UpdateNodePause:output_type -> Superplane.Canvases.UpdateNodePauseResponse
-	57,  // 156: Superplane.Canvases.Canvases.ListNodeExecutions:output_type -> Superplane.Canvases.ListNodeExecutionsResponse
-	47,  // 157: Superplane.Canvases.Canvases.ListNodeEvents:output_type -> Superplane.Canvases.ListNodeEventsResponse
-	49,  // 158: Superplane.Canvases.Canvases.EmitNodeEvent:output_type -> Superplane.Canvases.EmitNodeEventResponse
-	63,  // 159: Superplane.Canvases.Canvases.InvokeNodeExecutionAction:output_type -> Superplane.Canvases.InvokeNodeExecutionActionResponse
-	65,  // 160: Superplane.Canvases.Canvases.InvokeNodeTriggerAction:output_type -> Superplane.Canvases.InvokeNodeTriggerActionResponse
-	59,  // 161: Superplane.Canvases.Canvases.ListChildExecutions:output_type -> Superplane.Canvases.ListChildExecutionsResponse
-	78,  // 162: Superplane.Canvases.Canvases.CancelExecution:output_type -> Superplane.Canvases.CancelExecutionResponse
-	80,  // 163: Superplane.Canvases.Canvases.ResolveExecutionErrors:output_type -> Superplane.Canvases.ResolveExecutionErrorsResponse
-	67,  // 164: Superplane.Canvases.Canvases.ListCanvasEvents:output_type -> Superplane.Canvases.ListCanvasEventsResponse
-	70,  // 165: Superplane.Canvases.Canvases.ListCanvasMemories:output_type -> Superplane.Canvases.ListCanvasMemoriesResponse
-	72,  // 166: Superplane.Canvases.Canvases.DeleteCanvasMemory:output_type -> Superplane.Canvases.DeleteCanvasMemoryResponse
-	76,  // 167: Superplane.Canvases.Canvases.ListEventExecutions:output_type -> Superplane.Canvases.ListEventExecutionsResponse
-	139, // [139:168] is the sub-list for method output_type
-	110, // [110:139] is the sub-list for method input_type
-	110, // [110:110] is the sub-list for extension type_name
-	110, // [110:110] is the sub-list for extension extendee
-	0,   // [0:110] is the sub-list for field type_name
+	52,  // 0: Superplane.Canvases.ListCanvasesResponse.canvases:type_name -> Superplane.Canvases.Canvas
+	52,  // 1: Superplane.Canvases.DescribeCanvasResponse.canvas:type_name -> Superplane.Canvases.Canvas
+	58,  // 2: Superplane.Canvases.UpdateCanvasRequest.change_request_approval_config:type_name -> Superplane.Canvases.CanvasChangeRequestApprovalConfig
+	59,  // 3: Superplane.Canvases.UpdateCanvasRequest.change_request_check_config:type_name -> Superplane.Canvases.CanvasChangeRequestCheckConfig
+	61,  // 4: Superplane.Canvases.UpdateCanvasRequest.node_action_permissions_config:type_name -> Superplane.Canvases.CanvasNodeActionPermissionsConfig
+	52,  // 5: Superplane.Canvases.UpdateCanvasResponse.canvas:type_name -> Superplane.Canvases.Canvas
+	52,  // 6: Superplane.Canvases.CreateCanvasRequest.canvas:type_name -> Superplane.Canvases.Canvas
+	21,  // 7: Superplane.Canvases.CreateCanvasRequest.auto_layout:type_name -> Superplane.Canvases.CanvasAutoLayout
+	52,  // 8: Superplane.Canvases.CreateCanvasResponse.canvas:type_name -> Superplane.Canvases.Canvas
+	0,   // 9: Superplane.Canvases.CanvasAutoLayout.algorithm:type_name -> Superplane.Canvases.CanvasAutoLayout.Algorithm
+	1,   // 10: Superplane.Canvases.CanvasAutoLayout.scope:type_name -> Superplane.Canvases.CanvasAutoLayout.Scope
+	54,  // 11: Superplane.Canvases.CreateCanvasVersionResponse.version:type_name -> Superplane.Canvases.CanvasVersion
+	157, // 12: Superplane.Canvases.ListCanvasVersionsRequest.before:type_name -> google.protobuf.Timestamp
+	54,  // 13: Superplane.Canvases.ListCanvasVersionsResponse.versions:type_name -> Superplane.Canvases.CanvasVersion
+	157, // 14: Superplane.Canvases.ListCanvasVersionsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
+	54,  // 15: Superplane.Canvases.DescribeCanvasVersionResponse.version:type_name -> Superplane.Canvases.CanvasVersion
+	52,  // 16: Superplane.Canvases.UpdateCanvasVersionRequest.canvas:type_name -> Superplane.Canvases.Canvas
+	21,  // 17: Superplane.Canvases.UpdateCanvasVersionRequest.auto_layout:type_name -> Superplane.Canvases.CanvasAutoLayout
+	54,  // 18: Superplane.Canvases.UpdateCanvasVersionResponse.version:type_name -> Superplane.Canvases.CanvasVersion
+	55,  // 19: Superplane.Canvases.DiffCanvasVersionsResponse.diff:type_name -> Superplane.Canvases.CanvasVersionDiff
+	54,  // 20: Superplane.Canvases.RollbackCanvasVersionResponse.version:type_name -> Superplane.Canvases.CanvasVersion
+	64,  // 21: Superplane.Canvases.CreateCanvasChangeRequestResponse.change_request:type_name -> Superplane.Canvases.CanvasChangeRequest
+	157, // 22: Superplane.Canvases.ListCanvasChangeRequestsRequest.before:type_name -> google.protobuf.Timestamp
+	64,  // 23: Superplane.Canvases.ListCanvasChangeRequestsResponse.change_requests:type_name -> Superplane.Canvases.CanvasChangeRequest
+	157, // 24: Superplane.Canvases.ListCanvasChangeRequestsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
+	64,  // 25: Superplane.Canvases.DescribeCanvasChangeRequestResponse.change_request:type_name -> Superplane.Canvases.CanvasChangeRequest
+	2,   // 26: Superplane.Canvases.ActOnCanvasChangeRequestRequest.action:type_name -> Superplane.Canvases.ActOnCanvasChangeRequestRequest.Action
+	64,  // 27: Superplane.Canvases.ActOnCanvasChangeRequestResponse.change_request:type_name -> Superplane.Canvases.CanvasChangeRequest
+	52,  // 28: Superplane.Canvases.ResolveCanvasChangeRequestRequest.canvas:type_name -> Superplane.Canvases.Canvas
+	21,  // 29: Superplane.Canvases.ResolveCanvasChangeRequestRequest.auto_layout:type_name -> Superplane.Canvases.CanvasAutoLayout
+	54,  // 30: Superplane.Canvases.ResolveCanvasChangeRequestResponse.version:type_name -> Superplane.Canvases.CanvasVersion
+	64,  // 31: Superplane.Canvases.ResolveCanvasChangeRequestResponse.change_request:type_name -> Superplane.Canvases.CanvasChangeRequest
+	139, // 32: Superplane.Canvases.CanvasBundle.metadata:type_name -> Superplane.Canvases.CanvasBundle.Metadata
+	144, // 33: Superplane.Canvases.CanvasBundle.spec:type_name -> Superplane.Canvases.Canvas.Spec
+	158, // 34: Superplane.Canvases.CanvasBundle.blueprints:type_name -> Superplane.Blueprints.Blueprint
+	140, // 35: Superplane.Canvases.CanvasBundle.integrations:type_name -> Superplane.Canvases.CanvasBundle.IntegrationReference
+	141, // 36: Superplane.Canvases.CanvasBundle.secrets:type_name -> Superplane.Canvases.CanvasBundle.SecretReference
+	46,  // 37: Superplane.Canvases.ExportCanvasResponse.bundle:type_name -> Superplane.Canvases.CanvasBundle
+	46,  // 38: Superplane.Canvases.ImportCanvasRequest.bundle:type_name -> Superplane.Canvases.CanvasBundle
+	142, // 39: Superplane.Canvases.ImportCanvasRequest.integration_mappings:type_name -> Superplane.Canvases.ImportCanvasRequest.IntegrationMapping
+	52,  // 40: Superplane.Canvases.ImportCanvasResponse.canvas:type_name -> Superplane.Canvases.Canvas
+	143, // 41: Superplane.Canvases.Canvas.metadata:type_name -> Superplane.Canvases.Canvas.Metadata
+	144, // 42: Superplane.Canvases.Canvas.spec:type_name -> Superplane.Canvases.Canvas.Spec
+	145, // 43: Superplane.Canvases.Canvas.status:type_name -> Superplane.Canvases.Canvas.Status
+	146, // 44: Superplane.Canvases.CanvasVariable.secret:type_name -> Superplane.Canvases.CanvasVariable.SecretRef
+	147, // 45: Superplane.Canvases.CanvasVariable.overrides:type_name -> Superplane.Canvases.CanvasVariable.Override
+	148, // 46: Superplane.Canvases.CanvasVersion.metadata:type_name -> Superplane.Canvases.CanvasVersion.Metadata
+	144, // 47: Superplane.Canvases.CanvasVersion.spec:type_name -> Superplane.Canvases.Canvas.Spec
+	150, // 48: Superplane.Canvases.CanvasVersionDiff.nodes:type_name -> Superplane.Canvases.CanvasVersionDiff.NodeChange
+	151, // 49: Superplane.Canvases.CanvasVersionDiff.edges:type_name -> Superplane.Canvases.CanvasVersionDiff.EdgeChange
+	152, // 50: Superplane.Canvases.CanvasVersionDiff.variables:type_name -> Superplane.Canvases.CanvasVersionDiff.VariableChange
+	4,   // 51: Superplane.Canvases.CanvasChangeRequestApprover.type:type_name -> Superplane.Canvases.CanvasChangeRequestApprover.Type
+	57,  // 52: Superplane.Canvases.CanvasChangeRequestApprovalConfig.items:type_name -> Superplane.Canvases.CanvasChangeRequestApprover
+	60,  // 53: Superplane.Canvases.CanvasNodeActionPermissionsConfig.items:type_name -> Superplane.Canvases.CanvasNodeActionPermissions
+	5,   // 54: Superplane.Canvases.CanvasChangeRequestCheck.status:type_name -> Superplane.Canvases.CanvasChangeRequestCheck.Status
+	153, // 55: Superplane.Canvases.CanvasChangeRequestCheck.problems:type_name -> Superplane.Canvases.CanvasChangeRequestCheck.Problem
+	157, // 56: Superplane.Canvases.CanvasChangeRequestCheck.checked_at:type_name -> google.protobuf.Timestamp
+	51,  // 57: Superplane.Canvases.CanvasChangeRequestApproval.actor:type_name -> Superplane.Canvases.UserRef
+	57,  // 58: Superplane.Canvases.CanvasChangeRequestApproval.approver:type_name -> Superplane.Canvases.CanvasChangeRequestApprover
+	6,   // 59: Superplane.Canvases.CanvasChangeRequestApproval.state:type_name -> Superplane.Canvases.CanvasChangeRequestApproval.State
+	157, // 60: Superplane.Canvases.CanvasChangeRequestApproval.created_at:type_name -> google.protobuf.Timestamp
+	157, // 61: Superplane.Canvases.CanvasChangeRequestApproval.invalidated_at:type_name -> google.protobuf.Timestamp
+	154, // 62: Superplane.Canvases.CanvasChangeRequest.metadata:type_name -> Superplane.Canvases.CanvasChangeRequest.Metadata
+	54,  // 63: Superplane.Canvases.CanvasChangeRequest.version:type_name -> Superplane.Canvases.CanvasVersion
+	56,  // 64: Superplane.Canvases.CanvasChangeRequest.diff:type_name -> Superplane.Canvases.CanvasChangeRequestDiff
+	63,  // 65: Superplane.Canvases.CanvasChangeRequest.approvals:type_name -> Superplane.Canvases.CanvasChangeRequestApproval
+	62,  // 66: Superplane.Canvases.CanvasChangeRequest.checks:type_name -> Superplane.Canvases.CanvasChangeRequestCheck
+	157, // 67: Superplane.Canvases.ListNodeEventsRequest.before:type_name -> google.protobuf.Timestamp
+	126, // 68: Superplane.Canvases.ListNodeEventsResponse.events:type_name -> Superplane.Canvases.CanvasEvent
+	157, // 69: Superplane.Canvases.ListNodeEventsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
+	159, // 70: Superplane.Canvases.EmitNodeEventRequest.data:type_name -> google.protobuf.Struct
+	157, // 71: Superplane.Canvases.ListNodeQueueItemsRequest.before:type_name -> google.protobuf.Timestamp
+	80,  // 72: Superplane.Canvases.ListNodeQueueItemsResponse.items:type_name -> Superplane.Canvases.CanvasNodeQueueItem
+	157, // 73: Superplane.Canvases.ListNodeQueueItemsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
+	160, // 74: Superplane.Canvases.UpdateNodePauseResponse.node:type_name -> Superplane.Components.Node
+	8,   // 75: Superplane.Canvases.ListNodeExecutionsRequest.states:type_name -> Superplane.Canvases.CanvasNodeExecution.State
+	9,   // 76: Superplane.Canvases.ListNodeExecutionsRequest.results:type_name -> Superplane.Canvases.CanvasNodeExecution.Result
+	157, // 77: Superplane.Canvases.ListNodeExecutionsRequest.before:type_name -> google.protobuf.Timestamp
+	79,  // 78: Superplane.Canvases.ListNodeExecutionsResponse.executions:type_name -> Superplane.Canvases.CanvasNodeExecution
+	157, // 79: Superplane.Canvases.ListNodeExecutionsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
+	79,  // 80: Superplane.Canvases.ListChildExecutionsResponse.executions:type_name -> Superplane.Canvases.CanvasNodeExecution
+	8,   // 81: Superplane.Canvases.CanvasNodeExecution.state:type_name -> Superplane.Canvases.CanvasNodeExecution.State
+	9,   // 82: Superplane.Canvases.CanvasNodeExecution.result:type_name -> Superplane.Canvases.CanvasNodeExecution.Result
+	10,  // 83: Superplane.Canvases.CanvasNodeExecution.result_reason:type_name -> Superplane.Canvases.CanvasNodeExecution.ResultReason
+	159, // 84: Superplane.Canvases.CanvasNodeExecution.input:type_name -> google.protobuf.Struct
+	159, // 85: Superplane.Canvases.CanvasNodeExecution.outputs:type_name -> google.protobuf.Struct
+	157, // 86: Superplane.Canvases.CanvasNodeExecution.created_at:type_name -> google.protobuf.Timestamp
+	157, // 87: Superplane.Canvases.CanvasNodeExecution.updated_at:type_name -> google.protobuf.Timestamp
+	159, // 88: Superplane.Canvases.CanvasNodeExecution.metadata:type_name -> google.protobuf.Struct
+	159, // 89: Superplane.Canvases.CanvasNodeExecution.configuration:type_name -> google.protobuf.Struct
+	79,  // 90: Superplane.Canvases.CanvasNodeExecution.child_executions:type_name -> Superplane.Canvases.CanvasNodeExecution
+	126, // 91: Superplane.Canvases.CanvasNodeExecution.root_event:type_name -> Superplane.Canvases.CanvasEvent
+	51,  // 92: Superplane.Canvases.CanvasNodeExecution.cancelled_by:type_name -> Superplane.Canvases.UserRef
+	159, // 93: Superplane.Canvases.CanvasNodeQueueItem.input:type_name -> google.protobuf.Struct
+	126, // 94: Superplane.Canvases.CanvasNodeQueueItem.root_event:type_name -> Superplane.Canvases.CanvasEvent
+	157, // 95: Superplane.Canvases.CanvasNodeQueueItem.created_at:type_name -> google.protobuf.Timestamp
+	159, // 96: Superplane.Canvases.InvokeNodeExecutionActionRequest.parameters:type_name -> google.protobuf.Struct
+	159, // 97: Superplane.Canvases.InvokeNodeTriggerActionRequest.parameters:type_name -> google.protobuf.Struct
+	159, // 98: Superplane.Canvases.InvokeNodeTriggerActionResponse.result:type_name -> google.protobuf.Struct
+	157, // 99: Superplane.Canvases.ListCanvasEventsRequest.before:type_name -> google.protobuf.Timestamp
+	127, // 100: Superplane.Canvases.ListCanvasEventsResponse.events:type_name -> Superplane.Canvases.CanvasEventWithExecutions
+	157, // 101: Superplane.Canvases.ListCanvasEventsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
+	161, // 102: Superplane.Canvases.CanvasMemory.values:type_name -> google.protobuf.Value
+	87,  // 103: Superplane.Canvases.ListCanvasMemoriesResponse.items:type_name -> Superplane.Canvases.CanvasMemory
+	155, // 104: Superplane.Canvases.CanvasMemoryNamespace.fields:type_name -> Superplane.Canvases.CanvasMemoryNamespace.Field
+	92,  // 105: Superplane.Canvases.ListCanvasMemoryNamespacesResponse.namespaces:type_name -> Superplane.Canvases.CanvasMemoryNamespace
+	155, // 106: Superplane.Canvases.UpdateCanvasMemoryNamespaceRequest.fields:type_name -> Superplane.Canvases.CanvasMemoryNamespace.Field
+	92,  // 107: Superplane.Canvases.UpdateCanvasMemoryNamespaceResponse.namespace:type_name -> Superplane.Canvases.CanvasMemoryNamespace
+	156, // 108: Superplane.Canvases.CanvasGitSync.status:type_name -> Superplane.Canvases.CanvasGitSync.Status
+	99,  // 109: Superplane.Canvases.DescribeCanvasGitSyncResponse.sync:type_name -> Superplane.Canvases.CanvasGitSync
+	99,  // 110: Superplane.Canvases.UpdateCanvasGitSyncResponse.sync:type_name -> Superplane.Canvases.CanvasGitSync
+	106, // 111: Superplane.Canvases.ListCanvasMembersResponse.members:type_name -> Superplane.Canvases.CanvasMember
+	106, // 112: Superplane.Canvases.UpdateCanvasMemberResponse.member:type_name -> Superplane.Canvases.CanvasMember
+	107, // 113: Superplane.Canvases.ListCanvasGroupsResponse.groups:type_name -> Superplane.Canvases.CanvasGroup
+	107, // 114: Superplane.Canvases.UpdateCanvasGroupResponse.group:type_name -> Superplane.Canvases.CanvasGroup
+	122, // 115: Superplane.Canvases.ValidateExpressionResponse.diagnostics:type_name -> Superplane.Canvases.ExpressionDiagnostic
+	11,  // 116: Superplane.Canvases.ExpressionDiagnostic.severity:type_name -> Superplane.Canvases.ExpressionDiagnostic.Severity
+	125, // 117: Superplane.Canvases.CompleteExpressionResponse.completions:type_name -> Superplane.Canvases.ExpressionCompletion
+	12,  // 118: Superplane.Canvases.ExpressionCompletion.kind:type_name -> Superplane.Canvases.ExpressionCompletion.Kind
+	159, // 119: Superplane.Canvases.CanvasEvent.data:type_name -> google.protobuf.Struct
+	157, // 120: Superplane.Canvases.CanvasEvent.created_at:type_name -> google.protobuf.Timestamp
+	159, // 121: Superplane.Canvases.CanvasEventWithExecutions.data:type_name -> google.protobuf.Struct
+	157, // 122: Superplane.Canvases.CanvasEventWithExecutions.created_at:type_name -> google.protobuf.Timestamp
+	79,  // 123: Superplane.Canvases.CanvasEventWithExecutions.executions:type_name -> Superplane.Canvases.CanvasNodeExecution
+	79,  // 124: Superplane.Canvases.ListEventExecutionsResponse.executions:type_name -> Superplane.Canvases.CanvasNodeExecution
+	157, // 125: Superplane.Canvases.CanvasNodeEventMessage.timestamp:type_name -> google.protobuf.Timestamp
+	157, // 126: Superplane.Canvases.CanvasNodeExecutionMessage.timestamp:type_name -> google.protobuf.Timestamp
+	157, // 127: Superplane.Canvases.CanvasNodeQueueItemMessage.timestamp:type_name -> google.protobuf.Timestamp
+	157, // 128: Superplane.Canvases.CanvasMessage.timestamp:type_name -> google.protobuf.Timestamp
+	157, // 129: Superplane.Canvases.CanvasVersionMessage.timestamp:type_name -> google.protobuf.Timestamp
+	157, // 130: Superplane.Canvases.Canvas.Metadata.created_at:type_name -> google.protobuf.Timestamp
+	157, // 131: Superplane.Canvases.Canvas.Metadata.updated_at:type_name -> google.protobuf.Timestamp
+	51,  // 132: Superplane.Canvases.Canvas.Metadata.created_by:type_name -> Superplane.Canvases.UserRef
+	58,  // 133: Superplane.Canvases.Canvas.Metadata.change_request_approval_config:type_name -> Superplane.Canvases.CanvasChangeRequestApprovalConfig
+	59,  // 134: Superplane.Canvases.Canvas.Metadata.change_request_check_config:type_name -> Superplane.Canvases.CanvasChangeRequestCheckConfig
+	61,  // 135: Superplane.Canvases.Canvas.Metadata.node_action_permissions_config:type_name -> Superplane.Canvases.CanvasNodeActionPermissionsConfig
+	160, // 136: Superplane.Canvases.Canvas.Spec.nodes:type_name -> Superplane.Components.Node
+	162, // 137: Superplane.Canvases.Canvas.Spec.edges:type_name -> Superplane.Components.Edge
+	53,  // 138: Superplane.Canvases.Canvas.Spec.variables:type_name -> Superplane.Canvases.CanvasVariable
+	79,  // 139: Superplane.Canvases.Canvas.Status.last_executions:type_name -> Superplane.Canvases.CanvasNodeExecution
+	80,  // 140: Superplane.Canvases.Canvas.Status.next_queue_items:type_name -> Superplane.Canvases.CanvasNodeQueueItem
+	126, // 141: Superplane.Canvases.Canvas.Status.last_events:type_name -> Superplane.Canvases.CanvasEvent
+	146, // 142: Superplane.Canvases.CanvasVariable.Override.secret:type_name -> Superplane.Canvases.CanvasVariable.SecretRef
+	51,  // 143: Superplane.Canvases.CanvasVersion.Metadata.owner:type_name -> Superplane.Canvases.UserRef
+	157, // 144: Superplane.Canvases.CanvasVersion.Metadata.published_at:type_name -> google.protobuf.Timestamp
+	157, // 145: Superplane.Canvases.CanvasVersion.Metadata.created_at:type_name -> google.protobuf.Timestamp
+	157, // 146: Superplane.Canvases.CanvasVersion.Metadata.updated_at:type_name -> google.protobuf.Timestamp
+	3,   // 147: Superplane.Canvases.CanvasVersionDiff.NodeChange.type:type_name -> Superplane.Canvases.CanvasVersionDiff.ChangeType
+	149, // 148: Superplane.Canvases.CanvasVersionDiff.NodeChange.fields:type_name -> Superplane.Canvases.CanvasVersionDiff.FieldChange
+	3,   // 149: Superplane.Canvases.CanvasVersionDiff.EdgeChange.type:type_name -> Superplane.Canvases.CanvasVersionDiff.ChangeType
+	3,   // 150: Superplane.Canvases.CanvasVersionDiff.VariableChange.type:type_name -> Superplane.Canvases.CanvasVersionDiff.ChangeType
+	149, // 151: Superplane.Canvases.CanvasVersionDiff.VariableChange.fields:type_name -> Superplane.Canvases.CanvasVersionDiff.FieldChange
+	51,  // 152: Superplane.Canvases.CanvasChangeRequest.Metadata.owner:type_name -> Superplane.Canvases.UserRef
+	7,   // 153: Superplane.Canvases.CanvasChangeRequest.Metadata.status:type_name -> Superplane.Canvases.CanvasChangeRequest.Status
+	157, // 154: Superplane.Canvases.CanvasChangeRequest.Metadata.published_at:type_name -> google.protobuf.Timestamp
+	157, // 155: Superplane.Canvases.CanvasChangeRequest.Metadata.created_at:type_name -> google.protobuf.Timestamp
+	157, // 156: Superplane.Canvases.CanvasChangeRequest.Metadata.updated_at:type_name -> google.protobuf.Timestamp
+	157, // 157: Superplane.Canvases.CanvasGitSync.Status.last_synced_at:type_name -> google.protobuf.Timestamp
+	13,  // 158: Superplane.Canvases.Canvases.ListCanvases:input_type -> Superplane.Canvases.ListCanvasesRequest
+	19,  // 159: Superplane.Canvases.Canvases.CreateCanvas:input_type -> Superplane.Canvases.CreateCanvasRequest
+	15,  // 160: Superplane.Canvases.Canvases.DescribeCanvas:input_type -> Superplane.Canvases.DescribeCanvasRequest
+	17,  // 161: Superplane.Canvases.Canvases.UpdateCanvas:input_type -> Superplane.Canvases.UpdateCanvasRequest
+	22,  // 162: Superplane.Canvases.Canvases.CreateCanvasVersion:input_type -> Superplane.Canvases.CreateCanvasVersionRequest
+	24,  // 163: Superplane.Canvases.Canvases.ListCanvasVersions:input_type -> Superplane.Canvases.ListCanvasVersionsRequest
+	26,  // 164: Superplane.Canvases.Canvases.DescribeCanvasVersion:input_type -> Superplane.Canvases.DescribeCanvasVersionRequest
+	28,  // 165: Superplane.Canvases.Canvases.UpdateCanvasVersion:input_type -> Superplane.Canvases.UpdateCanvasVersionRequest
+	30,  // 166: Superplane.Canvases.Canvases.DiffCanvasVersions:input_type -> Superplane.Canvases.DiffCanvasVersionsRequest
+	32,  // 167: Superplane.Canvases.Canvases.RollbackCanvasVersion:input_type -> Superplane.Canvases.RollbackCanvasVersionRequest
+	34,  // 168: Superplane.Canvases.Canvases.CreateCanvasChangeRequest:input_type -> Superplane.Canvases.CreateCanvasChangeRequestRequest
+	36,  // 169: Superplane.Canvases.Canvases.ListCanvasChangeRequests:input_type -> Superplane.Canvases.ListCanvasChangeRequestsRequest
+	38,  // 170: Superplane.Canvases.Canvases.DescribeCanvasChangeRequest:input_type -> Superplane.Canvases.DescribeCanvasChangeRequestRequest
+	40,  // 171: Superplane.Canvases.Canvases.ActOnCanvasChangeRequest:input_type -> Superplane.Canvases.ActOnCanvasChangeRequestRequest
+	42,  // 172: Superplane.Canvases.Canvases.ResolveCanvasChangeRequest:input_type -> Superplane.Canvases.ResolveCanvasChangeRequestRequest
+	44,  // 173: Superplane.Canvases.Canvases.DeleteCanvas:input_type -> Superplane.Canvases.DeleteCanvasRequest
+	47,  // 174: Superplane.Canvases.Canvases.ExportCanvas:input_type -> Superplane.Canvases.ExportCanvasRequest
+	49,  // 175: Superplane.Canvases.Canvases.ImportCanvas:input_type -> Superplane.Canvases.ImportCanvasRequest
+	69,  // 176: Superplane.Canvases.Canvases.ListNodeQueueItems:input_type -> Superplane.Canvases.ListNodeQueueItemsRequest
+	71,  // 177: Superplane.Canvases.Canvases.DeleteNodeQueueItem:input_type -> Superplane.Canvases.DeleteNodeQueueItemRequest
+	73,  // 178: Superplane.Canvases.Canvases.UpdateNodePause:input_type -> Superplane.Canvases.UpdateNodePauseRequest
+	75,  // 179: Superplane.Canvases.Canvases.ListNodeExecutions:input_type -> Superplane.Canvases.ListNodeExecutionsRequest
+	65,  // 180: Superplane.Canvases.Canvases.ListNodeEvents:input_type -> Superplane.Canvases.ListNodeEventsRequest
+	67,  // 181: Superplane.Canvases.Canvases.EmitNodeEvent:input_type -> Superplane.Canvases.EmitNodeEventRequest
+	81,  // 182: Superplane.Canvases.Canvases.InvokeNodeExecutionAction:input_type -> Superplane.Canvases.InvokeNodeExecutionActionRequest
+	83,  // 183: Superplane.Canvases.Canvases.InvokeNodeTriggerAction:input_type -> Superplane.Canvases.InvokeNodeTriggerActionRequest
+	77,  // 184: Superplane.Canvases.Canvases.ListChildExecutions:input_type -> Superplane.Canvases.ListChildExecutionsRequest
+	130, // 185: Superplane.Canvases.Canvases.CancelExecution:input_type -> Superplane.Canvases.CancelExecutionRequest
+	132, // 186: Superplane.Canvases.Canvases.ResolveExecutionErrors:input_type -> Superplane.Canvases.ResolveExecutionErrorsRequest
+	85,  // 187: Superplane.Canvases.Canvases.ListCanvasEvents:input_type -> Superplane.Canvases.ListCanvasEventsRequest
+	88,  // 188: Superplane.Canvases.Canvases.ListCanvasMemories:input_type -> Superplane.Canvases.ListCanvasMemoriesRequest
+	90,  // 189: Superplane.Canvases.Canvases.DeleteCanvasMemory:input_type -> Superplane.Canvases.DeleteCanvasMemoryRequest
+	93,  // 190: Superplane.Canvases.Canvases.ListCanvasMemoryNamespaces:input_type -> Superplane.Canvases.ListCanvasMemoryNamespacesRequest
+	95,  // 191: Superplane.Canvases.Canvases.UpdateCanvasMemoryNamespace:input_type -> Superplane.Canvases.UpdateCanvasMemoryNamespaceRequest
+	97,  // 192: Superplane.Canvases.Canvases.DeleteCanvasMemoryNamespace:input_type -> Superplane.Canvases.DeleteCanvasMemoryNamespaceRequest
+	100, // 193: Superplane.Canvases.Canvases.DescribeCanvasGitSync:input_type -> Superplane.Canvases.DescribeCanvasGitSyncRequest
+	102, // 194: Superplane.Canvases.Canvases.UpdateCanvasGitSync:input_type -> Superplane.Canvases.UpdateCanvasGitSyncRequest
+	104, // 195: Superplane.Canvases.Canvases.DeleteCanvasGitSync:input_type -> Superplane.Canvases.DeleteCanvasGitSyncRequest
+	108, // 196: Superplane.Canvases.Canvases.ListCanvasMembers:input_type -> Superplane.Canvases.ListCanvasMembersRequest
+	110, // 197: Superplane.Canvases.Canvases.UpdateCanvasMember:input_type -> Superplane.Canvases.UpdateCanvasMemberRequest
+	112, // 198: Superplane.Canvases.Canvases.RemoveCanvasMember:input_type -> Superplane.Canvases.RemoveCanvasMemberRequest
+	114, // 199: Superplane.Canvases.Canvases.ListCanvasGroups:input_type -> Superplane.Canvases.ListCanvasGroupsRequest
+	116, // 200: Superplane.Canvases.Canvases.UpdateCanvasGroup:input_type -> Superplane.Canvases.UpdateCanvasGroupRequest
+	118, // 201: Superplane.Canvases.Canvases.RemoveCanvasGroup:input_type -> Superplane.Canvases.RemoveCanvasGroupRequest
+	120, // 202: Superplane.Canvases.Canvases.ValidateExpression:input_type -> Superplane.Canvases.ValidateExpressionRequest
+	123, // 203: Superplane.Canvases.Canvases.CompleteExpression:input_type -> Superplane.Canvases.CompleteExpressionRequest
+	128, // 204: Superplane.Canvases.Canvases.ListEventExecutions:input_type -> Superplane.Canvases.ListEventExecutionsRequest
+	14,  // 205: Superplane.Canvases.Canvases.ListCanvases:output_type -> Superplane.Canvases.ListCanvasesResponse
+	20,  // 206: Superplane.Canvases.Canvases.CreateCanvas:output_type -> Superplane.Canvases.CreateCanvasResponse
+	16,  // 207: Superplane.Canvases.Canvases.DescribeCanvas:output_type -> Superplane.Canvases.DescribeCanvasResponse
+	18,  // 208: Superplane.Canvases.Canvases.UpdateCanvas:output_type -> Superplane.Canvases.UpdateCanvasResponse
+	23,  // 209: Superplane.Canvases.Canvases.CreateCanvasVersion:output_type -> Superplane.Canvases.CreateCanvasVersionResponse
+	25,  // 210: Superplane.Canvases.Canvases.ListCanvasVersions:output_type -> Superplane.Canvases.ListCanvasVersionsResponse
+	27,  // 211: Superplane.Canvases.Canvases.DescribeCanvasVersion:output_type -> Superplane.Canvases.DescribeCanvasVersionResponse
+	29,  // 212: Superplane.Canvases.Canvases.UpdateCanvasVersion:output_type -> Superplane.Canvases.UpdateCanvasVersionResponse
+	31,  // 213: Superplane.Canvases.Canvases.DiffCanvasVersions:output_type -> Superplane.Canvases.DiffCanvasVersionsResponse
+	33,  // 214: Superplane.Canvases.Canvases.RollbackCanvasVersion:output_type -> Superplane.Canvases.RollbackCanvasVersionResponse
+	35,  // 215: Superplane.Canvases.Canvases.CreateCanvasChangeRequest:output_type -> Superplane.Canvases.CreateCanvasChangeRequestResponse
+	37,  // 216: Superplane.Canvases.Canvases.ListCanvasChangeRequests:output_type -> Superplane.Canvases.ListCanvasChangeRequestsResponse
+	39,  // 217: Superplane.Canvases.Canvases.DescribeCanvasChangeRequest:output_type -> Superplane.Canvases.DescribeCanvasChangeRequestResponse
+	41,  // 218: Superplane.Canvases.Canvases.ActOnCanvasChangeRequest:output_type -> Superplane.Canvases.ActOnCanvasChangeRequestResponse
+	43,  // 219: Superplane.Canvases.Canvases.ResolveCanvasChangeRequest:output_type -> Superplane.Canvases.ResolveCanvasChangeRequestResponse
+	45,  // 220: Superplane.Canvases.Canvases.DeleteCanvas:output_type -> Superplane.Canvases.DeleteCanvasResponse
+	48,  // 221: Superplane.Canvases.Canvases.ExportCanvas:output_type -> Superplane.Canvases.ExportCanvasResponse
+	50,  // 222: Superplane.Canvases.Canvases.ImportCanvas:output_type -> Superplane.Canvases.ImportCanvasResponse
+	70,  // 223: Superplane.Canvases.Canvases.ListNodeQueueItems:output_type -> Superplane.Canvases.ListNodeQueueItemsResponse
+	72,  // 224: Superplane.Canvases.Canvases.DeleteNodeQueueItem:output_type -> Superplane.Canvases.DeleteNodeQueueItemResponse
+	74,  // 225: Superplane.Canvases.Canvases.UpdateNodePause:output_type -> Superplane.Canvases.UpdateNodePauseResponse
+	76,  // 226: Superplane.Canvases.Canvases.ListNodeExecutions:output_type -> Superplane.Canvases.ListNodeExecutionsResponse
+	66,  // 227: Superplane.Canvases.Canvases.ListNodeEvents:output_type -> Superplane.Canvases.ListNodeEventsResponse
+	68,  // 228: Superplane.Canvases.Canvases.EmitNodeEvent:output_type -> Superplane.Canvases.EmitNodeEventResponse
+	82,  // 229: Superplane.Canvases.Canvases.InvokeNodeExecutionAction:output_type -> Superplane.Canvases.InvokeNodeExecutionActionResponse
+	84,  // 230: Superplane.Canvases.Canvases.InvokeNodeTriggerAction:output_type -> Superplane.Canvases.InvokeNodeTriggerActionResponse
+	78,  // 231: Superplane.Canvases.Canvases.ListChildExecutions:output_type -> Superplane.Canvases.ListChildExecutionsResponse
+	131, // 232: Superplane.Canvases.Canvases.CancelExecution:output_type -> Superplane.Canvases.CancelExecutionResponse
+	133, // 233: Superplane.Canvases.Canvases.ResolveExecutionErrors:output_type -> Superplane.Canvases.ResolveExecutionErrorsResponse
+	86,  // 234: Superplane.Canvases.Canvases.ListCanvasEvents:output_type -> Superplane.Canvases.ListCanvasEventsResponse
+	89,  // 235: Superplane.Canvases.Canvases.ListCanvasMemories:output_type -> Superplane.Canvases.ListCanvasMemoriesResponse
+	91,  // 236: Superplane.Canvases.Canvases.DeleteCanvasMemory:output_type -> Superplane.Canvases.DeleteCanvasMemoryResponse
+	94,  // 237: Superplane.Canvases.Canvases.ListCanvasMemoryNamespaces:output_type -> Superplane.Canvases.ListCanvasMemoryNamespacesResponse
+	96,  // 238: Superplane.Canvases.Canvases.UpdateCanvasMemoryNamespace:output_type -> Superplane.Canvases.UpdateCanvasMemoryNamespaceResponse
+	98,  // 239: Superplane.Canvases.Canvases.DeleteCanvasMemoryNamespace:output_type -> Superplane.Canvases.DeleteCanvasMemoryNamespaceResponse
+	101, // 240: Superplane.Canvases.Canvases.DescribeCanvasGitSync:output_type -> Superplane.Canvases.DescribeCanvasGitSyncResponse
+	103, // 241: Superplane.Canvases.Canvases.UpdateCanvasGitSync:output_type -> Superplane.Canvases.UpdateCanvasGitSyncResponse
+	105, // 242: Superplane.Canvases.Canvases.DeleteCanvasGitSync:output_type -> Superplane.Canvases.DeleteCanvasGitSyncResponse
+	109, // 243: Superplane.Canvases.Canvases.ListCanvasMembers:output_type -> Superplane.Canvases.ListCanvasMembersResponse
+	111, // 244: Superplane.Canvases.Canvases.UpdateCanvasMember:output_type -> Superplane.Canvases.UpdateCanvasMemberResponse
+	113, // 245: Superplane.Canvases.Canvases.RemoveCanvasMember:output_type -> Superplane.Canvases.RemoveCanvasMemberResponse
+	115, // 246: Superplane.Canvases.Canvases.ListCanvasGroups:output_type -> Superplane.Canvases.ListCanvasGroupsResponse
+	117, // 247: Superplane.Canvases.Canvases.UpdateCanvasGroup:output_type -> Superplane.Canvases.UpdateCanvasGroupResponse
+	119, // 248: Superplane.Canvases.Canvases.RemoveCanvasGroup:output_type -> Superplane.Canvases.RemoveCanvasGroupResponse
+	121, // 249: Superplane.Canvases.Canvases.ValidateExpression:output_type -> Superplane.Canvases.ValidateExpressionResponse
+	124, // 250: Superplane.Canvases.Canvases.CompleteExpression:output_type -> Superplane.Canvases.CompleteExpressionResponse
+	129, // 251: Superplane.Canvases.Canvases.ListEventExecutions:output_type -> Superplane.Canvases.ListEventExecutionsResponse
+	205, // [205:252] is the sub-list for method output_type
+	158, // [158:205] is the sub-list for method input_type
+	158, // [158:158] is the sub-list for extension type_name
+	158, // [158:158] is the sub-list for extension extendee
+	0,   // [0:158] is the sub-list for field type_name
 }
 
 func init() { file_canvases_proto_init() }
@@ -6202,13 +10433,14 @@ func file_canvases_proto_init() {
 		return
 	}
 	file_canvases_proto_msgTypes[4].OneofWrappers = []any{}
+	file_canvases_proto_msgTypes[110].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_canvases_proto_rawDesc), len(file_canvases_proto_rawDesc)),
-			NumEnums:      9,
-			NumMessages:   82,
+			NumEnums:      13,
+			NumMessages:   144,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
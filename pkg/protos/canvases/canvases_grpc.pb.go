@@ -27,12 +27,16 @@ const (
 	Canvases_ListCanvasVersions_FullMethodName          = "/Superplane.Canvases.Canvases/ListCanvasVersions"
 	Canvases_DescribeCanvasVersion_FullMethodName       = "/Superplane.Canvases.Canvases/DescribeCanvasVersion"
 	Canvases_UpdateCanvasVersion_FullMethodName         = "/Superplane.Canvases.Canvases/UpdateCanvasVersion"
+	Canvases_DiffCanvasVersions_FullMethodName          = "/Superplane.Canvases.Canvases/DiffCanvasVersions"
+	Canvases_RollbackCanvasVersion_FullMethodName       = "/Superplane.Canvases.Canvases/RollbackCanvasVersion"
 	Canvases_CreateCanvasChangeRequest_FullMethodName   = "/Superplane.Canvases.Canvases/CreateCanvasChangeRequest"
 	Canvases_ListCanvasChangeRequests_FullMethodName    = "/Superplane.Canvases.Canvases/ListCanvasChangeRequests"
 	Canvases_DescribeCanvasChangeRequest_FullMethodName = "/Superplane.Canvases.Canvases/DescribeCanvasChangeRequest"
 	Canvases_ActOnCanvasChangeRequest_FullMethodName    = "/Superplane.Canvases.Canvases/ActOnCanvasChangeRequest"
 	Canvases_ResolveCanvasChangeRequest_FullMethodName  = "/Superplane.Canvases.Canvases/ResolveCanvasChangeRequest"
 	Canvases_DeleteCanvas_FullMethodName                = "/Superplane.Canvases.Canvases/DeleteCanvas"
+	Canvases_ExportCanvas_FullMethodName                = "/Superplane.Canvases.Canvases/ExportCanvas"
+	Canvases_ImportCanvas_FullMethodName                = "/Superplane.Canvases.Canvases/ImportCanvas"
 	Canvases_ListNodeQueueItems_FullMethodName          = "/Superplane.Canvases.Canvases/ListNodeQueueItems"
 	Canvases_DeleteNodeQueueItem_FullMethodName         = "/Superplane.Canvases.Canvases/DeleteNodeQueueItem"
 	Canvases_UpdateNodePause_FullMethodName             = "/Superplane.Canvases.Canvases/UpdateNodePause"
@@ -47,6 +51,20 @@ const (
 	Canvases_ListCanvasEvents_FullMethodName            = "/Superplane.Canvases.Canvases/ListCanvasEvents"
 	Canvases_ListCanvasMemories_FullMethodName          = "/Superplane.Canvases.Canvases/ListCanvasMemories"
 	Canvases_DeleteCanvasMemory_FullMethodName          = "/Superplane.Canvases.Canvases/DeleteCanvasMemory"
+	Canvases_ListCanvasMemoryNamespaces_FullMethodName  = "/Superplane.Canvases.Canvases/ListCanvasMemoryNamespaces"
+	Canvases_UpdateCanvasMemoryNamespace_FullMethodName = "/Superplane.Canvases.Canvases/UpdateCanvasMemoryNamespace"
+	Canvases_DeleteCanvasMemoryNamespace_FullMethodName = "/Superplane.Canvases.Canvases/DeleteCanvasMemoryNamespace"
+	Canvases_DescribeCanvasGitSync_FullMethodName       = "/Superplane.Canvases.Canvases/DescribeCanvasGitSync"
+	Canvases_UpdateCanvasGitSync_FullMethodName         = "/Superplane.Canvases.Canvases/UpdateCanvasGitSync"
+	Canvases_DeleteCanvasGitSync_FullMethodName         = "/Superplane.Canvases.Canvases/DeleteCanvasGitSync"
+	Canvases_ListCanvasMembers_FullMethodName           = "/Superplane.Canvases.Canvases/ListCanvasMembers"
+	Canvases_UpdateCanvasMember_FullMethodName          = "/Superplane.Canvases.Canvases/UpdateCanvasMember"
+	Canvases_RemoveCanvasMember_FullMethodName          = "/Superplane.Canvases.Canvases/RemoveCanvasMember"
+	Canvases_ListCanvasGroups_FullMethodName            = "/Superplane.Canvases.Canvases/ListCanvasGroups"
+	Canvases_UpdateCanvasGroup_FullMethodName           = "/Superplane.Canvases.Canvases/UpdateCanvasGroup"
+	Canvases_RemoveCanvasGroup_FullMethodName           = "/Superplane.Canvases.Canvases/RemoveCanvasGroup"
+	Canvases_ValidateExpression_FullMethodName          = "/Superplane.Canvases.Canvases/ValidateExpression"
+	Canvases_CompleteExpression_FullMethodName          = "/Superplane.Canvases.Canvases/CompleteExpression"
 	Canvases_ListEventExecutions_FullMethodName         = "/Superplane.Canvases.Canvases/ListEventExecutions"
 )
 
@@ -62,12 +80,16 @@ type CanvasesClient interface {
 	ListCanvasVersions(ctx context.Context, in *ListCanvasVersionsRequest, opts ...grpc.CallOption) (*ListCanvasVersionsResponse, error)
 	DescribeCanvasVersion(ctx context.Context, in *DescribeCanvasVersionRequest, opts ...grpc.CallOption) (*DescribeCanvasVersionResponse, error)
 	UpdateCanvasVersion(ctx context.Context, in *UpdateCanvasVersionRequest, opts ...grpc.CallOption) (*UpdateCanvasVersionResponse, error)
+	DiffCanvasVersions(ctx context.Context, in *DiffCanvasVersionsRequest, opts ...grpc.CallOption) (*DiffCanvasVersionsResponse, error)
+	RollbackCanvasVersion(ctx context.Context, in *RollbackCanvasVersionRequest, opts ...grpc.CallOption) (*RollbackCanvasVersionResponse, error)
 	CreateCanvasChangeRequest(ctx context.Context, in *CreateCanvasChangeRequestRequest, opts ...grpc.CallOption) (*CreateCanvasChangeRequestResponse, error)
 	ListCanvasChangeRequests(ctx context.Context, in *ListCanvasChangeRequestsRequest, opts ...grpc.CallOption) (*ListCanvasChangeRequestsResponse, error)
 	DescribeCanvasChangeRequest(ctx context.Context, in *DescribeCanvasChangeRequestRequest, opts ...grpc.CallOption) (*DescribeCanvasChangeRequestResponse, error)
 	ActOnCanvasChangeRequest(ctx context.Context, in *ActOnCanvasChangeRequestRequest, opts ...grpc.CallOption) (*ActOnCanvasChangeRequestResponse, error)
 	ResolveCanvasChangeRequest(ctx context.Context, in *ResolveCanvasChangeRequestRequest, opts ...grpc.CallOption) (*ResolveCanvasChangeRequestResponse, error)
 	DeleteCanvas(ctx context.Context, in *DeleteCanvasRequest, opts ...grpc.CallOption) (*DeleteCanvasResponse, error)
+	ExportCanvas(ctx context.Context, in *ExportCanvasRequest, opts ...grpc.CallOption) (*ExportCanvasResponse, error)
+	ImportCanvas(ctx context.Context, in *ImportCanvasRequest, opts ...grpc.CallOption) (*ImportCanvasResponse, error)
 	ListNodeQueueItems(ctx context.Context, in *ListNodeQueueItemsRequest, opts ...grpc.CallOption) (*ListNodeQueueItemsResponse, error)
 	DeleteNodeQueueItem(ctx context.Context, in *DeleteNodeQueueItemRequest, opts ...grpc.CallOption) (*DeleteNodeQueueItemResponse, error)
 	UpdateNodePause(ctx context.Context, in *UpdateNodePauseRequest, opts ...grpc.CallOption) (*UpdateNodePauseResponse, error)
@@ -82,6 +104,20 @@ type CanvasesClient interface {
 	ListCanvasEvents(ctx context.Context, in *ListCanvasEventsRequest, opts ...grpc.CallOption) (*ListCanvasEventsResponse, error)
 	ListCanvasMemories(ctx context.Context, in *ListCanvasMemoriesRequest, opts ...grpc.CallOption) (*ListCanvasMemoriesResponse, error)
 	DeleteCanvasMemory(ctx context.Context, in *DeleteCanvasMemoryRequest, opts ...grpc.CallOption) (*DeleteCanvasMemoryResponse, error)
+	ListCanvasMemoryNamespaces(ctx context.Context, in *ListCanvasMemoryNamespacesRequest, opts ...grpc.CallOption) (*ListCanvasMemoryNamespacesResponse, error)
+	UpdateCanvasMemoryNamespace(ctx context.Context, in *UpdateCanvasMemoryNamespaceRequest, opts ...grpc.CallOption) (*UpdateCanvasMemoryNamespaceResponse, error)
+	DeleteCanvasMemoryNamespace(ctx context.Context, in *DeleteCanvasMemoryNamespaceRequest, opts ...grpc.CallOption) (*DeleteCanvasMemoryNamespaceResponse, error)
+	DescribeCanvasGitSync(ctx context.Context, in *DescribeCanvasGitSyncRequest, opts ...grpc.CallOption) (*DescribeCanvasGitSyncResponse, error)
+	UpdateCanvasGitSync(ctx context.Context, in *UpdateCanvasGitSyncRequest, opts ...grpc.CallOption) (*UpdateCanvasGitSyncResponse, error)
+	DeleteCanvasGitSync(ctx context.Context, in *DeleteCanvasGitSyncRequest, opts ...grpc.CallOption) (*DeleteCanvasGitSyncResponse, error)
+	ListCanvasMembers(ctx context.Context, in *ListCanvasMembersRequest, opts ...grpc.CallOption) (*ListCanvasMembersResponse, error)
+	UpdateCanvasMember(ctx context.Context, in *UpdateCanvasMemberRequest, opts ...grpc.CallOption) (*UpdateCanvasMemberResponse, error)
+	RemoveCanvasMember(ctx context.Context, in *RemoveCanvasMemberRequest, opts ...grpc.CallOption) (*RemoveCanvasMemberResponse, error)
+	ListCanvasGroups(ctx context.Context, in *ListCanvasGroupsRequest, opts ...grpc.CallOption) (*ListCanvasGroupsResponse, error)
+	UpdateCanvasGroup(ctx context.Context, in *UpdateCanvasGroupRequest, opts ...grpc.CallOption) (*UpdateCanvasGroupResponse, error)
+	RemoveCanvasGroup(ctx context.Context, in *RemoveCanvasGroupRequest, opts ...grpc.CallOption) (*RemoveCanvasGroupResponse, error)
+	ValidateExpression(ctx context.Context, in *ValidateExpressionRequest, opts ...grpc.CallOption) (*ValidateExpressionResponse, error)
+	CompleteExpression(ctx context.Context, in *CompleteExpressionRequest, opts ...grpc.CallOption) (*CompleteExpressionResponse, error)
 	ListEventExecutions(ctx context.Context, in *ListEventExecutionsRequest, opts ...grpc.CallOption) (*ListEventExecutionsResponse, error)
 }
 
@@ -173,6 +209,26 @@ func (c *canvasesClient) UpdateCanvasVersion(ctx context.Context, in *UpdateCanv
 	return out, nil
 }
 
+func (c *canvasesClient) DiffCanvasVersions(ctx context.Context, in *DiffCanvasVersionsRequest, opts ...grpc.CallOption) (*DiffCanvasVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiffCanvasVersionsResponse)
+	err := c.cc.Invoke(ctx, Canvases_DiffCanvasVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) RollbackCanvasVersion(ctx context.Context, in *RollbackCanvasVersionRequest, opts ...grpc.CallOption) (*RollbackCanvasVersionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RollbackCanvasVersionResponse)
+	err := c.cc.Invoke(ctx, Canvases_RollbackCanvasVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *canvasesClient) CreateCanvasChangeRequest(ctx context.Context, in *CreateCanvasChangeRequestRequest, opts ...grpc.CallOption) (*CreateCanvasChangeRequestResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateCanvasChangeRequestResponse)
@@ -233,6 +289,26 @@ func (c *canvasesClient) DeleteCanvas(ctx context.Context, in *DeleteCanvasReque
 	return out, nil
 }
 
+func (c *canvasesClient) ExportCanvas(ctx context.Context, in *ExportCanvasRequest, opts ...grpc.CallOption) (*ExportCanvasResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportCanvasResponse)
+	err := c.cc.Invoke(ctx, Canvases_ExportCanvas_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) ImportCanvas(ctx context.Context, in *ImportCanvasRequest, opts ...grpc.CallOption) (*ImportCanvasResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportCanvasResponse)
+	err := c.cc.Invoke(ctx, Canvases_ImportCanvas_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *canvasesClient) ListNodeQueueItems(ctx context.Context, in *ListNodeQueueItemsRequest, opts ...grpc.CallOption) (*ListNodeQueueItemsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListNodeQueueItemsResponse)
@@ -373,6 +449,146 @@ func (c *canvasesClient) DeleteCanvasMemory(ctx context.Context, in *DeleteCanva
 	return out, nil
 }
 
+func (c *canvasesClient) ListCanvasMemoryNamespaces(ctx context.Context, in *ListCanvasMemoryNamespacesRequest, opts ...grpc.CallOption) (*ListCanvasMemoryNamespacesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCanvasMemoryNamespacesResponse)
+	err := c.cc.Invoke(ctx, Canvases_ListCanvasMemoryNamespaces_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) UpdateCanvasMemoryNamespace(ctx context.Context, in *UpdateCanvasMemoryNamespaceRequest, opts ...grpc.CallOption) (*UpdateCanvasMemoryNamespaceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCanvasMemoryNamespaceResponse)
+	err := c.cc.Invoke(ctx, Canvases_UpdateCanvasMemoryNamespace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) DeleteCanvasMemoryNamespace(ctx context.Context, in *DeleteCanvasMemoryNamespaceRequest, opts ...grpc.CallOption) (*DeleteCanvasMemoryNamespaceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCanvasMemoryNamespaceResponse)
+	err := c.cc.Invoke(ctx, Canvases_DeleteCanvasMemoryNamespace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) DescribeCanvasGitSync(ctx context.Context, in *DescribeCanvasGitSyncRequest, opts ...grpc.CallOption) (*DescribeCanvasGitSyncResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DescribeCanvasGitSyncResponse)
+	err := c.cc.Invoke(ctx, Canvases_DescribeCanvasGitSync_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) UpdateCanvasGitSync(ctx context.Context, in *UpdateCanvasGitSyncRequest, opts ...grpc.CallOption) (*UpdateCanvasGitSyncResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCanvasGitSyncResponse)
+	err := c.cc.Invoke(ctx, Canvases_UpdateCanvasGitSync_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) DeleteCanvasGitSync(ctx context.Context, in *DeleteCanvasGitSyncRequest, opts ...grpc.CallOption) (*DeleteCanvasGitSyncResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCanvasGitSyncResponse)
+	err := c.cc.Invoke(ctx, Canvases_DeleteCanvasGitSync_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) ListCanvasMembers(ctx context.Context, in *ListCanvasMembersRequest, opts ...grpc.CallOption) (*ListCanvasMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCanvasMembersResponse)
+	err := c.cc.Invoke(ctx, Canvases_ListCanvasMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) UpdateCanvasMember(ctx context.Context, in *UpdateCanvasMemberRequest, opts ...grpc.CallOption) (*UpdateCanvasMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCanvasMemberResponse)
+	err := c.cc.Invoke(ctx, Canvases_UpdateCanvasMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) RemoveCanvasMember(ctx context.Context, in *RemoveCanvasMemberRequest, opts ...grpc.CallOption) (*RemoveCanvasMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveCanvasMemberResponse)
+	err := c.cc.Invoke(ctx, Canvases_RemoveCanvasMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) ListCanvasGroups(ctx context.Context, in *ListCanvasGroupsRequest, opts ...grpc.CallOption) (*ListCanvasGroupsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCanvasGroupsResponse)
+	err := c.cc.Invoke(ctx, Canvases_ListCanvasGroups_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) UpdateCanvasGroup(ctx context.Context, in *UpdateCanvasGroupRequest, opts ...grpc.CallOption) (*UpdateCanvasGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCanvasGroupResponse)
+	err := c.cc.Invoke(ctx, Canvases_UpdateCanvasGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) RemoveCanvasGroup(ctx context.Context, in *RemoveCanvasGroupRequest, opts ...grpc.CallOption) (*RemoveCanvasGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveCanvasGroupResponse)
+	err := c.cc.Invoke(ctx, Canvases_RemoveCanvasGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) ValidateExpression(ctx context.Context, in *ValidateExpressionRequest, opts ...grpc.CallOption) (*ValidateExpressionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateExpressionResponse)
+	err := c.cc.Invoke(ctx, Canvases_ValidateExpression_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) CompleteExpression(ctx context.Context, in *CompleteExpressionRequest, opts ...grpc.CallOption) (*CompleteExpressionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompleteExpressionResponse)
+	err := c.cc.Invoke(ctx, Canvases_CompleteExpression_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *canvasesClient) ListEventExecutions(ctx context.Context, in *ListEventExecutionsRequest, opts ...grpc.CallOption) (*ListEventExecutionsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListEventExecutionsResponse)
@@ -395,12 +611,16 @@ type CanvasesServer interface {
 	ListCanvasVersions(context.Context, *ListCanvasVersionsRequest) (*ListCanvasVersionsResponse, error)
 	DescribeCanvasVersion(context.Context, *DescribeCanvasVersionRequest) (*DescribeCanvasVersionResponse, error)
 	UpdateCanvasVersion(context.Context, *UpdateCanvasVersionRequest) (*UpdateCanvasVersionResponse, error)
+	DiffCanvasVersions(context.Context, *DiffCanvasVersionsRequest) (*DiffCanvasVersionsResponse, error)
+	RollbackCanvasVersion(context.Context, *RollbackCanvasVersionRequest) (*RollbackCanvasVersionResponse, error)
 	CreateCanvasChangeRequest(context.Context, *CreateCanvasChangeRequestRequest) (*CreateCanvasChangeRequestResponse, error)
 	ListCanvasChangeRequests(context.Context, *ListCanvasChangeRequestsRequest) (*ListCanvasChangeRequestsResponse, error)
 	DescribeCanvasChangeRequest(context.Context, *DescribeCanvasChangeRequestRequest) (*DescribeCanvasChangeRequestResponse, error)
 	ActOnCanvasChangeRequest(context.Context, *ActOnCanvasChangeRequestRequest) (*ActOnCanvasChangeRequestResponse, error)
 	ResolveCanvasChangeRequest(context.Context, *ResolveCanvasChangeRequestRequest) (*ResolveCanvasChangeRequestResponse, error)
 	DeleteCanvas(context.Context, *DeleteCanvasRequest) (*DeleteCanvasResponse, error)
+	ExportCanvas(context.Context, *ExportCanvasRequest) (*ExportCanvasResponse, error)
+	ImportCanvas(context.Context, *ImportCanvasRequest) (*ImportCanvasResponse, error)
 	ListNodeQueueItems(context.Context, *ListNodeQueueItemsRequest) (*ListNodeQueueItemsResponse, error)
 	DeleteNodeQueueItem(context.Context, *DeleteNodeQueueItemRequest) (*DeleteNodeQueueItemResponse, error)
 	UpdateNodePause(context.Context, *UpdateNodePauseRequest) (*UpdateNodePauseResponse, error)
@@ -415,6 +635,20 @@ type CanvasesServer interface {
 	ListCanvasEvents(context.Context, *ListCanvasEventsRequest) (*ListCanvasEventsResponse, error)
 	ListCanvasMemories(context.Context, *ListCanvasMemoriesRequest) (*ListCanvasMemoriesResponse, error)
 	DeleteCanvasMemory(context.Context, *DeleteCanvasMemoryRequest) (*DeleteCanvasMemoryResponse, error)
+	ListCanvasMemoryNamespaces(context.Context, *ListCanvasMemoryNamespacesRequest) (*ListCanvasMemoryNamespacesResponse, error)
+	UpdateCanvasMemoryNamespace(context.Context, *UpdateCanvasMemoryNamespaceRequest) (*UpdateCanvasMemoryNamespaceResponse, error)
+	DeleteCanvasMemoryNamespace(context.Context, *DeleteCanvasMemoryNamespaceRequest) (*DeleteCanvasMemoryNamespaceResponse, error)
+	DescribeCanvasGitSync(context.Context, *DescribeCanvasGitSyncRequest) (*DescribeCanvasGitSyncResponse, error)
+	UpdateCanvasGitSync(context.Context, *UpdateCanvasGitSyncRequest) (*UpdateCanvasGitSyncResponse, error)
+	DeleteCanvasGitSync(context.Context, *DeleteCanvasGitSyncRequest) (*DeleteCanvasGitSyncResponse, error)
+	ListCanvasMembers(context.Context, *ListCanvasMembersRequest) (*ListCanvasMembersResponse, error)
+	UpdateCanvasMember(context.Context, *UpdateCanvasMemberRequest) (*UpdateCanvasMemberResponse, error)
+	RemoveCanvasMember(context.Context, *RemoveCanvasMemberRequest) (*RemoveCanvasMemberResponse, error)
+	ListCanvasGroups(context.Context, *ListCanvasGroupsRequest) (*ListCanvasGroupsResponse, error)
+	UpdateCanvasGroup(context.Context, *UpdateCanvasGroupRequest) (*UpdateCanvasGroupResponse, error)
+	RemoveCanvasGroup(context.Context, *RemoveCanvasGroupRequest) (*RemoveCanvasGroupResponse, error)
+	ValidateExpression(context.Context, *ValidateExpressionRequest) (*ValidateExpressionResponse, error)
+	CompleteExpression(context.Context, *CompleteExpressionRequest) (*CompleteExpressionResponse, error)
 	ListEventExecutions(context.Context, *ListEventExecutionsRequest) (*ListEventExecutionsResponse, error)
 }
 
@@ -449,6 +683,12 @@ func (UnimplementedCanvasesServer) DescribeCanvasVersion(context.Context, *Descr
 func (UnimplementedCanvasesServer) UpdateCanvasVersion(context.Context, *UpdateCanvasVersionRequest) (*UpdateCanvasVersionResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateCanvasVersion not implemented")
 }
+func (UnimplementedCanvasesServer) DiffCanvasVersions(context.Context, *DiffCanvasVersionsRequest) (*DiffCanvasVersionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DiffCanvasVersions not implemented")
+}
+func (UnimplementedCanvasesServer) RollbackCanvasVersion(context.Context, *RollbackCanvasVersionRequest) (*RollbackCanvasVersionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RollbackCanvasVersion not implemented")
+}
 func (UnimplementedCanvasesServer) CreateCanvasChangeRequest(context.Context, *CreateCanvasChangeRequestRequest) (*CreateCanvasChangeRequestResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CreateCanvasChangeRequest not implemented")
 }
@@ -467,6 +707,12 @@ func (UnimplementedCanvasesServer) ResolveCanvasChangeRequest(context.Context, *
 func (UnimplementedCanvasesServer) DeleteCanvas(context.Context, *DeleteCanvasRequest) (*DeleteCanvasResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteCanvas not implemented")
 }
+func (UnimplementedCanvasesServer) ExportCanvas(context.Context, *ExportCanvasRequest) (*ExportCanvasResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportCanvas not implemented")
+}
+func (UnimplementedCanvasesServer) ImportCanvas(context.Context, *ImportCanvasRequest) (*ImportCanvasResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportCanvas not implemented")
+}
 func (UnimplementedCanvasesServer) ListNodeQueueItems(context.Context, *ListNodeQueueItemsRequest) (*ListNodeQueueItemsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListNodeQueueItems not implemented")
 }
@@ -509,6 +755,48 @@ func (UnimplementedCanvasesServer) ListCanvasMemories(context.Context, *ListCanv
 func (UnimplementedCanvasesServer) DeleteCanvasMemory(context.Context, *DeleteCanvasMemoryRequest) (*DeleteCanvasMemoryResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteCanvasMemory not implemented")
 }
+func (UnimplementedCanvasesServer) ListCanvasMemoryNamespaces(context.Context, *ListCanvasMemoryNamespacesRequest) (*ListCanvasMemoryNamespacesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCanvasMemoryNamespaces not implemented")
+}
+func (UnimplementedCanvasesServer) UpdateCanvasMemoryNamespace(context.Context, *UpdateCanvasMemoryNamespaceRequest) (*UpdateCanvasMemoryNamespaceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateCanvasMemoryNamespace not implemented")
+}
+func (UnimplementedCanvasesServer) DeleteCanvasMemoryNamespace(context.Context, *DeleteCanvasMemoryNamespaceRequest) (*DeleteCanvasMemoryNamespaceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteCanvasMemoryNamespace not implemented")
+}
+func (UnimplementedCanvasesServer) DescribeCanvasGitSync(context.Context, *DescribeCanvasGitSyncRequest) (*DescribeCanvasGitSyncResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DescribeCanvasGitSync not implemented")
+}
+func (UnimplementedCanvasesServer) UpdateCanvasGitSync(context.Context, *UpdateCanvasGitSyncRequest) (*UpdateCanvasGitSyncResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateCanvasGitSync not implemented")
+}
+func (UnimplementedCanvasesServer) DeleteCanvasGitSync(context.Context, *DeleteCanvasGitSyncRequest) (*DeleteCanvasGitSyncResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteCanvasGitSync not implemented")
+}
+func (UnimplementedCanvasesServer) ListCanvasMembers(context.Context, *ListCanvasMembersRequest) (*ListCanvasMembersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCanvasMembers not implemented")
+}
+func (UnimplementedCanvasesServer) UpdateCanvasMember(context.Context, *UpdateCanvasMemberRequest) (*UpdateCanvasMemberResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateCanvasMember not implemented")
+}
+func (UnimplementedCanvasesServer) RemoveCanvasMember(context.Context, *RemoveCanvasMemberRequest) (*RemoveCanvasMemberResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveCanvasMember not implemented")
+}
+func (UnimplementedCanvasesServer) ListCanvasGroups(context.Context, *ListCanvasGroupsRequest) (*ListCanvasGroupsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCanvasGroups not implemented")
+}
+func (UnimplementedCanvasesServer) UpdateCanvasGroup(context.Context, *UpdateCanvasGroupRequest) (*UpdateCanvasGroupResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateCanvasGroup not implemented")
+}
+func (UnimplementedCanvasesServer) RemoveCanvasGroup(context.Context, *RemoveCanvasGroupRequest) (*RemoveCanvasGroupResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveCanvasGroup not implemented")
+}
+func (UnimplementedCanvasesServer) ValidateExpression(context.Context, *ValidateExpressionRequest) (*ValidateExpressionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ValidateExpression not implemented")
+}
+func (UnimplementedCanvasesServer) CompleteExpression(context.Context, *CompleteExpressionRequest) (*CompleteExpressionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CompleteExpression not implemented")
+}
 func (UnimplementedCanvasesServer) ListEventExecutions(context.Context, *ListEventExecutionsRequest) (*ListEventExecutionsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListEventExecutions not implemented")
 }
@@ -676,6 +964,42 @@ func _Canvases_UpdateCanvasVersion_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Canvases_DiffCanvasVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffCanvasVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).DiffCanvasVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_DiffCanvasVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).DiffCanvasVersions(ctx, req.(*DiffCanvasVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_RollbackCanvasVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackCanvasVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).RollbackCanvasVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_RollbackCanvasVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).RollbackCanvasVersion(ctx, req.(*RollbackCanvasVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Canvases_CreateCanvasChangeRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateCanvasChangeRequestRequest)
 	if err := dec(in); err != nil {
@@ -784,6 +1108,42 @@ func _Canvases_DeleteCanvas_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Canvases_ExportCanvas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportCanvasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).ExportCanvas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_ExportCanvas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).ExportCanvas(ctx, req.(*ExportCanvasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_ImportCanvas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportCanvasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).ImportCanvas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_ImportCanvas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).ImportCanvas(ctx, req.(*ImportCanvasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Canvases_ListNodeQueueItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListNodeQueueItemsRequest)
 	if err := dec(in); err != nil {
@@ -1036,6 +1396,258 @@ func _Canvases_DeleteCanvasMemory_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Canvases_ListCanvasMemoryNamespaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCanvasMemoryNamespacesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).ListCanvasMemoryNamespaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_ListCanvasMemoryNamespaces_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).ListCanvasMemoryNamespaces(ctx, req.(*ListCanvasMemoryNamespacesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_UpdateCanvasMemoryNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCanvasMemoryNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).UpdateCanvasMemoryNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_UpdateCanvasMemoryNamespace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).UpdateCanvasMemoryNamespace(ctx, req.(*UpdateCanvasMemoryNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_DeleteCanvasMemoryNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCanvasMemoryNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).DeleteCanvasMemoryNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_DeleteCanvasMemoryNamespace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).DeleteCanvasMemoryNamespace(ctx, req.(*DeleteCanvasMemoryNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_DescribeCanvasGitSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeCanvasGitSyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).DescribeCanvasGitSync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_DescribeCanvasGitSync_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).DescribeCanvasGitSync(ctx, req.(*DescribeCanvasGitSyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_UpdateCanvasGitSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCanvasGitSyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).UpdateCanvasGitSync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_UpdateCanvasGitSync_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).UpdateCanvasGitSync(ctx, req.(*UpdateCanvasGitSyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_DeleteCanvasGitSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCanvasGitSyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).DeleteCanvasGitSync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_DeleteCanvasGitSync_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).DeleteCanvasGitSync(ctx, req.(*DeleteCanvasGitSyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_ListCanvasMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCanvasMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).ListCanvasMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_ListCanvasMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).ListCanvasMembers(ctx, req.(*ListCanvasMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_UpdateCanvasMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCanvasMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).UpdateCanvasMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_UpdateCanvasMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).UpdateCanvasMember(ctx, req.(*UpdateCanvasMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_RemoveCanvasMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCanvasMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).RemoveCanvasMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_RemoveCanvasMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).RemoveCanvasMember(ctx, req.(*RemoveCanvasMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_ListCanvasGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCanvasGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).ListCanvasGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_ListCanvasGroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).ListCanvasGroups(ctx, req.(*ListCanvasGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_UpdateCanvasGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCanvasGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).UpdateCanvasGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_UpdateCanvasGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).UpdateCanvasGroup(ctx, req.(*UpdateCanvasGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_RemoveCanvasGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCanvasGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).RemoveCanvasGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_RemoveCanvasGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).RemoveCanvasGroup(ctx, req.(*RemoveCanvasGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_ValidateExpression_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateExpressionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).ValidateExpression(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_ValidateExpression_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).ValidateExpression(ctx, req.(*ValidateExpressionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_CompleteExpression_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteExpressionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).CompleteExpression(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_CompleteExpression_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).CompleteExpression(ctx, req.(*CompleteExpressionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Canvases_ListEventExecutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListEventExecutionsRequest)
 	if err := dec(in); err != nil {
@@ -1093,6 +1705,14 @@ var Canvases_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateCanvasVersion",
 			Handler:    _Canvases_UpdateCanvasVersion_Handler,
 		},
+		{
+			MethodName: "DiffCanvasVersions",
+			Handler:    _Canvases_DiffCanvasVersions_Handler,
+		},
+		{
+			MethodName: "RollbackCanvasVersion",
+			Handler:    _Canvases_RollbackCanvasVersion_Handler,
+		},
 		{
 			MethodName: "CreateCanvasChangeRequest",
 			Handler:    _Canvases_CreateCanvasChangeRequest_Handler,
@@ -1117,6 +1737,14 @@ var Canvases_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCanvas",
 			Handler:    _Canvases_DeleteCanvas_Handler,
 		},
+		{
+			MethodName: "ExportCanvas",
+			Handler:    _Canvases_ExportCanvas_Handler,
+		},
+		{
+			MethodName: "ImportCanvas",
+			Handler:    _Canvases_ImportCanvas_Handler,
+		},
 		{
 			MethodName: "ListNodeQueueItems",
 			Handler:    _Canvases_ListNodeQueueItems_Handler,
@@ -1173,6 +1801,62 @@ var Canvases_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCanvasMemory",
 			Handler:    _Canvases_DeleteCanvasMemory_Handler,
 		},
+		{
+			MethodName: "ListCanvasMemoryNamespaces",
+			Handler:    _Canvases_ListCanvasMemoryNamespaces_Handler,
+		},
+		{
+			MethodName: "UpdateCanvasMemoryNamespace",
+			Handler:    _Canvases_UpdateCanvasMemoryNamespace_Handler,
+		},
+		{
+			MethodName: "DeleteCanvasMemoryNamespace",
+			Handler:    _Canvases_DeleteCanvasMemoryNamespace_Handler,
+		},
+		{
+			MethodName: "DescribeCanvasGitSync",
+			Handler:    _Canvases_DescribeCanvasGitSync_Handler,
+		},
+		{
+			MethodName: "UpdateCanvasGitSync",
+			Handler:    _Canvases_UpdateCanvasGitSync_Handler,
+		},
+		{
+			MethodName: "DeleteCanvasGitSync",
+			Handler:    _Canvases_DeleteCanvasGitSync_Handler,
+		},
+		{
+			MethodName: "ListCanvasMembers",
+			Handler:    _Canvases_ListCanvasMembers_Handler,
+		},
+		{
+			MethodName: "UpdateCanvasMember",
+			Handler:    _Canvases_UpdateCanvasMember_Handler,
+		},
+		{
+			MethodName: "RemoveCanvasMember",
+			Handler:    _Canvases_RemoveCanvasMember_Handler,
+		},
+		{
+			MethodName: "ListCanvasGroups",
+			Handler:    _Canvases_ListCanvasGroups_Handler,
+		},
+		{
+			MethodName: "UpdateCanvasGroup",
+			Handler:    _Canvases_UpdateCanvasGroup_Handler,
+		},
+		{
+			MethodName: "RemoveCanvasGroup",
+			Handler:    _Canvases_RemoveCanvasGroup_Handler,
+		},
+		{
+			MethodName: "ValidateExpression",
+			Handler:    _Canvases_ValidateExpression_Handler,
+		},
+		{
+			MethodName: "CompleteExpression",
+			Handler:    _Canvases_CompleteExpression_Handler,
+		},
 		{
 			MethodName: "ListEventExecutions",
 			Handler:    _Canvases_ListEventExecutions_Handler,
@@ -25,6 +25,55 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type MemoryNamespace_Access int32
+
+const (
+	MemoryNamespace_ACCESS_NONE  MemoryNamespace_Access = 0
+	MemoryNamespace_ACCESS_READ  MemoryNamespace_Access = 1
+	MemoryNamespace_ACCESS_WRITE MemoryNamespace_Access = 2
+)
+
+// Enum value maps for MemoryNamespace_Access.
+var (
+	MemoryNamespace_Access_name = map[int32]string{
+		0: "ACCESS_NONE",
+		1: "ACCESS_READ",
+		2: "ACCESS_WRITE",
+	}
+	MemoryNamespace_Access_value = map[string]int32{
+		"ACCESS_NONE":  0,
+		"ACCESS_READ":  1,
+		"ACCESS_WRITE": 2,
+	}
+)
+
+func (x MemoryNamespace_Access) Enum() *MemoryNamespace_Access {
+	p := new(MemoryNamespace_Access)
+	*p = x
+	return p
+}
+
+func (x MemoryNamespace_Access) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MemoryNamespace_Access) Descriptor() protoreflect.EnumDescriptor {
+	return file_organizations_proto_enumTypes[0].Descriptor()
+}
+
+func (MemoryNamespace_Access) Type() protoreflect.EnumType {
+	return &file_organizations_proto_enumTypes[0]
+}
+
+func (x MemoryNamespace_Access) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MemoryNamespace_Access.Descriptor instead.
+func (MemoryNamespace_Access) EnumDescriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{54, 0}
+}
+
 type Organization struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Metadata      *Organization_Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
@@ -1573,28 +1622,33 @@ func (x *DeleteAgentOpenAIKeyResponse) GetAgentSettings() *AgentSettings {
 	return nil
 }
 
-type RemoveUserRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type SSOOIDCSettings struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	IssuerUrl string                 `protobuf:"bytes,1,opt,name=issuer_url,json=issuerUrl,proto3" json:"issuer_url,omitempty"`
+	ClientId  string                 `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// Only used when updating the settings.
+	// An empty value keeps the current client secret.
+	ClientSecret           string `protobuf:"bytes,3,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	ClientSecretConfigured bool   `protobuf:"varint,4,opt,name=client_secret_configured,json=clientSecretConfigured,proto3" json:"client_secret_configured,omitempty"`
+	RedirectUrl            string `protobuf:"bytes,5,opt,name=redirect_url,json=redirectUrl,proto3" json:"redirect_url,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
-func (x *RemoveUserRequest) Reset() {
-	*x = RemoveUserRequest{}
+func (x *SSOOIDCSettings) Reset() {
+	*x = SSOOIDCSettings{}
 	mi := &file_organizations_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveUserRequest) String() string {
+func (x *SSOOIDCSettings) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveUserRequest) ProtoMessage() {}
+func (*SSOOIDCSettings) ProtoMessage() {}
 
-func (x *RemoveUserRequest) ProtoReflect() protoreflect.Message {
+func (x *SSOOIDCSettings) ProtoReflect() protoreflect.Message {
 	mi := &file_organizations_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1606,45 +1660,71 @@ func (x *RemoveUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveUserRequest.ProtoReflect.Descriptor instead.
-func (*RemoveUserRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SSOOIDCSettings.ProtoReflect.Descriptor instead.
+func (*SSOOIDCSettings) Descriptor() ([]byte, []int) {
 	return file_organizations_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *RemoveUserRequest) GetId() string {
+func (x *SSOOIDCSettings) GetIssuerUrl() string {
 	if x != nil {
-		return x.Id
+		return x.IssuerUrl
 	}
 	return ""
 }
 
-func (x *RemoveUserRequest) GetUserId() string {
+func (x *SSOOIDCSettings) GetClientId() string {
 	if x != nil {
-		return x.UserId
+		return x.ClientId
 	}
 	return ""
 }
 
-type RemoveUserResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *SSOOIDCSettings) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
 }
 
-func (x *RemoveUserResponse) Reset() {
-	*x = RemoveUserResponse{}
+func (x *SSOOIDCSettings) GetClientSecretConfigured() bool {
+	if x != nil {
+		return x.ClientSecretConfigured
+	}
+	return false
+}
+
+func (x *SSOOIDCSettings) GetRedirectUrl() string {
+	if x != nil {
+		return x.RedirectUrl
+	}
+	return ""
+}
+
+type SSOSAMLSettings struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	IdpMetadataUrl string                 `protobuf:"bytes,1,opt,name=idp_metadata_url,json=idpMetadataUrl,proto3" json:"idp_metadata_url,omitempty"`
+	IdpMetadata    string                 `protobuf:"bytes,2,opt,name=idp_metadata,json=idpMetadata,proto3" json:"idp_metadata,omitempty"`
+	EntityId       string                 `protobuf:"bytes,3,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	AcsUrl         string                 `protobuf:"bytes,4,opt,name=acs_url,json=acsUrl,proto3" json:"acs_url,omitempty"`
+	MetadataUrl    string                 `protobuf:"bytes,5,opt,name=metadata_url,json=metadataUrl,proto3" json:"metadata_url,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SSOSAMLSettings) Reset() {
+	*x = SSOSAMLSettings{}
 	mi := &file_organizations_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveUserResponse) String() string {
+func (x *SSOSAMLSettings) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveUserResponse) ProtoMessage() {}
+func (*SSOSAMLSettings) ProtoMessage() {}
 
-func (x *RemoveUserResponse) ProtoReflect() protoreflect.Message {
+func (x *SSOSAMLSettings) ProtoReflect() protoreflect.Message {
 	mi := &file_organizations_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1656,32 +1736,72 @@ func (x *RemoveUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveUserResponse.ProtoReflect.Descriptor instead.
-func (*RemoveUserResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SSOSAMLSettings.ProtoReflect.Descriptor instead.
+func (*SSOSAMLSettings) Descriptor() ([]byte, []int) {
 	return file_organizations_proto_rawDescGZIP(), []int{32}
 }
 
-type ListIntegrationsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *SSOSAMLSettings) GetIdpMetadataUrl() string {
+	if x != nil {
+		return x.IdpMetadataUrl
+	}
+	return ""
 }
 
-func (x *ListIntegrationsRequest) Reset() {
-	*x = ListIntegrationsRequest{}
+func (x *SSOSAMLSettings) GetIdpMetadata() string {
+	if x != nil {
+		return x.IdpMetadata
+	}
+	return ""
+}
+
+func (x *SSOSAMLSettings) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *SSOSAMLSettings) GetAcsUrl() string {
+	if x != nil {
+		return x.AcsUrl
+	}
+	return ""
+}
+
+func (x *SSOSAMLSettings) GetMetadataUrl() string {
+	if x != nil {
+		return x.MetadataUrl
+	}
+	return ""
+}
+
+type SSODomain struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Domain   string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	Verified bool                   `protobuf:"varint,2,opt,name=verified,proto3" json:"verified,omitempty"`
+	// The DNS TXT record, and its value, that verifies the domain.
+	VerificationRecord string               `protobuf:"bytes,3,opt,name=verification_record,json=verificationRecord,proto3" json:"verification_record,omitempty"`
+	VerificationValue  string               `protobuf:"bytes,4,opt,name=verification_value,json=verificationValue,proto3" json:"verification_value,omitempty"`
+	VerifiedAt         *timestamp.Timestamp `protobuf:"bytes,5,opt,name=verified_at,json=verifiedAt,proto3" json:"verified_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SSODomain) Reset() {
+	*x = SSODomain{}
 	mi := &file_organizations_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListIntegrationsRequest) String() string {
+func (x *SSODomain) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListIntegrationsRequest) ProtoMessage() {}
+func (*SSODomain) ProtoMessage() {}
 
-func (x *ListIntegrationsRequest) ProtoReflect() protoreflect.Message {
+func (x *SSODomain) ProtoReflect() protoreflect.Message {
 	mi := &file_organizations_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1693,39 +1813,77 @@ func (x *ListIntegrationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListIntegrationsRequest.ProtoReflect.Descriptor instead.
-func (*ListIntegrationsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SSODomain.ProtoReflect.Descriptor instead.
+func (*SSODomain) Descriptor() ([]byte, []int) {
 	return file_organizations_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *ListIntegrationsRequest) GetId() string {
+func (x *SSODomain) GetDomain() string {
 	if x != nil {
-		return x.Id
+		return x.Domain
 	}
 	return ""
 }
 
-type ListIntegrationsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Integrations  []*Integration         `protobuf:"bytes,1,rep,name=integrations,proto3" json:"integrations,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *SSODomain) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
 }
 
-func (x *ListIntegrationsResponse) Reset() {
-	*x = ListIntegrationsResponse{}
+func (x *SSODomain) GetVerificationRecord() string {
+	if x != nil {
+		return x.VerificationRecord
+	}
+	return ""
+}
+
+func (x *SSODomain) GetVerificationValue() string {
+	if x != nil {
+		return x.VerificationValue
+	}
+	return ""
+}
+
+func (x *SSODomain) GetVerifiedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.VerifiedAt
+	}
+	return nil
+}
+
+type SSOSettings struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId  string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Protocol        string                 `protobuf:"bytes,2,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	Enabled         bool                   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Enforced        bool                   `protobuf:"varint,4,opt,name=enforced,proto3" json:"enforced,omitempty"`
+	JitProvisioning bool                   `protobuf:"varint,5,opt,name=jit_provisioning,json=jitProvisioning,proto3" json:"jit_provisioning,omitempty"`
+	Oidc            *SSOOIDCSettings       `protobuf:"bytes,6,opt,name=oidc,proto3" json:"oidc,omitempty"`
+	Saml            *SSOSAMLSettings       `protobuf:"bytes,7,opt,name=saml,proto3" json:"saml,omitempty"`
+	LoginUrl        string                 `protobuf:"bytes,8,opt,name=login_url,json=loginUrl,proto3" json:"login_url,omitempty"`
+	UpdatedAt       *timestamp.Timestamp   `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UpdatedBy       string                 `protobuf:"bytes,10,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Domains         []*SSODomain           `protobuf:"bytes,11,rep,name=domains,proto3" json:"domains,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SSOSettings) Reset() {
+	*x = SSOSettings{}
 	mi := &file_organizations_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListIntegrationsResponse) String() string {
+func (x *SSOSettings) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListIntegrationsResponse) ProtoMessage() {}
+func (*SSOSettings) ProtoMessage() {}
 
-func (x *ListIntegrationsResponse) ProtoReflect() protoreflect.Message {
+func (x *SSOSettings) ProtoReflect() protoreflect.Message {
 	mi := &file_organizations_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1737,108 +1895,110 @@ func (x *ListIntegrationsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListIntegrationsResponse.ProtoReflect.Descriptor instead.
-func (*ListIntegrationsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SSOSettings.ProtoReflect.Descriptor instead.
+func (*SSOSettings) Descriptor() ([]byte, []int) {
 	return file_organizations_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *ListIntegrationsResponse) GetIntegrations() []*Integration {
+func (x *SSOSettings) GetOrganizationId() string {
 	if x != nil {
-		return x.Integrations
+		return x.OrganizationId
 	}
-	return nil
+	return ""
 }
 
-type CreateIntegrationRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	IntegrationName string                 `protobuf:"bytes,3,opt,name=integration_name,json=integrationName,proto3" json:"integration_name,omitempty"`
-	Configuration   *_struct.Struct        `protobuf:"bytes,4,opt,name=configuration,proto3" json:"configuration,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *SSOSettings) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
 }
 
-func (x *CreateIntegrationRequest) Reset() {
-	*x = CreateIntegrationRequest{}
-	mi := &file_organizations_proto_msgTypes[35]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *SSOSettings) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
 }
 
-func (x *CreateIntegrationRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SSOSettings) GetEnforced() bool {
+	if x != nil {
+		return x.Enforced
+	}
+	return false
 }
 
-func (*CreateIntegrationRequest) ProtoMessage() {}
+func (x *SSOSettings) GetJitProvisioning() bool {
+	if x != nil {
+		return x.JitProvisioning
+	}
+	return false
+}
 
-func (x *CreateIntegrationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[35]
+func (x *SSOSettings) GetOidc() *SSOOIDCSettings {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Oidc
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use CreateIntegrationRequest.ProtoReflect.Descriptor instead.
-func (*CreateIntegrationRequest) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{35}
+func (x *SSOSettings) GetSaml() *SSOSAMLSettings {
+	if x != nil {
+		return x.Saml
+	}
+	return nil
 }
 
-func (x *CreateIntegrationRequest) GetId() string {
+func (x *SSOSettings) GetLoginUrl() string {
 	if x != nil {
-		return x.Id
+		return x.LoginUrl
 	}
 	return ""
 }
 
-func (x *CreateIntegrationRequest) GetName() string {
+func (x *SSOSettings) GetUpdatedAt() *timestamp.Timestamp {
 	if x != nil {
-		return x.Name
+		return x.UpdatedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateIntegrationRequest) GetIntegrationName() string {
+func (x *SSOSettings) GetUpdatedBy() string {
 	if x != nil {
-		return x.IntegrationName
+		return x.UpdatedBy
 	}
 	return ""
 }
 
-func (x *CreateIntegrationRequest) GetConfiguration() *_struct.Struct {
+func (x *SSOSettings) GetDomains() []*SSODomain {
 	if x != nil {
-		return x.Configuration
+		return x.Domains
 	}
 	return nil
 }
 
-type CreateIntegrationResponse struct {
+type GetSSOSettingsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Integration   *Integration           `protobuf:"bytes,1,opt,name=integration,proto3" json:"integration,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateIntegrationResponse) Reset() {
-	*x = CreateIntegrationResponse{}
-	mi := &file_organizations_proto_msgTypes[36]
+func (x *GetSSOSettingsRequest) Reset() {
+	*x = GetSSOSettingsRequest{}
+	mi := &file_organizations_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateIntegrationResponse) String() string {
+func (x *GetSSOSettingsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateIntegrationResponse) ProtoMessage() {}
+func (*GetSSOSettingsRequest) ProtoMessage() {}
 
-func (x *CreateIntegrationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[36]
+func (x *GetSSOSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1849,41 +2009,40 @@ func (x *CreateIntegrationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateIntegrationResponse.ProtoReflect.Descriptor instead.
-func (*CreateIntegrationResponse) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use GetSSOSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetSSOSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *CreateIntegrationResponse) GetIntegration() *Integration {
+func (x *GetSSOSettingsRequest) GetId() string {
 	if x != nil {
-		return x.Integration
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type DescribeIntegrationRequest struct {
+type GetSSOSettingsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	IntegrationId string                 `protobuf:"bytes,2,opt,name=integration_id,json=integrationId,proto3" json:"integration_id,omitempty"`
+	SsoSettings   *SSOSettings           `protobuf:"bytes,1,opt,name=sso_settings,json=ssoSettings,proto3" json:"sso_settings,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DescribeIntegrationRequest) Reset() {
-	*x = DescribeIntegrationRequest{}
-	mi := &file_organizations_proto_msgTypes[37]
+func (x *GetSSOSettingsResponse) Reset() {
+	*x = GetSSOSettingsResponse{}
+	mi := &file_organizations_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DescribeIntegrationRequest) String() string {
+func (x *GetSSOSettingsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DescribeIntegrationRequest) ProtoMessage() {}
+func (*GetSSOSettingsResponse) ProtoMessage() {}
 
-func (x *DescribeIntegrationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[37]
+func (x *GetSSOSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1894,47 +2053,1141 @@ func (x *DescribeIntegrationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DescribeIntegrationRequest.ProtoReflect.Descriptor instead.
-func (*DescribeIntegrationRequest) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use GetSSOSettingsResponse.ProtoReflect.Descriptor instead.
+func (*GetSSOSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *DescribeIntegrationRequest) GetId() string {
+func (x *GetSSOSettingsResponse) GetSsoSettings() *SSOSettings {
 	if x != nil {
-		return x.Id
+		return x.SsoSettings
 	}
-	return ""
+	return nil
 }
 
-func (x *DescribeIntegrationRequest) GetIntegrationId() string {
-	if x != nil {
-		return x.IntegrationId
-	}
-	return ""
+type UpdateSSOSettingsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Protocol        string                 `protobuf:"bytes,2,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	Enabled         bool                   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Enforced        bool                   `protobuf:"varint,4,opt,name=enforced,proto3" json:"enforced,omitempty"`
+	JitProvisioning bool                   `protobuf:"varint,5,opt,name=jit_provisioning,json=jitProvisioning,proto3" json:"jit_provisioning,omitempty"`
+	Oidc            *SSOOIDCSettings       `protobuf:"bytes,6,opt,name=oidc,proto3" json:"oidc,omitempty"`
+	Saml            *SSOSAMLSettings       `protobuf:"bytes,7,opt,name=saml,proto3" json:"saml,omitempty"`
+	// Email domains of the organization. Unverified domains are verified
+	// when the settings are updated, if their DNS TXT record exists.
+	Domains       []string `protobuf:"bytes,8,rep,name=domains,proto3" json:"domains,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-type DescribeIntegrationResponse struct {
+func (x *UpdateSSOSettingsRequest) Reset() {
+	*x = UpdateSSOSettingsRequest{}
+	mi := &file_organizations_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSSOSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSSOSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateSSOSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSSOSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSSOSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *UpdateSSOSettingsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateSSOSettingsRequest) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *UpdateSSOSettingsRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *UpdateSSOSettingsRequest) GetEnforced() bool {
+	if x != nil {
+		return x.Enforced
+	}
+	return false
+}
+
+func (x *UpdateSSOSettingsRequest) GetJitProvisioning() bool {
+	if x != nil {
+		return x.JitProvisioning
+	}
+	return false
+}
+
+func (x *UpdateSSOSettingsRequest) GetOidc() *SSOOIDCSettings {
+	if x != nil {
+		return x.Oidc
+	}
+	return nil
+}
+
+func (x *UpdateSSOSettingsRequest) GetSaml() *SSOSAMLSettings {
+	if x != nil {
+		return x.Saml
+	}
+	return nil
+}
+
+func (x *UpdateSSOSettingsRequest) GetDomains() []string {
+	if x != nil {
+		return x.Domains
+	}
+	return nil
+}
+
+type UpdateSSOSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SsoSettings   *SSOSettings           `protobuf:"bytes,1,opt,name=sso_settings,json=ssoSettings,proto3" json:"sso_settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSSOSettingsResponse) Reset() {
+	*x = UpdateSSOSettingsResponse{}
+	mi := &file_organizations_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSSOSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSSOSettingsResponse) ProtoMessage() {}
+
+func (x *UpdateSSOSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSSOSettingsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSSOSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *UpdateSSOSettingsResponse) GetSsoSettings() *SSOSettings {
+	if x != nil {
+		return x.SsoSettings
+	}
+	return nil
+}
+
+type RemoveUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveUserRequest) Reset() {
+	*x = RemoveUserRequest{}
+	mi := &file_organizations_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveUserRequest) ProtoMessage() {}
+
+func (x *RemoveUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveUserRequest.ProtoReflect.Descriptor instead.
+func (*RemoveUserRequest) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RemoveUserRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RemoveUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RemoveUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveUserResponse) Reset() {
+	*x = RemoveUserResponse{}
+	mi := &file_organizations_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveUserResponse) ProtoMessage() {}
+
+func (x *RemoveUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveUserResponse.ProtoReflect.Descriptor instead.
+func (*RemoveUserResponse) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{40}
+}
+
+type ListIntegrationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIntegrationsRequest) Reset() {
+	*x = ListIntegrationsRequest{}
+	mi := &file_organizations_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIntegrationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIntegrationsRequest) ProtoMessage() {}
+
+func (x *ListIntegrationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIntegrationsRequest.ProtoReflect.Descriptor instead.
+func (*ListIntegrationsRequest) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListIntegrationsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListIntegrationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Integrations  []*Integration         `protobuf:"bytes,1,rep,name=integrations,proto3" json:"integrations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIntegrationsResponse) Reset() {
+	*x = ListIntegrationsResponse{}
+	mi := &file_organizations_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIntegrationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIntegrationsResponse) ProtoMessage() {}
+
+func (x *ListIntegrationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIntegrationsResponse.ProtoReflect.Descriptor instead.
+func (*ListIntegrationsResponse) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListIntegrationsResponse) GetIntegrations() []*Integration {
+	if x != nil {
+		return x.Integrations
+	}
+	return nil
+}
+
+type CreateIntegrationRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	IntegrationName string                 `protobuf:"bytes,3,opt,name=integration_name,json=integrationName,proto3" json:"integration_name,omitempty"`
+	Configuration   *_struct.Struct        `protobuf:"bytes,4,opt,name=configuration,proto3" json:"configuration,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateIntegrationRequest) Reset() {
+	*x = CreateIntegrationRequest{}
+	mi := &file_organizations_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateIntegrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateIntegrationRequest) ProtoMessage() {}
+
+func (x *CreateIntegrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateIntegrationRequest.ProtoReflect.Descriptor instead.
+func (*CreateIntegrationRequest) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CreateIntegrationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CreateIntegrationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateIntegrationRequest) GetIntegrationName() string {
+	if x != nil {
+		return x.IntegrationName
+	}
+	return ""
+}
+
+func (x *CreateIntegrationRequest) GetConfiguration() *_struct.Struct {
+	if x != nil {
+		return x.Configuration
+	}
+	return nil
+}
+
+type CreateIntegrationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Integration   *Integration           `protobuf:"bytes,1,opt,name=integration,proto3" json:"integration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateIntegrationResponse) Reset() {
+	*x = CreateIntegrationResponse{}
+	mi := &file_organizations_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateIntegrationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateIntegrationResponse) ProtoMessage() {}
+
+func (x *CreateIntegrationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateIntegrationResponse.ProtoReflect.Descriptor instead.
+func (*CreateIntegrationResponse) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CreateIntegrationResponse) GetIntegration() *Integration {
+	if x != nil {
+		return x.Integration
+	}
+	return nil
+}
+
+type DescribeIntegrationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IntegrationId string                 `protobuf:"bytes,2,opt,name=integration_id,json=integrationId,proto3" json:"integration_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DescribeIntegrationRequest) Reset() {
+	*x = DescribeIntegrationRequest{}
+	mi := &file_organizations_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeIntegrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeIntegrationRequest) ProtoMessage() {}
+
+func (x *DescribeIntegrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeIntegrationRequest.ProtoReflect.Descriptor instead.
+func (*DescribeIntegrationRequest) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *DescribeIntegrationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DescribeIntegrationRequest) GetIntegrationId() string {
+	if x != nil {
+		return x.IntegrationId
+	}
+	return ""
+}
+
+type DescribeIntegrationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Integration   *Integration           `protobuf:"bytes,1,opt,name=integration,proto3" json:"integration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DescribeIntegrationResponse) Reset() {
+	*x = DescribeIntegrationResponse{}
+	mi := &file_organizations_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeIntegrationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeIntegrationResponse) ProtoMessage() {}
+
+func (x *DescribeIntegrationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeIntegrationResponse.ProtoReflect.Descriptor instead.
+func (*DescribeIntegrationResponse) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *DescribeIntegrationResponse) GetIntegration() *Integration {
+	if x != nil {
+		return x.Integration
+	}
+	return nil
+}
+
+type ListIntegrationResourcesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IntegrationId string                 `protobuf:"bytes,2,opt,name=integration_id,json=integrationId,proto3" json:"integration_id,omitempty"`
+	Parameters    map[string]string      `protobuf:"bytes,4,rep,name=parameters,proto3" json:"parameters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIntegrationResourcesRequest) Reset() {
+	*x = ListIntegrationResourcesRequest{}
+	mi := &file_organizations_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIntegrationResourcesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIntegrationResourcesRequest) ProtoMessage() {}
+
+func (x *ListIntegrationResourcesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIntegrationResourcesRequest.ProtoReflect.Descriptor instead.
+func (*ListIntegrationResourcesRequest) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListIntegrationResourcesRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListIntegrationResourcesRequest) GetIntegrationId() string {
+	if x != nil {
+		return x.IntegrationId
+	}
+	return ""
+}
+
+func (x *ListIntegrationResourcesRequest) GetParameters() map[string]string {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+type ListIntegrationResourcesResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Resources     []*IntegrationResourceRef `protobuf:"bytes,1,rep,name=resources,proto3" json:"resources,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIntegrationResourcesResponse) Reset() {
+	*x = ListIntegrationResourcesResponse{}
+	mi := &file_organizations_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIntegrationResourcesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIntegrationResourcesResponse) ProtoMessage() {}
+
+func (x *ListIntegrationResourcesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIntegrationResourcesResponse.ProtoReflect.Descriptor instead.
+func (*ListIntegrationResourcesResponse) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListIntegrationResourcesResponse) GetResources() []*IntegrationResourceRef {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+type IntegrationResourceRef struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Id            string                 `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IntegrationResourceRef) Reset() {
+	*x = IntegrationResourceRef{}
+	mi := &file_organizations_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IntegrationResourceRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntegrationResourceRef) ProtoMessage() {}
+
+func (x *IntegrationResourceRef) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntegrationResourceRef.ProtoReflect.Descriptor instead.
+func (*IntegrationResourceRef) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *IntegrationResourceRef) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *IntegrationResourceRef) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *IntegrationResourceRef) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type UpdateIntegrationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IntegrationId string                 `protobuf:"bytes,2,opt,name=integration_id,json=integrationId,proto3" json:"integration_id,omitempty"`
+	Configuration *_struct.Struct        `protobuf:"bytes,3,opt,name=configuration,proto3" json:"configuration,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateIntegrationRequest) Reset() {
+	*x = UpdateIntegrationRequest{}
+	mi := &file_organizations_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateIntegrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateIntegrationRequest) ProtoMessage() {}
+
+func (x *UpdateIntegrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateIntegrationRequest.ProtoReflect.Descriptor instead.
+func (*UpdateIntegrationRequest) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *UpdateIntegrationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateIntegrationRequest) GetIntegrationId() string {
+	if x != nil {
+		return x.IntegrationId
+	}
+	return ""
+}
+
+func (x *UpdateIntegrationRequest) GetConfiguration() *_struct.Struct {
+	if x != nil {
+		return x.Configuration
+	}
+	return nil
+}
+
+func (x *UpdateIntegrationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UpdateIntegrationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Integration   *Integration           `protobuf:"bytes,1,opt,name=integration,proto3" json:"integration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateIntegrationResponse) Reset() {
+	*x = UpdateIntegrationResponse{}
+	mi := &file_organizations_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateIntegrationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateIntegrationResponse) ProtoMessage() {}
+
+func (x *UpdateIntegrationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateIntegrationResponse.ProtoReflect.Descriptor instead.
+func (*UpdateIntegrationResponse) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *UpdateIntegrationResponse) GetIntegration() *Integration {
+	if x != nil {
+		return x.Integration
+	}
+	return nil
+}
+
+type DeleteIntegrationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IntegrationId string                 `protobuf:"bytes,2,opt,name=integration_id,json=integrationId,proto3" json:"integration_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteIntegrationRequest) Reset() {
+	*x = DeleteIntegrationRequest{}
+	mi := &file_organizations_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteIntegrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteIntegrationRequest) ProtoMessage() {}
+
+func (x *DeleteIntegrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteIntegrationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteIntegrationRequest) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DeleteIntegrationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteIntegrationRequest) GetIntegrationId() string {
+	if x != nil {
+		return x.IntegrationId
+	}
+	return ""
+}
+
+type DeleteIntegrationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteIntegrationResponse) Reset() {
+	*x = DeleteIntegrationResponse{}
+	mi := &file_organizations_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteIntegrationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteIntegrationResponse) ProtoMessage() {}
+
+func (x *DeleteIntegrationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteIntegrationResponse.ProtoReflect.Descriptor instead.
+func (*DeleteIntegrationResponse) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{53}
+}
+
+// Memory namespaces shared by the canvases of an organization.
+// Canvases use them with the org/ prefix in memory components,
+// and only with the access granted to them, or the default access.
+type MemoryNamespace struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Namespace     string                          `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Description   string                          `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	DefaultAccess MemoryNamespace_Access          `protobuf:"varint,3,opt,name=default_access,json=defaultAccess,proto3,enum=Superplane.Organizations.MemoryNamespace_Access" json:"default_access,omitempty"`
+	CanvasAccess  []*MemoryNamespace_CanvasAccess `protobuf:"bytes,4,rep,name=canvas_access,json=canvasAccess,proto3" json:"canvas_access,omitempty"`
+	CreatedAt     *timestamp.Timestamp            `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamp.Timestamp            `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoryNamespace) Reset() {
+	*x = MemoryNamespace{}
+	mi := &file_organizations_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoryNamespace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoryNamespace) ProtoMessage() {}
+
+func (x *MemoryNamespace) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoryNamespace.ProtoReflect.Descriptor instead.
+func (*MemoryNamespace) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *MemoryNamespace) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *MemoryNamespace) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *MemoryNamespace) GetDefaultAccess() MemoryNamespace_Access {
+	if x != nil {
+		return x.DefaultAccess
+	}
+	return MemoryNamespace_ACCESS_NONE
+}
+
+func (x *MemoryNamespace) GetCanvasAccess() []*MemoryNamespace_CanvasAccess {
+	if x != nil {
+		return x.CanvasAccess
+	}
+	return nil
+}
+
+func (x *MemoryNamespace) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *MemoryNamespace) GetUpdatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type Memory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Values        *_struct.Value         `protobuf:"bytes,3,opt,name=values,proto3" json:"values,omitempty"`
+	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Memory) Reset() {
+	*x = Memory{}
+	mi := &file_organizations_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Memory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Memory) ProtoMessage() {}
+
+func (x *Memory) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Memory.ProtoReflect.Descriptor instead.
+func (*Memory) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *Memory) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Memory) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *Memory) GetValues() *_struct.Value {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *Memory) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Memory) GetUpdatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListMemoryNamespacesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoryNamespacesRequest) Reset() {
+	*x = ListMemoryNamespacesRequest{}
+	mi := &file_organizations_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoryNamespacesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoryNamespacesRequest) ProtoMessage() {}
+
+func (x *ListMemoryNamespacesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoryNamespacesRequest.ProtoReflect.Descriptor instead.
+func (*ListMemoryNamespacesRequest) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListMemoryNamespacesRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListMemoryNamespacesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Integration   *Integration           `protobuf:"bytes,1,opt,name=integration,proto3" json:"integration,omitempty"`
+	Namespaces    []*MemoryNamespace     `protobuf:"bytes,1,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DescribeIntegrationResponse) Reset() {
-	*x = DescribeIntegrationResponse{}
-	mi := &file_organizations_proto_msgTypes[38]
+func (x *ListMemoryNamespacesResponse) Reset() {
+	*x = ListMemoryNamespacesResponse{}
+	mi := &file_organizations_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DescribeIntegrationResponse) String() string {
+func (x *ListMemoryNamespacesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DescribeIntegrationResponse) ProtoMessage() {}
+func (*ListMemoryNamespacesResponse) ProtoMessage() {}
 
-func (x *DescribeIntegrationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[38]
+func (x *ListMemoryNamespacesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1945,42 +3198,44 @@ func (x *DescribeIntegrationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DescribeIntegrationResponse.ProtoReflect.Descriptor instead.
-func (*DescribeIntegrationResponse) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use ListMemoryNamespacesResponse.ProtoReflect.Descriptor instead.
+func (*ListMemoryNamespacesResponse) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *DescribeIntegrationResponse) GetIntegration() *Integration {
+func (x *ListMemoryNamespacesResponse) GetNamespaces() []*MemoryNamespace {
 	if x != nil {
-		return x.Integration
+		return x.Namespaces
 	}
 	return nil
 }
 
-type ListIntegrationResourcesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	IntegrationId string                 `protobuf:"bytes,2,opt,name=integration_id,json=integrationId,proto3" json:"integration_id,omitempty"`
-	Parameters    map[string]string      `protobuf:"bytes,4,rep,name=parameters,proto3" json:"parameters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+type UpdateMemoryNamespaceRequest struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Id            string                          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Namespace     string                          `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Description   string                          `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	DefaultAccess MemoryNamespace_Access          `protobuf:"varint,4,opt,name=default_access,json=defaultAccess,proto3,enum=Superplane.Organizations.MemoryNamespace_Access" json:"default_access,omitempty"`
+	CanvasAccess  []*MemoryNamespace_CanvasAccess `protobuf:"bytes,5,rep,name=canvas_access,json=canvasAccess,proto3" json:"canvas_access,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListIntegrationResourcesRequest) Reset() {
-	*x = ListIntegrationResourcesRequest{}
-	mi := &file_organizations_proto_msgTypes[39]
+func (x *UpdateMemoryNamespaceRequest) Reset() {
+	*x = UpdateMemoryNamespaceRequest{}
+	mi := &file_organizations_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListIntegrationResourcesRequest) String() string {
+func (x *UpdateMemoryNamespaceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListIntegrationResourcesRequest) ProtoMessage() {}
+func (*UpdateMemoryNamespaceRequest) ProtoMessage() {}
 
-func (x *ListIntegrationResourcesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[39]
+func (x *UpdateMemoryNamespaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1991,54 +3246,68 @@ func (x *ListIntegrationResourcesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListIntegrationResourcesRequest.ProtoReflect.Descriptor instead.
-func (*ListIntegrationResourcesRequest) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use UpdateMemoryNamespaceRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMemoryNamespaceRequest) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *ListIntegrationResourcesRequest) GetId() string {
+func (x *UpdateMemoryNamespaceRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *ListIntegrationResourcesRequest) GetIntegrationId() string {
+func (x *UpdateMemoryNamespaceRequest) GetNamespace() string {
 	if x != nil {
-		return x.IntegrationId
+		return x.Namespace
 	}
 	return ""
 }
 
-func (x *ListIntegrationResourcesRequest) GetParameters() map[string]string {
+func (x *UpdateMemoryNamespaceRequest) GetDescription() string {
 	if x != nil {
-		return x.Parameters
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateMemoryNamespaceRequest) GetDefaultAccess() MemoryNamespace_Access {
+	if x != nil {
+		return x.DefaultAccess
+	}
+	return MemoryNamespace_ACCESS_NONE
+}
+
+func (x *UpdateMemoryNamespaceRequest) GetCanvasAccess() []*MemoryNamespace_CanvasAccess {
+	if x != nil {
+		return x.CanvasAccess
 	}
 	return nil
 }
 
-type ListIntegrationResourcesResponse struct {
-	state         protoimpl.MessageState    `protogen:"open.v1"`
-	Resources     []*IntegrationResourceRef `protobuf:"bytes,1,rep,name=resources,proto3" json:"resources,omitempty"`
+type UpdateMemoryNamespaceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     *MemoryNamespace       `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListIntegrationResourcesResponse) Reset() {
-	*x = ListIntegrationResourcesResponse{}
-	mi := &file_organizations_proto_msgTypes[40]
+func (x *UpdateMemoryNamespaceResponse) Reset() {
+	*x = UpdateMemoryNamespaceResponse{}
+	mi := &file_organizations_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListIntegrationResourcesResponse) String() string {
+func (x *UpdateMemoryNamespaceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListIntegrationResourcesResponse) ProtoMessage() {}
+func (*UpdateMemoryNamespaceResponse) ProtoMessage() {}
 
-func (x *ListIntegrationResourcesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[40]
+func (x *UpdateMemoryNamespaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2049,42 +3318,41 @@ func (x *ListIntegrationResourcesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListIntegrationResourcesResponse.ProtoReflect.Descriptor instead.
-func (*ListIntegrationResourcesResponse) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use UpdateMemoryNamespaceResponse.ProtoReflect.Descriptor instead.
+func (*UpdateMemoryNamespaceResponse) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *ListIntegrationResourcesResponse) GetResources() []*IntegrationResourceRef {
+func (x *UpdateMemoryNamespaceResponse) GetNamespace() *MemoryNamespace {
 	if x != nil {
-		return x.Resources
+		return x.Namespace
 	}
 	return nil
 }
 
-type IntegrationResourceRef struct {
+type DeleteMemoryNamespaceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Id            string                 `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IntegrationResourceRef) Reset() {
-	*x = IntegrationResourceRef{}
-	mi := &file_organizations_proto_msgTypes[41]
+func (x *DeleteMemoryNamespaceRequest) Reset() {
+	*x = DeleteMemoryNamespaceRequest{}
+	mi := &file_organizations_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IntegrationResourceRef) String() string {
+func (x *DeleteMemoryNamespaceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IntegrationResourceRef) ProtoMessage() {}
+func (*DeleteMemoryNamespaceRequest) ProtoMessage() {}
 
-func (x *IntegrationResourceRef) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[41]
+func (x *DeleteMemoryNamespaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2095,57 +3363,84 @@ func (x *IntegrationResourceRef) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IntegrationResourceRef.ProtoReflect.Descriptor instead.
-func (*IntegrationResourceRef) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use DeleteMemoryNamespaceRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMemoryNamespaceRequest) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *IntegrationResourceRef) GetType() string {
+func (x *DeleteMemoryNamespaceRequest) GetId() string {
 	if x != nil {
-		return x.Type
+		return x.Id
 	}
 	return ""
 }
 
-func (x *IntegrationResourceRef) GetName() string {
+func (x *DeleteMemoryNamespaceRequest) GetNamespace() string {
 	if x != nil {
-		return x.Name
+		return x.Namespace
 	}
 	return ""
 }
 
-func (x *IntegrationResourceRef) GetId() string {
+type DeleteMemoryNamespaceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMemoryNamespaceResponse) Reset() {
+	*x = DeleteMemoryNamespaceResponse{}
+	mi := &file_organizations_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMemoryNamespaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMemoryNamespaceResponse) ProtoMessage() {}
+
+func (x *DeleteMemoryNamespaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[61]
 	if x != nil {
-		return x.Id
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-type UpdateIntegrationRequest struct {
+// Deprecated: Use DeleteMemoryNamespaceResponse.ProtoReflect.Descriptor instead.
+func (*DeleteMemoryNamespaceResponse) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{61}
+}
+
+type ListMemoriesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	IntegrationId string                 `protobuf:"bytes,2,opt,name=integration_id,json=integrationId,proto3" json:"integration_id,omitempty"`
-	Configuration *_struct.Struct        `protobuf:"bytes,3,opt,name=configuration,proto3" json:"configuration,omitempty"`
-	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateIntegrationRequest) Reset() {
-	*x = UpdateIntegrationRequest{}
-	mi := &file_organizations_proto_msgTypes[42]
+func (x *ListMemoriesRequest) Reset() {
+	*x = ListMemoriesRequest{}
+	mi := &file_organizations_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateIntegrationRequest) String() string {
+func (x *ListMemoriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateIntegrationRequest) ProtoMessage() {}
+func (*ListMemoriesRequest) ProtoMessage() {}
 
-func (x *UpdateIntegrationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[42]
+func (x *ListMemoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2156,61 +3451,47 @@ func (x *UpdateIntegrationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateIntegrationRequest.ProtoReflect.Descriptor instead.
-func (*UpdateIntegrationRequest) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use ListMemoriesRequest.ProtoReflect.Descriptor instead.
+func (*ListMemoriesRequest) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *UpdateIntegrationRequest) GetId() string {
+func (x *ListMemoriesRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateIntegrationRequest) GetIntegrationId() string {
-	if x != nil {
-		return x.IntegrationId
-	}
-	return ""
-}
-
-func (x *UpdateIntegrationRequest) GetConfiguration() *_struct.Struct {
-	if x != nil {
-		return x.Configuration
-	}
-	return nil
-}
-
-func (x *UpdateIntegrationRequest) GetName() string {
+func (x *ListMemoriesRequest) GetNamespace() string {
 	if x != nil {
-		return x.Name
+		return x.Namespace
 	}
 	return ""
 }
 
-type UpdateIntegrationResponse struct {
+type ListMemoriesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Integration   *Integration           `protobuf:"bytes,1,opt,name=integration,proto3" json:"integration,omitempty"`
+	Items         []*Memory              `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateIntegrationResponse) Reset() {
-	*x = UpdateIntegrationResponse{}
-	mi := &file_organizations_proto_msgTypes[43]
+func (x *ListMemoriesResponse) Reset() {
+	*x = ListMemoriesResponse{}
+	mi := &file_organizations_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateIntegrationResponse) String() string {
+func (x *ListMemoriesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateIntegrationResponse) ProtoMessage() {}
+func (*ListMemoriesResponse) ProtoMessage() {}
 
-func (x *UpdateIntegrationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[43]
+func (x *ListMemoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2221,41 +3502,42 @@ func (x *UpdateIntegrationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateIntegrationResponse.ProtoReflect.Descriptor instead.
-func (*UpdateIntegrationResponse) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use ListMemoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListMemoriesResponse) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *UpdateIntegrationResponse) GetIntegration() *Integration {
+func (x *ListMemoriesResponse) GetItems() []*Memory {
 	if x != nil {
-		return x.Integration
+		return x.Items
 	}
 	return nil
 }
 
-type DeleteIntegrationRequest struct {
+type DeleteMemoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	IntegrationId string                 `protobuf:"bytes,2,opt,name=integration_id,json=integrationId,proto3" json:"integration_id,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	MemoryId      string                 `protobuf:"bytes,3,opt,name=memory_id,json=memoryId,proto3" json:"memory_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteIntegrationRequest) Reset() {
-	*x = DeleteIntegrationRequest{}
-	mi := &file_organizations_proto_msgTypes[44]
+func (x *DeleteMemoryRequest) Reset() {
+	*x = DeleteMemoryRequest{}
+	mi := &file_organizations_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteIntegrationRequest) String() string {
+func (x *DeleteMemoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteIntegrationRequest) ProtoMessage() {}
+func (*DeleteMemoryRequest) ProtoMessage() {}
 
-func (x *DeleteIntegrationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[44]
+func (x *DeleteMemoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2266,46 +3548,53 @@ func (x *DeleteIntegrationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteIntegrationRequest.ProtoReflect.Descriptor instead.
-func (*DeleteIntegrationRequest) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use DeleteMemoryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMemoryRequest) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *DeleteIntegrationRequest) GetId() string {
+func (x *DeleteMemoryRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *DeleteIntegrationRequest) GetIntegrationId() string {
+func (x *DeleteMemoryRequest) GetNamespace() string {
 	if x != nil {
-		return x.IntegrationId
+		return x.Namespace
 	}
 	return ""
 }
 
-type DeleteIntegrationResponse struct {
+func (x *DeleteMemoryRequest) GetMemoryId() string {
+	if x != nil {
+		return x.MemoryId
+	}
+	return ""
+}
+
+type DeleteMemoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteIntegrationResponse) Reset() {
-	*x = DeleteIntegrationResponse{}
-	mi := &file_organizations_proto_msgTypes[45]
+func (x *DeleteMemoryResponse) Reset() {
+	*x = DeleteMemoryResponse{}
+	mi := &file_organizations_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteIntegrationResponse) String() string {
+func (x *DeleteMemoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteIntegrationResponse) ProtoMessage() {}
+func (*DeleteMemoryResponse) ProtoMessage() {}
 
-func (x *DeleteIntegrationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[45]
+func (x *DeleteMemoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2316,9 +3605,9 @@ func (x *DeleteIntegrationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteIntegrationResponse.ProtoReflect.Descriptor instead.
-func (*DeleteIntegrationResponse) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use DeleteMemoryResponse.ProtoReflect.Descriptor instead.
+func (*DeleteMemoryResponse) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{65}
 }
 
 type Integration struct {
@@ -2332,7 +3621,7 @@ type Integration struct {
 
 func (x *Integration) Reset() {
 	*x = Integration{}
-	mi := &file_organizations_proto_msgTypes[46]
+	mi := &file_organizations_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2344,7 +3633,7 @@ func (x *Integration) String() string {
 func (*Integration) ProtoMessage() {}
 
 func (x *Integration) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[46]
+	mi := &file_organizations_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2357,7 +3646,7 @@ func (x *Integration) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Integration.ProtoReflect.Descriptor instead.
 func (*Integration) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{46}
+	return file_organizations_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *Integration) GetMetadata() *Integration_Metadata {
@@ -2393,7 +3682,7 @@ type BrowserAction struct {
 
 func (x *BrowserAction) Reset() {
 	*x = BrowserAction{}
-	mi := &file_organizations_proto_msgTypes[47]
+	mi := &file_organizations_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2405,7 +3694,7 @@ func (x *BrowserAction) String() string {
 func (*BrowserAction) ProtoMessage() {}
 
 func (x *BrowserAction) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[47]
+	mi := &file_organizations_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2418,7 +3707,7 @@ func (x *BrowserAction) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BrowserAction.ProtoReflect.Descriptor instead.
 func (*BrowserAction) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{47}
+	return file_organizations_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *BrowserAction) GetUrl() string {
@@ -2460,7 +3749,7 @@ type OrganizationCreated struct {
 
 func (x *OrganizationCreated) Reset() {
 	*x = OrganizationCreated{}
-	mi := &file_organizations_proto_msgTypes[48]
+	mi := &file_organizations_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2472,7 +3761,7 @@ func (x *OrganizationCreated) String() string {
 func (*OrganizationCreated) ProtoMessage() {}
 
 func (x *OrganizationCreated) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[48]
+	mi := &file_organizations_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2485,7 +3774,7 @@ func (x *OrganizationCreated) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OrganizationCreated.ProtoReflect.Descriptor instead.
 func (*OrganizationCreated) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{48}
+	return file_organizations_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *OrganizationCreated) GetOrganizationId() string {
@@ -2512,7 +3801,7 @@ type OrganizationUpdated struct {
 
 func (x *OrganizationUpdated) Reset() {
 	*x = OrganizationUpdated{}
-	mi := &file_organizations_proto_msgTypes[49]
+	mi := &file_organizations_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2524,7 +3813,7 @@ func (x *OrganizationUpdated) String() string {
 func (*OrganizationUpdated) ProtoMessage() {}
 
 func (x *OrganizationUpdated) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[49]
+	mi := &file_organizations_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2537,7 +3826,7 @@ func (x *OrganizationUpdated) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OrganizationUpdated.ProtoReflect.Descriptor instead.
 func (*OrganizationUpdated) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{49}
+	return file_organizations_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *OrganizationUpdated) GetOrganizationId() string {
@@ -2564,7 +3853,7 @@ type OrganizationDeleted struct {
 
 func (x *OrganizationDeleted) Reset() {
 	*x = OrganizationDeleted{}
-	mi := &file_organizations_proto_msgTypes[50]
+	mi := &file_organizations_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2576,7 +3865,7 @@ func (x *OrganizationDeleted) String() string {
 func (*OrganizationDeleted) ProtoMessage() {}
 
 func (x *OrganizationDeleted) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[50]
+	mi := &file_organizations_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2589,7 +3878,7 @@ func (x *OrganizationDeleted) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OrganizationDeleted.ProtoReflect.Descriptor instead.
 func (*OrganizationDeleted) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{50}
+	return file_organizations_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *OrganizationDeleted) GetOrganizationId() string {
@@ -2616,7 +3905,7 @@ type InvitationCreated struct {
 
 func (x *InvitationCreated) Reset() {
 	*x = InvitationCreated{}
-	mi := &file_organizations_proto_msgTypes[51]
+	mi := &file_organizations_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2628,7 +3917,7 @@ func (x *InvitationCreated) String() string {
 func (*InvitationCreated) ProtoMessage() {}
 
 func (x *InvitationCreated) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[51]
+	mi := &file_organizations_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2641,7 +3930,7 @@ func (x *InvitationCreated) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InvitationCreated.ProtoReflect.Descriptor instead.
 func (*InvitationCreated) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{51}
+	return file_organizations_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *InvitationCreated) GetInvitationId() string {
@@ -2672,7 +3961,7 @@ type Organization_Metadata struct {
 
 func (x *Organization_Metadata) Reset() {
 	*x = Organization_Metadata{}
-	mi := &file_organizations_proto_msgTypes[52]
+	mi := &file_organizations_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2684,7 +3973,7 @@ func (x *Organization_Metadata) String() string {
 func (*Organization_Metadata) ProtoMessage() {}
 
 func (x *Organization_Metadata) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[52]
+	mi := &file_organizations_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2742,6 +4031,58 @@ func (x *Organization_Metadata) GetVersioningEnabled() bool {
 	return false
 }
 
+type MemoryNamespace_CanvasAccess struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	Access        MemoryNamespace_Access `protobuf:"varint,2,opt,name=access,proto3,enum=Superplane.Organizations.MemoryNamespace_Access" json:"access,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoryNamespace_CanvasAccess) Reset() {
+	*x = MemoryNamespace_CanvasAccess{}
+	mi := &file_organizations_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoryNamespace_CanvasAccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoryNamespace_CanvasAccess) ProtoMessage() {}
+
+func (x *MemoryNamespace_CanvasAccess) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoryNamespace_CanvasAccess.ProtoReflect.Descriptor instead.
+func (*MemoryNamespace_CanvasAccess) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{54, 0}
+}
+
+func (x *MemoryNamespace_CanvasAccess) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *MemoryNamespace_CanvasAccess) GetAccess() MemoryNamespace_Access {
+	if x != nil {
+		return x.Access
+	}
+	return MemoryNamespace_ACCESS_NONE
+}
+
 type Integration_Metadata struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -2754,7 +4095,7 @@ type Integration_Metadata struct {
 
 func (x *Integration_Metadata) Reset() {
 	*x = Integration_Metadata{}
-	mi := &file_organizations_proto_msgTypes[54]
+	mi := &file_organizations_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2766,7 +4107,7 @@ func (x *Integration_Metadata) String() string {
 func (*Integration_Metadata) ProtoMessage() {}
 
 func (x *Integration_Metadata) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[54]
+	mi := &file_organizations_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2779,7 +4120,7 @@ func (x *Integration_Metadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Integration_Metadata.ProtoReflect.Descriptor instead.
 func (*Integration_Metadata) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{46, 0}
+	return file_organizations_proto_rawDescGZIP(), []int{66, 0}
 }
 
 func (x *Integration_Metadata) GetId() string {
@@ -2820,7 +4161,7 @@ type Integration_Spec struct {
 
 func (x *Integration_Spec) Reset() {
 	*x = Integration_Spec{}
-	mi := &file_organizations_proto_msgTypes[55]
+	mi := &file_organizations_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2832,7 +4173,7 @@ func (x *Integration_Spec) String() string {
 func (*Integration_Spec) ProtoMessage() {}
 
 func (x *Integration_Spec) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[55]
+	mi := &file_organizations_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2845,7 +4186,7 @@ func (x *Integration_Spec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Integration_Spec.ProtoReflect.Descriptor instead.
 func (*Integration_Spec) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{46, 1}
+	return file_organizations_proto_rawDescGZIP(), []int{66, 1}
 }
 
 func (x *Integration_Spec) GetIntegrationName() string {
@@ -2875,7 +4216,7 @@ type Integration_Status struct {
 
 func (x *Integration_Status) Reset() {
 	*x = Integration_Status{}
-	mi := &file_organizations_proto_msgTypes[56]
+	mi := &file_organizations_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2887,7 +4228,7 @@ func (x *Integration_Status) String() string {
 func (*Integration_Status) ProtoMessage() {}
 
 func (x *Integration_Status) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[56]
+	mi := &file_organizations_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2900,7 +4241,7 @@ func (x *Integration_Status) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Integration_Status.ProtoReflect.Descriptor instead.
 func (*Integration_Status) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{46, 2}
+	return file_organizations_proto_rawDescGZIP(), []int{66, 2}
 }
 
 func (x *Integration_Status) GetState() string {
@@ -2950,7 +4291,7 @@ type Integration_NodeRef struct {
 
 func (x *Integration_NodeRef) Reset() {
 	*x = Integration_NodeRef{}
-	mi := &file_organizations_proto_msgTypes[57]
+	mi := &file_organizations_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2962,7 +4303,7 @@ func (x *Integration_NodeRef) String() string {
 func (*Integration_NodeRef) ProtoMessage() {}
 
 func (x *Integration_NodeRef) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[57]
+	mi := &file_organizations_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2975,7 +4316,7 @@ func (x *Integration_NodeRef) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Integration_NodeRef.ProtoReflect.Descriptor instead.
 func (*Integration_NodeRef) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{46, 3}
+	return file_organizations_proto_rawDescGZIP(), []int{66, 3}
 }
 
 func (x *Integration_NodeRef) GetCanvasId() string {
@@ -3120,7 +4461,57 @@ const file_organizations_proto_rawDesc = "" +
 	"\x1bDeleteAgentOpenAIKeyRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"n\n" +
 	"\x1cDeleteAgentOpenAIKeyResponse\x12N\n" +
-	"\x0eagent_settings\x18\x01 \x01(\v2'.Superplane.Organizations.AgentSettingsR\ragentSettings\"<\n" +
+	"\x0eagent_settings\x18\x01 \x01(\v2'.Superplane.Organizations.AgentSettingsR\ragentSettings\"\xcf\x01\n" +
+	"\x0fSSOOIDCSettings\x12\x1d\n" +
+	"\n" +
+	"issuer_url\x18\x01 \x01(\tR\tissuerUrl\x12\x1b\n" +
+	"\tclient_id\x18\x02 \x01(\tR\bclientId\x12#\n" +
+	"\rclient_secret\x18\x03 \x01(\tR\fclientSecret\x128\n" +
+	"\x18client_secret_configured\x18\x04 \x01(\bR\x16clientSecretConfigured\x12!\n" +
+	"\fredirect_url\x18\x05 \x01(\tR\vredirectUrl\"\xb7\x01\n" +
+	"\x0fSSOSAMLSettings\x12(\n" +
+	"\x10idp_metadata_url\x18\x01 \x01(\tR\x0eidpMetadataUrl\x12!\n" +
+	"\fidp_metadata\x18\x02 \x01(\tR\vidpMetadata\x12\x1b\n" +
+	"\tentity_id\x18\x03 \x01(\tR\bentityId\x12\x17\n" +
+	"\aacs_url\x18\x04 \x01(\tR\x06acsUrl\x12!\n" +
+	"\fmetadata_url\x18\x05 \x01(\tR\vmetadataUrl\"\xdc\x01\n" +
+	"\tSSODomain\x12\x16\n" +
+	"\x06domain\x18\x01 \x01(\tR\x06domain\x12\x1a\n" +
+	"\bverified\x18\x02 \x01(\bR\bverified\x12/\n" +
+	"\x13verification_record\x18\x03 \x01(\tR\x12verificationRecord\x12-\n" +
+	"\x12verification_value\x18\x04 \x01(\tR\x11verificationValue\x12;\n" +
+	"\vverified_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"verifiedAt\"\xe7\x03\n" +
+	"\vSSOSettings\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1a\n" +
+	"\bprotocol\x18\x02 \x01(\tR\bprotocol\x12\x18\n" +
+	"\aenabled\x18\x03 \x01(\bR\aenabled\x12\x1a\n" +
+	"\benforced\x18\x04 \x01(\bR\benforced\x12)\n" +
+	"\x10jit_provisioning\x18\x05 \x01(\bR\x0fjitProvisioning\x12=\n" +
+	"\x04oidc\x18\x06 \x01(\v2).Superplane.Organizations.SSOOIDCSettingsR\x04oidc\x12=\n" +
+	"\x04saml\x18\a \x01(\v2).Superplane.Organizations.SSOSAMLSettingsR\x04saml\x12\x1b\n" +
+	"\tlogin_url\x18\b \x01(\tR\bloginUrl\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\n" +
+	" \x01(\tR\tupdatedBy\x12=\n" +
+	"\adomains\x18\v \x03(\v2#.Superplane.Organizations.SSODomainR\adomains\"'\n" +
+	"\x15GetSSOSettingsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"b\n" +
+	"\x16GetSSOSettingsResponse\x12H\n" +
+	"\fsso_settings\x18\x01 \x01(\v2%.Superplane.Organizations.SSOSettingsR\vssoSettings\"\xbf\x02\n" +
+	"\x18UpdateSSOSettingsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bprotocol\x18\x02 \x01(\tR\bprotocol\x12\x18\n" +
+	"\aenabled\x18\x03 \x01(\bR\aenabled\x12\x1a\n" +
+	"\benforced\x18\x04 \x01(\bR\benforced\x12)\n" +
+	"\x10jit_provisioning\x18\x05 \x01(\bR\x0fjitProvisioning\x12=\n" +
+	"\x04oidc\x18\x06 \x01(\v2).Superplane.Organizations.SSOOIDCSettingsR\x04oidc\x12=\n" +
+	"\x04saml\x18\a \x01(\v2).Superplane.Organizations.SSOSAMLSettingsR\x04saml\x12\x18\n" +
+	"\adomains\x18\b \x03(\tR\adomains\"e\n" +
+	"\x19UpdateSSOSettingsResponse\x12H\n" +
+	"\fsso_settings\x18\x01 \x01(\v2%.Superplane.Organizations.SSOSettingsR\vssoSettings\"<\n" +
 	"\x11RemoveUserRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x14\n" +
@@ -3166,7 +4557,59 @@ const file_organizations_proto_rawDesc = "" +
 	"\x18DeleteIntegrationRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12%\n" +
 	"\x0eintegration_id\x18\x02 \x01(\tR\rintegrationId\"\x1b\n" +
-	"\x19DeleteIntegrationResponse\"\x92\a\n" +
+	"\x19DeleteIntegrationResponse\"\xb2\x04\n" +
+	"\x0fMemoryNamespace\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12W\n" +
+	"\x0edefault_access\x18\x03 \x01(\x0e20.Superplane.Organizations.MemoryNamespace.AccessR\rdefaultAccess\x12[\n" +
+	"\rcanvas_access\x18\x04 \x03(\v26.Superplane.Organizations.MemoryNamespace.CanvasAccessR\fcanvasAccess\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x1au\n" +
+	"\fCanvasAccess\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12H\n" +
+	"\x06access\x18\x02 \x01(\x0e20.Superplane.Organizations.MemoryNamespace.AccessR\x06access\"<\n" +
+	"\x06Access\x12\x0f\n" +
+	"\vACCESS_NONE\x10\x00\x12\x0f\n" +
+	"\vACCESS_READ\x10\x01\x12\x10\n" +
+	"\fACCESS_WRITE\x10\x02\"\xdc\x01\n" +
+	"\x06Memory\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12.\n" +
+	"\x06values\x18\x03 \x01(\v2\x16.google.protobuf.ValueR\x06values\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"-\n" +
+	"\x1bListMemoryNamespacesRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"i\n" +
+	"\x1cListMemoryNamespacesResponse\x12I\n" +
+	"\n" +
+	"namespaces\x18\x01 \x03(\v2).Superplane.Organizations.MemoryNamespaceR\n" +
+	"namespaces\"\xa4\x02\n" +
+	"\x1cUpdateMemoryNamespaceRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12W\n" +
+	"\x0edefault_access\x18\x04 \x01(\x0e20.Superplane.Organizations.MemoryNamespace.AccessR\rdefaultAccess\x12[\n" +
+	"\rcanvas_access\x18\x05 \x03(\v26.Superplane.Organizations.MemoryNamespace.CanvasAccessR\fcanvasAccess\"h\n" +
+	"\x1dUpdateMemoryNamespaceResponse\x12G\n" +
+	"\tnamespace\x18\x01 \x01(\v2).Superplane.Organizations.MemoryNamespaceR\tnamespace\"L\n" +
+	"\x1cDeleteMemoryNamespaceRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\"\x1f\n" +
+	"\x1dDeleteMemoryNamespaceResponse\"C\n" +
+	"\x13ListMemoriesRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\"N\n" +
+	"\x14ListMemoriesResponse\x126\n" +
+	"\x05items\x18\x01 \x03(\v2 .Superplane.Organizations.MemoryR\x05items\"`\n" +
+	"\x13DeleteMemoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x1b\n" +
+	"\tmemory_id\x18\x03 \x01(\tR\bmemoryId\"\x16\n" +
+	"\x14DeleteMemoryResponse\"\x92\a\n" +
 	"\vIntegration\x12J\n" +
 	"\bmetadata\x18\x01 \x01(\v2..Superplane.Organizations.Integration.MetadataR\bmetadata\x12>\n" +
 	"\x04spec\x18\x02 \x01(\v2*.Superplane.Organizations.Integration.SpecR\x04spec\x12D\n" +
@@ -3213,7 +4656,7 @@ const file_organizations_proto_rawDesc = "" +
 	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"r\n" +
 	"\x11InvitationCreated\x12#\n" +
 	"\rinvitation_id\x18\x01 \x01(\tR\finvitationId\x128\n" +
-	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp2\x96/\n" +
+	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp2\xbe@\n" +
 	"\rOrganizations\x12\xa7\x02\n" +
 	"\x14DescribeOrganization\x125.Superplane.Organizations.DescribeOrganizationRequest\x1a6.Superplane.Organizations.DescribeOrganizationResponse\"\x9f\x01\x92Az\n" +
 	"\fOrganization\x12\x18Get organization details\x1aPReturns the details of a specific organization (can be referenced by ID or name)\x82\xd3\xe4\x93\x02\x1c\x12\x1a/api/v1/organizations/{id}\x12\x96\x02\n" +
@@ -3243,7 +4686,11 @@ const file_organizations_proto_rawDesc = "" +
 	"\x11SetAgentOpenAIKey\x122.Superplane.Organizations.SetAgentOpenAIKeyRequest\x1a3.Superplane.Organizations.SetAgentOpenAIKeyResponse\"\xbe\x01\x92A|\n" +
 	"\fOrganization\x127Create or update organization OpenAI key for Agent Mode\x1a3Sets the OpenAI key used by organization Agent Mode\x82\xd3\xe4\x93\x029:\x01*\x1a4/api/v1/organizations/{id}/agent-settings/openai-key\x12\xbc\x02\n" +
 	"\x14DeleteAgentOpenAIKey\x125.Superplane.Organizations.DeleteAgentOpenAIKeyRequest\x1a6.Superplane.Organizations.DeleteAgentOpenAIKeyResponse\"\xb4\x01\x92Au\n" +
-	"\fOrganization\x12-Delete organization OpenAI key for Agent Mode\x1a6Deletes the OpenAI key used by organization Agent Mode\x82\xd3\xe4\x93\x026*4/api/v1/organizations/{id}/agent-settings/openai-key\x12\xea\x01\n" +
+	"\fOrganization\x12-Delete organization OpenAI key for Agent Mode\x1a6Deletes the OpenAI key used by organization Agent Mode\x82\xd3\xe4\x93\x026*4/api/v1/organizations/{id}/agent-settings/openai-key\x12\x92\x02\n" +
+	"\x0eGetSSOSettings\x12/.Superplane.Organizations.GetSSOSettingsRequest\x1a0.Superplane.Organizations.GetSSOSettingsResponse\"\x9c\x01\x92Aj\n" +
+	"\fOrganization\x12\x1dGet organization SSO settings\x1a;Returns the single sign-on configuration of an organization\x82\xd3\xe4\x93\x02)\x12'/api/v1/organizations/{id}/sso-settings\x12\xbe\x02\n" +
+	"\x11UpdateSSOSettings\x122.Superplane.Organizations.UpdateSSOSettingsRequest\x1a3.Superplane.Organizations.UpdateSSOSettingsResponse\"\xbf\x01\x92A\x89\x01\n" +
+	"\fOrganization\x12 Update organization SSO settings\x1aWConfigures single sign-on with a SAML 2.0 or OIDC identity provider for an organization\x82\xd3\xe4\x93\x02,:\x01*\x1a'/api/v1/organizations/{id}/sso-settings\x12\xea\x01\n" +
 	"\x10AcceptInviteLink\x12$.Superplane.Organizations.InviteLink\x1a\x17.google.protobuf.Struct\"\x96\x01\x92Ah\n" +
 	"\fOrganization\x12\x15Accept an invite link\x1aAAccepts an organization invite link for the authenticated account\x82\xd3\xe4\x93\x02%\"#/api/v1/invite-links/{token}/accept\x12\x95\x02\n" +
 	"\x10ListIntegrations\x121.Superplane.Organizations.ListIntegrationsRequest\x1a2.Superplane.Organizations.ListIntegrationsResponse\"\x99\x01\x92Ag\n" +
@@ -3257,7 +4704,17 @@ const file_organizations_proto_rawDesc = "" +
 	"\x11UpdateIntegration\x122.Superplane.Organizations.UpdateIntegrationRequest\x1a3.Superplane.Organizations.UpdateIntegrationResponse\"\xa3\x01\x92A]\n" +
 	"\fOrganization\x12\x12Update integration\x1a9Updates the configuration for an organization integration\x82\xd3\xe4\x93\x02=:\x01*28/api/v1/organizations/{id}/integrations/{integration_id}\x12\x9e\x02\n" +
 	"\x11DeleteIntegration\x122.Superplane.Organizations.DeleteIntegrationRequest\x1a3.Superplane.Organizations.DeleteIntegrationResponse\"\x9f\x01\x92A\\\n" +
-	"\fOrganization\x12\x1fDelete organization integration\x1a+Deletes an integration from an organization\x82\xd3\xe4\x93\x02:*8/api/v1/organizations/{id}/integrations/{integration_id}B\xf0\x01\x92A\xaf\x01\x12\x84\x01\n" +
+	"\fOrganization\x12\x1fDelete organization integration\x1a+Deletes an integration from an organization\x82\xd3\xe4\x93\x02:*8/api/v1/organizations/{id}/integrations/{integration_id}\x12\xbb\x02\n" +
+	"\x14ListMemoryNamespaces\x125.Superplane.Organizations.ListMemoryNamespacesRequest\x1a6.Superplane.Organizations.ListMemoryNamespacesResponse\"\xb3\x01\x92A|\n" +
+	"\fOrganization\x12#List organization memory namespaces\x1aGReturns the memory namespaces shared by the canvases of an organization\x82\xd3\xe4\x93\x02.\x12,/api/v1/organizations/{id}/memory-namespaces\x12\xec\x02\n" +
+	"\x15UpdateMemoryNamespace\x126.Superplane.Organizations.UpdateMemoryNamespaceRequest\x1a7.Superplane.Organizations.UpdateMemoryNamespaceResponse\"\xe1\x01\x92A\x9a\x01\n" +
+	"\fOrganization\x121Create or update an organization memory namespace\x1aWCreates or replaces an organization memory namespace and the access canvases have to it\x82\xd3\xe4\x93\x02=:\x01*\x1a8/api/v1/organizations/{id}/memory-namespaces/{namespace}\x12\xc3\x02\n" +
+	"\x15DeleteMemoryNamespace\x126.Superplane.Organizations.DeleteMemoryNamespaceRequest\x1a7.Superplane.Organizations.DeleteMemoryNamespaceResponse\"\xb8\x01\x92Au\n" +
+	"\fOrganization\x12'Delete an organization memory namespace\x1a<Deletes an organization memory namespace and all its records\x82\xd3\xe4\x93\x02:*8/api/v1/organizations/{id}/memory-namespaces/{namespace}\x12\xa5\x02\n" +
+	"\fListMemories\x12-.Superplane.Organizations.ListMemoriesRequest\x1a..Superplane.Organizations.ListMemoriesResponse\"\xb5\x01\x92Ai\n" +
+	"\fOrganization\x12 List organization memory records\x1a7Returns the records of an organization memory namespace\x82\xd3\xe4\x93\x02C\x12A/api/v1/organizations/{id}/memory-namespaces/{namespace}/memories\x12\xb4\x02\n" +
+	"\fDeleteMemory\x12-.Superplane.Organizations.DeleteMemoryRequest\x1a..Superplane.Organizations.DeleteMemoryResponse\"\xc4\x01\x92Al\n" +
+	"\fOrganization\x12$Delete an organization memory record\x1a6Deletes a record from an organization memory namespace\x82\xd3\xe4\x93\x02O*M/api/v1/organizations/{id}/memory-namespaces/{namespace}/memories/{memory_id}B\xf0\x01\x92A\xaf\x01\x12\x84\x01\n" +
 	"\x1cSuperplane Organizations API\x128API for managing organizations in the Superplane service\"%\n" +
 	"\vAPI Support\x1a\x16support@superplane.com2\x031.0*\x02\x01\x022\x10application/json:\x10application/jsonZ;github.com/superplanehq/superplane/pkg/protos/organizationsb\x06proto3"
 
@@ -3273,161 +4730,221 @@ func file_organizations_proto_rawDescGZIP() []byte {
 	return file_organizations_proto_rawDescData
 }
 
-var file_organizations_proto_msgTypes = make([]protoimpl.MessageInfo, 59)
+var file_organizations_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_organizations_proto_msgTypes = make([]protoimpl.MessageInfo, 80)
 var file_organizations_proto_goTypes = []any{
-	(*Organization)(nil),                     // 0: Superplane.Organizations.Organization
-	(*DescribeOrganizationRequest)(nil),      // 1: Superplane.Organizations.DescribeOrganizationRequest
-	(*DescribeOrganizationResponse)(nil),     // 2: Superplane.Organizations.DescribeOrganizationResponse
-	(*UpdateOrganizationRequest)(nil),        // 3: Superplane.Organizations.UpdateOrganizationRequest
-	(*UpdateOrganizationResponse)(nil),       // 4: Superplane.Organizations.UpdateOrganizationResponse
-	(*DeleteOrganizationRequest)(nil),        // 5: Superplane.Organizations.DeleteOrganizationRequest
-	(*DeleteOrganizationResponse)(nil),       // 6: Superplane.Organizations.DeleteOrganizationResponse
-	(*Invitation)(nil),                       // 7: Superplane.Organizations.Invitation
-	(*InviteLink)(nil),                       // 8: Superplane.Organizations.InviteLink
-	(*AgentOpenAIKey)(nil),                   // 9: Superplane.Organizations.AgentOpenAIKey
-	(*AgentSettings)(nil),                    // 10: Superplane.Organizations.AgentSettings
-	(*CreateInvitationRequest)(nil),          // 11: Superplane.Organizations.CreateInvitationRequest
-	(*CreateInvitationResponse)(nil),         // 12: Superplane.Organizations.CreateInvitationResponse
-	(*ListInvitationsRequest)(nil),           // 13: Superplane.Organizations.ListInvitationsRequest
-	(*ListInvitationsResponse)(nil),          // 14: Superplane.Organizations.ListInvitationsResponse
-	(*RemoveInvitationRequest)(nil),          // 15: Superplane.Organizations.RemoveInvitationRequest
-	(*RemoveInvitationResponse)(nil),         // 16: Superplane.Organizations.RemoveInvitationResponse
-	(*GetInviteLinkRequest)(nil),             // 17: Superplane.Organizations.GetInviteLinkRequest
-	(*GetInviteLinkResponse)(nil),            // 18: Superplane.Organizations.GetInviteLinkResponse
-	(*UpdateInviteLinkRequest)(nil),          // 19: Superplane.Organizations.UpdateInviteLinkRequest
-	(*UpdateInviteLinkResponse)(nil),         // 20: Superplane.Organizations.UpdateInviteLinkResponse
-	(*ResetInviteLinkRequest)(nil),           // 21: Superplane.Organizations.ResetInviteLinkRequest
-	(*ResetInviteLinkResponse)(nil),          // 22: Superplane.Organizations.ResetInviteLinkResponse
-	(*GetAgentSettingsRequest)(nil),          // 23: Superplane.Organizations.GetAgentSettingsRequest
-	(*GetAgentSettingsResponse)(nil),         // 24: Superplane.Organizations.GetAgentSettingsResponse
-	(*UpdateAgentSettingsRequest)(nil),       // 25: Superplane.Organizations.UpdateAgentSettingsRequest
-	(*UpdateAgentSettingsResponse)(nil),      // 26: Superplane.Organizations.UpdateAgentSettingsResponse
-	(*SetAgentOpenAIKeyRequest)(nil),         // 27: Superplane.Organizations.SetAgentOpenAIKeyRequest
-	(*SetAgentOpenAIKeyResponse)(nil),        // 28: Superplane.Organizations.SetAgentOpenAIKeyResponse
-	(*DeleteAgentOpenAIKeyRequest)(nil),      // 29: Superplane.Organizations.DeleteAgentOpenAIKeyRequest
-	(*DeleteAgentOpenAIKeyResponse)(nil),     // 30: Superplane.Organizations.DeleteAgentOpenAIKeyResponse
-	(*RemoveUserRequest)(nil),                // 31: Superplane.Organizations.RemoveUserRequest
-	(*RemoveUserResponse)(nil),               // 32: Superplane.Organizations.RemoveUserResponse
-	(*ListIntegrationsRequest)(nil),          // 33: Superplane.Organizations.ListIntegrationsRequest
-	(*ListIntegrationsResponse)(nil),         // 34: Superplane.Organizations.ListIntegrationsResponse
-	(*CreateIntegrationRequest)(nil),         // 35: Superplane.Organizations.CreateIntegrationRequest
-	(*CreateIntegrationResponse)(nil),        // 36: Superplane.Organizations.CreateIntegrationResponse
-	(*DescribeIntegrationRequest)(nil),       // 37: Superplane.Organizations.DescribeIntegrationRequest
-	(*DescribeIntegrationResponse)(nil),      // 38: Superplane.Organizations.DescribeIntegrationResponse
-	(*ListIntegrationResourcesRequest)(nil),  // 39: Superplane.Organizations.ListIntegrationResourcesRequest
-	(*ListIntegrationResourcesResponse)(nil), // 40: Superplane.Organizations.ListIntegrationResourcesResponse
-	(*IntegrationResourceRef)(nil),           // 41: Superplane.Organizations.IntegrationResourceRef
-	(*UpdateIntegrationRequest)(nil),         // 42: Superplane.Organizations.UpdateIntegrationRequest
-	(*UpdateIntegrationResponse)(nil),        // 43: Superplane.Organizations.UpdateIntegrationResponse
-	(*DeleteIntegrationRequest)(nil),         // 44: Superplane.Organizations.DeleteIntegrationRequest
-	(*DeleteIntegrationResponse)(nil),        // 45: Superplane.Organizations.DeleteIntegrationResponse
-	(*Integration)(nil),                      // 46: Superplane.Organizations.Integration
-	(*BrowserAction)(nil),                    // 47: Superplane.Organizations.BrowserAction
-	(*OrganizationCreated)(nil),              // 48: Superplane.Organizations.OrganizationCreated
-	(*OrganizationUpdated)(nil),              // 49: Superplane.Organizations.OrganizationUpdated
-	(*OrganizationDeleted)(nil),              // 50: Superplane.Organizations.OrganizationDeleted
-	(*InvitationCreated)(nil),                // 51: Superplane.Organizations.InvitationCreated
-	(*Organization_Metadata)(nil),            // 52: Superplane.Organizations.Organization.Metadata
-	nil,                                      // 53: Superplane.Organizations.ListIntegrationResourcesRequest.ParametersEntry
-	(*Integration_Metadata)(nil),             // 54: Superplane.Organizations.Integration.Metadata
-	(*Integration_Spec)(nil),                 // 55: Superplane.Organizations.Integration.Spec
-	(*Integration_Status)(nil),               // 56: Superplane.Organizations.Integration.Status
-	(*Integration_NodeRef)(nil),              // 57: Superplane.Organizations.Integration.NodeRef
-	nil,                                      // 58: Superplane.Organizations.BrowserAction.FormFieldsEntry
-	(*timestamp.Timestamp)(nil),              // 59: google.protobuf.Timestamp
-	(*_struct.Struct)(nil),                   // 60: google.protobuf.Struct
+	(MemoryNamespace_Access)(0),              // 0: Superplane.Organizations.MemoryNamespace.Access
+	(*Organization)(nil),                     // 1: Superplane.Organizations.Organization
+	(*DescribeOrganizationRequest)(nil),      // 2: Superplane.Organizations.DescribeOrganizationRequest
+	(*DescribeOrganizationResponse)(nil),     // 3: Superplane.Organizations.DescribeOrganizationResponse
+	(*UpdateOrganizationRequest)(nil),        // 4: Superplane.Organizations.UpdateOrganizationRequest
+	(*UpdateOrganizationResponse)(nil),       // 5: Superplane.Organizations.UpdateOrganizationResponse
+	(*DeleteOrganizationRequest)(nil),        // 6: Superplane.Organizations.DeleteOrganizationRequest
+	(*DeleteOrganizationResponse)(nil),       // 7: Superplane.Organizations.DeleteOrganizationResponse
+	(*Invitation)(nil),                       // 8: Superplane.Organizations.Invitation
+	(*InviteLink)(nil),                       // 9: Superplane.Organizations.InviteLink
+	(*AgentOpenAIKey)(nil),                   // 10: Superplane.Organizations.AgentOpenAIKey
+	(*AgentSettings)(nil),                    // 11: Superplane.Organizations.AgentSettings
+	(*CreateInvitationRequest)(nil),          // 12: Superplane.Organizations.CreateInvitationRequest
+	(*CreateInvitationResponse)(nil),         // 13: Superplane.Organizations.CreateInvitationResponse
+	(*ListInvitationsRequest)(nil),           // 14: Superplane.Organizations.ListInvitationsRequest
+	(*ListInvitationsResponse)(nil),          // 15: Superplane.Organizations.ListInvitationsResponse
+	(*RemoveInvitationRequest)(nil),          // 16: Superplane.Organizations.RemoveInvitationRequest
+	(*RemoveInvitationResponse)(nil),         // 17: Superplane.Organizations.RemoveInvitationResponse
+	(*GetInviteLinkRequest)(nil),             // 18: Superplane.Organizations.GetInviteLinkRequest
+	(*GetInviteLinkResponse)(nil),            // 19: Superplane.Organizations.GetInviteLinkResponse
+	(*UpdateInviteLinkRequest)(nil),          // 20: Superplane.Organizations.UpdateInviteLinkRequest
+	(*UpdateInviteLinkResponse)(nil),         // 21: Superplane.Organizations.UpdateInviteLinkResponse
+	(*ResetInviteLinkRequest)(nil),           // 22: Superplane.Organizations.ResetInviteLinkRequest
+	(*ResetInviteLinkResponse)(nil),          // 23: Superplane.Organizations.ResetInviteLinkResponse
+	(*GetAgentSettingsRequest)(nil),          // 24: Superplane.Organizations.GetAgentSettingsRequest
+	(*GetAgentSettingsResponse)(nil),         // 25: Superplane.Organizations.GetAgentSettingsResponse
+	(*UpdateAgentSettingsRequest)(nil),       // 26: Superplane.Organizations.UpdateAgentSettingsRequest
+	(*UpdateAgentSettingsResponse)(nil),      // 27: Superplane.Organizations.UpdateAgentSettingsResponse
+	(*SetAgentOpenAIKeyRequest)(nil),         // 28: Superplane.Organizations.SetAgentOpenAIKeyRequest
+	(*SetAgentOpenAIKeyResponse)(nil),        // 29: Superplane.Organizations.SetAgentOpenAIKeyResponse
+	(*DeleteAgentOpenAIKeyRequest)(nil),      // 30: Superplane.Organizations.DeleteAgentOpenAIKeyRequest
+	(*DeleteAgentOpenAIKeyResponse)(nil),     // 31: Superplane.Organizations.DeleteAgentOpenAIKeyResponse
+	(*SSOOIDCSettings)(nil),                  // 32: Superplane.Organizations.SSOOIDCSettings
+	(*SSOSAMLSettings)(nil),                  // 33: Superplane.Organizations.SSOSAMLSettings
+	(*SSODomain)(nil),                        // 34: Superplane.Organizations.SSODomain
+	(*SSOSettings)(nil),                      // 35: Superplane.Organizations.SSOSettings
+	(*GetSSOSettingsRequest)(nil),            // 36: Superplane.Organizations.GetSSOSettingsRequest
+	(*GetSSOSettingsResponse)(nil),           // 37: Superplane.Organizations.GetSSOSettingsResponse
+	(*UpdateSSOSettingsRequest)(nil),         // 38: Superplane.Organizations.UpdateSSOSettingsRequest
+	(*UpdateSSOSettingsResponse)(nil),        // 39: Superplane.Organizations.UpdateSSOSettingsResponse
+	(*RemoveUserRequest)(nil),                // 40: Superplane.Organizations.RemoveUserRequest
+	(*RemoveUserResponse)(nil),               // 41: Superplane.Organizations.RemoveUserResponse
+	(*ListIntegrationsRequest)(nil),          // 42: Superplane.Organizations.ListIntegrationsRequest
+	(*ListIntegrationsResponse)(nil),         // 43: Superplane.Organizations.ListIntegrationsResponse
+	(*CreateIntegrationRequest)(nil),         // 44: Superplane.Organizations.CreateIntegrationRequest
+	(*CreateIntegrationResponse)(nil),        // 45: Superplane.Organizations.CreateIntegrationResponse
+	(*DescribeIntegrationRequest)(nil),       // 46: Superplane.Organizations.DescribeIntegrationRequest
+	(*DescribeIntegrationResponse)(nil),      // 47: Superplane.Organizations.DescribeIntegrationResponse
+	(*ListIntegrationResourcesRequest)(nil),  // 48: Superplane.Organizations.ListIntegrationResourcesRequest
+	(*ListIntegrationResourcesResponse)(nil), // 49: Superplane.Organizations.ListIntegrationResourcesResponse
+	(*IntegrationResourceRef)(nil),           // 50: Superplane.Organizations.IntegrationResourceRef
+	(*UpdateIntegrationRequest)(nil),         // 51: Superplane.Organizations.UpdateIntegrationRequest
+	(*UpdateIntegrationResponse)(nil),        // 52: Superplane.Organizations.UpdateIntegrationResponse
+	(*DeleteIntegrationRequest)(nil),         // 53: Superplane.Organizations.DeleteIntegrationRequest
+	(*DeleteIntegrationResponse)(nil),        // 54: Superplane.Organizations.DeleteIntegrationResponse
+	(*MemoryNamespace)(nil),                  // 55: Superplane.Organizations.MemoryNamespace
+	(*Memory)(nil),                           // 56: Superplane.Organizations.Memory
+	(*ListMemoryNamespacesRequest)(nil),      // 57: Superplane.Organizations.ListMemoryNamespacesRequest
+	(*ListMemoryNamespacesResponse)(nil),     // 58: Superplane.Organizations.ListMemoryNamespacesResponse
+	(*UpdateMemoryNamespaceRequest)(nil),     // 59: Superplane.Organizations.UpdateMemoryNamespaceRequest
+	(*UpdateMemoryNamespaceResponse)(nil),    // 60: Superplane.Organizations.UpdateMemoryNamespaceResponse
+	(*DeleteMemoryNamespaceRequest)(nil),     // 61: Superplane.Organizations.DeleteMemoryNamespaceRequest
+	(*DeleteMemoryNamespaceResponse)(nil),    // 62: Superplane.Organizations.DeleteMemoryNamespaceResponse
+	(*ListMemoriesRequest)(nil),              // 63: Superplane.Organizations.ListMemoriesRequest
+	(*ListMemoriesResponse)(nil),             // 64: Superplane.Organizations.ListMemoriesResponse
+	(*DeleteMemoryRequest)(nil),              // 65: Superplane.Organizations.DeleteMemoryRequest
+	(*DeleteMemoryResponse)(nil),             // 66: Superplane.Organizations.DeleteMemoryResponse
+	(*Integration)(nil),                      // 67: Superplane.Organizations.Integration
+	(*BrowserAction)(nil),                    // 68: Superplane.Organizations.BrowserAction
+	(*OrganizationCreated)(nil),              // 69: Superplane.Organizations.OrganizationCreated
+	(*OrganizationUpdated)(nil),              // 70: Superplane.Organizations.OrganizationUpdated
+	(*OrganizationDeleted)(nil),              // 71: Superplane.Organizations.OrganizationDeleted
+	(*InvitationCreated)(nil),                // 72: Superplane.Organizations.InvitationCreated
+	(*Organization_Metadata)(nil),            // 73: Superplane.Organizations.Organization.Metadata
+	nil,                                      // 74: Superplane.Organizations.ListIntegrationResourcesRequest.ParametersEntry
+	(*MemoryNamespace_CanvasAccess)(nil),     // 75: Superplane.Organizations.MemoryNamespace.CanvasAccess
+	(*Integration_Metadata)(nil),             // 76: Superplane.Organizations.Integration.Metadata
+	(*Integration_Spec)(nil),                 // 77: Superplane.Organizations.Integration.Spec
+	(*Integration_Status)(nil),               // 78: Superplane.Organizations.Integration.Status
+	(*Integration_NodeRef)(nil),              // 79: Superplane.Organizations.Integration.NodeRef
+	nil,                                      // 80: Superplane.Organizations.BrowserAction.FormFieldsEntry
+	(*timestamp.Timestamp)(nil),              // 81: google.protobuf.Timestamp
+	(*_struct.Struct)(nil),                   // 82: google.protobuf.Struct
+	(*_struct.Value)(nil),                    // 83: google.protobuf.Value
 }
 var file_organizations_proto_depIdxs = []int32{
-	52, // 0: Superplane.Organizations.Organization.metadata:type_name -> Superplane.Organizations.Organization.Metadata
-	0,  // 1: Superplane.Organizations.DescribeOrganizationResponse.organization:type_name -> Superplane.Organizations.Organization
-	0,  // 2: Superplane.Organizations.UpdateOrganizationRequest.organization:type_name -> Superplane.Organizations.Organization
-	0,  // 3: Superplane.Organizations.UpdateOrganizationResponse.organization:type_name -> Superplane.Organizations.Organization
-	59, // 4: Superplane.Organizations.Invitation.created_at:type_name -> google.protobuf.Timestamp
-	59, // 5: Superplane.Organizations.InviteLink.created_at:type_name -> google.protobuf.Timestamp
-	59, // 6: Superplane.Organizations.InviteLink.updated_at:type_name -> google.protobuf.Timestamp
-	59, // 7: Superplane.Organizations.AgentOpenAIKey.validated_at:type_name -> google.protobuf.Timestamp
-	59, // 8: Superplane.Organizations.AgentOpenAIKey.updated_at:type_name -> google.protobuf.Timestamp
-	9,  // 9: Superplane.Organizations.AgentSettings.openai_key:type_name -> Superplane.Organizations.AgentOpenAIKey
-	7,  // 10: Superplane.Organizations.CreateInvitationResponse.invitation:type_name -> Superplane.Organizations.Invitation
-	7,  // 11: Superplane.Organizations.ListInvitationsResponse.invitations:type_name -> Superplane.Organizations.Invitation
-	8,  // 12: Superplane.Organizations.GetInviteLinkResponse.invite_link:type_name -> Superplane.Organizations.InviteLink
-	8,  // 13: Superplane.Organizations.UpdateInviteLinkResponse.invite_link:type_name -> Superplane.Organizations.InviteLink
-	8,  // 14: Superplane.Organizations.ResetInviteLinkResponse.invite_link:type_name -> Superplane.Organizations.InviteLink
-	10, // 15: Superplane.Organizations.GetAgentSettingsResponse.agent_settings:type_name -> Superplane.Organizations.AgentSettings
-	10, // 16: Superplane.Organizations.UpdateAgentSettingsResponse.agent_settings:type_name -> Superplane.Organizations.AgentSettings
-	10, // 17: Superplane.Organizations.SetAgentOpenAIKeyResponse.agent_settings:type_name -> Superplane.Organizations.AgentSettings
-	10, // 18: Superplane.Organizations.DeleteAgentOpenAIKeyResponse.agent_settings:type_name -> Superplane.Organizations.AgentSettings
-	46, // 19: Superplane.Organizations.ListIntegrationsResponse.integrations:type_name -> Superplane.Organizations.Integration
-	60, // 20: Superplane.Organizations.CreateIntegrationRequest.configuration:type_name -> google.protobuf.Struct
-	46, // 21: Superplane.Organizations.CreateIntegrationResponse.integration:type_name -> Superplane.Organizations.Integration
-	46, // 22: Superplane.Organizations.DescribeIntegrationResponse.integration:type_name -> Superplane.Organizations.Integration
-	53, // 23: Superplane.Organizations.ListIntegrationResourcesRequest.parameters:type_name -> Superplane.Organizations.ListIntegrationResourcesRequest.ParametersEntry
-	41, // 24: Superplane.Organizations.ListIntegrationResourcesResponse.resources:type_name -> Superplane.Organizations.IntegrationResourceRef
-	60, // 25: Superplane.Organizations.UpdateIntegrationRequest.configuration:type_name -> google.protobuf.Struct
-	46, // 26: Superplane.Organizations.UpdateIntegrationResponse.integration:type_name -> Superplane.Organizations.Integration
-	54, // 27: Superplane.Organizations.Integration.metadata:type_name -> Superplane.Organizations.Integration.Metadata
-	55, // 28: Superplane.Organizations.Integration.spec:type_name -> Superplane.Organizations.Integration.Spec
-	56, // 29: Superplane.Organizations.Integration.status:type_name -> Superplane.Organizations.Integration.Status
-	58, // 30: Superplane.Organizations.BrowserAction.form_fields:type_name -> Superplane.Organizations.BrowserAction.FormFieldsEntry
-	59, // 31: Superplane.Organizations.OrganizationCreated.timestamp:type_name -> google.protobuf.Timestamp
-	59, // 32: Superplane.Organizations.OrganizationUpdated.timestamp:type_name -> google.protobuf.Timestamp
-	59, // 33: Superplane.Organizations.OrganizationDeleted.timestamp:type_name -> google.protobuf.Timestamp
-	59, // 34: Superplane.Organizations.InvitationCreated.timestamp:type_name -> google.protobuf.Timestamp
-	59, // 35: Superplane.Organizations.Organization.Metadata.created_at:type_name -> google.protobuf.Timestamp
-	59, // 36: Superplane.Organizations.Organization.Metadata.updated_at:type_name -> google.protobuf.Timestamp
-	59, // 37: Superplane.Organizations.Integration.Metadata.created_at:type_name -> google.protobuf.Timestamp
-	59, // 38: Superplane.Organizations.Integration.Metadata.updated_at:type_name -> google.protobuf.Timestamp
-	60, // 39: Superplane.Organizations.Integration.Spec.configuration:type_name -> google.protobuf.Struct
-	60, // 40: Superplane.Organizations.Integration.Status.metadata:type_name -> google.protobuf.Struct
-	47, // 41: Superplane.Organizations.Integration.Status.browser_action:type_name -> Superplane.Organizations.BrowserAction
-	57, // 42: Superplane.Organizations.Integration.Status.used_in:type_name -> Superplane.Organizations.Integration.NodeRef
-	1,  // 43: Superplane.Organizations.Organizations.DescribeOrganization:input_type -> Superplane.Organizations.DescribeOrganizationRequest
-	3,  // 44: Superplane.Organizations.Organizations.UpdateOrganization:input_type -> Superplane.Organizations.UpdateOrganizationRequest
-	5,  // 45: Superplane.Organizations.Organizations.DeleteOrganization:input_type -> Superplane.Organizations.DeleteOrganizationRequest
-	31, // 46: Superplane.Organizations.Organizations.RemoveUser:input_type -> Superplane.Organizations.RemoveUserRequest
-	11, // 47: Superplane.Organizations.Organizations.CreateInvitation:input_type -> Superplane.Organizations.CreateInvitationRequest
-	13, // 48: Superplane.Organizations.Organizations.ListInvitations:input_type -> Superplane.Organizations.ListInvitationsRequest
-	15, // 49: Superplane.Organizations.Organizations.RemoveInvitation:input_type -> Superplane.Organizations.RemoveInvitationRequest
-	17, // 50: Superplane.Organizations.Organizations.GetInviteLink:input_type -> Superplane.Organizations.GetInviteLinkRequest
-	19, // 51: Superplane.Organizations.Organizations.UpdateInviteLink:input_type -> Superplane.Organizations.UpdateInviteLinkRequest
-	21, // 52: Superplane.Organizations.Organizations.ResetInviteLink:input_type -> Superplane.Organizations.ResetInviteLinkRequest
-	23, // 53: Superplane.Organizations.Organizations.GetAgentSettings:input_type -> Superplane.Organizations.GetAgentSettingsRequest
-	25, // 54: Superplane.Organizations.Organizations.UpdateAgentSettings:input_type -> Superplane.Organizations.UpdateAgentSettingsRequest
-	27, // 55: Superplane.Organizations.Organizations.SetAgentOpenAIKey:input_type -> Superplane.Organizations.SetAgentOpenAIKeyRequest
-	29, // 56: Superplane.Organizations.Organizations.DeleteAgentOpenAIKey:input_type -> Superplane.Organizations.DeleteAgentOpenAIKeyRequest
-	8,  // 57: Superplane.Organizations.Organizations.AcceptInviteLink:input_type -> Superplane.Organizations.InviteLink
-	33, // 58: Superplane.Organizations.Organizations.ListIntegrations:input_type -> Superplane.Organizations.ListIntegrationsRequest
-	37, // 59: Superplane.Organizations.Organizations.DescribeIntegration:input_type -> Superplane.Organizations.DescribeIntegrationRequest
-	39, // 60: Superplane.Organizations.Organizations.ListIntegrationResources:input_type -> Superplane.Organizations.ListIntegrationResourcesRequest
-	35, // 61: Superplane.Organizations.Organizations.CreateIntegration:input_type -> Superplane.Organizations.CreateIntegrationRequest
-	42, // 62: Superplane.Organizations.Organizations.UpdateIntegration:input_type -> Superplane.Organizations.UpdateIntegrationRequest
-	44, // 63: Superplane.Organizations.Organizations.DeleteIntegration:input_type -> Superplane.Organizations.DeleteIntegrationRequest
-	2,  // 64: Superplane.Organizations.Organizations.DescribeOrganization:output_type -> Superplane.Organizations.DescribeOrganizationResponse
-	4,  // 65: Superplane.Organizations.Organizations.UpdateOrganization:output_type -> Superplane.Organizations.UpdateOrganizationResponse
-	6,  // 66: Superplane.Organizations.Organizations.DeleteOrganization:output_type -> Superplane.Organizations.DeleteOrganizationResponse
-	32, // 67: Superplane.Organizations.Organizations.RemoveUser:output_type -> Superplane.Organizations.RemoveUserResponse
-	12, // 68: Superplane.Organizations.Organizations.CreateInvitation:output_type -> Superplane.Organizations.CreateInvitationResponse
-	14, // 69: Superplane.Organizations.Organizations.ListInvitations:output_type -> Superplane.Organizations.ListInvitationsResponse
-	16, // 70: Superplane.Organizations.Organizations.RemoveInvitation:output_type -> Superplane.Organizations.RemoveInvitationResponse
-	18, // 71: Superplane.Organizations.Organizations.GetInviteLink:output_type -> Superplane.Organizations.GetInviteLinkResponse
-	20, // 72: Superplane.Organizations.Organizations.UpdateInviteLink:output_type -> Superplane.Organizations.UpdateInviteLinkResponse
-	22, // 73: Superplane.Organizations.Organizations.ResetInviteLink:output_type -> Superplane.Organizations.ResetInviteLinkResponse
-	24, // 74: Superplane.Organizations.Organizations.GetAgentSettings:output_type -> Superplane.Organizations.GetAgentSettingsResponse
-	26, // 75: Superplane.Organizations.Organizations.UpdateAgentSettings:output_type -> Superplane.Organizations.UpdateAgentSettingsResponse
-	28, // 76: Superplane.Organizations.Organizations.SetAgentOpenAIKey:output_type -> Superplane.Organizations.SetAgentOpenAIKeyResponse
-	30, // 77: Superplane.Organizations.Organizations.DeleteAgentOpenAIKey:output_type -> Superplane.Organizations.DeleteAgentOpenAIKeyResponse
-	60, // 78: Superplane.Organizations.Organizations.AcceptInviteLink:output_type -> google.protobuf.Struct
-	34, // 79: Superplane.Organizations.Organizations.ListIntegrations:output_type -> Superplane.Organizations.ListIntegrationsResponse
-	38, // 80: Superplane.Organizations.Organizations.DescribeIntegration:output_type -> Superplane.Organizations.DescribeIntegrationResponse
-	40, // 81: Superplane.Organizations.Organizations.ListIntegrationResources:output_type -> Superplane.Organizations.ListIntegrationResourcesResponse
-	36, // 82: Superplane.Organizations.Organizations.CreateIntegration:output_type -> Superplane.Organizations.CreateIntegrationResponse
-	43, // 83: Superplane.Organizations.Organizations.UpdateIntegration:output_type -> Superplane.Organizations.UpdateIntegrationResponse
-	45, // 84: Superplane.Organizations.Organizations.DeleteIntegration:output_type -> Superplane.Organizations.DeleteIntegrationResponse
-	64, // [64:85] is the sub-list for method output_type
-	43, // [43:64] is the sub-list for method input_type
-	43, // [43:43] is the sub-list for extension type_name
-	43, // [43:43] is the sub-list for extension extendee
-	0,  // [0:43] is the sub-list for field type_name
+	73, // 0: Superplane.Organizations.Organization.metadata:type_name -> Superplane.Organizations.Organization.Metadata
+	1,  // 1: Superplane.Organizations.DescribeOrganizationResponse.organization:type_name -> Superplane.Organizations.Organization
+	1,  // 2: Superplane.Organizations.UpdateOrganizationRequest.organization:type_name -> Superplane.Organizations.Organization
+	1,  // 3: Superplane.Organizations.UpdateOrganizationResponse.organization:type_name -> Superplane.Organizations.Organization
+	81, // 4: Superplane.Organizations.Invitation.created_at:type_name -> google.protobuf.Timestamp
+	81, // 5: Superplane.Organizations.InviteLink.created_at:type_name -> google.protobuf.Timestamp
+	81, // 6: Superplane.Organizations.InviteLink.updated_at:type_name -> google.protobuf.Timestamp
+	81, // 7: Superplane.Organizations.AgentOpenAIKey.validated_at:type_name -> google.protobuf.Timestamp
+	81, // 8: Superplane.Organizations.AgentOpenAIKey.updated_at:type_name -> google.protobuf.Timestamp
+	10, // 9: Superplane.Organizations.AgentSettings.openai_key:type_name -> Superplane.Organizations.AgentOpenAIKey
+	8,  // 10: Superplane.Organizations.CreateInvitationResponse.invitation:type_name -> Superplane.Organizations.Invitation
+	8,  // 11: Superplane.Organizations.ListInvitationsResponse.invitations:type_name -> Superplane.Organizations.Invitation
+	9,  // 12: Superplane.Organizations.GetInviteLinkResponse.invite_link:type_name -> Superplane.Organizations.InviteLink
+	9,  // 13: Superplane.Organizations.UpdateInviteLinkResponse.invite_link:type_name -> Superplane.Organizations.InviteLink
+	9,  // 14: Superplane.Organizations.ResetInviteLinkResponse.invite_link:type_name -> Superplane.Organizations.InviteLink
+	11, // 15: Superplane.Organizations.GetAgentSettingsResponse.agent_settings:type_name -> Superplane.Organizations.AgentSettings
+	11, // 16: Superplane.Organizations.UpdateAgentSettingsResponse.agent_settings:type_name -> Superplane.Organizations.AgentSettings
+	11, // 17: Superplane.Organizations.SetAgentOpenAIKeyResponse.agent_settings:type_name -> Superplane.Organizations.AgentSettings
+	11, // 18: Superplane.Organizations.DeleteAgentOpenAIKeyResponse.agent_settings:type_name -> Superplane.Organizations.AgentSettings
+	81, // 19: Superplane.Organizations.SSODomain.verified_at:type_name -> google.protobuf.Timestamp
+	32, // 20: Superplane.Organizations.SSOSettings.oidc:type_name -> Superplane.Organizations.SSOOIDCSettings
+	33, // 21: Superplane.Organizations.SSOSettings.saml:type_name -> Superplane.Organizations.SSOSAMLSettings
+	81, // 22: Superplane.Organizations.SSOSettings.updated_at:type_name -> google.protobuf.Timestamp
+	34, // 23: Superplane.Organizations.SSOSettings.domains:type_name -> Superplane.Organizations.SSODomain
+	35, // 24: Superplane.Organizations.GetSSOSettingsResponse.sso_settings:type_name -> Superplane.Organizations.SSOSettings
+	32, // 25: Superplane.Organizations.UpdateSSOSettingsRequest.oidc:type_name -> Superplane.Organizations.SSOOIDCSettings
+	33, // 26: Superplane.Organizations.UpdateSSOSettingsRequest.saml:type_name -> Superplane.Organizations.SSOSAMLSettings
+	35, // 27: Superplane.Organizations.UpdateSSOSettingsResponse.sso_settings:type_name -> Superplane.Organizations.SSOSettings
+	67, // 28: Superplane.Organizations.ListIntegrationsResponse.integrations:type_name -> Superplane.Organizations.Integration
+	82, // 29: Superplane.Organizations.CreateIntegrationRequest.configuration:type_name -> google.protobuf.Struct
+	67, // 30: Superplane.Organizations.CreateIntegrationResponse.integration:type_name -> Superplane.Organizations.Integration
+	67, // 31: Superplane.Organizations.DescribeIntegrationResponse.integration:type_name -> Superplane.Organizations.Integration
+	74, // 32: Superplane.Organizations.ListIntegrationResourcesRequest.parameters:type_name -> Superplane.Organizations.ListIntegrationResourcesRequest.ParametersEntry
+	50, // 33: Superplane.Organizations.ListIntegrationResourcesResponse.resources:type_name -> Superplane.Organizations.IntegrationResourceRef
+	82, // 34: Superplane.Organizations.UpdateIntegrationRequest.configuration:type_name -> google.protobuf.Struct
+	67, // 35: Superplane.Organizations.UpdateIntegrationResponse.integration:type_name -> Superplane.Organizations.Integration
+	0,  // 36: Superplane.Organizations.MemoryNamespace.default_access:type_name -> Superplane.Organizations.MemoryNamespace.Access
+	75, // 37: Superplane.Organizations.MemoryNamespace.canvas_access:type_name -> Superplane.Organizations.MemoryNamespace.CanvasAccess
+	81, // 38: Superplane.Organizations.MemoryNamespace.created_at:type_name -> google.protobuf.Timestamp
+	81, // 39: Superplane.Organizations.MemoryNamespace.updated_at:type_name -> google.protobuf.Timestamp
+	83, // 40: Superplane.Organizations.Memory.values:type_name -> google.protobuf.Value
+	81, // 41: Superplane.Organizations.Memory.created_at:type_name -> google.protobuf.Timestamp
+	81, // 42: Superplane.Organizations.Memory.updated_at:type_name -> google.protobuf.Timestamp
+	55, // 43: Superplane.Organizations.ListMemoryNamespacesResponse.namespaces:type_name -> Superplane.Organizations.MemoryNamespace
+	0,  // 44: Superplane.Organizations.UpdateMemoryNamespaceRequest.default_access:type_name -> Superplane.Organizations.MemoryNamespace.Access
+	75, // 45: Superplane.Organizations.UpdateMemoryNamespaceRequest.canvas_access:type_name -> Superplane.Organizations.MemoryNamespace.CanvasAccess
+	55, // 46: Superplane.Organizations.UpdateMemoryNamespaceResponse.namespace:type_name -> Superplane.Organizations.MemoryNamespace
+	56, // 47: Superplane.Organizations.ListMemoriesResponse.items:type_name -> Superplane.Organizations.Memory
+	76, // 48: Superplane.Organizations.Integration.metadata:type_name -> Superplane.Organizations.Integration.Metadata
+	77, // 49: Superplane.Organizations.Integration.spec:type_name -> Superplane.Organizations.Integration.Spec
+	78, // 50: Superplane.Organizations.Integration.status:type_name -> Superplane.Organizations.Integration.Status
+	80, // 51: Superplane.Organizations.BrowserAction.form_fields:type_name -> Superplane.Organizations.BrowserAction.FormFieldsEntry
+	81, // 52: Superplane.Organizations.OrganizationCreated.timestamp:type_name -> google.protobuf.Timestamp
+	81, // 53: Superplane.Organizations.OrganizationUpdated.timestamp:type_name -> google.protobuf.Timestamp
+	81, // 54: Superplane.Organizations.OrganizationDeleted.timestamp:type_name -> google.protobuf.Timestamp
+	81, // 55: Superplane.Organizations.InvitationCreated.timestamp:type_name -> google.protobuf.Timestamp
+	81, // 56: Superplane.Organizations.Organization.Metadata.created_at:type_name -> google.protobuf.Timestamp
+	81, // 57: Superplane.Organizations.Organization.Metadata.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 58: Superplane.Organizations.MemoryNamespace.CanvasAccess.access:type_name -> Superplane.Organizations.MemoryNamespace.Access
+	81, // 59: Superplane.Organizations.Integration.Metadata.created_at:type_name -> google.protobuf.Timestamp
+	81, // 60: Superplane.Organizations.Integration.Metadata.updated_at:type_name -> google.protobuf.Timestamp
+	82, // 61: Superplane.Organizations.Integration.Spec.configuration:type_name -> google.protobuf.Struct
+	82, // 62: Superplane.Organizations.Integration.Status.metadata:type_name -> google.protobuf.Struct
+	68, // 63: Superplane.Organizations.Integration.Status.browser_action:type_name -> Superplane.Organizations.BrowserAction
+	79, // 64: Superplane.Organizations.Integration.Status.used_in:type_name -> Superplane.Organizations.Integration.NodeRef
+	2,  // 65: Superplane.Organizations.Organizations.DescribeOrganization:input_type -> Superplane.Organizations.DescribeOrganizationRequest
+	4,  // 66: Superplane.Organizations.Organizations.UpdateOrganization:input_type -> Superplane.Organizations.UpdateOrganizationRequest
+	6,  // 67: Superplane.Organizations.Organizations.DeleteOrganization:input_type -> Superplane.Organizations.DeleteOrganizationRequest
+	40, // 68: Superplane.Organizations.Organizations.RemoveUser:input_type -> Superplane.Organizations.RemoveUserRequest
+	12, // 69: Superplane.Organizations.Organizations.CreateInvitation:input_type -> Superplane.Organizations.CreateInvitationRequest
+	14, // 70: Superplane.Organizations.Organizations.ListInvitations:input_type -> Superplane.Organizations.ListInvitationsRequest
+	16, // 71: Superplane.Organizations.Organizations.RemoveInvitation:input_type -> Superplane.Organizations.RemoveInvitationRequest
+	18, // 72: Superplane.Organizations.Organizations.GetInviteLink:input_type -> Superplane.Organizations.GetInviteLinkRequest
+	20, // 73: Superplane.Organizations.Organizations.UpdateInviteLink:input_type -> Superplane.Organizations.UpdateInviteLinkRequest
+	22, // 74: Superplane.Organizations.Organizations.ResetInviteLink:input_type -> Superplane.Organizations.ResetInviteLinkRequest
+	24, // 75: Superplane.Organizations.Organizations.GetAgentSettings:input_type -> Superplane.Organizations.GetAgentSettingsRequest
+	26, // 76: Superplane.Organizations.Organizations.UpdateAgentSettings:input_type -> Superplane.Organizations.UpdateAgentSettingsRequest
+	28, // 77: Superplane.Organizations.Organizations.SetAgentOpenAIKey:input_type -> Superplane.Organizations.SetAgentOpenAIKeyRequest
+	30, // 78: Superplane.Organizations.Organizations.DeleteAgentOpenAIKey:input_type -> Superplane.Organizations.DeleteAgentOpenAIKeyRequest
+	36, // 79: Superplane.Organizations.Organizations.GetSSOSettings:input_type -> Superplane.Organizations.GetSSOSettingsRequest
+	38, // 80: Superplane.Organizations.Organizations.UpdateSSOSettings:input_type -> Superplane.Organizations.UpdateSSOSettingsRequest
+	9,  // 81: Superplane.Organizations.Organizations.AcceptInviteLink:input_type -> Superplane.Organizations.InviteLink
+	42, // 82: Superplane.Organizations.Organizations.ListIntegrations:input_type -> Superplane.Organizations.ListIntegrationsRequest
+	46, // 83: Superplane.Organizations.Organizations.DescribeIntegration:input_type -> Superplane.Organizations.DescribeIntegrationRequest
+	48, // 84: Superplane.Organizations.Organizations.ListIntegrationResources:input_type -> Superplane.Organizations.ListIntegrationResourcesRequest
+	44, // 85: Superplane.Organizations.Organizations.CreateIntegration:input_type -> Superplane.Organizations.CreateIntegrationRequest
+	51, // 86: Superplane.Organizations.Organizations.UpdateIntegration:input_type -> Superplane.Organizations.UpdateIntegrationRequest
+	53, // 87: Superplane.Organizations.Organizations.DeleteIntegration:input_type -> Superplane.Organizations.DeleteIntegrationRequest
+	57, // 88: Superplane.Organizations.Organizations.ListMemoryNamespaces:input_type -> Superplane.Organizations.ListMemoryNamespacesRequest
+	59, // 89: Superplane.Organizations.Organizations.UpdateMemoryNamespace:input_type -> Superplane.Organizations.UpdateMemoryNamespaceRequest
+	61, // 90: Superplane.Organizations.Organizations.DeleteMemoryNamespace:input_type -> Superplane.Organizations.DeleteMemoryNamespaceRequest
+	63, // 91: Superplane.Organizations.Organizations.ListMemories:input_type -> Superplane.Organizations.ListMemoriesRequest
+	65, // 92: Superplane.Organizations.Organizations.DeleteMemory:input_type -> Superplane.Organizations.DeleteMemoryRequest
+	3,  // 93: Superplane.Organizations.Organizations.DescribeOrganization:output_type -> Superplane.Organizations.DescribeOrganizationResponse
+	5,  // 94: Superplane.Organizations.Organizations.UpdateOrganization:output_type -> Superplane.Organizations.UpdateOrganizationResponse
+	7,  // 95: Superplane.Organizations.Organizations.DeleteOrganization:output_type -> Superplane.Organizations.DeleteOrganizationResponse
+	41, // 96: Superplane.Organizations.Organizations.RemoveUser:output_type -> Superplane.Organizations.RemoveUserResponse
+	13, // 97: Superplane.Organizations.Organizations.CreateInvitation:output_type -> Superplane.Organizations.CreateInvitationResponse
+	15, // 98: Superplane.Organizations.Organizations.ListInvitations:output_type -> Superplane.Organizations.ListInvitationsResponse
+	17, // 99: Superplane.Organizations.Organizations.RemoveInvitation:output_type -> Superplane.Organizations.RemoveInvitationResponse
+	19, // 100: Superplane.Organizations.Organizations.GetInviteLink:output_type -> Superplane.Organizations.GetInviteLinkResponse
+	21, // 101: Superplane.Organizations.Organizations.UpdateInviteLink:output_type -> Superplane.Organizations.UpdateInviteLinkResponse
+	23, // 102: Superplane.Organizations.Organizations.ResetInviteLink:output_type -> Superplane.Organizations.ResetInviteLinkResponse
+	25, // 103: Superplane.Organizations.Organizations.GetAgentSettings:output_type -> Superplane.Organizations.GetAgentSettingsResponse
+	27, // 104: Superplane.Organizations.Organizations.UpdateAgentSettings:output_type -> Superplane.Organizations.UpdateAgentSettingsResponse
+	29, // 105: Superplane.Organizations.Organizations.SetAgentOpenAIKey:output_type -> Superplane.Organizations.SetAgentOpenAIKeyResponse
+	31, // 106: Superplane.Organizations.Organizations.DeleteAgentOpenAIKey:output_type -> Superplane.Organizations.DeleteAgentOpenAIKeyResponse
+	37, // 107: Superplane.Organizations.Organizations.GetSSOSettings:output_type -> Superplane.Organizations.GetSSOSettingsResponse
+	39, // 108: Superplane.Organizations.Organizations.UpdateSSOSettings:output_type -> Superplane.Organizations.UpdateSSOSettingsResponse
+	82, // 109: Superplane.Organizations.Organizations.AcceptInviteLink:output_type -> google.protobuf.Struct
+	43, // 110: Superplane.Organizations.Organizations.ListIntegrations:output_type -> Superplane.Organizations.ListIntegrationsResponse
+	47, // 111: Superplane.Organizations.Organizations.DescribeIntegration:output_type -> Superplane.Organizations.DescribeIntegrationResponse
+	49, // 112: Superplane.Organizations.Organizations.ListIntegrationResources:output_type -> Superplane.Organizations.ListIntegrationResourcesResponse
+	45, // 113: Superplane.Organizations.Organizations.CreateIntegration:output_type -> Superplane.Organizations.CreateIntegrationResponse
+	52, // 114: Superplane.Organizations.Organizations.UpdateIntegration:output_type -> Superplane.Organizations.UpdateIntegrationResponse
+	54, // 115: Superplane.Organizations.Organizations.DeleteIntegration:output_type -> Superplane.Organizations.DeleteIntegrationResponse
+	58, // 116: Superplane.Organizations.Organizations.ListMemoryNamespaces:output_type -> Superplane.Organizations.ListMemoryNamespacesResponse
+	60, // 117: Superplane.Organizations.Organizations.UpdateMemoryNamespace:output_type -> Superplane.Organizations.UpdateMemoryNamespaceResponse
+	62, // 118: Superplane.Organizations.Organizations.DeleteMemoryNamespace:output_type -> Superplane.Organizations.DeleteMemoryNamespaceResponse
+	64, // 119: Superplane.Organizations.Organizations.ListMemories:output_type -> Superplane.Organizations.ListMemoriesResponse
+	66, // 120: Superplane.Organizations.Organizations.DeleteMemory:output_type -> Superplane.Organizations.DeleteMemoryResponse
+	93, // [93:121] is the sub-list for method output_type
+	65, // [65:93] is the sub-list for method input_type
+	65, // [65:65] is the sub-list for extension type_name
+	65, // [65:65] is the sub-list for extension extendee
+	0,  // [0:65] is the sub-list for field type_name
 }
 
 func init() { file_organizations_proto_init() }
@@ -3435,19 +4952,20 @@ func file_organizations_proto_init() {
 	if File_organizations_proto != nil {
 		return
 	}
-	file_organizations_proto_msgTypes[52].OneofWrappers = []any{}
+	file_organizations_proto_msgTypes[72].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_organizations_proto_rawDesc), len(file_organizations_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   59,
+			NumEnums:      1,
+			NumMessages:   80,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_organizations_proto_goTypes,
 		DependencyIndexes: file_organizations_proto_depIdxs,
+		EnumInfos:         file_organizations_proto_enumTypes,
 		MessageInfos:      file_organizations_proto_msgTypes,
 	}.Build()
 	File_organizations_proto = out.File
@@ -34,6 +34,8 @@ const (
 	Organizations_UpdateAgentSettings_FullMethodName      = "/Superplane.Organizations.Organizations/UpdateAgentSettings"
 	Organizations_SetAgentOpenAIKey_FullMethodName        = "/Superplane.Organizations.Organizations/SetAgentOpenAIKey"
 	Organizations_DeleteAgentOpenAIKey_FullMethodName     = "/Superplane.Organizations.Organizations/DeleteAgentOpenAIKey"
+	Organizations_GetSSOSettings_FullMethodName           = "/Superplane.Organizations.Organizations/GetSSOSettings"
+	Organizations_UpdateSSOSettings_FullMethodName        = "/Superplane.Organizations.Organizations/UpdateSSOSettings"
 	Organizations_AcceptInviteLink_FullMethodName         = "/Superplane.Organizations.Organizations/AcceptInviteLink"
 	Organizations_ListIntegrations_FullMethodName         = "/Superplane.Organizations.Organizations/ListIntegrations"
 	Organizations_DescribeIntegration_FullMethodName      = "/Superplane.Organizations.Organizations/DescribeIntegration"
@@ -41,6 +43,11 @@ const (
 	Organizations_CreateIntegration_FullMethodName        = "/Superplane.Organizations.Organizations/CreateIntegration"
 	Organizations_UpdateIntegration_FullMethodName        = "/Superplane.Organizations.Organizations/UpdateIntegration"
 	Organizations_DeleteIntegration_FullMethodName        = "/Superplane.Organizations.Organizations/DeleteIntegration"
+	Organizations_ListMemoryNamespaces_FullMethodName     = "/Superplane.Organizations.Organizations/ListMemoryNamespaces"
+	Organizations_UpdateMemoryNamespace_FullMethodName    = "/Superplane.Organizations.Organizations/UpdateMemoryNamespace"
+	Organizations_DeleteMemoryNamespace_FullMethodName    = "/Superplane.Organizations.Organizations/DeleteMemoryNamespace"
+	Organizations_ListMemories_FullMethodName             = "/Superplane.Organizations.Organizations/ListMemories"
+	Organizations_DeleteMemory_FullMethodName             = "/Superplane.Organizations.Organizations/DeleteMemory"
 )
 
 // OrganizationsClient is the client API for Organizations service.
@@ -61,6 +68,8 @@ type OrganizationsClient interface {
 	UpdateAgentSettings(ctx context.Context, in *UpdateAgentSettingsRequest, opts ...grpc.CallOption) (*UpdateAgentSettingsResponse, error)
 	SetAgentOpenAIKey(ctx context.Context, in *SetAgentOpenAIKeyRequest, opts ...grpc.CallOption) (*SetAgentOpenAIKeyResponse, error)
 	DeleteAgentOpenAIKey(ctx context.Context, in *DeleteAgentOpenAIKeyRequest, opts ...grpc.CallOption) (*DeleteAgentOpenAIKeyResponse, error)
+	GetSSOSettings(ctx context.Context, in *GetSSOSettingsRequest, opts ...grpc.CallOption) (*GetSSOSettingsResponse, error)
+	UpdateSSOSettings(ctx context.Context, in *UpdateSSOSettingsRequest, opts ...grpc.CallOption) (*UpdateSSOSettingsResponse, error)
 	AcceptInviteLink(ctx context.Context, in *InviteLink, opts ...grpc.CallOption) (*_struct.Struct, error)
 	ListIntegrations(ctx context.Context, in *ListIntegrationsRequest, opts ...grpc.CallOption) (*ListIntegrationsResponse, error)
 	DescribeIntegration(ctx context.Context, in *DescribeIntegrationRequest, opts ...grpc.CallOption) (*DescribeIntegrationResponse, error)
@@ -68,6 +77,11 @@ type OrganizationsClient interface {
 	CreateIntegration(ctx context.Context, in *CreateIntegrationRequest, opts ...grpc.CallOption) (*CreateIntegrationResponse, error)
 	UpdateIntegration(ctx context.Context, in *UpdateIntegrationRequest, opts ...grpc.CallOption) (*UpdateIntegrationResponse, error)
 	DeleteIntegration(ctx context.Context, in *DeleteIntegrationRequest, opts ...grpc.CallOption) (*DeleteIntegrationResponse, error)
+	ListMemoryNamespaces(ctx context.Context, in *ListMemoryNamespacesRequest, opts ...grpc.CallOption) (*ListMemoryNamespacesResponse, error)
+	UpdateMemoryNamespace(ctx context.Context, in *UpdateMemoryNamespaceRequest, opts ...grpc.CallOption) (*UpdateMemoryNamespaceResponse, error)
+	DeleteMemoryNamespace(ctx context.Context, in *DeleteMemoryNamespaceRequest, opts ...grpc.CallOption) (*DeleteMemoryNamespaceResponse, error)
+	ListMemories(ctx context.Context, in *ListMemoriesRequest, opts ...grpc.CallOption) (*ListMemoriesResponse, error)
+	DeleteMemory(ctx context.Context, in *DeleteMemoryRequest, opts ...grpc.CallOption) (*DeleteMemoryResponse, error)
 }
 
 type organizationsClient struct {
@@ -218,6 +232,26 @@ func (c *organizationsClient) DeleteAgentOpenAIKey(ctx context.Context, in *Dele
 	return out, nil
 }
 
+func (c *organizationsClient) GetSSOSettings(ctx context.Context, in *GetSSOSettingsRequest, opts ...grpc.CallOption) (*GetSSOSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSSOSettingsResponse)
+	err := c.cc.Invoke(ctx, Organizations_GetSSOSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationsClient) UpdateSSOSettings(ctx context.Context, in *UpdateSSOSettingsRequest, opts ...grpc.CallOption) (*UpdateSSOSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateSSOSettingsResponse)
+	err := c.cc.Invoke(ctx, Organizations_UpdateSSOSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *organizationsClient) AcceptInviteLink(ctx context.Context, in *InviteLink, opts ...grpc.CallOption) (*_struct.Struct, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(_struct.Struct)
@@ -288,6 +322,56 @@ func (c *organizationsClient) DeleteIntegration(ctx context.Context, in *DeleteI
 	return out, nil
 }
 
+func (c *organizationsClient) ListMemoryNamespaces(ctx context.Context, in *ListMemoryNamespacesRequest, opts ...grpc.CallOption) (*ListMemoryNamespacesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMemoryNamespacesResponse)
+	err := c.cc.Invoke(ctx, Organizations_ListMemoryNamespaces_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationsClient) UpdateMemoryNamespace(ctx context.Context, in *UpdateMemoryNamespaceRequest, opts ...grpc.CallOption) (*UpdateMemoryNamespaceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateMemoryNamespaceResponse)
+	err := c.cc.Invoke(ctx, Organizations_UpdateMemoryNamespace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationsClient) DeleteMemoryNamespace(ctx context.Context, in *DeleteMemoryNamespaceRequest, opts ...grpc.CallOption) (*DeleteMemoryNamespaceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteMemoryNamespaceResponse)
+	err := c.cc.Invoke(ctx, Organizations_DeleteMemoryNamespace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationsClient) ListMemories(ctx context.Context, in *ListMemoriesRequest, opts ...grpc.CallOption) (*ListMemoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMemoriesResponse)
+	err := c.cc.Invoke(ctx, Organizations_ListMemories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationsClient) DeleteMemory(ctx context.Context, in *DeleteMemoryRequest, opts ...grpc.CallOption) (*DeleteMemoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteMemoryResponse)
+	err := c.cc.Invoke(ctx, Organizations_DeleteMemory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OrganizationsServer is the server API for Organizations service.
 // All implementations should embed UnimplementedOrganizationsServer
 // for forward compatibility.
@@ -306,6 +390,8 @@ type OrganizationsServer interface {
 	UpdateAgentSettings(context.Context, *UpdateAgentSettingsRequest) (*UpdateAgentSettingsResponse, error)
 	SetAgentOpenAIKey(context.Context, *SetAgentOpenAIKeyRequest) (*SetAgentOpenAIKeyResponse, error)
 	DeleteAgentOpenAIKey(context.Context, *DeleteAgentOpenAIKeyRequest) (*DeleteAgentOpenAIKeyResponse, error)
+	GetSSOSettings(context.Context, *GetSSOSettingsRequest) (*GetSSOSettingsResponse, error)
+	UpdateSSOSettings(context.Context, *UpdateSSOSettingsRequest) (*UpdateSSOSettingsResponse, error)
 	AcceptInviteLink(context.Context, *InviteLink) (*_struct.Struct, error)
 	ListIntegrations(context.Context, *ListIntegrationsRequest) (*ListIntegrationsResponse, error)
 	DescribeIntegration(context.Context, *DescribeIntegrationRequest) (*DescribeIntegrationResponse, error)
@@ -313,6 +399,11 @@ type OrganizationsServer interface {
 	CreateIntegration(context.Context, *CreateIntegrationRequest) (*CreateIntegrationResponse, error)
 	UpdateIntegration(context.Context, *UpdateIntegrationRequest) (*UpdateIntegrationResponse, error)
 	DeleteIntegration(context.Context, *DeleteIntegrationRequest) (*DeleteIntegrationResponse, error)
+	ListMemoryNamespaces(context.Context, *ListMemoryNamespacesRequest) (*ListMemoryNamespacesResponse, error)
+	UpdateMemoryNamespace(context.Context, *UpdateMemoryNamespaceRequest) (*UpdateMemoryNamespaceResponse, error)
+	DeleteMemoryNamespace(context.Context, *DeleteMemoryNamespaceRequest) (*DeleteMemoryNamespaceResponse, error)
+	ListMemories(context.Context, *ListMemoriesRequest) (*ListMemoriesResponse, error)
+	DeleteMemory(context.Context, *DeleteMemoryRequest) (*DeleteMemoryResponse, error)
 }
 
 // UnimplementedOrganizationsServer should be embedded to have
@@ -364,6 +455,12 @@ func (UnimplementedOrganizationsServer) SetAgentOpenAIKey(context.Context, *SetA
 func (UnimplementedOrganizationsServer) DeleteAgentOpenAIKey(context.Context, *DeleteAgentOpenAIKeyRequest) (*DeleteAgentOpenAIKeyResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteAgentOpenAIKey not implemented")
 }
+func (UnimplementedOrganizationsServer) GetSSOSettings(context.Context, *GetSSOSettingsRequest) (*GetSSOSettingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSSOSettings not implemented")
+}
+func (UnimplementedOrganizationsServer) UpdateSSOSettings(context.Context, *UpdateSSOSettingsRequest) (*UpdateSSOSettingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateSSOSettings not implemented")
+}
 func (UnimplementedOrganizationsServer) AcceptInviteLink(context.Context, *InviteLink) (*_struct.Struct, error) {
 	return nil, status.Error(codes.Unimplemented, "method AcceptInviteLink not implemented")
 }
@@ -385,6 +482,21 @@ func (UnimplementedOrganizationsServer) UpdateIntegration(context.Context, *Upda
 func (UnimplementedOrganizationsServer) DeleteIntegration(context.Context, *DeleteIntegrationRequest) (*DeleteIntegrationResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteIntegration not implemented")
 }
+func (UnimplementedOrganizationsServer) ListMemoryNamespaces(context.Context, *ListMemoryNamespacesRequest) (*ListMemoryNamespacesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMemoryNamespaces not implemented")
+}
+func (UnimplementedOrganizationsServer) UpdateMemoryNamespace(context.Context, *UpdateMemoryNamespaceRequest) (*UpdateMemoryNamespaceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateMemoryNamespace not implemented")
+}
+func (UnimplementedOrganizationsServer) DeleteMemoryNamespace(context.Context, *DeleteMemoryNamespaceRequest) (*DeleteMemoryNamespaceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteMemoryNamespace not implemented")
+}
+func (UnimplementedOrganizationsServer) ListMemories(context.Context, *ListMemoriesRequest) (*ListMemoriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMemories not implemented")
+}
+func (UnimplementedOrganizationsServer) DeleteMemory(context.Context, *DeleteMemoryRequest) (*DeleteMemoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteMemory not implemented")
+}
 func (UnimplementedOrganizationsServer) testEmbeddedByValue() {}
 
 // UnsafeOrganizationsServer may be embedded to opt out of forward compatibility for this service.
@@ -657,6 +769,42 @@ func _Organizations_DeleteAgentOpenAIKey_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Organizations_GetSSOSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSSOSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationsServer).GetSSOSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Organizations_GetSSOSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationsServer).GetSSOSettings(ctx, req.(*GetSSOSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organizations_UpdateSSOSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSSOSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationsServer).UpdateSSOSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Organizations_UpdateSSOSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationsServer).UpdateSSOSettings(ctx, req.(*UpdateSSOSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Organizations_AcceptInviteLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(InviteLink)
 	if err := dec(in); err != nil {
@@ -783,6 +931,96 @@ func _Organizations_DeleteIntegration_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Organizations_ListMemoryNamespaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemoryNamespacesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationsServer).ListMemoryNamespaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Organizations_ListMemoryNamespaces_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationsServer).ListMemoryNamespaces(ctx, req.(*ListMemoryNamespacesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organizations_UpdateMemoryNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMemoryNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationsServer).UpdateMemoryNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Organizations_UpdateMemoryNamespace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationsServer).UpdateMemoryNamespace(ctx, req.(*UpdateMemoryNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organizations_DeleteMemoryNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMemoryNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationsServer).DeleteMemoryNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Organizations_DeleteMemoryNamespace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationsServer).DeleteMemoryNamespace(ctx, req.(*DeleteMemoryNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organizations_ListMemories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationsServer).ListMemories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Organizations_ListMemories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationsServer).ListMemories(ctx, req.(*ListMemoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organizations_DeleteMemory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMemoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationsServer).DeleteMemory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Organizations_DeleteMemory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationsServer).DeleteMemory(ctx, req.(*DeleteMemoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Organizations_ServiceDesc is the grpc.ServiceDesc for Organizations service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -846,6 +1084,14 @@ var Organizations_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteAgentOpenAIKey",
 			Handler:    _Organizations_DeleteAgentOpenAIKey_Handler,
 		},
+		{
+			MethodName: "GetSSOSettings",
+			Handler:    _Organizations_GetSSOSettings_Handler,
+		},
+		{
+			MethodName: "UpdateSSOSettings",
+			Handler:    _Organizations_UpdateSSOSettings_Handler,
+		},
 		{
 			MethodName: "AcceptInviteLink",
 			Handler:    _Organizations_AcceptInviteLink_Handler,
@@ -874,6 +1120,26 @@ var Organizations_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteIntegration",
 			Handler:    _Organizations_DeleteIntegration_Handler,
 		},
+		{
+			MethodName: "ListMemoryNamespaces",
+			Handler:    _Organizations_ListMemoryNamespaces_Handler,
+		},
+		{
+			MethodName: "UpdateMemoryNamespace",
+			Handler:    _Organizations_UpdateMemoryNamespace_Handler,
+		},
+		{
+			MethodName: "DeleteMemoryNamespace",
+			Handler:    _Organizations_DeleteMemoryNamespace_Handler,
+		},
+		{
+			MethodName: "ListMemories",
+			Handler:    _Organizations_ListMemories_Handler,
+		},
+		{
+			MethodName: "DeleteMemory",
+			Handler:    _Organizations_DeleteMemory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "organizations.proto",
@@ -30,6 +30,9 @@ type Secret_Provider int32
 const (
 	Secret_PROVIDER_UNKNOWN Secret_Provider = 0
 	Secret_PROVIDER_LOCAL   Secret_Provider = 1
+	Secret_PROVIDER_VAULT   Secret_Provider = 2
+	Secret_PROVIDER_FILE    Secret_Provider = 3
+	Secret_PROVIDER_ENV     Secret_Provider = 4
 )
 
 // Enum value maps for Secret_Provider.
@@ -37,10 +40,16 @@ var (
 	Secret_Provider_name = map[int32]string{
 		0: "PROVIDER_UNKNOWN",
 		1: "PROVIDER_LOCAL",
+		2: "PROVIDER_VAULT",
+		3: "PROVIDER_FILE",
+		4: "PROVIDER_ENV",
 	}
 	Secret_Provider_value = map[string]int32{
 		"PROVIDER_UNKNOWN": 0,
 		"PROVIDER_LOCAL":   1,
+		"PROVIDER_VAULT":   2,
+		"PROVIDER_FILE":    3,
+		"PROVIDER_ENV":     4,
 	}
 )
 
@@ -228,13 +237,18 @@ func (x *CreateSecretResponse) GetSecret() *Secret {
 }
 
 type UpdateSecretRequest struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	Secret        *Secret                  `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
-	IdOrName      string                   `protobuf:"bytes,2,opt,name=id_or_name,json=idOrName,proto3" json:"id_or_name,omitempty"`
-	DomainType    authorization.DomainType `protobuf:"varint,3,opt,name=domain_type,json=domainType,proto3,enum=Superplane.Authorization.DomainType" json:"domain_type,omitempty"`
-	DomainId      string                   `protobuf:"bytes,4,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state      protoimpl.MessageState   `protogen:"open.v1"`
+	Secret     *Secret                  `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	IdOrName   string                   `protobuf:"bytes,2,opt,name=id_or_name,json=idOrName,proto3" json:"id_or_name,omitempty"`
+	DomainType authorization.DomainType `protobuf:"varint,3,opt,name=domain_type,json=domainType,proto3,enum=Superplane.Authorization.DomainType" json:"domain_type,omitempty"`
+	DomainId   string                   `protobuf:"bytes,4,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	//
+	// The canvases allowed to use the secret are only replaced when this is set,
+	// so clients updating the secret values keep them.
+	//
+	UpdateAllowedCanvasIds bool `protobuf:"varint,5,opt,name=update_allowed_canvas_ids,json=updateAllowedCanvasIds,proto3" json:"update_allowed_canvas_ids,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
 func (x *UpdateSecretRequest) Reset() {
@@ -295,6 +309,13 @@ func (x *UpdateSecretRequest) GetDomainId() string {
 	return ""
 }
 
+func (x *UpdateSecretRequest) GetUpdateAllowedCanvasIds() bool {
+	if x != nil {
+		return x.UpdateAllowedCanvasIds
+	}
+	return false
+}
+
 type UpdateSecretResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Secret        *Secret                `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
@@ -979,6 +1000,420 @@ func (x *UpdateSecretNameResponse) GetSecret() *Secret {
 	return nil
 }
 
+// A version holds the values a secret had at some point.
+// Rotations and updates create a new version, and rollbacks activate an older one.
+type SecretVersion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       int32                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,3,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ActivatedAt   *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=activated_at,json=activatedAt,proto3" json:"activated_at,omitempty"`
+	Active        bool                   `protobuf:"varint,6,opt,name=active,proto3" json:"active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SecretVersion) Reset() {
+	*x = SecretVersion{}
+	mi := &file_secrets_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecretVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretVersion) ProtoMessage() {}
+
+func (x *SecretVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_secrets_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretVersion.ProtoReflect.Descriptor instead.
+func (*SecretVersion) Descriptor() ([]byte, []int) {
+	return file_secrets_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SecretVersion) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *SecretVersion) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SecretVersion) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *SecretVersion) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *SecretVersion) GetActivatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ActivatedAt
+	}
+	return nil
+}
+
+func (x *SecretVersion) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type RotateSecretRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	IdOrName      string                   `protobuf:"bytes,1,opt,name=id_or_name,json=idOrName,proto3" json:"id_or_name,omitempty"`
+	Data          map[string]string        `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	DomainType    authorization.DomainType `protobuf:"varint,3,opt,name=domain_type,json=domainType,proto3,enum=Superplane.Authorization.DomainType" json:"domain_type,omitempty"`
+	DomainId      string                   `protobuf:"bytes,4,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateSecretRequest) Reset() {
+	*x = RotateSecretRequest{}
+	mi := &file_secrets_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateSecretRequest) ProtoMessage() {}
+
+func (x *RotateSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_secrets_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateSecretRequest.ProtoReflect.Descriptor instead.
+func (*RotateSecretRequest) Descriptor() ([]byte, []int) {
+	return file_secrets_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RotateSecretRequest) GetIdOrName() string {
+	if x != nil {
+		return x.IdOrName
+	}
+	return ""
+}
+
+func (x *RotateSecretRequest) GetData() map[string]string {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *RotateSecretRequest) GetDomainType() authorization.DomainType {
+	if x != nil {
+		return x.DomainType
+	}
+	return authorization.DomainType(0)
+}
+
+func (x *RotateSecretRequest) GetDomainId() string {
+	if x != nil {
+		return x.DomainId
+	}
+	return ""
+}
+
+type RotateSecretResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Secret        *Secret                `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateSecretResponse) Reset() {
+	*x = RotateSecretResponse{}
+	mi := &file_secrets_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateSecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateSecretResponse) ProtoMessage() {}
+
+func (x *RotateSecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_secrets_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateSecretResponse.ProtoReflect.Descriptor instead.
+func (*RotateSecretResponse) Descriptor() ([]byte, []int) {
+	return file_secrets_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RotateSecretResponse) GetSecret() *Secret {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+type ListSecretVersionsRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	IdOrName      string                   `protobuf:"bytes,1,opt,name=id_or_name,json=idOrName,proto3" json:"id_or_name,omitempty"`
+	DomainType    authorization.DomainType `protobuf:"varint,2,opt,name=domain_type,json=domainType,proto3,enum=Superplane.Authorization.DomainType" json:"domain_type,omitempty"`
+	DomainId      string                   `protobuf:"bytes,3,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSecretVersionsRequest) Reset() {
+	*x = ListSecretVersionsRequest{}
+	mi := &file_secrets_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSecretVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSecretVersionsRequest) ProtoMessage() {}
+
+func (x *ListSecretVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_secrets_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSecretVersionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSecretVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_secrets_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListSecretVersionsRequest) GetIdOrName() string {
+	if x != nil {
+		return x.IdOrName
+	}
+	return ""
+}
+
+func (x *ListSecretVersionsRequest) GetDomainType() authorization.DomainType {
+	if x != nil {
+		return x.DomainType
+	}
+	return authorization.DomainType(0)
+}
+
+func (x *ListSecretVersionsRequest) GetDomainId() string {
+	if x != nil {
+		return x.DomainId
+	}
+	return ""
+}
+
+type ListSecretVersionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Versions      []*SecretVersion       `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSecretVersionsResponse) Reset() {
+	*x = ListSecretVersionsResponse{}
+	mi := &file_secrets_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSecretVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSecretVersionsResponse) ProtoMessage() {}
+
+func (x *ListSecretVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_secrets_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSecretVersionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSecretVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_secrets_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListSecretVersionsResponse) GetVersions() []*SecretVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+type RollbackSecretRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	IdOrName      string                   `protobuf:"bytes,1,opt,name=id_or_name,json=idOrName,proto3" json:"id_or_name,omitempty"`
+	Version       int32                    `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	DomainType    authorization.DomainType `protobuf:"varint,3,opt,name=domain_type,json=domainType,proto3,enum=Superplane.Authorization.DomainType" json:"domain_type,omitempty"`
+	DomainId      string                   `protobuf:"bytes,4,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RollbackSecretRequest) Reset() {
+	*x = RollbackSecretRequest{}
+	mi := &file_secrets_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollbackSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackSecretRequest) ProtoMessage() {}
+
+func (x *RollbackSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_secrets_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackSecretRequest.ProtoReflect.Descriptor instead.
+func (*RollbackSecretRequest) Descriptor() ([]byte, []int) {
+	return file_secrets_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RollbackSecretRequest) GetIdOrName() string {
+	if x != nil {
+		return x.IdOrName
+	}
+	return ""
+}
+
+func (x *RollbackSecretRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *RollbackSecretRequest) GetDomainType() authorization.DomainType {
+	if x != nil {
+		return x.DomainType
+	}
+	return authorization.DomainType(0)
+}
+
+func (x *RollbackSecretRequest) GetDomainId() string {
+	if x != nil {
+		return x.DomainId
+	}
+	return ""
+}
+
+type RollbackSecretResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Secret        *Secret                `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RollbackSecretResponse) Reset() {
+	*x = RollbackSecretResponse{}
+	mi := &file_secrets_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollbackSecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackSecretResponse) ProtoMessage() {}
+
+func (x *RollbackSecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_secrets_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackSecretResponse.ProtoReflect.Descriptor instead.
+func (*RollbackSecretResponse) Descriptor() ([]byte, []int) {
+	return file_secrets_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RollbackSecretResponse) GetSecret() *Secret {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
 // Local secrets are stored and managed by SuperPlane itself.
 type Secret_Local struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -989,7 +1424,7 @@ type Secret_Local struct {
 
 func (x *Secret_Local) Reset() {
 	*x = Secret_Local{}
-	mi := &file_secrets_proto_msgTypes[17]
+	mi := &file_secrets_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1001,7 +1436,7 @@ func (x *Secret_Local) String() string {
 func (*Secret_Local) ProtoMessage() {}
 
 func (x *Secret_Local) ProtoReflect() protoreflect.Message {
-	mi := &file_secrets_proto_msgTypes[17]
+	mi := &file_secrets_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1024,6 +1459,152 @@ func (x *Secret_Local) GetData() map[string]string {
 	return nil
 }
 
+// Vault secrets are read from the KV v2 engine of the Vault server
+// configured for this installation. Values are never stored by SuperPlane.
+type Secret_Vault struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mount         string                 `protobuf:"bytes,1,opt,name=mount,proto3" json:"mount,omitempty"`
+	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Secret_Vault) Reset() {
+	*x = Secret_Vault{}
+	mi := &file_secrets_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Secret_Vault) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Secret_Vault) ProtoMessage() {}
+
+func (x *Secret_Vault) ProtoReflect() protoreflect.Message {
+	mi := &file_secrets_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Secret_Vault.ProtoReflect.Descriptor instead.
+func (*Secret_Vault) Descriptor() ([]byte, []int) {
+	return file_secrets_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *Secret_Vault) GetMount() string {
+	if x != nil {
+		return x.Mount
+	}
+	return ""
+}
+
+func (x *Secret_Vault) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+// File secrets are read from a directory below the secrets directory
+// configured for this installation. Each file in it is a key of the secret.
+type Secret_File struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Secret_File) Reset() {
+	*x = Secret_File{}
+	mi := &file_secrets_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Secret_File) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Secret_File) ProtoMessage() {}
+
+func (x *Secret_File) ProtoReflect() protoreflect.Message {
+	mi := &file_secrets_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Secret_File.ProtoReflect.Descriptor instead.
+func (*Secret_File) Descriptor() ([]byte, []int) {
+	return file_secrets_proto_rawDescGZIP(), []int{0, 2}
+}
+
+func (x *Secret_File) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+// Env secrets are read from environment variables of the SuperPlane process.
+// Variables map each key of the secret to the variable holding its value.
+type Secret_Env struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Variables     map[string]string      `protobuf:"bytes,1,rep,name=variables,proto3" json:"variables,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Secret_Env) Reset() {
+	*x = Secret_Env{}
+	mi := &file_secrets_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Secret_Env) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Secret_Env) ProtoMessage() {}
+
+func (x *Secret_Env) ProtoReflect() protoreflect.Message {
+	mi := &file_secrets_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Secret_Env.ProtoReflect.Descriptor instead.
+func (*Secret_Env) Descriptor() ([]byte, []int) {
+	return file_secrets_proto_rawDescGZIP(), []int{0, 3}
+}
+
+func (x *Secret_Env) GetVariables() map[string]string {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
 type Secret_Metadata struct {
 	state         protoimpl.MessageState   `protogen:"open.v1"`
 	Id            string                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1031,13 +1612,14 @@ type Secret_Metadata struct {
 	DomainType    authorization.DomainType `protobuf:"varint,3,opt,name=domain_type,json=domainType,proto3,enum=Superplane.Authorization.DomainType" json:"domain_type,omitempty"`
 	DomainId      string                   `protobuf:"bytes,4,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
 	CreatedAt     *timestamp.Timestamp     `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ActiveVersion int32                    `protobuf:"varint,6,opt,name=active_version,json=activeVersion,proto3" json:"active_version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Secret_Metadata) Reset() {
 	*x = Secret_Metadata{}
-	mi := &file_secrets_proto_msgTypes[18]
+	mi := &file_secrets_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1049,7 +1631,7 @@ func (x *Secret_Metadata) String() string {
 func (*Secret_Metadata) ProtoMessage() {}
 
 func (x *Secret_Metadata) ProtoReflect() protoreflect.Message {
-	mi := &file_secrets_proto_msgTypes[18]
+	mi := &file_secrets_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1062,7 +1644,7 @@ func (x *Secret_Metadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Secret_Metadata.ProtoReflect.Descriptor instead.
 func (*Secret_Metadata) Descriptor() ([]byte, []int) {
-	return file_secrets_proto_rawDescGZIP(), []int{0, 1}
+	return file_secrets_proto_rawDescGZIP(), []int{0, 4}
 }
 
 func (x *Secret_Metadata) GetId() string {
@@ -1100,17 +1682,33 @@ func (x *Secret_Metadata) GetCreatedAt() *timestamp.Timestamp {
 	return nil
 }
 
+func (x *Secret_Metadata) GetActiveVersion() int32 {
+	if x != nil {
+		return x.ActiveVersion
+	}
+	return 0
+}
+
 type Secret_Spec struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Provider      Secret_Provider        `protobuf:"varint,1,opt,name=provider,proto3,enum=Superplane.Secrets.Secret_Provider" json:"provider,omitempty"`
-	Local         *Secret_Local          `protobuf:"bytes,2,opt,name=local,proto3" json:"local,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Provider Secret_Provider        `protobuf:"varint,1,opt,name=provider,proto3,enum=Superplane.Secrets.Secret_Provider" json:"provider,omitempty"`
+	Local    *Secret_Local          `protobuf:"bytes,2,opt,name=local,proto3" json:"local,omitempty"`
+	Vault    *Secret_Vault          `protobuf:"bytes,3,opt,name=vault,proto3" json:"vault,omitempty"`
+	File     *Secret_File           `protobuf:"bytes,4,opt,name=file,proto3" json:"file,omitempty"`
+	Env      *Secret_Env            `protobuf:"bytes,5,opt,name=env,proto3" json:"env,omitempty"`
+	//
+	// Canvases that can read an organization secret.
+	// If empty, all canvases of the organization can read it.
+	// Canvas secrets are only available to their canvas.
+	//
+	AllowedCanvasIds []string `protobuf:"bytes,6,rep,name=allowed_canvas_ids,json=allowedCanvasIds,proto3" json:"allowed_canvas_ids,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *Secret_Spec) Reset() {
 	*x = Secret_Spec{}
-	mi := &file_secrets_proto_msgTypes[19]
+	mi := &file_secrets_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1122,7 +1720,7 @@ func (x *Secret_Spec) String() string {
 func (*Secret_Spec) ProtoMessage() {}
 
 func (x *Secret_Spec) ProtoReflect() protoreflect.Message {
-	mi := &file_secrets_proto_msgTypes[19]
+	mi := &file_secrets_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1135,7 +1733,7 @@ func (x *Secret_Spec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Secret_Spec.ProtoReflect.Descriptor instead.
 func (*Secret_Spec) Descriptor() ([]byte, []int) {
-	return file_secrets_proto_rawDescGZIP(), []int{0, 2}
+	return file_secrets_proto_rawDescGZIP(), []int{0, 5}
 }
 
 func (x *Secret_Spec) GetProvider() Secret_Provider {
@@ -1152,11 +1750,39 @@ func (x *Secret_Spec) GetLocal() *Secret_Local {
 	return nil
 }
 
+func (x *Secret_Spec) GetVault() *Secret_Vault {
+	if x != nil {
+		return x.Vault
+	}
+	return nil
+}
+
+func (x *Secret_Spec) GetFile() *Secret_File {
+	if x != nil {
+		return x.File
+	}
+	return nil
+}
+
+func (x *Secret_Spec) GetEnv() *Secret_Env {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
+func (x *Secret_Spec) GetAllowedCanvasIds() []string {
+	if x != nil {
+		return x.AllowedCanvasIds
+	}
+	return nil
+}
+
 var File_secrets_proto protoreflect.FileDescriptor
 
 const file_secrets_proto_rawDesc = "" +
 	"\n" +
-	"\rsecrets.proto\x12\x12Superplane.Secrets\x1a\x13authorization.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\x88\x05\n" +
+	"\rsecrets.proto\x12\x12Superplane.Secrets\x1a\x13authorization.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\x98\t\n" +
 	"\x06Secret\x12?\n" +
 	"\bmetadata\x18\x01 \x01(\v2#.Superplane.Secrets.Secret.MetadataR\bmetadata\x123\n" +
 	"\x04spec\x18\x02 \x01(\v2\x1f.Superplane.Secrets.Secret.SpecR\x04spec\x1a\x80\x01\n" +
@@ -1164,7 +1790,17 @@ const file_secrets_proto_rawDesc = "" +
 	"\x04data\x18\x01 \x03(\v2*.Superplane.Secrets.Secret.Local.DataEntryR\x04data\x1a7\n" +
 	"\tDataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a\xcd\x01\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a1\n" +
+	"\x05Vault\x12\x14\n" +
+	"\x05mount\x18\x01 \x01(\tR\x05mount\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x1a\x1a\n" +
+	"\x04File\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x1a\x90\x01\n" +
+	"\x03Env\x12K\n" +
+	"\tvariables\x18\x01 \x03(\v2-.Superplane.Secrets.Secret.Env.VariablesEntryR\tvariables\x1a<\n" +
+	"\x0eVariablesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a\xf4\x01\n" +
 	"\bMetadata\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12E\n" +
@@ -1172,27 +1808,36 @@ const file_secrets_proto_rawDesc = "" +
 	"domainType\x12\x1b\n" +
 	"\tdomain_id\x18\x04 \x01(\tR\bdomainId\x129\n" +
 	"\n" +
-	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x1a\x7f\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12%\n" +
+	"\x0eactive_version\x18\x06 \x01(\x05R\ractiveVersion\x1a\xcc\x02\n" +
 	"\x04Spec\x12?\n" +
 	"\bprovider\x18\x01 \x01(\x0e2#.Superplane.Secrets.Secret.ProviderR\bprovider\x126\n" +
-	"\x05local\x18\x02 \x01(\v2 .Superplane.Secrets.Secret.LocalR\x05local\"4\n" +
+	"\x05local\x18\x02 \x01(\v2 .Superplane.Secrets.Secret.LocalR\x05local\x126\n" +
+	"\x05vault\x18\x03 \x01(\v2 .Superplane.Secrets.Secret.VaultR\x05vault\x123\n" +
+	"\x04file\x18\x04 \x01(\v2\x1f.Superplane.Secrets.Secret.FileR\x04file\x120\n" +
+	"\x03env\x18\x05 \x01(\v2\x1e.Superplane.Secrets.Secret.EnvR\x03env\x12,\n" +
+	"\x12allowed_canvas_ids\x18\x06 \x03(\tR\x10allowedCanvasIds\"m\n" +
 	"\bProvider\x12\x14\n" +
 	"\x10PROVIDER_UNKNOWN\x10\x00\x12\x12\n" +
-	"\x0ePROVIDER_LOCAL\x10\x01\"\xad\x01\n" +
+	"\x0ePROVIDER_LOCAL\x10\x01\x12\x12\n" +
+	"\x0ePROVIDER_VAULT\x10\x02\x12\x11\n" +
+	"\rPROVIDER_FILE\x10\x03\x12\x10\n" +
+	"\fPROVIDER_ENV\x10\x04\"\xad\x01\n" +
 	"\x13CreateSecretRequest\x122\n" +
 	"\x06secret\x18\x01 \x01(\v2\x1a.Superplane.Secrets.SecretR\x06secret\x12E\n" +
 	"\vdomain_type\x18\x02 \x01(\x0e2$.Superplane.Authorization.DomainTypeR\n" +
 	"domainType\x12\x1b\n" +
 	"\tdomain_id\x18\x03 \x01(\tR\bdomainId\"J\n" +
 	"\x14CreateSecretResponse\x122\n" +
-	"\x06secret\x18\x01 \x01(\v2\x1a.Superplane.Secrets.SecretR\x06secret\"\xcb\x01\n" +
+	"\x06secret\x18\x01 \x01(\v2\x1a.Superplane.Secrets.SecretR\x06secret\"\x86\x02\n" +
 	"\x13UpdateSecretRequest\x122\n" +
 	"\x06secret\x18\x01 \x01(\v2\x1a.Superplane.Secrets.SecretR\x06secret\x12\x1c\n" +
 	"\n" +
 	"id_or_name\x18\x02 \x01(\tR\bidOrName\x12E\n" +
 	"\vdomain_type\x18\x03 \x01(\x0e2$.Superplane.Authorization.DomainTypeR\n" +
 	"domainType\x12\x1b\n" +
-	"\tdomain_id\x18\x04 \x01(\tR\bdomainId\"J\n" +
+	"\tdomain_id\x18\x04 \x01(\tR\bdomainId\x129\n" +
+	"\x19update_allowed_canvas_ids\x18\x05 \x01(\bR\x16updateAllowedCanvasIds\"J\n" +
 	"\x14UpdateSecretResponse\x122\n" +
 	"\x06secret\x18\x01 \x01(\v2\x1a.Superplane.Secrets.SecretR\x06secret\"\x99\x01\n" +
 	"\x15DescribeSecretRequest\x12E\n" +
@@ -1243,7 +1888,45 @@ const file_secrets_proto_rawDesc = "" +
 	"domainType\x12\x1b\n" +
 	"\tdomain_id\x18\x04 \x01(\tR\bdomainId\"N\n" +
 	"\x18UpdateSecretNameResponse\x122\n" +
-	"\x06secret\x18\x01 \x01(\v2\x1a.Superplane.Secrets.SecretR\x06secret2\x9f\x0e\n" +
+	"\x06secret\x18\x01 \x01(\v2\x1a.Superplane.Secrets.SecretR\x06secret\"\xf2\x01\n" +
+	"\rSecretVersion\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x05R\aversion\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x03 \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12=\n" +
+	"\factivated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vactivatedAt\x12\x16\n" +
+	"\x06active\x18\x06 \x01(\bR\x06active\"\x97\x02\n" +
+	"\x13RotateSecretRequest\x12\x1c\n" +
+	"\n" +
+	"id_or_name\x18\x01 \x01(\tR\bidOrName\x12E\n" +
+	"\x04data\x18\x02 \x03(\v21.Superplane.Secrets.RotateSecretRequest.DataEntryR\x04data\x12E\n" +
+	"\vdomain_type\x18\x03 \x01(\x0e2$.Superplane.Authorization.DomainTypeR\n" +
+	"domainType\x12\x1b\n" +
+	"\tdomain_id\x18\x04 \x01(\tR\bdomainId\x1a7\n" +
+	"\tDataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"J\n" +
+	"\x14RotateSecretResponse\x122\n" +
+	"\x06secret\x18\x01 \x01(\v2\x1a.Superplane.Secrets.SecretR\x06secret\"\x9d\x01\n" +
+	"\x19ListSecretVersionsRequest\x12\x1c\n" +
+	"\n" +
+	"id_or_name\x18\x01 \x01(\tR\bidOrName\x12E\n" +
+	"\vdomain_type\x18\x02 \x01(\x0e2$.Superplane.Authorization.DomainTypeR\n" +
+	"domainType\x12\x1b\n" +
+	"\tdomain_id\x18\x03 \x01(\tR\bdomainId\"[\n" +
+	"\x1aListSecretVersionsResponse\x12=\n" +
+	"\bversions\x18\x01 \x03(\v2!.Superplane.Secrets.SecretVersionR\bversions\"\xb3\x01\n" +
+	"\x15RollbackSecretRequest\x12\x1c\n" +
+	"\n" +
+	"id_or_name\x18\x01 \x01(\tR\bidOrName\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\x12E\n" +
+	"\vdomain_type\x18\x03 \x01(\x0e2$.Superplane.Authorization.DomainTypeR\n" +
+	"domainType\x12\x1b\n" +
+	"\tdomain_id\x18\x04 \x01(\tR\bdomainId\"L\n" +
+	"\x16RollbackSecretResponse\x122\n" +
+	"\x06secret\x18\x01 \x01(\v2\x1a.Superplane.Secrets.SecretR\x06secret2\xbc\x14\n" +
 	"\aSecrets\x12\xb3\x01\n" +
 	"\fCreateSecret\x12'.Superplane.Secrets.CreateSecretRequest\x1a(.Superplane.Secrets.CreateSecretResponse\"P\x92A3\n" +
 	"\x06Secret\x12\x13Create a new secret\x1a\x14Creates a new secret\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/api/v1/secrets\x12\xd6\x01\n" +
@@ -1260,7 +1943,13 @@ const file_secrets_proto_rawDesc = "" +
 	"\x0fDeleteSecretKey\x12*.Superplane.Secrets.DeleteSecretKeyRequest\x1a+.Superplane.Secrets.DeleteSecretKeyResponse\"\xaa\x01\x92As\n" +
 	"\x06Secret\x12\x1aRemove a key from a secret\x1aMRemoves one key from the secret. Secret must have at least one key remaining.\x82\xd3\xe4\x93\x02.*,/api/v1/secrets/{id_or_name}/keys/{key_name}\x12\x88\x02\n" +
 	"\x10UpdateSecretName\x12+.Superplane.Secrets.UpdateSecretNameRequest\x1a,.Superplane.Secrets.UpdateSecretNameResponse\"\x98\x01\x92Ai\n" +
-	"\x06Secret\x12\x12Update secret name\x1aKUpdates only the name of the secret. Name must be unique within the domain.\x82\xd3\xe4\x93\x02&:\x01*2!/api/v1/secrets/{id_or_name}/nameB\xc5\x01\x92A\x8a\x01\x12`\n" +
+	"\x06Secret\x12\x12Update secret name\x1aKUpdates only the name of the secret. Name must be unique within the domain.\x82\xd3\xe4\x93\x02&:\x01*2!/api/v1/secrets/{id_or_name}/name\x12\xa1\x02\n" +
+	"\fRotateSecret\x12'.Superplane.Secrets.RotateSecretRequest\x1a(.Superplane.Secrets.RotateSecretResponse\"\xbd\x01\x92A\x8b\x01\n" +
+	"\x06Secret\x12\x0fRotate a secret\x1apStores new values for a local secret as a new version and activates it. Previous versions are kept for rollback.\x82\xd3\xe4\x93\x02(:\x01*\"#/api/v1/secrets/{id_or_name}/rotate\x12\x8e\x02\n" +
+	"\x12ListSecretVersions\x12-.Superplane.Secrets.ListSecretVersionsRequest\x1a..Superplane.Secrets.ListSecretVersionsResponse\"\x98\x01\x92Ah\n" +
+	"\x06Secret\x12\x14List secret versions\x1aHReturns the versions of a secret, newest first. Values are not included.\x82\xd3\xe4\x93\x02'\x12%/api/v1/secrets/{id_or_name}/versions\x12\xe5\x01\n" +
+	"\x0eRollbackSecret\x12).Superplane.Secrets.RollbackSecretRequest\x1a*.Superplane.Secrets.RollbackSecretResponse\"|\x92AI\n" +
+	"\x06Secret\x12\x12Roll back a secret\x1a+Activates a previous version of the secret.\x82\xd3\xe4\x93\x02*:\x01*\"%/api/v1/secrets/{id_or_name}/rollbackB\xc5\x01\x92A\x8a\x01\x12`\n" +
 	"\x16Superplane Secrets API\x12\x1aAPI for Superplane Secrets\"%\n" +
 	"\vAPI Support\x1a\x16support@superplane.com2\x031.0*\x02\x01\x022\x10application/json:\x10application/jsonZ5github.com/superplanehq/superplane/pkg/protos/secretsb\x06proto3"
 
@@ -1277,79 +1966,110 @@ func file_secrets_proto_rawDescGZIP() []byte {
 }
 
 var file_secrets_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_secrets_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_secrets_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
 var file_secrets_proto_goTypes = []any{
-	(Secret_Provider)(0),             // 0: Superplane.Secrets.Secret.Provider
-	(*Secret)(nil),                   // 1: Superplane.Secrets.Secret
-	(*CreateSecretRequest)(nil),      // 2: Superplane.Secrets.CreateSecretRequest
-	(*CreateSecretResponse)(nil),     // 3: Superplane.Secrets.CreateSecretResponse
-	(*UpdateSecretRequest)(nil),      // 4: Superplane.Secrets.UpdateSecretRequest
-	(*UpdateSecretResponse)(nil),     // 5: Superplane.Secrets.UpdateSecretResponse
-	(*DescribeSecretRequest)(nil),    // 6: Superplane.Secrets.DescribeSecretRequest
-	(*DescribeSecretResponse)(nil),   // 7: Superplane.Secrets.DescribeSecretResponse
-	(*ListSecretsRequest)(nil),       // 8: Superplane.Secrets.ListSecretsRequest
-	(*ListSecretsResponse)(nil),      // 9: Superplane.Secrets.ListSecretsResponse
-	(*DeleteSecretRequest)(nil),      // 10: Superplane.Secrets.DeleteSecretRequest
-	(*DeleteSecretResponse)(nil),     // 11: Superplane.Secrets.DeleteSecretResponse
-	(*SetSecretKeyRequest)(nil),      // 12: Superplane.Secrets.SetSecretKeyRequest
-	(*SetSecretKeyResponse)(nil),     // 13: Superplane.Secrets.SetSecretKeyResponse
-	(*DeleteSecretKeyRequest)(nil),   // 14: Superplane.Secrets.DeleteSecretKeyRequest
-	(*DeleteSecretKeyResponse)(nil),  // 15: Superplane.Secrets.DeleteSecretKeyResponse
-	(*UpdateSecretNameRequest)(nil),  // 16: Superplane.Secrets.UpdateSecretNameRequest
-	(*UpdateSecretNameResponse)(nil), // 17: Superplane.Secrets.UpdateSecretNameResponse
-	(*Secret_Local)(nil),             // 18: Superplane.Secrets.Secret.Local
-	(*Secret_Metadata)(nil),          // 19: Superplane.Secrets.Secret.Metadata
-	(*Secret_Spec)(nil),              // 20: Superplane.Secrets.Secret.Spec
-	nil,                              // 21: Superplane.Secrets.Secret.Local.DataEntry
-	(authorization.DomainType)(0),    // 22: Superplane.Authorization.DomainType
-	(*timestamp.Timestamp)(nil),      // 23: google.protobuf.Timestamp
+	(Secret_Provider)(0),               // 0: Superplane.Secrets.Secret.Provider
+	(*Secret)(nil),                     // 1: Superplane.Secrets.Secret
+	(*CreateSecretRequest)(nil),        // 2: Superplane.Secrets.CreateSecretRequest
+	(*CreateSecretResponse)(nil),       // 3: Superplane.Secrets.CreateSecretResponse
+	(*UpdateSecretRequest)(nil),        // 4: Superplane.Secrets.UpdateSecretRequest
+	(*UpdateSecretResponse)(nil),       // 5: Superplane.Secrets.UpdateSecretResponse
+	(*DescribeSecretRequest)(nil),      // 6: Superplane.Secrets.DescribeSecretRequest
+	(*DescribeSecretResponse)(nil),     // 7: Superplane.Secrets.DescribeSecretResponse
+	(*ListSecretsRequest)(nil),         // 8: Superplane.Secrets.ListSecretsRequest
+	(*ListSecretsResponse)(nil),        // 9: Superplane.Secrets.ListSecretsResponse
+	(*DeleteSecretRequest)(nil),        // 10: Superplane.Secrets.DeleteSecretRequest
+	(*DeleteSecretResponse)(nil),       // 11: Superplane.Secrets.DeleteSecretResponse
+	(*SetSecretKeyRequest)(nil),        // 12: Superplane.Secrets.SetSecretKeyRequest
+	(*SetSecretKeyResponse)(nil),       // 13: Superplane.Secrets.SetSecretKeyResponse
+	(*DeleteSecretKeyRequest)(nil),     // 14: Superplane.Secrets.DeleteSecretKeyRequest
+	(*DeleteSecretKeyResponse)(nil),    // 15: Superplane.Secrets.DeleteSecretKeyResponse
+	(*UpdateSecretNameRequest)(nil),    // 16: Superplane.Secrets.UpdateSecretNameRequest
+	(*UpdateSecretNameResponse)(nil),   // 17: Superplane.Secrets.UpdateSecretNameResponse
+	(*SecretVersion)(nil),              // 18: Superplane.Secrets.SecretVersion
+	(*RotateSecretRequest)(nil),        // 19: Superplane.Secrets.RotateSecretRequest
+	(*RotateSecretResponse)(nil),       // 20: Superplane.Secrets.RotateSecretResponse
+	(*ListSecretVersionsRequest)(nil),  // 21: Superplane.Secrets.ListSecretVersionsRequest
+	(*ListSecretVersionsResponse)(nil), // 22: Superplane.Secrets.ListSecretVersionsResponse
+	(*RollbackSecretRequest)(nil),      // 23: Superplane.Secrets.RollbackSecretRequest
+	(*RollbackSecretResponse)(nil),     // 24: Superplane.Secrets.RollbackSecretResponse
+	(*Secret_Local)(nil),               // 25: Superplane.Secrets.Secret.Local
+	(*Secret_Vault)(nil),               // 26: Superplane.Secrets.Secret.Vault
+	(*Secret_File)(nil),                // 27: Superplane.Secrets.Secret.File
+	(*Secret_Env)(nil),                 // 28: Superplane.Secrets.Secret.Env
+	(*Secret_Metadata)(nil),            // 29: Superplane.Secrets.Secret.Metadata
+	(*Secret_Spec)(nil),                // 30: Superplane.Secrets.Secret.Spec
+	nil,                                // 31: Superplane.Secrets.Secret.Local.DataEntry
+	nil,                                // 32: Superplane.Secrets.Secret.Env.VariablesEntry
+	nil,                                // 33: Superplane.Secrets.RotateSecretRequest.DataEntry
+	(authorization.DomainType)(0),      // 34: Superplane.Authorization.DomainType
+	(*timestamp.Timestamp)(nil),        // 35: google.protobuf.Timestamp
 }
 var file_secrets_proto_depIdxs = []int32{
-	19, // 0: Superplane.Secrets.Secret.metadata:type_name -> Superplane.Secrets.Secret.Metadata
-	20, // 1: Superplane.Secrets.Secret.spec:type_name -> Superplane.Secrets.Secret.Spec
+	29, // 0: Superplane.Secrets.Secret.metadata:type_name -> Superplane.Secrets.Secret.Metadata
+	30, // 1: Superplane.Secrets.Secret.spec:type_name -> Superplane.Secrets.Secret.Spec
 	1,  // 2: Superplane.Secrets.CreateSecretRequest.secret:type_name -> Superplane.Secrets.Secret
-	22, // 3: Superplane.Secrets.CreateSecretRequest.domain_type:type_name -> Superplane.Authorization.DomainType
+	34, // 3: Superplane.Secrets.CreateSecretRequest.domain_type:type_name -> Superplane.Authorization.DomainType
 	1,  // 4: Superplane.Secrets.CreateSecretResponse.secret:type_name -> Superplane.Secrets.Secret
 	1,  // 5: Superplane.Secrets.UpdateSecretRequest.secret:type_name -> Superplane.Secrets.Secret
-	22, // 6: Superplane.Secrets.UpdateSecretRequest.domain_type:type_name -> Superplane.Authorization.DomainType
+	34, // 6: Superplane.Secrets.UpdateSecretRequest.domain_type:type_name -> Superplane.Authorization.DomainType
 	1,  // 7: Superplane.Secrets.UpdateSecretResponse.secret:type_name -> Superplane.Secrets.Secret
-	22, // 8: Superplane.Secrets.DescribeSecretRequest.domain_type:type_name -> Superplane.Authorization.DomainType
+	34, // 8: Superplane.Secrets.DescribeSecretRequest.domain_type:type_name -> Superplane.Authorization.DomainType
 	1,  // 9: Superplane.Secrets.DescribeSecretResponse.secret:type_name -> Superplane.Secrets.Secret
-	22, // 10: Superplane.Secrets.ListSecretsRequest.domain_type:type_name -> Superplane.Authorization.DomainType
+	34, // 10: Superplane.Secrets.ListSecretsRequest.domain_type:type_name -> Superplane.Authorization.DomainType
 	1,  // 11: Superplane.Secrets.ListSecretsResponse.secrets:type_name -> Superplane.Secrets.Secret
-	22, // 12: Superplane.Secrets.DeleteSecretRequest.domain_type:type_name -> Superplane.Authorization.DomainType
-	22, // 13: Superplane.Secrets.SetSecretKeyRequest.domain_type:type_name -> Superplane.Authorization.DomainType
+	34, // 12: Superplane.Secrets.DeleteSecretRequest.domain_type:type_name -> Superplane.Authorization.DomainType
+	34, // 13: Superplane.Secrets.SetSecretKeyRequest.domain_type:type_name -> Superplane.Authorization.DomainType
 	1,  // 14: Superplane.Secrets.SetSecretKeyResponse.secret:type_name -> Superplane.Secrets.Secret
-	22, // 15: Superplane.Secrets.DeleteSecretKeyRequest.domain_type:type_name -> Superplane.Authorization.DomainType
+	34, // 15: Superplane.Secrets.DeleteSecretKeyRequest.domain_type:type_name -> Superplane.Authorization.DomainType
 	1,  // 16: Superplane.Secrets.DeleteSecretKeyResponse.secret:type_name -> Superplane.Secrets.Secret
-	22, // 17: Superplane.Secrets.UpdateSecretNameRequest.domain_type:type_name -> Superplane.Authorization.DomainType
+	34, // 17: Superplane.Secrets.UpdateSecretNameRequest.domain_type:type_name -> Superplane.Authorization.DomainType
 	1,  // 18: Superplane.Secrets.UpdateSecretNameResponse.secret:type_name -> Superplane.Secrets.Secret
-	21, // 19: Superplane.Secrets.Secret.Local.data:type_name -> Superplane.Secrets.Secret.Local.DataEntry
-	22, // 20: Superplane.Secrets.Secret.Metadata.domain_type:type_name -> Superplane.Authorization.DomainType
-	23, // 21: Superplane.Secrets.Secret.Metadata.created_at:type_name -> google.protobuf.Timestamp
-	0,  // 22: Superplane.Secrets.Secret.Spec.provider:type_name -> Superplane.Secrets.Secret.Provider
-	18, // 23: Superplane.Secrets.Secret.Spec.local:type_name -> Superplane.Secrets.Secret.Local
-	2,  // 24: Superplane.Secrets.Secrets.CreateSecret:input_type -> Superplane.Secrets.CreateSecretRequest
-	6,  // 25: Superplane.Secrets.Secrets.DescribeSecret:input_type -> Superplane.Secrets.DescribeSecretRequest
-	8,  // 26: Superplane.Secrets.Secrets.ListSecrets:input_type -> Superplane.Secrets.ListSecretsRequest
-	4,  // 27: Superplane.Secrets.Secrets.UpdateSecret:input_type -> Superplane.Secrets.UpdateSecretRequest
-	10, // 28: Superplane.Secrets.Secrets.DeleteSecret:input_type -> Superplane.Secrets.DeleteSecretRequest
-	12, // 29: Superplane.Secrets.Secrets.SetSecretKey:input_type -> Superplane.Secrets.SetSecretKeyRequest
-	14, // 30: Superplane.Secrets.Secrets.DeleteSecretKey:input_type -> Superplane.Secrets.DeleteSecretKeyRequest
-	16, // 31: Superplane.Secrets.Secrets.UpdateSecretName:input_type -> Superplane.Secrets.UpdateSecretNameRequest
-	3,  // 32: Superplane.Secrets.Secrets.CreateSecret:output_type -> Superplane.Secrets.CreateSecretResponse
-	7,  // 33: Superplane.Secrets.Secrets.DescribeSecret:output_type -> Superplane.Secrets.DescribeSecretResponse
-	9,  // 34: Superplane.Secrets.Secrets.ListSecrets:output_type -> Superplane.Secrets.ListSecretsResponse
-	5,  // 35: Superplane.Secrets.Secrets.UpdateSecret:output_type -> Superplane.Secrets.UpdateSecretResponse
-	11, // 36: Superplane.Secrets.Secrets.DeleteSecret:output_type -> Superplane.Secrets.DeleteSecretResponse
-	13, // 37: Superplane.Secrets.Secrets.SetSecretKey:output_type -> Superplane.Secrets.SetSecretKeyResponse
-	15, // 38: Superplane.Secrets.Secrets.DeleteSecretKey:output_type -> Superplane.Secrets.DeleteSecretKeyResponse
-	17, // 39: Superplane.Secrets.Secrets.UpdateSecretName:output_type -> Superplane.Secrets.UpdateSecretNameResponse
-	32, // [32:40] is the sub-list for method output_type
-	24, // [24:32] is the sub-list for method input_type
-	24, // [24:24] is the sub-list for extension type_name
-	24, // [24:24] is the sub-list for extension extendee
-	0,  // [0:24] is the sub-list for field type_name
+	35, // 19: Superplane.Secrets.SecretVersion.created_at:type_name -> google.protobuf.Timestamp
+	35, // 20: Superplane.Secrets.SecretVersion.activated_at:type_name -> google.protobuf.Timestamp
+	33, // 21: Superplane.Secrets.RotateSecretRequest.data:type_name -> Superplane.Secrets.RotateSecretRequest.DataEntry
+	34, // 22: Superplane.Secrets.RotateSecretRequest.domain_type:type_name -> Superplane.Authorization.DomainType
+	1,  // 23: Superplane.Secrets.RotateSecretResponse.secret:type_name -> Superplane.Secrets.Secret
+	34, // 24: Superplane.Secrets.ListSecretVersionsRequest.domain_type:type_name -> Superplane.Authorization.DomainType
+	18, // 25: Superplane.Secrets.ListSecretVersionsResponse.versions:type_name -> Superplane.Secrets.SecretVersion
+	34, // 26: Superplane.Secrets.RollbackSecretRequest.domain_type:type_name -> Superplane.Authorization.DomainType
+	1,  // 27: Superplane.Secrets.RollbackSecretResponse.secret:type_name -> Superplane.Secrets.Secret
+	31, // 28: Superplane.Secrets.Secret.Local.data:type_name -> Superplane.Secrets.Secret.Local.DataEntry
+	32, // 29: Superplane.Secrets.Secret.Env.variables:type_name -> Superplane.Secrets.Secret.Env.VariablesEntry
+	34, // 30: Superplane.Secrets.Secret.Metadata.domain_type:type_name -> Superplane.Authorization.DomainType
+	35, // 31: Superplane.Secrets.Secret.Metadata.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 32: Superplane.Secrets.Secret.Spec.provider:type_name -> Superplane.Secrets.Secret.Provider
+	25, // 33: Superplane.Secrets.Secret.Spec.local:type_name -> Superplane.Secrets.Secret.Local
+	26, // 34: Superplane.Secrets.Secret.Spec.vault:type_name -> Superplane.Secrets.Secret.Vault
+	27, // 35: Superplane.Secrets.Secret.Spec.file:type_name -> Superplane.Secrets.Secret.File
+	28, // 36: Superplane.Secrets.Secret.Spec.env:type_name -> Superplane.Secrets.Secret.Env
+	2,  // 37: Superplane.Secrets.Secrets.CreateSecret:input_type -> Superplane.Secrets.CreateSecretRequest
+	6,  // 38: Superplane.Secrets.Secrets.DescribeSecret:input_type -> Superplane.Secrets.DescribeSecretRequest
+	8,  // 39: Superplane.Secrets.Secrets.ListSecrets:input_type -> Superplane.Secrets.ListSecretsRequest
+	4,  // 40: Superplane.Secrets.Secrets.UpdateSecret:input_type -> Superplane.Secrets.UpdateSecretRequest
+	10, // 41: Superplane.Secrets.Secrets.DeleteSecret:input_type -> Superplane.Secrets.DeleteSecretRequest
+	12, // 42: Superplane.Secrets.Secrets.SetSecretKey:input_type -> Superplane.Secrets.SetSecretKeyRequest
+	14, // 43: Superplane.Secrets.Secrets.DeleteSecretKey:input_type -> Superplane.Secrets.DeleteSecretKeyRequest
+	16, // 44: Superplane.Secrets.Secrets.UpdateSecretName:input_type -> Superplane.Secrets.UpdateSecretNameRequest
+	19, // 45: Superplane.Secrets.Secrets.RotateSecret:input_type -> Superplane.Secrets.RotateSecretRequest
+	21, // 46: Superplane.Secrets.Secrets.ListSecretVersions:input_type -> Superplane.Secrets.ListSecretVersionsRequest
+	23, // 47: Superplane.Secrets.Secrets.RollbackSecret:input_type -> Superplane.Secrets.RollbackSecretRequest
+	3,  // 48: Superplane.Secrets.Secrets.CreateSecret:output_type -> Superplane.Secrets.CreateSecretResponse
+	7,  // 49: Superplane.Secrets.Secrets.DescribeSecret:output_type -> Superplane.Secrets.DescribeSecretResponse
+	9,  // 50: Superplane.Secrets.Secrets.ListSecrets:output_type -> Superplane.Secrets.ListSecretsResponse
+	5,  // 51: Superplane.Secrets.Secrets.UpdateSecret:output_type -> Superplane.Secrets.UpdateSecretResponse
+	11, // 52: Superplane.Secrets.Secrets.DeleteSecret:output_type -> Superplane.Secrets.DeleteSecretResponse
+	13, // 53: Superplane.Secrets.Secrets.SetSecretKey:output_type -> Superplane.Secrets.SetSecretKeyResponse
+	15, // 54: Superplane.Secrets.Secrets.DeleteSecretKey:output_type -> Superplane.Secrets.DeleteSecretKeyResponse
+	17, // 55: Superplane.Secrets.Secrets.UpdateSecretName:output_type -> Superplane.Secrets.UpdateSecretNameResponse
+	20, // 56: Superplane.Secrets.Secrets.RotateSecret:output_type -> Superplane.Secrets.RotateSecretResponse
+	22, // 57: Superplane.Secrets.Secrets.ListSecretVersions:output_type -> Superplane.Secrets.ListSecretVersionsResponse
+	24, // 58: Superplane.Secrets.Secrets.RollbackSecret:output_type -> Superplane.Secrets.RollbackSecretResponse
+	48, // [48:59] is the sub-list for method output_type
+	37, // [37:48] is the sub-list for method input_type
+	37, // [37:37] is the sub-list for extension type_name
+	37, // [37:37] is the sub-list for extension extendee
+	0,  // [0:37] is the sub-list for field type_name
 }
 
 func init() { file_secrets_proto_init() }
@@ -1363,7 +2083,7 @@ func file_secrets_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_secrets_proto_rawDesc), len(file_secrets_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   21,
+			NumMessages:   33,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,14 +19,17 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Secrets_CreateSecret_FullMethodName     = "/Superplane.Secrets.Secrets/CreateSecret"
-	Secrets_DescribeSecret_FullMethodName   = "/Superplane.Secrets.Secrets/DescribeSecret"
-	Secrets_ListSecrets_FullMethodName      = "/Superplane.Secrets.Secrets/ListSecrets"
-	Secrets_UpdateSecret_FullMethodName     = "/Superplane.Secrets.Secrets/UpdateSecret"
-	Secrets_DeleteSecret_FullMethodName     = "/Superplane.Secrets.Secrets/DeleteSecret"
-	Secrets_SetSecretKey_FullMethodName     = "/Superplane.Secrets.Secrets/SetSecretKey"
-	Secrets_DeleteSecretKey_FullMethodName  = "/Superplane.Secrets.Secrets/DeleteSecretKey"
-	Secrets_UpdateSecretName_FullMethodName = "/Superplane.Secrets.Secrets/UpdateSecretName"
+	Secrets_CreateSecret_FullMethodName       = "/Superplane.Secrets.Secrets/CreateSecret"
+	Secrets_DescribeSecret_FullMethodName     = "/Superplane.Secrets.Secrets/DescribeSecret"
+	Secrets_ListSecrets_FullMethodName        = "/Superplane.Secrets.Secrets/ListSecrets"
+	Secrets_UpdateSecret_FullMethodName       = "/Superplane.Secrets.Secrets/UpdateSecret"
+	Secrets_DeleteSecret_FullMethodName       = "/Superplane.Secrets.Secrets/DeleteSecret"
+	Secrets_SetSecretKey_FullMethodName       = "/Superplane.Secrets.Secrets/SetSecretKey"
+	Secrets_DeleteSecretKey_FullMethodName    = "/Superplane.Secrets.Secrets/DeleteSecretKey"
+	Secrets_UpdateSecretName_FullMethodName   = "/Superplane.Secrets.Secrets/UpdateSecretName"
+	Secrets_RotateSecret_FullMethodName       = "/Superplane.Secrets.Secrets/RotateSecret"
+	Secrets_ListSecretVersions_FullMethodName = "/Superplane.Secrets.Secrets/ListSecretVersions"
+	Secrets_RollbackSecret_FullMethodName     = "/Superplane.Secrets.Secrets/RollbackSecret"
 )
 
 // SecretsClient is the client API for Secrets service.
@@ -41,6 +44,9 @@ type SecretsClient interface {
 	SetSecretKey(ctx context.Context, in *SetSecretKeyRequest, opts ...grpc.CallOption) (*SetSecretKeyResponse, error)
 	DeleteSecretKey(ctx context.Context, in *DeleteSecretKeyRequest, opts ...grpc.CallOption) (*DeleteSecretKeyResponse, error)
 	UpdateSecretName(ctx context.Context, in *UpdateSecretNameRequest, opts ...grpc.CallOption) (*UpdateSecretNameResponse, error)
+	RotateSecret(ctx context.Context, in *RotateSecretRequest, opts ...grpc.CallOption) (*RotateSecretResponse, error)
+	ListSecretVersions(ctx context.Context, in *ListSecretVersionsRequest, opts ...grpc.CallOption) (*ListSecretVersionsResponse, error)
+	RollbackSecret(ctx context.Context, in *RollbackSecretRequest, opts ...grpc.CallOption) (*RollbackSecretResponse, error)
 }
 
 type secretsClient struct {
@@ -131,6 +137,36 @@ func (c *secretsClient) UpdateSecretName(ctx context.Context, in *UpdateSecretNa
 	return out, nil
 }
 
+func (c *secretsClient) RotateSecret(ctx context.Context, in *RotateSecretRequest, opts ...grpc.CallOption) (*RotateSecretResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RotateSecretResponse)
+	err := c.cc.Invoke(ctx, Secrets_RotateSecret_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *secretsClient) ListSecretVersions(ctx context.Context, in *ListSecretVersionsRequest, opts ...grpc.CallOption) (*ListSecretVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSecretVersionsResponse)
+	err := c.cc.Invoke(ctx, Secrets_ListSecretVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *secretsClient) RollbackSecret(ctx context.Context, in *RollbackSecretRequest, opts ...grpc.CallOption) (*RollbackSecretResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RollbackSecretResponse)
+	err := c.cc.Invoke(ctx, Secrets_RollbackSecret_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SecretsServer is the server API for Secrets service.
 // All implementations should embed UnimplementedSecretsServer
 // for forward compatibility.
@@ -143,6 +179,9 @@ type SecretsServer interface {
 	SetSecretKey(context.Context, *SetSecretKeyRequest) (*SetSecretKeyResponse, error)
 	DeleteSecretKey(context.Context, *DeleteSecretKeyRequest) (*DeleteSecretKeyResponse, error)
 	UpdateSecretName(context.Context, *UpdateSecretNameRequest) (*UpdateSecretNameResponse, error)
+	RotateSecret(context.Context, *RotateSecretRequest) (*RotateSecretResponse, error)
+	ListSecretVersions(context.Context, *ListSecretVersionsRequest) (*ListSecretVersionsResponse, error)
+	RollbackSecret(context.Context, *RollbackSecretRequest) (*RollbackSecretResponse, error)
 }
 
 // UnimplementedSecretsServer should be embedded to have
@@ -176,6 +215,15 @@ func (UnimplementedSecretsServer) DeleteSecretKey(context.Context, *DeleteSecret
 func (UnimplementedSecretsServer) UpdateSecretName(context.Context, *UpdateSecretNameRequest) (*UpdateSecretNameResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateSecretName not implemented")
 }
+func (UnimplementedSecretsServer) RotateSecret(context.Context, *RotateSecretRequest) (*RotateSecretResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RotateSecret not implemented")
+}
+func (UnimplementedSecretsServer) ListSecretVersions(context.Context, *ListSecretVersionsRequest) (*ListSecretVersionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSecretVersions not implemented")
+}
+func (UnimplementedSecretsServer) RollbackSecret(context.Context, *RollbackSecretRequest) (*RollbackSecretResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RollbackSecret not implemented")
+}
 func (UnimplementedSecretsServer) testEmbeddedByValue() {}
 
 // UnsafeSecretsServer may be embedded to opt out of forward compatibility for this service.
@@ -340,6 +388,60 @@ func _Secrets_UpdateSecretName_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Secrets_RotateSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecretsServer).RotateSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Secrets_RotateSecret_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretsServer).RotateSecret(ctx, req.(*RotateSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Secrets_ListSecretVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSecretVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecretsServer).ListSecretVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Secrets_ListSecretVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretsServer).ListSecretVersions(ctx, req.(*ListSecretVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Secrets_RollbackSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecretsServer).RollbackSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Secrets_RollbackSecret_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretsServer).RollbackSecret(ctx, req.(*RollbackSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Secrets_ServiceDesc is the grpc.ServiceDesc for Secrets service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -379,6 +481,18 @@ var Secrets_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateSecretName",
 			Handler:    _Secrets_UpdateSecretName_Handler,
 		},
+		{
+			MethodName: "RotateSecret",
+			Handler:    _Secrets_RotateSecret_Handler,
+		},
+		{
+			MethodName: "ListSecretVersions",
+			Handler:    _Secrets_ListSecretVersions_Handler,
+		},
+		{
+			MethodName: "RollbackSecret",
+			Handler:    _Secrets_RollbackSecret_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "secrets.proto",
@@ -24,6 +24,10 @@ const AccountContextKey contextKey = "account"
 const UserContextKey contextKey = "user"
 const OrganizationNotFoundError string = "organization_not_found_error"
 const AccountNotFoundError string = "account_not_found_error"
+const SSORequiredError string = "sso_required_error"
+
+// SSOLoginURLHeader tells clients where to log in when an organization requires SSO.
+const SSOLoginURLHeader = "X-SSO-Login-URL"
 
 var ownerSetupEnabled = os.Getenv("OWNER_SETUP_ENABLED") == "yes"
 
@@ -176,6 +180,12 @@ func OrganizationAuthMiddleware(jwtSigner *jwt.Signer) mux.MiddlewareFunc {
 					return
 				}
 
+				if err.Error() == SSORequiredError {
+					w.Header().Set(SSOLoginURLHeader, authentication.SSOLoginURL("", findOrganizationID(r)))
+					http.Error(w, "SSO Required", http.StatusForbidden)
+					return
+				}
+
 				http.Error(w, "Unauthorized", http.StatusUnauthorized)
 				return
 			}
@@ -203,7 +213,7 @@ func authenticateUserByToken(r *http.Request) (*models.User, error) {
 }
 
 func authenticateUserByCookie(jwtSigner *jwt.Signer, r *http.Request) (*models.User, error) {
-	accountID, err := getAccountFromCookie(r, jwtSigner)
+	accountID, ssoOrganizationID, err := getAccountSessionFromCookie(r, jwtSigner)
 	if err != nil {
 		return nil, errors.New(AccountNotFoundError)
 	}
@@ -213,6 +223,13 @@ func authenticateUserByCookie(jwtSigner *jwt.Signer, r *http.Request) (*models.U
 		return nil, errors.New(OrganizationNotFoundError)
 	}
 
+	//
+	// Sessions started with the SSO of an organization can only be used for it.
+	//
+	if ssoOrganizationID != "" && ssoOrganizationID != organizationID {
+		return nil, errors.New(OrganizationNotFoundError)
+	}
+
 	account, err := models.FindAccountByID(accountID)
 	if err != nil {
 		return nil, errors.New(AccountNotFoundError)
@@ -223,6 +240,17 @@ func authenticateUserByCookie(jwtSigner *jwt.Signer, r *http.Request) (*models.U
 		return nil, errors.New(OrganizationNotFoundError)
 	}
 
+	//
+	// Organizations enforcing SSO only accept sessions started with their SSO.
+	// API tokens are not affected, so admins can still fix the SSO settings with them.
+	//
+	if ssoOrganizationID == "" {
+		settings, err := models.FindOrganizationSSOSettings(organizationID)
+		if err == nil && settings.IsEnforced() {
+			return nil, errors.New(SSORequiredError)
+		}
+	}
+
 	return user, nil
 }
 
@@ -275,27 +303,46 @@ func isAccountAPIPath(path string) bool {
 }
 
 func getAccountFromCookie(r *http.Request, jwtSigner *jwt.Signer) (string, error) {
+	accountID, _, err := getAccountSessionFromCookie(r, jwtSigner)
+	return accountID, err
+}
+
+// getAccountSessionFromCookie returns the account of the cookie,
+// and the organization it is restricted to, if it was issued by an SSO login.
+func getAccountSessionFromCookie(r *http.Request, jwtSigner *jwt.Signer) (string, string, error) {
 	cookie, err := r.Cookie("account_token")
 	if err != nil {
-		return "", fmt.Errorf("account token cookie not found")
+		return "", "", fmt.Errorf("account token cookie not found")
 	}
 
 	claims, err := jwtSigner.ValidateAndGetClaims(cookie.Value)
 	if err != nil {
-		return "", fmt.Errorf("invalid account token: %v", err)
+		return "", "", fmt.Errorf("invalid account token: %v", err)
 	}
 
 	accountClaim, exists := claims["sub"]
 	if !exists {
-		return "", fmt.Errorf("account ID missing from token")
+		return "", "", fmt.Errorf("account ID missing from token")
 	}
 
 	accountID, ok := accountClaim.(string)
 	if !ok {
-		return "", fmt.Errorf("invalid account ID in token")
+		return "", "", fmt.Errorf("invalid account ID in token")
+	}
+
+	ssoOrganizationID, _ := claims[authentication.SSOOrganizationClaim].(string)
+	return accountID, ssoOrganizationID, nil
+}
+
+// SSOOrganizationFromCookie returns the organization the account session is restricted to,
+// or an empty string if it was not issued by an SSO login.
+func SSOOrganizationFromCookie(r *http.Request, jwtSigner *jwt.Signer) string {
+	_, ssoOrganizationID, err := getAccountSessionFromCookie(r, jwtSigner)
+	if err != nil {
+		return ""
 	}
 
-	return accountID, nil
+	return ssoOrganizationID
 }
 
 func GetUserFromContext(ctx context.Context) (*models.User, bool) {
@@ -9,7 +9,10 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/crypto"
 	"github.com/superplanehq/superplane/pkg/jwt"
+	"github.com/superplanehq/superplane/pkg/models"
 	"github.com/superplanehq/superplane/test/support"
 )
 
@@ -66,3 +69,80 @@ func TestOrganizationAuthMiddleware_CookieAuthErrors(t *testing.T) {
 		assert.Equal(t, http.StatusNoContent, res.Code)
 	})
 }
+
+func TestOrganizationAuthMiddleware_SSO(t *testing.T) {
+	r := support.Setup(t)
+	signer := jwt.NewSigner("test-secret")
+	orgID := r.Organization.ID.String()
+
+	token, err := signer.Generate(r.Account.ID.String(), time.Hour)
+	require.NoError(t, err)
+
+	ssoToken, err := signer.GenerateWithClaims(r.Account.ID.String(), time.Hour, map[string]any{
+		authentication.SSOOrganizationClaim: orgID,
+	})
+	require.NoError(t, err)
+
+	handler := OrganizationAuthMiddleware(signer)(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	request := func(token, organizationID string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/users", nil)
+		req.AddCookie(&http.Cookie{Name: "account_token", Value: token})
+		req.Header.Set("x-organization-id", organizationID)
+
+		res := httptest.NewRecorder()
+		handler.ServeHTTP(res, req)
+		return res
+	}
+
+	t.Run("SSO session for another organization returns not found", func(t *testing.T) {
+		otherToken, err := signer.GenerateWithClaims(r.Account.ID.String(), time.Hour, map[string]any{
+			authentication.SSOOrganizationClaim: uuid.NewString(),
+		})
+		require.NoError(t, err)
+
+		res := request(otherToken, orgID)
+		assert.Equal(t, http.StatusNotFound, res.Code)
+	})
+
+	t.Run("sessions are accepted when SSO is not enforced", func(t *testing.T) {
+		assert.Equal(t, http.StatusNoContent, request(token, orgID).Code)
+		assert.Equal(t, http.StatusNoContent, request(ssoToken, orgID).Code)
+	})
+
+	now := time.Now()
+	require.NoError(t, models.UpsertOrganizationSSOSettings(&models.OrganizationSSOSettings{
+		OrganizationID: r.Organization.ID,
+		Protocol:       models.SSOProtocolOIDC,
+		Enabled:        true,
+		Enforced:       true,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}))
+
+	t.Run("non-SSO session returns SSO required when enforced", func(t *testing.T) {
+		res := request(token, orgID)
+		assert.Equal(t, http.StatusForbidden, res.Code)
+		assert.Equal(t, "/auth/sso/"+orgID, res.Header().Get(SSOLoginURLHeader))
+	})
+
+	t.Run("SSO session is accepted when enforced", func(t *testing.T) {
+		assert.Equal(t, http.StatusNoContent, request(ssoToken, orgID).Code)
+	})
+
+	t.Run("API token is accepted when enforced", func(t *testing.T) {
+		plainToken := "sso-enforced-api-token"
+		user, err := models.FindActiveUserByID(orgID, r.User.String())
+		require.NoError(t, err)
+		require.NoError(t, user.UpdateTokenHash(crypto.HashToken(plainToken)))
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/users", nil)
+		req.Header.Set("Authorization", "Bearer "+plainToken)
+
+		res := httptest.NewRecorder()
+		handler.ServeHTTP(res, req)
+		assert.Equal(t, http.StatusNoContent, res.Code)
+	})
+}
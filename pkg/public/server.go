@@ -35,6 +35,7 @@ import (
 	"github.com/superplanehq/superplane/pkg/crypto"
 	"github.com/superplanehq/superplane/pkg/models"
 	"github.com/superplanehq/superplane/pkg/oidc"
+	pbAudit "github.com/superplanehq/superplane/pkg/protos/audit"
 	pbBlueprints "github.com/superplanehq/superplane/pkg/protos/blueprints"
 	pbCanvases "github.com/superplanehq/superplane/pkg/protos/canvases"
 	pbComponents "github.com/superplanehq/superplane/pkg/protos/components"
@@ -50,6 +51,7 @@ import (
 	pbWidgets "github.com/superplanehq/superplane/pkg/protos/widgets"
 	"github.com/superplanehq/superplane/pkg/public/middleware"
 	"github.com/superplanehq/superplane/pkg/public/ws"
+	"github.com/superplanehq/superplane/pkg/scim"
 	"github.com/superplanehq/superplane/pkg/web"
 	"github.com/superplanehq/superplane/pkg/web/assets"
 	grpcLib "google.golang.org/grpc"
@@ -107,6 +109,7 @@ func NewServer(
 	authHandler := authentication.NewHandler(jwtSigner, encryptor, authorizationService, appEnv, templateDir, blockSignup, passwordLoginEnabled)
 	providers := getOAuthProviders()
 	authHandler.InitializeProviders(providers)
+	authHandler.InitializeSSO(baseURL)
 
 	server := &Server{
 		BaseURL:               baseURL,
@@ -240,6 +243,11 @@ func (s *Server) RegisterGRPCGateway(grpcServerAddr string) error {
 		return err
 	}
 
+	err = pbAudit.RegisterAuditHandlerFromEndpoint(ctx, grpcGatewayMux, grpcServerAddr, opts)
+	if err != nil {
+		return err
+	}
+
 	// Public health check
 	s.Router.HandleFunc("/api/v1/canvases/is-alive", func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
@@ -266,6 +274,7 @@ func (s *Server) RegisterGRPCGateway(grpcServerAddr string) error {
 	s.Router.PathPrefix("/api/v1/widgets").Handler(protectedGRPCHandler)
 	s.Router.PathPrefix("/api/v1/blueprints").Handler(protectedGRPCHandler)
 	s.Router.PathPrefix("/api/v1/service-accounts").Handler(protectedGRPCHandler)
+	s.Router.PathPrefix("/api/v1/audit-events").Handler(protectedGRPCHandler)
 	s.Router.PathPrefix("/api/v1/workflows").Handler(protectedGRPCHandler)
 
 	return nil
@@ -433,6 +442,15 @@ func (s *Server) InitRouter(additionalMiddlewares ...mux.MiddlewareFunc) {
 	accountRoute.HandleFunc("/organizations", s.listAccountOrganizations).Methods("GET")
 	accountRoute.HandleFunc("/organizations", s.createOrganization).Methods("POST")
 
+	//
+	// SCIM endpoints, used by identity providers to provision users and groups.
+	// They are authenticated with the API token of a user or service account.
+	//
+	scimBasePath := s.BasePath + "/scim/v2"
+	scimRoute := r.PathPrefix(scimBasePath).Subrouter()
+	scimRoute.Use(middleware.OrganizationAuthMiddleware(s.jwt))
+	scim.NewHandler(s.authService, scimBasePath).RegisterRoutes(scimRoute)
+
 	// Apply additional middlewares
 	for _, middleware := range additionalMiddlewares {
 		publicRoute.Use(middleware)
@@ -561,6 +579,14 @@ func (s *Server) createOrganization(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	//
+	// Sessions started with the SSO of an organization can only be used for it.
+	//
+	if middleware.SSOOrganizationFromCookie(r, s.jwt) != "" {
+		http.Error(w, "Organizations cannot be created with an SSO session", http.StatusForbidden)
+		return
+	}
+
 	if req.Name == "" {
 		http.Error(w, "Name is required", http.StatusBadRequest)
 		return
@@ -657,6 +683,23 @@ func (s *Server) getAccount(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(accountResponse)
 }
 
+// filterSSOOrganizations only keeps the organization of the SSO login,
+// if the account session was started with one.
+func filterSSOOrganizations(organizations []models.Organization, ssoOrganizationID string) []models.Organization {
+	if ssoOrganizationID == "" {
+		return organizations
+	}
+
+	filtered := []models.Organization{}
+	for _, organization := range organizations {
+		if organization.ID.String() == ssoOrganizationID {
+			filtered = append(filtered, organization)
+		}
+	}
+
+	return filtered
+}
+
 func (s *Server) listAccountOrganizations(w http.ResponseWriter, r *http.Request) {
 	account, ok := middleware.GetAccountFromContext(r.Context())
 	if !ok {
@@ -678,6 +721,8 @@ func (s *Server) listAccountOrganizations(w http.ResponseWriter, r *http.Request
 		return
 	}
 
+	organizations = filterSSOOrganizations(organizations, middleware.SSOOrganizationFromCookie(r, s.jwt))
+
 	orgIDs := make([]string, 0, len(organizations))
 	for _, organization := range organizations {
 		orgIDs = append(orgIDs, organization.ID.String())
@@ -784,10 +829,19 @@ func (s *Server) HandleWebhook(w http.ResponseWriter, r *http.Request) {
 		newEvents = append(newEvents, events...)
 	}
 
+	//
+	// Components can finish executions when handling webhooks,
+	// so execution messages are published for the ones they look up.
+	//
+	executions := []models.CanvasNodeExecution{}
+	onExecution := func(execution *models.CanvasNodeExecution) {
+		executions = append(executions, *execution)
+	}
+
 	var firstResponse *core.WebhookResponseBody
 
 	for _, node := range nodes {
-		code, response, err := s.executeWebhookNode(r.Context(), body, r.Header, node, onNewEvents)
+		code, response, err := s.executeWebhookNode(r.Context(), body, r.Header, node, onNewEvents, onExecution)
 		if err != nil {
 			http.Error(w, fmt.Sprintf("error handling webhook: %v", err), code)
 			return
@@ -802,6 +856,10 @@ func (s *Server) HandleWebhook(w http.ResponseWriter, r *http.Request) {
 		messages.NewCanvasEventCreatedMessage(event.WorkflowID.String(), &event).Publish()
 	}
 
+	for _, execution := range executions {
+		messages.NewCanvasExecutionMessage(execution.WorkflowID.String(), execution.ID.String(), execution.NodeID).Publish()
+	}
+
 	if firstResponse != nil {
 		if firstResponse.ContentType != "" {
 			w.Header().Set("Content-Type", firstResponse.ContentType)
@@ -813,12 +871,12 @@ func (s *Server) HandleWebhook(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func (s *Server) executeWebhookNode(ctx context.Context, body []byte, headers http.Header, node models.CanvasNode, onNewEvents func([]models.CanvasEvent)) (int, *core.WebhookResponseBody, error) {
+func (s *Server) executeWebhookNode(ctx context.Context, body []byte, headers http.Header, node models.CanvasNode, onNewEvents func([]models.CanvasEvent), onExecution func(*models.CanvasNodeExecution)) (int, *core.WebhookResponseBody, error) {
 	if node.Type == models.NodeTypeTrigger {
 		return s.executeTriggerNode(ctx, body, headers, node, onNewEvents)
 	}
 
-	return s.executeComponentNode(ctx, body, headers, node, onNewEvents)
+	return s.executeComponentNode(ctx, body, headers, node, onNewEvents, onExecution)
 }
 
 func (s *Server) executeTriggerNode(ctx context.Context, body []byte, headers http.Header, node models.CanvasNode, onNewEvents func([]models.CanvasEvent)) (int, *core.WebhookResponseBody, error) {
@@ -856,7 +914,7 @@ func (s *Server) executeTriggerNode(ctx context.Context, body []byte, headers ht
 	})
 }
 
-func (s *Server) executeComponentNode(ctx context.Context, body []byte, headers http.Header, node models.CanvasNode, onNewEvents func([]models.CanvasEvent)) (int, *core.WebhookResponseBody, error) {
+func (s *Server) executeComponentNode(ctx context.Context, body []byte, headers http.Header, node models.CanvasNode, onNewEvents func([]models.CanvasEvent), onExecution func(*models.CanvasNodeExecution)) (int, *core.WebhookResponseBody, error) {
 	ref := node.Ref.Data()
 	component, err := s.registry.GetComponent(ref.Component.Name)
 	if err != nil {
@@ -894,6 +952,7 @@ func (s *Server) executeComponentNode(ctx context.Context, body []byte, headers
 				return nil, err
 			}
 
+			onExecution(execution)
 			return &core.ExecutionContext{
 				ID:             execution.ID,
 				WorkflowID:     execution.WorkflowID.String(),
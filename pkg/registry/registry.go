@@ -8,6 +8,7 @@ import (
 
 	"github.com/superplanehq/superplane/pkg/core"
 	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/secrets"
 )
 
 var (
@@ -57,13 +58,15 @@ type IntegrationRegistration struct {
 }
 
 type Registry struct {
-	httpCtx         *HTTPContext
-	Encryptor       crypto.Encryptor
-	Integrations    map[string]core.Integration
-	WebhookHandlers map[string]core.WebhookHandler
-	Components      map[string]core.Component
-	Triggers        map[string]core.Trigger
-	Widgets         map[string]core.Widget
+	httpCtx               *HTTPContext
+	Encryptor             crypto.Encryptor
+	Integrations          map[string]core.Integration
+	WebhookHandlers       map[string]core.WebhookHandler
+	RepositoryFileReaders map[string]core.RepositoryFileReader
+	Components            map[string]core.Component
+	Triggers              map[string]core.Trigger
+	Widgets               map[string]core.Widget
+	SecretBackends        *secrets.Backends
 }
 
 func NewRegistry(encryptor crypto.Encryptor, httpOptions HTTPOptions) (*Registry, error) {
@@ -73,13 +76,14 @@ func NewRegistry(encryptor crypto.Encryptor, httpOptions HTTPOptions) (*Registry
 	}
 
 	r := &Registry{
-		Encryptor:       encryptor,
-		httpCtx:         httpCtx,
-		Components:      map[string]core.Component{},
-		Triggers:        map[string]core.Trigger{},
-		Integrations:    map[string]core.Integration{},
-		WebhookHandlers: map[string]core.WebhookHandler{},
-		Widgets:         map[string]core.Widget{},
+		Encryptor:             encryptor,
+		httpCtx:               httpCtx,
+		Components:            map[string]core.Component{},
+		Triggers:              map[string]core.Trigger{},
+		Integrations:          map[string]core.Integration{},
+		WebhookHandlers:       map[string]core.WebhookHandler{},
+		RepositoryFileReaders: map[string]core.RepositoryFileReader{},
+		Widgets:               map[string]core.Widget{},
 	}
 
 	r.Init()
@@ -104,6 +108,10 @@ func (r *Registry) Init() {
 
 	for name, integration := range registeredIntegrations {
 		r.Integrations[name] = NewPanicableIntegration(integration)
+
+		if reader, ok := integration.(core.RepositoryFileReader); ok {
+			r.RepositoryFileReaders[name] = NewPanicableRepositoryFileReader(reader)
+		}
 	}
 
 	for name, webhookHandler := range registeredWebhookHandlers {
@@ -226,6 +234,15 @@ func (r *Registry) GetWebhookHandler(name string) (core.WebhookHandler, error) {
 	return webhookHandler, nil
 }
 
+func (r *Registry) GetRepositoryFileReader(name string) (core.RepositoryFileReader, error) {
+	reader, ok := r.RepositoryFileReaders[name]
+	if !ok {
+		return nil, fmt.Errorf("integration %s cannot read repository files", name)
+	}
+
+	return reader, nil
+}
+
 func (r *Registry) ListIntegrations() []core.Integration {
 	integrations := make([]core.Integration, 0, len(r.Integrations))
 	for _, integration := range r.Integrations {
@@ -0,0 +1,26 @@
+package registry
+
+import (
+	"fmt"
+
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+type PanicableRepositoryFileReader struct {
+	underlying core.RepositoryFileReader
+}
+
+func NewPanicableRepositoryFileReader(underlying core.RepositoryFileReader) *PanicableRepositoryFileReader {
+	return &PanicableRepositoryFileReader{underlying: underlying}
+}
+
+func (r *PanicableRepositoryFileReader) ReadRepositoryFile(ctx core.RepositoryFileContext) (content []byte, err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			content = nil
+			err = fmt.Errorf("integration panicked in ReadRepositoryFile(): %v", p)
+		}
+	}()
+
+	return r.underlying.ReadRepositoryFile(ctx)
+}
@@ -0,0 +1,24 @@
+package registry
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+type panickingRepositoryFileReader struct{}
+
+func (p *panickingRepositoryFileReader) ReadRepositoryFile(ctx core.RepositoryFileContext) ([]byte, error) {
+	panic("read repository file panic")
+}
+
+func Test_PanicableRepositoryFileReader_ReadRepositoryFile_CatchesPanic(t *testing.T) {
+	panicable := NewPanicableRepositoryFileReader(&panickingRepositoryFileReader{})
+
+	content, err := panicable.ReadRepositoryFile(core.RepositoryFileContext{Path: "canvas.yaml"})
+	require.Error(t, err)
+	assert.Nil(t, content)
+	assert.Contains(t, err.Error(), "read repository file panic")
+}
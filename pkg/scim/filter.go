@@ -0,0 +1,56 @@
+package scim
+
+import (
+	"strings"
+)
+
+// Filter is an equality filter on a single attribute, like userName eq "jane@example.com".
+// Identity providers only use these to find resources before creating them,
+// so other filter expressions are rejected.
+type Filter struct {
+	Attribute string
+	Value     string
+}
+
+func ParseFilter(filter string) (*Filter, error) {
+	filter = strings.TrimSpace(filter)
+	if filter == "" {
+		return nil, nil
+	}
+
+	attribute, rest, ok := strings.Cut(filter, " ")
+	if !ok {
+		return nil, badRequest("invalidFilter", "invalid filter: "+filter)
+	}
+
+	operator, value, ok := strings.Cut(strings.TrimSpace(rest), " ")
+	if !ok || !strings.EqualFold(operator, "eq") {
+		return nil, badRequest("invalidFilter", "only eq filters are supported")
+	}
+
+	value = strings.TrimSpace(value)
+	if len(value) < 2 || !strings.HasPrefix(value, `"`) || !strings.HasSuffix(value, `"`) {
+		return nil, badRequest("invalidFilter", "filter value must be a string")
+	}
+
+	return &Filter{
+		Attribute: attribute,
+		Value:     strings.ReplaceAll(value[1:len(value)-1], `\"`, `"`),
+	}, nil
+}
+
+// Matches checks the filter against the attributes of a resource.
+// Attribute names are case-insensitive, like in SCIM.
+func (f *Filter) Matches(attributes map[string]string) (bool, error) {
+	if f == nil {
+		return true, nil
+	}
+
+	for name, value := range attributes {
+		if strings.EqualFold(name, f.Attribute) {
+			return value == f.Value, nil
+		}
+	}
+
+	return false, badRequest("invalidFilter", "filtering by "+f.Attribute+" is not supported")
+}
@@ -0,0 +1,73 @@
+package scim
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test__ParseFilter(t *testing.T) {
+	t.Run("empty filter -> nil", func(t *testing.T) {
+		filter, err := ParseFilter("  ")
+		require.NoError(t, err)
+		assert.Nil(t, filter)
+	})
+
+	t.Run("eq filter", func(t *testing.T) {
+		filter, err := ParseFilter(`userName eq "jane@example.com"`)
+		require.NoError(t, err)
+		assert.Equal(t, "userName", filter.Attribute)
+		assert.Equal(t, "jane@example.com", filter.Value)
+	})
+
+	t.Run("operator is case-insensitive and values can have spaces", func(t *testing.T) {
+		filter, err := ParseFilter(`displayName EQ "Platform \"Core\" Team"`)
+		require.NoError(t, err)
+		assert.Equal(t, "displayName", filter.Attribute)
+		assert.Equal(t, `Platform "Core" Team`, filter.Value)
+	})
+
+	t.Run("other operators are rejected", func(t *testing.T) {
+		_, err := ParseFilter(`userName sw "jane"`)
+		require.ErrorContains(t, err, "only eq filters are supported")
+	})
+
+	t.Run("values that are not strings are rejected", func(t *testing.T) {
+		_, err := ParseFilter(`active eq true`)
+		require.ErrorContains(t, err, "filter value must be a string")
+	})
+
+	t.Run("incomplete filter is rejected", func(t *testing.T) {
+		_, err := ParseFilter(`userName`)
+		require.ErrorContains(t, err, "invalid filter")
+	})
+}
+
+func Test__FilterMatches(t *testing.T) {
+	attributes := map[string]string{"id": "123", "userName": "jane@example.com"}
+
+	t.Run("nil filter matches everything", func(t *testing.T) {
+		var filter *Filter
+		ok, err := filter.Matches(attributes)
+		require.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("attribute names are case-insensitive", func(t *testing.T) {
+		ok, err := (&Filter{Attribute: "username", Value: "jane@example.com"}).Matches(attributes)
+		require.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("different value -> no match", func(t *testing.T) {
+		ok, err := (&Filter{Attribute: "id", Value: "456"}).Matches(attributes)
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("unknown attribute -> error", func(t *testing.T) {
+		_, err := (&Filter{Attribute: "title", Value: "Engineer"}).Matches(attributes)
+		require.ErrorContains(t, err, "filtering by title is not supported")
+	})
+}
@@ -0,0 +1,508 @@
+package scim
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/gorilla/mux"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/grpc/actions/auth"
+	"github.com/superplanehq/superplane/pkg/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SCIM groups are SuperPlane groups of the organization, and their IDs are the group names.
+// Groups created with SCIM get the role in the SuperPlane group extension,
+// or org_viewer if there is none. Groups created in SuperPlane before are found
+// by their display name, so they can be linked to identity provider groups
+// and keep the role they already have.
+const DefaultGroupRole = models.RoleOrgViewer
+
+var invalidGroupNameCharacters = regexp.MustCompile(`[^a-z0-9]+`)
+
+// groupName converts the display name of an identity provider group
+// into the name of the SuperPlane group, like Platform Engineers -> platform-engineers.
+func groupName(displayName string) string {
+	name := invalidGroupNameCharacters.ReplaceAllString(strings.ToLower(displayName), "-")
+	return strings.Trim(name, "-")
+}
+
+func (h *Handler) listGroups(w http.ResponseWriter, r *http.Request, caller *models.User) error {
+	filter, err := ParseFilter(r.URL.Query().Get("filter"))
+	if err != nil {
+		return err
+	}
+
+	orgID := caller.OrganizationID.String()
+	names, err := h.authService.GetGroups(orgID, models.DomainTypeOrganization)
+	if err != nil {
+		return err
+	}
+
+	sort.Strings(names)
+	matching := []*Group{}
+	for _, name := range names {
+		group, err := h.findGroup(orgID, name)
+		if err != nil {
+			return err
+		}
+
+		ok, err := filter.Matches(map[string]string{
+			"id":          group.ID,
+			"displayName": group.DisplayName,
+		})
+		if err != nil {
+			return err
+		}
+
+		if ok {
+			matching = append(matching, group)
+		}
+	}
+
+	//
+	// Identity providers exclude members when they only look for a group,
+	// since big groups would make the response too big.
+	//
+	excludeMembers := slices.Contains(strings.Split(r.URL.Query().Get("excludedAttributes"), ","), "members")
+
+	startIndex, count := pagination(r)
+	resources := []any{}
+	for _, group := range paginate(matching, startIndex, count) {
+		if excludeMembers {
+			group.Members = nil
+		}
+
+		resources = append(resources, group)
+	}
+
+	writeJSON(w, http.StatusOK, newListResponse(resources, len(matching), startIndex))
+	return nil
+}
+
+func (h *Handler) getGroup(w http.ResponseWriter, r *http.Request, caller *models.User) error {
+	group, err := h.findGroup(caller.OrganizationID.String(), mux.Vars(r)["id"])
+	if err != nil {
+		return err
+	}
+
+	writeJSON(w, http.StatusOK, group)
+	return nil
+}
+
+func (h *Handler) createGroup(w http.ResponseWriter, r *http.Request, caller *models.User) error {
+	var request Group
+	err := readJSON(r, &request)
+	if err != nil {
+		return err
+	}
+
+	name := groupName(request.DisplayName)
+	if name == "" {
+		return badRequest("invalidValue", "displayName is required")
+	}
+
+	role := DefaultGroupRole
+	if request.Extension != nil && request.Extension.Role != "" {
+		role = request.Extension.Role
+	}
+
+	orgID := caller.OrganizationID.String()
+	_, err = h.authService.GetGroupRole(orgID, models.DomainTypeOrganization, name)
+	if err == nil {
+		return conflict("group " + name + " already exists")
+	}
+
+	memberIDs, err := h.memberIDs(orgID, request.Members)
+	if err != nil {
+		return err
+	}
+
+	err = h.authService.CreateGroup(orgID, models.DomainTypeOrganization, name, role, request.DisplayName, "")
+	if err != nil {
+		log.Errorf("SCIM: error creating group %s with role %s in organization %s: %v", name, role, orgID, err)
+		return badRequest("invalidValue", "error creating group with role "+role)
+	}
+
+	err = h.setMembers(orgID, name, memberIDs)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("SCIM: created group %s with role %s in organization %s", name, role, orgID)
+	return h.writeGroup(w, http.StatusCreated, orgID, name)
+}
+
+func (h *Handler) replaceGroup(w http.ResponseWriter, r *http.Request, caller *models.User) error {
+	var request Group
+	err := readJSON(r, &request)
+	if err != nil {
+		return err
+	}
+
+	orgID := caller.OrganizationID.String()
+	group, err := h.findGroup(orgID, mux.Vars(r)["id"])
+	if err != nil {
+		return err
+	}
+
+	memberIDs, err := h.memberIDs(orgID, request.Members)
+	if err != nil {
+		return err
+	}
+
+	role := ""
+	if request.Extension != nil {
+		role = request.Extension.Role
+	}
+
+	err = h.updateGroup(orgID, group.ID, request.DisplayName, role)
+	if err != nil {
+		return err
+	}
+
+	err = h.setMembers(orgID, group.ID, memberIDs)
+	if err != nil {
+		return err
+	}
+
+	return h.writeGroup(w, http.StatusOK, orgID, group.ID)
+}
+
+func (h *Handler) patchGroup(w http.ResponseWriter, r *http.Request, caller *models.User) error {
+	var request PatchRequest
+	err := readJSON(r, &request)
+	if err != nil {
+		return err
+	}
+
+	orgID := caller.OrganizationID.String()
+	group, err := h.findGroup(orgID, mux.Vars(r)["id"])
+	if err != nil {
+		return err
+	}
+
+	for _, operation := range request.Operations {
+		err = h.applyGroupOperation(orgID, group.ID, operation)
+		if err != nil {
+			return err
+		}
+	}
+
+	return h.writeGroup(w, http.StatusOK, orgID, group.ID)
+}
+
+func (h *Handler) applyGroupOperation(orgID, name string, operation PatchOperation) error {
+	op := strings.ToLower(operation.Op)
+	path := strings.ToLower(operation.Path)
+
+	//
+	// Members are removed with a filter in the path,
+	// like members[value eq "<user-id>"].
+	//
+	if op == "remove" && strings.HasPrefix(path, "members[") && strings.HasSuffix(path, "]") {
+		filter, err := ParseFilter(operation.Path[len("members[") : len(operation.Path)-1])
+		if err != nil {
+			return err
+		}
+
+		if filter == nil || !strings.EqualFold(filter.Attribute, "value") {
+			return badRequest("invalidPath", "invalid path "+operation.Path)
+		}
+
+		return h.removeMembers(orgID, name, []string{filter.Value})
+	}
+
+	switch {
+	case path == "members":
+		var members []Reference
+		if op != "remove" || len(operation.Value) > 0 {
+			err := json.Unmarshal(operation.Value, &members)
+			if err != nil {
+				return badRequest("invalidValue", "members must be a list")
+			}
+		}
+
+		return h.patchMembers(orgID, name, op, members)
+
+	case op == "remove":
+		return badRequest("invalidPath", "unsupported path "+operation.Path+" for remove")
+	}
+
+	attributes, err := patchAttributes(operation)
+	if err != nil {
+		return err
+	}
+
+	for attribute, value := range attributes {
+		switch strings.ToLower(attribute) {
+		case "displayname":
+			var displayName string
+			err := json.Unmarshal(value, &displayName)
+			if err != nil {
+				return badRequest("invalidValue", "displayName must be a string")
+			}
+
+			err = h.updateGroup(orgID, name, displayName, "")
+			if err != nil {
+				return err
+			}
+
+		case "members":
+			var members []Reference
+			err := json.Unmarshal(value, &members)
+			if err != nil {
+				return badRequest("invalidValue", "members must be a list")
+			}
+
+			err = h.patchMembers(orgID, name, op, members)
+			if err != nil {
+				return err
+			}
+
+		case strings.ToLower(SchemaGroupExtension) + ":role":
+			var role string
+			err := json.Unmarshal(value, &role)
+			if err != nil {
+				return badRequest("invalidValue", "role must be a string")
+			}
+
+			err = h.updateGroup(orgID, name, "", role)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (h *Handler) patchMembers(orgID, name, op string, members []Reference) error {
+	memberIDs, err := h.memberIDs(orgID, members)
+	if err != nil && op != "remove" {
+		return err
+	}
+
+	switch op {
+	case "add":
+		return h.addMembers(orgID, name, memberIDs)
+	case "replace":
+		return h.setMembers(orgID, name, memberIDs)
+	case "remove":
+		//
+		// Without a value, all the members are removed.
+		//
+		if len(members) == 0 {
+			return h.setMembers(orgID, name, []string{})
+		}
+
+		ids := []string{}
+		for _, member := range members {
+			ids = append(ids, member.Value)
+		}
+
+		return h.removeMembers(orgID, name, ids)
+	default:
+		return badRequest("invalidValue", "unsupported operation "+op)
+	}
+}
+
+func (h *Handler) deleteGroup(w http.ResponseWriter, r *http.Request, caller *models.User) error {
+	orgID := caller.OrganizationID.String()
+	group, err := h.findGroup(orgID, mux.Vars(r)["id"])
+	if err != nil {
+		return err
+	}
+
+	_, err = auth.DeleteGroup(r.Context(), models.DomainTypeOrganization, orgID, group.ID, h.authService)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return notFound("group not found")
+		}
+
+		return err
+	}
+
+	log.Infof("SCIM: deleted group %s from organization %s", group.ID, orgID)
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (h *Handler) updateGroup(orgID, name, displayName, role string) error {
+	currentRole, err := h.authService.GetGroupRole(orgID, models.DomainTypeOrganization, name)
+	if err != nil {
+		return notFound("group not found")
+	}
+
+	if role == "" {
+		role = currentRole
+	}
+
+	err = h.authService.UpdateGroup(orgID, models.DomainTypeOrganization, name, role, displayName, "")
+	if err != nil {
+		log.Errorf("SCIM: error updating group %s in organization %s: %v", name, orgID, err)
+		return badRequest("invalidValue", "error updating group with role "+role)
+	}
+
+	return nil
+}
+
+// memberIDs checks that the members are active users of the organization.
+func (h *Handler) memberIDs(orgID string, members []Reference) ([]string, error) {
+	ids := []string{}
+	for _, member := range members {
+		user, err := h.findUser(orgID, member.Value)
+		if err != nil || user.DeletedAt.Valid {
+			return nil, badRequest("invalidValue", "user "+member.Value+" is not a member of the organization")
+		}
+
+		ids = append(ids, user.ID.String())
+	}
+
+	return ids, nil
+}
+
+func (h *Handler) addMembers(orgID, name string, userIDs []string) error {
+	for _, userID := range userIDs {
+		err := h.authService.AddUserToGroup(orgID, models.DomainTypeOrganization, userID, name)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h *Handler) removeMembers(orgID, name string, userIDs []string) error {
+	current, err := h.authService.GetGroupUsers(orgID, models.DomainTypeOrganization, name)
+	if err != nil {
+		return err
+	}
+
+	for _, userID := range userIDs {
+		if !slices.Contains(current, userID) {
+			continue
+		}
+
+		err := h.authService.RemoveUserFromGroup(orgID, models.DomainTypeOrganization, userID, name)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h *Handler) setMembers(orgID, name string, userIDs []string) error {
+	current, err := h.authService.GetGroupUsers(orgID, models.DomainTypeOrganization, name)
+	if err != nil {
+		return err
+	}
+
+	removed := []string{}
+	for _, userID := range current {
+		if !slices.Contains(userIDs, userID) {
+			removed = append(removed, userID)
+		}
+	}
+
+	err = h.removeMembers(orgID, name, removed)
+	if err != nil {
+		return err
+	}
+
+	return h.addMembers(orgID, name, userIDs)
+}
+
+func (h *Handler) findGroup(orgID, name string) (*Group, error) {
+	role, err := h.authService.GetGroupRole(orgID, models.DomainTypeOrganization, name)
+	if err != nil {
+		return nil, notFound("group not found")
+	}
+
+	group := &Group{
+		Schemas:     []string{SchemaGroup, SchemaGroupExtension},
+		ID:          name,
+		DisplayName: name,
+		Members:     []Reference{},
+		Extension:   &GroupExtension{Role: role},
+		Meta: &Meta{
+			ResourceType: "Group",
+			Location:     h.location("Groups", name),
+		},
+	}
+
+	metadata, err := models.FindGroupMetadata(name, models.DomainTypeOrganization, orgID)
+	if err == nil {
+		if metadata.DisplayName != "" {
+			group.DisplayName = metadata.DisplayName
+		}
+
+		group.Meta.Created = &metadata.CreatedAt
+		group.Meta.LastModified = &metadata.UpdatedAt
+	}
+
+	userIDs, err := h.authService.GetGroupUsers(orgID, models.DomainTypeOrganization, name)
+	if err != nil {
+		return nil, err
+	}
+
+	users, err := models.ListActiveUsersByID(orgID, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, user := range users {
+		group.Members = append(group.Members, Reference{
+			Value:   user.ID.String(),
+			Display: user.GetEmail(),
+			Ref:     h.location("Users", user.ID.String()),
+		})
+	}
+
+	return group, nil
+}
+
+func (h *Handler) writeGroup(w http.ResponseWriter, status int, orgID, name string) error {
+	group, err := h.findGroup(orgID, name)
+	if err != nil {
+		return err
+	}
+
+	writeJSON(w, status, group)
+	return nil
+}
+
+// groupMemberships returns the groups of every user of the organization.
+func (h *Handler) groupMemberships(orgID string) (map[string][]Reference, error) {
+	names, err := h.authService.GetGroups(orgID, models.DomainTypeOrganization)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(names)
+	memberships := map[string][]Reference{}
+	for _, name := range names {
+		userIDs, err := h.authService.GetGroupUsers(orgID, models.DomainTypeOrganization, name)
+		if err != nil {
+			return nil, err
+		}
+
+		reference := Reference{Value: name, Display: name, Ref: h.location("Groups", name)}
+		metadata, err := models.FindGroupMetadata(name, models.DomainTypeOrganization, orgID)
+		if err == nil && metadata.DisplayName != "" {
+			reference.Display = metadata.DisplayName
+		}
+
+		for _, userID := range userIDs {
+			memberships[userID] = append(memberships[userID], reference)
+		}
+	}
+
+	return memberships, nil
+}
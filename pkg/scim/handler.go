@@ -0,0 +1,220 @@
+package scim
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/authorization"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/public/middleware"
+)
+
+const (
+	DefaultCount = 100
+	MaxCount     = 100
+
+	// SCIM requests carry a single user or group,
+	// but groups can have a lot of members.
+	MaxRequestSize = 1024 * 1024
+)
+
+// Handler implements the SCIM 2.0 API (RFC 7643 and RFC 7644),
+// used by identity providers to provision the users and groups of an organization.
+//
+// Requests are authenticated with the API token of a user or service account
+// of the organization, which needs permissions to manage members and groups.
+type Handler struct {
+	authService authorization.Authorization
+	basePath    string
+}
+
+func NewHandler(authService authorization.Authorization, basePath string) *Handler {
+	return &Handler{
+		authService: authService,
+		basePath:    basePath,
+	}
+}
+
+func (h *Handler) RegisterRoutes(router *mux.Router) {
+	router.HandleFunc("/ServiceProviderConfig", h.handleServiceProviderConfig).Methods("GET")
+	router.HandleFunc("/ResourceTypes", h.handleResourceTypes).Methods("GET")
+
+	router.HandleFunc("/Users", h.authorize("members", "read", h.listUsers)).Methods("GET")
+	router.HandleFunc("/Users", h.authorize("members", "create", h.createUser)).Methods("POST")
+	router.HandleFunc("/Users/{id}", h.authorize("members", "read", h.getUser)).Methods("GET")
+	router.HandleFunc("/Users/{id}", h.authorize("members", "update", h.replaceUser)).Methods("PUT")
+	router.HandleFunc("/Users/{id}", h.authorize("members", "update", h.patchUser)).Methods("PATCH")
+	router.HandleFunc("/Users/{id}", h.authorize("members", "delete", h.deleteUser)).Methods("DELETE")
+
+	router.HandleFunc("/Groups", h.authorize("groups", "read", h.listGroups)).Methods("GET")
+	router.HandleFunc("/Groups", h.authorize("groups", "create", h.createGroup)).Methods("POST")
+	router.HandleFunc("/Groups/{id}", h.authorize("groups", "read", h.getGroup)).Methods("GET")
+	router.HandleFunc("/Groups/{id}", h.authorize("groups", "update", h.replaceGroup)).Methods("PUT")
+	router.HandleFunc("/Groups/{id}", h.authorize("groups", "update", h.patchGroup)).Methods("PATCH")
+	router.HandleFunc("/Groups/{id}", h.authorize("groups", "delete", h.deleteGroup)).Methods("DELETE")
+}
+
+type handlerFunc func(w http.ResponseWriter, r *http.Request, user *models.User) error
+
+func (h *Handler) authorize(resource, action string, handler handlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		user, ok := middleware.GetUserFromContext(r.Context())
+		if !ok {
+			writeError(w, &scimError{status: http.StatusUnauthorized, detail: "unauthorized"})
+			return
+		}
+
+		orgID := user.OrganizationID.String()
+		allowed, err := h.authService.CheckOrganizationPermission(user.ID.String(), orgID, resource, action)
+		if err != nil {
+			log.Errorf("Error checking SCIM permission %s:%s for %s: %v", resource, action, user.ID.String(), err)
+			writeError(w, err)
+			return
+		}
+
+		if !allowed {
+			log.Warnf("User %s tried to %s %s with SCIM in organization %s", user.ID.String(), action, resource, orgID)
+			writeError(w, &scimError{status: http.StatusForbidden, detail: "forbidden"})
+			return
+		}
+
+		err = handler(w, r, user)
+		if err != nil {
+			writeError(w, err)
+		}
+	}
+}
+
+func (h *Handler) location(resourceType, id string) string {
+	return fmt.Sprintf("%s/%s/%s", h.basePath, resourceType, id)
+}
+
+func (h *Handler) handleServiceProviderConfig(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]any{
+		"schemas": []string{SchemaServiceProviderConfig},
+		"patch":   map[string]any{"supported": true},
+		"bulk":    map[string]any{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":  map[string]any{"supported": true, "maxResults": MaxCount},
+		"changePassword": map[string]any{
+			"supported": false,
+		},
+		"sort": map[string]any{"supported": false},
+		"etag": map[string]any{"supported": false},
+		"authenticationSchemes": []map[string]any{
+			{
+				"type":        "oauthbearertoken",
+				"name":        "API token",
+				"description": "API token of a user or service account of the organization",
+				"primary":     true,
+			},
+		},
+	})
+}
+
+func (h *Handler) handleResourceTypes(w http.ResponseWriter, r *http.Request) {
+	resourceTypes := []any{
+		map[string]any{
+			"schemas":  []string{SchemaResourceType},
+			"id":       "User",
+			"name":     "User",
+			"endpoint": "/Users",
+			"schema":   SchemaUser,
+		},
+		map[string]any{
+			"schemas":  []string{SchemaResourceType},
+			"id":       "Group",
+			"name":     "Group",
+			"endpoint": "/Groups",
+			"schema":   SchemaGroup,
+			"schemaExtensions": []map[string]any{
+				{"schema": SchemaGroupExtension, "required": false},
+			},
+		},
+	}
+
+	writeJSON(w, http.StatusOK, newListResponse(resourceTypes, len(resourceTypes), 1))
+}
+
+// pagination returns the 1-based start index and the number of resources to return.
+func pagination(r *http.Request) (int, int) {
+	startIndex, err := strconv.Atoi(r.URL.Query().Get("startIndex"))
+	if err != nil || startIndex < 1 {
+		startIndex = 1
+	}
+
+	count, err := strconv.Atoi(r.URL.Query().Get("count"))
+	if err != nil || count < 0 {
+		count = DefaultCount
+	}
+
+	if count > MaxCount {
+		count = MaxCount
+	}
+
+	return startIndex, count
+}
+
+func paginate[T any](items []T, startIndex, count int) []T {
+	start := startIndex - 1
+	if start >= len(items) {
+		return []T{}
+	}
+
+	end := min(start+count, len(items))
+	return items[start:end]
+}
+
+func newListResponse(resources []any, total, startIndex int) *ListResponse {
+	return &ListResponse{
+		Schemas:      []string{SchemaListResponse},
+		TotalResults: total,
+		StartIndex:   startIndex,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	}
+}
+
+func readJSON(r *http.Request, v any) error {
+	body, err := io.ReadAll(io.LimitReader(r.Body, MaxRequestSize))
+	if err != nil {
+		return badRequest("invalidSyntax", "error reading request body")
+	}
+
+	err = json.Unmarshal(body, v)
+	if err != nil {
+		return badRequest("invalidSyntax", "invalid JSON: "+err.Error())
+	}
+
+	return nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", ContentType)
+	w.WriteHeader(status)
+
+	err := json.NewEncoder(w).Encode(v)
+	if err != nil {
+		log.Errorf("Error writing SCIM response: %v", err)
+	}
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	var e *scimError
+	if !errors.As(err, &e) {
+		log.Errorf("Error handling SCIM request: %v", err)
+		e = &scimError{status: http.StatusInternalServerError, detail: "internal error"}
+	}
+
+	writeJSON(w, e.status, &Error{
+		Schemas:  []string{SchemaError},
+		Status:   strconv.Itoa(e.status),
+		ScimType: e.scimType,
+		Detail:   e.detail,
+	})
+}
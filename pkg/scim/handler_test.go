@@ -0,0 +1,210 @@
+package scim
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/public/middleware"
+	"github.com/superplanehq/superplane/test/support"
+)
+
+func Test__SCIM(t *testing.T) {
+	r := support.Setup(t)
+	orgID := r.Organization.ID.String()
+	owner, err := models.FindActiveUserByID(orgID, r.User.String())
+	require.NoError(t, err)
+
+	handler := NewHandler(r.AuthService, "/api/v1/scim/v2")
+
+	t.Run("unauthenticated request -> 401", func(t *testing.T) {
+		response := execSCIMRequest(handler, nil, "GET", "/Users", nil)
+		assert.Equal(t, http.StatusUnauthorized, response.Code)
+	})
+
+	t.Run("member without permissions -> 403", func(t *testing.T) {
+		viewer := support.CreateUser(t, r, r.Organization.ID)
+		response := execSCIMRequest(handler, viewer, "POST", "/Users", map[string]any{
+			"schemas":  []string{SchemaUser},
+			"userName": "someone@example.com",
+		})
+
+		assert.Equal(t, http.StatusForbidden, response.Code)
+	})
+
+	var userID string
+	t.Run("create user", func(t *testing.T) {
+		response := execSCIMRequest(handler, owner, "POST", "/Users", map[string]any{
+			"schemas":  []string{SchemaUser},
+			"userName": "Jane.Doe@Example.com",
+			"name":     map[string]any{"givenName": "Jane", "familyName": "Doe"},
+		})
+
+		require.Equal(t, http.StatusCreated, response.Code)
+		assert.Equal(t, ContentType, response.Header().Get("Content-Type"))
+
+		user := User{}
+		require.NoError(t, json.Unmarshal(response.Body.Bytes(), &user))
+		assert.Equal(t, "jane.doe@example.com", user.UserName)
+		assert.Equal(t, "Jane Doe", user.DisplayName)
+		require.NotNil(t, user.Active)
+		assert.True(t, *user.Active)
+		userID = user.ID
+
+		roles, err := r.AuthService.GetUserRolesForOrg(userID, orgID)
+		require.NoError(t, err)
+		roleNames := []string{}
+		for _, role := range roles {
+			roleNames = append(roleNames, role.Name)
+		}
+
+		assert.Contains(t, roleNames, models.RoleOrgViewer)
+	})
+
+	t.Run("create existing user -> 409", func(t *testing.T) {
+		response := execSCIMRequest(handler, owner, "POST", "/Users", map[string]any{
+			"schemas":  []string{SchemaUser},
+			"userName": "jane.doe@example.com",
+		})
+
+		assert.Equal(t, http.StatusConflict, response.Code)
+	})
+
+	t.Run("find user by userName", func(t *testing.T) {
+		response := execSCIMRequest(handler, owner, "GET", `/Users?filter=userName+eq+"JANE.DOE@example.com"`, nil)
+		require.Equal(t, http.StatusOK, response.Code)
+
+		list := ListResponse{}
+		require.NoError(t, json.Unmarshal(response.Body.Bytes(), &list))
+		assert.Equal(t, 1, list.TotalResults)
+		require.Len(t, list.Resources, 1)
+	})
+
+	var groupID string
+	t.Run("create group with members and role", func(t *testing.T) {
+		response := execSCIMRequest(handler, owner, "POST", "/Groups", map[string]any{
+			"schemas":            []string{SchemaGroup, SchemaGroupExtension},
+			"displayName":        "Platform Engineers",
+			"members":            []map[string]any{{"value": userID}},
+			SchemaGroupExtension: map[string]any{"role": models.RoleOrgAdmin},
+		})
+
+		require.Equal(t, http.StatusCreated, response.Code)
+
+		group := Group{}
+		require.NoError(t, json.Unmarshal(response.Body.Bytes(), &group))
+		assert.Equal(t, "platform-engineers", group.ID)
+		assert.Equal(t, "Platform Engineers", group.DisplayName)
+		require.Len(t, group.Members, 1)
+		assert.Equal(t, userID, group.Members[0].Value)
+		groupID = group.ID
+
+		role, err := r.AuthService.GetGroupRole(orgID, models.DomainTypeOrganization, groupID)
+		require.NoError(t, err)
+		assert.Equal(t, models.RoleOrgAdmin, role)
+	})
+
+	t.Run("remove member from group", func(t *testing.T) {
+		response := execSCIMRequest(handler, owner, "PATCH", "/Groups/"+groupID, map[string]any{
+			"schemas": []string{SchemaPatchOp},
+			"Operations": []map[string]any{
+				{"op": "remove", "path": `members[value eq "` + userID + `"]`},
+			},
+		})
+
+		require.Equal(t, http.StatusOK, response.Code)
+
+		users, err := r.AuthService.GetGroupUsers(orgID, models.DomainTypeOrganization, groupID)
+		require.NoError(t, err)
+		assert.Empty(t, users)
+	})
+
+	t.Run("deactivate user removes them from the organization and their groups", func(t *testing.T) {
+		require.NoError(t, r.AuthService.AddUserToGroup(orgID, models.DomainTypeOrganization, userID, groupID))
+
+		response := execSCIMRequest(handler, owner, "PATCH", "/Users/"+userID, map[string]any{
+			"schemas": []string{SchemaPatchOp},
+			"Operations": []map[string]any{
+				{"op": "replace", "value": map[string]any{"active": "False"}},
+			},
+		})
+
+		require.Equal(t, http.StatusOK, response.Code)
+
+		user := User{}
+		require.NoError(t, json.Unmarshal(response.Body.Bytes(), &user))
+		require.NotNil(t, user.Active)
+		assert.False(t, *user.Active)
+		assert.Empty(t, user.Groups)
+
+		_, err := models.FindActiveUserByID(orgID, userID)
+		require.Error(t, err)
+
+		users, err := r.AuthService.GetGroupUsers(orgID, models.DomainTypeOrganization, groupID)
+		require.NoError(t, err)
+		assert.Empty(t, users)
+	})
+
+	t.Run("reactivate user restores them", func(t *testing.T) {
+		response := execSCIMRequest(handler, owner, "PUT", "/Users/"+userID, map[string]any{
+			"schemas":  []string{SchemaUser},
+			"userName": "jane.doe@example.com",
+			"active":   true,
+		})
+
+		require.Equal(t, http.StatusOK, response.Code)
+
+		user, err := models.FindActiveUserByID(orgID, userID)
+		require.NoError(t, err)
+		assert.Equal(t, "jane.doe@example.com", user.GetEmail())
+	})
+
+	t.Run("delete group", func(t *testing.T) {
+		response := execSCIMRequest(handler, owner, "DELETE", "/Groups/"+groupID, nil)
+		require.Equal(t, http.StatusNoContent, response.Code)
+
+		response = execSCIMRequest(handler, owner, "GET", "/Groups/"+groupID, nil)
+		assert.Equal(t, http.StatusNotFound, response.Code)
+	})
+
+	t.Run("delete user", func(t *testing.T) {
+		response := execSCIMRequest(handler, owner, "DELETE", "/Users/"+userID, nil)
+		require.Equal(t, http.StatusNoContent, response.Code)
+
+		_, err := models.FindActiveUserByID(orgID, userID)
+		require.Error(t, err)
+	})
+}
+
+func execSCIMRequest(handler *Handler, user *models.User, method, path string, body any) *httptest.ResponseRecorder {
+	router := mux.NewRouter()
+	router.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if user != nil {
+				r = r.WithContext(context.WithValue(r.Context(), middleware.UserContextKey, user))
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	})
+
+	handler.RegisterRoutes(router)
+
+	data := []byte{}
+	if body != nil {
+		data, _ = json.Marshal(body)
+	}
+
+	request := httptest.NewRequest(method, path, bytes.NewReader(data))
+	request.Header.Set("Content-Type", ContentType)
+	response := httptest.NewRecorder()
+	router.ServeHTTP(response, request)
+	return response
+}
@@ -0,0 +1,62 @@
+package scim
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+// patchAttributes returns the attributes changed by a PATCH operation, by path.
+// Operations without a path change the attributes in their value,
+// and attributes of schema extensions are returned as <schema>:<attribute>.
+func patchAttributes(operation PatchOperation) (map[string]json.RawMessage, error) {
+	if operation.Path != "" {
+		return map[string]json.RawMessage{operation.Path: operation.Value}, nil
+	}
+
+	values := map[string]json.RawMessage{}
+	err := json.Unmarshal(operation.Value, &values)
+	if err != nil {
+		return nil, badRequest("invalidValue", "value must be an object when no path is given")
+	}
+
+	attributes := map[string]json.RawMessage{}
+	for name, value := range values {
+		if !strings.HasPrefix(strings.ToLower(name), "urn:") {
+			attributes[name] = value
+			continue
+		}
+
+		extension := map[string]json.RawMessage{}
+		err := json.Unmarshal(value, &extension)
+		if err != nil {
+			attributes[name] = value
+			continue
+		}
+
+		for attribute, v := range extension {
+			attributes[name+":"+attribute] = v
+		}
+	}
+
+	return attributes, nil
+}
+
+// parseBool accepts booleans, and also strings like "False",
+// which some identity providers send for the active attribute.
+func parseBool(value json.RawMessage) (bool, error) {
+	var b bool
+	if err := json.Unmarshal(value, &b); err == nil {
+		return b, nil
+	}
+
+	var s string
+	if err := json.Unmarshal(value, &s); err == nil {
+		b, err := strconv.ParseBool(strings.ToLower(s))
+		if err == nil {
+			return b, nil
+		}
+	}
+
+	return false, badRequest("invalidValue", "expected a boolean, got "+string(value))
+}
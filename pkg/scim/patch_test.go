@@ -0,0 +1,61 @@
+package scim
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test__PatchAttributes(t *testing.T) {
+	t.Run("operation with path", func(t *testing.T) {
+		attributes, err := patchAttributes(PatchOperation{Op: "replace", Path: "active", Value: json.RawMessage(`false`)})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]json.RawMessage{"active": json.RawMessage(`false`)}, attributes)
+	})
+
+	t.Run("operation without path uses the attributes in the value", func(t *testing.T) {
+		attributes, err := patchAttributes(PatchOperation{
+			Op:    "replace",
+			Value: json.RawMessage(`{"displayName":"Jane","active":true}`),
+		})
+
+		require.NoError(t, err)
+		require.Len(t, attributes, 2)
+		assert.JSONEq(t, `"Jane"`, string(attributes["displayName"]))
+		assert.JSONEq(t, `true`, string(attributes["active"]))
+	})
+
+	t.Run("extension attributes are prefixed with the schema", func(t *testing.T) {
+		attributes, err := patchAttributes(PatchOperation{
+			Op:    "replace",
+			Value: json.RawMessage(`{"` + SchemaGroupExtension + `":{"role":"org_admin"}}`),
+		})
+
+		require.NoError(t, err)
+		require.Len(t, attributes, 1)
+		assert.JSONEq(t, `"org_admin"`, string(attributes[SchemaGroupExtension+":role"]))
+	})
+
+	t.Run("value that is not an object without path -> error", func(t *testing.T) {
+		_, err := patchAttributes(PatchOperation{Op: "replace", Value: json.RawMessage(`"Jane"`)})
+		require.ErrorContains(t, err, "value must be an object")
+	})
+}
+
+func Test__ParseBool(t *testing.T) {
+	for value, expected := range map[string]bool{
+		`true`:    true,
+		`false`:   false,
+		`"True"`:  true,
+		`"False"`: false,
+	} {
+		b, err := parseBool(json.RawMessage(value))
+		require.NoError(t, err, value)
+		assert.Equal(t, expected, b, value)
+	}
+
+	_, err := parseBool(json.RawMessage(`"maybe"`))
+	require.ErrorContains(t, err, "expected a boolean")
+}
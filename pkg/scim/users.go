@@ -0,0 +1,373 @@
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/grpc/actions/organizations"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func (h *Handler) listUsers(w http.ResponseWriter, r *http.Request, caller *models.User) error {
+	filter, err := ParseFilter(r.URL.Query().Get("filter"))
+	if err != nil {
+		return err
+	}
+
+	//
+	// userName is not case-sensitive, and SuperPlane stores normalized emails.
+	//
+	if filter != nil && strings.EqualFold(filter.Attribute, "userName") {
+		filter.Value = utils.NormalizeEmail(filter.Value)
+	}
+
+	orgID := caller.OrganizationID.String()
+	users, err := models.ListMaybeDeletedHumanUsersByOrganization(orgID)
+	if err != nil {
+		return err
+	}
+
+	matching := []models.User{}
+	for _, user := range users {
+		ok, err := filter.Matches(map[string]string{
+			"id":          user.ID.String(),
+			"userName":    user.GetEmail(),
+			"displayName": user.Name,
+		})
+		if err != nil {
+			return err
+		}
+
+		if ok {
+			matching = append(matching, user)
+		}
+	}
+
+	memberships, err := h.groupMemberships(orgID)
+	if err != nil {
+		return err
+	}
+
+	startIndex, count := pagination(r)
+	resources := []any{}
+	for _, user := range paginate(matching, startIndex, count) {
+		resources = append(resources, h.toSCIMUser(&user, memberships[user.ID.String()]))
+	}
+
+	writeJSON(w, http.StatusOK, newListResponse(resources, len(matching), startIndex))
+	return nil
+}
+
+func (h *Handler) getUser(w http.ResponseWriter, r *http.Request, caller *models.User) error {
+	user, err := h.findUser(caller.OrganizationID.String(), mux.Vars(r)["id"])
+	if err != nil {
+		return err
+	}
+
+	return h.writeUser(w, http.StatusOK, user)
+}
+
+// createUser adds a member to the organization, with the org_viewer role.
+// The SuperPlane account is created if there is none for the email yet,
+// and members removed before are restored.
+func (h *Handler) createUser(w http.ResponseWriter, r *http.Request, caller *models.User) error {
+	var request User
+	err := readJSON(r, &request)
+	if err != nil {
+		return err
+	}
+
+	email := utils.NormalizeEmail(request.Email())
+	if email == "" {
+		return badRequest("invalidValue", "userName is required")
+	}
+
+	name := request.FullName()
+	if name == "" {
+		name = email
+	}
+
+	orgID := caller.OrganizationID.String()
+	existing, err := models.FindMaybeDeletedUserByEmail(orgID, email)
+	if err == nil && !existing.DeletedAt.Valid {
+		return conflict("user " + email + " already exists")
+	}
+
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+
+	user, err := h.provisionUser(caller.OrganizationID, email, name)
+	if err != nil {
+		return err
+	}
+
+	//
+	// Identity providers can create users that are not assigned yet.
+	//
+	if request.Active != nil && !*request.Active {
+		err = h.deprovisionUser(r.Context(), orgID, user)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Infof("SCIM: provisioned user %s in organization %s", user.ID.String(), orgID)
+	return h.writeUser(w, http.StatusCreated, user)
+}
+
+func (h *Handler) replaceUser(w http.ResponseWriter, r *http.Request, caller *models.User) error {
+	var request User
+	err := readJSON(r, &request)
+	if err != nil {
+		return err
+	}
+
+	user, err := h.findUser(caller.OrganizationID.String(), mux.Vars(r)["id"])
+	if err != nil {
+		return err
+	}
+
+	email := utils.NormalizeEmail(request.Email())
+	if email != "" && email != user.GetEmail() {
+		return badRequest("mutability", "userName cannot be changed")
+	}
+
+	active := request.Active == nil || *request.Active
+	user, err = h.updateUser(r.Context(), user, request.FullName(), active)
+	if err != nil {
+		return err
+	}
+
+	return h.writeUser(w, http.StatusOK, user)
+}
+
+func (h *Handler) patchUser(w http.ResponseWriter, r *http.Request, caller *models.User) error {
+	var request PatchRequest
+	err := readJSON(r, &request)
+	if err != nil {
+		return err
+	}
+
+	user, err := h.findUser(caller.OrganizationID.String(), mux.Vars(r)["id"])
+	if err != nil {
+		return err
+	}
+
+	name := ""
+	active := !user.DeletedAt.Valid
+	for _, operation := range request.Operations {
+		if !strings.EqualFold(operation.Op, "replace") && !strings.EqualFold(operation.Op, "add") {
+			return badRequest("invalidValue", "unsupported operation "+operation.Op+" for users")
+		}
+
+		attributes, err := patchAttributes(operation)
+		if err != nil {
+			return err
+		}
+
+		//
+		// Attributes SuperPlane does not store, like titles or phone numbers, are ignored,
+		// so identity providers can keep sending them.
+		//
+		for path, value := range attributes {
+			switch strings.ToLower(path) {
+			case "active":
+				active, err = parseBool(value)
+				if err != nil {
+					return err
+				}
+
+			case "displayname", "name.formatted":
+				err = json.Unmarshal(value, &name)
+				if err != nil {
+					return badRequest("invalidValue", path+" must be a string")
+				}
+
+			case "name":
+				var n Name
+				err = json.Unmarshal(value, &n)
+				if err != nil {
+					return badRequest("invalidValue", "name must be an object")
+				}
+
+				if name == "" {
+					name = (&User{Name: &n}).FullName()
+				}
+			}
+		}
+	}
+
+	user, err = h.updateUser(r.Context(), user, name, active)
+	if err != nil {
+		return err
+	}
+
+	return h.writeUser(w, http.StatusOK, user)
+}
+
+func (h *Handler) deleteUser(w http.ResponseWriter, r *http.Request, caller *models.User) error {
+	orgID := caller.OrganizationID.String()
+	user, err := h.findUser(orgID, mux.Vars(r)["id"])
+	if err != nil {
+		return err
+	}
+
+	if !user.DeletedAt.Valid {
+		err = h.deprovisionUser(r.Context(), orgID, user)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Infof("SCIM: deleted user %s from organization %s", user.ID.String(), orgID)
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (h *Handler) updateUser(ctx context.Context, user *models.User, name string, active bool) (*models.User, error) {
+	orgID := user.OrganizationID.String()
+
+	if name != "" && name != user.Name {
+		err := user.UpdateName(name)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if active && user.DeletedAt.Valid {
+		log.Infof("SCIM: reactivating user %s in organization %s", user.ID.String(), orgID)
+		return h.provisionUser(user.OrganizationID, user.GetEmail(), user.Name)
+	}
+
+	if !active && !user.DeletedAt.Valid {
+		log.Infof("SCIM: deactivating user %s in organization %s", user.ID.String(), orgID)
+		err := h.deprovisionUser(ctx, orgID, user)
+		if err != nil {
+			return nil, err
+		}
+
+		return h.findUser(orgID, user.ID.String())
+	}
+
+	return user, nil
+}
+
+func (h *Handler) provisionUser(orgID uuid.UUID, email, name string) (*models.User, error) {
+	account, err := models.FindAccountByEmail(email)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	tx := database.Conn().Begin()
+	if account == nil {
+		account, err = models.CreateAccountInTransaction(tx, name, email)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	user, err := models.FindMaybeDeletedUserByEmailInTransaction(tx, orgID.String(), email)
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		user, err = models.CreateUserInTransaction(tx, orgID, account.ID, email, name)
+	case err == nil:
+		err = user.RestoreInTransaction(tx)
+		user.DeletedAt = gorm.DeletedAt{}
+	}
+
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	err = h.authService.AssignRole(user.ID.String(), models.RoleOrgViewer, orgID.String(), models.DomainTypeOrganization)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	return user, tx.Commit().Error
+}
+
+// deprovisionUser removes a member from the organization, like removing them in the UI,
+// and also removes them from their groups, so they get no access back
+// if they are provisioned again, until the identity provider adds them to groups again.
+func (h *Handler) deprovisionUser(ctx context.Context, orgID string, user *models.User) error {
+	memberships, err := h.groupMemberships(orgID)
+	if err != nil {
+		return err
+	}
+
+	for _, group := range memberships[user.ID.String()] {
+		err = h.authService.RemoveUserFromGroup(orgID, models.DomainTypeOrganization, user.ID.String(), group.Value)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = organizations.RemoveUser(ctx, h.authService, orgID, user.ID.String())
+	if status.Code(err) == codes.FailedPrecondition {
+		return badRequest("mutability", "cannot deactivate the last organization owner")
+	}
+
+	return err
+}
+
+func (h *Handler) findUser(orgID, id string) (*models.User, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, notFound("user not found")
+	}
+
+	user, err := models.FindMaybeDeletedUserByID(orgID, id)
+	if err != nil || user.IsServiceAccount() {
+		return nil, notFound("user not found")
+	}
+
+	return user, nil
+}
+
+func (h *Handler) writeUser(w http.ResponseWriter, status int, user *models.User) error {
+	memberships, err := h.groupMemberships(user.OrganizationID.String())
+	if err != nil {
+		return err
+	}
+
+	writeJSON(w, status, h.toSCIMUser(user, memberships[user.ID.String()]))
+	return nil
+}
+
+func (h *Handler) toSCIMUser(user *models.User, groups []Reference) *User {
+	active := !user.DeletedAt.Valid
+	createdAt := user.CreatedAt
+	updatedAt := user.UpdatedAt
+
+	return &User{
+		Schemas:     []string{SchemaUser},
+		ID:          user.ID.String(),
+		UserName:    user.GetEmail(),
+		Name:        &Name{Formatted: user.Name},
+		DisplayName: user.Name,
+		Emails:      []Email{{Value: user.GetEmail(), Type: "work", Primary: true}},
+		Active:      &active,
+		Groups:      groups,
+		Meta: &Meta{
+			ResourceType: "User",
+			Created:      &createdAt,
+			LastModified: &updatedAt,
+			Location:     h.location("Users", user.ID.String()),
+		},
+	}
+}
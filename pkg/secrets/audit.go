@@ -0,0 +1,70 @@
+package secrets
+
+import (
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	"google.golang.org/grpc/codes"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+//
+// Changes to secrets made through the API are recorded in the audit log
+// by the authorization interceptor, like other API calls.
+// The events here are for what canvases do with secrets.
+//
+
+const (
+	AuditResourceType = "secrets"
+	AuditActionRead   = "read"
+	AuditActionRotate = "rotate"
+)
+
+// RecordRead records a read of a secret kept outside of SuperPlane by a canvas,
+// including the ones served from a cache, so the audit log shows
+// which secrets were used and when. Failing to record it does not fail the read, but is logged.
+func RecordRead(organizationID, canvasID uuid.UUID, record *models.Secret, readErr error) {
+	event := newAuditEvent(organizationID, canvasID, record, AuditActionRead)
+	if readErr != nil {
+		event.Status = codes.Unknown.String()
+		event.Response = datatypes.NewJSONType(map[string]any{"error": readErr.Error()})
+	}
+
+	err := models.CreateAuditEventInTransaction(database.Conn(), event)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"audit":           "audit_event_failed",
+			"organization_id": organizationID.String(),
+			"secret_id":       record.ID.String(),
+		}).WithError(err).Error("Error recording secret read")
+	}
+}
+
+// RecordRotation records a rotation of a secret by a canvas in the transaction of the rotation.
+// Values are never recorded, only the version the secret is on after the rotation.
+func RecordRotation(tx *gorm.DB, organizationID, canvasID uuid.UUID, record *models.Secret) error {
+	event := newAuditEvent(organizationID, canvasID, record, AuditActionRotate)
+	event.Response = datatypes.NewJSONType(map[string]any{"version": record.ActiveVersion})
+	return models.CreateAuditEventInTransaction(tx, event)
+}
+
+func newAuditEvent(organizationID, canvasID uuid.UUID, record *models.Secret, action string) *models.AuditEvent {
+	return &models.AuditEvent{
+		OrganizationID: organizationID,
+		ResourceType:   AuditResourceType,
+		ResourceID:     record.ID.String(),
+		ResourceName:   record.Name,
+		Action:         action,
+		DomainType:     models.DomainTypeCanvas,
+		DomainID:       canvasID.String(),
+		Status:         codes.OK.String(),
+		Request: datatypes.NewJSONType(map[string]any{
+			"provider":   record.Provider,
+			"domainType": record.DomainType,
+			"domainId":   record.DomainID.String(),
+		}),
+		Response: datatypes.NewJSONType(map[string]any{}),
+	}
+}
@@ -0,0 +1,156 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/superplanehq/superplane/pkg/models"
+)
+
+var envVariableNameRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// EnvReference maps the keys of a secret to the names
+// of the environment variables holding their values.
+type EnvReference struct {
+	Variables map[string]string `json:"variables"`
+}
+
+func (r EnvReference) Validate() error {
+	if len(r.Variables) == 0 {
+		return fmt.Errorf("at least one environment variable is required")
+	}
+
+	for key, variable := range r.Variables {
+		if strings.TrimSpace(key) == "" {
+			return fmt.Errorf("secret keys cannot be empty")
+		}
+
+		if !envVariableNameRegex.MatchString(variable) {
+			return fmt.Errorf("invalid environment variable name %q for key %s", variable, key)
+		}
+	}
+
+	return nil
+}
+
+func ParseEnvReference(data []byte) (*EnvReference, error) {
+	var reference EnvReference
+	if err := json.Unmarshal(data, &reference); err != nil {
+		return nil, fmt.Errorf("invalid env reference: %v", err)
+	}
+
+	if err := reference.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &reference, nil
+}
+
+// EnvBackend reads secrets from the environment of the process.
+// Only variables starting with the configured prefix can be read,
+// so secrets cannot expose the configuration of SuperPlane itself.
+// Each organization can only read the variables below its own prefix, see Prefix.
+type EnvBackend struct {
+	prefix string
+	lookup func(string) (string, bool)
+}
+
+func NewEnvBackend(prefix string) *EnvBackend {
+	return &EnvBackend{
+		prefix: prefix,
+		lookup: os.LookupEnv,
+	}
+}
+
+// NewEnvBackendFromEnv configures the env provider from SECRETS_ENV_PREFIX.
+// If it is not set, env secrets are disabled and nil is returned.
+func NewEnvBackendFromEnv() *EnvBackend {
+	prefix := os.Getenv("SECRETS_ENV_PREFIX")
+	if prefix == "" {
+		return nil
+	}
+
+	return NewEnvBackend(prefix)
+}
+
+// Prefix returns the prefix of the variables the organization can read:
+// the configured prefix followed by the organization ID, in upper case and
+// with underscores instead of dashes, e.g. SUPERPLANE_SECRET_<ORGANIZATION_ID>_.
+func (b *EnvBackend) Prefix(organizationID string) string {
+	return b.prefix + strings.ToUpper(strings.ReplaceAll(organizationID, "-", "_")) + "_"
+}
+
+// Check returns an error if the organization cannot read one of the referenced variables.
+func (b *EnvBackend) Check(organizationID string, reference EnvReference) error {
+	if organizationID == "" {
+		return fmt.Errorf("env secrets require an organization")
+	}
+
+	prefix := b.Prefix(organizationID)
+	for _, variable := range reference.Variables {
+		if !strings.HasPrefix(variable, prefix) {
+			return fmt.Errorf("environment variable %s is not allowed, variables of this organization must start with %s", variable, prefix)
+		}
+	}
+
+	return nil
+}
+
+func (b *EnvBackend) Read(variables map[string]string) (map[string]string, error) {
+	values := make(map[string]string, len(variables))
+	for key, variable := range variables {
+		if !strings.HasPrefix(variable, b.prefix) {
+			return nil, fmt.Errorf("environment variable %s does not start with %s", variable, b.prefix)
+		}
+
+		value, ok := b.lookup(variable)
+		if !ok {
+			return nil, fmt.Errorf("environment variable %s is not set", variable)
+		}
+
+		values[key] = value
+	}
+
+	return values, nil
+}
+
+type EnvProvider struct {
+	backend        *EnvBackend
+	record         *models.Secret
+	organizationID string
+}
+
+func NewEnvProvider(backend *EnvBackend, record *models.Secret, organizationID string) *EnvProvider {
+	return &EnvProvider{
+		backend:        backend,
+		record:         record,
+		organizationID: organizationID,
+	}
+}
+
+func (p *EnvProvider) Load(ctx context.Context) (map[string]string, error) {
+	reference, err := ParseEnvReference(p.record.Data)
+	if err != nil {
+		return nil, fmt.Errorf("error loading secret %s: %v", p.record.Name, err)
+	}
+
+	//
+	// Also checked here, so secrets saved before SECRETS_ENV_PREFIX
+	// changed cannot read variables of other organizations.
+	//
+	err = p.backend.Check(p.organizationID, *reference)
+	if err != nil {
+		return nil, fmt.Errorf("error loading secret %s: %v", p.record.Name, err)
+	}
+
+	values, err := p.backend.Read(reference.Variables)
+	if err != nil {
+		return nil, err
+	}
+
+	return values, nil
+}
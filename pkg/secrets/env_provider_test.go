@@ -0,0 +1,74 @@
+package secrets
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/models"
+)
+
+func Test__EnvProvider(t *testing.T) {
+	organizationID := "6f1c2a9e-8d4b-4c3a-9f0e-2b7d5a1c3e4f"
+	t.Setenv("SUPERPLANE_SECRET_6F1C2A9E_8D4B_4C3A_9F0E_2B7D5A1C3E4F_GITHUB_TOKEN", "abc")
+	t.Setenv("SUPERPLANE_SECRET_GITHUB_TOKEN", "shared")
+	t.Setenv("DB_PASSWORD", "hunter2")
+	backend := NewEnvBackend("SUPERPLANE_SECRET_")
+
+	t.Run("reads mapped variables", func(t *testing.T) {
+		provider := NewEnvProvider(backend, &models.Secret{
+			Name: "github",
+			Data: []byte(`{"variables": {"token": "SUPERPLANE_SECRET_6F1C2A9E_8D4B_4C3A_9F0E_2B7D5A1C3E4F_GITHUB_TOKEN"}}`),
+		}, organizationID)
+
+		values, err := provider.Load(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"token": "abc"}, values)
+	})
+
+	t.Run("variables without the organization prefix -> error", func(t *testing.T) {
+		provider := NewEnvProvider(backend, &models.Secret{
+			Name: "github",
+			Data: []byte(`{"variables": {"token": "SUPERPLANE_SECRET_GITHUB_TOKEN"}}`),
+		}, organizationID)
+
+		_, err := provider.Load(context.Background())
+		require.ErrorContains(t, err, "must start with SUPERPLANE_SECRET_6F1C2A9E_8D4B_4C3A_9F0E_2B7D5A1C3E4F_")
+	})
+
+	t.Run("variables of other organizations -> error", func(t *testing.T) {
+		provider := NewEnvProvider(backend, &models.Secret{
+			Name: "github",
+			Data: []byte(`{"variables": {"token": "SUPERPLANE_SECRET_6F1C2A9E_8D4B_4C3A_9F0E_2B7D5A1C3E4F_GITHUB_TOKEN"}}`),
+		}, "0a9b8c7d-6e5f-4a3b-2c1d-0e9f8a7b6c5d")
+
+		_, err := provider.Load(context.Background())
+		require.ErrorContains(t, err, "is not allowed")
+	})
+
+	t.Run("variables without the prefix -> error", func(t *testing.T) {
+		provider := NewEnvProvider(backend, &models.Secret{
+			Name: "db",
+			Data: []byte(`{"variables": {"password": "DB_PASSWORD"}}`),
+		}, organizationID)
+
+		_, err := provider.Load(context.Background())
+		require.ErrorContains(t, err, "is not allowed")
+	})
+
+	t.Run("unset variables -> error", func(t *testing.T) {
+		provider := NewEnvProvider(backend, &models.Secret{
+			Name: "missing",
+			Data: []byte(`{"variables": {"token": "SUPERPLANE_SECRET_6F1C2A9E_8D4B_4C3A_9F0E_2B7D5A1C3E4F_MISSING"}}`),
+		}, organizationID)
+
+		_, err := provider.Load(context.Background())
+		require.ErrorContains(t, err, "SUPERPLANE_SECRET_6F1C2A9E_8D4B_4C3A_9F0E_2B7D5A1C3E4F_MISSING is not set")
+	})
+
+	t.Run("invalid variable names are rejected", func(t *testing.T) {
+		err := EnvReference{Variables: map[string]string{"token": "NOT-VALID"}}.Validate()
+		require.Error(t, err)
+	})
+}
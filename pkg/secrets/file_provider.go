@@ -0,0 +1,198 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/superplanehq/superplane/pkg/models"
+)
+
+// DefaultFileSecretsPathPrefix puts the secrets of each organization
+// in a directory named after it, below the file provider root.
+const DefaultFileSecretsPathPrefix = organizationIDPlaceholder
+
+// FileReference points a secret to a directory below the file provider root.
+// Every file in the directory is a key of the secret, which is
+// the layout of a Kubernetes secret mounted as a volume.
+type FileReference struct {
+	Path string `json:"path"`
+}
+
+func (r FileReference) Validate() error {
+	if r.Path == "" {
+		return fmt.Errorf("file path is required")
+	}
+
+	if !filepath.IsLocal(r.Path) {
+		return fmt.Errorf("file path must be relative to the secrets directory")
+	}
+
+	return nil
+}
+
+func ParseFileReference(data []byte) (*FileReference, error) {
+	var reference FileReference
+	if err := json.Unmarshal(data, &reference); err != nil {
+		return nil, fmt.Errorf("invalid file reference: %v", err)
+	}
+
+	if err := reference.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &reference, nil
+}
+
+// FileBackend reads secrets from directories below a root directory.
+// Every organization can only read the directories below its own prefix,
+// since all the secrets are mounted in the same filesystem.
+type FileBackend struct {
+	root string
+
+	//
+	// PathPrefix is the directory below the root where the secrets of an organization are,
+	// with {organization_id} replaced by the ID of the organization.
+	//
+	PathPrefix string
+}
+
+func NewFileBackend(root string) (*FileBackend, error) {
+	info, err := os.Stat(root)
+	if err != nil {
+		return nil, fmt.Errorf("error opening secrets directory %s: %v", root, err)
+	}
+
+	if !info.IsDir() {
+		return nil, fmt.Errorf("secrets directory %s is not a directory", root)
+	}
+
+	return &FileBackend{root: root, PathPrefix: DefaultFileSecretsPathPrefix}, nil
+}
+
+// NewFileBackendFromEnv configures the file provider from SECRETS_FILE_ROOT
+// and SECRETS_FILE_PATH_PREFIX. If the root is not set, file secrets are disabled and nil is returned.
+func NewFileBackendFromEnv() (*FileBackend, error) {
+	root := os.Getenv("SECRETS_FILE_ROOT")
+	if root == "" {
+		return nil, nil
+	}
+
+	backend, err := NewFileBackend(root)
+	if err != nil {
+		return nil, err
+	}
+
+	if value := os.Getenv("SECRETS_FILE_PATH_PREFIX"); value != "" {
+		backend.PathPrefix = strings.Trim(value, "/")
+	}
+
+	if !strings.Contains(backend.PathPrefix, organizationIDPlaceholder) {
+		return nil, fmt.Errorf("secrets file path prefix %q must include %s", backend.PathPrefix, organizationIDPlaceholder)
+	}
+
+	return backend, nil
+}
+
+// Prefix returns the directory below which the secrets of the organization must be.
+func (b *FileBackend) Prefix(organizationID string) string {
+	return strings.ReplaceAll(b.PathPrefix, organizationIDPlaceholder, organizationID)
+}
+
+// Check returns an error if the organization cannot read the referenced directory.
+func (b *FileBackend) Check(organizationID string, reference FileReference) error {
+	if organizationID == "" {
+		return fmt.Errorf("file secrets require an organization")
+	}
+
+	secretPath := filepath.ToSlash(reference.Path)
+	if path.Clean(secretPath) != secretPath {
+		return fmt.Errorf("file path %s is not a clean path", reference.Path)
+	}
+
+	prefix := b.Prefix(organizationID)
+	if !strings.HasPrefix(secretPath, prefix+"/") {
+		return fmt.Errorf("file path %s is not allowed, secrets of this organization must be below %s", secretPath, prefix)
+	}
+
+	return nil
+}
+
+func (b *FileBackend) Read(path string) (map[string]string, error) {
+	dir := filepath.Join(b.root, path)
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("error reading secrets directory %s: %v", path, err)
+	}
+
+	values := map[string]string{}
+	for _, entry := range entries {
+		//
+		// Kubernetes keeps the real files in hidden directories
+		// and exposes each key as a symlink to them.
+		//
+		if strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+
+		file := filepath.Join(dir, entry.Name())
+		info, err := os.Stat(file)
+		if err != nil {
+			return nil, fmt.Errorf("error reading %s/%s: %v", path, entry.Name(), err)
+		}
+
+		if !info.Mode().IsRegular() {
+			continue
+		}
+
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("error reading %s/%s: %v", path, entry.Name(), err)
+		}
+
+		values[entry.Name()] = string(content)
+	}
+
+	return values, nil
+}
+
+type FileProvider struct {
+	backend        *FileBackend
+	record         *models.Secret
+	organizationID string
+}
+
+func NewFileProvider(backend *FileBackend, record *models.Secret, organizationID string) *FileProvider {
+	return &FileProvider{
+		backend:        backend,
+		record:         record,
+		organizationID: organizationID,
+	}
+}
+
+func (p *FileProvider) Load(ctx context.Context) (map[string]string, error) {
+	reference, err := ParseFileReference(p.record.Data)
+	if err != nil {
+		return nil, fmt.Errorf("error loading secret %s: %v", p.record.Name, err)
+	}
+
+	//
+	// SECRETS_FILE_PATH_PREFIX may have changed since the secret
+	// was created, so the reference is checked again on every read.
+	//
+	err = p.backend.Check(p.organizationID, *reference)
+	if err != nil {
+		return nil, fmt.Errorf("error loading secret %s: %v", p.record.Name, err)
+	}
+
+	values, err := p.backend.Read(reference.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	return values, nil
+}
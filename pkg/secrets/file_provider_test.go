@@ -0,0 +1,69 @@
+package secrets
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/models"
+)
+
+func Test__FileProvider(t *testing.T) {
+	root := t.TempDir()
+	organizationID := uuid.NewString()
+
+	//
+	// Same layout as a Kubernetes secret volume:
+	// keys are symlinks to files in a hidden directory.
+	//
+	dir := filepath.Join(root, organizationID, "github")
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "..data"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "..data", "token"), []byte("abc"), 0o600))
+	require.NoError(t, os.Symlink(filepath.Join("..data", "token"), filepath.Join(dir, "token")))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "user"), []byte("bot"), 0o600))
+
+	backend, err := NewFileBackend(root)
+	require.NoError(t, err)
+
+	t.Run("reads every file in the directory", func(t *testing.T) {
+		provider := NewFileProvider(backend, &models.Secret{Name: "github", Data: []byte(`{"path": "` + organizationID + `/github"}`)}, organizationID)
+		values, err := provider.Load(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"token": "abc", "user": "bot"}, values)
+	})
+
+	t.Run("missing directory -> error", func(t *testing.T) {
+		provider := NewFileProvider(backend, &models.Secret{Name: "missing", Data: []byte(`{"path": "` + organizationID + `/missing"}`)}, organizationID)
+		_, err := provider.Load(context.Background())
+		require.Error(t, err)
+	})
+
+	t.Run("directories of other organizations -> error", func(t *testing.T) {
+		provider := NewFileProvider(backend, &models.Secret{Name: "github", Data: []byte(`{"path": "` + organizationID + `/github"}`)}, uuid.NewString())
+		_, err := provider.Load(context.Background())
+		require.ErrorContains(t, err, "is not allowed")
+	})
+
+	t.Run("directories outside of the organization prefix -> error", func(t *testing.T) {
+		for _, path := range []string{"github", organizationID, organizationID + "/../other/github", organizationID + "/./github"} {
+			err := backend.Check(organizationID, FileReference{Path: path})
+			assert.Error(t, err, path)
+		}
+	})
+
+	t.Run("paths outside of the root are rejected", func(t *testing.T) {
+		for _, path := range []string{"", "../etc", "/etc"} {
+			err := FileReference{Path: path}.Validate()
+			assert.Error(t, err, path)
+		}
+	})
+
+	t.Run("root must be a directory", func(t *testing.T) {
+		_, err := NewFileBackend(filepath.Join(dir, "user"))
+		require.Error(t, err)
+	})
+}
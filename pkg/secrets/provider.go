@@ -12,6 +12,9 @@ import (
 
 const (
 	ProviderLocal = "local"
+	ProviderVault = "vault"
+	ProviderFile  = "file"
+	ProviderEnv   = "env"
 )
 
 type Provider interface {
@@ -24,16 +27,104 @@ type Options struct {
 	Encryptor  crypto.Encryptor
 }
 
-func NewProvider(tx *gorm.DB, encryptor crypto.Encryptor, name, domainType string, domainID uuid.UUID) (Provider, error) {
+// Backends holds the clients of the secret providers
+// which keep secret values outside of SuperPlane.
+// Backends that are not configured are nil.
+type Backends struct {
+	Vault *VaultClient
+	File  *FileBackend
+	Env   *EnvBackend
+}
+
+func NewBackendsFromEnv() (*Backends, error) {
+	vault, err := NewVaultClientFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := NewFileBackendFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Backends{
+		Vault: vault,
+		File:  file,
+		Env:   NewEnvBackendFromEnv(),
+	}, nil
+}
+
+func NewProvider(tx *gorm.DB, encryptor crypto.Encryptor, backends *Backends, name, domainType string, domainID uuid.UUID) (Provider, error) {
 	secret, err := models.FindSecretByNameInTransaction(tx, domainType, domainID, name)
 	if err != nil {
 		return nil, fmt.Errorf("error finding secret %s: %v", name, err)
 	}
 
+	return NewProviderForSecret(tx, encryptor, backends, secret)
+}
+
+func NewProviderForSecret(tx *gorm.DB, encryptor crypto.Encryptor, backends *Backends, secret *models.Secret) (Provider, error) {
 	switch secret.Provider {
 	case ProviderLocal:
 		return NewLocalProvider(tx, encryptor, secret), nil
+	case ProviderVault:
+		if backends == nil || backends.Vault == nil {
+			return nil, fmt.Errorf("secret %s uses vault, but vault is not configured", secret.Name)
+		}
+
+		organizationID, err := SecretOrganizationID(tx, secret)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewVaultProvider(backends.Vault, secret, organizationID.String()), nil
+	case ProviderFile:
+		if backends == nil || backends.File == nil {
+			return nil, fmt.Errorf("secret %s uses files, but the file provider is not configured", secret.Name)
+		}
+
+		organizationID, err := SecretOrganizationID(tx, secret)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewFileProvider(backends.File, secret, organizationID.String()), nil
+	case ProviderEnv:
+		if backends == nil || backends.Env == nil {
+			return nil, fmt.Errorf("secret %s uses environment variables, but the env provider is not configured", secret.Name)
+		}
+
+		organizationID, err := SecretOrganizationID(tx, secret)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewEnvProvider(backends.Env, secret, organizationID.String()), nil
 	default:
 		return nil, fmt.Errorf("provider not supported: %s", secret.Provider)
 	}
 }
+
+// ReadsRemotely reports whether reading the secret calls a remote system.
+// These secrets are read before transactions start, so the reads do not hold them open.
+func ReadsRemotely(secret *models.Secret) bool {
+	return secret.Provider == ProviderVault
+}
+
+// SecretOrganizationID returns the organization the secret belongs to,
+// directly or through its canvas.
+func SecretOrganizationID(tx *gorm.DB, secret *models.Secret) (uuid.UUID, error) {
+	switch secret.DomainType {
+	case models.DomainTypeOrganization:
+		return secret.DomainID, nil
+	case models.DomainTypeCanvas:
+		canvas, err := models.FindCanvasWithoutOrgScopeInTransaction(tx, secret.DomainID)
+		if err != nil {
+			return uuid.Nil, fmt.Errorf("error finding canvas of secret %s: %v", secret.Name, err)
+		}
+
+		return canvas.OrganizationID, nil
+	default:
+		return uuid.Nil, fmt.Errorf("unsupported domain type %s for secret %s", secret.DomainType, secret.Name)
+	}
+}
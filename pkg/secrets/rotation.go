@@ -0,0 +1,48 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/models"
+	"gorm.io/gorm"
+)
+
+// RotateLocalSecret stores new values for a local secret as a new version
+// and activates it. The previous versions are kept, so the rotation can be rolled back.
+func RotateLocalSecret(
+	ctx context.Context,
+	tx *gorm.DB,
+	encryptor crypto.Encryptor,
+	secret *models.Secret,
+	values map[string]string,
+	rotatedBy *uuid.UUID,
+) (*models.SecretVersion, error) {
+	if secret.Provider != ProviderLocal {
+		return nil, fmt.Errorf("values of %s secrets are rotated by the provider", secret.Provider)
+	}
+
+	if len(values) == 0 {
+		return nil, fmt.Errorf("at least one key is required")
+	}
+
+	data, err := json.Marshal(values)
+	if err != nil {
+		return nil, err
+	}
+
+	encrypted, err := encryptor.Encrypt(ctx, data, []byte(secret.Name))
+	if err != nil {
+		return nil, fmt.Errorf("error encrypting secret %s: %v", secret.Name, err)
+	}
+
+	version, err := secret.UpdateDataInTransaction(tx, encrypted, rotatedBy, models.SecretVersionReasonRotated)
+	if err != nil {
+		return nil, fmt.Errorf("error rotating secret %s: %v", secret.Name, err)
+	}
+
+	return version, nil
+}
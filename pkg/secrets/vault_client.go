@@ -0,0 +1,339 @@
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultVaultCacheTTL     = 30 * time.Second
+	DefaultVaultAppRoleMount = "approle"
+
+	//
+	// AppRole tokens are renewed a bit before they expire,
+	// so requests in flight do not use an expired token.
+	//
+	vaultTokenExpiryMargin = 10 * time.Second
+)
+
+type VaultClientOptions struct {
+	Address      string
+	Namespace    string
+	Token        string
+	RoleID       string
+	SecretID     string
+	AppRoleMount string
+	CacheTTL     time.Duration
+	HTTPClient   *http.Client
+	Policy       *VaultPolicy
+}
+
+// VaultClient reads secrets from the KV v2 engine of a HashiCorp Vault server,
+// and gives access to the rest of the Vault API for other engines, like Transit.
+// It authenticates with a static token or with AppRole,
+// and caches the values it reads for a short time.
+type VaultClient struct {
+	options VaultClientOptions
+	now     func() time.Time
+
+	mu          sync.Mutex
+	token       string
+	tokenExpiry time.Time
+	cache       map[string]vaultCacheEntry
+}
+
+type vaultCacheEntry struct {
+	values    map[string]string
+	expiresAt time.Time
+}
+
+func NewVaultClient(options VaultClientOptions) (*VaultClient, error) {
+	if options.Address == "" {
+		return nil, fmt.Errorf("vault address is required")
+	}
+
+	if options.Token == "" && (options.RoleID == "" || options.SecretID == "") {
+		return nil, fmt.Errorf("vault token or AppRole credentials are required")
+	}
+
+	if options.AppRoleMount == "" {
+		options.AppRoleMount = DefaultVaultAppRoleMount
+	}
+
+	if options.CacheTTL == 0 {
+		options.CacheTTL = DefaultVaultCacheTTL
+	}
+
+	if options.HTTPClient == nil {
+		options.HTTPClient = &http.Client{Timeout: 10 * time.Second}
+	}
+
+	if options.Policy == nil {
+		options.Policy = &VaultPolicy{
+			Mounts:     []string{DefaultVaultSecretsMount},
+			PathPrefix: DefaultVaultSecretsPathPrefix,
+		}
+	}
+
+	if err := options.Policy.validate(); err != nil {
+		return nil, err
+	}
+
+	options.Address = strings.TrimSuffix(options.Address, "/")
+
+	return &VaultClient{
+		options: options,
+		now:     time.Now,
+		token:   options.Token,
+		cache:   map[string]vaultCacheEntry{},
+	}, nil
+}
+
+// NewVaultClientFromEnv configures the Vault client from VAULT_* variables.
+// If VAULT_ADDR is not set, Vault secrets are disabled and nil is returned.
+func NewVaultClientFromEnv() (*VaultClient, error) {
+	address := os.Getenv("VAULT_ADDR")
+	if address == "" {
+		return nil, nil
+	}
+
+	cacheTTL := DefaultVaultCacheTTL
+	if value := os.Getenv("VAULT_CACHE_TTL"); value != "" {
+		ttl, err := time.ParseDuration(value)
+		if err != nil || ttl < 0 {
+			return nil, fmt.Errorf("invalid VAULT_CACHE_TTL %q", value)
+		}
+
+		cacheTTL = ttl
+	}
+
+	policy, err := NewVaultPolicyFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewVaultClient(VaultClientOptions{
+		Address:      address,
+		Namespace:    os.Getenv("VAULT_NAMESPACE"),
+		Token:        os.Getenv("VAULT_TOKEN"),
+		RoleID:       os.Getenv("VAULT_ROLE_ID"),
+		SecretID:     os.Getenv("VAULT_SECRET_ID"),
+		AppRoleMount: os.Getenv("VAULT_APPROLE_MOUNT"),
+		CacheTTL:     cacheTTL,
+		Policy:       policy,
+	})
+}
+
+// Policy returns the policy limiting the secrets organizations can reference.
+func (c *VaultClient) Policy() *VaultPolicy {
+	return c.options.Policy
+}
+
+// ReadKV reads the latest version of a KV v2 secret.
+// The boolean result reports whether the values came from the cache.
+func (c *VaultClient) ReadKV(ctx context.Context, mount, path string) (map[string]string, bool, error) {
+	cacheKey := mount + "/" + path
+	if values, ok := c.cached(cacheKey); ok {
+		return values, true, nil
+	}
+
+	token, err := c.getToken(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	endpoint := fmt.Sprintf("%s/v1/%s/data/%s", c.options.Address, escapeVaultPath(mount), escapeVaultPath(path))
+	var response struct {
+		Data struct {
+			Data map[string]any `json:"data"`
+		} `json:"data"`
+	}
+
+	err = c.do(ctx, http.MethodGet, endpoint, token, nil, &response)
+	if err != nil {
+		return nil, false, fmt.Errorf("error reading %s from vault: %w", cacheKey, err)
+	}
+
+	values := make(map[string]string, len(response.Data.Data))
+	for key, value := range response.Data.Data {
+		switch v := value.(type) {
+		case string:
+			values[key] = v
+		default:
+			encoded, err := json.Marshal(v)
+			if err != nil {
+				return nil, false, fmt.Errorf("error encoding key %s of %s: %w", key, cacheKey, err)
+			}
+
+			values[key] = string(encoded)
+		}
+	}
+
+	c.store(cacheKey, values)
+	return values, false, nil
+}
+
+// Read sends a GET request to a Vault API path, like transit/keys/superplane,
+// and decodes the response into out. Responses are not cached.
+func (c *VaultClient) Read(ctx context.Context, path string, out any) error {
+	return c.call(ctx, http.MethodGet, path, nil, out)
+}
+
+// Write sends a POST request with a JSON body to a Vault API path,
+// and decodes the response into out.
+func (c *VaultClient) Write(ctx context.Context, path string, body any, out any) error {
+	return c.call(ctx, http.MethodPost, path, body, out)
+}
+
+func (c *VaultClient) call(ctx context.Context, method, path string, body any, out any) error {
+	token, err := c.getToken(ctx)
+	if err != nil {
+		return err
+	}
+
+	endpoint := fmt.Sprintf("%s/v1/%s", c.options.Address, escapeVaultPath(path))
+	err = c.do(ctx, method, endpoint, token, body, out)
+	if err != nil {
+		return fmt.Errorf("error calling %s on vault: %w", path, err)
+	}
+
+	return nil
+}
+
+func (c *VaultClient) cached(key string) (map[string]string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.cache[key]
+	if !ok || c.now().After(entry.expiresAt) {
+		return nil, false
+	}
+
+	return entry.values, true
+}
+
+func (c *VaultClient) store(key string, values map[string]string) {
+	if c.options.CacheTTL <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.cache[key] = vaultCacheEntry{
+		values:    values,
+		expiresAt: c.now().Add(c.options.CacheTTL),
+	}
+}
+
+func (c *VaultClient) getToken(ctx context.Context) (string, error) {
+	if c.options.Token != "" {
+		return c.options.Token, nil
+	}
+
+	c.mu.Lock()
+	if c.token != "" && c.now().Before(c.tokenExpiry) {
+		token := c.token
+		c.mu.Unlock()
+		return token, nil
+	}
+	c.mu.Unlock()
+
+	endpoint := fmt.Sprintf("%s/v1/auth/%s/login", c.options.Address, escapeVaultPath(c.options.AppRoleMount))
+	body := map[string]string{
+		"role_id":   c.options.RoleID,
+		"secret_id": c.options.SecretID,
+	}
+
+	var response struct {
+		Auth struct {
+			ClientToken   string `json:"client_token"`
+			LeaseDuration int    `json:"lease_duration"`
+		} `json:"auth"`
+	}
+
+	err := c.do(ctx, http.MethodPost, endpoint, "", body, &response)
+	if err != nil {
+		return "", fmt.Errorf("error logging in to vault with AppRole: %w", err)
+	}
+
+	if response.Auth.ClientToken == "" {
+		return "", fmt.Errorf("vault AppRole login returned no token")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.token = response.Auth.ClientToken
+	c.tokenExpiry = c.now().Add(time.Duration(response.Auth.LeaseDuration)*time.Second - vaultTokenExpiryMargin)
+	return c.token, nil
+}
+
+func (c *VaultClient) do(ctx context.Context, method, endpoint, token string, body any, out any) error {
+	var reader io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+
+		reader = bytes.NewReader(encoded)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, reader)
+	if err != nil {
+		return err
+	}
+
+	if token != "" {
+		req.Header.Set("X-Vault-Token", token)
+	}
+
+	if c.options.Namespace != "" {
+		req.Header.Set("X-Vault-Namespace", c.options.Namespace)
+	}
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	res, err := c.options.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		var errorResponse struct {
+			Errors []string `json:"errors"`
+		}
+
+		_ = json.NewDecoder(io.LimitReader(res.Body, 64*1024)).Decode(&errorResponse)
+		if len(errorResponse.Errors) > 0 {
+			return fmt.Errorf("status %d: %s", res.StatusCode, strings.Join(errorResponse.Errors, "; "))
+		}
+
+		return fmt.Errorf("status %d", res.StatusCode)
+	}
+
+	return json.NewDecoder(res.Body).Decode(out)
+}
+
+func escapeVaultPath(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+
+	return strings.Join(segments, "/")
+}
@@ -0,0 +1,140 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestVaultClientReadsKVWithToken(t *testing.T) {
+	reads := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/secret/data/superplane/github" {
+			t.Fatalf("unexpected path %s", r.URL.Path)
+		}
+
+		if r.Header.Get("X-Vault-Token") != "root" {
+			t.Fatalf("unexpected token %q", r.Header.Get("X-Vault-Token"))
+		}
+
+		reads++
+		_, _ = w.Write([]byte(`{"data": {"data": {"token": "abc", "port": 22}}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewVaultClient(VaultClientOptions{Address: server.URL, Token: "root"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	values, cached, err := client.ReadKV(context.Background(), "secret", "superplane/github")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if cached || values["token"] != "abc" || values["port"] != "22" {
+		t.Fatalf("unexpected values %v (cached: %t)", values, cached)
+	}
+
+	_, cached, err = client.ReadKV(context.Background(), "secret", "superplane/github")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !cached || reads != 1 {
+		t.Fatalf("expected second read to be cached, got %d reads", reads)
+	}
+
+	client.now = func() time.Time { return time.Now().Add(DefaultVaultCacheTTL + time.Second) }
+	_, cached, err = client.ReadKV(context.Background(), "secret", "superplane/github")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if cached || reads != 2 {
+		t.Fatalf("expected expired cache entry to be read again, got %d reads", reads)
+	}
+}
+
+func TestVaultClientLogsInWithAppRole(t *testing.T) {
+	logins := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/auth/approle/login":
+			var body map[string]string
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			if body["role_id"] != "role" || body["secret_id"] != "secret" {
+				t.Fatalf("unexpected login body %v", body)
+			}
+
+			logins++
+			_, _ = w.Write([]byte(`{"auth": {"client_token": "approle-token", "lease_duration": 3600}}`))
+
+		case "/v1/kv/data/app":
+			if r.Header.Get("X-Vault-Token") != "approle-token" {
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = w.Write([]byte(`{"errors": ["permission denied"]}`))
+				return
+			}
+
+			_, _ = w.Write([]byte(`{"data": {"data": {"password": "hunter2"}}}`))
+
+		default:
+			t.Fatalf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewVaultClient(VaultClientOptions{
+		Address:  server.URL,
+		RoleID:   "role",
+		SecretID: "secret",
+		CacheTTL: -1,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for range 2 {
+		values, _, err := client.ReadKV(context.Background(), "kv", "app")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if values["password"] != "hunter2" {
+			t.Fatalf("unexpected values %v", values)
+		}
+	}
+
+	if logins != 1 {
+		t.Fatalf("expected token to be reused, got %d logins", logins)
+	}
+}
+
+func TestVaultClientReturnsVaultErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"errors": ["permission denied"]}`))
+	}))
+	defer server.Close()
+
+	client, err := NewVaultClient(VaultClientOptions{Address: server.URL, Token: "root"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, _, err = client.ReadKV(context.Background(), "secret", "missing")
+	if err == nil || err.Error() != "error reading secret/missing from vault: status 403: permission denied" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestNewVaultClientRequiresCredentials(t *testing.T) {
+	_, err := NewVaultClient(VaultClientOptions{Address: "http://127.0.0.1:8200", RoleID: "role"})
+	if err == nil {
+		t.Fatalf("expected error without token or secret id")
+	}
+}
@@ -0,0 +1,99 @@
+package secrets
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"slices"
+	"strings"
+)
+
+const (
+	DefaultVaultSecretsMount      = "secret"
+	DefaultVaultSecretsPathPrefix = "superplane/{organization_id}"
+
+	organizationIDPlaceholder = "{organization_id}"
+)
+
+// VaultPolicy limits the Vault secrets an organization can reference.
+// Every read uses the same server credentials, so without it,
+// organizations could read each other's secrets, or anything else the server can read.
+type VaultPolicy struct {
+	Mounts []string
+
+	//
+	// PathPrefix is the path below which the secrets of an organization are,
+	// with {organization_id} replaced by the ID of the organization.
+	//
+	PathPrefix string
+}
+
+// NewVaultPolicyFromEnv configures the policy from VAULT_SECRETS_MOUNTS,
+// a comma-separated list of KV v2 mounts, and VAULT_SECRETS_PATH_PREFIX.
+func NewVaultPolicyFromEnv() (*VaultPolicy, error) {
+	policy := &VaultPolicy{
+		Mounts:     []string{DefaultVaultSecretsMount},
+		PathPrefix: DefaultVaultSecretsPathPrefix,
+	}
+
+	if value := os.Getenv("VAULT_SECRETS_MOUNTS"); value != "" {
+		policy.Mounts = []string{}
+		for _, mount := range strings.Split(value, ",") {
+			mount = strings.Trim(strings.TrimSpace(mount), "/")
+			if mount != "" {
+				policy.Mounts = append(policy.Mounts, mount)
+			}
+		}
+	}
+
+	if value := os.Getenv("VAULT_SECRETS_PATH_PREFIX"); value != "" {
+		policy.PathPrefix = strings.Trim(value, "/")
+	}
+
+	if err := policy.validate(); err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+func (p *VaultPolicy) validate() error {
+	if len(p.Mounts) == 0 {
+		return fmt.Errorf("at least one vault mount is required")
+	}
+
+	if !strings.Contains(p.PathPrefix, organizationIDPlaceholder) {
+		return fmt.Errorf("vault path prefix %q must include %s", p.PathPrefix, organizationIDPlaceholder)
+	}
+
+	return nil
+}
+
+// Prefix returns the path below which the secrets of the organization must be.
+func (p *VaultPolicy) Prefix(organizationID string) string {
+	return strings.ReplaceAll(p.PathPrefix, organizationIDPlaceholder, organizationID)
+}
+
+// Check returns an error if the organization cannot read the referenced secret.
+func (p *VaultPolicy) Check(organizationID string, reference VaultReference) error {
+	if organizationID == "" {
+		return fmt.Errorf("vault secrets require an organization")
+	}
+
+	mount := strings.Trim(reference.Mount, "/")
+	if !slices.Contains(p.Mounts, mount) {
+		return fmt.Errorf("vault mount %s is not allowed, use one of: %s", mount, strings.Join(p.Mounts, ", "))
+	}
+
+	secretPath := strings.Trim(reference.Path, "/")
+	if slices.Contains(strings.Split(secretPath, "/"), "..") || path.Clean(secretPath) != secretPath {
+		return fmt.Errorf("vault path %s is not a clean path", reference.Path)
+	}
+
+	prefix := p.Prefix(organizationID)
+	if secretPath != prefix && !strings.HasPrefix(secretPath, prefix+"/") {
+		return fmt.Errorf("vault path %s is not allowed, secrets of this organization must be below %s", secretPath, prefix)
+	}
+
+	return nil
+}
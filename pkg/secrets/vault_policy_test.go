@@ -0,0 +1,95 @@
+package secrets
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/models"
+)
+
+func TestVaultPolicyCheck(t *testing.T) {
+	policy := &VaultPolicy{Mounts: []string{"secret", "kv"}, PathPrefix: "tenants/{organization_id}/superplane"}
+
+	allowed := []VaultReference{
+		{Mount: "secret", Path: "tenants/org-1/superplane"},
+		{Mount: "/kv/", Path: "/tenants/org-1/superplane/github/"},
+	}
+
+	for _, reference := range allowed {
+		if err := policy.Check("org-1", reference); err != nil {
+			t.Fatalf("expected %v to be allowed: %v", reference, err)
+		}
+	}
+
+	rejected := []VaultReference{
+		{Mount: "other", Path: "tenants/org-1/superplane/github"},
+		{Mount: "secret", Path: "tenants/org-2/superplane/github"},
+		{Mount: "secret", Path: "tenants/org-1/superplane-other/github"},
+		{Mount: "secret", Path: "tenants/org-1/superplane/../../org-2/superplane"},
+		{Mount: "secret", Path: "tenants/org-1/superplane//github"},
+	}
+
+	for _, reference := range rejected {
+		if err := policy.Check("org-1", reference); err == nil {
+			t.Fatalf("expected %v to be rejected", reference)
+		}
+	}
+
+	if err := policy.Check("", allowed[0]); err == nil {
+		t.Fatalf("expected reference without organization to be rejected")
+	}
+}
+
+func TestVaultPolicyFromEnv(t *testing.T) {
+	t.Setenv("VAULT_SECRETS_MOUNTS", "secret, /kv/")
+	t.Setenv("VAULT_SECRETS_PATH_PREFIX", "/orgs/{organization_id}/")
+
+	policy, err := NewVaultPolicyFromEnv()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(policy.Mounts) != 2 || policy.Mounts[1] != "kv" || policy.Prefix("org-1") != "orgs/org-1" {
+		t.Fatalf("unexpected policy %+v", policy)
+	}
+
+	t.Setenv("VAULT_SECRETS_PATH_PREFIX", "shared")
+	if _, err := NewVaultPolicyFromEnv(); err == nil {
+		t.Fatalf("expected prefix without organization ID to be rejected")
+	}
+}
+
+func TestVaultProviderChecksPolicyOnLoad(t *testing.T) {
+	reads := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reads++
+		_, _ = w.Write([]byte(`{"data": {"data": {"token": "abc"}}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewVaultClient(VaultClientOptions{Address: server.URL, Token: "root"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	organizationID := uuid.NewString()
+	record := &models.Secret{
+		Name:     "github",
+		Provider: ProviderVault,
+		Data:     []byte(`{"mount": "secret", "path": "superplane/` + uuid.NewString() + `/github"}`),
+	}
+
+	_, err = NewVaultProvider(client, record, organizationID).Load(context.Background())
+	if err == nil || reads != 0 {
+		t.Fatalf("expected secret of another organization to be rejected without reading vault, got %v (%d reads)", err, reads)
+	}
+
+	record.Data = []byte(`{"mount": "secret", "path": "superplane/` + organizationID + `/github"}`)
+	values, err := NewVaultProvider(client, record, organizationID).Load(context.Background())
+	if err != nil || values["token"] != "abc" {
+		t.Fatalf("unexpected result %v: %v", values, err)
+	}
+}
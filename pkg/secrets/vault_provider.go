@@ -0,0 +1,79 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/superplanehq/superplane/pkg/models"
+)
+
+// VaultReference points a secret to a KV v2 secret in Vault.
+// It does not hold any secret value, so it is stored as plain JSON.
+type VaultReference struct {
+	Mount string `json:"mount"`
+	Path  string `json:"path"`
+}
+
+func (r VaultReference) Validate() error {
+	if strings.Trim(r.Mount, "/") == "" {
+		return fmt.Errorf("vault mount is required")
+	}
+
+	if strings.Trim(r.Path, "/") == "" {
+		return fmt.Errorf("vault path is required")
+	}
+
+	return nil
+}
+
+func ParseVaultReference(data []byte) (*VaultReference, error) {
+	var reference VaultReference
+	if err := json.Unmarshal(data, &reference); err != nil {
+		return nil, fmt.Errorf("invalid vault reference: %v", err)
+	}
+
+	if err := reference.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &reference, nil
+}
+
+type VaultProvider struct {
+	client         *VaultClient
+	record         *models.Secret
+	organizationID string
+}
+
+func NewVaultProvider(client *VaultClient, record *models.Secret, organizationID string) *VaultProvider {
+	return &VaultProvider{
+		client:         client,
+		record:         record,
+		organizationID: organizationID,
+	}
+}
+
+func (p *VaultProvider) Load(ctx context.Context) (map[string]string, error) {
+	reference, err := ParseVaultReference(p.record.Data)
+	if err != nil {
+		return nil, fmt.Errorf("error loading secret %s: %v", p.record.Name, err)
+	}
+
+	//
+	// The policy is checked on every read too, since the policy
+	// may have changed since the secret was created.
+	//
+	err = p.client.Policy().Check(p.organizationID, *reference)
+	if err != nil {
+		return nil, fmt.Errorf("error loading secret %s: %v", p.record.Name, err)
+	}
+
+	values, _, err := p.client.ReadKV(ctx, reference.Mount, reference.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	return values, nil
+}
@@ -12,23 +12,29 @@ import (
 	"github.com/superplanehq/superplane/pkg/authorization"
 	"github.com/superplanehq/superplane/pkg/config"
 	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/emaillistener"
 	grpc "github.com/superplanehq/superplane/pkg/grpc"
 	"github.com/superplanehq/superplane/pkg/jwt"
+	"github.com/superplanehq/superplane/pkg/kms"
 	"github.com/superplanehq/superplane/pkg/oidc"
 	"github.com/superplanehq/superplane/pkg/public"
 	registry "github.com/superplanehq/superplane/pkg/registry"
+	"github.com/superplanehq/superplane/pkg/secrets"
 	"github.com/superplanehq/superplane/pkg/services"
 	"github.com/superplanehq/superplane/pkg/telemetry"
 	"github.com/superplanehq/superplane/pkg/templates"
+	"github.com/superplanehq/superplane/pkg/triggers/email"
 	"github.com/superplanehq/superplane/pkg/workers"
 
 	// Import integrations, components and triggers to register them via init()
 	_ "github.com/superplanehq/superplane/pkg/components/addmemory"
 	_ "github.com/superplanehq/superplane/pkg/components/approval"
+	_ "github.com/superplanehq/superplane/pkg/components/compareandsetmemory"
 	_ "github.com/superplanehq/superplane/pkg/components/deletememory"
 	_ "github.com/superplanehq/superplane/pkg/components/filter"
 	_ "github.com/superplanehq/superplane/pkg/components/http"
 	_ "github.com/superplanehq/superplane/pkg/components/if"
+	_ "github.com/superplanehq/superplane/pkg/components/incrementmemory"
 	_ "github.com/superplanehq/superplane/pkg/components/merge"
 	_ "github.com/superplanehq/superplane/pkg/components/noop"
 	_ "github.com/superplanehq/superplane/pkg/components/readmemory"
@@ -62,13 +68,16 @@ import (
 	_ "github.com/superplanehq/superplane/pkg/integrations/incident"
 	_ "github.com/superplanehq/superplane/pkg/integrations/jfrog_artifactory"
 	_ "github.com/superplanehq/superplane/pkg/integrations/jira"
+	_ "github.com/superplanehq/superplane/pkg/integrations/kafka"
 	_ "github.com/superplanehq/superplane/pkg/integrations/launchdarkly"
+	_ "github.com/superplanehq/superplane/pkg/integrations/nats"
 	_ "github.com/superplanehq/superplane/pkg/integrations/newrelic"
 	_ "github.com/superplanehq/superplane/pkg/integrations/octopus"
 	_ "github.com/superplanehq/superplane/pkg/integrations/openai"
 	_ "github.com/superplanehq/superplane/pkg/integrations/pagerduty"
 	_ "github.com/superplanehq/superplane/pkg/integrations/perplexity"
 	_ "github.com/superplanehq/superplane/pkg/integrations/prometheus"
+	_ "github.com/superplanehq/superplane/pkg/integrations/rabbitmq"
 	_ "github.com/superplanehq/superplane/pkg/integrations/render"
 	_ "github.com/superplanehq/superplane/pkg/integrations/rootly"
 	_ "github.com/superplanehq/superplane/pkg/integrations/semaphore"
@@ -79,6 +88,7 @@ import (
 	_ "github.com/superplanehq/superplane/pkg/integrations/statuspage"
 	_ "github.com/superplanehq/superplane/pkg/integrations/teams"
 	_ "github.com/superplanehq/superplane/pkg/integrations/telegram"
+	_ "github.com/superplanehq/superplane/pkg/triggers/canvasexecution"
 	_ "github.com/superplanehq/superplane/pkg/triggers/schedule"
 	_ "github.com/superplanehq/superplane/pkg/triggers/start"
 	_ "github.com/superplanehq/superplane/pkg/triggers/webhook"
@@ -135,6 +145,34 @@ func startWorkers(encryptor crypto.Encryptor, registry *registry.Registry, oidcP
 		go w.Start(context.Background())
 	}
 
+	if os.Getenv("START_CANVAS_EXECUTION_TRIGGER_WORKER") == "yes" {
+		log.Println("Starting Canvas Execution Trigger Worker")
+
+		w := workers.NewCanvasExecutionTriggerWorker(registry, rabbitMQURL)
+		go w.Start(context.Background())
+	}
+
+	if os.Getenv("START_NATS_SUBSCRIPTION_WORKER") == "yes" {
+		log.Println("Starting NATS Subscription Worker")
+
+		w := workers.NewNATSSubscriptionWorker(encryptor, registry)
+		go w.Start(context.Background())
+	}
+
+	if os.Getenv("START_RABBITMQ_CONSUMER_WORKER") == "yes" {
+		log.Println("Starting RabbitMQ Consumer Worker")
+
+		w := workers.NewRabbitMQConsumerWorker(encryptor, registry)
+		go w.Start(context.Background())
+	}
+
+	if os.Getenv("START_KAFKA_CONSUMER_WORKER") == "yes" {
+		log.Println("Starting Kafka Consumer Worker")
+
+		w := workers.NewKafkaConsumerWorker(encryptor, registry)
+		go w.Start(context.Background())
+	}
+
 	// Start Webhook Provisioner when internal API runs so integration webhooks (e.g. GCP On VM Created) get provisioned.
 	// Can be disabled by setting START_WEBHOOK_PROVISIONER=no.
 	if os.Getenv("START_WEBHOOK_PROVISIONER") != "no" {
@@ -160,12 +198,43 @@ func startWorkers(encryptor crypto.Encryptor, registry *registry.Registry, oidcP
 		go w.Start(context.Background())
 	}
 
+	if os.Getenv("START_CANVAS_GIT_SYNC_WORKER") == "yes" {
+		log.Println("Starting Canvas Git Sync Worker")
+
+		w := workers.NewCanvasGitSyncWorker(encryptor, registry, getWebhookBaseURL(baseURL))
+		go w.Start(context.Background())
+	}
+
+	if os.Getenv("START_REENCRYPTION_WORKER") == "yes" {
+		envelopeEncryptor, ok := encryptor.(*crypto.EnvelopeEncryptor)
+		if ok {
+			log.Println("Starting Re-encryption Worker")
+
+			w := workers.NewReencryptionWorker(envelopeEncryptor, registry)
+			go w.Start(context.Background())
+		} else {
+			log.Warn("START_REENCRYPTION_WORKER is set, but envelope encryption is not configured")
+		}
+	}
+
 	if os.Getenv("START_WORKFLOW_CLEANUP_WORKER") == "yes" || os.Getenv("START_CANVAS_CLEANUP_WORKER") == "yes" {
 		log.Println("Starting Canvas Cleanup Worker")
 
 		w := workers.NewCanvasCleanupWorker()
 		go w.Start(context.Background())
 	}
+
+	if os.Getenv("START_AUDIT_RETENTION_WORKER") == "yes" {
+		retentionDays := lookupAuditLogRetentionDays()
+		if retentionDays > 0 {
+			log.Printf("Starting Audit Retention Worker, keeping audit events for %d days", retentionDays)
+
+			w := workers.NewAuditRetentionWorker(retentionDays)
+			go w.Start(context.Background())
+		} else {
+			log.Println("Audit Retention Worker not started - audit events are kept forever")
+		}
+	}
 }
 
 func startEmailConsumers(rabbitMQURL string, encryptor crypto.Encryptor, baseURL string, authService authorization.Authorization) {
@@ -263,6 +332,21 @@ func startPublicAPI(baseURL, basePath string, encryptor crypto.Encryptor, regist
 	}
 }
 
+func startEmailListener(webhooksBaseURL string, encryptor crypto.Encryptor, registry *registry.Registry) {
+	log.Println("Starting Email Listener")
+
+	addr := os.Getenv("EMAIL_LISTENER_ADDR")
+	if addr == "" {
+		addr = "0.0.0.0:2525"
+	}
+
+	listener := emaillistener.NewListener(registry, encryptor, webhooksBaseURL, email.Domain(webhooksBaseURL))
+	err := listener.ListenAndServe(addr)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
 func lookupPublicAPIPort() int {
 	port := 8000
 
@@ -277,6 +361,22 @@ func lookupPublicAPIPort() int {
 	return port
 }
 
+// lookupAuditLogRetentionDays returns how many days audit events are kept.
+// Zero keeps them forever.
+func lookupAuditLogRetentionDays() int {
+	days := 365
+
+	if d := os.Getenv("AUDIT_LOG_RETENTION_DAYS"); d != "" {
+		if v, errConv := strconv.Atoi(d); errConv == nil && v >= 0 {
+			days = v
+		} else {
+			log.Warnf("Invalid AUDIT_LOG_RETENTION_DAYS %q, falling back to 365", d)
+		}
+	}
+
+	return days
+}
+
 func lookupInternalAPIPort() int {
 	port := 50051
 
@@ -342,6 +442,20 @@ func Start() {
 		encryptorInstance = crypto.NewNoOpEncryptor()
 	} else {
 		encryptorInstance = crypto.NewAESGCMEncryptor([]byte(encryptionKey))
+
+		//
+		// With a key encryption key provider configured, new values use envelope encryption,
+		// and values encrypted with ENCRYPTION_KEY before that can still be read.
+		//
+		kekProvider, err := kms.NewProviderFromEnv()
+		if err != nil {
+			panic(fmt.Sprintf("failed to configure key encryption key provider: %v", err))
+		}
+
+		if kekProvider != nil {
+			log.Infof("Using envelope encryption with the %s key encryption key provider", os.Getenv("ENCRYPTION_KEK_PROVIDER"))
+			encryptorInstance = crypto.NewEnvelopeEncryptor(kekProvider, encryptorInstance)
+		}
 	}
 
 	authService, err := authorization.NewAuthService()
@@ -386,6 +500,11 @@ func Start() {
 		panic(fmt.Sprintf("failed to create registry: %v", err))
 	}
 
+	registry.SecretBackends, err = secrets.NewBackendsFromEnv()
+	if err != nil {
+		panic(fmt.Sprintf("failed to configure secret backends: %v", err))
+	}
+
 	templates.Setup(registry)
 
 	if os.Getenv("START_PUBLIC_API") == "yes" {
@@ -396,6 +515,10 @@ func Start() {
 		go startInternalAPI(baseURL, webhooksBaseURL, basePath, encryptorInstance, authService, registry, oidcProvider)
 	}
 
+	if os.Getenv("START_EMAIL_LISTENER") == "yes" {
+		go startEmailListener(webhooksBaseURL, encryptorInstance, registry)
+	}
+
 	startWorkers(encryptorInstance, registry, oidcProvider, baseURL, authService)
 
 	log.Println("SuperPlane is UP.")
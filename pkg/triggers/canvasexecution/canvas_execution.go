@@ -0,0 +1,306 @@
+package canvasexecution
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/registry"
+)
+
+const (
+	Name = "canvasExecution"
+
+	//
+	// Action invoked by the CanvasExecutionTriggerWorker
+	// when a node execution in another canvas finishes.
+	//
+	ActionExecutionFinished = "executionFinished"
+
+	ResultPassed    = "passed"
+	ResultFailed    = "failed"
+	ResultCancelled = "cancelled"
+
+	//
+	// Maximum number of canvases chained through this trigger.
+	// Loops between canvases are ignored, but long chains are cut too,
+	// in case they are not loops but still runaway.
+	//
+	MaxChainLength = 10
+)
+
+func init() {
+	registry.RegisterTrigger(Name, &CanvasExecution{})
+}
+
+type CanvasExecution struct{}
+
+type Configuration struct {
+	Canvas  string   `json:"canvas" mapstructure:"canvas"`
+	Node    string   `json:"node" mapstructure:"node"`
+	Results []string `json:"results" mapstructure:"results"`
+}
+
+// FinishedExecution is what the CanvasExecutionTriggerWorker
+// sends as the parameters of the executionFinished action.
+type FinishedExecution struct {
+	Canvas    Reference        `json:"canvas" mapstructure:"canvas"`
+	Node      Reference        `json:"node" mapstructure:"node"`
+	Execution ExecutionDetails `json:"execution" mapstructure:"execution"`
+	Outputs   map[string][]any `json:"outputs" mapstructure:"outputs"`
+
+	//
+	// IDs of the canvases that led to the finished execution, oldest first,
+	// ending with the canvas of the finished execution.
+	//
+	Chain []string `json:"chain" mapstructure:"chain"`
+}
+
+type Reference struct {
+	ID   string `json:"id" mapstructure:"id"`
+	Name string `json:"name" mapstructure:"name"`
+}
+
+type ExecutionDetails struct {
+	ID            string `json:"id" mapstructure:"id"`
+	RootEventID   string `json:"rootEventId" mapstructure:"rootEventId"`
+	Result        string `json:"result" mapstructure:"result"`
+	ResultReason  string `json:"resultReason" mapstructure:"resultReason"`
+	ResultMessage string `json:"resultMessage" mapstructure:"resultMessage"`
+	CreatedAt     string `json:"createdAt" mapstructure:"createdAt"`
+	FinishedAt    string `json:"finishedAt" mapstructure:"finishedAt"`
+}
+
+func (t *CanvasExecution) Name() string {
+	return Name
+}
+
+func (t *CanvasExecution) Label() string {
+	return "On Canvas Execution"
+}
+
+func (t *CanvasExecution) Description() string {
+	return "Start a new execution chain when a node in another canvas finishes"
+}
+
+func (t *CanvasExecution) Documentation() string {
+	return `The On Canvas Execution trigger starts a new workflow execution when a node in another canvas of the same organization finishes.
+
+## Use Cases
+
+- **Chaining canvases**: Start a deployment canvas when the build canvas finishes
+- **Cross-team workflows**: Let product teams react to canvases owned by platform teams
+- **Failure handling**: Run a dedicated incident canvas when a node in another canvas fails
+
+## How It Works
+
+1. Add the On Canvas Execution trigger to your canvas
+2. Select the canvas and node to watch, by name or ID
+3. Select which execution results should start a new execution
+4. Every time an execution of that node finishes with one of the selected results, this canvas starts a new execution
+
+No webhook URLs or secrets need to be shared between canvases.
+
+## Configuration
+
+- **Canvas**: Name or ID of the canvas to watch
+- **Node**: Name or ID of the node to watch in that canvas
+- **Results**: Execution results that start a new execution (passed, failed, cancelled)
+
+## Event Data
+
+Each event includes:
+- **canvas**: ID and name of the canvas where the execution finished
+- **node**: ID and name of the node that finished
+- **execution**: ID, result, result reason and message, and timestamps of the finished execution
+- **outputs**: The events emitted by the execution, grouped by output channel
+- **chain**: IDs of the canvases that led to the execution, ending with the canvas where it finished
+
+## Loops
+
+Executions from the same canvas are ignored, and so are executions started by a chain that already went through this canvas.
+Chains are limited to 10 canvases.`
+}
+
+func (t *CanvasExecution) Icon() string {
+	return "workflow"
+}
+
+func (t *CanvasExecution) Color() string {
+	return "purple"
+}
+
+func (t *CanvasExecution) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:        "canvas",
+			Label:       "Canvas",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Description: "Name or ID of the canvas to watch",
+		},
+		{
+			Name:        "node",
+			Label:       "Node",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Description: "Name or ID of the node to watch",
+		},
+		{
+			Name:     "results",
+			Label:    "Results",
+			Type:     configuration.FieldTypeMultiSelect,
+			Required: true,
+			Default:  []string{ResultPassed},
+			TypeOptions: &configuration.TypeOptions{
+				MultiSelect: &configuration.MultiSelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "Passed", Value: ResultPassed},
+						{Label: "Failed", Value: ResultFailed},
+						{Label: "Cancelled", Value: ResultCancelled},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (t *CanvasExecution) Setup(ctx core.TriggerContext) error {
+	config := Configuration{}
+	err := mapstructure.Decode(ctx.Configuration, &config)
+	if err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	return config.Validate()
+}
+
+func (t *CanvasExecution) Actions() []core.Action {
+	return []core.Action{
+		{
+			Name:           ActionExecutionFinished,
+			Description:    "Emit an event for a finished execution in another canvas",
+			UserAccessible: false,
+		},
+	}
+}
+
+func (t *CanvasExecution) HandleAction(ctx core.TriggerActionContext) (map[string]any, error) {
+	switch ctx.Name {
+	case ActionExecutionFinished:
+		return nil, t.executionFinished(ctx)
+	}
+
+	return nil, fmt.Errorf("action %s not supported", ctx.Name)
+}
+
+func (t *CanvasExecution) executionFinished(ctx core.TriggerActionContext) error {
+	config := Configuration{}
+	err := mapstructure.Decode(ctx.Configuration, &config)
+	if err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	execution := FinishedExecution{}
+	err = mapstructure.Decode(ctx.Parameters, &execution)
+	if err != nil {
+		return fmt.Errorf("failed to decode parameters: %w", err)
+	}
+
+	if !config.Matches(execution) {
+		return nil
+	}
+
+	return ctx.Events.Emit("canvas.execution.finished", map[string]any{
+		"canvas":    execution.Canvas,
+		"node":      execution.Node,
+		"execution": execution.Execution,
+		"outputs":   execution.Outputs,
+		"chain":     execution.Chain,
+	})
+}
+
+func (t *CanvasExecution) HandleWebhook(ctx core.WebhookRequestContext) (int, *core.WebhookResponseBody, error) {
+	return http.StatusOK, nil, nil
+}
+
+func (t *CanvasExecution) Cleanup(ctx core.TriggerContext) error {
+	return nil
+}
+
+func (c Configuration) Validate() error {
+	if strings.TrimSpace(c.Canvas) == "" {
+		return fmt.Errorf("canvas is required")
+	}
+
+	if strings.TrimSpace(c.Node) == "" {
+		return fmt.Errorf("node is required")
+	}
+
+	if len(c.Results) == 0 {
+		return fmt.Errorf("at least one result is required")
+	}
+
+	for _, result := range c.Results {
+		if !slices.Contains([]string{ResultPassed, ResultFailed, ResultCancelled}, result) {
+			return fmt.Errorf("invalid result %s", result)
+		}
+	}
+
+	return nil
+}
+
+// Matches reports whether a finished execution should start a new execution.
+// Canvas and node can be referenced either by name or by ID.
+func (c Configuration) Matches(execution FinishedExecution) bool {
+	if !matchesReference(c.Canvas, execution.Canvas) {
+		return false
+	}
+
+	if !matchesReference(c.Node, execution.Node) {
+		return false
+	}
+
+	return slices.Contains(c.Results, execution.Execution.Result)
+}
+
+func matchesReference(value string, ref Reference) bool {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return false
+	}
+
+	return value == ref.ID || value == ref.Name
+}
+
+// ChainFromEventData returns the chain of canvases
+// recorded in an event emitted by this trigger.
+func ChainFromEventData(data any) []string {
+	event, ok := data.(map[string]any)
+	if !ok {
+		return []string{}
+	}
+
+	payload, ok := event["data"].(map[string]any)
+	if !ok {
+		return []string{}
+	}
+
+	values, ok := payload["chain"].([]any)
+	if !ok {
+		return []string{}
+	}
+
+	chain := []string{}
+	for _, value := range values {
+		if canvasID, ok := value.(string); ok {
+			chain = append(chain, canvasID)
+		}
+	}
+
+	return chain
+}
@@ -0,0 +1,135 @@
+package canvasexecution
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__CanvasExecution__Setup(t *testing.T) {
+	trigger := &CanvasExecution{}
+
+	t.Run("canvas is required", func(t *testing.T) {
+		err := trigger.Setup(core.TriggerContext{
+			Configuration: map[string]any{"node": "deploy", "results": []string{ResultPassed}},
+		})
+
+		require.ErrorContains(t, err, "canvas is required")
+	})
+
+	t.Run("node is required", func(t *testing.T) {
+		err := trigger.Setup(core.TriggerContext{
+			Configuration: map[string]any{"canvas": "build", "results": []string{ResultPassed}},
+		})
+
+		require.ErrorContains(t, err, "node is required")
+	})
+
+	t.Run("invalid result", func(t *testing.T) {
+		err := trigger.Setup(core.TriggerContext{
+			Configuration: map[string]any{"canvas": "build", "node": "deploy", "results": []string{"unknown"}},
+		})
+
+		require.ErrorContains(t, err, "invalid result unknown")
+	})
+
+	t.Run("valid configuration", func(t *testing.T) {
+		err := trigger.Setup(core.TriggerContext{
+			Configuration: map[string]any{"canvas": "build", "node": "deploy", "results": []string{ResultPassed, ResultFailed}},
+		})
+
+		require.NoError(t, err)
+	})
+}
+
+func Test__CanvasExecution__HandleAction(t *testing.T) {
+	trigger := &CanvasExecution{}
+	parameters := map[string]any{
+		"canvas": map[string]any{"id": "9b7f8c1e-2f4a-4a63-9a57-0c3a2b1f4e11", "name": "build"},
+		"node":   map[string]any{"id": "deploy-x8k2p1", "name": "Deploy"},
+		"execution": map[string]any{
+			"id":     "3f1e6a2b-7c4d-4e8f-9a0b-1c2d3e4f5a6b",
+			"result": ResultPassed,
+		},
+		"outputs": map[string]any{
+			"default": []any{map[string]any{"data": map[string]any{"status": 200}}},
+		},
+		"chain": []any{"0c5d7e1f-3a2b-4c6d-8e9f-1a2b3c4d5e6f", "9b7f8c1e-2f4a-4a63-9a57-0c3a2b1f4e11"},
+	}
+
+	t.Run("matching canvas name and node ID -> emits", func(t *testing.T) {
+		events := &contexts.EventContext{}
+		_, err := trigger.HandleAction(core.TriggerActionContext{
+			Name:          ActionExecutionFinished,
+			Parameters:    parameters,
+			Configuration: map[string]any{"canvas": "build", "node": "deploy-x8k2p1", "results": []string{ResultPassed}},
+			Events:        events,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, 1, events.Count())
+		assert.Equal(t, "canvas.execution.finished", events.Payloads[0].Type)
+
+		data := events.Payloads[0].Data.(map[string]any)
+		assert.Equal(t, Reference{ID: "9b7f8c1e-2f4a-4a63-9a57-0c3a2b1f4e11", Name: "build"}, data["canvas"])
+		assert.Equal(t, ResultPassed, data["execution"].(ExecutionDetails).Result)
+		assert.Len(t, data["outputs"].(map[string][]any)["default"], 1)
+		assert.Equal(t, []string{"0c5d7e1f-3a2b-4c6d-8e9f-1a2b3c4d5e6f", "9b7f8c1e-2f4a-4a63-9a57-0c3a2b1f4e11"}, data["chain"])
+	})
+
+	t.Run("matching canvas ID and node name -> emits", func(t *testing.T) {
+		events := &contexts.EventContext{}
+		_, err := trigger.HandleAction(core.TriggerActionContext{
+			Name:          ActionExecutionFinished,
+			Parameters:    parameters,
+			Configuration: map[string]any{"canvas": "9b7f8c1e-2f4a-4a63-9a57-0c3a2b1f4e11", "node": "Deploy", "results": []string{ResultPassed}},
+			Events:        events,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, 1, events.Count())
+	})
+
+	t.Run("different node -> does not emit", func(t *testing.T) {
+		events := &contexts.EventContext{}
+		_, err := trigger.HandleAction(core.TriggerActionContext{
+			Name:          ActionExecutionFinished,
+			Parameters:    parameters,
+			Configuration: map[string]any{"canvas": "build", "node": "other", "results": []string{ResultPassed}},
+			Events:        events,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, 0, events.Count())
+	})
+
+	t.Run("different result -> does not emit", func(t *testing.T) {
+		events := &contexts.EventContext{}
+		_, err := trigger.HandleAction(core.TriggerActionContext{
+			Name:          ActionExecutionFinished,
+			Parameters:    parameters,
+			Configuration: map[string]any{"canvas": "build", "node": "Deploy", "results": []string{ResultFailed}},
+			Events:        events,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, 0, events.Count())
+	})
+
+	t.Run("unknown action -> error", func(t *testing.T) {
+		_, err := trigger.HandleAction(core.TriggerActionContext{Name: "unknown"})
+		require.ErrorContains(t, err, "action unknown not supported")
+	})
+}
+
+func Test__CanvasExecution__ChainFromEventData(t *testing.T) {
+	assert.Empty(t, ChainFromEventData(nil))
+	assert.Empty(t, ChainFromEventData(map[string]any{"data": map[string]any{}}))
+	assert.Equal(t, []string{"a", "b"}, ChainFromEventData(map[string]any{
+		"type": "canvas.execution.finished",
+		"data": map[string]any{"chain": []any{"a", "b"}},
+	}))
+}
@@ -0,0 +1,308 @@
+package email
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/registry"
+)
+
+const (
+	Name           = "email"
+	PayloadType    = "email.received"
+	ReceiveAction  = "receive"
+	MaxMessageSize = 10 * 1024 * 1024
+	MaxBodySize    = 64 * 1024
+)
+
+var (
+	ErrSenderNotAllowed = errors.New("sender not allowed")
+	ErrInvalidMessage   = errors.New("invalid message")
+)
+
+func init() {
+	registry.RegisterTrigger(Name, &Email{})
+}
+
+type Email struct{}
+
+type Metadata struct {
+	Address string `json:"address" mapstructure:"address"`
+}
+
+type Configuration struct {
+	Senders []string `json:"senders" mapstructure:"senders"`
+}
+
+// Delivery is an email received by the SMTP listener for the node.
+type Delivery struct {
+	From string   `mapstructure:"from"`
+	To   []string `mapstructure:"to"`
+	Data []byte   `mapstructure:"data"`
+}
+
+// Parameters returns the delivery as the parameters of the receive action.
+func (d Delivery) Parameters() map[string]any {
+	return map[string]any{
+		"from": d.From,
+		"to":   d.To,
+		"data": d.Data,
+	}
+}
+
+// Domain returns the domain of the addresses generated for email triggers,
+// which is the only domain the SMTP listener accepts emails for.
+// It is set with EMAIL_LISTENER_DOMAIN, and defaults to the host of the webhooks base URL.
+func Domain(webhooksBaseURL string) string {
+	if domain := os.Getenv("EMAIL_LISTENER_DOMAIN"); domain != "" {
+		return strings.ToLower(domain)
+	}
+
+	u, err := url.Parse(webhooksBaseURL)
+	if err == nil && u.Hostname() != "" {
+		return strings.ToLower(u.Hostname())
+	}
+
+	return "localhost"
+}
+
+// Allows reports whether an email from the given sender address
+// is accepted by the configuration. An empty list of senders accepts everything.
+// Entries can be full addresses (alerts@vendor.com) or domains (@vendor.com).
+func (c *Configuration) Allows(sender string) bool {
+	if len(c.Senders) == 0 {
+		return true
+	}
+
+	sender = strings.ToLower(strings.TrimSpace(sender))
+	for _, allowed := range c.Senders {
+		allowed = strings.ToLower(strings.TrimSpace(allowed))
+		if allowed == "" {
+			continue
+		}
+
+		if strings.HasPrefix(allowed, "@") {
+			if strings.HasSuffix(sender, allowed) {
+				return true
+			}
+
+			continue
+		}
+
+		if sender == allowed {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (e *Email) Name() string {
+	return Name
+}
+
+func (e *Email) Label() string {
+	return "Email"
+}
+
+func (e *Email) Description() string {
+	return "Start a new execution chain when an email is received"
+}
+
+func (e *Email) Documentation() string {
+	return `The Email trigger starts a new workflow execution when an email is received at the address generated for the node.
+
+## Use Cases
+
+- **Vendor alerts**: React to alert emails from systems that can only notify by email
+- **Support inboxes**: Forward emails into a workflow for triage
+- **Reports**: Process scheduled reports delivered by email
+
+## How It Works
+
+1. When you add an Email trigger to a workflow, SuperPlane generates a unique address for the node
+2. Emails are received by the built-in SMTP listener, enabled with ` + "`START_EMAIL_LISTENER=yes`" + `
+3. Each accepted email starts a new workflow execution with the parsed email data
+
+The domain of the generated address is set with ` + "`EMAIL_LISTENER_DOMAIN`" + `, and defaults to the host
+of the webhooks base URL. The MX record of that domain must point to the SMTP listener.
+The listener only accepts emails for that domain, and the local part of the address identifies the node.
+Emails are only received over SMTP: the webhook URL of the node does not accept emails.
+
+## Sender Filtering
+
+Use **Allowed Senders** to only accept emails from specific addresses (` + "`alerts@vendor.com`" + `)
+or domains (` + "`@vendor.com`" + `). Both the SMTP envelope sender and the From header must be allowed.
+Emails from other senders are rejected.
+
+SPF and DKIM are not verified by the listener, so sender addresses can be forged.
+Do not rely on **Allowed Senders** alone to accept emails that start sensitive workflows.
+
+## Event Data
+
+Each email includes:
+- **from**, **to**, **cc**, **replyTo**: Addresses from the email headers
+- **subject**, **date**, **messageId**: Common email headers
+- **headers**: All email headers
+- **text** and **html**: Email bodies, truncated to 64KB each
+- **attachments**: Metadata (filename, content type and size) for each attachment
+- **envelope**: SMTP envelope sender and recipients
+
+Attachment contents are not included in the event. Maximum email size: 10MB.`
+}
+
+func (e *Email) Icon() string {
+	return "mail"
+}
+
+func (e *Email) Color() string {
+	return "black"
+}
+
+func (e *Email) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:        "senders",
+			Label:       "Allowed Senders",
+			Type:        configuration.FieldTypeList,
+			Required:    false,
+			Togglable:   true,
+			Description: "Only accept emails from these addresses or domains (e.g. @example.com)",
+			TypeOptions: &configuration.TypeOptions{
+				List: &configuration.ListTypeOptions{
+					ItemLabel: "Sender",
+					ItemDefinition: &configuration.ListItemDefinition{
+						Type: configuration.FieldTypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (e *Email) Setup(ctx core.TriggerContext) error {
+	var metadata Metadata
+	err := mapstructure.Decode(ctx.Metadata.Get(), &metadata)
+	if err != nil {
+		return fmt.Errorf("failed to parse metadata: %w", err)
+	}
+
+	config := Configuration{}
+	err = mapstructure.Decode(ctx.Configuration, &config)
+	if err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if metadata.Address != "" {
+		return nil
+	}
+
+	webhookURL, err := ctx.Webhook.Setup()
+	if err != nil {
+		return fmt.Errorf("failed to setup webhook: %w", err)
+	}
+
+	address, err := addressFor(webhookURL, Domain(ctx.Webhook.GetBaseURL()))
+	if err != nil {
+		return err
+	}
+
+	return ctx.Metadata.Set(Metadata{Address: address})
+}
+
+// The local part of the address is the ID of the node webhook,
+// which is what the SMTP listener uses to find the nodes for an email.
+func addressFor(webhookURL, domain string) (string, error) {
+	webhookID, err := uuid.Parse(path.Base(webhookURL))
+	if err != nil {
+		return "", fmt.Errorf("invalid webhook URL %s: %w", webhookURL, err)
+	}
+
+	return fmt.Sprintf("%s@%s", webhookID.String(), domain), nil
+}
+
+func (e *Email) Actions() []core.Action {
+	return []core.Action{
+		{
+			Name:           ReceiveAction,
+			UserAccessible: false,
+		},
+	}
+}
+
+// HandleAction is called by the SMTP listener for every email received for the node,
+// with the parameters of the Delivery.
+func (e *Email) HandleAction(ctx core.TriggerActionContext) (map[string]any, error) {
+	switch ctx.Name {
+	case ReceiveAction:
+		return nil, e.receive(ctx)
+	}
+
+	return nil, fmt.Errorf("action %s not supported", ctx.Name)
+}
+
+func (e *Email) receive(ctx core.TriggerActionContext) error {
+	delivery := Delivery{}
+	err := mapstructure.Decode(ctx.Parameters, &delivery)
+	if err != nil {
+		return fmt.Errorf("failed to decode delivery: %w", err)
+	}
+
+	if len(delivery.Data) > MaxMessageSize {
+		return fmt.Errorf("%w: message too large", ErrInvalidMessage)
+	}
+
+	config := Configuration{}
+	err = mapstructure.Decode(ctx.Configuration, &config)
+	if err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	message, err := ParseMessage(delivery.Data)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidMessage, err)
+	}
+
+	//
+	// Either address can be set freely by the sender,
+	// so both of them need to be allowed.
+	//
+	if !config.Allows(delivery.From) {
+		return fmt.Errorf("%w: %s", ErrSenderNotAllowed, delivery.From)
+	}
+
+	if !config.Allows(message.FromAddress()) {
+		return fmt.Errorf("%w: %s", ErrSenderNotAllowed, message.FromAddress())
+	}
+
+	message.Envelope = Envelope{
+		From: delivery.From,
+		To:   delivery.To,
+	}
+
+	err = ctx.Events.Emit(PayloadType, message.ToMap())
+	if err != nil {
+		return fmt.Errorf("error emitting event: %v", err)
+	}
+
+	return nil
+}
+
+// HandleWebhook rejects every request, since emails are only received over SMTP.
+// Otherwise, anyone knowing the node webhook URL could deliver emails from any sender.
+func (e *Email) HandleWebhook(ctx core.WebhookRequestContext) (int, *core.WebhookResponseBody, error) {
+	return http.StatusNotFound, nil, fmt.Errorf("emails are only received over SMTP")
+}
+
+func (e *Email) Cleanup(ctx core.TriggerContext) error {
+	return nil
+}
@@ -0,0 +1,256 @@
+package email
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/test/support/contexts"
+)
+
+const multipartMessage = "From: Vendor Alerts <alerts@vendor.com>\r\n" +
+	"To: a@superplane.example.com, Ops <ops@example.com>\r\n" +
+	"Subject: =?UTF-8?Q?High_error_rate_=E2=9A=A0?=\r\n" +
+	"Date: Thu, 15 Jan 2026 10:30:00 +0100\r\n" +
+	"Message-ID: <1234@vendor.com>\r\n" +
+	"MIME-Version: 1.0\r\n" +
+	"Content-Type: multipart/mixed; boundary=outer\r\n" +
+	"\r\n" +
+	"--outer\r\n" +
+	"Content-Type: multipart/alternative; boundary=inner\r\n" +
+	"\r\n" +
+	"--inner\r\n" +
+	"Content-Type: text/plain; charset=utf-8\r\n" +
+	"Content-Transfer-Encoding: quoted-printable\r\n" +
+	"\r\n" +
+	"Error rate is above 5=25.\r\n" +
+	"--inner\r\n" +
+	"Content-Type: text/html; charset=utf-8\r\n" +
+	"\r\n" +
+	"<p>Error rate is above 5%.</p>\r\n" +
+	"--inner--\r\n" +
+	"--outer\r\n" +
+	"Content-Type: image/png\r\n" +
+	"Content-Disposition: attachment; filename=\"graph.png\"\r\n" +
+	"Content-Transfer-Encoding: base64\r\n" +
+	"\r\n" +
+	"aGVsbG8g\r\n" +
+	"d29ybGQ=\r\n" +
+	"--outer--\r\n"
+
+func Test__ParseMessage(t *testing.T) {
+	t.Run("multipart message", func(t *testing.T) {
+		message, err := ParseMessage([]byte(multipartMessage))
+		require.NoError(t, err)
+
+		assert.Equal(t, "alerts@vendor.com", message.FromAddress())
+		assert.Equal(t, []string{"a@superplane.example.com", "ops@example.com"}, message.To)
+		assert.Equal(t, "High error rate ⚠", message.Subject)
+		assert.Equal(t, "2026-01-15T09:30:00Z", message.Date)
+		assert.Equal(t, "1234@vendor.com", message.MessageID)
+		assert.Equal(t, "Error rate is above 5%.", strings.TrimSpace(message.Text))
+		assert.Equal(t, "<p>Error rate is above 5%.</p>", strings.TrimSpace(message.HTML))
+		assert.False(t, message.Truncated)
+		require.Len(t, message.Attachments, 1)
+		assert.Equal(t, Attachment{Filename: "graph.png", ContentType: "image/png", Size: 11}, message.Attachments[0])
+	})
+
+	t.Run("plain message", func(t *testing.T) {
+		message, err := ParseMessage([]byte("From: alerts@vendor.com\r\nSubject: hello\r\n\r\nhello world\r\n"))
+		require.NoError(t, err)
+		assert.Equal(t, "hello world\r\n", message.Text)
+		assert.Empty(t, message.HTML)
+		assert.Empty(t, message.Attachments)
+	})
+
+	t.Run("large bodies are truncated", func(t *testing.T) {
+		body := strings.Repeat("a", MaxBodySize+100)
+		message, err := ParseMessage([]byte("From: alerts@vendor.com\r\n\r\n" + body))
+		require.NoError(t, err)
+		assert.Len(t, message.Text, MaxBodySize)
+		assert.True(t, message.Truncated)
+	})
+
+	t.Run("large bodies are not truncated in the middle of a character", func(t *testing.T) {
+		body := strings.Repeat("€", MaxBodySize/3+1) + "invalid \xff byte"
+		message, err := ParseMessage([]byte("From: alerts@vendor.com\r\n\r\n" + body))
+		require.NoError(t, err)
+		assert.Len(t, message.Text, MaxBodySize-1)
+		assert.True(t, utf8.ValidString(message.Text))
+		assert.True(t, message.Truncated)
+	})
+
+	t.Run("bodies are decoded using their charset", func(t *testing.T) {
+		message, err := ParseMessage([]byte(
+			"From: alerts@vendor.com\r\n" +
+				"Content-Type: text/plain; charset=iso-8859-1\r\n" +
+				"\r\n" +
+				"caf\xe9\r\n",
+		))
+
+		require.NoError(t, err)
+		assert.Equal(t, "café\r\n", message.Text)
+	})
+
+	t.Run("invalid bytes in UTF-8 bodies are replaced", func(t *testing.T) {
+		message, err := ParseMessage([]byte("From: alerts@vendor.com\r\n\r\nhello \xff world\r\n"))
+		require.NoError(t, err)
+		assert.Equal(t, "hello � world\r\n", message.Text)
+	})
+
+	t.Run("invalid message", func(t *testing.T) {
+		_, err := ParseMessage([]byte("not an email"))
+		require.Error(t, err)
+	})
+}
+
+func Test__Email__Setup(t *testing.T) {
+	t.Run("generates address", func(t *testing.T) {
+		t.Setenv("EMAIL_LISTENER_DOMAIN", "")
+		metadataCtx := &contexts.MetadataContext{Metadata: Metadata{}}
+		require.NoError(t, (&Email{}).Setup(core.TriggerContext{
+			Configuration: map[string]any{},
+			Metadata:      metadataCtx,
+			Webhook:       &contexts.NodeWebhookContext{},
+		}))
+
+		metadata, ok := metadataCtx.Metadata.(Metadata)
+		require.True(t, ok)
+		assert.True(t, strings.HasSuffix(metadata.Address, "@localhost"))
+	})
+
+	t.Run("uses listener domain", func(t *testing.T) {
+		t.Setenv("EMAIL_LISTENER_DOMAIN", "Mail.Example.com")
+		metadataCtx := &contexts.MetadataContext{Metadata: Metadata{}}
+		require.NoError(t, (&Email{}).Setup(core.TriggerContext{
+			Configuration: map[string]any{},
+			Metadata:      metadataCtx,
+			Webhook:       &contexts.NodeWebhookContext{},
+		}))
+
+		metadata, ok := metadataCtx.Metadata.(Metadata)
+		require.True(t, ok)
+		assert.True(t, strings.HasSuffix(metadata.Address, "@mail.example.com"))
+	})
+
+	t.Run("keeps existing address", func(t *testing.T) {
+		metadataCtx := &contexts.MetadataContext{Metadata: Metadata{Address: "existing@localhost"}}
+		require.NoError(t, (&Email{}).Setup(core.TriggerContext{
+			Configuration: map[string]any{},
+			Metadata:      metadataCtx,
+			Webhook:       &contexts.NodeWebhookContext{},
+		}))
+
+		assert.Equal(t, Metadata{Address: "existing@localhost"}, metadataCtx.Metadata)
+	})
+}
+
+func Test__Domain(t *testing.T) {
+	t.Setenv("EMAIL_LISTENER_DOMAIN", "")
+	assert.Equal(t, "hooks.example.com", Domain("https://Hooks.Example.com/api/v1"))
+	assert.Equal(t, "localhost", Domain(""))
+
+	t.Setenv("EMAIL_LISTENER_DOMAIN", "mail.example.com")
+	assert.Equal(t, "mail.example.com", Domain("https://hooks.example.com/api/v1"))
+}
+
+func Test__Email__Receive(t *testing.T) {
+	delivery := Delivery{
+		From: "bounces@vendor.com",
+		To:   []string{"a@superplane.example.com"},
+		Data: []byte(multipartMessage),
+	}
+
+	t.Run("emits event", func(t *testing.T) {
+		events := &contexts.EventContext{}
+		_, err := (&Email{}).HandleAction(core.TriggerActionContext{
+			Name:          ReceiveAction,
+			Parameters:    delivery.Parameters(),
+			Configuration: map[string]any{},
+			Events:        events,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, 1, events.Count())
+		assert.Equal(t, PayloadType, events.Payloads[0].Type)
+
+		data, ok := events.Payloads[0].Data.(map[string]any)
+		require.True(t, ok)
+		assert.Equal(t, "alerts@vendor.com", data["from"])
+		assert.Equal(t, "High error rate ⚠", data["subject"])
+		assert.Equal(t, map[string]any{
+			"from": "bounces@vendor.com",
+			"to":   []string{"a@superplane.example.com"},
+		}, data["envelope"])
+	})
+
+	t.Run("allowed sender domain", func(t *testing.T) {
+		events := &contexts.EventContext{}
+		_, err := (&Email{}).HandleAction(core.TriggerActionContext{
+			Name:          ReceiveAction,
+			Parameters:    delivery.Parameters(),
+			Configuration: map[string]any{"senders": []string{"@vendor.com"}},
+			Events:        events,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, events.Count())
+	})
+
+	t.Run("sender not allowed", func(t *testing.T) {
+		events := &contexts.EventContext{}
+		_, err := (&Email{}).HandleAction(core.TriggerActionContext{
+			Name:          ReceiveAction,
+			Parameters:    delivery.Parameters(),
+			Configuration: map[string]any{"senders": []string{"alerts@other.com", "@example.com"}},
+			Events:        events,
+		})
+
+		require.ErrorIs(t, err, ErrSenderNotAllowed)
+		assert.Equal(t, 0, events.Count())
+	})
+
+	t.Run("envelope sender not allowed", func(t *testing.T) {
+		events := &contexts.EventContext{}
+		forged := Delivery{From: "attacker@evil.com", To: delivery.To, Data: delivery.Data}
+		_, err := (&Email{}).HandleAction(core.TriggerActionContext{
+			Name:          ReceiveAction,
+			Parameters:    forged.Parameters(),
+			Configuration: map[string]any{"senders": []string{"@vendor.com"}},
+			Events:        events,
+		})
+
+		require.ErrorIs(t, err, ErrSenderNotAllowed)
+		assert.Equal(t, 0, events.Count())
+	})
+
+	t.Run("invalid message", func(t *testing.T) {
+		invalid := Delivery{From: delivery.From, To: delivery.To, Data: []byte("not an email")}
+		_, err := (&Email{}).HandleAction(core.TriggerActionContext{
+			Name:          ReceiveAction,
+			Parameters:    invalid.Parameters(),
+			Configuration: map[string]any{},
+			Events:        &contexts.EventContext{},
+		})
+
+		require.ErrorIs(t, err, ErrInvalidMessage)
+	})
+}
+
+func Test__Email__HandleWebhook(t *testing.T) {
+	events := &contexts.EventContext{}
+	code, _, err := (&Email{}).HandleWebhook(core.WebhookRequestContext{
+		Body:          []byte(multipartMessage),
+		Headers:       http.Header{},
+		Configuration: map[string]any{},
+		Events:        events,
+	})
+
+	require.Error(t, err)
+	assert.Equal(t, http.StatusNotFound, code)
+	assert.Equal(t, 0, events.Count())
+}
@@ -0,0 +1,297 @@
+package email
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding/htmlindex"
+)
+
+type Message struct {
+	From        []string
+	To          []string
+	Cc          []string
+	ReplyTo     []string
+	Subject     string
+	Date        string
+	MessageID   string
+	Headers     map[string][]string
+	Text        string
+	HTML        string
+	Truncated   bool
+	Attachments []Attachment
+	Envelope    Envelope
+}
+
+type Attachment struct {
+	Filename    string
+	ContentType string
+	Size        int
+}
+
+type Envelope struct {
+	From string
+	To   []string
+}
+
+// FromAddress returns the first address in the From header.
+func (m *Message) FromAddress() string {
+	if len(m.From) == 0 {
+		return ""
+	}
+
+	return m.From[0]
+}
+
+func (m *Message) ToMap() map[string]any {
+	attachments := []any{}
+	for _, attachment := range m.Attachments {
+		attachments = append(attachments, map[string]any{
+			"filename":    attachment.Filename,
+			"contentType": attachment.ContentType,
+			"size":        attachment.Size,
+		})
+	}
+
+	headers := map[string]any{}
+	for name, values := range m.Headers {
+		headers[name] = values
+	}
+
+	return map[string]any{
+		"from":        m.FromAddress(),
+		"to":          m.To,
+		"cc":          m.Cc,
+		"replyTo":     m.ReplyTo,
+		"subject":     m.Subject,
+		"date":        m.Date,
+		"messageId":   m.MessageID,
+		"headers":     headers,
+		"text":        m.Text,
+		"html":        m.HTML,
+		"truncated":   m.Truncated,
+		"attachments": attachments,
+		"envelope": map[string]any{
+			"from": m.Envelope.From,
+			"to":   m.Envelope.To,
+		},
+	}
+}
+
+var wordDecoder = mime.WordDecoder{}
+
+// ParseMessage parses a raw RFC 5322 message.
+// Only metadata is kept for attachments, and bodies are truncated to MaxBodySize.
+func ParseMessage(raw []byte) (*Message, error) {
+	msg, err := mail.ReadMessage(bytes.NewReader(raw))
+	if err != nil {
+		return nil, err
+	}
+
+	message := &Message{
+		From:        parseAddressList(msg.Header.Get("From")),
+		To:          parseAddressList(msg.Header.Get("To")),
+		Cc:          parseAddressList(msg.Header.Get("Cc")),
+		ReplyTo:     parseAddressList(msg.Header.Get("Reply-To")),
+		Subject:     decodeHeader(msg.Header.Get("Subject")),
+		MessageID:   strings.Trim(msg.Header.Get("Message-Id"), "<>"),
+		Headers:     map[string][]string{},
+		Attachments: []Attachment{},
+	}
+
+	for name, values := range msg.Header {
+		decoded := make([]string, 0, len(values))
+		for _, value := range values {
+			decoded = append(decoded, decodeHeader(value))
+		}
+
+		message.Headers[name] = decoded
+	}
+
+	if date, err := msg.Header.Date(); err == nil {
+		message.Date = date.UTC().Format(time.RFC3339)
+	}
+
+	err = message.readPart(
+		msg.Header.Get("Content-Type"),
+		msg.Header.Get("Content-Disposition"),
+		msg.Header.Get("Content-Transfer-Encoding"),
+		msg.Body,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return message, nil
+}
+
+func (m *Message) readPart(contentType, disposition, encoding string, body io.Reader) error {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		mediaType = "text/plain"
+		params = map[string]string{}
+	}
+
+	if strings.HasPrefix(mediaType, "multipart/") {
+		reader := multipart.NewReader(body, params["boundary"])
+		for {
+			part, err := reader.NextRawPart()
+			if err == io.EOF {
+				return nil
+			}
+
+			if err != nil {
+				return fmt.Errorf("error reading multipart body: %v", err)
+			}
+
+			err = m.readPart(
+				part.Header.Get("Content-Type"),
+				part.Header.Get("Content-Disposition"),
+				part.Header.Get("Content-Transfer-Encoding"),
+				part,
+			)
+
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	content, err := io.ReadAll(decodeTransferEncoding(encoding, body))
+	if err != nil {
+		return fmt.Errorf("error reading body: %v", err)
+	}
+
+	filename := attachmentFilename(disposition, params)
+	if filename != "" || !strings.HasPrefix(mediaType, "text/") {
+		m.Attachments = append(m.Attachments, Attachment{
+			Filename:    filename,
+			ContentType: mediaType,
+			Size:        len(content),
+		})
+
+		return nil
+	}
+
+	//
+	// Only the first text and HTML bodies are used,
+	// the other ones are usually alternative representations.
+	//
+	switch {
+	case mediaType == "text/html" && m.HTML == "":
+		m.HTML = m.truncate(decodeCharset(params["charset"], content))
+	case mediaType != "text/html" && m.Text == "":
+		m.Text = m.truncate(decodeCharset(params["charset"], content))
+	}
+
+	return nil
+}
+
+func (m *Message) truncate(body string) string {
+	if len(body) <= MaxBodySize {
+		return body
+	}
+
+	m.Truncated = true
+	body = body[:MaxBodySize]
+
+	//
+	// Bodies are valid UTF-8 after decoding,
+	// so only the last rune can be cut in the middle.
+	//
+	for i := 1; i < utf8.UTFMax; i++ {
+		r, size := utf8.DecodeLastRuneInString(body)
+		if r != utf8.RuneError || size != 1 {
+			break
+		}
+
+		body = body[:len(body)-1]
+	}
+
+	return body
+}
+
+// decodeCharset converts a text body to UTF-8 using the charset of its Content-Type.
+// Bodies without a charset are read as UTF-8, and invalid bytes are replaced.
+func decodeCharset(charset string, content []byte) string {
+	if charset == "" {
+		charset = "utf-8"
+	}
+
+	encoding, err := htmlindex.Get(charset)
+	if err != nil {
+		return strings.ToValidUTF8(string(content), string(utf8.RuneError))
+	}
+
+	decoded, err := encoding.NewDecoder().Bytes(content)
+	if err != nil {
+		return strings.ToValidUTF8(string(content), string(utf8.RuneError))
+	}
+
+	return string(decoded)
+}
+
+func attachmentFilename(disposition string, contentTypeParams map[string]string) string {
+	if disposition != "" {
+		kind, params, err := mime.ParseMediaType(disposition)
+		if err == nil {
+			if params["filename"] != "" {
+				return decodeHeader(params["filename"])
+			}
+
+			if kind == "attachment" {
+				return "attachment"
+			}
+		}
+	}
+
+	return decodeHeader(contentTypeParams["name"])
+}
+
+func decodeTransferEncoding(encoding string, body io.Reader) io.Reader {
+	switch strings.ToLower(strings.TrimSpace(encoding)) {
+	case "base64":
+		return base64.NewDecoder(base64.StdEncoding, body)
+	case "quoted-printable":
+		return quotedprintable.NewReader(body)
+	default:
+		return body
+	}
+}
+
+func decodeHeader(value string) string {
+	decoded, err := wordDecoder.DecodeHeader(value)
+	if err != nil {
+		return value
+	}
+
+	return decoded
+}
+
+func parseAddressList(value string) []string {
+	if value == "" {
+		return []string{}
+	}
+
+	addresses, err := mail.ParseAddressList(value)
+	if err != nil {
+		return []string{decodeHeader(value)}
+	}
+
+	result := make([]string, 0, len(addresses))
+	for _, address := range addresses {
+		result = append(result, address.Address)
+	}
+
+	return result
+}
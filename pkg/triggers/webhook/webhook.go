@@ -30,8 +30,9 @@ type Metadata struct {
 }
 
 type Configuration struct {
-	Authentication string `json:"authentication"`
-	HeaderName     string `json:"headerName" mapstructure:"headerName"`
+	Authentication string                     `json:"authentication"`
+	HeaderName     string                     `json:"headerName" mapstructure:"headerName"`
+	StoreSecretIn  configuration.SecretKeyRef `json:"storeSecretIn" mapstructure:"storeSecretIn"`
 }
 
 func (w *Webhook) Name() string {
@@ -80,6 +81,7 @@ The webhook payload includes:
 
 - Each webhook has a unique secret key for authentication
 - Secrets can be reset using the "Reset Authentication" action
+- The reset secret can also be stored in a key of a canvas secret, with **Store Secret In**, as a new version of the secret
 - Maximum payload size: 64KB
 
 ## Example Usage
@@ -129,6 +131,15 @@ func (w *Webhook) Configuration() []configuration.Field {
 				{Field: "authentication", Values: []string{"header_token"}},
 			},
 		},
+		{
+			Name:        "storeSecretIn",
+			Label:       "Store Secret In",
+			Type:        configuration.FieldTypeSecretKey,
+			Description: "Canvas secret key that gets the new secret when the authentication is reset",
+			VisibilityConditions: []configuration.VisibilityCondition{
+				{Field: "authentication", Values: []string{"signature", "bearer", "header_token"}},
+			},
+		},
 	}
 }
 
@@ -217,6 +228,13 @@ func (w *Webhook) resetAuthentication(ctx core.TriggerActionContext) (map[string
 		return nil, fmt.Errorf("failed to reset authentication: %w", err)
 	}
 
+	if config.StoreSecretIn.IsSet() {
+		err = ctx.Secrets.Rotate(config.StoreSecretIn.Secret, map[string]string{config.StoreSecretIn.Key: string(plainKey)})
+		if err != nil {
+			return nil, fmt.Errorf("authentication was reset, but storing it in secret %s failed: %w", config.StoreSecretIn.Secret, err)
+		}
+	}
+
 	result := map[string]any{
 		"secret": string(plainKey),
 	}
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/configuration"
 	"github.com/superplanehq/superplane/pkg/core"
 	"github.com/superplanehq/superplane/test/support/contexts"
 )
@@ -134,6 +135,30 @@ func Test__Webhook__HandleAction__ResetAuthentication(t *testing.T) {
 		require.Equal(t, "header-token-secret", result["secret"])
 	})
 
+	t.Run("stores the new secret in a canvas secret", func(t *testing.T) {
+		webhook := &Webhook{}
+		secretsCtx := &contexts.SecretsContext{}
+		metadataCtx := &contexts.MetadataContext{
+			Metadata: Metadata{Authentication: "bearer"},
+		}
+
+		_, err := webhook.HandleAction(core.TriggerActionContext{
+			Name: "resetAuthentication",
+			Configuration: Configuration{
+				Authentication: "bearer",
+				StoreSecretIn:  configuration.SecretKeyRef{Secret: "webhook", Key: "token"},
+			},
+			Metadata: metadataCtx,
+			Webhook:  &contexts.NodeWebhookContext{Secret: "bearer-secret"},
+			Secrets:  secretsCtx,
+		})
+
+		require.NoError(t, err)
+		value, err := secretsCtx.GetKey("webhook", "token")
+		require.NoError(t, err)
+		require.Equal(t, "bearer-secret", string(value))
+	})
+
 	t.Run("rejects unsupported authentication", func(t *testing.T) {
 		webhook := &Webhook{}
 		metadataCtx := &contexts.MetadataContext{
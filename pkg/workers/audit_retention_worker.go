@@ -0,0 +1,62 @@
+package workers
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/models"
+)
+
+// AuditRetentionWorker deletes audit events older than the retention period.
+// Events are deleted in batches, so a long backlog does not lock the table.
+type AuditRetentionWorker struct {
+	retention time.Duration
+	logger    *log.Entry
+	interval  time.Duration
+	batchSize int
+}
+
+func NewAuditRetentionWorker(retentionDays int) *AuditRetentionWorker {
+	return &AuditRetentionWorker{
+		retention: time.Duration(retentionDays) * 24 * time.Hour,
+		logger:    log.WithFields(log.Fields{"worker": "AuditRetentionWorker"}),
+		interval:  time.Hour,
+		batchSize: 1000,
+	}
+}
+
+func (w *AuditRetentionWorker) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.Tick()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *AuditRetentionWorker) Tick() {
+	cutoff := time.Now().Add(-w.retention)
+
+	for {
+		deleted, err := models.DeleteAuditEventsOlderThan(cutoff, w.batchSize)
+		if err != nil {
+			w.logger.Errorf("Error deleting audit events: %v", err)
+			return
+		}
+
+		if deleted > 0 {
+			w.logger.Infof("Deleted %d audit events older than %s", deleted, cutoff.Format(time.RFC3339))
+		}
+
+		if deleted < int64(w.batchSize) {
+			return
+		}
+	}
+}
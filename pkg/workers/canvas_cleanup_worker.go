@@ -62,11 +62,27 @@ func (w *CanvasCleanupWorker) Start(ctx context.Context) {
 				}(canvas)
 			}
 
+			w.DeleteExpiredMemories()
+
 			telemetry.RecordWorkflowCleanupWorkerTickDuration(context.Background(), time.Since(tickStart))
 		}
 	}
 }
 
+// DeleteExpiredMemories removes canvas memory records
+// older than the TTL of their namespace, in batches of maxResourcesPerTick.
+func (w *CanvasCleanupWorker) DeleteExpiredMemories() {
+	deleted, err := models.DeleteExpiredCanvasMemories(w.maxResourcesPerTick)
+	if err != nil {
+		w.logger.Errorf("Error deleting expired canvas memories: %v", err)
+		return
+	}
+
+	if deleted > 0 {
+		w.logger.Infof("Deleted %d expired canvas memories", deleted)
+	}
+}
+
 func (w *CanvasCleanupWorker) LockAndProcessCanvas(canvas models.Canvas) error {
 	return database.Conn().Transaction(func(tx *gorm.DB) error {
 		lockedCanvas, err := models.LockCanvas(tx, canvas.ID)
@@ -138,6 +154,10 @@ func (w *CanvasCleanupWorker) processCanvas(tx *gorm.DB, canvas models.Canvas) e
 	}
 
 	w.logger.Infof("Processed %d nodes from canvas %s (deleted %d resources, %d nodes remaining)", nodesProcessed, canvas.ID, totalResourcesDeleted, remainingNodesCount)
+	if err := models.DeleteCanvasSecretsInTransaction(tx, canvas.ID); err != nil {
+		return fmt.Errorf("failed to delete canvas secrets: %w", err)
+	}
+
 	if err := tx.Unscoped().Delete(&canvas).Error; err != nil {
 		return fmt.Errorf("failed to delete canvas: %w", err)
 	}
@@ -406,3 +406,43 @@ func Test__CanvasCleanupWorker_IgnoresNonDeletedWorkflows(t *testing.T) {
 
 	support.VerifyCanvasEventsCount(t, canvas.ID, 1)
 }
+
+func Test__CanvasCleanupWorker_DeletesExpiredMemories(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+	worker := NewCanvasCleanupWorker()
+
+	canvas, _ := support.CreateCanvas(t, r.Organization.ID, r.User, []models.CanvasNode{}, []models.Edge{})
+
+	require.NoError(t, models.SaveCanvasMemoryNamespace(&models.CanvasMemoryNamespace{
+		CanvasID:   canvas.ID,
+		Namespace:  "deployments",
+		TTLSeconds: 60,
+	}))
+
+	require.NoError(t, models.AddCanvasMemory(canvas.ID, "deployments", map[string]any{"version": "1.0.0"}))
+	require.NoError(t, models.AddCanvasMemory(canvas.ID, "deployments", map[string]any{"version": "2.0.0"}))
+	require.NoError(t, models.AddCanvasMemory(canvas.ID, "releases", map[string]any{"version": "1.0.0"}))
+
+	//
+	// Move the first deployment and the release past the TTL.
+	// Only the deployment is expired, since releases have no TTL.
+	//
+	require.NoError(t, database.Conn().Exec(
+		`UPDATE canvas_memories SET updated_at = NOW() - interval '2 minutes' WHERE values @> '{"version": "1.0.0"}'::jsonb`,
+	).Error)
+
+	records, err := models.ListCanvasMemoriesByNamespace(canvas.ID, "deployments")
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+
+	worker.DeleteExpiredMemories()
+
+	var count int64
+	require.NoError(t, database.Conn().Model(&models.CanvasMemory{}).Where("canvas_id = ?", canvas.ID).Count(&count).Error)
+	assert.Equal(t, int64(2), count)
+
+	records, err = models.ListCanvasMemoriesByNamespace(canvas.ID, "releases")
+	require.NoError(t, err)
+	assert.Len(t, records, 1)
+}
@@ -0,0 +1,368 @@
+package workers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/renderedtext/go-tackle"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
+	"github.com/superplanehq/superplane/pkg/logging"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"github.com/superplanehq/superplane/pkg/registry"
+	"github.com/superplanehq/superplane/pkg/triggers/canvasexecution"
+	"github.com/superplanehq/superplane/pkg/workers/contexts"
+)
+
+// Key used to record, on the finished execution,
+// which canvasExecution trigger nodes were already notified about it.
+// The same execution message is published more than once,
+// so this is what keeps us from emitting duplicate events.
+const CanvasExecutionTriggerKVKey = "canvasExecutionTrigger"
+
+// Executions can finish in places that do not publish execution messages,
+// so finished executions are also swept periodically.
+// The sweep looks a bit further back than its interval, so nothing is missed.
+const (
+	canvasExecutionSweepInterval = time.Minute
+	canvasExecutionSweepWindow   = 5 * time.Minute
+)
+
+type CanvasExecutionTriggerWorker struct {
+	registry *registry.Registry
+	logger   *log.Entry
+
+	rabbitMQURL string
+	consumer    *tackle.Consumer
+}
+
+func NewCanvasExecutionTriggerWorker(registry *registry.Registry, rabbitMQURL string) *CanvasExecutionTriggerWorker {
+	return &CanvasExecutionTriggerWorker{
+		registry:    registry,
+		logger:      log.WithFields(log.Fields{"worker": "CanvasExecutionTriggerWorker"}),
+		rabbitMQURL: rabbitMQURL,
+	}
+}
+
+func (w *CanvasExecutionTriggerWorker) Name() string {
+	return "CanvasExecutionTriggerWorker"
+}
+
+func (w *CanvasExecutionTriggerWorker) Start(ctx context.Context) {
+	go w.StartRabbitMQConsumer(ctx)
+
+	ticker := time.NewTicker(canvasExecutionSweepInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.sweep()
+		}
+	}
+}
+
+func (w *CanvasExecutionTriggerWorker) sweep() {
+	executions, err := models.ListFinishedNodeExecutionsForTrigger(canvasexecution.Name, time.Now().Add(-canvasExecutionSweepWindow))
+	if err != nil {
+		w.logger.Errorf("Error listing finished executions: %v", err)
+		return
+	}
+
+	for _, execution := range executions {
+		err := w.LockAndProcessExecution(execution.ID)
+		if err != nil && !errors.Is(err, ErrRecordLocked) {
+			w.logger.Errorf("Error processing execution %s: %v", execution.ID, err)
+		}
+	}
+}
+
+func (w *CanvasExecutionTriggerWorker) StartRabbitMQConsumer(ctx context.Context) {
+	options := tackle.Options{
+		URL:            w.rabbitMQURL,
+		ConnectionName: w.Name(),
+		RemoteExchange: messages.WorkflowExchange,
+		Service:        messages.WorkflowExchange + "." + messages.WorkflowExecutionRoutingKey + "." + w.Name(),
+		RoutingKey:     messages.WorkflowExecutionRoutingKey,
+	}
+
+	consumer := tackle.NewConsumer()
+	consumer.SetLogger(logging.NewTackleLogger(w.logger))
+	w.consumer = consumer
+
+	for {
+		w.logger.Infof("Connecting to RabbitMQ queue for %s events", messages.WorkflowExecutionRoutingKey)
+
+		err := w.consumer.Start(&options, w.Consume)
+		if err != nil {
+			w.logger.Errorf("Error consuming messages from %s: %v", messages.WorkflowExecutionRoutingKey, err)
+			time.Sleep(5 * time.Second)
+			continue
+		}
+
+		w.logger.Warnf("Connection to RabbitMQ closed for %s, reconnecting...", messages.WorkflowExecutionRoutingKey)
+		time.Sleep(5 * time.Second)
+	}
+}
+
+func (w *CanvasExecutionTriggerWorker) Consume(delivery tackle.Delivery) error {
+	data := &pb.CanvasNodeExecutionMessage{}
+	err := proto.Unmarshal(delivery.Body(), data)
+	if err != nil {
+		w.logger.Errorf("Error unmarshaling canvas execution message: %v", err)
+		return err
+	}
+
+	executionID, err := uuid.Parse(data.Id)
+	if err != nil {
+		w.logger.Errorf("Error parsing execution id: %v", err)
+		return nil
+	}
+
+	//
+	// If the execution is locked, the message is returned
+	// so it is retried once whoever holds the lock is done.
+	//
+	err = w.LockAndProcessExecution(executionID)
+	if err != nil {
+		w.logger.Errorf("Error processing execution %s: %v", executionID, err)
+		return err
+	}
+
+	return nil
+}
+
+func (w *CanvasExecutionTriggerWorker) LockAndProcessExecution(id uuid.UUID) error {
+	newEvents := []models.CanvasEvent{}
+	onNewEvents := func(events []models.CanvasEvent) {
+		newEvents = append(newEvents, events...)
+	}
+
+	id, err := w.findTopLevelExecutionID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			w.logger.Debugf("Execution %s not found - skipping", id)
+			return nil
+		}
+
+		return err
+	}
+
+	err = database.Conn().Transaction(func(tx *gorm.DB) error {
+		execution, err := models.LockCanvasNodeExecution(tx, id)
+		if err != nil {
+			w.logger.Debugf("Execution %s locked", id)
+			return ErrRecordLocked
+		}
+
+		return w.processExecution(tx, execution, onNewEvents)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	for _, event := range newEvents {
+		messages.NewCanvasEventCreatedMessage(event.WorkflowID.String(), &event).Publish()
+	}
+
+	return nil
+}
+
+// findTopLevelExecutionID returns the ID of the execution visible to other canvases.
+// Executions of nodes inside of blueprints are not, but they can finish
+// the execution of the blueprint node when they fail, so that one is used instead.
+func (w *CanvasExecutionTriggerWorker) findTopLevelExecutionID(id uuid.UUID) (uuid.UUID, error) {
+	var execution models.CanvasNodeExecution
+	err := database.Conn().Where("id = ?", id).First(&execution).Error
+	if err != nil {
+		return id, err
+	}
+
+	if execution.ParentExecutionID != nil {
+		return *execution.ParentExecutionID, nil
+	}
+
+	return id, nil
+}
+
+func (w *CanvasExecutionTriggerWorker) processExecution(tx *gorm.DB, execution *models.CanvasNodeExecution, onNewEvents func([]models.CanvasEvent)) error {
+	if execution.State != models.CanvasNodeExecutionStateFinished {
+		return nil
+	}
+
+	canvas, err := models.FindCanvasWithoutOrgScopeInTransaction(tx, execution.WorkflowID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+
+		return fmt.Errorf("failed to find canvas: %w", err)
+	}
+
+	triggerNodes, err := models.ListTriggerNodesForOrganizationInTransaction(tx, canvas.OrganizationID, canvasexecution.Name)
+	if err != nil {
+		return fmt.Errorf("failed to list trigger nodes: %w", err)
+	}
+
+	if len(triggerNodes) == 0 {
+		return nil
+	}
+
+	chain := w.buildChain(tx, canvas, execution)
+	if len(chain) >= canvasexecution.MaxChainLength {
+		w.logger.Warnf("Execution %s finished after a chain of %d canvases - not triggering other canvases", execution.ID, len(chain))
+		return nil
+	}
+
+	parameters, err := w.buildParameters(tx, canvas, execution, chain)
+	if err != nil {
+		return err
+	}
+
+	for _, triggerNode := range triggerNodes {
+		//
+		// Canvases cannot trigger themselves, or any canvas
+		// in the chain that led to this execution, since that would be a loop.
+		//
+		if slices.Contains(chain, triggerNode.WorkflowID.String()) {
+			continue
+		}
+
+		err := w.notifyTrigger(tx, execution, triggerNode, parameters, onNewEvents)
+		if err != nil {
+			return fmt.Errorf("failed to notify trigger %s in canvas %s: %w", triggerNode.NodeID, triggerNode.WorkflowID, err)
+		}
+	}
+
+	return nil
+}
+
+func (w *CanvasExecutionTriggerWorker) notifyTrigger(
+	tx *gorm.DB,
+	execution *models.CanvasNodeExecution,
+	triggerNode models.CanvasNode,
+	parameters map[string]any,
+	onNewEvents func([]models.CanvasEvent),
+) error {
+	kvValue := fmt.Sprintf("%s/%s", triggerNode.WorkflowID, triggerNode.NodeID)
+	notified, err := models.NodeExecutionKVExistsInTransaction(tx, execution.ID, CanvasExecutionTriggerKVKey, kvValue)
+	if err != nil {
+		return err
+	}
+
+	if notified {
+		return nil
+	}
+
+	trigger, err := w.registry.GetTrigger(canvasexecution.Name)
+	if err != nil {
+		return err
+	}
+
+	_, err = trigger.HandleAction(core.TriggerActionContext{
+		Name:          canvasexecution.ActionExecutionFinished,
+		Parameters:    parameters,
+		Configuration: triggerNode.Configuration.Data(),
+		Logger:        logging.ForNode(triggerNode),
+		HTTP:          w.registry.HTTPContext(),
+		Metadata:      contexts.NewNodeMetadataContext(tx, &triggerNode),
+		Events:        contexts.NewEventContext(tx, &triggerNode, onNewEvents),
+		Requests:      contexts.NewNodeRequestContext(tx, &triggerNode),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return models.CreateNodeExecutionKVInTransaction(tx, execution.WorkflowID, execution.NodeID, execution.ID, CanvasExecutionTriggerKVKey, kvValue)
+}
+
+// buildChain returns the IDs of the canvases that led to the execution, ending with its own canvas.
+// If the execution was started by this trigger, the chain is recorded in its root event.
+func (w *CanvasExecutionTriggerWorker) buildChain(tx *gorm.DB, canvas *models.Canvas, execution *models.CanvasNodeExecution) []string {
+	chain := []string{}
+
+	rootEvent, err := models.FindCanvasEventInTransaction(tx, execution.RootEventID)
+	if err == nil {
+		rootNode, err := models.FindCanvasNode(tx, rootEvent.WorkflowID, rootEvent.NodeID)
+		if err == nil && rootNode.Type == models.NodeTypeTrigger {
+			ref := rootNode.Ref.Data()
+			if ref.Trigger != nil && ref.Trigger.Name == canvasexecution.Name {
+				chain = canvasexecution.ChainFromEventData(rootEvent.Data.Data())
+			}
+		}
+	}
+
+	return append(chain, canvas.ID.String())
+}
+
+func (w *CanvasExecutionTriggerWorker) buildParameters(tx *gorm.DB, canvas *models.Canvas, execution *models.CanvasNodeExecution, chain []string) (map[string]any, error) {
+	nodeName := execution.NodeID
+	node, err := models.FindCanvasNode(tx, canvas.ID, execution.NodeID)
+	if err == nil && node.Name != "" {
+		nodeName = node.Name
+	}
+
+	outputEvents, err := execution.GetOutputsInTransaction(tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find execution outputs: %w", err)
+	}
+
+	outputs := map[string][]any{}
+	for _, event := range outputEvents {
+		outputs[event.Channel] = append(outputs[event.Channel], event.Data.Data())
+	}
+
+	finished := canvasexecution.FinishedExecution{
+		Canvas: canvasexecution.Reference{ID: canvas.ID.String(), Name: canvas.Name},
+		Node:   canvasexecution.Reference{ID: execution.NodeID, Name: nodeName},
+		Execution: canvasexecution.ExecutionDetails{
+			ID:            execution.ID.String(),
+			RootEventID:   execution.RootEventID.String(),
+			Result:        execution.Result,
+			ResultReason:  execution.ResultReason,
+			ResultMessage: execution.ResultMessage,
+		},
+		Outputs: outputs,
+		Chain:   chain,
+	}
+
+	if execution.CreatedAt != nil {
+		finished.Execution.CreatedAt = execution.CreatedAt.Format(time.RFC3339)
+	}
+
+	if execution.UpdatedAt != nil {
+		finished.Execution.FinishedAt = execution.UpdatedAt.Format(time.RFC3339)
+	}
+
+	//
+	// Parameters are passed to the trigger the same way
+	// they would be if coming from a node request.
+	//
+	data, err := json.Marshal(finished)
+	if err != nil {
+		return nil, err
+	}
+
+	var parameters map[string]any
+	err = json.Unmarshal(data, &parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	return parameters, nil
+}
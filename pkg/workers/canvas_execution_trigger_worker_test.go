@@ -0,0 +1,325 @@
+package workers
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/config"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/test/support"
+	"gorm.io/datatypes"
+)
+
+func Test__CanvasExecutionTriggerWorker_EmitsEventForMatchingTrigger(t *testing.T) {
+	r := support.Setup(t)
+	amqpURL, _ := config.RabbitMQURL()
+	worker := NewCanvasExecutionTriggerWorker(r.Registry, amqpURL)
+
+	//
+	// Source canvas, with a trigger and a component.
+	//
+	sourceTrigger := "trigger-1"
+	sourceNode := "component-1"
+	sourceCanvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{NodeID: sourceTrigger, Type: models.NodeTypeTrigger},
+			{NodeID: sourceNode, Type: models.NodeTypeComponent},
+		},
+		[]models.Edge{
+			{SourceID: sourceTrigger, TargetID: sourceNode, Channel: "default"},
+		},
+	)
+
+	//
+	// Target canvas, watching the component in the source canvas.
+	//
+	targetTrigger := "canvas-execution-1"
+	targetCanvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: targetTrigger,
+				Type:   models.NodeTypeTrigger,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Trigger: &models.TriggerRef{Name: "canvasExecution"}}),
+				Configuration: datatypes.NewJSONType(map[string]any{
+					"canvas":  sourceCanvas.ID.String(),
+					"node":    sourceNode,
+					"results": []string{"passed"},
+				}),
+			},
+		},
+		[]models.Edge{},
+	)
+
+	triggerEvent := support.EmitCanvasEventForNode(t, sourceCanvas.ID, sourceTrigger, "default", nil)
+	execution := support.CreateCanvasNodeExecution(t, sourceCanvas.ID, sourceNode, triggerEvent.ID, triggerEvent.ID, nil)
+
+	//
+	// Execution is not finished yet, so nothing is emitted.
+	//
+	require.NoError(t, worker.LockAndProcessExecution(execution.ID))
+	support.VerifyCanvasNodeEventsCount(t, targetCanvas.ID, targetTrigger, 0)
+
+	//
+	// Execution finishes, and event is emitted on the target trigger.
+	//
+	_, err := execution.Pass(map[string][]any{"default": {map[string]any{"hello": "world"}}})
+	require.NoError(t, err)
+	require.NoError(t, worker.LockAndProcessExecution(execution.ID))
+	support.VerifyCanvasNodeEventsCount(t, targetCanvas.ID, targetTrigger, 1)
+
+	events, err := models.ListCanvasEvents(targetCanvas.ID, targetTrigger, 10, nil)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Nil(t, events[0].ExecutionID)
+
+	payload, ok := events[0].Data.Data().(map[string]any)
+	require.True(t, ok)
+	assert.Equal(t, "canvas.execution.finished", payload["type"])
+	data, ok := payload["data"].(map[string]any)
+	require.True(t, ok)
+	assert.Equal(t, sourceCanvas.ID.String(), data["canvas"].(map[string]any)["id"])
+	assert.Equal(t, execution.ID.String(), data["execution"].(map[string]any)["id"])
+	assert.Equal(t, "passed", data["execution"].(map[string]any)["result"])
+	assert.Len(t, data["outputs"].(map[string]any)["default"], 1)
+
+	//
+	// The same execution message can be received more than once,
+	// but only one event is emitted for it.
+	//
+	require.NoError(t, worker.LockAndProcessExecution(execution.ID))
+	support.VerifyCanvasNodeEventsCount(t, targetCanvas.ID, targetTrigger, 1)
+}
+
+func Test__CanvasExecutionTriggerWorker_IgnoresNonMatchingResult(t *testing.T) {
+	r := support.Setup(t)
+	amqpURL, _ := config.RabbitMQURL()
+	worker := NewCanvasExecutionTriggerWorker(r.Registry, amqpURL)
+
+	sourceTrigger := "trigger-1"
+	sourceNode := "component-1"
+	sourceCanvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{NodeID: sourceTrigger, Type: models.NodeTypeTrigger},
+			{NodeID: sourceNode, Type: models.NodeTypeComponent},
+		},
+		[]models.Edge{
+			{SourceID: sourceTrigger, TargetID: sourceNode, Channel: "default"},
+		},
+	)
+
+	targetTrigger := "canvas-execution-1"
+	targetCanvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: targetTrigger,
+				Type:   models.NodeTypeTrigger,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Trigger: &models.TriggerRef{Name: "canvasExecution"}}),
+				Configuration: datatypes.NewJSONType(map[string]any{
+					"canvas":  sourceCanvas.Name,
+					"node":    sourceNode,
+					"results": []string{"passed"},
+				}),
+			},
+		},
+		[]models.Edge{},
+	)
+
+	triggerEvent := support.EmitCanvasEventForNode(t, sourceCanvas.ID, sourceTrigger, "default", nil)
+	execution := support.CreateCanvasNodeExecution(t, sourceCanvas.ID, sourceNode, triggerEvent.ID, triggerEvent.ID, nil)
+	require.NoError(t, execution.Fail(models.CanvasNodeExecutionResultReasonError, "boom"))
+
+	require.NoError(t, worker.LockAndProcessExecution(execution.ID))
+	support.VerifyCanvasNodeEventsCount(t, targetCanvas.ID, targetTrigger, 0)
+}
+
+func Test__CanvasExecutionTriggerWorker_IgnoresExecutionsFromSameCanvas(t *testing.T) {
+	r := support.Setup(t)
+	amqpURL, _ := config.RabbitMQURL()
+	worker := NewCanvasExecutionTriggerWorker(r.Registry, amqpURL)
+
+	triggerNode := "canvas-execution-1"
+	componentNode := "component-1"
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: triggerNode,
+				Type:   models.NodeTypeTrigger,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Trigger: &models.TriggerRef{Name: "canvasExecution"}}),
+			},
+			{NodeID: componentNode, Type: models.NodeTypeComponent},
+		},
+		[]models.Edge{
+			{SourceID: triggerNode, TargetID: componentNode, Channel: "default"},
+		},
+	)
+
+	//
+	// Point the trigger to its own canvas.
+	//
+	err := database.Conn().
+		Model(&models.CanvasNode{}).
+		Where("workflow_id = ? AND node_id = ?", canvas.ID, triggerNode).
+		Update("configuration", datatypes.NewJSONType(map[string]any{
+			"canvas":  canvas.ID.String(),
+			"node":    componentNode,
+			"results": []string{"passed"},
+		})).
+		Error
+	require.NoError(t, err)
+
+	triggerEvent := support.EmitCanvasEventForNode(t, canvas.ID, triggerNode, "default", nil)
+	execution := support.CreateCanvasNodeExecution(t, canvas.ID, componentNode, triggerEvent.ID, triggerEvent.ID, nil)
+	_, err = execution.Pass(map[string][]any{"default": {map[string]any{}}})
+	require.NoError(t, err)
+
+	//
+	// Only the root event created above exists for the trigger.
+	//
+	require.NoError(t, worker.LockAndProcessExecution(execution.ID))
+	support.VerifyCanvasNodeEventsCount(t, canvas.ID, triggerNode, 1)
+}
+
+func Test__CanvasExecutionTriggerWorker_IgnoresLoopsBetweenCanvases(t *testing.T) {
+	r := support.Setup(t)
+	amqpURL, _ := config.RabbitMQURL()
+	worker := NewCanvasExecutionTriggerWorker(r.Registry, amqpURL)
+
+	//
+	// Canvas B is started by canvas A, and canvas A watches canvas B.
+	//
+	canvasA, _ := support.CreateCanvas(t, r.Organization.ID, r.User, []models.CanvasNode{}, []models.Edge{})
+
+	triggerNode := "from-a"
+	componentNode := "component-1"
+	canvasB, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: triggerNode,
+				Type:   models.NodeTypeTrigger,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Trigger: &models.TriggerRef{Name: "canvasExecution"}}),
+			},
+			{NodeID: componentNode, Type: models.NodeTypeComponent},
+		},
+		[]models.Edge{
+			{SourceID: triggerNode, TargetID: componentNode, Channel: "default"},
+		},
+	)
+
+	watchB := func(canvasID uuid.UUID, nodeID string) {
+		err := database.Conn().Create(&models.CanvasNode{
+			WorkflowID: canvasID,
+			NodeID:     nodeID,
+			Name:       nodeID,
+			Type:       models.NodeTypeTrigger,
+			State:      models.CanvasNodeStateReady,
+			Ref:        datatypes.NewJSONType(models.NodeRef{Trigger: &models.TriggerRef{Name: "canvasExecution"}}),
+			Configuration: datatypes.NewJSONType(map[string]any{
+				"canvas":  canvasB.ID.String(),
+				"node":    componentNode,
+				"results": []string{"passed"},
+			}),
+		}).Error
+		require.NoError(t, err)
+	}
+
+	watchB(canvasA.ID, "from-b")
+	canvasC, _ := support.CreateCanvas(t, r.Organization.ID, r.User, []models.CanvasNode{}, []models.Edge{})
+	watchB(canvasC.ID, "from-b")
+
+	rootEvent := support.EmitCanvasEventForNodeWithData(t, canvasB.ID, triggerNode, "default", nil, map[string]any{
+		"type": "canvas.execution.finished",
+		"data": map[string]any{"chain": []string{canvasA.ID.String()}},
+	})
+
+	execution := support.CreateCanvasNodeExecution(t, canvasB.ID, componentNode, rootEvent.ID, rootEvent.ID, nil)
+	_, err := execution.Pass(map[string][]any{"default": {map[string]any{}}})
+	require.NoError(t, err)
+	require.NoError(t, worker.LockAndProcessExecution(execution.ID))
+
+	//
+	// Canvas A is already in the chain, so it is not triggered again,
+	// but canvas C is, with the chain that led to it.
+	//
+	support.VerifyCanvasNodeEventsCount(t, canvasA.ID, "from-b", 0)
+	support.VerifyCanvasNodeEventsCount(t, canvasC.ID, "from-b", 1)
+
+	events, err := models.ListCanvasEvents(canvasC.ID, "from-b", 10, nil)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	data := events[0].Data.Data().(map[string]any)["data"].(map[string]any)
+	assert.Equal(t, []any{canvasA.ID.String(), canvasB.ID.String()}, data["chain"])
+}
+
+func Test__CanvasExecutionTriggerWorker_UsesParentOfChildExecutions(t *testing.T) {
+	r := support.Setup(t)
+	amqpURL, _ := config.RabbitMQURL()
+	worker := NewCanvasExecutionTriggerWorker(r.Registry, amqpURL)
+
+	sourceTrigger := "trigger-1"
+	blueprintNode := "blueprint-1"
+	sourceCanvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{NodeID: sourceTrigger, Type: models.NodeTypeTrigger},
+			{NodeID: blueprintNode, Type: models.NodeTypeBlueprint},
+		},
+		[]models.Edge{
+			{SourceID: sourceTrigger, TargetID: blueprintNode, Channel: "default"},
+		},
+	)
+
+	targetTrigger := "canvas-execution-1"
+	targetCanvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: targetTrigger,
+				Type:   models.NodeTypeTrigger,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Trigger: &models.TriggerRef{Name: "canvasExecution"}}),
+				Configuration: datatypes.NewJSONType(map[string]any{
+					"canvas":  sourceCanvas.ID.String(),
+					"node":    blueprintNode,
+					"results": []string{"failed"},
+				}),
+			},
+		},
+		[]models.Edge{},
+	)
+
+	triggerEvent := support.EmitCanvasEventForNode(t, sourceCanvas.ID, sourceTrigger, "default", nil)
+	parent := support.CreateCanvasNodeExecution(t, sourceCanvas.ID, blueprintNode, triggerEvent.ID, triggerEvent.ID, nil)
+	child := support.CreateCanvasNodeExecution(t, sourceCanvas.ID, blueprintNode+":child-1", triggerEvent.ID, triggerEvent.ID, &parent.ID)
+
+	//
+	// The failure of the child execution fails the blueprint execution too,
+	// and the message for the child is enough to notify other canvases.
+	//
+	require.NoError(t, child.Fail(models.CanvasNodeExecutionResultReasonError, "boom"))
+	require.NoError(t, worker.LockAndProcessExecution(child.ID))
+	support.VerifyCanvasNodeEventsCount(t, targetCanvas.ID, targetTrigger, 1)
+}
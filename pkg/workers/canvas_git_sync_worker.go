@@ -0,0 +1,267 @@
+package workers
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+	"unicode"
+
+	"golang.org/x/sync/semaphore"
+	"gorm.io/gorm"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/grpc/actions/canvases"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/registry"
+	"github.com/superplanehq/superplane/pkg/workers/contexts"
+)
+
+const (
+	CanvasGitSyncInterval     = time.Minute
+	canvasGitSyncCloneTimeout = time.Minute
+
+	canvasGitSyncMaxErrorOutput = 256
+)
+
+// CanvasGitSyncWorker reads the canvas files of canvases synced from Git repositories,
+// opening change requests when they change, and reporting drift
+// when the live canvas no longer matches them.
+type CanvasGitSyncWorker struct {
+	semaphore      *semaphore.Weighted
+	logger         *log.Entry
+	encryptor      crypto.Encryptor
+	registry       *registry.Registry
+	webhookBaseURL string
+	interval       time.Duration
+}
+
+func NewCanvasGitSyncWorker(encryptor crypto.Encryptor, registry *registry.Registry, webhookBaseURL string) *CanvasGitSyncWorker {
+	return &CanvasGitSyncWorker{
+		semaphore:      semaphore.NewWeighted(10),
+		logger:         log.WithFields(log.Fields{"worker": "CanvasGitSyncWorker"}),
+		encryptor:      encryptor,
+		registry:       registry,
+		webhookBaseURL: webhookBaseURL,
+		interval:       CanvasGitSyncInterval,
+	}
+}
+
+func (w *CanvasGitSyncWorker) Start(ctx context.Context) {
+	ticker := time.NewTicker(15 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			syncs, err := models.ListCanvasGitSyncsDue(time.Now().Add(-w.interval), 100)
+			if err != nil {
+				w.logger.Errorf("Error finding canvas Git syncs: %v", err)
+				continue
+			}
+
+			for _, sync := range syncs {
+				if err := w.semaphore.Acquire(context.Background(), 1); err != nil {
+					w.logger.Errorf("Error acquiring semaphore: %v", err)
+					continue
+				}
+
+				go func(sync models.CanvasGitSync) {
+					defer w.semaphore.Release(1)
+
+					if err := w.LockAndSync(ctx, sync); err != nil {
+						w.logger.Errorf("Error syncing canvas %s: %v", sync.CanvasID, err)
+					}
+				}(sync)
+			}
+		}
+	}
+}
+
+func (w *CanvasGitSyncWorker) LockAndSync(ctx context.Context, sync models.CanvasGitSync) error {
+	claimed, err := w.claim(sync)
+	if err != nil || claimed == nil {
+		return err
+	}
+
+	//
+	// The file is read outside of any transaction,
+	// since cloning and reading it through integrations can take a while.
+	// The sync was already marked as synced while claiming it,
+	// so other workers do not pick it up in the meantime.
+	//
+	syncErr := w.sync(ctx, claimed)
+	if syncErr != nil {
+		w.logger.Warnf("Failed to sync canvas %s from %s@%s: %v", claimed.CanvasID, claimed.Repository, claimed.Branch, syncErr)
+	}
+
+	claimed.LastError = ""
+	if syncErr != nil {
+		claimed.LastError = syncErr.Error()
+	}
+
+	return claimed.SaveStatus()
+}
+
+// claim locks the sync and marks it as synced,
+// returning nil if it is being processed by another worker,
+// or was synced between listing and locking it.
+func (w *CanvasGitSyncWorker) claim(sync models.CanvasGitSync) (*models.CanvasGitSync, error) {
+	var claimed *models.CanvasGitSync
+	err := database.Conn().Transaction(func(tx *gorm.DB) error {
+		locked, err := models.LockCanvasGitSync(tx, sync.ID)
+		if err != nil {
+			w.logger.Infof("Git sync for canvas %s already being processed - skipping", sync.CanvasID)
+			return nil
+		}
+
+		if locked.LastSyncedAt != nil && time.Since(*locked.LastSyncedAt) < w.interval {
+			return nil
+		}
+
+		now := time.Now()
+		locked.LastSyncedAt = &now
+		if err := locked.SaveStatusInTransaction(tx); err != nil {
+			return err
+		}
+
+		claimed = locked
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return claimed, nil
+}
+
+func (w *CanvasGitSyncWorker) sync(ctx context.Context, sync *models.CanvasGitSync) error {
+	content, err := w.readCanvasFile(ctx, sync)
+	if err != nil {
+		return err
+	}
+
+	return canvases.SyncCanvasFromGit(ctx, w.encryptor, w.registry, sync, content, w.webhookBaseURL)
+}
+
+func (w *CanvasGitSyncWorker) readCanvasFile(ctx context.Context, sync *models.CanvasGitSync) ([]byte, error) {
+	if sync.IntegrationID == nil {
+		if !canvases.IsValidGitRepositoryURL(sync.Repository) {
+			return nil, fmt.Errorf("repository %s is not a Git URL, and the sync has no integration", redactGitRepository(sync.Repository))
+		}
+
+		return readGitRepositoryFile(ctx, sync.Repository, sync.Branch, sync.Path)
+	}
+
+	instance, err := models.FindUnscopedIntegration(*sync.IntegrationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find integration: %w", err)
+	}
+
+	reader, err := w.registry.GetRepositoryFileReader(instance.AppName)
+	if err != nil {
+		return nil, err
+	}
+
+	return reader.ReadRepositoryFile(core.RepositoryFileContext{
+		HTTP:        w.registry.HTTPContext(),
+		Integration: contexts.NewIntegrationContext(database.Conn(), nil, instance, w.encryptor, w.registry, nil),
+		Repository:  sync.Repository,
+		Branch:      sync.Branch,
+		Path:        sync.Path,
+	})
+}
+
+// readGitRepositoryFile does a shallow clone of a single branch
+// into a temporary directory, and reads a file from it.
+func readGitRepositoryFile(ctx context.Context, repository, branch, path string) ([]byte, error) {
+	dir, err := os.MkdirTemp("", "canvas-git-sync-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	ctx, cancel := context.WithTimeout(ctx, canvasGitSyncCloneTimeout)
+	defer cancel()
+
+	// #nosec
+	cmd := exec.CommandContext(ctx, "git", "clone", "--quiet", "--depth", "1", "--single-branch", "--branch", branch, "--", repository, dir)
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return nil, fmt.Errorf("failed to clone %s: %v%s", redactGitRepository(repository), err, gitCloneOutputSummary(output))
+	}
+
+	//
+	// Symlinks in the repository are resolved,
+	// so the file read is always inside of the clone.
+	//
+	root, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve clone directory: %w", err)
+	}
+
+	filePath, err := filepath.EvalSymlinks(filepath.Join(root, filepath.FromSlash(path)))
+	if err != nil {
+		return nil, fmt.Errorf("%s not found in %s@%s", path, repository, branch)
+	}
+
+	if !strings.HasPrefix(filePath, root+string(filepath.Separator)) {
+		return nil, fmt.Errorf("%s is outside of the repository", path)
+	}
+
+	// #nosec
+	return os.ReadFile(filePath)
+}
+
+// gitCloneOutputSummary keeps only the last line of the clone output,
+// which is where Git reports why it failed, so progress messages
+// and anything else Git printed are not stored in the sync status.
+func gitCloneOutputSummary(output []byte) string {
+	lines := strings.Split(strings.TrimSpace(string(output)), "\n")
+	line := strings.TrimSpace(lines[len(lines)-1])
+	if line == "" {
+		return ""
+	}
+
+	line = strings.Map(func(r rune) rune {
+		if unicode.IsPrint(r) {
+			return r
+		}
+
+		return -1
+	}, line)
+
+	if len(line) > canvasGitSyncMaxErrorOutput {
+		line = strings.ToValidUTF8(line[:canvasGitSyncMaxErrorOutput], "") + "..."
+	}
+
+	return ": " + line
+}
+
+// redactGitRepository hides the credentials of repository URLs.
+// SSH user names are kept, since they are not secret.
+func redactGitRepository(repository string) string {
+	u, err := url.Parse(repository)
+	if err != nil || u.User == nil {
+		return repository
+	}
+
+	if u.Scheme == "ssh" {
+		return u.Redacted()
+	}
+
+	u.User = url.User("xxxxx")
+	return u.String()
+}
@@ -0,0 +1,35 @@
+package workers
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"net"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// transientError reports whether handling a message failed for reasons unrelated to the message,
+// like the database being unavailable, so handling it again later can succeed.
+// Other errors, like a missing node or a payload the database rejects,
+// fail the same way every time the message is delivered.
+func transientError(err error) bool {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		//
+		// Data exceptions (class 22) and integrity violations (class 23)
+		// are caused by what is being stored, not by the database.
+		//
+		return !strings.HasPrefix(pgErr.Code, "22") && !strings.HasPrefix(pgErr.Code, "23")
+	}
+
+	var connectErr *pgconn.ConnectError
+	var netErr net.Error
+	return errors.As(err, &connectErr) ||
+		errors.As(err, &netErr) ||
+		errors.Is(err, driver.ErrBadConn) ||
+		errors.Is(err, context.DeadlineExceeded) ||
+		pgconn.Timeout(err) ||
+		pgconn.SafeToRetry(err)
+}
@@ -0,0 +1,26 @@
+package workers
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func Test__TransientError(t *testing.T) {
+	t.Run("database unavailable -> transient", func(t *testing.T) {
+		assert.True(t, transientError(fmt.Errorf("error finding node: %w", driver.ErrBadConn)))
+		assert.True(t, transientError(&pgconn.PgError{Code: "57P01"}))
+		assert.True(t, transientError(&pgconn.PgError{Code: "40001"}))
+	})
+
+	t.Run("problems with the message -> not transient", func(t *testing.T) {
+		assert.False(t, transientError(fmt.Errorf("error finding node: %w", gorm.ErrRecordNotFound)))
+		assert.False(t, transientError(fmt.Errorf("event payload too large: 100 bytes (max 10)")))
+		assert.False(t, transientError(&pgconn.PgError{Code: "22P05"}))
+		assert.False(t, transientError(&pgconn.PgError{Code: "23505"}))
+	})
+}
@@ -1,6 +1,7 @@
 package contexts
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -9,6 +10,10 @@ import (
 	"gorm.io/gorm"
 )
 
+// CanvasMemoryContext gives components access to the memory of a canvas.
+// Namespaces starting with models.OrganizationMemoryNamespacePrefix
+// refer to organization memory namespaces shared with other canvases,
+// which can only be used if the namespace grants the canvas access to it.
 type CanvasMemoryContext struct {
 	tx       *gorm.DB
 	canvasID uuid.UUID
@@ -24,6 +29,16 @@ func (c *CanvasMemoryContext) Add(namespace string, values any) error {
 		return fmt.Errorf("namespace is required")
 	}
 
+	if name, ok := models.ParseOrganizationMemoryNamespace(namespace); ok {
+		settings, err := c.organizationNamespace(name, true)
+		if err != nil {
+			return err
+		}
+
+		_, err = models.AddOrganizationMemoryInTransaction(c.tx, settings.OrganizationID, settings.Namespace, values)
+		return err
+	}
+
 	return models.AddCanvasMemoryInTransaction(c.tx, c.canvasID, namespace, values)
 }
 
@@ -33,6 +48,20 @@ func (c *CanvasMemoryContext) Find(namespace string, matches map[string]any) ([]
 		return nil, fmt.Errorf("namespace is required")
 	}
 
+	if name, ok := models.ParseOrganizationMemoryNamespace(namespace); ok {
+		settings, err := c.organizationNamespace(name, false)
+		if err != nil {
+			return nil, err
+		}
+
+		records, err := models.ListOrganizationMemoriesByNamespaceAndMatchesInTransaction(c.tx, settings.OrganizationID, settings.Namespace, matches)
+		if err != nil {
+			return nil, err
+		}
+
+		return organizationMemoryValues(records), nil
+	}
+
 	records, err := models.ListCanvasMemoriesByNamespaceAndMatchesInTransaction(c.tx, c.canvasID, namespace, matches)
 	if err != nil {
 		return nil, err
@@ -52,6 +81,23 @@ func (c *CanvasMemoryContext) FindFirst(namespace string, matches map[string]any
 		return nil, fmt.Errorf("namespace is required")
 	}
 
+	if name, ok := models.ParseOrganizationMemoryNamespace(namespace); ok {
+		settings, err := c.organizationNamespace(name, false)
+		if err != nil {
+			return nil, err
+		}
+
+		record, err := models.FindFirstOrganizationMemoryByNamespaceAndMatchesInTransaction(c.tx, settings.OrganizationID, settings.Namespace, matches)
+		if err != nil {
+			return nil, err
+		}
+		if record == nil {
+			return nil, nil
+		}
+
+		return record.Values.Data(), nil
+	}
+
 	record, err := models.FindFirstCanvasMemoryByNamespaceAndMatchesInTransaction(c.tx, c.canvasID, namespace, matches)
 	if err != nil {
 		return nil, err
@@ -69,6 +115,20 @@ func (c *CanvasMemoryContext) Delete(namespace string, matches map[string]any) (
 		return nil, fmt.Errorf("namespace is required")
 	}
 
+	if name, ok := models.ParseOrganizationMemoryNamespace(namespace); ok {
+		settings, err := c.organizationNamespace(name, true)
+		if err != nil {
+			return nil, err
+		}
+
+		records, err := models.DeleteOrganizationMemoriesByNamespaceAndMatchesInTransaction(c.tx, settings.OrganizationID, settings.Namespace, matches)
+		if err != nil {
+			return nil, err
+		}
+
+		return organizationMemoryValues(records), nil
+	}
+
 	records, err := models.DeleteCanvasMemoriesByNamespaceAndMatchesInTransaction(c.tx, c.canvasID, namespace, matches)
 	if err != nil {
 		return nil, err
@@ -88,6 +148,20 @@ func (c *CanvasMemoryContext) Update(namespace string, matches map[string]any, v
 		return nil, fmt.Errorf("namespace is required")
 	}
 
+	if name, ok := models.ParseOrganizationMemoryNamespace(namespace); ok {
+		settings, err := c.organizationNamespace(name, true)
+		if err != nil {
+			return nil, err
+		}
+
+		records, err := models.UpdateOrganizationMemoriesByNamespaceAndMatchesInTransaction(c.tx, settings.OrganizationID, settings.Namespace, matches, values)
+		if err != nil {
+			return nil, err
+		}
+
+		return organizationMemoryValues(records), nil
+	}
+
 	records, err := models.UpdateCanvasMemoriesByNamespaceAndMatchesInTransaction(c.tx, c.canvasID, namespace, matches, values)
 	if err != nil {
 		return nil, err
@@ -100,3 +174,106 @@ func (c *CanvasMemoryContext) Update(namespace string, matches map[string]any, v
 
 	return updatedValues, nil
 }
+
+func (c *CanvasMemoryContext) Increment(namespace string, matches map[string]any, field string, delta float64) ([]any, error) {
+	namespace = strings.TrimSpace(namespace)
+	if namespace == "" {
+		return nil, fmt.Errorf("namespace is required")
+	}
+
+	if name, ok := models.ParseOrganizationMemoryNamespace(namespace); ok {
+		settings, err := c.organizationNamespace(name, true)
+		if err != nil {
+			return nil, err
+		}
+
+		records, err := models.IncrementOrganizationMemoryInTransaction(c.tx, settings.OrganizationID, settings.Namespace, matches, field, delta)
+		if err != nil {
+			return nil, err
+		}
+
+		return organizationMemoryValues(records), nil
+	}
+
+	records, err := models.IncrementCanvasMemoryInTransaction(c.tx, c.canvasID, namespace, matches, field, delta)
+	if err != nil {
+		return nil, err
+	}
+
+	incrementedValues := make([]any, 0, len(records))
+	for _, record := range records {
+		incrementedValues = append(incrementedValues, record.Values.Data())
+	}
+
+	return incrementedValues, nil
+}
+
+func (c *CanvasMemoryContext) CompareAndSet(namespace string, matches map[string]any, field string, expected any, value any) (bool, []any, error) {
+	namespace = strings.TrimSpace(namespace)
+	if namespace == "" {
+		return false, nil, fmt.Errorf("namespace is required")
+	}
+
+	if name, ok := models.ParseOrganizationMemoryNamespace(namespace); ok {
+		settings, err := c.organizationNamespace(name, true)
+		if err != nil {
+			return false, nil, err
+		}
+
+		set, records, err := models.CompareAndSetOrganizationMemoryInTransaction(c.tx, settings.OrganizationID, settings.Namespace, matches, field, expected, value)
+		if err != nil {
+			return false, nil, err
+		}
+
+		return set, organizationMemoryValues(records), nil
+	}
+
+	set, records, err := models.CompareAndSetCanvasMemoryInTransaction(c.tx, c.canvasID, namespace, matches, field, expected, value)
+	if err != nil {
+		return false, nil, err
+	}
+
+	currentValues := make([]any, 0, len(records))
+	for _, record := range records {
+		currentValues = append(currentValues, record.Values.Data())
+	}
+
+	return set, currentValues, nil
+}
+
+// organizationNamespace finds the organization memory namespace
+// of the canvas organization, and checks the canvas has the access required.
+func (c *CanvasMemoryContext) organizationNamespace(name string, write bool) (*models.OrganizationMemoryNamespace, error) {
+	canvas, err := models.FindCanvasWithoutOrgScopeInTransaction(c.tx, c.canvasID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find canvas: %w", err)
+	}
+
+	settings, err := models.FindOrganizationMemoryNamespaceInTransaction(c.tx, canvas.OrganizationID, name)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("organization memory namespace %s not found", name)
+		}
+
+		return nil, err
+	}
+
+	if write && !settings.CanvasCanWrite(c.canvasID) {
+		return nil, fmt.Errorf("canvas does not have write access to organization memory namespace %s", name)
+	}
+
+	if !settings.CanvasCanRead(c.canvasID) {
+		return nil, fmt.Errorf("canvas does not have read access to organization memory namespace %s", name)
+	}
+
+	return settings, nil
+}
+
+func organizationMemoryValues(records []models.OrganizationMemory) []any {
+	values := make([]any, 0, len(records))
+	for _, record := range records {
+		values = append(values, record.Values.Data())
+	}
+
+	return values
+}
@@ -20,6 +20,7 @@ import (
 
 var expressionRegex = regexp.MustCompile(`\{\{(.*?)\}\}`)
 var previousDepthRegex = regexp.MustCompile(`\bprevious\s*\(([^)]*)\)`)
+var variablesRegex = regexp.MustCompile(`\bvars\b`)
 
 type NodeConfigurationBuilder struct {
 	tx                  *gorm.DB
@@ -30,6 +31,7 @@ type NodeConfigurationBuilder struct {
 	input               any
 	parentBlueprintNode *models.CanvasNode
 	configurationFields []configuration.Field
+	variables           map[string]any
 }
 
 func NewNodeConfigurationBuilder(tx *gorm.DB, workflowID uuid.UUID) *NodeConfigurationBuilder {
@@ -139,9 +141,36 @@ func (b *NodeConfigurationBuilder) resolveFieldValue(value any, field configurat
 		}
 	}
 
+	//
+	// Secret key fields can reference a secret-backed canvas variable,
+	// e.g. {{ vars.sshKey }}, which resolves to the secret key reference itself.
+	//
+	if field.Type == configuration.FieldTypeSecretKey {
+		if expression, ok := value.(string); ok {
+			if ref, ok, err := b.resolveSecretKeyExpression(expression); ok || err != nil {
+				return ref, err
+			}
+		}
+	}
+
 	return b.resolveValue(value)
 }
 
+func (b *NodeConfigurationBuilder) resolveSecretKeyExpression(value string) (map[string]any, bool, error) {
+	matches := expressionRegex.FindStringSubmatchIndex(value)
+	if matches == nil || matches[0] != 0 || matches[1] != len(value) {
+		return nil, false, nil
+	}
+
+	resolved, err := b.resolveExpression(value[matches[2]:matches[3]])
+	if err != nil {
+		return nil, false, err
+	}
+
+	ref, ok := resolved.(map[string]any)
+	return ref, ok, nil
+}
+
 func (b *NodeConfigurationBuilder) resolveListItems(list []any, itemDef *configuration.ListItemDefinition) ([]any, error) {
 	result := make([]any, len(list))
 	for i, item := range list {
@@ -261,6 +290,14 @@ func (b *NodeConfigurationBuilder) BuildExpressionEnv(expression string) (map[st
 		"memory": b.buildMemoryExpressionNamespace(),
 	}
 
+	if variablesRegex.MatchString(expression) {
+		variables, err := b.buildVariablesExpressionNamespace()
+		if err != nil {
+			return nil, err
+		}
+		env["vars"] = variables
+	}
+
 	if strings.Contains(expression, "root(") {
 		rootPayload, err := b.resolveRootPayload()
 		if err != nil {
@@ -304,6 +341,14 @@ func (b *NodeConfigurationBuilder) resolveExpression(expression string) (any, er
 		"memory": b.buildMemoryExpressionNamespace(),
 	}
 
+	if variablesRegex.MatchString(expression) {
+		variables, err := b.buildVariablesExpressionNamespace()
+		if err != nil {
+			return "", err
+		}
+		env["vars"] = variables
+	}
+
 	if b.parentBlueprintNode != nil {
 		env["config"] = b.parentBlueprintNode.Configuration.Data()
 	}
@@ -313,7 +358,7 @@ func (b *NodeConfigurationBuilder) resolveExpression(expression string) (any, er
 		expr.AsAny(),
 		expr.WithContext("ctx"),
 		expr.Timezone(time.UTC.String()),
-		exprruntime.DateFunctionOption(),
+		exprruntime.StandardLibrary(),
 		expr.Function("root", func(params ...any) (any, error) {
 			if len(params) != 0 {
 				return nil, fmt.Errorf("root() takes no arguments")
@@ -757,7 +802,27 @@ func (b *NodeConfigurationBuilder) resolveFromRoot(depth int, step int) (any, er
 	return nil, nil
 }
 
+// buildVariablesExpressionNamespace returns the canvas variables
+// for the vars namespace. They are loaded once per builder.
+func (b *NodeConfigurationBuilder) buildVariablesExpressionNamespace() (map[string]any, error) {
+	if b.variables != nil {
+		return b.variables, nil
+	}
+
+	variables, err := models.FindLiveCanvasExpressionVariablesInTransaction(b.tx, b.workflowID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load canvas variables: %w", err)
+	}
+
+	b.variables = variables
+	return variables, nil
+}
+
+// buildMemoryExpressionNamespace finds memory records the same way components do,
+// so organization namespaces are only found if the canvas can read them.
 func (b *NodeConfigurationBuilder) buildMemoryExpressionNamespace() map[string]any {
+	memory := NewCanvasMemoryContext(b.tx, b.workflowID)
+
 	return map[string]any{
 		"find": func(params ...any) (any, error) {
 			namespace, matches, err := parseMemoryFindParams(params)
@@ -765,17 +830,7 @@ func (b *NodeConfigurationBuilder) buildMemoryExpressionNamespace() map[string]a
 				return nil, err
 			}
 
-			records, err := models.ListCanvasMemoriesByNamespaceAndMatchesInTransaction(b.tx, b.workflowID, namespace, matches)
-			if err != nil {
-				return nil, err
-			}
-
-			values := make([]any, 0, len(records))
-			for _, record := range records {
-				values = append(values, record.Values.Data())
-			}
-
-			return values, nil
+			return memory.Find(namespace, matches)
 		},
 		"findFirst": func(params ...any) (any, error) {
 			namespace, matches, err := parseMemoryFindParams(params)
@@ -783,15 +838,7 @@ func (b *NodeConfigurationBuilder) buildMemoryExpressionNamespace() map[string]a
 				return nil, err
 			}
 
-			record, err := models.FindFirstCanvasMemoryByNamespaceAndMatchesInTransaction(b.tx, b.workflowID, namespace, matches)
-			if err != nil {
-				return nil, err
-			}
-			if record != nil {
-				return record.Values.Data(), nil
-			}
-
-			return nil, nil
+			return memory.FindFirst(namespace, matches)
 		},
 	}
 }
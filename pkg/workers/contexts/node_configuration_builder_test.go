@@ -1254,3 +1254,56 @@ func Test_NodeConfigurationBuilder_MemoryFindFirst(t *testing.T) {
 	require.NoError(t, err)
 	assert.Nil(t, missing)
 }
+
+func Test_NodeConfigurationBuilder_MemoryFindInOrganizationNamespace(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{NodeID: "node-1", Name: "node-1", Type: models.NodeTypeComponent},
+		},
+		[]models.Edge{},
+	)
+
+	require.NoError(t, models.SaveOrganizationMemoryNamespaceInTransaction(database.Conn(), &models.OrganizationMemoryNamespace{
+		OrganizationID: r.Organization.ID,
+		Namespace:      "deployments",
+		DefaultAccess:  models.OrganizationMemoryAccessRead,
+	}))
+
+	require.NoError(t, models.SaveOrganizationMemoryNamespaceInTransaction(database.Conn(), &models.OrganizationMemoryNamespace{
+		OrganizationID: r.Organization.ID,
+		Namespace:      "restricted",
+		DefaultAccess:  models.OrganizationMemoryAccessNone,
+	}))
+
+	_, err := models.AddOrganizationMemoryInTransaction(database.Conn(), r.Organization.ID, "deployments", map[string]any{"service": "api"})
+	require.NoError(t, err)
+	_, err = models.AddOrganizationMemoryInTransaction(database.Conn(), r.Organization.ID, "restricted", map[string]any{"service": "api"})
+	require.NoError(t, err)
+
+	//
+	// A canvas namespace with the same name is not used.
+	//
+	require.NoError(t, models.AddCanvasMemory(canvas.ID, "org/deployments", map[string]any{"service": "canvas"}))
+
+	builder := NewNodeConfigurationBuilder(database.Conn(), canvas.ID).WithInput(map[string]any{})
+
+	result, err := builder.resolveExpression(`memory.find("org/deployments", {"service": "api"})`)
+	require.NoError(t, err)
+	assert.Equal(t, []any{map[string]any{"service": "api"}}, result)
+
+	first, err := builder.resolveExpression(`memory.findFirst("org/deployments", {"service": "api"}).service`)
+	require.NoError(t, err)
+	assert.Equal(t, "api", first)
+
+	_, err = builder.resolveExpression(`memory.find("org/restricted", {"service": "api"})`)
+	require.ErrorContains(t, err, "does not have read access")
+
+	_, err = builder.resolveExpression(`memory.findFirst("org/missing", {"service": "api"})`)
+	require.ErrorContains(t, err, "not found")
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
 	"github.com/superplanehq/superplane/pkg/crypto"
 	"github.com/superplanehq/superplane/pkg/models"
 	"gorm.io/gorm"
@@ -82,6 +83,13 @@ func (c *NodeWebhookContext) ResetSecret() ([]byte, []byte, error) {
 		return nil, nil, fmt.Errorf("error saving webhook: %v", err)
 	}
 
+	log.WithFields(log.Fields{
+		"audit":      "webhook_secret_rotated",
+		"webhook_id": webhook.ID.String(),
+		"canvas_id":  c.node.WorkflowID.String(),
+		"node_id":    c.node.NodeID,
+	}).Info("Webhook secret rotated")
+
 	return []byte(plainKey), encryptedKey, nil
 }
 
@@ -2,44 +2,67 @@ package contexts
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
 
 	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/configuration"
 	"github.com/superplanehq/superplane/pkg/core"
 	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/database"
 	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/registry"
+	"github.com/superplanehq/superplane/pkg/secrets"
 	"gorm.io/gorm"
 )
 
-// SecretsContext resolves organization secret key values for component execution.
+const secretsPrefetchTimeout = 10 * time.Second
+
+// SecretsContext resolves secret key values for component execution.
+// Components can read the secrets of their canvas,
+// and the organization secrets that the canvas is allowed to read.
 type SecretsContext struct {
 	tx             *gorm.DB
 	organizationID uuid.UUID
+	canvasID       uuid.UUID
 	encryptor      crypto.Encryptor
+	backends       *secrets.Backends
+	prefetched     *PrefetchedSecrets
 }
 
 // NewSecretsContext returns a SecretsContext that looks up secrets in the given transaction
-// for the given organization.
-func NewSecretsContext(tx *gorm.DB, organizationID uuid.UUID, encryptor crypto.Encryptor) *SecretsContext {
+// for the given canvas. Values of secrets kept outside of SuperPlane are read through the backends.
+func NewSecretsContext(tx *gorm.DB, organizationID, canvasID uuid.UUID, encryptor crypto.Encryptor, backends *secrets.Backends) *SecretsContext {
 	return &SecretsContext{
 		tx:             tx,
 		organizationID: organizationID,
+		canvasID:       canvasID,
 		encryptor:      encryptor,
+		backends:       backends,
 	}
 }
 
+// WithPrefetched sets the values of the secrets kept in remote providers, like Vault,
+// read before the transaction was opened. Other remote secrets cannot be read.
+func (c *SecretsContext) WithPrefetched(prefetched *PrefetchedSecrets) *SecretsContext {
+	c.prefetched = prefetched
+	return c
+}
+
 // GetKey implements core.SecretsContext.
 func (c *SecretsContext) GetKey(secretName, keyName string) ([]byte, error) {
 	if secretName == "" || keyName == "" {
 		return nil, core.ErrSecretKeyNotFound
 	}
 
-	secret, err := models.FindSecretByNameInTransaction(c.tx, models.DomainTypeOrganization, c.organizationID, secretName)
+	secret, err := models.FindSecretForCanvasInTransaction(c.tx, c.organizationID, c.canvasID, secretName)
 	if err != nil {
 		return nil, err
 	}
 
-	data, err := c.decryptSecretData(secret)
+	data, err := c.load(secret)
 	if err != nil {
 		return nil, err
 	}
@@ -52,18 +75,150 @@ func (c *SecretsContext) GetKey(secretName, keyName string) ([]byte, error) {
 	return []byte(val), nil
 }
 
-func (c *SecretsContext) decryptSecretData(secret *models.Secret) (map[string]string, error) {
-	plain, err := c.encryptor.Decrypt(context.Background(), secret.Data, []byte(secret.Name))
+func (c *SecretsContext) load(secret *models.Secret) (map[string]string, error) {
+	//
+	// Remote reads are not done here, since the transaction
+	// of the execution is open, and would stay open while waiting for them.
+	//
+	if secrets.ReadsRemotely(secret) {
+		return c.prefetched.get(secret.Name)
+	}
+
+	provider, err := secrets.NewProviderForSecret(c.tx, c.encryptor, c.backends, secret)
 	if err != nil {
 		return nil, err
 	}
 
-	var data map[string]string
-	if len(plain) == 0 {
-		return make(map[string]string), nil
+	values, err := provider.Load(context.Background())
+	if secret.Provider != secrets.ProviderLocal {
+		secrets.RecordRead(c.organizationID, c.canvasID, secret, err)
+	}
+
+	return values, err
+}
+
+// Rotate implements core.SecretsContext.
+// Only secrets of the canvas can be rotated, since organization secrets
+// are shared with other canvases, which executions of this one must not change.
+func (c *SecretsContext) Rotate(secretName string, values map[string]string) error {
+	return c.tx.Transaction(func(tx *gorm.DB) error {
+		secret, err := models.FindSecretByNameInTransaction(tx, models.DomainTypeCanvas, c.canvasID, secretName)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return fmt.Errorf("secret %s not found: only secrets of the canvas can be rotated", secretName)
+			}
+
+			return err
+		}
+
+		if secret.Provider != secrets.ProviderLocal {
+			return fmt.Errorf("values of %s secrets are rotated by the provider", secret.Provider)
+		}
+
+		current, err := secrets.NewLocalProvider(tx, c.encryptor, secret).Load(context.Background())
+		if err != nil {
+			return err
+		}
+
+		for key, value := range values {
+			current[key] = value
+		}
+
+		_, err = secrets.RotateLocalSecret(context.Background(), tx, c.encryptor, secret, current, nil)
+		if err != nil {
+			return err
+		}
+
+		return secrets.RecordRotation(tx, c.organizationID, c.canvasID, secret)
+	})
+}
+
+// PrefetchedSecrets holds the values of the secrets kept in remote providers, like Vault,
+// read before the transaction of an execution or action is opened.
+type PrefetchedSecrets struct {
+	values map[string]map[string]string
+	errors map[string]error
+}
+
+func (p *PrefetchedSecrets) get(secretName string) (map[string]string, error) {
+	if p != nil {
+		if err, ok := p.errors[secretName]; ok {
+			return nil, err
+		}
+
+		if values, ok := p.values[secretName]; ok {
+			return values, nil
+		}
+	}
+
+	return nil, fmt.Errorf("secret %s is kept outside of SuperPlane, and can only be used in secret fields of the node configuration", secretName)
+}
+
+// PrefetchSecrets reads the remote secrets referenced by the given keys, outside of any transaction.
+// Secrets kept in SuperPlane are not read here, and read errors are returned
+// when the secret is used, so executions fail as they do for other secret errors.
+func PrefetchSecrets(ctx context.Context, organizationID, canvasID uuid.UUID, encryptor crypto.Encryptor, backends *secrets.Backends, refs []configuration.SecretKeyRef) *PrefetchedSecrets {
+	prefetched := &PrefetchedSecrets{
+		values: map[string]map[string]string{},
+		errors: map[string]error{},
+	}
+
+	for _, ref := range refs {
+		if _, ok := prefetched.values[ref.Secret]; ok {
+			continue
+		}
+
+		if _, ok := prefetched.errors[ref.Secret]; ok {
+			continue
+		}
+
+		secret, err := models.FindSecretForCanvasInTransaction(database.Conn(), organizationID, canvasID, ref.Secret)
+		if err != nil || !secrets.ReadsRemotely(secret) {
+			continue
+		}
+
+		values, err := loadRemoteSecret(ctx, encryptor, backends, secret)
+		secrets.RecordRead(organizationID, canvasID, secret, err)
+		if err != nil {
+			prefetched.errors[ref.Secret] = err
+			continue
+		}
+
+		prefetched.values[ref.Secret] = values
 	}
-	if err := json.Unmarshal(plain, &data); err != nil {
+
+	return prefetched
+}
+
+func loadRemoteSecret(ctx context.Context, encryptor crypto.Encryptor, backends *secrets.Backends, secret *models.Secret) (map[string]string, error) {
+	provider, err := secrets.NewProviderForSecret(database.Conn(), encryptor, backends, secret)
+	if err != nil {
 		return nil, err
 	}
-	return data, nil
+
+	ctx, cancel := context.WithTimeout(ctx, secretsPrefetchTimeout)
+	defer cancel()
+
+	return provider.Load(ctx)
+}
+
+// PrefetchComponentSecrets reads the remote secrets referenced by the configuration of a component.
+func PrefetchComponentSecrets(ctx context.Context, reg *registry.Registry, encryptor crypto.Encryptor, canvasID uuid.UUID, componentName string, config map[string]any) *PrefetchedSecrets {
+	component, err := reg.GetComponent(componentName)
+	if err != nil {
+		return nil
+	}
+
+	refs := configuration.FindSecretKeyRefs(config, component.Configuration())
+	if len(refs) == 0 {
+		return nil
+	}
+
+	canvas, err := models.FindCanvasWithoutOrgScope(canvasID)
+	if err != nil {
+		log.Warnf("Error finding canvas %s to read secrets: %v", canvasID, err)
+		return nil
+	}
+
+	return PrefetchSecrets(ctx, canvas.OrganizationID, canvas.ID, encryptor, reg.SecretBackends, refs)
 }
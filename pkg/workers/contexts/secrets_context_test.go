@@ -0,0 +1,181 @@
+package contexts
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/secrets"
+	"github.com/superplanehq/superplane/test/support"
+	"gorm.io/gorm"
+)
+
+func Test__SecretsContext_GetKey(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	canvas, _ := support.CreateCanvas(t, r.Organization.ID, r.User, []models.CanvasNode{}, []models.Edge{})
+	otherCanvas, _ := support.CreateCanvas(t, r.Organization.ID, r.User, []models.CanvasNode{}, []models.Edge{})
+
+	createSecret := func(name, domainType string, domainID uuid.UUID, token string, allowedCanvasIDs []string) {
+		data, err := json.Marshal(map[string]string{"token": token})
+		require.NoError(t, err)
+
+		encrypted, err := r.Encryptor.Encrypt(context.Background(), data, []byte(name))
+		require.NoError(t, err)
+
+		_, err = models.CreateSecretWithAllowedCanvases(name, secrets.ProviderLocal, r.User.String(), domainType, domainID, encrypted, allowedCanvasIDs)
+		require.NoError(t, err)
+	}
+
+	createSecret("shared", models.DomainTypeOrganization, r.Organization.ID, "from-org", nil)
+	createSecret("shared", models.DomainTypeCanvas, canvas.ID, "from-canvas", nil)
+	createSecret("private", models.DomainTypeCanvas, canvas.ID, "private", nil)
+	createSecret("restricted", models.DomainTypeOrganization, r.Organization.ID, "restricted", []string{canvas.ID.String()})
+
+	canvasSecrets := NewSecretsContext(database.Conn(), r.Organization.ID, canvas.ID, r.Encryptor, nil)
+	otherCanvasSecrets := NewSecretsContext(database.Conn(), r.Organization.ID, otherCanvas.ID, r.Encryptor, nil)
+
+	t.Run("canvas secrets take precedence over organization secrets", func(t *testing.T) {
+		value, err := canvasSecrets.GetKey("shared", "token")
+		require.NoError(t, err)
+		assert.Equal(t, "from-canvas", string(value))
+
+		value, err = otherCanvasSecrets.GetKey("shared", "token")
+		require.NoError(t, err)
+		assert.Equal(t, "from-org", string(value))
+	})
+
+	t.Run("canvas secrets are not available to other canvases", func(t *testing.T) {
+		value, err := canvasSecrets.GetKey("private", "token")
+		require.NoError(t, err)
+		assert.Equal(t, "private", string(value))
+
+		_, err = otherCanvasSecrets.GetKey("private", "token")
+		require.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	})
+
+	t.Run("organization secrets are only available to allowed canvases", func(t *testing.T) {
+		value, err := canvasSecrets.GetKey("restricted", "token")
+		require.NoError(t, err)
+		assert.Equal(t, "restricted", string(value))
+
+		_, err = otherCanvasSecrets.GetKey("restricted", "token")
+		require.ErrorIs(t, err, models.ErrSecretNotAllowedForCanvas)
+	})
+}
+
+func Test__SecretsContext_GetKey_Vault(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	reads := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		reads++
+		_, _ = w.Write([]byte(`{"data": {"data": {"token": "from-vault"}}}`))
+	}))
+	defer server.Close()
+
+	client, err := secrets.NewVaultClient(secrets.VaultClientOptions{Address: server.URL, Token: "root"})
+	require.NoError(t, err)
+	backends := &secrets.Backends{Vault: client}
+
+	canvas, _ := support.CreateCanvas(t, r.Organization.ID, r.User, []models.CanvasNode{}, []models.Edge{})
+	data := []byte(`{"mount": "secret", "path": "superplane/` + r.Organization.ID.String() + `/github"}`)
+	_, err = models.CreateSecretWithAllowedCanvases("github", secrets.ProviderVault, r.User.String(), models.DomainTypeOrganization, r.Organization.ID, data, nil)
+	require.NoError(t, err)
+
+	t.Run("vault secrets are not read inside the transaction", func(t *testing.T) {
+		secretsCtx := NewSecretsContext(database.Conn(), r.Organization.ID, canvas.ID, r.Encryptor, backends)
+		_, err := secretsCtx.GetKey("github", "token")
+		require.Error(t, err)
+		assert.Equal(t, 0, reads)
+	})
+
+	t.Run("prefetched vault secrets are available", func(t *testing.T) {
+		refs := []configuration.SecretKeyRef{{Secret: "github", Key: "token"}}
+		prefetched := PrefetchSecrets(context.Background(), r.Organization.ID, canvas.ID, r.Encryptor, backends, refs)
+		assert.Equal(t, 1, reads)
+
+		secretsCtx := NewSecretsContext(database.Conn(), r.Organization.ID, canvas.ID, r.Encryptor, backends).WithPrefetched(prefetched)
+		value, err := secretsCtx.GetKey("github", "token")
+		require.NoError(t, err)
+		assert.Equal(t, "from-vault", string(value))
+		assert.Equal(t, 1, reads)
+
+		events, err := models.ListAuditEvents(r.Organization.ID, models.AuditEventFilters{
+			ResourceType: secrets.AuditResourceType,
+			Action:       secrets.AuditActionRead,
+		}, 0, nil)
+
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, "github", events[0].ResourceName)
+		assert.Equal(t, canvas.ID.String(), events[0].DomainID)
+		assert.Equal(t, "OK", events[0].Status)
+	})
+}
+
+func Test__SecretsContext_Rotate(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	canvas, _ := support.CreateCanvas(t, r.Organization.ID, r.User, []models.CanvasNode{}, []models.Edge{})
+	createSecret := func(name, domainType string, domainID uuid.UUID) {
+		data, err := json.Marshal(map[string]string{"token": "old", "other": "kept"})
+		require.NoError(t, err)
+
+		encrypted, err := r.Encryptor.Encrypt(context.Background(), data, []byte(name))
+		require.NoError(t, err)
+
+		_, err = models.CreateSecretWithAllowedCanvases(name, secrets.ProviderLocal, r.User.String(), domainType, domainID, encrypted, nil)
+		require.NoError(t, err)
+	}
+
+	createSecret("webhook", models.DomainTypeCanvas, canvas.ID)
+	createSecret("shared", models.DomainTypeOrganization, r.Organization.ID)
+	secretsCtx := NewSecretsContext(database.Conn(), r.Organization.ID, canvas.ID, r.Encryptor, nil)
+
+	t.Run("canvas secrets are rotated", func(t *testing.T) {
+		require.NoError(t, secretsCtx.Rotate("webhook", map[string]string{"token": "new"}))
+
+		value, err := secretsCtx.GetKey("webhook", "token")
+		require.NoError(t, err)
+		assert.Equal(t, "new", string(value))
+
+		value, err = secretsCtx.GetKey("webhook", "other")
+		require.NoError(t, err)
+		assert.Equal(t, "kept", string(value))
+
+		secret, err := models.FindSecretByName(models.DomainTypeCanvas, canvas.ID, "webhook")
+		require.NoError(t, err)
+		assert.Equal(t, 2, secret.ActiveVersion)
+
+		events, err := models.ListAuditEvents(r.Organization.ID, models.AuditEventFilters{
+			ResourceType: secrets.AuditResourceType,
+			Action:       secrets.AuditActionRotate,
+		}, 0, nil)
+
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, secret.ID.String(), events[0].ResourceID)
+		assert.Equal(t, float64(2), events[0].Response.Data()["version"])
+	})
+
+	t.Run("organization secrets are not rotated", func(t *testing.T) {
+		err := secretsCtx.Rotate("shared", map[string]string{"token": "new"})
+		require.Error(t, err)
+
+		value, err := secretsCtx.GetKey("shared", "token")
+		require.NoError(t, err)
+		assert.Equal(t, "old", string(value))
+	})
+}
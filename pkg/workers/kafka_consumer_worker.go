@@ -0,0 +1,315 @@
+package workers
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	kafkago "github.com/segmentio/kafka-go"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
+	"github.com/superplanehq/superplane/pkg/integrations/kafka"
+	"github.com/superplanehq/superplane/pkg/logging"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/registry"
+	"github.com/superplanehq/superplane/pkg/workers/contexts"
+)
+
+const KafkaConsumerSyncInterval = 10 * time.Second
+
+// KafkaConsumerWorker keeps a member of the consumer group of every Kafka trigger
+// reading from its topic, so messages are processed as they are published.
+//
+// Offsets are committed to the consumer group only after the transaction storing
+// the event for the message is committed. Every instance running this worker joins
+// the same consumer groups, and Kafka assigns each partition to a single member.
+type KafkaConsumerWorker struct {
+	registry  *registry.Registry
+	encryptor crypto.Encryptor
+	logger    *log.Entry
+
+	mu        sync.Mutex
+	consumers map[string]*kafkaConsumer
+}
+
+type kafkaConsumer struct {
+	integrationID uuid.UUID
+	updatedAt     time.Time
+	config        kafkaConsumerConfig
+	client        kafka.Client
+	cancel        context.CancelFunc
+	done          chan struct{}
+}
+
+type kafkaConsumerConfig struct {
+	topic     string
+	groupID   string
+	startFrom string
+}
+
+func NewKafkaConsumerWorker(encryptor crypto.Encryptor, registry *registry.Registry) *KafkaConsumerWorker {
+	return &KafkaConsumerWorker{
+		registry:  registry,
+		encryptor: encryptor,
+		logger:    log.WithFields(log.Fields{"worker": "KafkaConsumerWorker"}),
+		consumers: map[string]*kafkaConsumer{},
+	}
+}
+
+func (w *KafkaConsumerWorker) Start(ctx context.Context) {
+	ticker := time.NewTicker(KafkaConsumerSyncInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := w.Sync(); err != nil {
+			w.logger.Errorf("Error syncing Kafka consumers: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			w.Stop()
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *KafkaConsumerWorker) Stop() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for key := range w.consumers {
+		w.stopConsumer(key)
+	}
+}
+
+// Sync reconciles the consumers with the Kafka trigger nodes.
+// Consumers are re-created when the topic, the consumer group or the integration changes,
+// and when they stop reading messages.
+func (w *KafkaConsumerWorker) Sync() error {
+	nodes, err := models.ListTriggerNodesByName(kafka.OnMessageTriggerName)
+	if err != nil {
+		return fmt.Errorf("error listing Kafka trigger nodes: %w", err)
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	integrations := map[uuid.UUID]*models.Integration{}
+	desired := map[string]bool{}
+
+	for _, node := range nodes {
+		config, ok := w.consumerConfig(node)
+		if !ok {
+			continue
+		}
+
+		integration, ok := integrations[*node.AppInstallationID]
+		if !ok {
+			integration, err = models.FindUnscopedIntegration(*node.AppInstallationID)
+			if err != nil {
+				w.logger.Warnf("Error finding integration %s: %v", *node.AppInstallationID, err)
+				continue
+			}
+
+			integrations[integration.ID] = integration
+		}
+
+		key := subscriptionKey(node)
+		desired[key] = true
+
+		existing, ok := w.consumers[key]
+		if ok && existing.matches(integration, config) {
+			continue
+		}
+
+		if ok {
+			w.stopConsumer(key)
+		}
+
+		err := w.startConsumer(node, integration, config)
+		if err != nil {
+			w.logger.Errorf("Error consuming for node %s in canvas %s: %v", node.NodeID, node.WorkflowID, err)
+		}
+	}
+
+	for key := range w.consumers {
+		if !desired[key] {
+			w.stopConsumer(key)
+		}
+	}
+
+	return nil
+}
+
+func (w *KafkaConsumerWorker) consumerConfig(node models.CanvasNode) (kafkaConsumerConfig, bool) {
+	if node.AppInstallationID == nil {
+		return kafkaConsumerConfig{}, false
+	}
+
+	var metadata kafka.OnMessageMetadata
+	if err := mapstructure.Decode(node.Metadata.Data(), &metadata); err != nil || metadata.Topic == "" || metadata.GroupID == "" {
+		return kafkaConsumerConfig{}, false
+	}
+
+	var config kafka.OnMessageConfiguration
+	if err := mapstructure.Decode(node.Configuration.Data(), &config); err != nil {
+		return kafkaConsumerConfig{}, false
+	}
+
+	return kafkaConsumerConfig{
+		topic:     metadata.Topic,
+		groupID:   metadata.GroupID,
+		startFrom: config.StartFrom,
+	}, true
+}
+
+func (w *KafkaConsumerWorker) startConsumer(node models.CanvasNode, integration *models.Integration, config kafkaConsumerConfig) error {
+	integrationCtx := contexts.NewIntegrationContext(database.Conn(), &node, integration, w.encryptor, w.registry, nil)
+	client, err := kafka.NewClient(integrationCtx)
+	if err != nil {
+		return fmt.Errorf("error connecting to Kafka: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	reader := client.Consumer(config.topic, config.groupID, config.startFrom)
+	consumer := &kafkaConsumer{
+		integrationID: integration.ID,
+		updatedAt:     updatedAt(integration),
+		config:        config,
+		client:        client,
+		cancel:        cancel,
+		done:          make(chan struct{}),
+	}
+
+	workflowID := node.WorkflowID
+	nodeID := node.NodeID
+	go func() {
+		defer close(consumer.done)
+		defer reader.Close()
+
+		for {
+			message, err := reader.FetchMessage(ctx)
+			if err != nil {
+				if ctx.Err() == nil {
+					w.logger.Errorf("Error reading from %s for node %s in canvas %s: %v", config.topic, nodeID, workflowID, err)
+				}
+
+				return
+			}
+
+			//
+			// If a message cannot be handled for now, we stop reading.
+			// The consumer is re-created on the next sync,
+			// and continues from the last offset committed.
+			//
+			err = w.HandleMessage(ctx, workflowID, nodeID, reader, message)
+			if err != nil {
+				w.logger.Errorf("Error handling message from %s for node %s in canvas %s: %v", config.topic, nodeID, workflowID, err)
+				return
+			}
+		}
+	}()
+
+	w.consumers[subscriptionKey(node)] = consumer
+	return nil
+}
+
+// HandleMessage emits the event for a message,
+// and commits its offset to the consumer group once the event is committed.
+// Messages that can never be handled are logged and committed too,
+// so they do not block their partition. Only transient errors are returned.
+func (w *KafkaConsumerWorker) HandleMessage(ctx context.Context, workflowID uuid.UUID, nodeID string, consumer kafka.Consumer, message kafkago.Message) error {
+	err := w.emitMessage(workflowID, nodeID, message)
+	if err != nil {
+		if transientError(err) {
+			return err
+		}
+
+		w.logger.Errorf(
+			"Skipping message at offset %d of %s partition %d for node %s in canvas %s: %v",
+			message.Offset, message.Topic, message.Partition, nodeID, workflowID, err,
+		)
+	}
+
+	if err := consumer.CommitMessages(ctx, message); err != nil {
+		return fmt.Errorf("error committing offset: %w", err)
+	}
+
+	return nil
+}
+
+func (w *KafkaConsumerWorker) emitMessage(workflowID uuid.UUID, nodeID string, message kafkago.Message) error {
+	trigger, err := w.registry.GetTrigger(kafka.OnMessageTriggerName)
+	if err != nil {
+		return err
+	}
+
+	newEvents := []models.CanvasEvent{}
+	onNewEvents := func(events []models.CanvasEvent) {
+		newEvents = append(newEvents, events...)
+	}
+
+	err = database.Conn().Transaction(func(tx *gorm.DB) error {
+		node, err := models.FindCanvasNode(tx, workflowID, nodeID)
+		if err != nil {
+			return fmt.Errorf("error finding node: %w", err)
+		}
+
+		_, err = trigger.HandleAction(core.TriggerActionContext{
+			Name:          kafka.OnMessageReceiveAction,
+			Parameters:    kafka.BuildMessagePayload(message),
+			Configuration: node.Configuration.Data(),
+			Logger:        logging.ForNode(*node),
+			HTTP:          w.registry.HTTPContext(),
+			Metadata:      contexts.NewNodeMetadataContext(tx, node),
+			Events:        contexts.NewEventContext(tx, node, onNewEvents),
+			Requests:      contexts.NewNodeRequestContext(tx, node),
+		})
+
+		return err
+	})
+
+	if err != nil {
+		return err
+	}
+
+	for _, event := range newEvents {
+		messages.NewCanvasEventCreatedMessage(event.WorkflowID.String(), &event).Publish()
+	}
+
+	return nil
+}
+
+// stopConsumer stops reading and leaves the consumer group.
+// Messages read but not committed yet are read again by the next member assigned their partition.
+func (w *KafkaConsumerWorker) stopConsumer(key string) {
+	consumer, ok := w.consumers[key]
+	if !ok {
+		return
+	}
+
+	consumer.cancel()
+	consumer.client.Close()
+	delete(w.consumers, key)
+}
+
+func (c *kafkaConsumer) matches(integration *models.Integration, config kafkaConsumerConfig) bool {
+	select {
+	case <-c.done:
+		return false
+	default:
+	}
+
+	return c.integrationID == integration.ID &&
+		c.updatedAt.Equal(updatedAt(integration)) &&
+		c.config == config
+}
@@ -0,0 +1,76 @@
+package workers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	kafkago "github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/integrations/kafka"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/test/support"
+	"gorm.io/datatypes"
+)
+
+type recordingKafkaConsumer struct {
+	committed []int64
+}
+
+func (c *recordingKafkaConsumer) FetchMessage(ctx context.Context) (kafkago.Message, error) {
+	<-ctx.Done()
+	return kafkago.Message{}, ctx.Err()
+}
+
+func (c *recordingKafkaConsumer) CommitMessages(ctx context.Context, messages ...kafkago.Message) error {
+	for _, message := range messages {
+		c.committed = append(c.committed, message.Offset)
+	}
+
+	return nil
+}
+
+func (c *recordingKafkaConsumer) Close() error {
+	return nil
+}
+
+func Test__KafkaConsumerWorker_HandleMessage(t *testing.T) {
+	r := support.Setup(t)
+	worker := NewKafkaConsumerWorker(r.Encryptor, r.Registry)
+
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID:        "kafka-1",
+				Type:          models.NodeTypeTrigger,
+				Ref:           datatypes.NewJSONType(models.NodeRef{Trigger: &models.TriggerRef{Name: kafka.OnMessageTriggerName}}),
+				Configuration: datatypes.NewJSONType(map[string]any{"topic": "deployments", "startFrom": kafka.StartFromLatest}),
+			},
+		},
+		[]models.Edge{},
+	)
+
+	t.Run("offset is committed after the event is stored", func(t *testing.T) {
+		consumer := &recordingKafkaConsumer{}
+		message := kafkago.Message{Topic: "deployments", Offset: 7, Value: []byte(`{"service":"api"}`)}
+
+		err := worker.HandleMessage(context.Background(), canvas.ID, "kafka-1", consumer, message)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{7}, consumer.committed)
+		support.VerifyCanvasNodeEventsCount(t, canvas.ID, "kafka-1", 1)
+	})
+
+	t.Run("messages that can never be handled are skipped", func(t *testing.T) {
+		consumer := &recordingKafkaConsumer{}
+		message := kafkago.Message{Topic: "deployments", Offset: 8, Value: []byte(`{}`)}
+
+		err := worker.HandleMessage(context.Background(), uuid.New(), "kafka-1", consumer, message)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{8}, consumer.committed)
+		support.VerifyCanvasNodeEventsCount(t, canvas.ID, "kafka-1", 1)
+	})
+}
@@ -0,0 +1,505 @@
+package workers
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	natsio "github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
+	natsintegration "github.com/superplanehq/superplane/pkg/integrations/nats"
+	"github.com/superplanehq/superplane/pkg/logging"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/registry"
+	"github.com/superplanehq/superplane/pkg/workers/contexts"
+)
+
+const NATSSubscriptionSyncInterval = 10 * time.Second
+
+// NATSSubscriptionWorker holds the subscriptions for NATS triggers in core NATS mode,
+// and consumes the JetStream consumers of triggers in JetStream mode.
+// Core NATS messages are not stored by the server, so someone
+// needs to be subscribed when they are published.
+//
+// Every instance running this worker subscribes to the same subjects,
+// using the same queue group, so each message is delivered to a single instance.
+// JetStream messages are fetched from the same durable consumers,
+// and acknowledged only after the transaction storing their event is committed.
+type NATSSubscriptionWorker struct {
+	registry  *registry.Registry
+	encryptor crypto.Encryptor
+	logger    *log.Entry
+
+	mu            sync.Mutex
+	connections   map[uuid.UUID]*natsConnection
+	subscriptions map[string]*natsSubscription
+	consumers     map[string]*natsConsumer
+}
+
+type natsConnection struct {
+	client    natsintegration.Client
+	updatedAt time.Time
+}
+
+type natsSubscription struct {
+	integrationID uuid.UUID
+	subject       string
+	queueGroup    string
+	subscription  *natsio.Subscription
+}
+
+type natsConsumer struct {
+	integrationID uuid.UUID
+	stream        string
+	consumer      string
+	cancel        context.CancelFunc
+	done          chan struct{}
+}
+
+func NewNATSSubscriptionWorker(encryptor crypto.Encryptor, registry *registry.Registry) *NATSSubscriptionWorker {
+	return &NATSSubscriptionWorker{
+		registry:      registry,
+		encryptor:     encryptor,
+		logger:        log.WithFields(log.Fields{"worker": "NATSSubscriptionWorker"}),
+		connections:   map[uuid.UUID]*natsConnection{},
+		subscriptions: map[string]*natsSubscription{},
+		consumers:     map[string]*natsConsumer{},
+	}
+}
+
+func (w *NATSSubscriptionWorker) Start(ctx context.Context) {
+	ticker := time.NewTicker(NATSSubscriptionSyncInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := w.Sync(); err != nil {
+			w.logger.Errorf("Error syncing NATS subscriptions: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			w.Stop()
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *NATSSubscriptionWorker) Stop() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for key, sub := range w.subscriptions {
+		_ = sub.subscription.Unsubscribe()
+		delete(w.subscriptions, key)
+	}
+
+	for key := range w.consumers {
+		w.stopConsumer(key)
+	}
+
+	for id, conn := range w.connections {
+		conn.client.Close()
+		delete(w.connections, id)
+	}
+}
+
+// Sync reconciles the open subscriptions and consumers with the NATS trigger nodes.
+func (w *NATSSubscriptionWorker) Sync() error {
+	nodes, err := models.ListTriggerNodesByName(natsintegration.OnMessageTriggerName)
+	if err != nil {
+		return fmt.Errorf("error listing NATS trigger nodes: %w", err)
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	integrations := w.refreshConnections(nodes)
+
+	desired := map[string]bool{}
+	desiredConsumers := map[string]bool{}
+	for _, node := range nodes {
+		metadata, ok := w.triggerMetadata(node)
+		if !ok {
+			continue
+		}
+
+		integration, ok := integrations[*node.AppInstallationID]
+		if !ok {
+			continue
+		}
+
+		key := subscriptionKey(node)
+		if metadata.Mode == natsintegration.ModeJetStream {
+			desiredConsumers[key] = true
+			w.syncConsumer(node, integration, metadata)
+			continue
+		}
+
+		desired[key] = true
+
+		existing, ok := w.subscriptions[key]
+		if ok && existing.matches(integration.ID, metadata) {
+			continue
+		}
+
+		if ok {
+			w.unsubscribe(key)
+		}
+
+		err := w.subscribe(node, integration, metadata)
+		if err != nil {
+			w.logger.Errorf("Error subscribing node %s in canvas %s: %v", node.NodeID, node.WorkflowID, err)
+		}
+	}
+
+	for key := range w.subscriptions {
+		if !desired[key] {
+			w.unsubscribe(key)
+		}
+	}
+
+	for key := range w.consumers {
+		if !desiredConsumers[key] {
+			w.stopConsumer(key)
+		}
+	}
+
+	w.closeUnusedConnections()
+	return nil
+}
+
+// refreshConnections loads the integrations used by the nodes,
+// and closes the connections for integrations that were updated or removed,
+// so subscriptions are re-created with the new configuration.
+func (w *NATSSubscriptionWorker) refreshConnections(nodes []models.CanvasNode) map[uuid.UUID]*models.Integration {
+	integrations := map[uuid.UUID]*models.Integration{}
+	for _, node := range nodes {
+		if node.AppInstallationID == nil {
+			continue
+		}
+
+		if _, ok := integrations[*node.AppInstallationID]; ok {
+			continue
+		}
+
+		integration, err := models.FindUnscopedIntegration(*node.AppInstallationID)
+		if err != nil {
+			w.logger.Warnf("Error finding integration %s: %v", *node.AppInstallationID, err)
+			continue
+		}
+
+		integrations[integration.ID] = integration
+	}
+
+	for id, conn := range w.connections {
+		integration, ok := integrations[id]
+		if !ok || !conn.updatedAt.Equal(updatedAt(integration)) {
+			w.closeConnection(id)
+		}
+	}
+
+	return integrations
+}
+
+func (w *NATSSubscriptionWorker) triggerMetadata(node models.CanvasNode) (*natsintegration.OnMessageMetadata, bool) {
+	if node.AppInstallationID == nil {
+		return nil, false
+	}
+
+	var metadata natsintegration.OnMessageMetadata
+	if err := mapstructure.Decode(node.Metadata.Data(), &metadata); err != nil {
+		return nil, false
+	}
+
+	switch metadata.Mode {
+	case natsintegration.ModeCore:
+		return &metadata, metadata.Subject != ""
+	case natsintegration.ModeJetStream:
+		return &metadata, metadata.Stream != "" && metadata.Consumer != ""
+	default:
+		return nil, false
+	}
+}
+
+func (w *NATSSubscriptionWorker) subscribe(node models.CanvasNode, integration *models.Integration, metadata *natsintegration.OnMessageMetadata) error {
+	conn, err := w.connectionFor(node, integration)
+	if err != nil {
+		return err
+	}
+
+	//
+	// If no queue group is given, we use one specific to the node,
+	// so instances of this worker share the subscription,
+	// but other subscribers still receive all the messages.
+	//
+	queueGroup := metadata.QueueGroup
+	if queueGroup == "" {
+		queueGroup = fmt.Sprintf("superplane.%s.%s", node.WorkflowID, node.NodeID)
+	}
+
+	workflowID := node.WorkflowID
+	nodeID := node.NodeID
+	subscription, err := conn.client.Subscribe(metadata.Subject, queueGroup, func(msg *natsio.Msg) {
+		err := w.emitMessage(workflowID, nodeID, natsintegration.OnMessageReceiveAction, natsintegration.BuildMessagePayload(msg))
+		if err != nil {
+			w.logger.Errorf("Error handling message on %s for node %s in canvas %s: %v", msg.Subject, nodeID, workflowID, err)
+		}
+	})
+
+	if err != nil {
+		return fmt.Errorf("error subscribing to %s: %w", metadata.Subject, err)
+	}
+
+	w.subscriptions[subscriptionKey(node)] = &natsSubscription{
+		integrationID: integration.ID,
+		subject:       metadata.Subject,
+		queueGroup:    metadata.QueueGroup,
+		subscription:  subscription,
+	}
+
+	return nil
+}
+
+func (w *NATSSubscriptionWorker) connectionFor(node models.CanvasNode, integration *models.Integration) (*natsConnection, error) {
+	if conn, ok := w.connections[integration.ID]; ok {
+		return conn, nil
+	}
+
+	integrationCtx := contexts.NewIntegrationContext(database.Conn(), &node, integration, w.encryptor, w.registry, nil)
+	client, err := natsintegration.NewClient(integrationCtx, natsio.MaxReconnects(-1))
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to NATS: %w", err)
+	}
+
+	conn := &natsConnection{client: client, updatedAt: updatedAt(integration)}
+	w.connections[integration.ID] = conn
+	return conn, nil
+}
+
+// syncConsumer starts consuming the JetStream consumer of the node,
+// unless it is already being consumed.
+func (w *NATSSubscriptionWorker) syncConsumer(node models.CanvasNode, integration *models.Integration, metadata *natsintegration.OnMessageMetadata) {
+	key := subscriptionKey(node)
+	existing, ok := w.consumers[key]
+	if ok && existing.matches(integration.ID, metadata) {
+		return
+	}
+
+	if ok {
+		w.stopConsumer(key)
+	}
+
+	conn, err := w.connectionFor(node, integration)
+	if err != nil {
+		w.logger.Errorf("Error consuming for node %s in canvas %s: %v", node.NodeID, node.WorkflowID, err)
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	consumer := &natsConsumer{
+		integrationID: integration.ID,
+		stream:        metadata.Stream,
+		consumer:      metadata.Consumer,
+		cancel:        cancel,
+		done:          make(chan struct{}),
+	}
+
+	go w.consume(ctx, conn.client, node.WorkflowID, node.NodeID, consumer)
+	w.consumers[key] = consumer
+}
+
+// consume fetches messages from the consumer until the context is canceled.
+// When there are no messages left, it waits for the poll interval before fetching again.
+// Messages not acknowledged when it stops are redelivered by JetStream.
+func (w *NATSSubscriptionWorker) consume(ctx context.Context, client natsintegration.Client, workflowID uuid.UUID, nodeID string, consumer *natsConsumer) {
+	defer close(consumer.done)
+
+	for {
+		messages, err := client.Fetch(consumer.stream, consumer.consumer, natsintegration.OnMessageMaxPerPoll)
+		if err != nil && ctx.Err() == nil {
+			w.logger.Errorf("Error fetching messages from consumer %s on stream %s: %v", consumer.consumer, consumer.stream, err)
+		}
+
+		for _, msg := range messages {
+			if ctx.Err() != nil {
+				return
+			}
+
+			err := w.HandleJetStreamMessage(workflowID, nodeID, msg)
+			if err != nil {
+				w.logger.Errorf("Error handling message on %s for node %s in canvas %s: %v", msg.Subject(), nodeID, workflowID, err)
+			}
+		}
+
+		if err == nil && len(messages) == natsintegration.OnMessageMaxPerPoll {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(natsintegration.OnMessagePollInterval):
+		}
+	}
+}
+
+// HandleJetStreamMessage emits the event for a JetStream message,
+// and acknowledges it once the event is committed.
+// If the event cannot be stored, the message is redelivered.
+func (w *NATSSubscriptionWorker) HandleJetStreamMessage(workflowID uuid.UUID, nodeID string, msg jetstream.Msg) error {
+	err := w.emitMessage(workflowID, nodeID, natsintegration.OnMessageReceiveJetStreamAction, natsintegration.BuildJetStreamPayload(msg))
+	if err != nil {
+		_ = msg.Nak()
+		return err
+	}
+
+	if err := msg.Ack(); err != nil {
+		return fmt.Errorf("error acknowledging message: %w", err)
+	}
+
+	return nil
+}
+
+func (w *NATSSubscriptionWorker) emitMessage(workflowID uuid.UUID, nodeID string, action string, payload map[string]any) error {
+	trigger, err := w.registry.GetTrigger(natsintegration.OnMessageTriggerName)
+	if err != nil {
+		return err
+	}
+
+	newEvents := []models.CanvasEvent{}
+	onNewEvents := func(events []models.CanvasEvent) {
+		newEvents = append(newEvents, events...)
+	}
+
+	err = database.Conn().Transaction(func(tx *gorm.DB) error {
+		node, err := models.FindCanvasNode(tx, workflowID, nodeID)
+		if err != nil {
+			return fmt.Errorf("error finding node: %w", err)
+		}
+
+		_, err = trigger.HandleAction(core.TriggerActionContext{
+			Name:          action,
+			Parameters:    payload,
+			Configuration: node.Configuration.Data(),
+			Logger:        logging.ForNode(*node),
+			HTTP:          w.registry.HTTPContext(),
+			Metadata:      contexts.NewNodeMetadataContext(tx, node),
+			Events:        contexts.NewEventContext(tx, node, onNewEvents),
+			Requests:      contexts.NewNodeRequestContext(tx, node),
+		})
+
+		return err
+	})
+
+	if err != nil {
+		return err
+	}
+
+	for _, event := range newEvents {
+		messages.NewCanvasEventCreatedMessage(event.WorkflowID.String(), &event).Publish()
+	}
+
+	return nil
+}
+
+func (w *NATSSubscriptionWorker) unsubscribe(key string) {
+	sub, ok := w.subscriptions[key]
+	if !ok {
+		return
+	}
+
+	if err := sub.subscription.Unsubscribe(); err != nil {
+		w.logger.Warnf("Error unsubscribing from %s: %v", sub.subject, err)
+	}
+
+	delete(w.subscriptions, key)
+}
+
+// stopConsumer stops fetching from the consumer.
+// A message being handled is still acknowledged once its event is committed.
+func (w *NATSSubscriptionWorker) stopConsumer(key string) {
+	consumer, ok := w.consumers[key]
+	if !ok {
+		return
+	}
+
+	consumer.cancel()
+	delete(w.consumers, key)
+}
+
+func (w *NATSSubscriptionWorker) closeConnection(integrationID uuid.UUID) {
+	for key, sub := range w.subscriptions {
+		if sub.integrationID == integrationID {
+			w.unsubscribe(key)
+		}
+	}
+
+	for key, consumer := range w.consumers {
+		if consumer.integrationID == integrationID {
+			w.stopConsumer(key)
+		}
+	}
+
+	if conn, ok := w.connections[integrationID]; ok {
+		conn.client.Close()
+		delete(w.connections, integrationID)
+	}
+}
+
+func (w *NATSSubscriptionWorker) closeUnusedConnections() {
+	used := map[uuid.UUID]bool{}
+	for _, sub := range w.subscriptions {
+		used[sub.integrationID] = true
+	}
+
+	for _, consumer := range w.consumers {
+		used[consumer.integrationID] = true
+	}
+
+	for id := range w.connections {
+		if !used[id] {
+			w.closeConnection(id)
+		}
+	}
+}
+
+func (s *natsSubscription) matches(integrationID uuid.UUID, metadata *natsintegration.OnMessageMetadata) bool {
+	return s.integrationID == integrationID &&
+		s.subject == metadata.Subject &&
+		s.queueGroup == metadata.QueueGroup
+}
+
+func (c *natsConsumer) matches(integrationID uuid.UUID, metadata *natsintegration.OnMessageMetadata) bool {
+	select {
+	case <-c.done:
+		return false
+	default:
+	}
+
+	return c.integrationID == integrationID &&
+		c.stream == metadata.Stream &&
+		c.consumer == metadata.Consumer
+}
+
+func subscriptionKey(node models.CanvasNode) string {
+	return node.WorkflowID.String() + "/" + node.NodeID
+}
+
+func updatedAt(integration *models.Integration) time.Time {
+	if integration.UpdatedAt == nil {
+		return time.Time{}
+	}
+
+	return *integration.UpdatedAt
+}
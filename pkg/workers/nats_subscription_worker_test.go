@@ -0,0 +1,97 @@
+package workers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	natsio "github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	natsintegration "github.com/superplanehq/superplane/pkg/integrations/nats"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/test/support"
+	"gorm.io/datatypes"
+)
+
+// recordingJetStreamMsg implements the parts of jetstream.Msg used by the worker.
+type recordingJetStreamMsg struct {
+	jetstream.Msg
+
+	data   []byte
+	acked  bool
+	nacked bool
+}
+
+func (m *recordingJetStreamMsg) Subject() string {
+	return "deploys.production.api"
+}
+
+func (m *recordingJetStreamMsg) Data() []byte {
+	return m.data
+}
+
+func (m *recordingJetStreamMsg) Headers() natsio.Header {
+	return natsio.Header{}
+}
+
+func (m *recordingJetStreamMsg) Metadata() (*jetstream.MsgMetadata, error) {
+	return &jetstream.MsgMetadata{Stream: "DEPLOYS", NumDelivered: 1, Timestamp: time.Now()}, nil
+}
+
+func (m *recordingJetStreamMsg) Ack() error {
+	m.acked = true
+	return nil
+}
+
+func (m *recordingJetStreamMsg) Nak() error {
+	m.nacked = true
+	return nil
+}
+
+func Test__NATSSubscriptionWorker_HandleJetStreamMessage(t *testing.T) {
+	r := support.Setup(t)
+	worker := NewNATSSubscriptionWorker(r.Encryptor, r.Registry)
+
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID:        "nats-1",
+				Type:          models.NodeTypeTrigger,
+				Ref:           datatypes.NewJSONType(models.NodeRef{Trigger: &models.TriggerRef{Name: natsintegration.OnMessageTriggerName}}),
+				Configuration: datatypes.NewJSONType(map[string]any{"mode": natsintegration.ModeJetStream, "stream": "DEPLOYS", "subject": "deploys.>"}),
+				Metadata: datatypes.NewJSONType(map[string]any{
+					"mode":     natsintegration.ModeJetStream,
+					"subject":  "deploys.>",
+					"stream":   "DEPLOYS",
+					"consumer": "superplane-abc",
+					"managed":  true,
+				}),
+			},
+		},
+		[]models.Edge{},
+	)
+
+	t.Run("message is acknowledged after the event is stored", func(t *testing.T) {
+		msg := &recordingJetStreamMsg{data: []byte(`{"service":"api"}`)}
+
+		err := worker.HandleJetStreamMessage(canvas.ID, "nats-1", msg)
+		require.NoError(t, err)
+		assert.True(t, msg.acked)
+		assert.False(t, msg.nacked)
+		support.VerifyCanvasNodeEventsCount(t, canvas.ID, "nats-1", 1)
+	})
+
+	t.Run("message is redelivered if the event cannot be stored", func(t *testing.T) {
+		msg := &recordingJetStreamMsg{data: []byte(`{}`)}
+
+		err := worker.HandleJetStreamMessage(uuid.New(), "nats-1", msg)
+		require.Error(t, err)
+		assert.False(t, msg.acked)
+		assert.True(t, msg.nacked)
+	})
+}
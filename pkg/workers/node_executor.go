@@ -168,6 +168,8 @@ func (w *NodeExecutor) LockAndProcessNodeExecution(id uuid.UUID) error {
 		newEvents = append(newEvents, events...)
 	}
 
+	prefetched := w.prefetchSecrets(id)
+
 	err := database.Conn().Transaction(func(tx *gorm.DB) error {
 		var execution models.CanvasNodeExecution
 
@@ -202,7 +204,7 @@ func (w *NodeExecutor) LockAndProcessNodeExecution(id uuid.UUID) error {
 			return ErrRecordLocked
 		}
 
-		return w.processNodeExecution(tx, &execution, onNewEvents)
+		return w.processNodeExecution(tx, &execution, prefetched, onNewEvents)
 	})
 
 	if err != nil {
@@ -216,7 +218,34 @@ func (w *NodeExecutor) LockAndProcessNodeExecution(id uuid.UUID) error {
 	return nil
 }
 
-func (w *NodeExecutor) processNodeExecution(tx *gorm.DB, execution *models.CanvasNodeExecution, onNewEvents func([]models.CanvasEvent)) error {
+// prefetchSecrets reads the remote secrets used by the execution before its transaction is opened.
+// The execution is not locked yet, so if another worker picks it up, the secrets are not used.
+func (w *NodeExecutor) prefetchSecrets(id uuid.UUID) *contexts.PrefetchedSecrets {
+	var execution models.CanvasNodeExecution
+	err := database.Conn().
+		Where("id = ?", id).
+		Where("state = ?", models.CanvasNodeExecutionStatePending).
+		First(&execution).
+		Error
+
+	if err != nil {
+		return nil
+	}
+
+	node, err := models.FindCanvasNode(database.Conn(), execution.WorkflowID, execution.NodeID)
+	if err != nil || node.Type != models.NodeTypeComponent {
+		return nil
+	}
+
+	ref := node.Ref.Data()
+	if ref.Component == nil {
+		return nil
+	}
+
+	return contexts.PrefetchComponentSecrets(context.Background(), w.registry, w.encryptor, execution.WorkflowID, ref.Component.Name, execution.Configuration.Data())
+}
+
+func (w *NodeExecutor) processNodeExecution(tx *gorm.DB, execution *models.CanvasNodeExecution, prefetched *contexts.PrefetchedSecrets, onNewEvents func([]models.CanvasEvent)) error {
 	node, err := models.FindCanvasNode(tx, execution.WorkflowID, execution.NodeID)
 	if err != nil {
 		return err
@@ -226,7 +255,7 @@ func (w *NodeExecutor) processNodeExecution(tx *gorm.DB, execution *models.Canva
 		return w.executeBlueprintNode(tx, execution, node)
 	}
 
-	return w.executeComponentNode(tx, execution, node, onNewEvents)
+	return w.executeComponentNode(tx, execution, node, prefetched, onNewEvents)
 }
 
 func (w *NodeExecutor) executeBlueprintNode(tx *gorm.DB, execution *models.CanvasNodeExecution, node *models.CanvasNode) error {
@@ -324,7 +353,7 @@ func (w *NodeExecutor) configurationFieldsForBlueprintNode(tx *gorm.DB, node mod
 	}
 }
 
-func (w *NodeExecutor) executeComponentNode(tx *gorm.DB, execution *models.CanvasNodeExecution, node *models.CanvasNode, onNewEvents func([]models.CanvasEvent)) error {
+func (w *NodeExecutor) executeComponentNode(tx *gorm.DB, execution *models.CanvasNodeExecution, node *models.CanvasNode, prefetched *contexts.PrefetchedSecrets, onNewEvents func([]models.CanvasEvent)) error {
 	logger := logging.WithExecution(
 		logging.WithNode(w.logger, *node),
 		execution,
@@ -374,7 +403,7 @@ func (w *NodeExecutor) executeComponentNode(tx *gorm.DB, execution *models.Canva
 		Requests:       contexts.NewExecutionRequestContext(tx, execution),
 		Auth:           contexts.NewAuthContext(tx, workflow.OrganizationID, nil, nil),
 		Notifications:  contexts.NewNotificationContext(tx, workflow.OrganizationID, execution.WorkflowID),
-		Secrets:        contexts.NewSecretsContext(tx, workflow.OrganizationID, w.encryptor),
+		Secrets:        contexts.NewSecretsContext(tx, workflow.OrganizationID, workflow.ID, w.encryptor, w.registry.SecretBackends).WithPrefetched(prefetched),
 		CanvasMemory:   contexts.NewCanvasMemoryContext(tx, execution.WorkflowID),
 		Webhook:        contexts.NewNodeWebhookContext(context.Background(), tx, w.encryptor, node, w.webhookBaseURL),
 	}
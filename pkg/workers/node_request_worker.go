@@ -87,6 +87,8 @@ func (w *NodeRequestWorker) LockAndProcessRequest(request models.CanvasNodeReque
 		newEvents = append(newEvents, events...)
 	}
 
+	prefetched := w.prefetchSecrets(&request)
+
 	err := database.Conn().Transaction(func(tx *gorm.DB) error {
 		r, err := models.LockNodeRequest(tx, request.ID)
 		if err != nil {
@@ -94,7 +96,7 @@ func (w *NodeRequestWorker) LockAndProcessRequest(request models.CanvasNodeReque
 			return nil
 		}
 
-		return w.processRequest(tx, r, onNewEvents)
+		return w.processRequest(tx, r, prefetched, onNewEvents)
 	})
 
 	if err != nil {
@@ -108,21 +110,50 @@ func (w *NodeRequestWorker) LockAndProcessRequest(request models.CanvasNodeReque
 	return nil
 }
 
-func (w *NodeRequestWorker) processRequest(tx *gorm.DB, request *models.CanvasNodeRequest, onNewEvents func([]models.CanvasEvent)) error {
+// prefetchSecrets reads the remote secrets used by actions on executions before the transaction is opened.
+// The request is not locked yet, so if another worker picks it up, the secrets are not used.
+func (w *NodeRequestWorker) prefetchSecrets(request *models.CanvasNodeRequest) *contexts.PrefetchedSecrets {
+	if request.Type != models.NodeRequestTypeInvokeAction || request.ExecutionID == nil {
+		return nil
+	}
+
+	execution, err := models.FindNodeExecution(request.WorkflowID, *request.ExecutionID)
+	if err != nil {
+		return nil
+	}
+
+	if execution.ParentExecutionID == nil {
+		node, err := models.FindCanvasNode(database.Conn(), execution.WorkflowID, execution.NodeID)
+		if err != nil || node.Ref.Data().Component == nil {
+			return nil
+		}
+
+		return contexts.PrefetchComponentSecrets(context.Background(), w.registry, w.encryptor, execution.WorkflowID, node.Ref.Data().Component.Name, node.Configuration.Data())
+	}
+
+	childNode, err := w.findChildNode(database.Conn(), execution)
+	if err != nil || childNode.Ref.Component == nil {
+		return nil
+	}
+
+	return contexts.PrefetchComponentSecrets(context.Background(), w.registry, w.encryptor, execution.WorkflowID, childNode.Ref.Component.Name, execution.Configuration.Data())
+}
+
+func (w *NodeRequestWorker) processRequest(tx *gorm.DB, request *models.CanvasNodeRequest, prefetched *contexts.PrefetchedSecrets, onNewEvents func([]models.CanvasEvent)) error {
 	switch request.Type {
 	case models.NodeRequestTypeInvokeAction:
-		return w.invokeAction(tx, request, onNewEvents)
+		return w.invokeAction(tx, request, prefetched, onNewEvents)
 	}
 
 	return fmt.Errorf("unsupported node execution request type %s", request.Type)
 }
 
-func (w *NodeRequestWorker) invokeAction(tx *gorm.DB, request *models.CanvasNodeRequest, onNewEvents func([]models.CanvasEvent)) error {
+func (w *NodeRequestWorker) invokeAction(tx *gorm.DB, request *models.CanvasNodeRequest, prefetched *contexts.PrefetchedSecrets, onNewEvents func([]models.CanvasEvent)) error {
 	if request.ExecutionID == nil {
 		return w.invokeNodeAction(tx, request, onNewEvents)
 	}
 
-	return w.invokeComponentAction(tx, request, onNewEvents)
+	return w.invokeComponentAction(tx, request, prefetched, onNewEvents)
 }
 
 func (w *NodeRequestWorker) invokeNodeAction(tx *gorm.DB, request *models.CanvasNodeRequest, onNewEvents func([]models.CanvasEvent)) error {
@@ -268,23 +299,24 @@ func (w *NodeRequestWorker) invokeNodeComponentAction(tx *gorm.DB, request *mode
 	return request.Complete(tx)
 }
 
-func (w *NodeRequestWorker) invokeComponentAction(tx *gorm.DB, request *models.CanvasNodeRequest, onNewEvents func([]models.CanvasEvent)) error {
+func (w *NodeRequestWorker) invokeComponentAction(tx *gorm.DB, request *models.CanvasNodeRequest, prefetched *contexts.PrefetchedSecrets, onNewEvents func([]models.CanvasEvent)) error {
 	execution, err := models.FindNodeExecutionInTransaction(tx, request.WorkflowID, *request.ExecutionID)
 	if err != nil {
 		return fmt.Errorf("execution %s not found: %w", request.ExecutionID, err)
 	}
 
 	if execution.ParentExecutionID == nil {
-		return w.invokeParentNodeComponentAction(tx, request, execution, onNewEvents)
+		return w.invokeParentNodeComponentAction(tx, request, execution, prefetched, onNewEvents)
 	}
 
-	return w.invokeChildNodeComponentAction(tx, request, execution, onNewEvents)
+	return w.invokeChildNodeComponentAction(tx, request, execution, prefetched, onNewEvents)
 }
 
 func (w *NodeRequestWorker) invokeParentNodeComponentAction(
 	tx *gorm.DB,
 	request *models.CanvasNodeRequest,
 	execution *models.CanvasNodeExecution,
+	prefetched *contexts.PrefetchedSecrets,
 	onNewEvents func([]models.CanvasEvent),
 ) error {
 	node, err := models.FindCanvasNode(tx, execution.WorkflowID, execution.NodeID)
@@ -324,7 +356,7 @@ func (w *NodeRequestWorker) invokeParentNodeComponentAction(
 		Requests:       contexts.NewExecutionRequestContext(tx, execution),
 		Notifications:  contexts.NewNotificationContext(tx, uuid.Nil, node.WorkflowID),
 		Auth:           contexts.NewAuthContext(tx, workflow.OrganizationID, nil, nil),
-		Secrets:        contexts.NewSecretsContext(tx, workflow.OrganizationID, w.encryptor),
+		Secrets:        contexts.NewSecretsContext(tx, workflow.OrganizationID, workflow.ID, w.encryptor, w.registry.SecretBackends).WithPrefetched(prefetched),
 	}
 
 	if node.AppInstallationID != nil {
@@ -355,6 +387,7 @@ func (w *NodeRequestWorker) invokeChildNodeComponentAction(
 	tx *gorm.DB,
 	request *models.CanvasNodeRequest,
 	execution *models.CanvasNodeExecution,
+	prefetched *contexts.PrefetchedSecrets,
 	onNewEvents func([]models.CanvasEvent),
 ) error {
 	parentExecution, err := models.FindNodeExecutionInTransaction(tx, execution.WorkflowID, *execution.ParentExecutionID)
@@ -362,20 +395,9 @@ func (w *NodeRequestWorker) invokeChildNodeComponentAction(
 		return fmt.Errorf("parent execution %s not found: %w", execution.ParentExecutionID, err)
 	}
 
-	parentNode, err := models.FindCanvasNode(tx, execution.WorkflowID, parentExecution.NodeID)
-	if err != nil {
-		return fmt.Errorf("node not found: %w", err)
-	}
-
-	blueprint, err := models.FindUnscopedBlueprintInTransaction(tx, parentNode.Ref.Data().Blueprint.ID)
-	if err != nil {
-		return fmt.Errorf("blueprint not found: %w", err)
-	}
-
-	childNodeID := strings.Split(execution.NodeID, ":")[1]
-	childNode, err := blueprint.FindNode(childNodeID)
+	childNode, err := w.findChildNode(tx, execution)
 	if err != nil {
-		return fmt.Errorf("node not found: %w", err)
+		return err
 	}
 
 	component, err := w.registry.GetComponent(childNode.Ref.Component.Name)
@@ -410,7 +432,7 @@ func (w *NodeRequestWorker) invokeChildNodeComponentAction(
 		Requests:       contexts.NewExecutionRequestContext(tx, execution),
 		Notifications:  contexts.NewNotificationContext(tx, uuid.Nil, execution.WorkflowID),
 		Auth:           contexts.NewAuthContext(tx, workflow.OrganizationID, nil, nil),
-		Secrets:        contexts.NewSecretsContext(tx, workflow.OrganizationID, w.encryptor),
+		Secrets:        contexts.NewSecretsContext(tx, workflow.OrganizationID, workflow.ID, w.encryptor, w.registry.SecretBackends).WithPrefetched(prefetched),
 	}
 
 	err = component.HandleAction(actionCtx)
@@ -426,6 +448,31 @@ func (w *NodeRequestWorker) invokeChildNodeComponentAction(
 	return request.Complete(tx)
 }
 
+func (w *NodeRequestWorker) findChildNode(tx *gorm.DB, execution *models.CanvasNodeExecution) (*models.Node, error) {
+	parentExecution, err := models.FindNodeExecutionInTransaction(tx, execution.WorkflowID, *execution.ParentExecutionID)
+	if err != nil {
+		return nil, fmt.Errorf("parent execution %s not found: %w", execution.ParentExecutionID, err)
+	}
+
+	parentNode, err := models.FindCanvasNode(tx, execution.WorkflowID, parentExecution.NodeID)
+	if err != nil {
+		return nil, fmt.Errorf("node not found: %w", err)
+	}
+
+	blueprint, err := models.FindUnscopedBlueprintInTransaction(tx, parentNode.Ref.Data().Blueprint.ID)
+	if err != nil {
+		return nil, fmt.Errorf("blueprint not found: %w", err)
+	}
+
+	childNodeID := strings.Split(execution.NodeID, ":")[1]
+	childNode, err := blueprint.FindNode(childNodeID)
+	if err != nil {
+		return nil, fmt.Errorf("node not found: %w", err)
+	}
+
+	return childNode, nil
+}
+
 func (w *NodeRequestWorker) log(format string, v ...any) {
 	log.Printf("[NodeRequestWorker] "+format, v...)
 }
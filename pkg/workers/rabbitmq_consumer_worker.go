@@ -0,0 +1,293 @@
+package workers
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	amqp "github.com/rabbitmq/amqp091-go"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
+	"github.com/superplanehq/superplane/pkg/integrations/rabbitmq"
+	"github.com/superplanehq/superplane/pkg/logging"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/registry"
+	"github.com/superplanehq/superplane/pkg/workers/contexts"
+)
+
+const RabbitMQConsumerSyncInterval = 10 * time.Second
+
+// RabbitMQConsumerWorker keeps a consumer connected to the queue of every RabbitMQ trigger,
+// so messages are processed as they arrive, without connecting to RabbitMQ on every poll.
+//
+// Messages are acknowledged only after the transaction storing their event is committed,
+// unless the trigger acknowledges them on receive.
+// Every instance running this worker consumes from the same queues,
+// and RabbitMQ delivers each message to a single consumer.
+type RabbitMQConsumerWorker struct {
+	registry  *registry.Registry
+	encryptor crypto.Encryptor
+	logger    *log.Entry
+
+	mu        sync.Mutex
+	consumers map[string]*rabbitMQConsumer
+}
+
+type rabbitMQConsumer struct {
+	integrationID uuid.UUID
+	updatedAt     time.Time
+	queue         string
+	autoAck       bool
+	client        *rabbitmq.Client
+	done          chan struct{}
+}
+
+func NewRabbitMQConsumerWorker(encryptor crypto.Encryptor, registry *registry.Registry) *RabbitMQConsumerWorker {
+	return &RabbitMQConsumerWorker{
+		registry:  registry,
+		encryptor: encryptor,
+		logger:    log.WithFields(log.Fields{"worker": "RabbitMQConsumerWorker"}),
+		consumers: map[string]*rabbitMQConsumer{},
+	}
+}
+
+func (w *RabbitMQConsumerWorker) Start(ctx context.Context) {
+	ticker := time.NewTicker(RabbitMQConsumerSyncInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := w.Sync(); err != nil {
+			w.logger.Errorf("Error syncing RabbitMQ consumers: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			w.Stop()
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *RabbitMQConsumerWorker) Stop() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for key := range w.consumers {
+		w.stopConsumer(key)
+	}
+}
+
+// Sync reconciles the consumers with the RabbitMQ trigger nodes.
+// Consumers are re-created when the queue, the acknowledgement mode or the integration changes,
+// and when their connection is lost.
+func (w *RabbitMQConsumerWorker) Sync() error {
+	nodes, err := models.ListTriggerNodesByName(rabbitmq.OnMessageTriggerName)
+	if err != nil {
+		return fmt.Errorf("error listing RabbitMQ trigger nodes: %w", err)
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	integrations := map[uuid.UUID]*models.Integration{}
+	desired := map[string]bool{}
+
+	for _, node := range nodes {
+		queue, autoAck, ok := w.consumerConfig(node)
+		if !ok {
+			continue
+		}
+
+		integration, ok := integrations[*node.AppInstallationID]
+		if !ok {
+			integration, err = models.FindUnscopedIntegration(*node.AppInstallationID)
+			if err != nil {
+				w.logger.Warnf("Error finding integration %s: %v", *node.AppInstallationID, err)
+				continue
+			}
+
+			integrations[integration.ID] = integration
+		}
+
+		key := subscriptionKey(node)
+		desired[key] = true
+
+		existing, ok := w.consumers[key]
+		if ok && existing.matches(integration, queue, autoAck) {
+			continue
+		}
+
+		if ok {
+			w.stopConsumer(key)
+		}
+
+		err := w.startConsumer(node, integration, queue, autoAck)
+		if err != nil {
+			w.logger.Errorf("Error consuming for node %s in canvas %s: %v", node.NodeID, node.WorkflowID, err)
+		}
+	}
+
+	for key := range w.consumers {
+		if !desired[key] {
+			w.stopConsumer(key)
+		}
+	}
+
+	return nil
+}
+
+func (w *RabbitMQConsumerWorker) consumerConfig(node models.CanvasNode) (string, bool, bool) {
+	if node.AppInstallationID == nil {
+		return "", false, false
+	}
+
+	var metadata rabbitmq.OnMessageMetadata
+	if err := mapstructure.Decode(node.Metadata.Data(), &metadata); err != nil || metadata.Queue == "" {
+		return "", false, false
+	}
+
+	var config rabbitmq.OnMessageConfiguration
+	if err := mapstructure.Decode(node.Configuration.Data(), &config); err != nil {
+		return "", false, false
+	}
+
+	return metadata.Queue, config.AckMode == rabbitmq.AckModeOnReceive, true
+}
+
+func (w *RabbitMQConsumerWorker) startConsumer(node models.CanvasNode, integration *models.Integration, queue string, autoAck bool) error {
+	integrationCtx := contexts.NewIntegrationContext(database.Conn(), &node, integration, w.encryptor, w.registry, nil)
+	client, err := rabbitmq.NewClient(integrationCtx)
+	if err != nil {
+		return fmt.Errorf("error connecting to RabbitMQ: %w", err)
+	}
+
+	deliveries, err := client.Consume(queue, autoAck, rabbitmq.OnMessagePrefetch)
+	if err != nil {
+		client.Close()
+		return err
+	}
+
+	consumer := &rabbitMQConsumer{
+		integrationID: integration.ID,
+		updatedAt:     updatedAt(integration),
+		queue:         queue,
+		autoAck:       autoAck,
+		client:        client,
+		done:          make(chan struct{}),
+	}
+
+	workflowID := node.WorkflowID
+	nodeID := node.NodeID
+	go func() {
+		defer close(consumer.done)
+
+		for delivery := range deliveries {
+			err := w.HandleDelivery(workflowID, nodeID, queue, delivery, autoAck)
+			if err != nil {
+				w.logger.Errorf("Error handling message from %s for node %s in canvas %s: %v", queue, nodeID, workflowID, err)
+			}
+		}
+	}()
+
+	w.consumers[subscriptionKey(node)] = consumer
+	return nil
+}
+
+// HandleDelivery emits the event for a message, and acknowledges it once the event is committed.
+// If the event cannot be stored for now, the message is requeued.
+// Messages that can never be stored are rejected without requeueing,
+// so they go to the dead letter exchange of the queue, if it has one, or are dropped.
+func (w *RabbitMQConsumerWorker) HandleDelivery(workflowID uuid.UUID, nodeID string, queue string, delivery amqp.Delivery, autoAck bool) error {
+	err := w.emitDelivery(workflowID, nodeID, queue, delivery)
+	if err != nil {
+		if !autoAck {
+			_ = delivery.Nack(false, transientError(err))
+		}
+
+		return err
+	}
+
+	if !autoAck {
+		if err := delivery.Ack(false); err != nil {
+			return fmt.Errorf("error acknowledging message: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (w *RabbitMQConsumerWorker) emitDelivery(workflowID uuid.UUID, nodeID string, queue string, delivery amqp.Delivery) error {
+	trigger, err := w.registry.GetTrigger(rabbitmq.OnMessageTriggerName)
+	if err != nil {
+		return err
+	}
+
+	newEvents := []models.CanvasEvent{}
+	onNewEvents := func(events []models.CanvasEvent) {
+		newEvents = append(newEvents, events...)
+	}
+
+	err = database.Conn().Transaction(func(tx *gorm.DB) error {
+		node, err := models.FindCanvasNode(tx, workflowID, nodeID)
+		if err != nil {
+			return fmt.Errorf("error finding node: %w", err)
+		}
+
+		_, err = trigger.HandleAction(core.TriggerActionContext{
+			Name:          rabbitmq.OnMessageReceiveAction,
+			Parameters:    rabbitmq.BuildMessagePayload(queue, &delivery),
+			Configuration: node.Configuration.Data(),
+			Logger:        logging.ForNode(*node),
+			HTTP:          w.registry.HTTPContext(),
+			Metadata:      contexts.NewNodeMetadataContext(tx, node),
+			Events:        contexts.NewEventContext(tx, node, onNewEvents),
+			Requests:      contexts.NewNodeRequestContext(tx, node),
+		})
+
+		return err
+	})
+
+	if err != nil {
+		return err
+	}
+
+	for _, event := range newEvents {
+		messages.NewCanvasEventCreatedMessage(event.WorkflowID.String(), &event).Publish()
+	}
+
+	return nil
+}
+
+// stopConsumer closes the connection of the consumer.
+// Messages delivered but not acknowledged yet are requeued by RabbitMQ.
+func (w *RabbitMQConsumerWorker) stopConsumer(key string) {
+	consumer, ok := w.consumers[key]
+	if !ok {
+		return
+	}
+
+	consumer.client.Close()
+	delete(w.consumers, key)
+}
+
+func (c *rabbitMQConsumer) matches(integration *models.Integration, queue string, autoAck bool) bool {
+	select {
+	case <-c.done:
+		return false
+	default:
+	}
+
+	return c.integrationID == integration.ID &&
+		c.updatedAt.Equal(updatedAt(integration)) &&
+		c.queue == queue &&
+		c.autoAck == autoAck
+}
@@ -0,0 +1,91 @@
+package workers
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/integrations/rabbitmq"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/test/support"
+	"gorm.io/datatypes"
+)
+
+type recordingAcknowledger struct {
+	acked    []uint64
+	nacked   []uint64
+	requeued []uint64
+}
+
+func (a *recordingAcknowledger) Ack(tag uint64, multiple bool) error {
+	a.acked = append(a.acked, tag)
+	return nil
+}
+
+func (a *recordingAcknowledger) Nack(tag uint64, multiple bool, requeue bool) error {
+	a.nacked = append(a.nacked, tag)
+	if requeue {
+		a.requeued = append(a.requeued, tag)
+	}
+
+	return nil
+}
+
+func (a *recordingAcknowledger) Reject(tag uint64, requeue bool) error {
+	a.nacked = append(a.nacked, tag)
+	return nil
+}
+
+func Test__RabbitMQConsumerWorker_HandleDelivery(t *testing.T) {
+	r := support.Setup(t)
+	worker := NewRabbitMQConsumerWorker(r.Encryptor, r.Registry)
+
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID:        "rabbitmq-1",
+				Type:          models.NodeTypeTrigger,
+				Ref:           datatypes.NewJSONType(models.NodeRef{Trigger: &models.TriggerRef{Name: rabbitmq.OnMessageTriggerName}}),
+				Configuration: datatypes.NewJSONType(map[string]any{"queue": "jobs", "ackMode": rabbitmq.AckModeAfterEmit}),
+			},
+		},
+		[]models.Edge{},
+	)
+
+	t.Run("message is acknowledged after the event is stored", func(t *testing.T) {
+		acknowledger := &recordingAcknowledger{}
+		delivery := amqp.Delivery{Acknowledger: acknowledger, DeliveryTag: 1, Body: []byte(`{"service":"api"}`)}
+
+		err := worker.HandleDelivery(canvas.ID, "rabbitmq-1", "jobs", delivery, false)
+		require.NoError(t, err)
+		assert.Equal(t, []uint64{1}, acknowledger.acked)
+		assert.Empty(t, acknowledger.nacked)
+		support.VerifyCanvasNodeEventsCount(t, canvas.ID, "rabbitmq-1", 1)
+	})
+
+	t.Run("messages that can never be stored are rejected without requeueing", func(t *testing.T) {
+		acknowledger := &recordingAcknowledger{}
+		delivery := amqp.Delivery{Acknowledger: acknowledger, DeliveryTag: 2, Body: []byte(`{}`)}
+
+		err := worker.HandleDelivery(uuid.New(), "rabbitmq-1", "jobs", delivery, false)
+		require.Error(t, err)
+		assert.Empty(t, acknowledger.acked)
+		assert.Equal(t, []uint64{2}, acknowledger.nacked)
+		assert.Empty(t, acknowledger.requeued)
+	})
+
+	t.Run("messages acknowledged on receive are not acknowledged again", func(t *testing.T) {
+		acknowledger := &recordingAcknowledger{}
+		delivery := amqp.Delivery{Acknowledger: acknowledger, DeliveryTag: 3, Body: []byte(`{}`)}
+
+		err := worker.HandleDelivery(canvas.ID, "rabbitmq-1", "jobs", delivery, true)
+		require.NoError(t, err)
+		assert.Empty(t, acknowledger.acked)
+		support.VerifyCanvasNodeEventsCount(t, canvas.ID, "rabbitmq-1", 2)
+	})
+}
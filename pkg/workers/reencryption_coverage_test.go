@@ -0,0 +1,122 @@
+package workers
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/models"
+)
+
+// integrationConfigurationColumn holds the sensitive fields of integrations,
+// re-wrapped by ProcessIntegrations.
+const integrationConfigurationColumn = "app_installations.configuration"
+
+// encryptCallSites maps every function encrypting values with the application encryptor
+// to the column the values are stored in. Functions encrypting values not stored
+// with the application encryptor map to an empty column.
+var encryptCallSites = map[string]string{
+	"authentication/authentication.go:updateAccountProviders":                       "account_providers.access_token",
+	"crypto/envelope_encryptor.go:Encrypt":                                          "",
+	"crypto/envelope_encryptor.go:Rewrap":                                           "",
+	"crypto/random.go:NewRandomKey":                                                 "",
+	"grpc/actions/organizations/create_integration.go:encryptConfigurationIfNeeded": integrationConfigurationColumn,
+	"grpc/actions/organizations/set_agent_openai_key.go:SetAgentOpenAIKey":          "organization_agent_settings.openai_api_key_ciphertext",
+	"grpc/actions/organizations/update_sso_settings.go:updateOIDCSettings":          "organization_sso_settings.oidc_client_secret_ciphertext",
+	"grpc/actions/secrets/create_secret.go:encryptSecretData":                       "secrets.data",
+	"grpc/actions/secrets/create_secret.go:prepareSecretData":                       "secrets.data",
+	"kms/local_keyfile_provider.go:WrapKey":                                         "",
+	"public/setup_owner.go:setupOwner":                                              "email_settings.smtp_password",
+	"secrets/rotation.go:RotateLocalSecret":                                         "secret_versions.data",
+	"workers/contexts/integration_context.go:SetSecret":                             "app_installation_secrets.value",
+	"workers/contexts/integration_context.go:createWebhook":                         "webhooks.secret",
+	"workers/contexts/node_webhook_context.go:ResetSecret":                          "webhooks.secret",
+	"workers/contexts/node_webhook_context.go:SetSecret":                            "webhooks.secret",
+	"workers/contexts/node_webhook_context.go:findOrCreateWebhook":                  "webhooks.secret",
+	"workers/contexts/webhook_context.go:SetSecret":                                 "webhooks.secret",
+}
+
+func Test__ReencryptionWorker_CoversEncryptedValues(t *testing.T) {
+	covered := map[string]bool{integrationConfigurationColumn: true}
+	for _, column := range models.EncryptedColumns {
+		covered[column.Table+"."+column.Column] = true
+	}
+
+	found := findEncryptCallSites(t, "..")
+
+	for _, site := range found {
+		column, ok := encryptCallSites[site]
+		if !assert.True(t, ok, "%s encrypts values, add its column to models.EncryptedColumns and to encryptCallSites", site) {
+			continue
+		}
+
+		if column != "" {
+			assert.True(t, covered[column], "%s stores values in %s, which is not re-encrypted", site, column)
+		}
+	}
+
+	for site := range encryptCallSites {
+		assert.Contains(t, found, site, "%s does not encrypt values anymore, remove it from encryptCallSites", site)
+	}
+}
+
+// findEncryptCallSites returns the functions calling Encrypt or NewRandomKey,
+// as <file>:<function>, with files relative to root.
+func findEncryptCallSites(t *testing.T, root string) []string {
+	sites := []string{}
+
+	err := filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if entry.IsDir() || !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+
+		file, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+		if err != nil {
+			return err
+		}
+
+		relativePath, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+
+		for _, decl := range file.Decls {
+			function, ok := decl.(*ast.FuncDecl)
+			if !ok || function.Body == nil {
+				continue
+			}
+
+			ast.Inspect(function.Body, func(node ast.Node) bool {
+				call, ok := node.(*ast.CallExpr)
+				if !ok {
+					return true
+				}
+
+				selector, ok := call.Fun.(*ast.SelectorExpr)
+				if ok && (selector.Sel.Name == "Encrypt" || selector.Sel.Name == "NewRandomKey") {
+					site := filepath.ToSlash(relativePath) + ":" + function.Name.Name
+					if len(sites) == 0 || sites[len(sites)-1] != site {
+						sites = append(sites, site)
+					}
+				}
+
+				return true
+			})
+		}
+
+		return nil
+	})
+
+	require.NoError(t, err)
+	return sites
+}
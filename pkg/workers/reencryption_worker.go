@@ -0,0 +1,210 @@
+package workers
+
+import (
+	"context"
+	"encoding/base64"
+	"maps"
+	"time"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/registry"
+)
+
+// ReencryptionWorker re-wraps encrypted values whose data keys
+// were not wrapped by the active key encryption key,
+// so the previous master key can be retired after a rotation.
+// Values written before envelope encryption was enabled are encrypted again.
+// Besides the encrypted columns, the sensitive fields of integration configurations
+// are re-wrapped, since only the registry knows which fields are sensitive.
+//
+// Every value is replaced only if it was not changed since it was read,
+// so the worker can run while SuperPlane keeps writing new values.
+type ReencryptionWorker struct {
+	encryptor *crypto.EnvelopeEncryptor
+	registry  *registry.Registry
+	logger    *log.Entry
+	interval  time.Duration
+	batchSize int
+}
+
+func NewReencryptionWorker(encryptor *crypto.EnvelopeEncryptor, registry *registry.Registry) *ReencryptionWorker {
+	return &ReencryptionWorker{
+		encryptor: encryptor,
+		registry:  registry,
+		logger:    log.WithFields(log.Fields{"worker": "ReencryptionWorker"}),
+		interval:  10 * time.Minute,
+		batchSize: 100,
+	}
+}
+
+func (w *ReencryptionWorker) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.Tick(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *ReencryptionWorker) Tick(ctx context.Context) {
+	for _, column := range models.EncryptedColumns {
+		rewrapped, err := w.ProcessColumn(ctx, column)
+		if err != nil {
+			w.logger.Errorf("Error re-encrypting %s.%s: %v", column.Table, column.Column, err)
+		}
+
+		if rewrapped > 0 {
+			w.logger.Infof("Re-encrypted %d values of %s.%s", rewrapped, column.Table, column.Column)
+		}
+	}
+
+	rewrapped, err := w.ProcessIntegrations(ctx)
+	if err != nil {
+		w.logger.Errorf("Error re-encrypting integration configurations: %v", err)
+	}
+
+	if rewrapped > 0 {
+		w.logger.Infof("Re-encrypted %d integration configurations", rewrapped)
+	}
+}
+
+// ProcessColumn goes through every value of an encrypted column in batches,
+// and returns how many values were re-encrypted.
+func (w *ReencryptionWorker) ProcessColumn(ctx context.Context, column models.EncryptedColumn) (int, error) {
+	rewrapped := 0
+	afterID := uuid.Nil
+
+	for {
+		values, err := models.ListEncryptedValues(column, afterID, w.batchSize)
+		if err != nil {
+			return rewrapped, err
+		}
+
+		for _, value := range values {
+			ok, err := w.processValue(ctx, column, value)
+			if err != nil {
+				w.logger.Errorf("Error re-encrypting %s.%s for %s: %v", column.Table, column.Column, value.ID, err)
+				continue
+			}
+
+			if ok {
+				rewrapped++
+			}
+		}
+
+		if len(values) < w.batchSize {
+			return rewrapped, nil
+		}
+
+		afterID = values[len(values)-1].ID
+	}
+}
+
+func (w *ReencryptionWorker) processValue(ctx context.Context, column models.EncryptedColumn, value models.EncryptedValue) (bool, error) {
+	if len(value.Data) == 0 {
+		return false, nil
+	}
+
+	needsRewrap, err := w.encryptor.NeedsRewrap(ctx, value.Data)
+	if err != nil || !needsRewrap {
+		return false, err
+	}
+
+	data, err := w.encryptor.Rewrap(ctx, value.Data, []byte(value.AssociatedData))
+	if err != nil {
+		return false, err
+	}
+
+	return models.ReplaceEncryptedValue(column, value.ID, value.Data, data)
+}
+
+// ProcessIntegrations goes through every integration in batches,
+// and returns how many configurations were re-encrypted.
+func (w *ReencryptionWorker) ProcessIntegrations(ctx context.Context) (int, error) {
+	rewrapped := 0
+	afterID := uuid.Nil
+
+	for {
+		integrations, err := models.ListIntegrationsAfter(afterID, w.batchSize)
+		if err != nil {
+			return rewrapped, err
+		}
+
+		for _, integration := range integrations {
+			ok, err := w.processIntegration(ctx, integration)
+			if err != nil {
+				w.logger.Errorf("Error re-encrypting configuration of integration %s: %v", integration.ID, err)
+				continue
+			}
+
+			if ok {
+				rewrapped++
+			}
+		}
+
+		if len(integrations) < w.batchSize {
+			return rewrapped, nil
+		}
+
+		afterID = integrations[len(integrations)-1].ID
+	}
+}
+
+func (w *ReencryptionWorker) processIntegration(ctx context.Context, integration models.Integration) (bool, error) {
+	impl, err := w.registry.GetIntegration(integration.AppName)
+	if err != nil {
+		return false, err
+	}
+
+	config := integration.Configuration.Data()
+	newConfig := maps.Clone(config)
+	changed := false
+
+	for _, field := range impl.Configuration() {
+		if !field.Sensitive {
+			continue
+		}
+
+		value, ok := config[field.Name].(string)
+		if !ok || value == "" {
+			continue
+		}
+
+		ciphertext, err := base64.StdEncoding.DecodeString(value)
+		if err != nil {
+			return false, err
+		}
+
+		needsRewrap, err := w.encryptor.NeedsRewrap(ctx, ciphertext)
+		if err != nil {
+			return false, err
+		}
+
+		if !needsRewrap {
+			continue
+		}
+
+		data, err := w.encryptor.Rewrap(ctx, ciphertext, []byte(integration.ID.String()))
+		if err != nil {
+			return false, err
+		}
+
+		newConfig[field.Name] = base64.StdEncoding.EncodeToString(data)
+		changed = true
+	}
+
+	if !changed {
+		return false, nil
+	}
+
+	return models.ReplaceIntegrationConfiguration(integration.ID, config, newConfig)
+}
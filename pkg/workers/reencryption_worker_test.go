@@ -0,0 +1,155 @@
+package workers
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/kms"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/secrets"
+	"github.com/superplanehq/superplane/test/support"
+)
+
+func writeTestKeyfile(t *testing.T, path string, keyfile kms.LocalKeyfile, modTime time.Time) {
+	data, err := json.Marshal(keyfile)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, data, 0600))
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+}
+
+func newTestKey() string {
+	key := make([]byte, 32)
+	_, _ = rand.Read(key)
+	return base64.StdEncoding.EncodeToString(key)
+}
+
+func Test__ReencryptionWorker(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+	ctx := context.Background()
+
+	legacyKey := make([]byte, 32)
+	_, _ = rand.Read(legacyKey)
+	legacy := crypto.NewAESGCMEncryptor(legacyKey)
+
+	keyfile := kms.LocalKeyfile{ActiveKeyID: "k1", Keys: map[string]string{"k1": newTestKey()}}
+	keyfilePath := filepath.Join(t.TempDir(), "keys.json")
+	writeTestKeyfile(t, keyfilePath, keyfile, time.Now())
+
+	provider, err := kms.NewLocalKeyfileProvider(keyfilePath)
+	require.NoError(t, err)
+	encryptor := crypto.NewEnvelopeEncryptor(provider, legacy)
+	worker := NewReencryptionWorker(encryptor, r.Registry)
+
+	//
+	// Secret written before envelope encryption was enabled.
+	//
+	data := []byte(`{"token":"abc"}`)
+	ciphertext, err := legacy.Encrypt(ctx, data, []byte("reencrypted"))
+	require.NoError(t, err)
+	secret, err := models.CreateSecret("reencrypted", secrets.ProviderLocal, r.User.String(), models.DomainTypeOrganization, r.Organization.ID, ciphertext)
+	require.NoError(t, err)
+
+	assertKeyID := func(keyID string) {
+		t.Helper()
+
+		var record models.Secret
+		require.NoError(t, database.Conn().Where("id = ?", secret.ID).First(&record).Error)
+		actual, ok := crypto.EnvelopeKeyID(record.Data)
+		require.True(t, ok)
+		require.Equal(t, keyID, actual)
+
+		plaintext, err := encryptor.Decrypt(ctx, record.Data, []byte(record.Name))
+		require.NoError(t, err)
+		require.Equal(t, data, plaintext)
+
+		var version models.SecretVersion
+		require.NoError(t, database.Conn().Where("secret_id = ?", secret.ID).First(&version).Error)
+		actual, ok = crypto.EnvelopeKeyID(version.Data)
+		require.True(t, ok)
+		require.Equal(t, keyID, actual)
+	}
+
+	t.Run("legacy values are encrypted again with the active key", func(t *testing.T) {
+		worker.Tick(ctx)
+		assertKeyID("k1")
+	})
+
+	t.Run("values are re-wrapped after the key is rotated", func(t *testing.T) {
+		keyfile.Keys["k2"] = newTestKey()
+		keyfile.ActiveKeyID = "k2"
+		writeTestKeyfile(t, keyfilePath, keyfile, time.Now().Add(time.Minute))
+
+		worker.Tick(ctx)
+		assertKeyID("k2")
+
+		//
+		// Nothing is left to re-wrap, so k1 can be removed.
+		//
+		rewrapped, err := worker.ProcessColumn(ctx, models.EncryptedColumns[0])
+		require.NoError(t, err)
+		require.Zero(t, rewrapped)
+
+		delete(keyfile.Keys, "k1")
+		writeTestKeyfile(t, keyfilePath, keyfile, time.Now().Add(2*time.Minute))
+		assertKeyID("k2")
+	})
+}
+
+func Test__ReencryptionWorker_Base64Column(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+	ctx := context.Background()
+
+	legacyKey := make([]byte, 32)
+	_, _ = rand.Read(legacyKey)
+	legacy := crypto.NewAESGCMEncryptor(legacyKey)
+
+	keyfile := kms.LocalKeyfile{ActiveKeyID: "k1", Keys: map[string]string{"k1": newTestKey()}}
+	keyfilePath := filepath.Join(t.TempDir(), "keys.json")
+	writeTestKeyfile(t, keyfilePath, keyfile, time.Now())
+
+	provider, err := kms.NewLocalKeyfileProvider(keyfilePath)
+	require.NoError(t, err)
+	encryptor := crypto.NewEnvelopeEncryptor(provider, legacy)
+	worker := NewReencryptionWorker(encryptor, r.Registry)
+
+	account, err := models.CreateAccount("reencrypted", "reencrypted@test.com")
+	require.NoError(t, err)
+
+	ciphertext, err := legacy.Encrypt(ctx, []byte("token"), []byte(account.Email))
+	require.NoError(t, err)
+
+	accountProvider := models.AccountProvider{
+		AccountID:   account.ID,
+		Provider:    "github",
+		ProviderID:  "reencrypted",
+		Email:       account.Email,
+		AccessToken: base64.StdEncoding.EncodeToString(ciphertext),
+	}
+	require.NoError(t, database.Conn().Create(&accountProvider).Error)
+
+	worker.Tick(ctx)
+
+	var record models.AccountProvider
+	require.NoError(t, database.Conn().Where("id = ?", accountProvider.ID).First(&record).Error)
+	data, err := base64.StdEncoding.DecodeString(record.AccessToken)
+	require.NoError(t, err)
+
+	keyID, ok := crypto.EnvelopeKeyID(data)
+	require.True(t, ok)
+	require.Equal(t, "k1", keyID)
+
+	plaintext, err := encryptor.Decrypt(ctx, data, []byte(record.Email))
+	require.NoError(t, err)
+	require.Equal(t, []byte("token"), plaintext)
+}
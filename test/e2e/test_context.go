@@ -45,6 +45,7 @@ func (s *TestContext) Start() {
 	os.Setenv("START_BLUEPRINT_NODE_EXECUTOR", "yes")
 	os.Setenv("START_NODE_QUEUE_WORKER", "yes")
 	os.Setenv("START_NODE_REQUEST_WORKER", "yes")
+	os.Setenv("START_CANVAS_EXECUTION_TRIGGER_WORKER", "yes")
 	os.Setenv("START_WEBHOOK_PROVISIONER", "yes")
 	os.Setenv("START_WEBHOOK_CLEANUP_WORKER", "yes")
 	os.Setenv("NO_ENCRYPTION", "yes")
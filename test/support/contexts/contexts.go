@@ -367,3 +367,15 @@ func (c *SecretsContext) GetKey(secretName, keyName string) ([]byte, error) {
 
 	return value, nil
 }
+
+func (c *SecretsContext) Rotate(secretName string, values map[string]string) error {
+	if c.Values == nil {
+		c.Values = map[string][]byte{}
+	}
+
+	for key, value := range values {
+		c.Values[secretName+"/"+key] = []byte(value)
+	}
+
+	return nil
+}
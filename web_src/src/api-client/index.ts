@@ -1,6 +1,7 @@
 // This file is auto-generated by @hey-api/openapi-ts
 
 export {
+  auditListAuditEvents,
   blueprintsCreateBlueprint,
   blueprintsDeleteBlueprint,
   blueprintsDescribeBlueprint,
@@ -8,34 +9,52 @@ export {
   blueprintsUpdateBlueprint,
   canvasesActOnCanvasChangeRequest,
   canvasesCancelExecution,
+  canvasesCompleteExpression,
   canvasesCreateCanvas,
   canvasesCreateCanvasChangeRequest,
   canvasesCreateCanvasVersion,
   canvasesDeleteCanvas,
+  canvasesDeleteCanvasGitSync,
   canvasesDeleteCanvasMemory,
+  canvasesDeleteCanvasMemoryNamespace,
   canvasesDeleteNodeQueueItem,
   canvasesDescribeCanvas,
   canvasesDescribeCanvasChangeRequest,
+  canvasesDescribeCanvasGitSync,
   canvasesDescribeCanvasVersion,
+  canvasesDiffCanvasVersions,
   canvasesEmitNodeEvent,
+  canvasesExportCanvas,
+  canvasesImportCanvas,
   canvasesInvokeNodeExecutionAction,
   canvasesInvokeNodeTriggerAction,
   canvasesListCanvasChangeRequests,
   canvasesListCanvases,
   canvasesListCanvasEvents,
+  canvasesListCanvasGroups,
+  canvasesListCanvasMembers,
   canvasesListCanvasMemories,
+  canvasesListCanvasMemoryNamespaces,
   canvasesListCanvasVersions,
   canvasesListChildExecutions,
   canvasesListEventExecutions,
   canvasesListNodeEvents,
   canvasesListNodeExecutions,
   canvasesListNodeQueueItems,
+  canvasesRemoveCanvasGroup,
+  canvasesRemoveCanvasMember,
   canvasesResolveCanvasChangeRequest,
   canvasesResolveExecutionErrors,
+  canvasesRollbackCanvasVersion,
   canvasesUpdateCanvas,
+  canvasesUpdateCanvasGitSync,
+  canvasesUpdateCanvasGroup,
+  canvasesUpdateCanvasMember,
+  canvasesUpdateCanvasMemoryNamespace,
   canvasesUpdateCanvasVersion,
   canvasesUpdateCanvasVersion2,
   canvasesUpdateNodePause,
+  canvasesValidateExpression,
   componentsDescribeComponent,
   componentsListComponentActions,
   componentsListComponents,
@@ -56,14 +75,19 @@ export {
   organizationsCreateInvitation,
   organizationsDeleteAgentOpenAiKey,
   organizationsDeleteIntegration,
+  organizationsDeleteMemory,
+  organizationsDeleteMemoryNamespace,
   organizationsDeleteOrganization,
   organizationsDescribeIntegration,
   organizationsDescribeOrganization,
   organizationsGetAgentSettings,
   organizationsGetInviteLink,
+  organizationsGetSsoSettings,
   organizationsListIntegrationResources,
   organizationsListIntegrations,
   organizationsListInvitations,
+  organizationsListMemories,
+  organizationsListMemoryNamespaces,
   organizationsRemoveInvitation,
   organizationsRemoveUser,
   organizationsResetInviteLink,
@@ -71,7 +95,9 @@ export {
   organizationsUpdateAgentSettings,
   organizationsUpdateIntegration,
   organizationsUpdateInviteLink,
+  organizationsUpdateMemoryNamespace,
   organizationsUpdateOrganization,
+  organizationsUpdateSsoSettings,
   rolesAssignRole,
   rolesCreateRole,
   rolesDeleteRole,
@@ -83,6 +109,9 @@ export {
   secretsDeleteSecretKey,
   secretsDescribeSecret,
   secretsListSecrets,
+  secretsListSecretVersions,
+  secretsRollbackSecret,
+  secretsRotateSecret,
   secretsSetSecretKey,
   secretsUpdateSecret,
   secretsUpdateSecretName,
@@ -102,6 +131,15 @@ export {
 } from "./sdk.gen";
 export type {
   ActOnCanvasChangeRequestRequestAction,
+  AuditAuditEvent,
+  AuditEventActor,
+  AuditEventResource,
+  AuditListAuditEventsData,
+  AuditListAuditEventsError,
+  AuditListAuditEventsErrors,
+  AuditListAuditEventsResponse,
+  AuditListAuditEventsResponse2,
+  AuditListAuditEventsResponses,
   AuthorizationDomainType,
   AuthorizationPermission,
   BlueprintsBlueprint,
@@ -139,6 +177,9 @@ export type {
   BlueprintsUpdateBlueprintResponses,
   CanvasAutoLayoutAlgorithm,
   CanvasAutoLayoutScope,
+  CanvasBundleIntegrationReference,
+  CanvasBundleSecretReference,
+  CanvasChangeRequestCheckProblem,
   CanvasesActOnCanvasChangeRequestBody,
   CanvasesActOnCanvasChangeRequestData,
   CanvasesActOnCanvasChangeRequestError,
@@ -155,26 +196,48 @@ export type {
   CanvasesCancelExecutionResponses,
   CanvasesCanvas,
   CanvasesCanvasAutoLayout,
+  CanvasesCanvasBundle,
+  CanvasesCanvasBundleMetadata,
   CanvasesCanvasChangeRequest,
   CanvasesCanvasChangeRequestApproval,
   CanvasesCanvasChangeRequestApprovalConfig,
   CanvasesCanvasChangeRequestApprovalState,
   CanvasesCanvasChangeRequestApprover,
   CanvasesCanvasChangeRequestApproverType,
+  CanvasesCanvasChangeRequestCheck,
+  CanvasesCanvasChangeRequestCheckConfig,
+  CanvasesCanvasChangeRequestCheckStatus,
   CanvasesCanvasChangeRequestDiff,
   CanvasesCanvasChangeRequestMetadata,
   CanvasesCanvasChangeRequestStatus,
   CanvasesCanvasEvent,
   CanvasesCanvasEventWithExecutions,
+  CanvasesCanvasGitSync,
+  CanvasesCanvasGitSyncStatus,
+  CanvasesCanvasGroup,
+  CanvasesCanvasMember,
   CanvasesCanvasMemory,
+  CanvasesCanvasMemoryNamespace,
+  CanvasesCanvasMemoryNamespaceField,
   CanvasesCanvasMetadata,
+  CanvasesCanvasNodeActionPermissions,
+  CanvasesCanvasNodeActionPermissionsConfig,
   CanvasesCanvasNodeExecution,
   CanvasesCanvasNodeExecutionState,
   CanvasesCanvasNodeQueueItem,
   CanvasesCanvasSpec,
   CanvasesCanvasStatus,
+  CanvasesCanvasVariable,
   CanvasesCanvasVersion,
+  CanvasesCanvasVersionDiff,
   CanvasesCanvasVersionMetadata,
+  CanvasesCompleteExpressionBody,
+  CanvasesCompleteExpressionData,
+  CanvasesCompleteExpressionError,
+  CanvasesCompleteExpressionErrors,
+  CanvasesCompleteExpressionResponse,
+  CanvasesCompleteExpressionResponse2,
+  CanvasesCompleteExpressionResponses,
   CanvasesCreateCanvasChangeRequestBody,
   CanvasesCreateCanvasChangeRequestData,
   CanvasesCreateCanvasChangeRequestError,
@@ -199,9 +262,21 @@ export type {
   CanvasesDeleteCanvasData,
   CanvasesDeleteCanvasError,
   CanvasesDeleteCanvasErrors,
+  CanvasesDeleteCanvasGitSyncData,
+  CanvasesDeleteCanvasGitSyncError,
+  CanvasesDeleteCanvasGitSyncErrors,
+  CanvasesDeleteCanvasGitSyncResponse,
+  CanvasesDeleteCanvasGitSyncResponse2,
+  CanvasesDeleteCanvasGitSyncResponses,
   CanvasesDeleteCanvasMemoryData,
   CanvasesDeleteCanvasMemoryError,
   CanvasesDeleteCanvasMemoryErrors,
+  CanvasesDeleteCanvasMemoryNamespaceData,
+  CanvasesDeleteCanvasMemoryNamespaceError,
+  CanvasesDeleteCanvasMemoryNamespaceErrors,
+  CanvasesDeleteCanvasMemoryNamespaceResponse,
+  CanvasesDeleteCanvasMemoryNamespaceResponse2,
+  CanvasesDeleteCanvasMemoryNamespaceResponses,
   CanvasesDeleteCanvasMemoryResponse,
   CanvasesDeleteCanvasMemoryResponse2,
   CanvasesDeleteCanvasMemoryResponses,
@@ -223,6 +298,12 @@ export type {
   CanvasesDescribeCanvasData,
   CanvasesDescribeCanvasError,
   CanvasesDescribeCanvasErrors,
+  CanvasesDescribeCanvasGitSyncData,
+  CanvasesDescribeCanvasGitSyncError,
+  CanvasesDescribeCanvasGitSyncErrors,
+  CanvasesDescribeCanvasGitSyncResponse,
+  CanvasesDescribeCanvasGitSyncResponse2,
+  CanvasesDescribeCanvasGitSyncResponses,
   CanvasesDescribeCanvasResponse,
   CanvasesDescribeCanvasResponse2,
   CanvasesDescribeCanvasResponses,
@@ -232,6 +313,12 @@ export type {
   CanvasesDescribeCanvasVersionResponse,
   CanvasesDescribeCanvasVersionResponse2,
   CanvasesDescribeCanvasVersionResponses,
+  CanvasesDiffCanvasVersionsData,
+  CanvasesDiffCanvasVersionsError,
+  CanvasesDiffCanvasVersionsErrors,
+  CanvasesDiffCanvasVersionsResponse,
+  CanvasesDiffCanvasVersionsResponse2,
+  CanvasesDiffCanvasVersionsResponses,
   CanvasesEmitNodeEventBody,
   CanvasesEmitNodeEventData,
   CanvasesEmitNodeEventError,
@@ -239,6 +326,21 @@ export type {
   CanvasesEmitNodeEventResponse,
   CanvasesEmitNodeEventResponse2,
   CanvasesEmitNodeEventResponses,
+  CanvasesExportCanvasData,
+  CanvasesExportCanvasError,
+  CanvasesExportCanvasErrors,
+  CanvasesExportCanvasResponse,
+  CanvasesExportCanvasResponse2,
+  CanvasesExportCanvasResponses,
+  CanvasesExpressionCompletion,
+  CanvasesExpressionDiagnostic,
+  CanvasesImportCanvasData,
+  CanvasesImportCanvasError,
+  CanvasesImportCanvasErrors,
+  CanvasesImportCanvasRequest,
+  CanvasesImportCanvasResponse,
+  CanvasesImportCanvasResponse2,
+  CanvasesImportCanvasResponses,
   CanvasesInvokeNodeExecutionActionBody,
   CanvasesInvokeNodeExecutionActionData,
   CanvasesInvokeNodeExecutionActionError,
@@ -271,12 +373,30 @@ export type {
   CanvasesListCanvasEventsResponse,
   CanvasesListCanvasEventsResponse2,
   CanvasesListCanvasEventsResponses,
+  CanvasesListCanvasGroupsData,
+  CanvasesListCanvasGroupsError,
+  CanvasesListCanvasGroupsErrors,
+  CanvasesListCanvasGroupsResponse,
+  CanvasesListCanvasGroupsResponse2,
+  CanvasesListCanvasGroupsResponses,
+  CanvasesListCanvasMembersData,
+  CanvasesListCanvasMembersError,
+  CanvasesListCanvasMembersErrors,
+  CanvasesListCanvasMembersResponse,
+  CanvasesListCanvasMembersResponse2,
+  CanvasesListCanvasMembersResponses,
   CanvasesListCanvasMemoriesData,
   CanvasesListCanvasMemoriesError,
   CanvasesListCanvasMemoriesErrors,
   CanvasesListCanvasMemoriesResponse,
   CanvasesListCanvasMemoriesResponse2,
   CanvasesListCanvasMemoriesResponses,
+  CanvasesListCanvasMemoryNamespacesData,
+  CanvasesListCanvasMemoryNamespacesError,
+  CanvasesListCanvasMemoryNamespacesErrors,
+  CanvasesListCanvasMemoryNamespacesResponse,
+  CanvasesListCanvasMemoryNamespacesResponse2,
+  CanvasesListCanvasMemoryNamespacesResponses,
   CanvasesListCanvasVersionsData,
   CanvasesListCanvasVersionsError,
   CanvasesListCanvasVersionsErrors,
@@ -314,6 +434,18 @@ export type {
   CanvasesListNodeQueueItemsResponse,
   CanvasesListNodeQueueItemsResponse2,
   CanvasesListNodeQueueItemsResponses,
+  CanvasesRemoveCanvasGroupData,
+  CanvasesRemoveCanvasGroupError,
+  CanvasesRemoveCanvasGroupErrors,
+  CanvasesRemoveCanvasGroupResponse,
+  CanvasesRemoveCanvasGroupResponse2,
+  CanvasesRemoveCanvasGroupResponses,
+  CanvasesRemoveCanvasMemberData,
+  CanvasesRemoveCanvasMemberError,
+  CanvasesRemoveCanvasMemberErrors,
+  CanvasesRemoveCanvasMemberResponse,
+  CanvasesRemoveCanvasMemberResponse2,
+  CanvasesRemoveCanvasMemberResponses,
   CanvasesResolveCanvasChangeRequestBody,
   CanvasesResolveCanvasChangeRequestData,
   CanvasesResolveCanvasChangeRequestError,
@@ -328,10 +460,45 @@ export type {
   CanvasesResolveExecutionErrorsResponse,
   CanvasesResolveExecutionErrorsResponse2,
   CanvasesResolveExecutionErrorsResponses,
+  CanvasesRollbackCanvasVersionBody,
+  CanvasesRollbackCanvasVersionData,
+  CanvasesRollbackCanvasVersionError,
+  CanvasesRollbackCanvasVersionErrors,
+  CanvasesRollbackCanvasVersionResponse,
+  CanvasesRollbackCanvasVersionResponse2,
+  CanvasesRollbackCanvasVersionResponses,
   CanvasesUpdateCanvasBody,
   CanvasesUpdateCanvasData,
   CanvasesUpdateCanvasError,
   CanvasesUpdateCanvasErrors,
+  CanvasesUpdateCanvasGitSyncBody,
+  CanvasesUpdateCanvasGitSyncData,
+  CanvasesUpdateCanvasGitSyncError,
+  CanvasesUpdateCanvasGitSyncErrors,
+  CanvasesUpdateCanvasGitSyncResponse,
+  CanvasesUpdateCanvasGitSyncResponse2,
+  CanvasesUpdateCanvasGitSyncResponses,
+  CanvasesUpdateCanvasGroupBody,
+  CanvasesUpdateCanvasGroupData,
+  CanvasesUpdateCanvasGroupError,
+  CanvasesUpdateCanvasGroupErrors,
+  CanvasesUpdateCanvasGroupResponse,
+  CanvasesUpdateCanvasGroupResponse2,
+  CanvasesUpdateCanvasGroupResponses,
+  CanvasesUpdateCanvasMemberBody,
+  CanvasesUpdateCanvasMemberData,
+  CanvasesUpdateCanvasMemberError,
+  CanvasesUpdateCanvasMemberErrors,
+  CanvasesUpdateCanvasMemberResponse,
+  CanvasesUpdateCanvasMemberResponse2,
+  CanvasesUpdateCanvasMemberResponses,
+  CanvasesUpdateCanvasMemoryNamespaceBody,
+  CanvasesUpdateCanvasMemoryNamespaceData,
+  CanvasesUpdateCanvasMemoryNamespaceError,
+  CanvasesUpdateCanvasMemoryNamespaceErrors,
+  CanvasesUpdateCanvasMemoryNamespaceResponse,
+  CanvasesUpdateCanvasMemoryNamespaceResponse2,
+  CanvasesUpdateCanvasMemoryNamespaceResponses,
   CanvasesUpdateCanvasResponse,
   CanvasesUpdateCanvasResponse2,
   CanvasesUpdateCanvasResponses,
@@ -354,8 +521,22 @@ export type {
   CanvasesUpdateNodePauseResponse,
   CanvasesUpdateNodePauseResponse2,
   CanvasesUpdateNodePauseResponses,
+  CanvasesValidateExpressionBody,
+  CanvasesValidateExpressionData,
+  CanvasesValidateExpressionError,
+  CanvasesValidateExpressionErrors,
+  CanvasesValidateExpressionResponse,
+  CanvasesValidateExpressionResponse2,
+  CanvasesValidateExpressionResponses,
   CanvasNodeExecutionResult,
   CanvasNodeExecutionResultReason,
+  CanvasVariableOverride,
+  CanvasVariableSecretRef,
+  CanvasVersionDiffChangeType,
+  CanvasVersionDiffEdgeChange,
+  CanvasVersionDiffFieldChange,
+  CanvasVersionDiffNodeChange,
+  CanvasVersionDiffVariableChange,
   ClientOptions,
   ComponentsComponent,
   ComponentsComponentAction,
@@ -403,6 +584,8 @@ export type {
   ConfigurationTimeTypeOptions,
   ConfigurationTypeOptions,
   ConfigurationVisibilityCondition,
+  ExpressionCompletionKind,
+  ExpressionDiagnosticSeverity,
   GooglerpcStatus,
   GroupsAddUserToGroupBody,
   GroupsAddUserToGroupData,
@@ -460,6 +643,7 @@ export type {
   GroupsUpdateGroupResponse,
   GroupsUpdateGroupResponse2,
   GroupsUpdateGroupResponses,
+  ImportCanvasRequestIntegrationMapping,
   IntegrationNodeRef,
   IntegrationsIntegrationDefinition,
   IntegrationsListIntegrationsData,
@@ -472,6 +656,8 @@ export type {
   MeMeErrors,
   MeMeResponse,
   MeMeResponses,
+  MemoryNamespaceAccess,
+  MemoryNamespaceCanvasAccess,
   MeRegenerateTokenData,
   MeRegenerateTokenError,
   MeRegenerateTokenErrors,
@@ -516,6 +702,18 @@ export type {
   OrganizationsDeleteIntegrationResponse,
   OrganizationsDeleteIntegrationResponse2,
   OrganizationsDeleteIntegrationResponses,
+  OrganizationsDeleteMemoryData,
+  OrganizationsDeleteMemoryError,
+  OrganizationsDeleteMemoryErrors,
+  OrganizationsDeleteMemoryNamespaceData,
+  OrganizationsDeleteMemoryNamespaceError,
+  OrganizationsDeleteMemoryNamespaceErrors,
+  OrganizationsDeleteMemoryNamespaceResponse,
+  OrganizationsDeleteMemoryNamespaceResponse2,
+  OrganizationsDeleteMemoryNamespaceResponses,
+  OrganizationsDeleteMemoryResponse,
+  OrganizationsDeleteMemoryResponse2,
+  OrganizationsDeleteMemoryResponses,
   OrganizationsDeleteOrganizationData,
   OrganizationsDeleteOrganizationError,
   OrganizationsDeleteOrganizationErrors,
@@ -546,6 +744,12 @@ export type {
   OrganizationsGetInviteLinkResponse,
   OrganizationsGetInviteLinkResponse2,
   OrganizationsGetInviteLinkResponses,
+  OrganizationsGetSsoSettingsData,
+  OrganizationsGetSsoSettingsError,
+  OrganizationsGetSsoSettingsErrors,
+  OrganizationsGetSsoSettingsResponse,
+  OrganizationsGetSsoSettingsResponse2,
+  OrganizationsGetSsoSettingsResponses,
   OrganizationsIntegration,
   OrganizationsIntegrationMetadata,
   OrganizationsIntegrationResourceRef,
@@ -570,6 +774,20 @@ export type {
   OrganizationsListInvitationsResponse,
   OrganizationsListInvitationsResponse2,
   OrganizationsListInvitationsResponses,
+  OrganizationsListMemoriesData,
+  OrganizationsListMemoriesError,
+  OrganizationsListMemoriesErrors,
+  OrganizationsListMemoriesResponse,
+  OrganizationsListMemoriesResponse2,
+  OrganizationsListMemoriesResponses,
+  OrganizationsListMemoryNamespacesData,
+  OrganizationsListMemoryNamespacesError,
+  OrganizationsListMemoryNamespacesErrors,
+  OrganizationsListMemoryNamespacesResponse,
+  OrganizationsListMemoryNamespacesResponse2,
+  OrganizationsListMemoryNamespacesResponses,
+  OrganizationsMemory,
+  OrganizationsMemoryNamespace,
   OrganizationsOrganization,
   OrganizationsOrganizationMetadata,
   OrganizationsRemoveInvitationData,
@@ -597,6 +815,10 @@ export type {
   OrganizationsSetAgentOpenAiKeyResponse,
   OrganizationsSetAgentOpenAiKeyResponse2,
   OrganizationsSetAgentOpenAiKeyResponses,
+  OrganizationsSsoDomain,
+  OrganizationsSsooidcSettings,
+  OrganizationsSsosamlSettings,
+  OrganizationsSsoSettings,
   OrganizationsUpdateAgentSettingsBody,
   OrganizationsUpdateAgentSettingsData,
   OrganizationsUpdateAgentSettingsError,
@@ -618,6 +840,13 @@ export type {
   OrganizationsUpdateInviteLinkResponse,
   OrganizationsUpdateInviteLinkResponse2,
   OrganizationsUpdateInviteLinkResponses,
+  OrganizationsUpdateMemoryNamespaceBody,
+  OrganizationsUpdateMemoryNamespaceData,
+  OrganizationsUpdateMemoryNamespaceError,
+  OrganizationsUpdateMemoryNamespaceErrors,
+  OrganizationsUpdateMemoryNamespaceResponse,
+  OrganizationsUpdateMemoryNamespaceResponse2,
+  OrganizationsUpdateMemoryNamespaceResponses,
   OrganizationsUpdateOrganizationBody,
   OrganizationsUpdateOrganizationData,
   OrganizationsUpdateOrganizationError,
@@ -625,6 +854,13 @@ export type {
   OrganizationsUpdateOrganizationResponse,
   OrganizationsUpdateOrganizationResponse2,
   OrganizationsUpdateOrganizationResponses,
+  OrganizationsUpdateSsoSettingsBody,
+  OrganizationsUpdateSsoSettingsData,
+  OrganizationsUpdateSsoSettingsError,
+  OrganizationsUpdateSsoSettingsErrors,
+  OrganizationsUpdateSsoSettingsResponse,
+  OrganizationsUpdateSsoSettingsResponse2,
+  OrganizationsUpdateSsoSettingsResponses,
   ProtobufAny,
   ProtobufNullValue,
   RolesAssignRoleBody,
@@ -669,6 +905,8 @@ export type {
   RolesUpdateRoleResponse,
   RolesUpdateRoleResponse2,
   RolesUpdateRoleResponses,
+  SecretEnv,
+  SecretFile,
   SecretLocal,
   SecretProvider,
   SecretsCreateSecretData,
@@ -702,9 +940,30 @@ export type {
   SecretsListSecretsResponse,
   SecretsListSecretsResponse2,
   SecretsListSecretsResponses,
+  SecretsListSecretVersionsData,
+  SecretsListSecretVersionsError,
+  SecretsListSecretVersionsErrors,
+  SecretsListSecretVersionsResponse,
+  SecretsListSecretVersionsResponse2,
+  SecretsListSecretVersionsResponses,
+  SecretsRollbackSecretBody,
+  SecretsRollbackSecretData,
+  SecretsRollbackSecretError,
+  SecretsRollbackSecretErrors,
+  SecretsRollbackSecretResponse,
+  SecretsRollbackSecretResponse2,
+  SecretsRollbackSecretResponses,
+  SecretsRotateSecretBody,
+  SecretsRotateSecretData,
+  SecretsRotateSecretError,
+  SecretsRotateSecretErrors,
+  SecretsRotateSecretResponse,
+  SecretsRotateSecretResponse2,
+  SecretsRotateSecretResponses,
   SecretsSecret,
   SecretsSecretMetadata,
   SecretsSecretSpec,
+  SecretsSecretVersion,
   SecretsSetSecretKeyBody,
   SecretsSetSecretKeyData,
   SecretsSetSecretKeyError,
@@ -726,6 +985,7 @@ export type {
   SecretsUpdateSecretResponse,
   SecretsUpdateSecretResponse2,
   SecretsUpdateSecretResponses,
+  SecretVault,
   ServiceAccountsCreateServiceAccountData,
   ServiceAccountsCreateServiceAccountError,
   ServiceAccountsCreateServiceAccountErrors,
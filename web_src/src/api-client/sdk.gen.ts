@@ -3,6 +3,9 @@
 import type { Client, Options as Options2, TDataShape } from "./client";
 import { client } from "./client.gen";
 import type {
+  AuditListAuditEventsData,
+  AuditListAuditEventsErrors,
+  AuditListAuditEventsResponses,
   BlueprintsCreateBlueprintData,
   BlueprintsCreateBlueprintErrors,
   BlueprintsCreateBlueprintResponses,
@@ -24,6 +27,9 @@ import type {
   CanvasesCancelExecutionData,
   CanvasesCancelExecutionErrors,
   CanvasesCancelExecutionResponses,
+  CanvasesCompleteExpressionData,
+  CanvasesCompleteExpressionErrors,
+  CanvasesCompleteExpressionResponses,
   CanvasesCreateCanvasChangeRequestData,
   CanvasesCreateCanvasChangeRequestErrors,
   CanvasesCreateCanvasChangeRequestResponses,
@@ -35,8 +41,14 @@ import type {
   CanvasesCreateCanvasVersionResponses,
   CanvasesDeleteCanvasData,
   CanvasesDeleteCanvasErrors,
+  CanvasesDeleteCanvasGitSyncData,
+  CanvasesDeleteCanvasGitSyncErrors,
+  CanvasesDeleteCanvasGitSyncResponses,
   CanvasesDeleteCanvasMemoryData,
   CanvasesDeleteCanvasMemoryErrors,
+  CanvasesDeleteCanvasMemoryNamespaceData,
+  CanvasesDeleteCanvasMemoryNamespaceErrors,
+  CanvasesDeleteCanvasMemoryNamespaceResponses,
   CanvasesDeleteCanvasMemoryResponses,
   CanvasesDeleteCanvasResponses,
   CanvasesDeleteNodeQueueItemData,
@@ -47,13 +59,25 @@ import type {
   CanvasesDescribeCanvasChangeRequestResponses,
   CanvasesDescribeCanvasData,
   CanvasesDescribeCanvasErrors,
+  CanvasesDescribeCanvasGitSyncData,
+  CanvasesDescribeCanvasGitSyncErrors,
+  CanvasesDescribeCanvasGitSyncResponses,
   CanvasesDescribeCanvasResponses,
   CanvasesDescribeCanvasVersionData,
   CanvasesDescribeCanvasVersionErrors,
   CanvasesDescribeCanvasVersionResponses,
+  CanvasesDiffCanvasVersionsData,
+  CanvasesDiffCanvasVersionsErrors,
+  CanvasesDiffCanvasVersionsResponses,
   CanvasesEmitNodeEventData,
   CanvasesEmitNodeEventErrors,
   CanvasesEmitNodeEventResponses,
+  CanvasesExportCanvasData,
+  CanvasesExportCanvasErrors,
+  CanvasesExportCanvasResponses,
+  CanvasesImportCanvasData,
+  CanvasesImportCanvasErrors,
+  CanvasesImportCanvasResponses,
   CanvasesInvokeNodeExecutionActionData,
   CanvasesInvokeNodeExecutionActionErrors,
   CanvasesInvokeNodeExecutionActionResponses,
@@ -69,9 +93,18 @@ import type {
   CanvasesListCanvasEventsData,
   CanvasesListCanvasEventsErrors,
   CanvasesListCanvasEventsResponses,
+  CanvasesListCanvasGroupsData,
+  CanvasesListCanvasGroupsErrors,
+  CanvasesListCanvasGroupsResponses,
+  CanvasesListCanvasMembersData,
+  CanvasesListCanvasMembersErrors,
+  CanvasesListCanvasMembersResponses,
   CanvasesListCanvasMemoriesData,
   CanvasesListCanvasMemoriesErrors,
   CanvasesListCanvasMemoriesResponses,
+  CanvasesListCanvasMemoryNamespacesData,
+  CanvasesListCanvasMemoryNamespacesErrors,
+  CanvasesListCanvasMemoryNamespacesResponses,
   CanvasesListCanvasVersionsData,
   CanvasesListCanvasVersionsErrors,
   CanvasesListCanvasVersionsResponses,
@@ -90,14 +123,35 @@ import type {
   CanvasesListNodeQueueItemsData,
   CanvasesListNodeQueueItemsErrors,
   CanvasesListNodeQueueItemsResponses,
+  CanvasesRemoveCanvasGroupData,
+  CanvasesRemoveCanvasGroupErrors,
+  CanvasesRemoveCanvasGroupResponses,
+  CanvasesRemoveCanvasMemberData,
+  CanvasesRemoveCanvasMemberErrors,
+  CanvasesRemoveCanvasMemberResponses,
   CanvasesResolveCanvasChangeRequestData,
   CanvasesResolveCanvasChangeRequestErrors,
   CanvasesResolveCanvasChangeRequestResponses,
   CanvasesResolveExecutionErrorsData,
   CanvasesResolveExecutionErrorsErrors,
   CanvasesResolveExecutionErrorsResponses,
+  CanvasesRollbackCanvasVersionData,
+  CanvasesRollbackCanvasVersionErrors,
+  CanvasesRollbackCanvasVersionResponses,
   CanvasesUpdateCanvasData,
   CanvasesUpdateCanvasErrors,
+  CanvasesUpdateCanvasGitSyncData,
+  CanvasesUpdateCanvasGitSyncErrors,
+  CanvasesUpdateCanvasGitSyncResponses,
+  CanvasesUpdateCanvasGroupData,
+  CanvasesUpdateCanvasGroupErrors,
+  CanvasesUpdateCanvasGroupResponses,
+  CanvasesUpdateCanvasMemberData,
+  CanvasesUpdateCanvasMemberErrors,
+  CanvasesUpdateCanvasMemberResponses,
+  CanvasesUpdateCanvasMemoryNamespaceData,
+  CanvasesUpdateCanvasMemoryNamespaceErrors,
+  CanvasesUpdateCanvasMemoryNamespaceResponses,
   CanvasesUpdateCanvasResponses,
   CanvasesUpdateCanvasVersion2Data,
   CanvasesUpdateCanvasVersion2Errors,
@@ -108,6 +162,9 @@ import type {
   CanvasesUpdateNodePauseData,
   CanvasesUpdateNodePauseErrors,
   CanvasesUpdateNodePauseResponses,
+  CanvasesValidateExpressionData,
+  CanvasesValidateExpressionErrors,
+  CanvasesValidateExpressionResponses,
   ComponentsDescribeComponentData,
   ComponentsDescribeComponentErrors,
   ComponentsDescribeComponentResponses,
@@ -165,6 +222,12 @@ import type {
   OrganizationsDeleteIntegrationData,
   OrganizationsDeleteIntegrationErrors,
   OrganizationsDeleteIntegrationResponses,
+  OrganizationsDeleteMemoryData,
+  OrganizationsDeleteMemoryErrors,
+  OrganizationsDeleteMemoryNamespaceData,
+  OrganizationsDeleteMemoryNamespaceErrors,
+  OrganizationsDeleteMemoryNamespaceResponses,
+  OrganizationsDeleteMemoryResponses,
   OrganizationsDeleteOrganizationData,
   OrganizationsDeleteOrganizationErrors,
   OrganizationsDeleteOrganizationResponses,
@@ -180,6 +243,9 @@ import type {
   OrganizationsGetInviteLinkData,
   OrganizationsGetInviteLinkErrors,
   OrganizationsGetInviteLinkResponses,
+  OrganizationsGetSsoSettingsData,
+  OrganizationsGetSsoSettingsErrors,
+  OrganizationsGetSsoSettingsResponses,
   OrganizationsListIntegrationResourcesData,
   OrganizationsListIntegrationResourcesErrors,
   OrganizationsListIntegrationResourcesResponses,
@@ -189,6 +255,12 @@ import type {
   OrganizationsListInvitationsData,
   OrganizationsListInvitationsErrors,
   OrganizationsListInvitationsResponses,
+  OrganizationsListMemoriesData,
+  OrganizationsListMemoriesErrors,
+  OrganizationsListMemoriesResponses,
+  OrganizationsListMemoryNamespacesData,
+  OrganizationsListMemoryNamespacesErrors,
+  OrganizationsListMemoryNamespacesResponses,
   OrganizationsRemoveInvitationData,
   OrganizationsRemoveInvitationErrors,
   OrganizationsRemoveInvitationResponses,
@@ -210,9 +282,15 @@ import type {
   OrganizationsUpdateInviteLinkData,
   OrganizationsUpdateInviteLinkErrors,
   OrganizationsUpdateInviteLinkResponses,
+  OrganizationsUpdateMemoryNamespaceData,
+  OrganizationsUpdateMemoryNamespaceErrors,
+  OrganizationsUpdateMemoryNamespaceResponses,
   OrganizationsUpdateOrganizationData,
   OrganizationsUpdateOrganizationErrors,
   OrganizationsUpdateOrganizationResponses,
+  OrganizationsUpdateSsoSettingsData,
+  OrganizationsUpdateSsoSettingsErrors,
+  OrganizationsUpdateSsoSettingsResponses,
   RolesAssignRoleData,
   RolesAssignRoleErrors,
   RolesAssignRoleResponses,
@@ -246,6 +324,15 @@ import type {
   SecretsListSecretsData,
   SecretsListSecretsErrors,
   SecretsListSecretsResponses,
+  SecretsListSecretVersionsData,
+  SecretsListSecretVersionsErrors,
+  SecretsListSecretVersionsResponses,
+  SecretsRollbackSecretData,
+  SecretsRollbackSecretErrors,
+  SecretsRollbackSecretResponses,
+  SecretsRotateSecretData,
+  SecretsRotateSecretErrors,
+  SecretsRotateSecretResponses,
   SecretsSetSecretKeyData,
   SecretsSetSecretKeyErrors,
   SecretsSetSecretKeyResponses,
@@ -313,6 +400,19 @@ export type Options<TData extends TDataShape = TDataShape, ThrowOnError extends
   meta?: Record<string, unknown>;
 };
 
+/**
+ * List audit events
+ *
+ * Returns the audit events of the organization, newest first
+ */
+export const auditListAuditEvents = <ThrowOnError extends boolean = true>(
+  options?: Options<AuditListAuditEventsData, ThrowOnError>,
+) =>
+  (options?.client ?? client).get<AuditListAuditEventsResponses, AuditListAuditEventsErrors, ThrowOnError>({
+    url: "/api/v1/audit-events",
+    ...options,
+  });
+
 /**
  * List blueprints
  *
@@ -415,6 +515,23 @@ export const canvasesCreateCanvas = <ThrowOnError extends boolean = true>(
     },
   });
 
+/**
+ * Import canvas
+ *
+ * Creates a canvas from a bundle, creating the blueprints it uses and remapping its integrations
+ */
+export const canvasesImportCanvas = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesImportCanvasData, ThrowOnError>,
+) =>
+  (options.client ?? client).post<CanvasesImportCanvasResponses, CanvasesImportCanvasErrors, ThrowOnError>({
+    url: "/api/v1/canvases/import",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
 /**
  * List canvas change requests
  *
@@ -611,6 +728,184 @@ export const canvasesListChildExecutions = <ThrowOnError extends boolean = true>
     },
   });
 
+/**
+ * Complete expression
+ *
+ * Returns completion candidates for an expression used in a node configuration, at the cursor position
+ */
+export const canvasesCompleteExpression = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesCompleteExpressionData, ThrowOnError>,
+) =>
+  (options.client ?? client).post<
+    CanvasesCompleteExpressionResponses,
+    CanvasesCompleteExpressionErrors,
+    ThrowOnError
+  >({
+    url: "/api/v1/canvases/{canvasId}/expressions/complete",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
+/**
+ * Validate expression
+ *
+ * Type-checks an expression used in a node configuration against the example payloads of its upstream nodes
+ */
+export const canvasesValidateExpression = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesValidateExpressionData, ThrowOnError>,
+) =>
+  (options.client ?? client).post<
+    CanvasesValidateExpressionResponses,
+    CanvasesValidateExpressionErrors,
+    ThrowOnError
+  >({
+    url: "/api/v1/canvases/{canvasId}/expressions/validate",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
+/**
+ * Describe canvas Git sync
+ *
+ * Returns the Git repository a canvas is synced from, and the status of the last sync
+ */
+export const canvasesDescribeCanvasGitSync = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesDescribeCanvasGitSyncData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<
+    CanvasesDescribeCanvasGitSyncResponses,
+    CanvasesDescribeCanvasGitSyncErrors,
+    ThrowOnError
+  >({ url: "/api/v1/canvases/{canvasId}/git-sync", ...options });
+
+/**
+ * Delete canvas Git sync
+ *
+ * Stops syncing a canvas from its Git repository
+ */
+export const canvasesDeleteCanvasGitSync = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesDeleteCanvasGitSyncData, ThrowOnError>,
+) =>
+  (options.client ?? client).delete<
+    CanvasesDeleteCanvasGitSyncResponses,
+    CanvasesDeleteCanvasGitSyncErrors,
+    ThrowOnError
+  >({ url: "/api/v1/canvases/{canvasId}/git-sync", ...options });
+
+/**
+ * Update canvas Git sync
+ *
+ * Configures the Git repository, branch and file a canvas is synced from. Changes to the file are opened as change requests.
+ */
+export const canvasesUpdateCanvasGitSync = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesUpdateCanvasGitSyncData, ThrowOnError>,
+) =>
+  (options.client ?? client).put<
+    CanvasesUpdateCanvasGitSyncResponses,
+    CanvasesUpdateCanvasGitSyncErrors,
+    ThrowOnError
+  >({
+    url: "/api/v1/canvases/{canvasId}/git-sync",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
+/**
+ * List canvas groups
+ *
+ * Returns the organization groups with a role on a canvas
+ */
+export const canvasesListCanvasGroups = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesListCanvasGroupsData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<CanvasesListCanvasGroupsResponses, CanvasesListCanvasGroupsErrors, ThrowOnError>({
+    url: "/api/v1/canvases/{canvasId}/groups",
+    ...options,
+  });
+
+/**
+ * Remove canvas group
+ *
+ * Removes the role of an organization group on a canvas
+ */
+export const canvasesRemoveCanvasGroup = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesRemoveCanvasGroupData, ThrowOnError>,
+) =>
+  (options.client ?? client).delete<CanvasesRemoveCanvasGroupResponses, CanvasesRemoveCanvasGroupErrors, ThrowOnError>({
+    url: "/api/v1/canvases/{canvasId}/groups/{groupName}",
+    ...options,
+  });
+
+/**
+ * Update canvas group
+ *
+ * Grants a role on a canvas to all members of an organization group, replacing its previous canvas role
+ */
+export const canvasesUpdateCanvasGroup = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesUpdateCanvasGroupData, ThrowOnError>,
+) =>
+  (options.client ?? client).put<CanvasesUpdateCanvasGroupResponses, CanvasesUpdateCanvasGroupErrors, ThrowOnError>({
+    url: "/api/v1/canvases/{canvasId}/groups/{groupName}",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
+/**
+ * List canvas members
+ *
+ * Returns the users with a role on a canvas
+ */
+export const canvasesListCanvasMembers = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesListCanvasMembersData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<CanvasesListCanvasMembersResponses, CanvasesListCanvasMembersErrors, ThrowOnError>({
+    url: "/api/v1/canvases/{canvasId}/members",
+    ...options,
+  });
+
+/**
+ * Remove canvas member
+ *
+ * Removes the role of a user on a canvas. Organization roles are not affected.
+ */
+export const canvasesRemoveCanvasMember = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesRemoveCanvasMemberData, ThrowOnError>,
+) =>
+  (options.client ?? client).delete<
+    CanvasesRemoveCanvasMemberResponses,
+    CanvasesRemoveCanvasMemberErrors,
+    ThrowOnError
+  >({ url: "/api/v1/canvases/{canvasId}/members/{userId}", ...options });
+
+/**
+ * Update canvas member
+ *
+ * Grants a role on a canvas to a user of the organization, replacing their previous canvas role
+ */
+export const canvasesUpdateCanvasMember = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesUpdateCanvasMemberData, ThrowOnError>,
+) =>
+  (options.client ?? client).put<CanvasesUpdateCanvasMemberResponses, CanvasesUpdateCanvasMemberErrors, ThrowOnError>({
+    url: "/api/v1/canvases/{canvasId}/members/{userId}",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
 /**
  * List canvas memories
  *
@@ -624,6 +919,55 @@ export const canvasesListCanvasMemories = <ThrowOnError extends boolean = true>(
     ...options,
   });
 
+/**
+ * List canvas memory namespaces
+ *
+ * Returns the TTL, schema and indexed fields configured for memory namespaces in a canvas
+ */
+export const canvasesListCanvasMemoryNamespaces = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesListCanvasMemoryNamespacesData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<
+    CanvasesListCanvasMemoryNamespacesResponses,
+    CanvasesListCanvasMemoryNamespacesErrors,
+    ThrowOnError
+  >({ url: "/api/v1/canvases/{canvasId}/memory-namespaces", ...options });
+
+/**
+ * Delete canvas memory namespace
+ *
+ * Removes the configuration of a memory namespace in a canvas. Its records are kept.
+ */
+export const canvasesDeleteCanvasMemoryNamespace = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesDeleteCanvasMemoryNamespaceData, ThrowOnError>,
+) =>
+  (options.client ?? client).delete<
+    CanvasesDeleteCanvasMemoryNamespaceResponses,
+    CanvasesDeleteCanvasMemoryNamespaceErrors,
+    ThrowOnError
+  >({ url: "/api/v1/canvases/{canvasId}/memory-namespaces/{namespace}", ...options });
+
+/**
+ * Update canvas memory namespace
+ *
+ * Configures the TTL, schema and indexed fields of a memory namespace in a canvas
+ */
+export const canvasesUpdateCanvasMemoryNamespace = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesUpdateCanvasMemoryNamespaceData, ThrowOnError>,
+) =>
+  (options.client ?? client).put<
+    CanvasesUpdateCanvasMemoryNamespaceResponses,
+    CanvasesUpdateCanvasMemoryNamespaceErrors,
+    ThrowOnError
+  >({
+    url: "/api/v1/canvases/{canvasId}/memory-namespaces/{namespace}",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
 /**
  * Delete canvas memory entry
  *
@@ -834,6 +1178,40 @@ export const canvasesUpdateCanvasVersion = <ThrowOnError extends boolean = true>
     },
   );
 
+/**
+ * Diff canvas versions
+ *
+ * Compares the nodes, edges and variables of a canvas version with another version, or with the live version if no base version is given
+ */
+export const canvasesDiffCanvasVersions = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesDiffCanvasVersionsData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<CanvasesDiffCanvasVersionsResponses, CanvasesDiffCanvasVersionsErrors, ThrowOnError>({
+    url: "/api/v1/canvases/{canvasId}/versions/{versionId}/diff",
+    ...options,
+  });
+
+/**
+ * Rollback canvas version
+ *
+ * Publishes a new live version of a canvas with the nodes, edges and variables of an older published version
+ */
+export const canvasesRollbackCanvasVersion = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesRollbackCanvasVersionData, ThrowOnError>,
+) =>
+  (options.client ?? client).post<
+    CanvasesRollbackCanvasVersionResponses,
+    CanvasesRollbackCanvasVersionErrors,
+    ThrowOnError
+  >({
+    url: "/api/v1/canvases/{canvasId}/versions/{versionId}/rollback",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
 /**
  * Delete canvas
  *
@@ -877,6 +1255,19 @@ export const canvasesUpdateCanvas = <ThrowOnError extends boolean = true>(
     },
   });
 
+/**
+ * Export canvas
+ *
+ * Returns a portable bundle of the live version of a canvas, which can be imported in another organization
+ */
+export const canvasesExportCanvas = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesExportCanvasData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<CanvasesExportCanvasResponses, CanvasesExportCanvasErrors, ThrowOnError>({
+    url: "/api/v1/canvases/{id}/export",
+    ...options,
+  });
+
 /**
  * List components
  *
@@ -1399,6 +1790,116 @@ export const organizationsResetInviteLink = <ThrowOnError extends boolean = true
     ThrowOnError
   >({ url: "/api/v1/organizations/{id}/invite-link/reset", ...options });
 
+/**
+ * List organization memory namespaces
+ *
+ * Returns the memory namespaces shared by the canvases of an organization
+ */
+export const organizationsListMemoryNamespaces = <ThrowOnError extends boolean = true>(
+  options: Options<OrganizationsListMemoryNamespacesData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<
+    OrganizationsListMemoryNamespacesResponses,
+    OrganizationsListMemoryNamespacesErrors,
+    ThrowOnError
+  >({ url: "/api/v1/organizations/{id}/memory-namespaces", ...options });
+
+/**
+ * Delete an organization memory namespace
+ *
+ * Deletes an organization memory namespace and all its records
+ */
+export const organizationsDeleteMemoryNamespace = <ThrowOnError extends boolean = true>(
+  options: Options<OrganizationsDeleteMemoryNamespaceData, ThrowOnError>,
+) =>
+  (options.client ?? client).delete<
+    OrganizationsDeleteMemoryNamespaceResponses,
+    OrganizationsDeleteMemoryNamespaceErrors,
+    ThrowOnError
+  >({ url: "/api/v1/organizations/{id}/memory-namespaces/{namespace}", ...options });
+
+/**
+ * Create or update an organization memory namespace
+ *
+ * Creates or replaces an organization memory namespace and the access canvases have to it
+ */
+export const organizationsUpdateMemoryNamespace = <ThrowOnError extends boolean = true>(
+  options: Options<OrganizationsUpdateMemoryNamespaceData, ThrowOnError>,
+) =>
+  (options.client ?? client).put<
+    OrganizationsUpdateMemoryNamespaceResponses,
+    OrganizationsUpdateMemoryNamespaceErrors,
+    ThrowOnError
+  >({
+    url: "/api/v1/organizations/{id}/memory-namespaces/{namespace}",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
+/**
+ * List organization memory records
+ *
+ * Returns the records of an organization memory namespace
+ */
+export const organizationsListMemories = <ThrowOnError extends boolean = true>(
+  options: Options<OrganizationsListMemoriesData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<OrganizationsListMemoriesResponses, OrganizationsListMemoriesErrors, ThrowOnError>({
+    url: "/api/v1/organizations/{id}/memory-namespaces/{namespace}/memories",
+    ...options,
+  });
+
+/**
+ * Delete an organization memory record
+ *
+ * Deletes a record from an organization memory namespace
+ */
+export const organizationsDeleteMemory = <ThrowOnError extends boolean = true>(
+  options: Options<OrganizationsDeleteMemoryData, ThrowOnError>,
+) =>
+  (options.client ?? client).delete<OrganizationsDeleteMemoryResponses, OrganizationsDeleteMemoryErrors, ThrowOnError>({
+    url: "/api/v1/organizations/{id}/memory-namespaces/{namespace}/memories/{memoryId}",
+    ...options,
+  });
+
+/**
+ * Get organization SSO settings
+ *
+ * Returns the single sign-on configuration of an organization
+ */
+export const organizationsGetSsoSettings = <ThrowOnError extends boolean = true>(
+  options: Options<OrganizationsGetSsoSettingsData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<
+    OrganizationsGetSsoSettingsResponses,
+    OrganizationsGetSsoSettingsErrors,
+    ThrowOnError
+  >({ url: "/api/v1/organizations/{id}/sso-settings", ...options });
+
+/**
+ * Update organization SSO settings
+ *
+ * Configures single sign-on with a SAML 2.0 or OIDC identity provider for an organization
+ */
+export const organizationsUpdateSsoSettings = <ThrowOnError extends boolean = true>(
+  options: Options<OrganizationsUpdateSsoSettingsData, ThrowOnError>,
+) =>
+  (options.client ?? client).put<
+    OrganizationsUpdateSsoSettingsResponses,
+    OrganizationsUpdateSsoSettingsErrors,
+    ThrowOnError
+  >({
+    url: "/api/v1/organizations/{id}/sso-settings",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
 /**
  * Remove a user from an organization
  *
@@ -1622,6 +2123,53 @@ export const secretsUpdateSecretName = <ThrowOnError extends boolean = true>(
     },
   });
 
+/**
+ * Roll back a secret
+ *
+ * Activates a previous version of the secret.
+ */
+export const secretsRollbackSecret = <ThrowOnError extends boolean = true>(
+  options: Options<SecretsRollbackSecretData, ThrowOnError>,
+) =>
+  (options.client ?? client).post<SecretsRollbackSecretResponses, SecretsRollbackSecretErrors, ThrowOnError>({
+    url: "/api/v1/secrets/{idOrName}/rollback",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
+/**
+ * Rotate a secret
+ *
+ * Stores new values for a local secret as a new version and activates it. Previous versions are kept for rollback.
+ */
+export const secretsRotateSecret = <ThrowOnError extends boolean = true>(
+  options: Options<SecretsRotateSecretData, ThrowOnError>,
+) =>
+  (options.client ?? client).post<SecretsRotateSecretResponses, SecretsRotateSecretErrors, ThrowOnError>({
+    url: "/api/v1/secrets/{idOrName}/rotate",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
+/**
+ * List secret versions
+ *
+ * Returns the versions of a secret, newest first. Values are not included.
+ */
+export const secretsListSecretVersions = <ThrowOnError extends boolean = true>(
+  options: Options<SecretsListSecretVersionsData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<SecretsListSecretVersionsResponses, SecretsListSecretVersionsErrors, ThrowOnError>({
+    url: "/api/v1/secrets/{idOrName}/versions",
+    ...options,
+  });
+
 /**
  * List service accounts
  *
@@ -12,10 +12,65 @@ export type ActOnCanvasChangeRequestRequestAction =
   | "ACTION_PUBLISH"
   | "ACTION_UNAPPROVE";
 
+export type AuditAuditEvent = {
+  id?: string;
+  actor?: AuditEventActor;
+  resource?: AuditEventResource;
+  /**
+   * What was done to the resource, like create, update or delete.
+   * Execution actions use the name of the action, like approve.
+   */
+  action?: string;
+  method?: string;
+  domainType?: AuthorizationDomainType;
+  domainId?: string;
+  /**
+   * gRPC status of the call, or PermissionDenied if the caller was not allowed to make it.
+   */
+  status?: string;
+  /**
+   * Summaries of the request and response, with sensitive values redacted.
+   */
+  request?: {
+    [key: string]: unknown;
+  };
+  response?: {
+    [key: string]: unknown;
+  };
+  createdAt?: string;
+  /**
+   * Summary of the resource before the call, for updates and deletions,
+   * with sensitive values redacted.
+   */
+  before?: {
+    [key: string]: unknown;
+  };
+};
+
+export type AuditEventActor = {
+  id?: string;
+  type?: string;
+  name?: string;
+  email?: string;
+};
+
+export type AuditEventResource = {
+  type?: string;
+  id?: string;
+  name?: string;
+};
+
+export type AuditListAuditEventsResponse = {
+  events?: Array<AuditAuditEvent>;
+  totalCount?: number;
+  hasNextPage?: boolean;
+  lastTimestamp?: string;
+};
+
 /**
  * Enums
  */
-export type AuthorizationDomainType = "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION";
+export type AuthorizationDomainType = "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION" | "DOMAIN_TYPE_CANVAS";
 
 /**
  * Common data structures
@@ -74,6 +129,21 @@ export type CanvasAutoLayoutAlgorithm = "ALGORITHM_UNSPECIFIED" | "ALGORITHM_HOR
 
 export type CanvasAutoLayoutScope = "SCOPE_UNSPECIFIED" | "SCOPE_FULL_CANVAS" | "SCOPE_CONNECTED_COMPONENT";
 
+export type CanvasBundleIntegrationReference = {
+  name?: string;
+  type?: string;
+};
+
+export type CanvasBundleSecretReference = {
+  name?: string;
+  keys?: Array<string>;
+};
+
+export type CanvasChangeRequestCheckProblem = {
+  nodeId?: string;
+  message?: string;
+};
+
 export type CanvasNodeExecutionResult = "RESULT_UNKNOWN" | "RESULT_PASSED" | "RESULT_FAILED" | "RESULT_CANCELLED";
 
 export type CanvasNodeExecutionResultReason =
@@ -81,6 +151,53 @@ export type CanvasNodeExecutionResultReason =
   | "RESULT_REASON_ERROR"
   | "RESULT_REASON_ERROR_RESOLVED";
 
+export type CanvasVariableOverride = {
+  environment?: string;
+  value?: string;
+  secret?: CanvasVariableSecretRef;
+};
+
+export type CanvasVariableSecretRef = {
+  secret?: string;
+  key?: string;
+};
+
+export type CanvasVersionDiffChangeType =
+  | "CHANGE_TYPE_UNSPECIFIED"
+  | "CHANGE_TYPE_ADDED"
+  | "CHANGE_TYPE_REMOVED"
+  | "CHANGE_TYPE_MODIFIED";
+
+export type CanvasVersionDiffEdgeChange = {
+  type?: CanvasVersionDiffChangeType;
+  sourceId?: string;
+  targetId?: string;
+  channel?: string;
+};
+
+/**
+ * Values are JSON encoded, and empty
+ * when the field is not set on that side.
+ */
+export type CanvasVersionDiffFieldChange = {
+  path?: string;
+  before?: string;
+  after?: string;
+};
+
+export type CanvasVersionDiffNodeChange = {
+  nodeId?: string;
+  nodeName?: string;
+  type?: CanvasVersionDiffChangeType;
+  fields?: Array<CanvasVersionDiffFieldChange>;
+};
+
+export type CanvasVersionDiffVariableChange = {
+  name?: string;
+  type?: CanvasVersionDiffChangeType;
+  fields?: Array<CanvasVersionDiffFieldChange>;
+};
+
 export type CanvasesActOnCanvasChangeRequestBody = {
   action?: ActOnCanvasChangeRequestRequestAction;
 };
@@ -109,11 +226,31 @@ export type CanvasesCanvasAutoLayout = {
   scope?: CanvasAutoLayoutScope;
 };
 
+/**
+ * A canvas bundle describes a canvas in a way that does not depend on
+ * the organization it was exported from. Blueprints used by the canvas are included,
+ * and nodes reference them by the IDs in the bundle. Integrations and secrets
+ * are referenced by name, and secret values are never included.
+ */
+export type CanvasesCanvasBundle = {
+  metadata?: CanvasesCanvasBundleMetadata;
+  spec?: CanvasesCanvasSpec;
+  blueprints?: Array<BlueprintsBlueprint>;
+  integrations?: Array<CanvasBundleIntegrationReference>;
+  secrets?: Array<CanvasBundleSecretReference>;
+};
+
+export type CanvasesCanvasBundleMetadata = {
+  name?: string;
+  description?: string;
+};
+
 export type CanvasesCanvasChangeRequest = {
   metadata?: CanvasesCanvasChangeRequestMetadata;
   version?: CanvasesCanvasVersion;
   diff?: CanvasesCanvasChangeRequestDiff;
   approvals?: Array<CanvasesCanvasChangeRequestApproval>;
+  checks?: Array<CanvasesCanvasChangeRequestCheck>;
 };
 
 export type CanvasesCanvasChangeRequestApproval = {
@@ -142,6 +279,36 @@ export type CanvasesCanvasChangeRequestApprover = {
 
 export type CanvasesCanvasChangeRequestApproverType = "TYPE_UNSPECIFIED" | "TYPE_ANYONE" | "TYPE_USER" | "TYPE_ROLE";
 
+/**
+ * Result of an automated check run on a change request.
+ * Change requests can only be published when all checks passed.
+ */
+export type CanvasesCanvasChangeRequestCheck = {
+  name?: string;
+  status?: CanvasesCanvasChangeRequestCheckStatus;
+  problems?: Array<CanvasChangeRequestCheckProblem>;
+  checkedAt?: string;
+};
+
+/**
+ * Configures the optional checks run on change requests.
+ * Configuration and expression checks always run.
+ */
+export type CanvasesCanvasChangeRequestCheckConfig = {
+  /**
+   * Components and triggers that cannot be added to the canvas.
+   * Patterns like "github.*" are supported.
+   */
+  forbiddenComponents?: Array<string>;
+  /**
+   * Simulates the flow of events from the triggers of the canvas,
+   * failing on nodes that cannot be reached and on unknown output channels.
+   */
+  simulationEnabled?: boolean;
+};
+
+export type CanvasesCanvasChangeRequestCheckStatus = "STATUS_UNSPECIFIED" | "STATUS_PASSED" | "STATUS_FAILED";
+
 export type CanvasesCanvasChangeRequestDiff = {
   changedNodeIds?: Array<string>;
   conflictingNodeIds?: Array<string>;
@@ -193,12 +360,82 @@ export type CanvasesCanvasEventWithExecutions = {
   customName?: string;
 };
 
+/**
+ * The canvas file is read through the integration, if integration_id is set.
+ * Otherwise, repository is a Git URL, cloned directly.
+ */
+export type CanvasesCanvasGitSync = {
+  canvasId?: string;
+  integrationId?: string;
+  repository?: string;
+  branch?: string;
+  path?: string;
+  enabled?: boolean;
+  status?: CanvasesCanvasGitSyncStatus;
+};
+
+export type CanvasesCanvasGitSyncStatus = {
+  lastSyncedAt?: string;
+  contentDigest?: string;
+  lastChangeRequestId?: string;
+  /**
+   * The live canvas was changed outside of the repository,
+   * and no longer matches the file.
+   */
+  driftDetected?: boolean;
+  lastError?: string;
+};
+
+/**
+ * An organization group with a role on a canvas.
+ * All members of the group get the role on the canvas.
+ */
+export type CanvasesCanvasGroup = {
+  groupName?: string;
+  displayName?: string;
+  role?: string;
+};
+
+/**
+ * A user with a role on a canvas.
+ * Canvas roles are granted in addition to organization roles:
+ * canvas_viewer, canvas_operator, canvas_editor and canvas_owner.
+ */
+export type CanvasesCanvasMember = {
+  userId?: string;
+  userName?: string;
+  userEmail?: string;
+  role?: string;
+};
+
 export type CanvasesCanvasMemory = {
   id?: string;
   namespace?: string;
   values?: unknown;
 };
 
+/**
+ * Memory namespaces without configuration keep records forever and accept any values.
+ * Records older than ttl_seconds are removed, and if fields are declared,
+ * new records are validated against them. Matches on indexed fields are
+ * served by an index, which keeps lookups fast in large namespaces.
+ */
+export type CanvasesCanvasMemoryNamespace = {
+  namespace?: string;
+  ttlSeconds?: number;
+  fields?: Array<CanvasesCanvasMemoryNamespaceField>;
+  indexedFields?: Array<string>;
+};
+
+export type CanvasesCanvasMemoryNamespaceField = {
+  name?: string;
+  /**
+   * One of string, number, boolean, object or list.
+   */
+  type?: string;
+  required?: boolean;
+};
+
 export type CanvasesCanvasMetadata = {
   id?: string;
   organizationId?: string;
@@ -210,6 +447,33 @@ export type CanvasesCanvasMetadata = {
   isTemplate?: boolean;
   versioningEnabled?: boolean;
   changeRequestApprovalConfig?: CanvasesCanvasChangeRequestApprovalConfig;
+  /**
+   * Environment the canvas runs in, like staging or production.
+   * Variable overrides for this environment are applied.
+   */
+  environment?: string;
+  changeRequestCheckConfig?: CanvasesCanvasChangeRequestCheckConfig;
+  nodeActionPermissionsConfig?: CanvasesCanvasNodeActionPermissionsConfig;
+};
+
+/**
+ * Restricts who can invoke actions, cancel executions and emit events on a node.
+ * Users need to be listed directly, belong to one of the groups
+ * or have one of the organization roles.
+ */
+export type CanvasesCanvasNodeActionPermissions = {
+  nodeId?: string;
+  userIds?: Array<string>;
+  groups?: Array<string>;
+  roles?: Array<string>;
+};
+
+/**
+ * Nodes without permissions are open to everyone
+ * with permission to run actions on the canvas.
+ */
+export type CanvasesCanvasNodeActionPermissionsConfig = {
+  items?: Array<CanvasesCanvasNodeActionPermissions>;
 };
 
 export type CanvasesCanvasNodeExecution = {
@@ -257,6 +521,7 @@ export type CanvasesCanvasNodeQueueItem = {
 export type CanvasesCanvasSpec = {
   nodes?: Array<ComponentsNode>;
   edges?: Array<ComponentsEdge>;
+  variables?: Array<CanvasesCanvasVariable>;
 };
 
 export type CanvasesCanvasStatus = {
@@ -265,11 +530,32 @@ export type CanvasesCanvasStatus = {
   lastEvents?: Array<CanvasesCanvasEvent>;
 };
 
+/**
+ * Variables are available in expressions as vars.<name>.
+ * Secret-backed variables resolve to a reference to the secret key,
+ * to be used in secret fields, and never to the secret value.
+ */
+export type CanvasesCanvasVariable = {
+  name?: string;
+  description?: string;
+  value?: string;
+  secret?: CanvasVariableSecretRef;
+  overrides?: Array<CanvasVariableOverride>;
+};
+
 export type CanvasesCanvasVersion = {
   metadata?: CanvasesCanvasVersionMetadata;
   spec?: CanvasesCanvasSpec;
 };
 
+export type CanvasesCanvasVersionDiff = {
+  baseVersionId?: string;
+  targetVersionId?: string;
+  nodes?: Array<CanvasVersionDiffNodeChange>;
+  edges?: Array<CanvasVersionDiffEdgeChange>;
+  variables?: Array<CanvasVersionDiffVariableChange>;
+};
+
 export type CanvasesCanvasVersionMetadata = {
   id?: string;
   canvasId?: string;
@@ -280,6 +566,21 @@ export type CanvasesCanvasVersionMetadata = {
   updatedAt?: string;
 };
 
+export type CanvasesCompleteExpressionBody = {
+  nodeId?: string;
+  expression?: string;
+  /**
+   * Character offset of the cursor in the expression.
+   * Defaults to the end of the expression.
+   */
+  cursor?: number;
+  versionId?: string;
+};
+
+export type CanvasesCompleteExpressionResponse = {
+  completions?: Array<CanvasesExpressionCompletion>;
+};
+
 export type CanvasesCreateCanvasChangeRequestBody = {
   versionId?: string;
   title?: string;
@@ -307,6 +608,14 @@ export type CanvasesCreateCanvasVersionResponse = {
   version?: CanvasesCanvasVersion;
 };
 
+export type CanvasesDeleteCanvasGitSyncResponse = {
+  [key: string]: unknown;
+};
+
+export type CanvasesDeleteCanvasMemoryNamespaceResponse = {
+  [key: string]: unknown;
+};
+
 export type CanvasesDeleteCanvasMemoryResponse = {
   [key: string]: unknown;
 };
@@ -323,6 +632,10 @@ export type CanvasesDescribeCanvasChangeRequestResponse = {
   changeRequest?: CanvasesCanvasChangeRequest;
 };
 
+export type CanvasesDescribeCanvasGitSyncResponse = {
+  sync?: CanvasesCanvasGitSync;
+};
+
 export type CanvasesDescribeCanvasResponse = {
   canvas?: CanvasesCanvas;
 };
@@ -331,6 +644,10 @@ export type CanvasesDescribeCanvasVersionResponse = {
   version?: CanvasesCanvasVersion;
 };
 
+export type CanvasesDiffCanvasVersionsResponse = {
+  diff?: CanvasesCanvasVersionDiff;
+};
+
 export type CanvasesEmitNodeEventBody = {
   channel?: string;
   data?: {
@@ -342,6 +659,52 @@ export type CanvasesEmitNodeEventResponse = {
   eventId?: string;
 };
 
+export type CanvasesExportCanvasResponse = {
+  bundle?: CanvasesCanvasBundle;
+};
+
+export type CanvasesExpressionCompletion = {
+  label?: string;
+  kind?: ExpressionCompletionKind;
+  detail?: string;
+  /**
+   * Text to insert, replacing the `replace` characters before the cursor.
+   */
+  insertText?: string;
+  replace?: number;
+};
+
+export type CanvasesExpressionDiagnostic = {
+  severity?: ExpressionDiagnosticSeverity;
+  message?: string;
+  /**
+   * Character offsets of the problem in the expression.
+   */
+  from?: number;
+  to?: number;
+};
+
+export type CanvasesImportCanvasRequest = {
+  bundle?: CanvasesCanvasBundle;
+  /**
+   * Name for the new canvas. Defaults to the name in the bundle.
+   */
+  name?: string;
+  /**
+   * Integrations of the organization to use in place of the ones in the bundle.
+   * Integrations not mapped are matched by name.
+   */
+  integrationMappings?: Array<ImportCanvasRequestIntegrationMapping>;
+};
+
+export type CanvasesImportCanvasResponse = {
+  canvas?: CanvasesCanvas;
+  /**
+   * Secrets referenced by the canvas that do not exist in the organization.
+   */
+  missingSecrets?: Array<string>;
+};
+
 export type CanvasesInvokeNodeExecutionActionBody = {
   parameters?: {
     [key: string]: unknown;
@@ -378,10 +741,22 @@ export type CanvasesListCanvasEventsResponse = {
   lastTimestamp?: string;
 };
 
+export type CanvasesListCanvasGroupsResponse = {
+  groups?: Array<CanvasesCanvasGroup>;
+};
+
+export type CanvasesListCanvasMembersResponse = {
+  members?: Array<CanvasesCanvasMember>;
+};
+
 export type CanvasesListCanvasMemoriesResponse = {
   items?: Array<CanvasesCanvasMemory>;
 };
 
+export type CanvasesListCanvasMemoryNamespacesResponse = {
+  namespaces?: Array<CanvasesCanvasMemoryNamespace>;
+};
+
 export type CanvasesListCanvasVersionsResponse = {
   versions?: Array<CanvasesCanvasVersion>;
   totalCount?: number;
@@ -426,6 +801,14 @@ export type CanvasesListNodeQueueItemsResponse = {
   lastTimestamp?: string;
 };
 
+export type CanvasesRemoveCanvasGroupResponse = {
+  [key: string]: unknown;
+};
+
+export type CanvasesRemoveCanvasMemberResponse = {
+  [key: string]: unknown;
+};
+
 export type CanvasesResolveCanvasChangeRequestBody = {
   canvas?: CanvasesCanvas;
   autoLayout?: CanvasesCanvasAutoLayout;
@@ -444,11 +827,60 @@ export type CanvasesResolveExecutionErrorsResponse = {
   [key: string]: unknown;
 };
 
+export type CanvasesRollbackCanvasVersionBody = {
+  [key: string]: unknown;
+};
+
+export type CanvasesRollbackCanvasVersionResponse = {
+  version?: CanvasesCanvasVersion;
+};
+
 export type CanvasesUpdateCanvasBody = {
   name?: string;
   description?: string;
   versioningEnabled?: boolean;
   changeRequestApprovalConfig?: CanvasesCanvasChangeRequestApprovalConfig;
+  environment?: string;
+  changeRequestCheckConfig?: CanvasesCanvasChangeRequestCheckConfig;
+  nodeActionPermissionsConfig?: CanvasesCanvasNodeActionPermissionsConfig;
+};
+
+export type CanvasesUpdateCanvasGitSyncBody = {
+  integrationId?: string;
+  repository?: string;
+  branch?: string;
+  path?: string;
+  enabled?: boolean;
+};
+
+export type CanvasesUpdateCanvasGitSyncResponse = {
+  sync?: CanvasesCanvasGitSync;
+};
+
+export type CanvasesUpdateCanvasGroupBody = {
+  role?: string;
+};
+
+export type CanvasesUpdateCanvasGroupResponse = {
+  group?: CanvasesCanvasGroup;
+};
+
+export type CanvasesUpdateCanvasMemberBody = {
+  role?: string;
+};
+
+export type CanvasesUpdateCanvasMemberResponse = {
+  member?: CanvasesCanvasMember;
+};
+
+export type CanvasesUpdateCanvasMemoryNamespaceBody = {
+  ttlSeconds?: number;
+  fields?: Array<CanvasesCanvasMemoryNamespaceField>;
+  indexedFields?: Array<string>;
+};
+
+export type CanvasesUpdateCanvasMemoryNamespaceResponse = {
+  namespace?: CanvasesCanvasMemoryNamespace;
 };
 
 export type CanvasesUpdateCanvasResponse = {
@@ -473,6 +905,23 @@ export type CanvasesUpdateNodePauseResponse = {
   node?: ComponentsNode;
 };
 
+/**
+ * Expressions are validated against the live canvas,
+ * or against a version, if version_id is set.
+ * The expression can be an expression field value,
+ * or a text with template expressions between double braces.
+ */
+export type CanvasesValidateExpressionBody = {
+  nodeId?: string;
+  expression?: string;
+  versionId?: string;
+};
+
+export type CanvasesValidateExpressionResponse = {
+  valid?: boolean;
+  diagnostics?: Array<CanvasesExpressionDiagnostic>;
+};
+
 export type ComponentsComponent = {
   name?: string;
   label?: string;
@@ -666,6 +1115,15 @@ export type ConfigurationVisibilityCondition = {
   values?: Array<string>;
 };
 
+export type ExpressionCompletionKind =
+  | "KIND_UNSPECIFIED"
+  | "KIND_FUNCTION"
+  | "KIND_VARIABLE"
+  | "KIND_NODE"
+  | "KIND_FIELD";
+
+export type ExpressionDiagnosticSeverity = "SEVERITY_UNSPECIFIED" | "SEVERITY_ERROR" | "SEVERITY_WARNING";
+
 export type GroupsAddUserToGroupBody = {
   domainType?: AuthorizationDomainType;
   domainId?: string;
@@ -749,6 +1207,11 @@ export type GroupsUpdateGroupResponse = {
   group?: GroupsGroup;
 };
 
+export type ImportCanvasRequestIntegrationMapping = {
+  from?: string;
+  to?: string;
+};
+
 export type IntegrationNodeRef = {
   canvasId?: string;
   canvasName?: string;
@@ -771,6 +1234,13 @@ export type MeRegenerateTokenResponse = {
   token?: string;
 };
 
+export type MemoryNamespaceAccess = "ACCESS_NONE" | "ACCESS_READ" | "ACCESS_WRITE";
+
+export type MemoryNamespaceCanvasAccess = {
+  canvasId?: string;
+  access?: MemoryNamespaceAccess;
+};
+
 export type NodeBlueprintRef = {
   id?: string;
 };
@@ -841,6 +1311,14 @@ export type OrganizationsDeleteIntegrationResponse = {
   [key: string]: unknown;
 };
 
+export type OrganizationsDeleteMemoryNamespaceResponse = {
+  [key: string]: unknown;
+};
+
+export type OrganizationsDeleteMemoryResponse = {
+  [key: string]: unknown;
+};
+
 export type OrganizationsDeleteOrganizationResponse = {
   [key: string]: unknown;
 };
@@ -861,6 +1339,10 @@ export type OrganizationsGetInviteLinkResponse = {
   inviteLink?: OrganizationsInviteLink;
 };
 
+export type OrganizationsGetSsoSettingsResponse = {
+  ssoSettings?: OrganizationsSsoSettings;
+};
+
 export type OrganizationsIntegration = {
   metadata?: OrganizationsIntegrationMetadata;
   spec?: OrganizationsIntegrationSpec;
@@ -922,8 +1404,38 @@ export type OrganizationsListInvitationsResponse = {
   invitations?: Array<OrganizationsInvitation>;
 };
 
-export type OrganizationsOrganization = {
-  metadata?: OrganizationsOrganizationMetadata;
+export type OrganizationsListMemoriesResponse = {
+  items?: Array<OrganizationsMemory>;
+};
+
+export type OrganizationsListMemoryNamespacesResponse = {
+  namespaces?: Array<OrganizationsMemoryNamespace>;
+};
+
+export type OrganizationsMemory = {
+  id?: string;
+  namespace?: string;
+  values?: unknown;
+  createdAt?: string;
+  updatedAt?: string;
+};
+
+/**
+ * Memory namespaces shared by the canvases of an organization.
+ * Canvases use them with the org/ prefix in memory components,
+ * and only with the access granted to them, or the default access.
+ */
+export type OrganizationsMemoryNamespace = {
+  namespace?: string;
+  description?: string;
+  defaultAccess?: MemoryNamespaceAccess;
+  canvasAccess?: Array<MemoryNamespaceCanvasAccess>;
+  createdAt?: string;
+  updatedAt?: string;
+};
+
+export type OrganizationsOrganization = {
+  metadata?: OrganizationsOrganizationMetadata;
 };
 
 export type OrganizationsOrganizationMetadata = {
@@ -956,6 +1468,51 @@ export type OrganizationsSetAgentOpenAiKeyResponse = {
   agentSettings?: OrganizationsAgentSettings;
 };
 
+export type OrganizationsSsoDomain = {
+  domain?: string;
+  verified?: boolean;
+  /**
+   * The DNS TXT record, and its value, that verifies the domain.
+   */
+  verificationRecord?: string;
+  verificationValue?: string;
+  verifiedAt?: string;
+};
+
+export type OrganizationsSsoSettings = {
+  organizationId?: string;
+  protocol?: string;
+  enabled?: boolean;
+  enforced?: boolean;
+  jitProvisioning?: boolean;
+  oidc?: OrganizationsSsooidcSettings;
+  saml?: OrganizationsSsosamlSettings;
+  loginUrl?: string;
+  updatedAt?: string;
+  updatedBy?: string;
+  domains?: Array<OrganizationsSsoDomain>;
+};
+
+export type OrganizationsSsooidcSettings = {
+  issuerUrl?: string;
+  clientId?: string;
+  /**
+   * Only used when updating the settings.
+   * An empty value keeps the current client secret.
+   */
+  clientSecret?: string;
+  clientSecretConfigured?: boolean;
+  redirectUrl?: string;
+};
+
+export type OrganizationsSsosamlSettings = {
+  idpMetadataUrl?: string;
+  idpMetadata?: string;
+  entityId?: string;
+  acsUrl?: string;
+  metadataUrl?: string;
+};
+
 export type OrganizationsUpdateAgentSettingsBody = {
   agentModeEnabled?: boolean;
 };
@@ -983,6 +1540,16 @@ export type OrganizationsUpdateInviteLinkResponse = {
   inviteLink?: OrganizationsInviteLink;
 };
 
+export type OrganizationsUpdateMemoryNamespaceBody = {
+  description?: string;
+  defaultAccess?: MemoryNamespaceAccess;
+  canvasAccess?: Array<MemoryNamespaceCanvasAccess>;
+};
+
+export type OrganizationsUpdateMemoryNamespaceResponse = {
+  namespace?: OrganizationsMemoryNamespace;
+};
+
 export type OrganizationsUpdateOrganizationBody = {
   organization?: OrganizationsOrganization;
 };
@@ -991,6 +1558,24 @@ export type OrganizationsUpdateOrganizationResponse = {
   organization?: OrganizationsOrganization;
 };
 
+export type OrganizationsUpdateSsoSettingsBody = {
+  protocol?: string;
+  enabled?: boolean;
+  enforced?: boolean;
+  jitProvisioning?: boolean;
+  oidc?: OrganizationsSsooidcSettings;
+  saml?: OrganizationsSsosamlSettings;
+  /**
+   * Email domains of the organization. Unverified domains are verified
+   * when the settings are updated, if their DNS TXT record exists.
+   */
+  domains?: Array<string>;
+};
+
+export type OrganizationsUpdateSsoSettingsResponse = {
+  ssoSettings?: OrganizationsSsoSettings;
+};
+
 export type RolesAssignRoleBody = {
   domainType?: AuthorizationDomainType;
   domainId?: string;
@@ -1057,13 +1642,45 @@ export type RolesUpdateRoleResponse = {
 /**
  * Local secrets are stored and managed by SuperPlane itself.
  */
+/**
+ * Env secrets are read from environment variables of the SuperPlane process.
+ * Variables map each key of the secret to the variable holding its value.
+ */
+export type SecretEnv = {
+  variables?: {
+    [key: string]: string;
+  };
+};
+
+/**
+ * File secrets are read from a directory below the secrets directory
+ * configured for this installation. Each file in it is a key of the secret.
+ */
+export type SecretFile = {
+  path?: string;
+};
+
 export type SecretLocal = {
   data?: {
     [key: string]: string;
   };
 };
 
-export type SecretProvider = "PROVIDER_UNKNOWN" | "PROVIDER_LOCAL";
+export type SecretProvider =
+  | "PROVIDER_UNKNOWN"
+  | "PROVIDER_LOCAL"
+  | "PROVIDER_VAULT"
+  | "PROVIDER_FILE"
+  | "PROVIDER_ENV";
+
+/**
+ * Vault secrets are read from the KV v2 engine of the Vault server
+ * configured for this installation. Values are never stored by SuperPlane.
+ */
+export type SecretVault = {
+  mount?: string;
+  path?: string;
+};
 
 export type SecretsCreateSecretRequest = {
   secret?: SecretsSecret;
@@ -1087,10 +1704,36 @@ export type SecretsDescribeSecretResponse = {
   secret?: SecretsSecret;
 };
 
+export type SecretsListSecretVersionsResponse = {
+  versions?: Array<SecretsSecretVersion>;
+};
+
 export type SecretsListSecretsResponse = {
   secrets?: Array<SecretsSecret>;
 };
 
+export type SecretsRollbackSecretBody = {
+  version?: number;
+  domainType?: AuthorizationDomainType;
+  domainId?: string;
+};
+
+export type SecretsRollbackSecretResponse = {
+  secret?: SecretsSecret;
+};
+
+export type SecretsRotateSecretBody = {
+  data?: {
+    [key: string]: string;
+  };
+  domainType?: AuthorizationDomainType;
+  domainId?: string;
+};
+
+export type SecretsRotateSecretResponse = {
+  secret?: SecretsSecret;
+};
+
 export type SecretsSecret = {
   metadata?: SecretsSecretMetadata;
   spec?: SecretsSecretSpec;
@@ -1102,11 +1745,34 @@ export type SecretsSecretMetadata = {
   domainType?: AuthorizationDomainType;
   domainId?: string;
   createdAt?: string;
+  activeVersion?: number;
 };
 
 export type SecretsSecretSpec = {
   provider?: SecretProvider;
   local?: SecretLocal;
+  vault?: SecretVault;
+  file?: SecretFile;
+  env?: SecretEnv;
+  /**
+   * Canvases that can read an organization secret.
+   * If empty, all canvases of the organization can read it.
+   * Canvas secrets are only available to their canvas.
+   */
+  allowedCanvasIds?: Array<string>;
+};
+
+/**
+ * A version holds the values a secret had at some point.
+ * Rotations and updates create a new version, and rollbacks activate an older one.
+ */
+export type SecretsSecretVersion = {
+  version?: number;
+  reason?: string;
+  createdBy?: string;
+  createdAt?: string;
+  activatedAt?: string;
+  active?: boolean;
 };
 
 export type SecretsSetSecretKeyBody = {
@@ -1123,6 +1789,11 @@ export type SecretsUpdateSecretBody = {
   secret?: SecretsSecret;
   domainType?: AuthorizationDomainType;
   domainId?: string;
+  /**
+   * The canvases allowed to use the secret are only replaced when this is set,
+   * so clients updating the secret values keep them.
+   */
+  updateAllowedCanvasIds?: boolean;
 };
 
 export type SecretsUpdateSecretNameBody = {
@@ -1345,6 +2016,38 @@ export type ProtobufAny = {
  */
 export type ProtobufNullValue = "NULL_VALUE";
 
+export type AuditListAuditEventsData = {
+  body?: never;
+  path?: never;
+  query?: {
+    limit?: number;
+    before?: string;
+    resourceType?: string;
+    resourceId?: string;
+    actorId?: string;
+    action?: string;
+  };
+  url: "/api/v1/audit-events";
+};
+
+export type AuditListAuditEventsErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type AuditListAuditEventsError = AuditListAuditEventsErrors[keyof AuditListAuditEventsErrors];
+
+export type AuditListAuditEventsResponses = {
+  /**
+   * A successful response.
+   */
+  200: AuditListAuditEventsResponse;
+};
+
+export type AuditListAuditEventsResponse2 = AuditListAuditEventsResponses[keyof AuditListAuditEventsResponses];
+
 export type BlueprintsListBlueprintsData = {
   body?: never;
   path?: never;
@@ -1534,6 +2237,31 @@ export type CanvasesCreateCanvasResponses = {
 
 export type CanvasesCreateCanvasResponse2 = CanvasesCreateCanvasResponses[keyof CanvasesCreateCanvasResponses];
 
+export type CanvasesImportCanvasData = {
+  body: CanvasesImportCanvasRequest;
+  path?: never;
+  query?: never;
+  url: "/api/v1/canvases/import";
+};
+
+export type CanvasesImportCanvasErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesImportCanvasError = CanvasesImportCanvasErrors[keyof CanvasesImportCanvasErrors];
+
+export type CanvasesImportCanvasResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesImportCanvasResponse;
+};
+
+export type CanvasesImportCanvasResponse2 = CanvasesImportCanvasResponses[keyof CanvasesImportCanvasResponses];
+
 export type CanvasesListCanvasChangeRequestsData = {
   body?: never;
   path: {
@@ -1867,6 +2595,321 @@ export type CanvasesListChildExecutionsResponses = {
 export type CanvasesListChildExecutionsResponse2 =
   CanvasesListChildExecutionsResponses[keyof CanvasesListChildExecutionsResponses];
 
+export type CanvasesCompleteExpressionData = {
+  body: CanvasesCompleteExpressionBody;
+  path: {
+    canvasId: string;
+  };
+  query?: never;
+  url: "/api/v1/canvases/{canvasId}/expressions/complete";
+};
+
+export type CanvasesCompleteExpressionErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesCompleteExpressionError = CanvasesCompleteExpressionErrors[keyof CanvasesCompleteExpressionErrors];
+
+export type CanvasesCompleteExpressionResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesCompleteExpressionResponse;
+};
+
+export type CanvasesCompleteExpressionResponse2 =
+  CanvasesCompleteExpressionResponses[keyof CanvasesCompleteExpressionResponses];
+
+export type CanvasesValidateExpressionData = {
+  body: CanvasesValidateExpressionBody;
+  path: {
+    canvasId: string;
+  };
+  query?: never;
+  url: "/api/v1/canvases/{canvasId}/expressions/validate";
+};
+
+export type CanvasesValidateExpressionErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesValidateExpressionError = CanvasesValidateExpressionErrors[keyof CanvasesValidateExpressionErrors];
+
+export type CanvasesValidateExpressionResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesValidateExpressionResponse;
+};
+
+export type CanvasesValidateExpressionResponse2 =
+  CanvasesValidateExpressionResponses[keyof CanvasesValidateExpressionResponses];
+
+export type CanvasesDescribeCanvasGitSyncData = {
+  body?: never;
+  path: {
+    canvasId: string;
+  };
+  query?: never;
+  url: "/api/v1/canvases/{canvasId}/git-sync";
+};
+
+export type CanvasesDescribeCanvasGitSyncErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesDescribeCanvasGitSyncError =
+  CanvasesDescribeCanvasGitSyncErrors[keyof CanvasesDescribeCanvasGitSyncErrors];
+
+export type CanvasesDescribeCanvasGitSyncResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesDescribeCanvasGitSyncResponse;
+};
+
+export type CanvasesDescribeCanvasGitSyncResponse2 =
+  CanvasesDescribeCanvasGitSyncResponses[keyof CanvasesDescribeCanvasGitSyncResponses];
+
+export type CanvasesDeleteCanvasGitSyncData = {
+  body?: never;
+  path: {
+    canvasId: string;
+  };
+  query?: never;
+  url: "/api/v1/canvases/{canvasId}/git-sync";
+};
+
+export type CanvasesDeleteCanvasGitSyncErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesDeleteCanvasGitSyncError =
+  CanvasesDeleteCanvasGitSyncErrors[keyof CanvasesDeleteCanvasGitSyncErrors];
+
+export type CanvasesDeleteCanvasGitSyncResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesDeleteCanvasGitSyncResponse;
+};
+
+export type CanvasesDeleteCanvasGitSyncResponse2 =
+  CanvasesDeleteCanvasGitSyncResponses[keyof CanvasesDeleteCanvasGitSyncResponses];
+
+export type CanvasesUpdateCanvasGitSyncData = {
+  body: CanvasesUpdateCanvasGitSyncBody;
+  path: {
+    canvasId: string;
+  };
+  query?: never;
+  url: "/api/v1/canvases/{canvasId}/git-sync";
+};
+
+export type CanvasesUpdateCanvasGitSyncErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesUpdateCanvasGitSyncError =
+  CanvasesUpdateCanvasGitSyncErrors[keyof CanvasesUpdateCanvasGitSyncErrors];
+
+export type CanvasesUpdateCanvasGitSyncResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesUpdateCanvasGitSyncResponse;
+};
+
+export type CanvasesUpdateCanvasGitSyncResponse2 =
+  CanvasesUpdateCanvasGitSyncResponses[keyof CanvasesUpdateCanvasGitSyncResponses];
+
+export type CanvasesListCanvasGroupsData = {
+  body?: never;
+  path: {
+    canvasId: string;
+  };
+  query?: never;
+  url: "/api/v1/canvases/{canvasId}/groups";
+};
+
+export type CanvasesListCanvasGroupsErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesListCanvasGroupsError = CanvasesListCanvasGroupsErrors[keyof CanvasesListCanvasGroupsErrors];
+
+export type CanvasesListCanvasGroupsResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesListCanvasGroupsResponse;
+};
+
+export type CanvasesListCanvasGroupsResponse2 =
+  CanvasesListCanvasGroupsResponses[keyof CanvasesListCanvasGroupsResponses];
+
+export type CanvasesRemoveCanvasGroupData = {
+  body?: never;
+  path: {
+    canvasId: string;
+    groupName: string;
+  };
+  query?: never;
+  url: "/api/v1/canvases/{canvasId}/groups/{groupName}";
+};
+
+export type CanvasesRemoveCanvasGroupErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesRemoveCanvasGroupError = CanvasesRemoveCanvasGroupErrors[keyof CanvasesRemoveCanvasGroupErrors];
+
+export type CanvasesRemoveCanvasGroupResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesRemoveCanvasGroupResponse;
+};
+
+export type CanvasesRemoveCanvasGroupResponse2 =
+  CanvasesRemoveCanvasGroupResponses[keyof CanvasesRemoveCanvasGroupResponses];
+
+export type CanvasesUpdateCanvasGroupData = {
+  body: CanvasesUpdateCanvasGroupBody;
+  path: {
+    canvasId: string;
+    groupName: string;
+  };
+  query?: never;
+  url: "/api/v1/canvases/{canvasId}/groups/{groupName}";
+};
+
+export type CanvasesUpdateCanvasGroupErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesUpdateCanvasGroupError = CanvasesUpdateCanvasGroupErrors[keyof CanvasesUpdateCanvasGroupErrors];
+
+export type CanvasesUpdateCanvasGroupResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesUpdateCanvasGroupResponse;
+};
+
+export type CanvasesUpdateCanvasGroupResponse2 =
+  CanvasesUpdateCanvasGroupResponses[keyof CanvasesUpdateCanvasGroupResponses];
+
+export type CanvasesListCanvasMembersData = {
+  body?: never;
+  path: {
+    canvasId: string;
+  };
+  query?: never;
+  url: "/api/v1/canvases/{canvasId}/members";
+};
+
+export type CanvasesListCanvasMembersErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesListCanvasMembersError = CanvasesListCanvasMembersErrors[keyof CanvasesListCanvasMembersErrors];
+
+export type CanvasesListCanvasMembersResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesListCanvasMembersResponse;
+};
+
+export type CanvasesListCanvasMembersResponse2 =
+  CanvasesListCanvasMembersResponses[keyof CanvasesListCanvasMembersResponses];
+
+export type CanvasesRemoveCanvasMemberData = {
+  body?: never;
+  path: {
+    canvasId: string;
+    userId: string;
+  };
+  query?: never;
+  url: "/api/v1/canvases/{canvasId}/members/{userId}";
+};
+
+export type CanvasesRemoveCanvasMemberErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesRemoveCanvasMemberError = CanvasesRemoveCanvasMemberErrors[keyof CanvasesRemoveCanvasMemberErrors];
+
+export type CanvasesRemoveCanvasMemberResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesRemoveCanvasMemberResponse;
+};
+
+export type CanvasesRemoveCanvasMemberResponse2 =
+  CanvasesRemoveCanvasMemberResponses[keyof CanvasesRemoveCanvasMemberResponses];
+
+export type CanvasesUpdateCanvasMemberData = {
+  body: CanvasesUpdateCanvasMemberBody;
+  path: {
+    canvasId: string;
+    userId: string;
+  };
+  query?: never;
+  url: "/api/v1/canvases/{canvasId}/members/{userId}";
+};
+
+export type CanvasesUpdateCanvasMemberErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesUpdateCanvasMemberError = CanvasesUpdateCanvasMemberErrors[keyof CanvasesUpdateCanvasMemberErrors];
+
+export type CanvasesUpdateCanvasMemberResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesUpdateCanvasMemberResponse;
+};
+
+export type CanvasesUpdateCanvasMemberResponse2 =
+  CanvasesUpdateCanvasMemberResponses[keyof CanvasesUpdateCanvasMemberResponses];
+
 export type CanvasesListCanvasMemoriesData = {
   body?: never;
   path: {
@@ -1895,6 +2938,95 @@ export type CanvasesListCanvasMemoriesResponses = {
 export type CanvasesListCanvasMemoriesResponse2 =
   CanvasesListCanvasMemoriesResponses[keyof CanvasesListCanvasMemoriesResponses];
 
+export type CanvasesListCanvasMemoryNamespacesData = {
+  body?: never;
+  path: {
+    canvasId: string;
+  };
+  query?: never;
+  url: "/api/v1/canvases/{canvasId}/memory-namespaces";
+};
+
+export type CanvasesListCanvasMemoryNamespacesErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesListCanvasMemoryNamespacesError =
+  CanvasesListCanvasMemoryNamespacesErrors[keyof CanvasesListCanvasMemoryNamespacesErrors];
+
+export type CanvasesListCanvasMemoryNamespacesResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesListCanvasMemoryNamespacesResponse;
+};
+
+export type CanvasesListCanvasMemoryNamespacesResponse2 =
+  CanvasesListCanvasMemoryNamespacesResponses[keyof CanvasesListCanvasMemoryNamespacesResponses];
+
+export type CanvasesDeleteCanvasMemoryNamespaceData = {
+  body?: never;
+  path: {
+    canvasId: string;
+    namespace: string;
+  };
+  query?: never;
+  url: "/api/v1/canvases/{canvasId}/memory-namespaces/{namespace}";
+};
+
+export type CanvasesDeleteCanvasMemoryNamespaceErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesDeleteCanvasMemoryNamespaceError =
+  CanvasesDeleteCanvasMemoryNamespaceErrors[keyof CanvasesDeleteCanvasMemoryNamespaceErrors];
+
+export type CanvasesDeleteCanvasMemoryNamespaceResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesDeleteCanvasMemoryNamespaceResponse;
+};
+
+export type CanvasesDeleteCanvasMemoryNamespaceResponse2 =
+  CanvasesDeleteCanvasMemoryNamespaceResponses[keyof CanvasesDeleteCanvasMemoryNamespaceResponses];
+
+export type CanvasesUpdateCanvasMemoryNamespaceData = {
+  body: CanvasesUpdateCanvasMemoryNamespaceBody;
+  path: {
+    canvasId: string;
+    namespace: string;
+  };
+  query?: never;
+  url: "/api/v1/canvases/{canvasId}/memory-namespaces/{namespace}";
+};
+
+export type CanvasesUpdateCanvasMemoryNamespaceErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesUpdateCanvasMemoryNamespaceError =
+  CanvasesUpdateCanvasMemoryNamespaceErrors[keyof CanvasesUpdateCanvasMemoryNamespaceErrors];
+
+export type CanvasesUpdateCanvasMemoryNamespaceResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesUpdateCanvasMemoryNamespaceResponse;
+};
+
+export type CanvasesUpdateCanvasMemoryNamespaceResponse2 =
+  CanvasesUpdateCanvasMemoryNamespaceResponses[keyof CanvasesUpdateCanvasMemoryNamespaceResponses];
+
 export type CanvasesDeleteCanvasMemoryData = {
   body?: never;
   path: {
@@ -2205,88 +3337,152 @@ export type CanvasesUpdateCanvasVersion2Data = {
     canvasId: string;
   };
   query?: never;
-  url: "/api/v1/canvases/{canvasId}/versions";
+  url: "/api/v1/canvases/{canvasId}/versions";
+};
+
+export type CanvasesUpdateCanvasVersion2Errors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesUpdateCanvasVersion2Error =
+  CanvasesUpdateCanvasVersion2Errors[keyof CanvasesUpdateCanvasVersion2Errors];
+
+export type CanvasesUpdateCanvasVersion2Responses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesUpdateCanvasVersionResponse;
+};
+
+export type CanvasesUpdateCanvasVersion2Response =
+  CanvasesUpdateCanvasVersion2Responses[keyof CanvasesUpdateCanvasVersion2Responses];
+
+export type CanvasesDescribeCanvasVersionData = {
+  body?: never;
+  path: {
+    canvasId: string;
+    versionId: string;
+  };
+  query?: never;
+  url: "/api/v1/canvases/{canvasId}/versions/{versionId}";
+};
+
+export type CanvasesDescribeCanvasVersionErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesDescribeCanvasVersionError =
+  CanvasesDescribeCanvasVersionErrors[keyof CanvasesDescribeCanvasVersionErrors];
+
+export type CanvasesDescribeCanvasVersionResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesDescribeCanvasVersionResponse;
+};
+
+export type CanvasesDescribeCanvasVersionResponse2 =
+  CanvasesDescribeCanvasVersionResponses[keyof CanvasesDescribeCanvasVersionResponses];
+
+export type CanvasesUpdateCanvasVersionData = {
+  body: CanvasesUpdateCanvasVersionBody;
+  path: {
+    canvasId: string;
+    versionId: string;
+  };
+  query?: never;
+  url: "/api/v1/canvases/{canvasId}/versions/{versionId}";
 };
 
-export type CanvasesUpdateCanvasVersion2Errors = {
+export type CanvasesUpdateCanvasVersionErrors = {
   /**
    * An unexpected error response.
    */
   default: GooglerpcStatus;
 };
 
-export type CanvasesUpdateCanvasVersion2Error =
-  CanvasesUpdateCanvasVersion2Errors[keyof CanvasesUpdateCanvasVersion2Errors];
+export type CanvasesUpdateCanvasVersionError =
+  CanvasesUpdateCanvasVersionErrors[keyof CanvasesUpdateCanvasVersionErrors];
 
-export type CanvasesUpdateCanvasVersion2Responses = {
+export type CanvasesUpdateCanvasVersionResponses = {
   /**
    * A successful response.
    */
   200: CanvasesUpdateCanvasVersionResponse;
 };
 
-export type CanvasesUpdateCanvasVersion2Response =
-  CanvasesUpdateCanvasVersion2Responses[keyof CanvasesUpdateCanvasVersion2Responses];
+export type CanvasesUpdateCanvasVersionResponse2 =
+  CanvasesUpdateCanvasVersionResponses[keyof CanvasesUpdateCanvasVersionResponses];
 
-export type CanvasesDescribeCanvasVersionData = {
+export type CanvasesDiffCanvasVersionsData = {
   body?: never;
   path: {
     canvasId: string;
     versionId: string;
   };
-  query?: never;
-  url: "/api/v1/canvases/{canvasId}/versions/{versionId}";
+  query?: {
+    /**
+     * Version to compare with. Defaults to the live version.
+     */
+    baseVersionId?: string;
+  };
+  url: "/api/v1/canvases/{canvasId}/versions/{versionId}/diff";
 };
 
-export type CanvasesDescribeCanvasVersionErrors = {
+export type CanvasesDiffCanvasVersionsErrors = {
   /**
    * An unexpected error response.
    */
   default: GooglerpcStatus;
 };
 
-export type CanvasesDescribeCanvasVersionError =
-  CanvasesDescribeCanvasVersionErrors[keyof CanvasesDescribeCanvasVersionErrors];
+export type CanvasesDiffCanvasVersionsError = CanvasesDiffCanvasVersionsErrors[keyof CanvasesDiffCanvasVersionsErrors];
 
-export type CanvasesDescribeCanvasVersionResponses = {
+export type CanvasesDiffCanvasVersionsResponses = {
   /**
    * A successful response.
    */
-  200: CanvasesDescribeCanvasVersionResponse;
+  200: CanvasesDiffCanvasVersionsResponse;
 };
 
-export type CanvasesDescribeCanvasVersionResponse2 =
-  CanvasesDescribeCanvasVersionResponses[keyof CanvasesDescribeCanvasVersionResponses];
+export type CanvasesDiffCanvasVersionsResponse2 =
+  CanvasesDiffCanvasVersionsResponses[keyof CanvasesDiffCanvasVersionsResponses];
 
-export type CanvasesUpdateCanvasVersionData = {
-  body: CanvasesUpdateCanvasVersionBody;
+export type CanvasesRollbackCanvasVersionData = {
+  body: CanvasesRollbackCanvasVersionBody;
   path: {
     canvasId: string;
     versionId: string;
   };
   query?: never;
-  url: "/api/v1/canvases/{canvasId}/versions/{versionId}";
+  url: "/api/v1/canvases/{canvasId}/versions/{versionId}/rollback";
 };
 
-export type CanvasesUpdateCanvasVersionErrors = {
+export type CanvasesRollbackCanvasVersionErrors = {
   /**
    * An unexpected error response.
    */
   default: GooglerpcStatus;
 };
 
-export type CanvasesUpdateCanvasVersionError =
-  CanvasesUpdateCanvasVersionErrors[keyof CanvasesUpdateCanvasVersionErrors];
+export type CanvasesRollbackCanvasVersionError =
+  CanvasesRollbackCanvasVersionErrors[keyof CanvasesRollbackCanvasVersionErrors];
 
-export type CanvasesUpdateCanvasVersionResponses = {
+export type CanvasesRollbackCanvasVersionResponses = {
   /**
    * A successful response.
    */
-  200: CanvasesUpdateCanvasVersionResponse;
+  200: CanvasesRollbackCanvasVersionResponse;
 };
 
-export type CanvasesUpdateCanvasVersionResponse2 =
-  CanvasesUpdateCanvasVersionResponses[keyof CanvasesUpdateCanvasVersionResponses];
+export type CanvasesRollbackCanvasVersionResponse2 =
+  CanvasesRollbackCanvasVersionResponses[keyof CanvasesRollbackCanvasVersionResponses];
 
 export type CanvasesDeleteCanvasData = {
   body?: never;
@@ -2369,6 +3565,33 @@ export type CanvasesUpdateCanvasResponses = {
 
 export type CanvasesUpdateCanvasResponse2 = CanvasesUpdateCanvasResponses[keyof CanvasesUpdateCanvasResponses];
 
+export type CanvasesExportCanvasData = {
+  body?: never;
+  path: {
+    id: string;
+  };
+  query?: never;
+  url: "/api/v1/canvases/{id}/export";
+};
+
+export type CanvasesExportCanvasErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesExportCanvasError = CanvasesExportCanvasErrors[keyof CanvasesExportCanvasErrors];
+
+export type CanvasesExportCanvasResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesExportCanvasResponse;
+};
+
+export type CanvasesExportCanvasResponse2 = CanvasesExportCanvasResponses[keyof CanvasesExportCanvasResponses];
+
 export type ComponentsListComponentsData = {
   body?: never;
   path?: never;
@@ -2457,7 +3680,7 @@ export type GroupsListGroupsData = {
   body?: never;
   path?: never;
   query?: {
-    domainType?: "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION";
+    domainType?: "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION" | "DOMAIN_TYPE_CANVAS";
     domainId?: string;
   };
   url: "/api/v1/groups";
@@ -2512,7 +3735,7 @@ export type GroupsDeleteGroupData = {
     groupName: string;
   };
   query?: {
-    domainType?: "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION";
+    domainType?: "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION" | "DOMAIN_TYPE_CANVAS";
     domainId?: string;
   };
   url: "/api/v1/groups/{groupName}";
@@ -2542,7 +3765,7 @@ export type GroupsDescribeGroupData = {
     groupName: string;
   };
   query?: {
-    domainType?: "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION";
+    domainType?: "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION" | "DOMAIN_TYPE_CANVAS";
     domainId?: string;
   };
   url: "/api/v1/groups/{groupName}";
@@ -2599,7 +3822,7 @@ export type GroupsListGroupUsersData = {
     groupName: string;
   };
   query?: {
-    domainType?: "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION";
+    domainType?: "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION" | "DOMAIN_TYPE_CANVAS";
     domainId?: string;
   };
   url: "/api/v1/groups/{groupName}/users";
@@ -3349,6 +4572,212 @@ export type OrganizationsResetInviteLinkResponses = {
 export type OrganizationsResetInviteLinkResponse2 =
   OrganizationsResetInviteLinkResponses[keyof OrganizationsResetInviteLinkResponses];
 
+export type OrganizationsListMemoryNamespacesData = {
+  body?: never;
+  path: {
+    id: string;
+  };
+  query?: never;
+  url: "/api/v1/organizations/{id}/memory-namespaces";
+};
+
+export type OrganizationsListMemoryNamespacesErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type OrganizationsListMemoryNamespacesError =
+  OrganizationsListMemoryNamespacesErrors[keyof OrganizationsListMemoryNamespacesErrors];
+
+export type OrganizationsListMemoryNamespacesResponses = {
+  /**
+   * A successful response.
+   */
+  200: OrganizationsListMemoryNamespacesResponse;
+};
+
+export type OrganizationsListMemoryNamespacesResponse2 =
+  OrganizationsListMemoryNamespacesResponses[keyof OrganizationsListMemoryNamespacesResponses];
+
+export type OrganizationsDeleteMemoryNamespaceData = {
+  body?: never;
+  path: {
+    id: string;
+    namespace: string;
+  };
+  query?: never;
+  url: "/api/v1/organizations/{id}/memory-namespaces/{namespace}";
+};
+
+export type OrganizationsDeleteMemoryNamespaceErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type OrganizationsDeleteMemoryNamespaceError =
+  OrganizationsDeleteMemoryNamespaceErrors[keyof OrganizationsDeleteMemoryNamespaceErrors];
+
+export type OrganizationsDeleteMemoryNamespaceResponses = {
+  /**
+   * A successful response.
+   */
+  200: OrganizationsDeleteMemoryNamespaceResponse;
+};
+
+export type OrganizationsDeleteMemoryNamespaceResponse2 =
+  OrganizationsDeleteMemoryNamespaceResponses[keyof OrganizationsDeleteMemoryNamespaceResponses];
+
+export type OrganizationsUpdateMemoryNamespaceData = {
+  body: OrganizationsUpdateMemoryNamespaceBody;
+  path: {
+    id: string;
+    namespace: string;
+  };
+  query?: never;
+  url: "/api/v1/organizations/{id}/memory-namespaces/{namespace}";
+};
+
+export type OrganizationsUpdateMemoryNamespaceErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type OrganizationsUpdateMemoryNamespaceError =
+  OrganizationsUpdateMemoryNamespaceErrors[keyof OrganizationsUpdateMemoryNamespaceErrors];
+
+export type OrganizationsUpdateMemoryNamespaceResponses = {
+  /**
+   * A successful response.
+   */
+  200: OrganizationsUpdateMemoryNamespaceResponse;
+};
+
+export type OrganizationsUpdateMemoryNamespaceResponse2 =
+  OrganizationsUpdateMemoryNamespaceResponses[keyof OrganizationsUpdateMemoryNamespaceResponses];
+
+export type OrganizationsListMemoriesData = {
+  body?: never;
+  path: {
+    id: string;
+    namespace: string;
+  };
+  query?: never;
+  url: "/api/v1/organizations/{id}/memory-namespaces/{namespace}/memories";
+};
+
+export type OrganizationsListMemoriesErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type OrganizationsListMemoriesError = OrganizationsListMemoriesErrors[keyof OrganizationsListMemoriesErrors];
+
+export type OrganizationsListMemoriesResponses = {
+  /**
+   * A successful response.
+   */
+  200: OrganizationsListMemoriesResponse;
+};
+
+export type OrganizationsListMemoriesResponse2 =
+  OrganizationsListMemoriesResponses[keyof OrganizationsListMemoriesResponses];
+
+export type OrganizationsDeleteMemoryData = {
+  body?: never;
+  path: {
+    id: string;
+    namespace: string;
+    memoryId: string;
+  };
+  query?: never;
+  url: "/api/v1/organizations/{id}/memory-namespaces/{namespace}/memories/{memoryId}";
+};
+
+export type OrganizationsDeleteMemoryErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type OrganizationsDeleteMemoryError = OrganizationsDeleteMemoryErrors[keyof OrganizationsDeleteMemoryErrors];
+
+export type OrganizationsDeleteMemoryResponses = {
+  /**
+   * A successful response.
+   */
+  200: OrganizationsDeleteMemoryResponse;
+};
+
+export type OrganizationsDeleteMemoryResponse2 =
+  OrganizationsDeleteMemoryResponses[keyof OrganizationsDeleteMemoryResponses];
+
+export type OrganizationsGetSsoSettingsData = {
+  body?: never;
+  path: {
+    id: string;
+  };
+  query?: never;
+  url: "/api/v1/organizations/{id}/sso-settings";
+};
+
+export type OrganizationsGetSsoSettingsErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type OrganizationsGetSsoSettingsError =
+  OrganizationsGetSsoSettingsErrors[keyof OrganizationsGetSsoSettingsErrors];
+
+export type OrganizationsGetSsoSettingsResponses = {
+  /**
+   * A successful response.
+   */
+  200: OrganizationsGetSsoSettingsResponse;
+};
+
+export type OrganizationsGetSsoSettingsResponse2 =
+  OrganizationsGetSsoSettingsResponses[keyof OrganizationsGetSsoSettingsResponses];
+
+export type OrganizationsUpdateSsoSettingsData = {
+  body: OrganizationsUpdateSsoSettingsBody;
+  path: {
+    id: string;
+  };
+  query?: never;
+  url: "/api/v1/organizations/{id}/sso-settings";
+};
+
+export type OrganizationsUpdateSsoSettingsErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type OrganizationsUpdateSsoSettingsError =
+  OrganizationsUpdateSsoSettingsErrors[keyof OrganizationsUpdateSsoSettingsErrors];
+
+export type OrganizationsUpdateSsoSettingsResponses = {
+  /**
+   * A successful response.
+   */
+  200: OrganizationsUpdateSsoSettingsResponse;
+};
+
+export type OrganizationsUpdateSsoSettingsResponse2 =
+  OrganizationsUpdateSsoSettingsResponses[keyof OrganizationsUpdateSsoSettingsResponses];
+
 export type OrganizationsRemoveUserData = {
   body?: never;
   path: {
@@ -3381,7 +4810,7 @@ export type RolesListRolesData = {
   body?: never;
   path?: never;
   query?: {
-    domainType?: "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION";
+    domainType?: "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION" | "DOMAIN_TYPE_CANVAS";
     domainId?: string;
   };
   url: "/api/v1/roles";
@@ -3436,7 +4865,7 @@ export type RolesDeleteRoleData = {
     roleName: string;
   };
   query?: {
-    domainType?: "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION";
+    domainType?: "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION" | "DOMAIN_TYPE_CANVAS";
     domainId?: string;
   };
   url: "/api/v1/roles/{roleName}";
@@ -3466,7 +4895,7 @@ export type RolesDescribeRoleData = {
     roleName: string;
   };
   query?: {
-    domainType?: "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION";
+    domainType?: "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION" | "DOMAIN_TYPE_CANVAS";
     domainId?: string;
   };
   url: "/api/v1/roles/{roleName}";
@@ -3548,7 +4977,7 @@ export type SecretsListSecretsData = {
   body?: never;
   path?: never;
   query?: {
-    domainType?: "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION";
+    domainType?: "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION" | "DOMAIN_TYPE_CANVAS";
     domainId?: string;
   };
   url: "/api/v1/secrets";
@@ -3603,7 +5032,7 @@ export type SecretsDeleteSecretData = {
     idOrName: string;
   };
   query?: {
-    domainType?: "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION";
+    domainType?: "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION" | "DOMAIN_TYPE_CANVAS";
     domainId?: string;
   };
   url: "/api/v1/secrets/{idOrName}";
@@ -3633,7 +5062,7 @@ export type SecretsDescribeSecretData = {
     idOrName: string;
   };
   query?: {
-    domainType?: "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION";
+    domainType?: "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION" | "DOMAIN_TYPE_CANVAS";
     domainId?: string;
   };
   url: "/api/v1/secrets/{idOrName}";
@@ -3691,7 +5120,7 @@ export type SecretsDeleteSecretKeyData = {
     keyName: string;
   };
   query?: {
-    domainType?: "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION";
+    domainType?: "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION" | "DOMAIN_TYPE_CANVAS";
     domainId?: string;
   };
   url: "/api/v1/secrets/{idOrName}/keys/{keyName}";
@@ -3770,6 +5199,91 @@ export type SecretsUpdateSecretNameResponses = {
 
 export type SecretsUpdateSecretNameResponse2 = SecretsUpdateSecretNameResponses[keyof SecretsUpdateSecretNameResponses];
 
+export type SecretsRollbackSecretData = {
+  body: SecretsRollbackSecretBody;
+  path: {
+    idOrName: string;
+  };
+  query?: never;
+  url: "/api/v1/secrets/{idOrName}/rollback";
+};
+
+export type SecretsRollbackSecretErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type SecretsRollbackSecretError = SecretsRollbackSecretErrors[keyof SecretsRollbackSecretErrors];
+
+export type SecretsRollbackSecretResponses = {
+  /**
+   * A successful response.
+   */
+  200: SecretsRollbackSecretResponse;
+};
+
+export type SecretsRollbackSecretResponse2 = SecretsRollbackSecretResponses[keyof SecretsRollbackSecretResponses];
+
+export type SecretsRotateSecretData = {
+  body: SecretsRotateSecretBody;
+  path: {
+    idOrName: string;
+  };
+  query?: never;
+  url: "/api/v1/secrets/{idOrName}/rotate";
+};
+
+export type SecretsRotateSecretErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type SecretsRotateSecretError = SecretsRotateSecretErrors[keyof SecretsRotateSecretErrors];
+
+export type SecretsRotateSecretResponses = {
+  /**
+   * A successful response.
+   */
+  200: SecretsRotateSecretResponse;
+};
+
+export type SecretsRotateSecretResponse2 = SecretsRotateSecretResponses[keyof SecretsRotateSecretResponses];
+
+export type SecretsListSecretVersionsData = {
+  body?: never;
+  path: {
+    idOrName: string;
+  };
+  query?: {
+    domainType?: "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION" | "DOMAIN_TYPE_CANVAS";
+    domainId?: string;
+  };
+  url: "/api/v1/secrets/{idOrName}/versions";
+};
+
+export type SecretsListSecretVersionsErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type SecretsListSecretVersionsError = SecretsListSecretVersionsErrors[keyof SecretsListSecretVersionsErrors];
+
+export type SecretsListSecretVersionsResponses = {
+  /**
+   * A successful response.
+   */
+  200: SecretsListSecretVersionsResponse;
+};
+
+export type SecretsListSecretVersionsResponse2 =
+  SecretsListSecretVersionsResponses[keyof SecretsListSecretVersionsResponses];
+
 export type ServiceAccountsListServiceAccountsData = {
   body?: never;
   path?: never;
@@ -3996,7 +5510,7 @@ export type UsersListUsersData = {
   body?: never;
   path?: never;
   query?: {
-    domainType?: "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION";
+    domainType?: "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION" | "DOMAIN_TYPE_CANVAS";
     domainId?: string;
     includeServiceAccounts?: boolean;
   };
@@ -4027,7 +5541,7 @@ export type UsersListUserPermissionsData = {
     userId: string;
   };
   query?: {
-    domainType?: "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION";
+    domainType?: "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION" | "DOMAIN_TYPE_CANVAS";
     domainId?: string;
   };
   url: "/api/v1/users/{userId}/permissions";
@@ -4058,7 +5572,7 @@ export type UsersListUserRolesData = {
     userId: string;
   };
   query?: {
-    domainType?: "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION";
+    domainType?: "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION" | "DOMAIN_TYPE_CANVAS";
     domainId?: string;
   };
   url: "/api/v1/users/{userId}/roles";
@@ -526,6 +526,177 @@ export const EXPR_FUNCTIONS: readonly ExprFunction[] = [
     description: "Returns the values resulting from the unsigned Right Shift operation.",
     example: "bitushr(-0b101, 2) == 4611686018427387902",
   },
+
+  // Semantic versions
+  {
+    name: "semver",
+    snippet: "semver(${1:version})",
+    description: "Parses a semantic version, returning its major, minor, patch, prerelease and build parts.",
+    example: 'semver("v1.2.3").minor == 2',
+  },
+  {
+    name: "semverCompare",
+    snippet: "semverCompare(${1:a}, ${2:b})",
+    description: "Compares two semantic versions, returning -1, 0 or 1.",
+    example: 'semverCompare("1.2.3", "1.10.0") == -1',
+  },
+  {
+    name: "semverSatisfies",
+    snippet: "semverSatisfies(${1:version}, ${2:constraint})",
+    description: 'Checks if a semantic version satisfies a constraint, like ">=1.2.0 <2.0.0", "^1.4" or "1.x || 2.x".',
+    example: 'semverSatisfies("1.9.0", "^1.4") == true',
+  },
+
+  // Regular expressions
+  {
+    name: "regexMatch",
+    snippet: "regexMatch(${1:str}, ${2:pattern})",
+    description: "Checks if the string matches the regular expression.",
+    example: 'regexMatch("refs/tags/v1", "^refs/tags/") == true',
+  },
+  {
+    name: "regexFind",
+    snippet: "regexFind(${1:str}, ${2:pattern})",
+    description: "Returns the first match of the regular expression, or an empty string.",
+    example: 'regexFind("build-42", "[0-9]+") == "42"',
+  },
+  {
+    name: "regexFindAll",
+    snippet: "regexFindAll(${1:str}, ${2:pattern})",
+    description: "Returns every match of the regular expression.",
+    example: 'regexFindAll("a1b22", "[0-9]+") == ["1", "22"]',
+  },
+  {
+    name: "regexExtract",
+    snippet: "regexExtract(${1:str}, ${2:pattern})",
+    description: "Returns the capture groups of the first match, keyed by name, or by position for unnamed groups.",
+    example: 'regexExtract("refs/tags/v1", "tags/(?P<tag>.*)").tag == "v1"',
+  },
+  {
+    name: "regexReplace",
+    snippet: "regexReplace(${1:str}, ${2:pattern}, ${3:replacement})",
+    description:
+      "Replaces every match of the regular expression. The replacement can reference groups with $1 or ${name}.",
+    example: 'regexReplace("v1.2", "^v", "") == "1.2"',
+  },
+
+  // JSON path
+  {
+    name: "jsonPath",
+    snippet: "jsonPath(${1:value}, ${2:path})",
+    description: "Queries a value, or a JSON string, with a JSON path.",
+    example: 'jsonPath({items: [{id: 1}]}, "$.items[*].id") == [1]',
+  },
+
+  // Encoding and hashing
+  {
+    name: "base64URLEncode",
+    snippet: "base64URLEncode(${1:str})",
+    description: "Encodes the string using URL-safe Base64, without padding.",
+    example: 'base64URLEncode("hello?>") == "aGVsbG8_Pg"',
+  },
+  {
+    name: "base64URLDecode",
+    snippet: "base64URLDecode(${1:str})",
+    description: "Decodes a URL-safe Base64 string.",
+    example: 'base64URLDecode("aGVsbG8_Pg") == "hello?>"',
+  },
+  {
+    name: "hexEncode",
+    snippet: "hexEncode(${1:str})",
+    description: "Encodes the string as hexadecimal.",
+    example: 'hexEncode("hi") == "6869"',
+  },
+  {
+    name: "hexDecode",
+    snippet: "hexDecode(${1:str})",
+    description: "Decodes a hexadecimal string.",
+    example: 'hexDecode("6869") == "hi"',
+  },
+  {
+    name: "sha256",
+    snippet: "sha256(${1:str})",
+    description: "Returns the hex-encoded SHA-256 digest of the string.",
+    example: 'len(sha256("abc")) == 64',
+  },
+  {
+    name: "sha512",
+    snippet: "sha512(${1:str})",
+    description: "Returns the hex-encoded SHA-512 digest of the string.",
+    example: 'len(sha512("abc")) == 128',
+  },
+  {
+    name: "hmacSha256",
+    snippet: "hmacSha256(${1:key}, ${2:message})",
+    description: "Returns the hex-encoded HMAC-SHA256 of the message.",
+    example: 'len(hmacSha256("key", "message")) == 64',
+  },
+
+  // URLs
+  {
+    name: "urlParse",
+    snippet: "urlParse(${1:url})",
+    description: "Parses a URL into its scheme, host, hostname, port, path, query, fragment and user.",
+    example: 'urlParse("https://example.com/a?x=1").query.x == "1"',
+  },
+  {
+    name: "urlEncode",
+    snippet: "urlEncode(${1:str})",
+    description: "Escapes the string so it can be used in a URL query.",
+    example: 'urlEncode("a b") == "a+b"',
+  },
+  {
+    name: "urlDecode",
+    snippet: "urlDecode(${1:str})",
+    description: "Unescapes a URL query string.",
+    example: 'urlDecode("a+b") == "a b"',
+  },
+
+  // Durations
+  {
+    name: "parseDuration",
+    snippet: "parseDuration(${1:str})",
+    description: "Parses a duration. Besides Go units, supports days (d) and weeks (w).",
+    example: 'parseDuration("2d").Hours() == 48',
+  },
+  {
+    name: "addDuration",
+    snippet: "addDuration(${1:date}, ${2:duration})",
+    description: "Adds a duration to a date. Negative durations subtract from it.",
+    example: 'addDuration("2024-01-01T00:00:00Z", "1d").Day() == 2',
+  },
+  {
+    name: "since",
+    snippet: "since(${1:date})",
+    description: "Returns the duration elapsed since the date.",
+    example: 'since(date("2024-01-01")) > duration("24h")',
+  },
+  {
+    name: "until",
+    snippet: "until(${1:date})",
+    description: "Returns the duration until the date.",
+    example: 'until(date("2099-01-01")) > duration("24h")',
+  },
+  {
+    name: "durationSeconds",
+    snippet: "durationSeconds(${1:duration})",
+    description: "Returns the number of seconds in a duration.",
+    example: 'durationSeconds("1m30s") == 90',
+  },
+
+  // Templating
+  {
+    name: "template",
+    snippet: "template(${1:text}, ${2:data})",
+    description: "Replaces ${path} placeholders in the text with values from data.",
+    example: 'template("Deploy ${app} to ${env}", {app: "api", env: "prod"}) == "Deploy api to prod"',
+  },
+  {
+    name: "sprintf",
+    snippet: "sprintf(${1:format}, ${2:args})",
+    description: "Formats a string using Go fmt verbs.",
+    example: 'sprintf("%s-%03d", "build", 7) == "build-007"',
+  },
 ] as const;
 
 export function getSuggestions<TGlobals extends Record<string, unknown>>(
@@ -797,6 +968,10 @@ const FUNCTION_RETURN_METHODS: Record<string, MethodInfo[]> = {
   now: DATE_METHODS,
   date: DATE_METHODS,
   duration: DURATION_METHODS,
+  addduration: DATE_METHODS,
+  parseduration: DURATION_METHODS,
+  since: DURATION_METHODS,
+  until: DURATION_METHODS,
 };
 
 function getFunctionReturnMethods(funcName: string): MethodInfo[] {
@@ -29,6 +29,7 @@ import {
   widgetsDescribeWidget,
 } from "../api-client/sdk.gen";
 import { withOrganizationHeader } from "../utils/withOrganizationHeader";
+import { CanvasesCanvasVariable } from "@/api-client";
 
 // Query Keys
 export const canvasKeys = {
@@ -362,6 +363,7 @@ export const useUpdateCanvas = (organizationId: string, canvasId: string) => {
       changeRequestApprovalConfig?: {
         items?: Array<{ type: "TYPE_ANYONE" | "TYPE_USER" | "TYPE_ROLE"; userId?: string; roleName?: string }>;
       };
+      environment?: string;
     }) => {
       return await canvasesUpdateCanvas(
         withOrganizationHeader({
@@ -371,6 +373,7 @@ export const useUpdateCanvas = (organizationId: string, canvasId: string) => {
             description: data.description,
             versioningEnabled: data.versioningEnabled,
             changeRequestApprovalConfig: data.changeRequestApprovalConfig,
+            environment: data.environment,
           },
         }),
       );
@@ -442,6 +445,7 @@ export const useUpdateCanvasVersion = (organizationId: string, canvasId: string)
       description?: string;
       nodes?: any[];
       edges?: any[];
+      variables?: CanvasesCanvasVariable[];
       autoLayout?: { algorithm?: string; scope?: string; nodeIds?: string[] };
     }) => {
       const body = {
@@ -453,6 +457,7 @@ export const useUpdateCanvasVersion = (organizationId: string, canvasId: string)
           spec: {
             nodes: data.nodes || [],
             edges: data.edges || [],
+            variables: data.variables || [],
           },
         },
         autoLayout: data.autoLayout,
@@ -594,6 +599,7 @@ export const useResolveCanvasChangeRequest = (organizationId: string, canvasId:
       description?: string;
       nodes?: any[];
       edges?: any[];
+      variables?: CanvasesCanvasVariable[];
       autoLayout?: { algorithm?: string; scope?: string; nodeIds?: string[] };
     }) => {
       return await canvasesResolveCanvasChangeRequest(
@@ -608,6 +614,7 @@ export const useResolveCanvasChangeRequest = (organizationId: string, canvasId:
               spec: {
                 nodes: data.nodes || [],
                 edges: data.edges || [],
+                variables: data.variables || [],
               },
             },
             autoLayout: data.autoLayout,
@@ -1,4 +1,4 @@
-// Setup function to add 401 redirect interceptor to the global API client
+// Setup function to add 401 and SSO redirect interceptors to the global API client
 let interceptorSetup = false;
 
 export const setupApiInterceptor = (): void => {
@@ -19,6 +19,16 @@ export const setupApiInterceptor = (): void => {
       throw new Error("Unauthorized");
     }
 
+    // Organizations enforcing SSO reject sessions not started with their SSO.
+    const ssoLoginURL = response.headers.get("X-SSO-Login-URL");
+    if (response.status === 403 && ssoLoginURL && isApiRequest(input)) {
+      const redirectTarget = `${window.location.pathname}${window.location.search}`;
+      const redirectParam = encodeURIComponent(redirectTarget);
+      window.location.href = `${ssoLoginURL}?redirect=${redirectParam}`;
+
+      throw new Error("SSO Required");
+    }
+
     return response;
   };
 
@@ -197,6 +197,14 @@ const ORGANIZATION_PERMISSIONS: PermissionCategory[] = [
         resource: "canvases",
         action: "update",
       },
+      {
+        id: "canvas.run",
+        name: "Run Canvases",
+        description: "Run actions and approve executions on canvases",
+        category: "Canvases",
+        resource: "executions",
+        action: "update",
+      },
       {
         id: "canvas.delete",
         name: "Delete Canvases",
@@ -325,6 +333,36 @@ const ORGANIZATION_PERMISSIONS: PermissionCategory[] = [
       },
     ],
   },
+  {
+    category: "Memory",
+    icon: "storage",
+    permissions: [
+      {
+        id: "memory.read",
+        name: "View Memory",
+        description: "View organization memory namespaces and their records",
+        category: "Memory",
+        resource: "memory",
+        action: "read",
+      },
+      {
+        id: "memory.update",
+        name: "Manage Memory",
+        description: "Create memory namespaces and grant canvases access to them",
+        category: "Memory",
+        resource: "memory",
+        action: "update",
+      },
+      {
+        id: "memory.delete",
+        name: "Delete Memory",
+        description: "Delete memory namespaces and records",
+        category: "Memory",
+        resource: "memory",
+        action: "delete",
+      },
+    ],
+  },
 ];
 
 const DEFAULT_ROLE_NAMES = ["org_viewer", "org_admin", "org_owner"];
@@ -1151,6 +1151,7 @@ export function WorkflowPageV2() {
               description: latestWorkflow.metadata?.description,
               nodes: updatedNodes,
               edges: latestWorkflow.spec?.edges,
+              variables: latestWorkflow.spec?.variables,
             });
             if (
               updateResponse?.data?.version &&
@@ -2093,6 +2094,7 @@ export function WorkflowPageV2() {
           description: targetWorkflow.metadata?.description,
           nodes: targetWorkflow.spec?.nodes,
           edges: targetWorkflow.spec?.edges,
+          variables: targetWorkflow.spec?.variables,
         });
         if (updateResponse?.data?.version && savingVersionID && activeCanvasVersionIdRef.current === savingVersionID) {
           setActiveCanvasVersion(updateResponse.data.version);
@@ -3544,6 +3546,7 @@ export function WorkflowPageV2() {
           description: canvas.metadata?.description,
           nodes: updatedNodes,
           edges: canvas.spec?.edges,
+          variables: canvas.spec?.variables,
         });
         if (updateResponse?.data?.version && savingVersionID && activeCanvasVersionIdRef.current === savingVersionID) {
           setActiveCanvasVersion(updateResponse.data.version);
@@ -3804,6 +3807,7 @@ export function WorkflowPageV2() {
           description: canvas.metadata.description || "",
           nodes: data.nodes,
           edges: data.edges,
+          variables: canvas.spec?.variables,
         });
 
         const resolvedVersion = response?.data?.version;
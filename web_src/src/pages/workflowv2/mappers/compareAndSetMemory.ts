@@ -0,0 +1,170 @@
+import {
+  ComponentBaseContext,
+  ComponentBaseMapper,
+  ExecutionDetailsContext,
+  ExecutionInfo,
+  NodeInfo,
+  OutputPayload,
+  SubtitleContext,
+} from "./types";
+import {
+  ComponentBaseProps,
+  DEFAULT_EVENT_STATE_MAP,
+  EventSection,
+  EventState,
+  EventStateMap,
+} from "@/ui/componentBase";
+import { getTriggerRenderer } from ".";
+import { formatTimeAgo } from "@/utils/date";
+import { defaultStateFunction } from "./stateRegistry";
+
+type CompareAndSetMemoryMetadata = {
+  namespace?: string;
+  matchFields?: string[];
+  matches?: Record<string, unknown>;
+  field?: string;
+  set?: boolean;
+};
+
+type CompareAndSetMemoryConfiguration = {
+  namespace?: string;
+  matchList?: Array<{ name?: string; value?: unknown }>;
+  field?: string;
+};
+
+type CompareAndSetMemoryOutputs = {
+  set?: OutputPayload[];
+  conflict?: OutputPayload[];
+};
+
+const COMPARE_AND_SET_MEMORY_STATE_MAP: EventStateMap = {
+  ...DEFAULT_EVENT_STATE_MAP,
+  conflict: {
+    icon: "circle-x",
+    textColor: "text-gray-800",
+    backgroundColor: "bg-orange-100",
+    badgeColor: "bg-orange-500",
+    label: "Conflict",
+  },
+};
+
+function getCompareAndSetMemoryState(execution: ExecutionInfo): EventState {
+  const defaultState = defaultStateFunction(execution);
+  if (defaultState !== "success") {
+    return defaultState;
+  }
+
+  const outputs = execution.outputs as CompareAndSetMemoryOutputs | undefined;
+  if (outputs?.conflict && outputs.conflict.length > 0) {
+    return "conflict";
+  }
+
+  return "success";
+}
+
+export const compareAndSetMemoryMapper: ComponentBaseMapper = {
+  props(context: ComponentBaseContext): ComponentBaseProps {
+    const lastExecution = context.lastExecutions.length > 0 ? context.lastExecutions[0] : null;
+
+    return {
+      iconSlug: context.componentDefinition.icon ?? "database",
+      collapsed: context.node.isCollapsed,
+      collapsedBackground: "bg-white",
+      title:
+        context.node.name ||
+        context.componentDefinition.label ||
+        context.componentDefinition.name ||
+        "Unnamed component",
+      eventSections: lastExecution ? getEventSections(context.nodes, lastExecution) : undefined,
+      includeEmptyState: !lastExecution,
+      metadata: getCompareAndSetMemoryMetadataList(context.node),
+      eventStateMap: COMPARE_AND_SET_MEMORY_STATE_MAP,
+    };
+  },
+  subtitle(context: SubtitleContext): string {
+    const timestamp = context.execution.updatedAt || context.execution.createdAt;
+    return timestamp ? formatTimeAgo(new Date(timestamp)) : "";
+  },
+  getExecutionDetails(context: ExecutionDetailsContext): Record<string, string> {
+    const details: Record<string, string> = {};
+    const config = (context.node.configuration || {}) as CompareAndSetMemoryConfiguration;
+    const metadata = (context.node.metadata || {}) as CompareAndSetMemoryMetadata;
+    const namespace = (metadata.namespace || "").trim();
+    const matchFields = extractConfiguredFields(config.matchList, metadata.matchFields, metadata.matches);
+    const field = (config.field || metadata.field || "").trim();
+
+    if (namespace) {
+      details["Namespace"] = namespace;
+    }
+    if (matchFields.length > 0) {
+      details["Match Fields"] = matchFields.join(", ");
+    }
+    if (field) {
+      details["Field"] = field;
+    }
+    if (metadata.set !== undefined) {
+      details["Result"] = metadata.set ? "Set" : "Conflict";
+    }
+
+    return details;
+  },
+};
+
+function getEventSections(nodes: NodeInfo[], execution: ExecutionInfo): EventSection[] {
+  const rootTriggerNode = nodes.find((n) => n.id === execution.rootEvent?.nodeId);
+  const rootTriggerRenderer = getTriggerRenderer(rootTriggerNode?.componentName || "");
+  const { title: fallbackTitle } = rootTriggerRenderer.getTitleAndSubtitle({ event: execution.rootEvent });
+  const subtitleTimestamp = execution.updatedAt || execution.createdAt;
+  const eventSubtitle = subtitleTimestamp ? formatTimeAgo(new Date(subtitleTimestamp)) : "";
+
+  return [
+    {
+      receivedAt: new Date(execution.createdAt),
+      eventTitle: fallbackTitle,
+      eventSubtitle,
+      eventState: getCompareAndSetMemoryState(execution),
+      eventId: execution.rootEvent?.id || "",
+    },
+  ];
+}
+
+function getCompareAndSetMemoryMetadataList(node: NodeInfo): Array<{ icon: string; label: string }> {
+  const config = (node.configuration || {}) as CompareAndSetMemoryConfiguration;
+  const metadata = (node.metadata || {}) as CompareAndSetMemoryMetadata;
+  const namespace = ((config.namespace as string) || metadata.namespace || "").trim();
+  const matchFields = extractConfiguredFields(config.matchList, metadata.matchFields, metadata.matches);
+  const field = (config.field || metadata.field || "").trim();
+  const items: Array<{ icon: string; label: string }> = [];
+
+  if (namespace) {
+    items.push({ icon: "database", label: namespace });
+  }
+  if (matchFields.length > 0) {
+    items.push({ icon: "search", label: `match: ${matchFields.join(", ")}` });
+  }
+  if (field) {
+    items.push({ icon: "lock", label: `compare and set: ${field}` });
+  }
+
+  return items;
+}
+
+function extractConfiguredFields(
+  list: Array<{ name?: string; value?: unknown }> | undefined,
+  metadataFields: string[] | undefined,
+  metadataMatches: Record<string, unknown> | undefined,
+): string[] {
+  const configFields = Array.isArray(list)
+    ? list.map((item) => (item?.name || "").trim()).filter((name): name is string => name.length > 0)
+    : [];
+
+  if (configFields.length > 0) {
+    return Array.from(new Set(configFields));
+  }
+
+  if (Array.isArray(metadataFields) && metadataFields.length > 0) {
+    return metadataFields.filter(Boolean);
+  }
+
+  return metadataMatches ? Object.keys(metadataMatches).filter((key) => key.trim().length > 0) : [];
+}
@@ -129,7 +129,11 @@ const SetSigningSecretSection: React.FC<{ nodeId: string }> = ({ nodeId }) => {
                   name: liveCanvas.metadata.name,
                   description: liveCanvas.metadata.description,
                 },
-                spec: { nodes: updatedVersion.spec?.nodes, edges: updatedVersion.spec?.edges },
+                spec: {
+                  nodes: updatedVersion.spec?.nodes,
+                  edges: updatedVersion.spec?.edges,
+                  variables: updatedVersion.spec?.variables,
+                },
               },
             },
           }),
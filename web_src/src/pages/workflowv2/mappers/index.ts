@@ -13,7 +13,9 @@ import { scheduleTriggerRenderer, scheduleCustomFieldRenderer } from "./schedule
 import { webhookTriggerRenderer, webhookCustomFieldRenderer } from "./webhook";
 import { noopMapper } from "./noop";
 import { addMemoryMapper } from "./addMemory";
+import { compareAndSetMemoryMapper } from "./compareAndSetMemory";
 import { deleteMemoryMapper } from "./deleteMemory";
+import { incrementMemoryMapper } from "./incrementMemory";
 import { readMemoryMapper } from "./readMemory";
 import { updateMemoryMapper } from "./updateMemory";
 import { upsertMemoryMapper } from "./upsertMemory";
@@ -249,7 +251,9 @@ const triggerRenderers: Record<string, TriggerRenderer> = {
 const componentBaseMappers: Record<string, ComponentBaseMapper> = {
   noop: noopMapper,
   addMemory: addMemoryMapper,
+  compareAndSetMemory: compareAndSetMemoryMapper,
   deleteMemory: deleteMemoryMapper,
+  incrementMemory: incrementMemoryMapper,
   readMemory: readMemoryMapper,
   updateMemory: updateMemoryMapper,
   upsertMemory: upsertMemoryMapper,